@@ -0,0 +1,424 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"time"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
+	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/mailer"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Validade dos links enviados por email
+const (
+	passwordResetTTL     = time.Hour
+	emailVerificationTTL = 48 * time.Hour
+)
+
+// errAccountTokenInvalid indica token inexistente, expirado ou já utilizado
+var errAccountTokenInvalid = errors.New("token inválido ou expirado")
+
+// ForgotPasswordRequest contém o email da conta que esqueceu a senha
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+// ResetPasswordRequest contém o token recebido por email e a nova senha
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// VerifyEmailRequest contém o token de verificação recebido por email
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+// ForgotPassword envia um link de redefinição de senha para o email informado
+// POST /api/auth/forgot-password
+func ForgotPassword(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	m := c.Locals("mailer").(mailer.Mailer)
+	appURL := c.Locals("appURL").(string)
+	ctx := context.Background()
+
+	var req ForgotPasswordRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	email := normalizeEmail(req.Email)
+	if email == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Email é obrigatório",
+		})
+	}
+
+	// A resposta é a mesma exista ou não a conta, para não revelar emails cadastrados
+	response := fiber.Map{
+		"message": "Se o email estiver cadastrado, você receberá um link para redefinir a senha",
+	}
+
+	u, err := client.User.
+		Query().
+		Where(user.Email(email)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusOK).JSON(response)
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	// Gerar o token e enviar o email em segundo plano, para que o tempo de resposta
+	// também não revele se o email está cadastrado
+	go sendPasswordReset(client, m, appURL, u)
+
+	return c.Status(fiber.StatusOK).JSON(response)
+}
+
+// ResetPassword define uma nova senha usando o token recebido por email
+// POST /api/auth/reset-password
+func ResetPassword(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	var req ResetPasswordRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Token == "" || req.Password == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Token e nova senha são obrigatórios",
+		})
+	}
+
+	if len(req.Password) < minPasswordLength {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "A senha deve ter pelo menos 8 caracteres",
+		})
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao processar senha",
+			"error":   err.Error(),
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	u, err := consumeAccountToken(ctx, tx, req.Token, accounttoken.PurposePasswordReset)
+	if err != nil {
+		return accountTokenErrorResponse(c, rollback(tx, err))
+	}
+
+	// Quem recebeu o link comprovou ser dono do email
+	update := tx.User.
+		UpdateOne(u).
+		SetPassword(string(hashedPassword))
+	if u.EmailVerifiedAt == nil {
+		update = update.SetEmailVerifiedAt(time.Now())
+	}
+
+	if _, err := update.Save(ctx); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar senha",
+			"error":   rollback(tx, err).Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar senha",
+			"error":   err.Error(),
+		})
+	}
+
+	// Encerrar todas as sessões abertas com a senha antiga
+	if _, err := revokeUserSessions(ctx, client, u.ID, ""); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Senha redefinida, mas houve erro ao encerrar as sessões",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Senha redefinida com sucesso",
+	})
+}
+
+// VerifyEmail confirma o email do usuário usando o token recebido por email
+// POST /api/auth/verify-email
+func VerifyEmail(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	var req VerifyEmailRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Token é obrigatório",
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	u, err := consumeAccountToken(ctx, tx, req.Token, accounttoken.PurposeEmailVerification)
+	if err != nil {
+		return accountTokenErrorResponse(c, rollback(tx, err))
+	}
+
+	if u.EmailVerifiedAt == nil {
+		u, err = tx.User.
+			UpdateOne(u).
+			SetEmailVerifiedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao verificar email",
+				"error":   rollback(tx, err).Error(),
+			})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar email",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Email verificado com sucesso",
+		"user":    newUserResponse(u.Unwrap()),
+	})
+}
+
+// SendVerificationEmail reenvia o link de verificação para o usuário autenticado
+// POST /api/users/verify-email
+func SendVerificationEmail(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	m := c.Locals("mailer").(mailer.Mailer)
+	appURL := c.Locals("appURL").(string)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Usuário não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if u.EmailVerifiedAt != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Email já verificado",
+		})
+	}
+
+	if err := sendVerificationEmail(ctx, client, m, appURL, u); err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"message": "Erro ao enviar email de verificação",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Email de verificação enviado",
+	})
+}
+
+// Helper que gera e envia um novo link de verificação de email
+func sendVerificationEmail(ctx context.Context, client *ent.Client, m mailer.Mailer, appURL string, u *ent.User) error {
+	token, err := issueAccountToken(ctx, client, u, accounttoken.PurposeEmailVerification, emailVerificationTTL)
+	if err != nil {
+		return err
+	}
+	return m.Send(ctx, verificationMessage(appURL, u, token))
+}
+
+// Helper que emite um token de uso único para o usuário, invalidando os anteriores
+// ainda não usados com a mesma finalidade
+func issueAccountToken(ctx context.Context, client *ent.Client, u *ent.User, purpose accounttoken.Purpose, ttl time.Duration) (string, error) {
+	token, err := newOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.AccountToken.
+		Delete().
+		Where(
+			accounttoken.UserID(u.ID),
+			accounttoken.PurposeEQ(purpose),
+			accounttoken.UsedAtIsNil(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return "", rollback(tx, err)
+	}
+
+	_, err = tx.AccountToken.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(u.ID).
+		SetPurpose(purpose).
+		SetTokenHash(hashToken(token)).
+		SetEmail(u.Email).
+		SetExpiresAt(time.Now().Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return "", rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// Helper que marca o token como usado e retorna o dono. A marcação é condicional,
+// então o mesmo token nunca é aceito duas vezes.
+func consumeAccountToken(ctx context.Context, tx *ent.Tx, token string, purpose accounttoken.Purpose) (*ent.User, error) {
+	hash := hashToken(token)
+
+	consumed, err := tx.AccountToken.
+		Update().
+		Where(
+			accounttoken.TokenHash(hash),
+			accounttoken.PurposeEQ(purpose),
+			accounttoken.UsedAtIsNil(),
+			accounttoken.ExpiresAtGT(time.Now()),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if consumed == 0 {
+		return nil, errAccountTokenInvalid
+	}
+
+	tokenObj, err := tx.AccountToken.
+		Query().
+		Where(accounttoken.TokenHash(hash)).
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens emitidos para um email que o usuário não usa mais perdem a validade
+	u := tokenObj.Edges.User
+	if u == nil || u.Email != tokenObj.Email {
+		return nil, errAccountTokenInvalid
+	}
+	return u, nil
+}
+
+// Helper para responder a falhas no consumo de tokens enviados por email
+func accountTokenErrorResponse(c fiber.Ctx, err error) error {
+	if errors.Is(err, errAccountTokenInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Token inválido ou expirado",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"message": "Erro ao validar token",
+		"error":   err.Error(),
+	})
+}
+
+// Helper que gera o token de redefinição de senha e envia o email. Roda fora da
+// requisição, então as falhas são apenas registradas no log.
+func sendPasswordReset(client *ent.Client, m mailer.Mailer, appURL string, u *ent.User) {
+	ctx := context.Background()
+
+	token, err := issueAccountToken(ctx, client, u, accounttoken.PurposePasswordReset, passwordResetTTL)
+	if err != nil {
+		log.Printf("Erro ao gerar token de redefinição de senha para %s: %v", u.Email, err)
+		return
+	}
+
+	if err := m.Send(ctx, passwordResetMessage(appURL, u, token)); err != nil {
+		log.Printf("Erro ao enviar email de redefinição de senha para %s: %v", u.Email, err)
+	}
+}
+
+// Helper que monta o email de redefinição de senha
+func passwordResetMessage(appURL string, u *ent.User, token string) mailer.Message {
+	link := fmt.Sprintf("%s/reset-password?token=%s", appURL, url.QueryEscape(token))
+	return mailer.Message{
+		To:      u.Email,
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf("Olá, %s.\n\nRecebemos um pedido para redefinir a sua senha. Para escolher uma nova senha, acesse:\n\n%s\n\nO link vale por %d minutos e só pode ser usado uma vez. Se você não fez este pedido, ignore este email.\n",
+			u.Name, link, int(passwordResetTTL.Minutes())),
+	}
+}
+
+// Helper que monta o email de verificação de endereço
+func verificationMessage(appURL string, u *ent.User, token string) mailer.Message {
+	link := fmt.Sprintf("%s/verify-email?token=%s", appURL, url.QueryEscape(token))
+	return mailer.Message{
+		To:      u.Email,
+		Subject: "Confirme seu email",
+		Body: fmt.Sprintf("Olá, %s.\n\nPara confirmar o seu endereço de email, acesse:\n\n%s\n\nO link vale por %d horas.\n",
+			u.Name, link, int(emailVerificationTTL.Hours())),
+	}
+}
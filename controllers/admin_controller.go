@@ -6,19 +6,20 @@ import (
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 
 	"github.com/gofiber/fiber/v3"
 )
 
 // DashboardData contém estatísticas para o dashboard administrativo
 type DashboardData struct {
-	Revenue          float64 `json:"revenue"`
+	Revenue          money.Amount `json:"revenue"`
 	Orders           int     `json:"orders"`
 	Users            int     `json:"users"`
 	Products         int     `json:"products"`
 	RecentOrders     []Order `json:"recentOrders"`
 	TopSellingItems  []Item  `json:"topSellingItems"`
-	MonthlyRevenue   []float64 `json:"monthlyRevenue"`
+	MonthlyRevenue   []money.Amount `json:"monthlyRevenue"`
 }
 
 // Item representa um produto com suas estatísticas de vendas
@@ -26,7 +27,7 @@ type Item struct {
 	ID          int     `json:"id"`
 	Name        string  `json:"name"`
 	Quantity    int     `json:"quantity"`
-	TotalSales  float64 `json:"totalSales"`
+	TotalSales  money.Amount `json:"totalSales"`
 }
 
 // GetDashboardData retorna dados estatísticos para o dashboard administrativo
@@ -39,7 +40,7 @@ func GetDashboardData(c fiber.Ctx) error {
 	startDate := endDate.AddDate(0, -1, 0)
 
 	// 1. Obter receita total
-	var revenue float64
+	var revenue money.Amount
 	err := client.Order.Query().
 		Where(order.StatusIn(
 			order.StatusCompleted,
@@ -105,7 +106,7 @@ func GetDashboardData(c fiber.Ctx) error {
 	}
 
 	// 6. Receita mensal (últimos 12 meses)
-	monthlyRevenue := make([]float64, 12)
+	monthlyRevenue := make([]money.Amount, 12)
 	currentMonth := endDate.Month()
 	currentYear := endDate.Year()
 
@@ -122,7 +123,7 @@ func GetDashboardData(c fiber.Ctx) error {
 		startOfMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
 		endOfMonth := startOfMonth.AddDate(0, 1, 0).Add(-time.Second)
 		
-		var monthRevenue float64
+		var monthRevenue money.Amount
 		err := client.Order.Query().
 			Where(
 				order.StatusIn(
@@ -190,15 +191,9 @@ func GetAllUsers(c fiber.Ctx) error {
 	}
 
 	// Formatando resposta - removendo campos sensíveis
-	formattedUsers := make([]fiber.Map, 0, len(users))
+	formattedUsers := make([]UserResponse, 0, len(users))
 	for _, u := range users {
-		formattedUsers = append(formattedUsers, fiber.Map{
-			"id":        u.ID,
-			"name":      u.Name,
-			"email":     u.Email,
-			"isAdmin":   u.IsAdmin,
-			"createdAt": u.CreatedAt.Format(time.RFC3339),
-		})
+		formattedUsers = append(formattedUsers, newUserResponse(u))
 	}
 
 	return c.JSON(fiber.Map{
@@ -1,41 +1,453 @@
 package controllers
 
 import (
+	"context"
+	"log"
+	"strings"
+	"time"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/loginguard"
+	"github.com/vtrod/veecomm-api/mailer"
+	"github.com/vtrod/veecomm-api/middleware"
+
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
 )
 
-// LoginUser realiza o login do usuário
+// Tamanho mínimo de senha aceito no cadastro e na troca de senha
+const minPasswordLength = 8
+
+// LoginRequest contém as credenciais para autenticação
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Device   string `json:"device"`
+}
+
+// RegisterRequest contém os dados para criação de usuário
+type RegisterRequest struct {
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Phone    string `json:"phone"`
+	Device   string `json:"device"`
+}
+
+// UserResponse representa os dados de usuário retornados ao cliente
+type UserResponse struct {
+	ID            string    `json:"id"`
+	Name          string    `json:"name"`
+	Email         string    `json:"email"`
+	EmailVerified bool      `json:"emailVerified"`
+	TwoFactor     bool      `json:"twoFactorEnabled"`
+	Phone         string    `json:"phone,omitempty"`
+	ProfileImage  string    `json:"profileImage,omitempty"`
+	Role          string    `json:"role"`
+	Permissions   []string  `json:"permissions"`
+	IsAdmin       bool      `json:"isAdmin"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+// ProfileUpdateRequest contém os dados para atualização de perfil
+type ProfileUpdateRequest struct {
+	Name            string `json:"name"`
+	Phone           string `json:"phone"`
+	ProfileImage    string `json:"profileImage"`
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword"`
+}
+
+// Hash usado para comparar senhas de emails inexistentes, mantendo o mesmo tempo de resposta
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("veecomm-dummy-password"), bcrypt.DefaultCost)
+
+// LoginUser autentica um usuário e retorna um token de acesso e um refresh token
 // POST /api/auth/login
 func LoginUser(c fiber.Ctx) error {
-	// TODO: Implementar login de usuário
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Login de usuário será implementado aqui",
-	})
+	client := c.Locals("dbClient").(*ent.Client)
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	guard := c.Locals("loginGuard").(*loginguard.Guard)
+	ctx := context.Background()
+
+	var req LoginRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	// Validar dados
+	email := normalizeEmail(req.Email)
+	if email == "" || req.Password == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Email e senha são obrigatórios",
+		})
+	}
+
+	// Recusar tentativas de contas ou IPs com falhas recentes demais
+	if handled, err := guardLoginAttempt(c, client, guard, email, ""); handled {
+		return err
+	}
+
+	// Buscar usuário pelo email
+	u, err := client.User.
+		Query().
+		Where(user.Email(email)).
+		First(ctx)
+
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			releaseLoginAttempt(guard, email, c.IP())
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar usuário",
+				"error":   err.Error(),
+			})
+		}
+
+		// Comparar com um hash fictício para não revelar se o email existe
+		bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(req.Password))
+		recordLoginFailure(c, client, guard, email, "", loginattempt.ReasonInvalidCredentials)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Credenciais inválidas",
+		})
+	}
+
+	// Verificar senha
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)); err != nil {
+		recordLoginFailure(c, client, guard, email, u.ID, loginattempt.ReasonInvalidCredentials)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Credenciais inválidas",
+		})
+	}
+
+	// Contas com 2FA recebem apenas um desafio; os tokens saem em /api/auth/2fa.
+	// Os contadores só são zerados depois do segundo fator.
+	if u.TotpEnabledAt != nil {
+		releaseLoginAttempt(guard, email, c.IP())
+		return twoFactorChallengeResponse(c, authConfig, u)
+	}
+
+	if err := guard.Succeed(ctx, email, c.IP()); err != nil {
+		log.Printf("Erro ao zerar tentativas de login de %s: %v", email, err)
+	}
+
+	return authResponse(c, fiber.StatusOK, client, authConfig, u, req.Device)
 }
 
 // RegisterUser registra um novo usuário
 // POST /api/auth/register
 func RegisterUser(c fiber.Ctx) error {
-	// TODO: Implementar registro de usuário
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"message": "Registro de usuário será implementado aqui",
-	})
+	client := c.Locals("dbClient").(*ent.Client)
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	ctx := context.Background()
+
+	var req RegisterRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	// Validar dados
+	email := normalizeEmail(req.Email)
+	name := strings.TrimSpace(req.Name)
+	if name == "" || email == "" || req.Password == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Nome, email e senha são obrigatórios",
+		})
+	}
+
+	if !strings.Contains(email, "@") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Email inválido",
+		})
+	}
+
+	if len(req.Password) < minPasswordLength {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "A senha deve ter pelo menos 8 caracteres",
+		})
+	}
+
+	// Verificar se email já existe
+	exists, err := client.User.
+		Query().
+		Where(user.Email(email)).
+		Exist(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar email",
+			"error":   err.Error(),
+		})
+	}
+
+	if exists {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Email já está em uso",
+		})
+	}
+
+	// Hash da senha
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao processar senha",
+			"error":   err.Error(),
+		})
+	}
+
+	// Criar usuário
+	u, err := client.User.
+		Create().
+		SetID(uuid.New().String()).
+		SetName(name).
+		SetEmail(email).
+		SetPassword(string(hashedPassword)).
+		SetNillablePhone(nilIfEmpty(req.Phone)).
+		Save(ctx)
+
+	if err != nil {
+		// O índice único de email cobre cadastros simultâneos
+		if ent.IsConstraintError(err) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "Email já está em uso",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao criar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	// Enviar o link de verificação sem bloquear o cadastro em caso de falha
+	m := c.Locals("mailer").(mailer.Mailer)
+	if err := sendVerificationEmail(ctx, client, m, c.Locals("appURL").(string), u); err != nil {
+		log.Printf("Erro ao enviar email de verificação para %s: %v", u.Email, err)
+	}
+
+	return authResponse(c, fiber.StatusCreated, client, authConfig, u, req.Device)
 }
 
 // GetUserProfile retorna o perfil do usuário autenticado
 // GET /api/users/profile
 func GetUserProfile(c fiber.Ctx) error {
-	// TODO: Implementar obtenção de perfil do usuário
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	// Buscar usuário no banco
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Usuário não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Perfil do usuário será implementado aqui",
+		"user": newUserResponse(u),
 	})
 }
 
 // UpdateUserProfile atualiza o perfil do usuário autenticado
 // PUT /api/users/profile
 func UpdateUserProfile(c fiber.Ctx) error {
-	// TODO: Implementar atualização de perfil do usuário
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	var req ProfileUpdateRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar usuário no banco
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Usuário não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	// Iniciar construção da atualização
+	update := client.User.
+		UpdateOneID(userId).
+		SetUpdatedAt(time.Now())
+
+	// Aplicar cada campo que foi enviado
+	if name := strings.TrimSpace(req.Name); name != "" {
+		update = update.SetName(name)
+	}
+	update = update.SetNillablePhone(nilIfEmpty(req.Phone))
+	update = update.SetNillableProfileImage(nilIfEmpty(req.ProfileImage))
+
+	// Trocar a senha somente com a confirmação da senha atual
+	if req.NewPassword != "" {
+		if req.CurrentPassword == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "Informe a senha atual para alterar a senha",
+			})
+		}
+
+		if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.CurrentPassword)); err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Senha atual incorreta",
+			})
+		}
+
+		if len(req.NewPassword) < minPasswordLength {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "A nova senha deve ter pelo menos 8 caracteres",
+			})
+		}
+
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.NewPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao processar nova senha",
+				"error":   err.Error(),
+			})
+		}
+
+		update = update.SetPassword(string(hashedPassword))
+	}
+
+	// Salvar atualização
+	updatedUser, err := update.Save(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar perfil",
+			"error":   err.Error(),
+		})
+	}
+
+	// Após trocar a senha, encerrar as demais sessões do usuário
+	if req.NewPassword != "" {
+		currentID, _ := c.Locals("sessionId").(string)
+		if _, err := revokeUserSessions(ctx, client, userId, currentID); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Senha alterada, mas houve erro ao encerrar as outras sessões",
+				"error":   err.Error(),
+			})
+		}
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Atualização de perfil será implementada aqui",
+		"message": "Perfil atualizado com sucesso",
+		"user":    newUserResponse(updatedUser),
 	})
-} 
\ No newline at end of file
+}
+
+// GetJWKS publica as chaves públicas usadas para assinar os tokens de acesso
+// GET /.well-known/jwks.json
+func GetJWKS(c fiber.Ctx) error {
+	authConfig := c.Locals("authConfig").(middleware.Config)
+
+	// Permitir cache curto para que chaves novas sejam vistas logo após uma rotação
+	c.Set(fiber.HeaderCacheControl, "public, max-age=300")
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"keys": authConfig.Keys.JWKS(),
+	})
+}
+
+// Helper que abre uma sessão para o usuário e monta a resposta de login/registro
+func authResponse(c fiber.Ctx, status int, client *ent.Client, authConfig middleware.Config, u *ent.User, device string) error {
+	sessionObj, refreshToken, err := createSession(context.Background(), client, authConfig, u.ID, "", strings.TrimSpace(device), c.IP(), c.Get("User-Agent"))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao criar sessão",
+			"error":   err.Error(),
+		})
+	}
+
+	// Incorporar o carrinho montado antes do login. Uma falha não impede o login e o
+	// carrinho de visitante continua disponível para a próxima tentativa.
+	cartMerge, err := mergeGuestCart(c, client, authConfig, u.ID)
+	if err != nil {
+		log.Printf("Erro ao incorporar carrinho de visitante do usuário %s: %v", u.ID, err)
+	} else if cartMerge != nil {
+		clearCartToken(c)
+	}
+
+	return tokenResponse(c, status, authConfig, u, sessionObj, refreshToken, cartMerge)
+}
+
+// Helper que emite o token de acesso da sessão e devolve o par de tokens ao cliente,
+// junto com o resultado da incorporação do carrinho de visitante, se houver
+func tokenResponse(c fiber.Ctx, status int, authConfig middleware.Config, u *ent.User, sessionObj *ent.Session, refreshToken string, cartMerge *CartMergeResult) error {
+	token, expiresAt, err := authConfig.GenerateToken(u.ID, string(u.Role), sessionObj.FamilyID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao gerar token",
+			"error":   err.Error(),
+		})
+	}
+
+	response := fiber.Map{
+		"token":            token,
+		"expiresAt":        expiresAt,
+		"refreshToken":     refreshToken,
+		"refreshExpiresAt": sessionObj.ExpiresAt,
+		"user":             newUserResponse(u),
+	}
+	if cartMerge != nil {
+		response["cartMerge"] = cartMerge
+	}
+
+	return c.Status(status).JSON(response)
+}
+
+// Helper para montar os dados públicos do usuário (sem senha)
+func newUserResponse(u *ent.User) UserResponse {
+	return UserResponse{
+		ID:            u.ID,
+		Name:          u.Name,
+		Email:         u.Email,
+		EmailVerified: u.EmailVerifiedAt != nil,
+		TwoFactor:     u.TotpEnabledAt != nil,
+		Phone:         u.Phone,
+		ProfileImage:  u.ProfileImage,
+		Role:          string(u.Role),
+		Permissions:   middleware.EffectivePermissions(u),
+		IsAdmin:       u.Role == user.RoleAdmin,
+		CreatedAt:     u.CreatedAt,
+	}
+}
+
+// Helper para normalizar emails antes de buscar ou salvar
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
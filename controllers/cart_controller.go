@@ -2,14 +2,18 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"strconv"
 	"github.com/vtrod/veecomm-api/database"
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/cart"
-	"github.com/vtrod/veecomm-api/ent/cart_item"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
 	"github.com/vtrod/veecomm-api/ent/coupon"
 	"github.com/vtrod/veecomm-api/ent/address"
+	"github.com/vtrod/veecomm-api/money"
+	"github.com/vtrod/veecomm-api/shipping"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -19,38 +23,45 @@ import (
 // Estrutura para adicionar/atualizar item no carrinho
 type CartItemRequest struct {
 	ProductID string `json:"product_id"`
+	VariantID string `json:"variant_id,omitempty"`
 	Quantity  int    `json:"quantity"`
 }
 
-// Estrutura para aplicar cupom
-type CouponRequest struct {
-	Code string `json:"code"`
-}
-
-// Estrutura para atualizar endereço de entrega
+// Estrutura para atualizar endereço e modalidade de entrega
 type ShippingAddressRequest struct {
 	AddressID string `json:"address_id"`
+	CEP       string `json:"cep"`
+	Estado    string `json:"estado"`
+	Service   string `json:"service"`
 }
 
-// GetCart retorna o carrinho do usuário atual
+// GetCart retorna o carrinho do usuário atual ou do visitante
 // GET /api/cart
 func GetCart(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
+
+	// Buscar o carrinho do usuário ou do visitante. Visitantes só ganham um carrinho
+	// ao adicionar o primeiro item.
+	cartObj, err := currentCart(c, client, getUserIdFromContext(c) != "")
+	if err != nil {
+		if errors.Is(err, errCartNotFound) {
+			return c.Status(fiber.StatusOK).JSON(fiber.Map{
+				"cart":  nil,
+				"items": []*ent.CartItem{},
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar carrinho",
+			"error":   err.Error(),
 		})
 	}
 
-	// Buscar ou criar carrinho para o usuário
-	cartObj, err := getOrCreateCart(ctx, client, userId)
+	// Reprecificar o carrinho com os preços e estoques atuais dos produtos
+	cartObj, err = updateCartTotals(ctx, client, cartObj.ID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao buscar carrinho",
+			"message": "Erro ao atualizar totais do carrinho",
 			"error":   err.Error(),
 		})
 	}
@@ -58,7 +69,7 @@ func GetCart(c fiber.Ctx) error {
 	// Buscar itens do carrinho
 	items, err := client.CartItem.
 		Query().
-		Where(cart_item.CartID(cartObj.ID)).
+		Where(cartitem.CartID(cartObj.ID)).
 		All(ctx)
 
 	if err != nil {
@@ -69,8 +80,9 @@ func GetCart(c fiber.Ctx) error {
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"cart":  cartObj,
-		"items": items,
+		"cart":           cartObj,
+		"items":          items,
+		"pendingChanges": cartHasPendingChanges(items),
 	})
 }
 
@@ -79,18 +91,10 @@ func GetCart(c fiber.Ctx) error {
 func AddToCart(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
-	}
 
 	// Extrair dados do request
 	var req CartItemRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -122,8 +126,28 @@ func AddToCart(c fiber.Ctx) error {
 		})
 	}
 
-	// Buscar ou criar carrinho para o usuário
-	cartObj, err := getOrCreateCart(ctx, client, userId)
+	// Verificar a variante escolhida (obrigatória em produtos com variantes)
+	variant, err := cartVariant(ctx, client, prod, req.VariantID)
+	if err != nil {
+		if errors.Is(err, errVariantRequired) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "Escolha uma variante do produto",
+				"code":    "variant_required",
+			})
+		}
+		if errors.Is(err, errVariantNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Variante não encontrada",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar ou criar carrinho para o usuário ou visitante
+	cartObj, err := currentCart(c, client, true)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao buscar carrinho",
@@ -131,35 +155,57 @@ func AddToCart(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar se o produto já está no carrinho
+	// Verificar se o produto (na mesma variante) já está no carrinho
+	sameVariant := cartitem.VariantIDIsNil()
+	if variant != nil {
+		sameVariant = cartitem.VariantID(variant.ID)
+	}
 	existingItem, err := client.CartItem.
 		Query().
 		Where(
-			cart_item.CartID(cartObj.ID),
-			cart_item.ProductID(req.ProductID),
+			cartitem.CartID(cartObj.ID),
+			cartitem.ProductID(req.ProductID),
+			sameVariant,
 		).
 		First(ctx)
 
+	// Verificar estoque considerando a quantidade já presente no carrinho
+	requestedQuantity := req.Quantity
+	if err == nil && existingItem != nil {
+		requestedQuantity += existingItem.Quantity
+	}
+	if available := variantStock(prod, variant); requestedQuantity > available {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message":   "Estoque insuficiente para o produto",
+			"available": available,
+		})
+	}
+
 	// Atualizar quantidade se já existir ou criar novo item
 	var item *ent.CartItem
 	if err == nil && existingItem != nil {
 		// Atualizar quantidade
 		item, err = client.CartItem.
 			UpdateOne(existingItem).
-			SetQuantity(existingItem.Quantity + req.Quantity).
+			SetQuantity(requestedQuantity).
 			Save(ctx)
 	} else {
 		// Criar novo item
-		item, err = client.CartItem.
+		create := client.CartItem.
 			Create().
 			SetID(uuid.New().String()).
 			SetCartID(cartObj.ID).
 			SetProductID(req.ProductID).
 			SetName(prod.Name).
-			SetPrice(prod.Price).
-			SetImage(prod.Image).
-			SetQuantity(req.Quantity).
-			Save(ctx)
+			SetPrice(variantPrice(prod, variant)).
+			SetImage(variantImage(prod, variant)).
+			SetQuantity(req.Quantity)
+		if variant != nil {
+			create = create.
+				SetVariantID(variant.ID).
+				SetVariantOptions(variant.Options)
+		}
+		item, err = create.Save(ctx)
 	}
 
 	if err != nil {
@@ -191,18 +237,10 @@ func UpdateCartItem(c fiber.Ctx) error {
 	itemId := c.Params("itemId")
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
-	}
 
 	// Extrair dados do request
 	var req CartItemRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -216,14 +254,10 @@ func UpdateCartItem(c fiber.Ctx) error {
 		})
 	}
 
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
-		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
-
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, errCartNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"message": "Carrinho não encontrado",
 			})
@@ -238,8 +272,8 @@ func UpdateCartItem(c fiber.Ctx) error {
 	item, err := client.CartItem.
 		Query().
 		Where(
-			cart_item.ID(itemId),
-			cart_item.HasCartWith(cart.ID(cartObj.ID)),
+			cartitem.ID(itemId),
+			cartitem.HasCartWith(cart.ID(cartObj.ID)),
 		).
 		First(ctx)
 
@@ -255,10 +289,49 @@ func UpdateCartItem(c fiber.Ctx) error {
 		})
 	}
 
-	// Atualizar quantidade do item
+	// Verificar estoque disponível para a nova quantidade
+	prod, err := client.Product.Get(ctx, item.ProductID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Produto não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar produto",
+			"error":   err.Error(),
+		})
+	}
+
+	var variant *ent.ProductVariant
+	if item.VariantID != "" {
+		variant, err = client.ProductVariant.Get(ctx, item.VariantID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"message": "Variante não encontrada",
+				})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao verificar variante",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if available := variantStock(prod, variant); req.Quantity > available {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message":   "Estoque insuficiente para o produto",
+			"available": available,
+		})
+	}
+
+	// Atualizar quantidade do item; a quantidade escolhida pelo cliente substitui a
+	// redução por falta de estoque ainda não confirmada
 	updatedItem, err := client.CartItem.
 		UpdateOne(item).
 		SetQuantity(req.Quantity).
+		ClearPreviousQuantity().
 		Save(ctx)
 
 	if err != nil {
@@ -290,23 +363,11 @@ func RemoveCartItem(c fiber.Ctx) error {
 	itemId := c.Params("itemId")
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
-	}
-
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
-		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
 
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, errCartNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"message": "Carrinho não encontrado",
 			})
@@ -321,8 +382,8 @@ func RemoveCartItem(c fiber.Ctx) error {
 	exists, err := client.CartItem.
 		Query().
 		Where(
-			cart_item.ID(itemId),
-			cart_item.HasCartWith(cart.ID(cartObj.ID)),
+			cartitem.ID(itemId),
+			cartitem.HasCartWith(cart.ID(cartObj.ID)),
 		).
 		Exist(ctx)
 
@@ -371,18 +432,10 @@ func RemoveCartItem(c fiber.Ctx) error {
 func ApplyCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
-	}
 
 	// Extrair dados do request
 	var req CouponRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -417,28 +470,10 @@ func ApplyCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar se o cupom expirou
-	if couponObj.ExpiresAt != nil && couponObj.ExpiresAt.Before(time.Now()) {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Cupom expirado",
-		})
-	}
-
-	// Verificar número máximo de usos
-	if couponObj.MaxUses != nil && *couponObj.MaxUses <= couponObj.TimesUsed {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Limite de uso do cupom excedido",
-		})
-	}
-
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
-		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
-
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, errCartNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"message": "Carrinho não encontrado",
 			})
@@ -449,10 +484,10 @@ func ApplyCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar valor mínimo de compra
-	if cartObj.Subtotal < couponObj.MinPurchase {
+	// Verificar validade, limite de usos e valor mínimo, com as mesmas regras do checkout
+	if problem := couponProblem(couponObj, cartObj.Subtotal, time.Now()); problem != "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Valor mínimo para uso do cupom não atingido",
+			"message":      problem,
 			"min_purchase": couponObj.MinPurchase,
 		})
 	}
@@ -464,15 +499,7 @@ func ApplyCoupon(c fiber.Ctx) error {
 		SetCouponCode(req.Code)
 
 	// Calcular desconto
-	var discount float64
-	if couponObj.DiscountType == "percentage" {
-		discount = cartObj.Subtotal * (couponObj.DiscountValue / 100)
-	} else { // fixed
-		discount = couponObj.DiscountValue
-		if discount > cartObj.Subtotal {
-			discount = cartObj.Subtotal
-		}
-	}
+	discount := couponDiscount(couponObj, cartObj.Subtotal)
 
 	// Atualizar desconto e total
 	updateCart = updateCart.
@@ -488,21 +515,7 @@ func ApplyCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Incrementar número de usos do cupom
-	_, err = client.Coupon.
-		UpdateOne(couponObj).
-		SetTimesUsed(couponObj.TimesUsed + 1).
-		Save(ctx)
-
-	if err != nil {
-		// Não falhar a operação, mas registrar o erro
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Cupom aplicado com sucesso, mas houve erro ao atualizar contador de usos",
-			"cart":    updatedCart,
-			"error":   err.Error(),
-		})
-	}
-
+	// O uso do cupom só é contabilizado na finalização do pedido (CreateOrder)
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Cupom aplicado com sucesso",
 		"cart":    updatedCart,
@@ -514,23 +527,11 @@ func ApplyCoupon(c fiber.Ctx) error {
 func RemoveCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
-	}
-
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
-		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
 
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		if errors.Is(err, errCartNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"message": "Carrinho não encontrado",
 			})
@@ -548,9 +549,6 @@ func RemoveCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Armazenar código do cupom para decrementar uso
-	couponCode := cartObj.CouponCode
-
 	// Remover o cupom do carrinho
 	updatedCart, err := client.Cart.
 		UpdateOne(cartObj).
@@ -567,58 +565,192 @@ func RemoveCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Decrementar número de usos do cupom
-	if couponCode != "" {
-		couponObj, err := client.Coupon.
-			Query().
-			Where(coupon.Code(couponCode)).
-			First(ctx)
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Cupom removido com sucesso",
+		"cart":    updatedCart,
+	})
+}
 
-		if err == nil && couponObj != nil && couponObj.TimesUsed > 0 {
-			_, err = client.Coupon.
-				UpdateOne(couponObj).
-				SetTimesUsed(couponObj.TimesUsed - 1).
-				Save(ctx)
-
-			if err != nil {
-				// Não falhar a operação, mas registrar o erro
-				return c.Status(fiber.StatusOK).JSON(fiber.Map{
-					"message": "Cupom removido com sucesso, mas houve erro ao atualizar contador de usos",
-					"cart":    updatedCart,
-					"error":   err.Error(),
-				})
-			}
+// SetCartShipping seleciona a modalidade de frete do carrinho
+// PUT /api/cart/shipping
+func SetCartShipping(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	provider := c.Locals("shippingProvider").(shipping.ShippingProvider)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req ShippingAddressRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Service == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Modalidade de frete é obrigatória",
+		})
+	}
+
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
+	if err != nil {
+		if errors.Is(err, errCartNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Carrinho não encontrado",
+			})
 		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar carrinho",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar itens do carrinho
+	cartItems, err := client.CartItem.
+		Query().
+		Where(cartitem.CartID(cartObj.ID)).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar itens do carrinho",
+			"error":   err.Error(),
+		})
+	}
+
+	if len(cartItems) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "O carrinho está vazio",
+		})
+	}
+
+	// Resolver o destino e cotar novamente no servidor (nunca confiar no preço do cliente)
+	cep, estado, err := resolveShippingDestination(ctx, client, getUserIdFromContext(c), req.AddressID, req.CEP, req.Estado)
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+
+	option, err := quoteCartShipping(ctx, client, provider, cartItems, cep, estado, req.Service)
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+
+	// Persistir a modalidade escolhida e recalcular o total
+	updatedCart, err := client.Cart.
+		UpdateOne(cartObj).
+		SetShipping(option.Price).
+		SetShippingService(option.Service).
+		SetShippingCep(cep).
+		SetTotal(cartObj.Subtotal - cartObj.Discount + option.Price).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar frete do carrinho",
+			"error":   err.Error(),
+		})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Cupom removido com sucesso",
-		"cart":    updatedCart,
+		"message":  "Frete selecionado com sucesso",
+		"shipping": option,
+		"cart":     updatedCart,
 	})
 }
 
-// ClearCart remove todos os itens do carrinho
-// DELETE /api/cart
-func ClearCart(c fiber.Ctx) error {
+// AcknowledgeCartChanges confirma as alterações de preço e disponibilidade dos itens,
+// removendo os indisponíveis. Pedidos só são criados depois dessa confirmação.
+// POST /api/cart/acknowledge
+func AcknowledgeCartChanges(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
+
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
+	if err != nil {
+		if errors.Is(err, errCartNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Carrinho não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar carrinho",
+			"error":   err.Error(),
 		})
 	}
 
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
+	// Remover os itens indisponíveis
+	_, err = client.CartItem.
+		Delete().
+		Where(
+			cartitem.CartID(cartObj.ID),
+			cartitem.UnavailableReasonNotNil(),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao remover itens indisponíveis",
+			"error":   err.Error(),
+		})
+	}
+
+	// Aceitar os preços e quantidades atuais
+	_, err = client.CartItem.
+		Update().
+		Where(cartitem.CartID(cartObj.ID)).
+		ClearPreviousPrice().
+		ClearPreviousQuantity().
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao confirmar alterações do carrinho",
+			"error":   err.Error(),
+		})
+	}
+
+	// Atualizar totais do carrinho
+	updatedCart, err := updateCartTotals(ctx, client, cartObj.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Alterações confirmadas, mas houve erro ao atualizar totais do carrinho",
+			"error":   err.Error(),
+		})
+	}
+
+	items, err := client.CartItem.
 		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
+		Where(cartitem.CartID(cartObj.ID)).
+		All(ctx)
 
 	if err != nil {
-		if ent.IsNotFound(err) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar itens do carrinho",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":        "Alterações do carrinho confirmadas",
+		"cart":           updatedCart,
+		"items":          items,
+		"pendingChanges": cartHasPendingChanges(items),
+	})
+}
+
+// ClearCart remove todos os itens do carrinho
+// DELETE /api/cart
+func ClearCart(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
+	if err != nil {
+		if errors.Is(err, errCartNotFound) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 				"message": "Carrinho não encontrado",
 			})
@@ -632,7 +764,7 @@ func ClearCart(c fiber.Ctx) error {
 	// Remover todos os itens do carrinho
 	_, err = client.CartItem.
 		Delete().
-		Where(cart_item.CartID(cartObj.ID)).
+		Where(cartitem.CartID(cartObj.ID)).
 		Exec(ctx)
 
 	if err != nil {
@@ -642,12 +774,6 @@ func ClearCart(c fiber.Ctx) error {
 		})
 	}
 
-	// Remover cupom se estiver aplicado
-	couponCode := ""
-	if cartObj.AppliedCoupon {
-		couponCode = cartObj.CouponCode
-	}
-
 	// Resetar o carrinho
 	updatedCart, err := client.Cart.
 		UpdateOne(cartObj).
@@ -665,30 +791,6 @@ func ClearCart(c fiber.Ctx) error {
 		})
 	}
 
-	// Decrementar número de usos do cupom se estava aplicado
-	if couponCode != "" {
-		couponObj, err := client.Coupon.
-			Query().
-			Where(coupon.Code(couponCode)).
-			First(ctx)
-
-		if err == nil && couponObj != nil && couponObj.TimesUsed > 0 {
-			_, err = client.Coupon.
-				UpdateOne(couponObj).
-				SetTimesUsed(couponObj.TimesUsed - 1).
-				Save(ctx)
-
-			if err != nil {
-				// Não falhar a operação, mas registrar o erro
-				return c.Status(fiber.StatusOK).JSON(fiber.Map{
-					"message": "Carrinho limpo com sucesso, mas houve erro ao atualizar contador de usos do cupom",
-					"cart":    updatedCart,
-					"error":   err.Error(),
-				})
-			}
-		}
-	}
-
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Carrinho limpo com sucesso",
 		"cart":    updatedCart,
@@ -727,7 +829,7 @@ func getOrCreateCart(ctx context.Context, client *ent.Client, userId string) (*e
 		Save(ctx)
 }
 
-// Helper para atualizar os totais do carrinho
+// Helper para reprecificar os itens e atualizar os totais do carrinho
 func updateCartTotals(ctx context.Context, client *ent.Client, cartId string) (*ent.Cart, error) {
 	// Buscar carrinho
 	cartObj, err := client.Cart.Get(ctx, cartId)
@@ -735,24 +837,23 @@ func updateCartTotals(ctx context.Context, client *ent.Client, cartId string) (*
 		return nil, err
 	}
 
-	// Buscar todos os itens do carrinho
-	items, err := client.CartItem.
-		Query().
-		Where(cart_item.CartID(cartId)).
-		All(ctx)
-
+	// Reprecificar todos os itens do carrinho com os produtos atuais
+	items, err := repriceCartItems(ctx, client, cartId)
 	if err != nil {
 		return nil, err
 	}
 
-	// Calcular subtotal
-	var subtotal float64
+	// Calcular subtotal, sem os itens indisponíveis
+	var subtotal money.Amount
 	for _, item := range items {
-		subtotal += item.Price * float64(item.Quantity)
+		if item.UnavailableReason != nil {
+			continue
+		}
+		subtotal += item.Price.Mul(item.Quantity)
 	}
 
 	// Calcular desconto (se tiver cupom aplicado)
-	discount := 0.0
+	discount := money.Zero
 	if cartObj.AppliedCoupon && cartObj.CouponCode != "" {
 		couponObj, err := client.Coupon.
 			Query().
@@ -760,14 +861,7 @@ func updateCartTotals(ctx context.Context, client *ent.Client, cartId string) (*
 			First(ctx)
 
 		if err == nil && couponObj != nil {
-			if couponObj.DiscountType == "percentage" {
-				discount = subtotal * (couponObj.DiscountValue / 100)
-			} else { // fixed
-				discount = couponObj.DiscountValue
-				if discount > subtotal {
-					discount = subtotal
-				}
-			}
+			discount = couponDiscount(couponObj, subtotal)
 		}
 	}
 
@@ -785,4 +879,181 @@ func updateCartTotals(ctx context.Context, client *ent.Client, cartId string) (*
 		SetTotal(total).
 		SetUpdatedAt(time.Now()).
 		Save(ctx)
-} 
\ No newline at end of file
+}
+
+// Helper que reprecifica os itens do carrinho com o preço e o estoque atuais dos
+// produtos. O preço e a quantidade que o cliente confirmou por último ficam em
+// previous_price e previous_quantity enquanto diferirem dos atuais; itens sem estoque
+// ou de produtos (ou variantes) excluídos são marcados como indisponíveis.
+func repriceCartItems(ctx context.Context, client *ent.Client, cartId string) ([]*ent.CartItem, error) {
+	items, err := client.CartItem.
+		Query().
+		Where(cartitem.CartID(cartId)).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	productIds := make([]string, 0, len(items))
+	for _, item := range items {
+		productIds = append(productIds, item.ProductID)
+	}
+
+	products, err := client.Product.
+		Query().
+		Where(product.IDIn(productIds...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*ent.Product, len(products))
+	for _, prod := range products {
+		byID[prod.ID] = prod
+	}
+
+	// Variantes dos produtos do carrinho
+	variants, err := client.ProductVariant.
+		Query().
+		Where(productvariant.ProductIDIn(productIds...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	variantsByID := make(map[string]*ent.ProductVariant, len(variants))
+	hasVariants := make(map[string]bool)
+	for _, variant := range variants {
+		variantsByID[variant.ID] = variant
+		hasVariants[variant.ProductID] = true
+	}
+
+	for i, item := range items {
+		// Valores confirmados pelo cliente
+		confirmedPrice := item.Price
+		if item.PreviousPrice != nil {
+			confirmedPrice = *item.PreviousPrice
+		}
+		confirmedQuantity := item.Quantity
+		if item.PreviousQuantity != nil {
+			confirmedQuantity = *item.PreviousQuantity
+		}
+
+		name, price, quantity := item.Name, confirmedPrice, confirmedQuantity
+		var reason *cartitem.UnavailableReason
+		prod, ok := byID[item.ProductID]
+		variant := variantsByID[item.VariantID]
+
+		// Produto excluído, variante excluída ou item sem variante de um produto que
+		// passou a ter variantes
+		if !ok || (item.VariantID != "" && variant == nil) || (item.VariantID == "" && hasVariants[prod.ID]) {
+			removed := cartitem.UnavailableReasonRemoved
+			reason = &removed
+		} else {
+			name, price = prod.Name, variantPrice(prod, variant)
+			if stock := variantStock(prod, variant); stock == 0 {
+				outOfStock := cartitem.UnavailableReasonOutOfStock
+				reason = &outOfStock
+			} else {
+				quantity = min(confirmedQuantity, stock)
+			}
+		}
+
+		var previousPrice *money.Amount
+		if price != confirmedPrice {
+			previousPrice = &confirmedPrice
+		}
+		var previousQuantity *int
+		if quantity != confirmedQuantity {
+			previousQuantity = &confirmedQuantity
+		}
+
+		// Gravar apenas os itens que mudaram
+		if name == item.Name && price == item.Price && quantity == item.Quantity &&
+			samePointer(previousPrice, item.PreviousPrice) &&
+			samePointer(previousQuantity, item.PreviousQuantity) &&
+			samePointer(reason, item.UnavailableReason) {
+			continue
+		}
+
+		update := client.CartItem.
+			UpdateOne(item).
+			SetName(name).
+			SetPrice(price).
+			SetQuantity(quantity).
+			SetNillablePreviousPrice(previousPrice).
+			SetNillablePreviousQuantity(previousQuantity).
+			SetNillableUnavailableReason(reason)
+		if previousPrice == nil {
+			update = update.ClearPreviousPrice()
+		}
+		if previousQuantity == nil {
+			update = update.ClearPreviousQuantity()
+		}
+		if reason == nil {
+			update = update.ClearUnavailableReason()
+		}
+
+		items[i], err = update.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return items, nil
+}
+
+// Helper que indica se há alterações de preço ou disponibilidade ainda não confirmadas
+func cartHasPendingChanges(items []*ent.CartItem) bool {
+	for _, item := range items {
+		if item.PreviousPrice != nil || item.PreviousQuantity != nil || item.UnavailableReason != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper que retorna o preço de venda atual do produto, considerando a promoção
+func currentPrice(prod *ent.Product) money.Amount {
+	if prod.OnSale && prod.SalePrice > 0 {
+		return prod.SalePrice
+	}
+	return prod.Price
+}
+
+// Helper que calcula o desconto do cupom sobre o subtotal. O percentual é arredondado
+// para o centavo mais próximo (metades para cima) e o desconto nunca passa do subtotal.
+func couponDiscount(couponObj *ent.Coupon, subtotal money.Amount) money.Amount {
+	if couponObj.DiscountType == "percentage" {
+		return money.Min(subtotal.Percent(couponObj.DiscountValue), subtotal)
+	}
+	return money.Min(couponObj.DiscountValue, subtotal)
+}
+
+// Helper que verifica se o cupom pode ser usado em uma compra com o subtotal informado.
+// Cupons sem limite de usos (max_uses vazio ou zero) valem até expirar. Retorna o
+// motivo pelo qual o cupom não vale, ou uma string vazia se ele for válido.
+func couponProblem(couponObj *ent.Coupon, subtotal money.Amount, now time.Time) string {
+	switch {
+	case !couponObj.IsActive:
+		return "Cupom não encontrado ou inativo"
+	case !couponObj.ExpiresAt.IsZero() && couponObj.ExpiresAt.Before(now):
+		return "Cupom expirado"
+	case couponObj.MaxUses > 0 && couponObj.TimesUsed >= couponObj.MaxUses:
+		return "Limite de uso do cupom excedido"
+	case subtotal < couponObj.MinPurchase:
+		return "Valor mínimo para uso do cupom não atingido"
+	}
+	return ""
+}
+
+// Helper que compara dois valores opcionais
+func samePointer[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
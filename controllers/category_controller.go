@@ -2,11 +2,14 @@ package controllers
 
 import (
 	"context"
+	"slices"
 	"time"
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
 )
@@ -17,6 +20,24 @@ type CategoryRequest struct {
 	Icon        string `json:"icon"`
 	Image       string `json:"image,omitempty"`
 	Description string `json:"description,omitempty"`
+	// Categoria pai; vazio move a categoria para a raiz, ausente mantém a atual
+	ParentID *string `json:"parent_id,omitempty"`
+}
+
+// CategoryBreadcrumb é um passo do caminho da raiz até uma categoria
+type CategoryBreadcrumb struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+// CategoryNode é uma categoria da árvore com as suas subcategorias
+type CategoryNode struct {
+	ID       string          `json:"id"`
+	Name     string          `json:"name"`
+	Slug     string          `json:"slug"`
+	Image    string          `json:"image,omitempty"`
+	Children []*CategoryNode `json:"children"`
 }
 
 // GetAllCategories retorna todas as categorias
@@ -43,7 +64,33 @@ func GetAllCategories(c fiber.Ctx) error {
 	})
 }
 
-// GetCategory retorna uma categoria pelo ID
+// GetCategoryTree retorna as categorias organizadas em árvore, a partir das que não
+// têm categoria pai, ordenadas pelo nome em cada nível
+// GET /api/categories/tree
+func GetCategoryTree(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Buscar todas as categorias
+	categories, err := client.Category.
+		Query().
+		Order(ent.Asc("name")).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar categorias",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"categories": categoryTree(categories),
+	})
+}
+
+// GetCategory retorna uma categoria pelo ID, com o caminho desde a raiz e as
+// subcategorias diretas
 // GET /api/categories/:id
 func GetCategory(c fiber.Ctx) error {
 	id := c.Params("id")
@@ -68,23 +115,7 @@ func GetCategory(c fiber.Ctx) error {
 		})
 	}
 
-	// Contar produtos da categoria
-	count, err := client.Product.
-		Query().
-		Where(product.CategoryID(id)).
-		Count(ctx)
-
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao contar produtos da categoria",
-			"error":   err.Error(),
-		})
-	}
-
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"category":        cat,
-		"products_count":  count,
-	})
+	return categoryResponse(c, ctx, client, cat)
 }
 
 // CreateCategory cria uma nova categoria
@@ -95,7 +126,7 @@ func CreateCategory(c fiber.Ctx) error {
 
 	// Extrair dados do request
 	var req CategoryRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -128,15 +159,49 @@ func CreateCategory(c fiber.Ctx) error {
 		})
 	}
 
-	// Criar categoria
-	cat, err := client.Category.
-		Create().
-		SetID(uuid.New().String()).
-		SetName(req.Name).
-		SetIcon(req.Icon).
-		SetNillableImage(nilIfEmpty(req.Image)).
-		SetNillableDescription(nilIfEmpty(req.Description)).
-		Save(ctx)
+	// Verificar se a categoria pai existe
+	parentId := ""
+	if req.ParentID != nil {
+		parentId = *req.ParentID
+	}
+	if parentId != "" {
+		parentExists, err := client.Category.
+			Query().
+			Where(category.ID(parentId)).
+			Exist(ctx)
+
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao verificar categoria pai",
+				"error":   err.Error(),
+			})
+		}
+
+		if !parentExists {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "Categoria pai não encontrada",
+			})
+		}
+	}
+
+	// Criar categoria com um slug gerado a partir do nome
+	categoryId := uuid.New().String()
+	var cat *ent.Category
+	_, err = saveWithUniqueSlug(ctx, client, slugredirect.EntityCategory, categoryId, req.Name, func(categorySlug string) error {
+		var err error
+		cat, err = client.Category.
+			Create().
+			SetID(categoryId).
+			SetName(req.Name).
+			SetSlug(categorySlug).
+			SetIcon(req.Icon).
+			SetNillableImage(nilIfEmpty(req.Image)).
+			SetNillableDescription(nilIfEmpty(req.Description)).
+			SetNillableParentID(nilIfEmpty(parentId)).
+			Save(ctx)
+
+		return err
+	})
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -159,27 +224,22 @@ func UpdateCategory(c fiber.Ctx) error {
 	ctx := context.Background()
 
 	// Verificar se a categoria existe
-	exists, err := client.Category.
-		Query().
-		Where(category.ID(id)).
-		Exist(ctx)
-
+	current, err := client.Category.Get(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Categoria não encontrada",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao verificar categoria",
 			"error":   err.Error(),
 		})
 	}
 
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"message": "Categoria não encontrada",
-		})
-	}
-
 	// Extrair dados do request
 	var req CategoryRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -210,8 +270,45 @@ func UpdateCategory(c fiber.Ctx) error {
 		}
 	}
 
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	// Validar a nova categoria pai, impedindo ciclos na árvore. As categorias ficam
+	// bloqueadas até o commit, para que outra troca de pai simultânea não feche um ciclo
+	// nem exclua o pai entre a verificação e a gravação.
+	if req.ParentID != nil && *req.ParentID != "" {
+		if err := lockCategories(ctx, tx.Client()); err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao verificar categoria pai",
+				"error":   err.Error(),
+			})
+		}
+
+		message, err := validateCategoryParent(ctx, tx.Client(), id, *req.ParentID)
+		if err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao verificar categoria pai",
+				"error":   err.Error(),
+			})
+		}
+
+		if message != "" {
+			tx.Rollback()
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": message,
+			})
+		}
+	}
+
 	// Iniciar atualização
-	update := client.Category.
+	update := tx.Category.
 		UpdateOneID(id).
 		SetUpdatedAt(time.Now())
 
@@ -224,16 +321,56 @@ func UpdateCategory(c fiber.Ctx) error {
 	}
 	update = update.SetNillableImage(nilIfEmpty(req.Image))
 	update = update.SetNillableDescription(nilIfEmpty(req.Description))
+	if req.ParentID != nil {
+		if *req.ParentID == "" {
+			update = update.ClearParentID()
+		} else {
+			update = update.SetParentID(*req.ParentID)
+		}
+	}
 
 	// Salvar atualização
-	cat, err := update.Save(ctx)
+	var cat *ent.Category
+	save := func(newSlug string) error {
+		if newSlug != current.Slug {
+			update = update.SetSlug(newSlug)
+		}
+		var err error
+		cat, err = update.Save(ctx)
+		return err
+	}
+
+	// Ao renomear, gerar um novo slug; o antigo fica no histórico apontando para a categoria
+	newSlug := current.Slug
+	if req.Name != "" && req.Name != current.Name {
+		newSlug, err = saveWithUniqueSlug(ctx, tx.Client(), slugredirect.EntityCategory, id, req.Name, save)
+	} else {
+		err = save(newSlug)
+	}
+
 	if err != nil {
+		tx.Rollback()
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao atualizar categoria",
 			"error":   err.Error(),
 		})
 	}
 
+	if err := recordSlugChange(ctx, tx.Client(), slugredirect.EntityCategory, id, current.Slug, newSlug); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao registrar slug antigo da categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar categoria",
+			"error":   err.Error(),
+		})
+	}
+
 	// Se o nome foi alterado, atualizar produtos relacionados
 	if req.Name != "" {
 		_, err = client.Product.
@@ -267,13 +404,42 @@ func DeleteCategory(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	// Bloquear a categoria até o commit, para que ela não ganhe subcategorias enquanto
+	// é excluída
+	_, err = tx.Category.
+		Query().
+		Where(category.ID(id), forUpdate).
+		Only(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Categoria não encontrada",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar categoria",
+			"error":   err.Error(),
+		})
+	}
+
 	// Verificar se existem produtos nesta categoria
-	count, err := client.Product.
+	count, err := tx.Product.
 		Query().
 		Where(product.CategoryID(id)).
 		Count(ctx)
 
 	if err != nil {
+		tx.Rollback()
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao verificar produtos da categoria",
 			"error":   err.Error(),
@@ -281,23 +447,54 @@ func DeleteCategory(c fiber.Ctx) error {
 	}
 
 	if count > 0 {
+		tx.Rollback()
 		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
 			"message": "Não é possível excluir a categoria pois ela contém produtos",
 			"products_count": count,
 		})
 	}
 
-	// Excluir a categoria
-	err = client.Category.
-		DeleteOneID(id).
-		Exec(ctx)
+	// Verificar se existem subcategorias
+	children, err := tx.Category.
+		Query().
+		Where(category.ParentID(id)).
+		Count(ctx)
 
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"message": "Categoria não encontrada",
-			})
-		}
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar subcategorias",
+			"error":   err.Error(),
+		})
+	}
+
+	if children > 0 {
+		tx.Rollback()
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message":        "Não é possível excluir a categoria pois ela tem subcategorias",
+			"children_count": children,
+		})
+	}
+
+	// Excluir os slugs antigos da categoria junto com ela
+	if err := deleteSlugRedirects(ctx, tx.Client(), slugredirect.EntityCategory, id); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir slugs antigos da categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	// Excluir a categoria
+	if err := tx.Category.DeleteOneID(id).Exec(ctx); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao excluir categoria",
 			"error":   err.Error(),
@@ -315,4 +512,184 @@ func nilIfEmpty(s string) *string {
 		return nil
 	}
 	return &s
-} 
\ No newline at end of file
+}
+
+// Helper que confere se a categoria pode passar a ter parentId como pai: o pai precisa
+// existir e não pode ser a própria categoria nem uma das suas subcategorias, o que
+// criaria um ciclo. Retorna a mensagem de erro, ou vazio se for válido.
+func validateCategoryParent(ctx context.Context, client *ent.Client, id, parentId string) (string, error) {
+	if parentId == id {
+		return "Uma categoria não pode ser pai de si mesma", nil
+	}
+
+	exists, err := client.Category.
+		Query().
+		Where(category.ID(parentId)).
+		Exist(ctx)
+
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "Categoria pai não encontrada", nil
+	}
+
+	descendants, err := categoryDescendantIDs(ctx, client, id)
+	if err != nil {
+		return "", err
+	}
+	if slices.Contains(descendants, parentId) {
+		return "A categoria pai não pode ser uma subcategoria desta categoria", nil
+	}
+	return "", nil
+}
+
+// Helper que bloqueia todas as categorias até o fim da transação. Trocas de pai
+// simultâneas ficam em fila, e a verificação de ciclos de cada uma já vê a árvore
+// gravada pela anterior.
+func lockCategories(ctx context.Context, client *ent.Client) error {
+	_, err := client.Category.
+		Query().
+		Where(forUpdate).
+		IDs(ctx)
+
+	return err
+}
+
+// Helper (predicado) que bloqueia as linhas lidas até o fim da transação, como
+// SELECT ... FOR UPDATE
+func forUpdate(s *sql.Selector) {
+	s.ForUpdate()
+}
+
+// Helper que retorna os IDs de todas as subcategorias (filhas, netas etc.) da categoria
+func categoryDescendantIDs(ctx context.Context, client *ent.Client, id string) ([]string, error) {
+	categories, err := client.Category.
+		Query().
+		Select(category.FieldID, category.FieldParentID).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]string, len(categories))
+	for _, cat := range categories {
+		if cat.ParentID != "" {
+			children[cat.ParentID] = append(children[cat.ParentID], cat.ID)
+		}
+	}
+
+	// Percorrer a árvore em largura, sem visitar a mesma categoria duas vezes
+	visited := map[string]bool{id: true}
+	queue := []string{id}
+	var ids []string
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, child := range children[current] {
+			if !visited[child] {
+				visited[child] = true
+				ids = append(ids, child)
+				queue = append(queue, child)
+			}
+		}
+	}
+	return ids, nil
+}
+
+// Helper que monta o caminho da categoria raiz até a categoria informada
+func categoryBreadcrumbs(ctx context.Context, client *ent.Client, cat *ent.Category) ([]CategoryBreadcrumb, error) {
+	path := []CategoryBreadcrumb{{ID: cat.ID, Name: cat.Name, Slug: cat.Slug}}
+	visited := map[string]bool{cat.ID: true}
+
+	for parentId := cat.ParentID; parentId != "" && !visited[parentId]; {
+		parent, err := client.Category.Get(ctx, parentId)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		visited[parentId] = true
+		path = append(path, CategoryBreadcrumb{ID: parent.ID, Name: parent.Name, Slug: parent.Slug})
+		parentId = parent.ParentID
+	}
+
+	slices.Reverse(path)
+	return path, nil
+}
+
+// Helper que organiza as categorias em árvore, mantendo a ordem recebida em cada
+// nível. Categorias cujo pai não existe mais ficam na raiz.
+func categoryTree(categories []*ent.Category) []*CategoryNode {
+	nodes := make(map[string]*CategoryNode, len(categories))
+	for _, cat := range categories {
+		nodes[cat.ID] = &CategoryNode{
+			ID:       cat.ID,
+			Name:     cat.Name,
+			Slug:     cat.Slug,
+			Image:    cat.Image,
+			Children: []*CategoryNode{},
+		}
+	}
+
+	roots := []*CategoryNode{}
+	for _, cat := range categories {
+		node := nodes[cat.ID]
+		if parent, ok := nodes[cat.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
+	}
+	return roots
+}
+
+// Helper que responde com os detalhes da categoria: a quantidade de produtos, o
+// caminho desde a raiz e as subcategorias diretas
+func categoryResponse(c fiber.Ctx, ctx context.Context, client *ent.Client, cat *ent.Category) error {
+	// Contar produtos da categoria
+	count, err := client.Product.
+		Query().
+		Where(product.CategoryID(cat.ID)).
+		Count(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao contar produtos da categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	// Montar o caminho desde a categoria raiz
+	breadcrumbs, err := categoryBreadcrumbs(ctx, client, cat)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar categorias superiores",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar subcategorias diretas
+	children, err := client.Category.
+		Query().
+		Where(category.ParentID(cat.ID)).
+		Order(ent.Asc("name")).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar subcategorias",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"category":        cat,
+		"products_count":  count,
+		"breadcrumbs":     breadcrumbs,
+		"children":        children,
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/middleware"
+	"github.com/vtrod/veecomm-api/money"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
@@ -12,13 +14,13 @@ import (
 
 // Estrutura para criar/atualizar cupom
 type CouponRequest struct {
-	Code          string     `json:"code"`
-	DiscountType  string     `json:"discount_type"`
-	DiscountValue float64    `json:"discount_value"`
-	MinPurchase   float64    `json:"min_purchase"`
-	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
-	IsActive      bool       `json:"is_active"`
-	MaxUses       *int       `json:"max_uses,omitempty"`
+	Code          string       `json:"code"`
+	DiscountType  string       `json:"discount_type"`
+	DiscountValue money.Amount `json:"discount_value"`
+	MinPurchase   money.Amount `json:"min_purchase"`
+	ExpiresAt     *time.Time   `json:"expires_at,omitempty"`
+	IsActive      bool         `json:"is_active"`
+	MaxUses       *int         `json:"max_uses,omitempty"`
 }
 
 // GetAllCoupons retorna todos os cupons
@@ -27,9 +29,8 @@ func GetAllCoupons(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar cupons
+	if !middleware.HasPermission(c, middleware.PermCouponsManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem listar todos os cupons",
 		})
@@ -60,9 +61,8 @@ func GetCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar cupons
+	if !middleware.HasPermission(c, middleware.PermCouponsManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem visualizar detalhes de cupons",
 		})
@@ -99,11 +99,11 @@ func ValidateCoupon(c fiber.Ctx) error {
 	
 	// Extrair código do cupom
 	var req struct {
-		Code       string  `json:"code"`
-		CartTotal  float64 `json:"cart_total"`
+		Code       string       `json:"code"`
+		CartTotal  money.Amount `json:"cart_total"`
 	}
 	
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -138,41 +138,17 @@ func ValidateCoupon(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar se expirou
-	if couponObj.ExpiresAt != nil && couponObj.ExpiresAt.Before(time.Now()) {
+	// Verificar validade, limite de usos e valor mínimo, com as mesmas regras do checkout
+	if problem := couponProblem(couponObj, req.CartTotal, time.Now()); problem != "" {
 		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Cupom expirado",
-			"valid":   false,
-		})
-	}
-
-	// Verificar número máximo de usos
-	if couponObj.MaxUses != nil && couponObj.TimesUsed >= *couponObj.MaxUses {
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Cupom atingiu o limite máximo de usos",
-			"valid":   false,
-		})
-	}
-
-	// Verificar valor mínimo de compra
-	if req.CartTotal < couponObj.MinPurchase {
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message":     "Valor mínimo para uso do cupom não atingido",
-			"valid":       false,
+			"message":      problem,
+			"valid":        false,
 			"min_purchase": couponObj.MinPurchase,
 		})
 	}
 
-	// Calcular valor do desconto
-	var discount float64
-	if couponObj.DiscountType == "percentage" {
-		discount = req.CartTotal * (couponObj.DiscountValue / 100)
-	} else { // fixed
-		discount = couponObj.DiscountValue
-		if discount > req.CartTotal {
-			discount = req.CartTotal
-		}
-	}
+	// Calcular valor do desconto, com o mesmo arredondamento do carrinho
+	discount := couponDiscount(couponObj, req.CartTotal)
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message":  "Cupom válido",
@@ -188,9 +164,8 @@ func CreateCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar cupons
+	if !middleware.HasPermission(c, middleware.PermCouponsManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem criar cupons",
 		})
@@ -198,7 +173,7 @@ func CreateCoupon(c fiber.Ctx) error {
 
 	// Extrair dados do request
 	var req CouponRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -257,7 +232,7 @@ func CreateCoupon(c fiber.Ctx) error {
 		Create().
 		SetID(uuid.New().String()).
 		SetCode(req.Code).
-		SetDiscountType(req.DiscountType).
+		SetDiscountType(coupon.DiscountType(req.DiscountType)).
 		SetDiscountValue(req.DiscountValue).
 		SetMinPurchase(req.MinPurchase).
 		SetIsActive(req.IsActive).
@@ -293,9 +268,8 @@ func UpdateCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar cupons
+	if !middleware.HasPermission(c, middleware.PermCouponsManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem atualizar cupons",
 		})
@@ -321,7 +295,7 @@ func UpdateCoupon(c fiber.Ctx) error {
 
 	// Extrair dados do request
 	var req CouponRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -383,7 +357,7 @@ func UpdateCoupon(c fiber.Ctx) error {
 		update = update.SetCode(req.Code)
 	}
 	if req.DiscountType != "" {
-		update = update.SetDiscountType(req.DiscountType)
+		update = update.SetDiscountType(coupon.DiscountType(req.DiscountType))
 	}
 	if req.DiscountValue > 0 {
 		update = update.SetDiscountValue(req.DiscountValue)
@@ -422,9 +396,8 @@ func DeleteCoupon(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar cupons
+	if !middleware.HasPermission(c, middleware.PermCouponsManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem excluir cupons",
 		})
@@ -0,0 +1,402 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/cart"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/middleware"
+	"github.com/vtrod/veecomm-api/money"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Nome do cookie e do cabeçalho que carregam o token do carrinho de visitantes
+const (
+	cartTokenCookie = "cart_token"
+	cartTokenHeader = "X-Cart-Token"
+)
+
+// errCartNotFound é retornado quando a requisição não tem carrinho associado
+var errCartNotFound = errors.New("carrinho não encontrado")
+
+// CartMergeAdjustment descreve um item do carrinho de visitante que não pôde ser
+// incorporado com a quantidade original
+type CartMergeAdjustment struct {
+	ProductID string `json:"productId"`
+	VariantID string `json:"variantId,omitempty"`
+	Name      string `json:"name"`
+	Requested int    `json:"requested"`
+	Quantity  int    `json:"quantity"`
+	Reason    string `json:"reason"`
+}
+
+// CartMergeResult resume a incorporação do carrinho de visitante ao carrinho do usuário
+type CartMergeResult struct {
+	Cart          *ent.Cart             `json:"cart"`
+	Adjustments   []CartMergeAdjustment `json:"adjustments"`
+	CouponRemoved string                `json:"couponRemoved,omitempty"`
+}
+
+// PurgeExpiredGuestCarts remove os carrinhos de visitantes abandonados e seus itens
+func PurgeExpiredGuestCarts(ctx context.Context, client *ent.Client) (int, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = tx.CartItem.
+		Delete().
+		Where(cartitem.HasCartWith(cart.UserIDIsNil(), cart.ExpiresAtLT(time.Now()))).
+		Exec(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+
+	removed, err := tx.Cart.
+		Delete().
+		Where(cart.UserIDIsNil(), cart.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return 0, rollback(tx, err)
+	}
+
+	return removed, tx.Commit()
+}
+
+// Helper que localiza o carrinho da requisição: o do usuário autenticado ou, para
+// visitantes, o indicado pelo token de carrinho. Com create, um carrinho novo é criado
+// quando não existe; sem ele, errCartNotFound é retornado.
+func currentCart(c fiber.Ctx, client *ent.Client, create bool) (*ent.Cart, error) {
+	ctx := context.Background()
+
+	if userId := getUserIdFromContext(c); userId != "" {
+		if create {
+			return getOrCreateCart(ctx, client, userId)
+		}
+
+		cartObj, err := client.Cart.
+			Query().
+			Where(cart.UserID(userId)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, errCartNotFound
+		}
+		return cartObj, err
+	}
+
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	expiresAt := time.Now().Add(authConfig.GuestCartExpiry)
+
+	// Renovar a validade de um carrinho de visitante existente a cada acesso
+	cartObj, err := findGuestCart(ctx, client, authConfig, cartTokenFromRequest(c))
+	switch {
+	case err == nil:
+		cartObj, err = client.Cart.
+			UpdateOne(cartObj).
+			SetExpiresAt(expiresAt).
+			Save(ctx)
+	case errors.Is(err, errCartNotFound) && create:
+		cartObj, err = client.Cart.
+			Create().
+			SetID(uuid.New().String()).
+			SetSubtotal(0).
+			SetDiscount(0).
+			SetTotal(0).
+			SetAppliedCoupon(false).
+			SetExpiresAt(expiresAt).
+			SetCreatedAt(time.Now()).
+			SetUpdatedAt(time.Now()).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setCartToken(c, authConfig, cartObj.ID); err != nil {
+		return nil, err
+	}
+	return cartObj, nil
+}
+
+// Helper que busca o carrinho de visitante ainda válido indicado pelo token
+func findGuestCart(ctx context.Context, client *ent.Client, authConfig middleware.Config, token string) (*ent.Cart, error) {
+	if token == "" {
+		return nil, errCartNotFound
+	}
+
+	cartId, err := authConfig.ParseCartToken(token)
+	if err != nil {
+		return nil, errCartNotFound
+	}
+
+	cartObj, err := client.Cart.
+		Query().
+		Where(
+			cart.ID(cartId),
+			cart.UserIDIsNil(),
+			cart.ExpiresAtGT(time.Now()),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, errCartNotFound
+	}
+	return cartObj, err
+}
+
+// Helper que lê o token de carrinho do cabeçalho (aplicativos) ou do cookie (navegador)
+func cartTokenFromRequest(c fiber.Ctx) string {
+	if token := c.Get(cartTokenHeader); token != "" {
+		return token
+	}
+	return c.Cookies(cartTokenCookie)
+}
+
+// Helper que emite um novo token de carrinho e o devolve no cookie e no cabeçalho
+func setCartToken(c fiber.Ctx, authConfig middleware.Config, cartId string) error {
+	token, expiresAt, err := authConfig.GenerateCartToken(cartId)
+	if err != nil {
+		return err
+	}
+
+	c.Cookie(&fiber.Cookie{
+		Name:     cartTokenCookie,
+		Value:    token,
+		Path:     "/api",
+		Expires:  expiresAt,
+		Secure:   c.Secure(),
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+	c.Set(cartTokenHeader, token)
+	return nil
+}
+
+// Helper que descarta o token de carrinho do cliente após a incorporação
+func clearCartToken(c fiber.Ctx) {
+	c.Cookie(&fiber.Cookie{
+		Name:     cartTokenCookie,
+		Path:     "/api",
+		Expires:  time.Unix(0, 0),
+		Secure:   c.Secure(),
+		HTTPOnly: true,
+		SameSite: fiber.CookieSameSiteLaxMode,
+	})
+}
+
+// Helper que incorpora o carrinho de visitante da requisição ao carrinho do usuário.
+// As quantidades de um mesmo produto são somadas e limitadas ao estoque atual; produtos
+// removidos são descartados. O cupom (o do usuário ou, na falta dele, o do visitante) é
+// validado novamente e removido se não puder mais ser usado. Retorna nil quando não há
+// carrinho de visitante a incorporar.
+func mergeGuestCart(c fiber.Ctx, client *ent.Client, authConfig middleware.Config, userId string) (*CartMergeResult, error) {
+	ctx := context.Background()
+
+	guestCart, err := findGuestCart(ctx, client, authConfig, cartTokenFromRequest(c))
+	if errors.Is(err, errCartNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	guestItems, err := tx.CartItem.
+		Query().
+		Where(cartitem.CartID(guestCart.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Excluir o carrinho de visitante primeiro: se outra requisição já o incorporou,
+	// nada é excluído e a incorporação é abandonada
+	_, err = tx.CartItem.
+		Delete().
+		Where(cartitem.CartID(guestCart.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	removed, err := tx.Cart.
+		Delete().
+		Where(cart.ID(guestCart.ID), cart.UserIDIsNil()).
+		Exec(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if removed == 0 {
+		return nil, tx.Rollback()
+	}
+
+	userCart, err := getOrCreateCart(ctx, tx.Client(), userId)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	userItems, err := tx.CartItem.
+		Query().
+		Where(cartitem.CartID(userCart.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Itens do mesmo produto e da mesma variante são somados
+	existing := make(map[string]*ent.CartItem, len(userItems))
+	for _, item := range userItems {
+		existing[item.ProductID+"\x00"+item.VariantID] = item
+	}
+
+	result := &CartMergeResult{Adjustments: []CartMergeAdjustment{}}
+	for _, item := range guestItems {
+		requested := item.Quantity
+		current := existing[item.ProductID+"\x00"+item.VariantID]
+		if current != nil {
+			requested += current.Quantity
+		}
+
+		prod, err := tx.Product.Get(ctx, item.ProductID)
+		if err != nil && !ent.IsNotFound(err) {
+			return nil, rollback(tx, err)
+		}
+
+		var variant *ent.ProductVariant
+		if prod != nil {
+			variant, err = cartVariant(ctx, tx.Client(), prod, item.VariantID)
+			if err != nil && !errors.Is(err, errVariantRequired) && !errors.Is(err, errVariantNotFound) {
+				return nil, rollback(tx, err)
+			}
+		}
+
+		// Limitar a quantidade ao estoque disponível
+		quantity := requested
+		reason := ""
+		if prod == nil || err != nil {
+			quantity, reason = 0, "unavailable"
+		} else if available := variantStock(prod, variant); quantity > available {
+			quantity, reason = max(available, 0), "insufficient_stock"
+		}
+		if reason != "" {
+			result.Adjustments = append(result.Adjustments, CartMergeAdjustment{
+				ProductID: item.ProductID,
+				VariantID: item.VariantID,
+				Name:      item.Name,
+				Requested: requested,
+				Quantity:  quantity,
+				Reason:    reason,
+			})
+		}
+
+		switch {
+		case quantity == 0 && current != nil:
+			err = tx.CartItem.DeleteOne(current).Exec(ctx)
+		case quantity == 0:
+			continue
+		case current != nil:
+			_, err = tx.CartItem.
+				UpdateOne(current).
+				SetQuantity(quantity).
+				Save(ctx)
+		default:
+			_, err = tx.CartItem.
+				Create().
+				SetID(uuid.New().String()).
+				SetCartID(userCart.ID).
+				SetProductID(item.ProductID).
+				SetNillableVariantID(nilIfEmpty(item.VariantID)).
+				SetVariantOptions(item.VariantOptions).
+				SetName(item.Name).
+				SetPrice(item.Price).
+				SetImage(item.Image).
+				SetQuantity(quantity).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	// O cupom do usuário tem prioridade sobre o aplicado pelo visitante
+	couponCode := ""
+	if userCart.AppliedCoupon && userCart.CouponCode != "" {
+		couponCode = userCart.CouponCode
+	} else if guestCart.AppliedCoupon && guestCart.CouponCode != "" {
+		couponCode = guestCart.CouponCode
+	}
+
+	_, err = tx.Cart.
+		UpdateOne(userCart).
+		SetAppliedCoupon(couponCode != "").
+		SetCouponCode(couponCode).
+		Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	updatedCart, err := updateCartTotals(ctx, tx.Client(), userCart.ID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	// Validar o cupom novamente com o subtotal resultante
+	if couponCode != "" {
+		problem, err := cartCouponProblem(ctx, tx.Client(), couponCode, updatedCart.Subtotal)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		if problem != "" {
+			result.CouponRemoved = problem
+			_, err = tx.Cart.
+				UpdateOne(updatedCart).
+				SetAppliedCoupon(false).
+				SetCouponCode("").
+				Save(ctx)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+
+			updatedCart, err = updateCartTotals(ctx, tx.Client(), userCart.ID)
+			if err != nil {
+				return nil, rollback(tx, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	result.Cart = updatedCart.Unwrap()
+	return result, nil
+}
+
+// Helper que verifica se o cupom ainda pode ser usado no carrinho. Retorna o motivo
+// pelo qual o cupom não vale mais, ou uma string vazia se ele continuar válido.
+func cartCouponProblem(ctx context.Context, client *ent.Client, code string, subtotal money.Amount) (string, error) {
+	couponObj, err := client.Coupon.
+		Query().
+		Where(
+			coupon.Code(code),
+			coupon.IsActive(true),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "Cupom não encontrado ou inativo", nil
+		}
+		return "", err
+	}
+
+	return couponProblem(couponObj, subtotal, time.Now()), nil
+}
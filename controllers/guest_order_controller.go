@@ -0,0 +1,188 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/mailer"
+	"github.com/vtrod/veecomm-api/middleware"
+	"github.com/vtrod/veecomm-api/shipping"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Cabeçalho alternativo ao parâmetro "token" para consultar um pedido sem login
+const orderTokenHeader = "X-Order-Token"
+
+// Estrutura com os dados de quem finaliza a compra sem cadastro
+type GuestCheckoutRequest struct {
+	Email   string          `json:"email"`
+	Name    string          `json:"name"`
+	Address *AddressRequest `json:"address,omitempty"`
+}
+
+// ClaimGuestOrders vincula à conta os pedidos feitos sem cadastro com o mesmo email.
+// Exige email verificado, para que ninguém reivindique pedidos de outra pessoa.
+// POST /api/orders/claim
+func ClaimGuestOrders(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	u, ok := c.Locals("user").(*ent.User)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	if u.EmailVerifiedAt == nil {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Confirme seu email antes de reivindicar pedidos",
+			"code":    "email_not_verified",
+		})
+	}
+
+	// Buscar os pedidos de visitante feitos com o email da conta
+	orders, err := client.Order.
+		Query().
+		Where(
+			order.UserIDIsNil(),
+			order.GuestEmail(u.Email),
+		).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pedidos",
+			"error":   err.Error(),
+		})
+	}
+
+	ids := make([]string, 0, len(orders))
+	for _, orderObj := range orders {
+		ids = append(ids, orderObj.ID)
+	}
+
+	// Vincular apenas os que continuam sem dono
+	claimed, err := client.Order.
+		Update().
+		Where(
+			order.IDIn(ids...),
+			order.UserIDIsNil(),
+		).
+		SetUserID(u.ID).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao reivindicar pedidos",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": fmt.Sprintf("%d pedido(s) vinculado(s) à sua conta", claimed),
+		"claimed": claimed,
+		"orders":  ids,
+	})
+}
+
+// Helper que valida e normaliza os dados do comprador sem cadastro. Retorna a
+// mensagem de erro para o cliente, ou uma string vazia se os dados forem válidos.
+func validateGuestCheckout(req *OrderRequest) string {
+	guest := req.Guest
+	if guest == nil {
+		return "Faça login ou informe email e nome para comprar sem cadastro"
+	}
+
+	guest.Email = normalizeEmail(guest.Email)
+	guest.Name = strings.TrimSpace(guest.Name)
+	if guest.Name == "" || guest.Email == "" {
+		return "Email e nome são obrigatórios para comprar sem cadastro"
+	}
+	if !strings.Contains(guest.Email, "@") {
+		return "Email inválido"
+	}
+
+	// Endereços salvos são exclusivos de usuários cadastrados
+	req.AddressID = ""
+	if req.DeliveryType != "delivery" {
+		guest.Address = nil
+		return ""
+	}
+
+	addr := guest.Address
+	if addr == nil {
+		return "Endereço de entrega é obrigatório para entregas"
+	}
+	if addr.CEP == "" || addr.Logradouro == "" || addr.Numero == "" ||
+		addr.Bairro == "" || addr.Cidade == "" || addr.Estado == "" {
+		return "Todos os campos do endereço são obrigatórios, exceto complemento"
+	}
+
+	cep, err := shipping.NormalizeCEP(addr.CEP)
+	if err != nil {
+		return "CEP inválido"
+	}
+	estado, err := shipping.ResolveEstado(cep, addr.Estado)
+	if err != nil {
+		if errors.Is(err, shipping.ErrEstadoMismatch) {
+			return "A UF informada não corresponde ao CEP"
+		}
+		return "CEP inválido"
+	}
+	addr.CEP = cep
+	addr.Estado = estado
+	return ""
+}
+
+// Helper que emite o token de consulta do pedido de um visitante e envia o link por
+// email. Falhas no envio não desfazem o pedido: o token também volta na resposta.
+func issueOrderLookup(c fiber.Ctx, orderObj *ent.Order) string {
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	m := c.Locals("mailer").(mailer.Mailer)
+	appURL := c.Locals("appURL").(string)
+
+	token, _, err := authConfig.GenerateOrderToken(orderObj.ID)
+	if err != nil {
+		log.Printf("Erro ao gerar token de consulta do pedido %s: %v", orderObj.ID, err)
+		return ""
+	}
+
+	if err := m.Send(context.Background(), orderLookupMessage(appURL, orderObj, token)); err != nil {
+		log.Printf("Erro ao enviar link do pedido %s para %s: %v", orderObj.ID, orderObj.GuestEmail, err)
+	}
+	return token
+}
+
+// Helper que verifica se a requisição traz um token de consulta válido para o pedido
+func hasOrderLookupToken(c fiber.Ctx, orderId string) bool {
+	token := c.Query("token")
+	if token == "" {
+		token = c.Get(orderTokenHeader)
+	}
+	if token == "" {
+		return false
+	}
+
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	tokenOrderId, err := authConfig.ParseOrderToken(token)
+	return err == nil && tokenOrderId == orderId
+}
+
+// Helper que monta o email com o link de consulta do pedido
+func orderLookupMessage(appURL string, orderObj *ent.Order, token string) mailer.Message {
+	link := fmt.Sprintf("%s/orders/%s?token=%s", appURL, url.PathEscape(orderObj.ID), url.QueryEscape(token))
+	return mailer.Message{
+		To:      orderObj.GuestEmail,
+		Subject: "Recebemos o seu pedido",
+		Body: fmt.Sprintf("Olá, %s.\n\nRecebemos o seu pedido %s. Para acompanhar o pagamento e a entrega, acesse:\n\n%s\n\nSe criar uma conta com este email, você poderá vincular o pedido a ela depois de confirmar o endereço.\n",
+			orderObj.GuestName, orderObj.ID, link),
+	}
+}
@@ -0,0 +1,168 @@
+package controllers
+
+import (
+	"context"
+	"log"
+	"math"
+	"strconv"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+	"github.com/vtrod/veecomm-api/loginguard"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// UnlockUser remove o bloqueio por tentativas de login de uma conta (admin)
+// POST /api/admin/users/:id/unlock
+func UnlockUser(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	guard := c.Locals("loginGuard").(*loginguard.Guard)
+	ctx := context.Background()
+
+	// Buscar usuário
+	u, err := client.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Usuário não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := guard.Unlock(ctx, u.Email); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao desbloquear conta",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Conta desbloqueada com sucesso",
+	})
+}
+
+// GetLoginAttempts lista as tentativas de login malsucedidas (admin)
+// GET /api/admin/login-attempts
+func GetLoginAttempts(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	query := client.LoginAttempt.Query()
+
+	// Filtrar por email ou IP
+	if email := normalizeEmail(c.Query("email")); email != "" {
+		query = query.Where(loginattempt.Email(email))
+	}
+	if ip := c.Query("ip"); ip != "" {
+		query = query.Where(loginattempt.IP(ip))
+	}
+
+	limit := 100
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "Limite inválido",
+			})
+		}
+		limit = min(parsed, 1000)
+	}
+
+	attempts, err := query.
+		Order(ent.Desc(loginattempt.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar tentativas de login",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"attempts": attempts,
+	})
+}
+
+// Helper que verifica se a conta e o IP podem tentar um login agora e reserva a
+// tentativa, que deve terminar com recordLoginFailure, guard.Succeed ou guard.Release.
+// Quando não podem, registra a tentativa e responde 429; handled indica que a
+// resposta já foi enviada.
+func guardLoginAttempt(c fiber.Ctx, client *ent.Client, guard *loginguard.Guard, email, userId string) (bool, error) {
+	ctx := context.Background()
+
+	decision, err := guard.Reserve(ctx, email, c.IP())
+	if err != nil {
+		return true, c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar tentativas de login",
+			"error":   err.Error(),
+		})
+	}
+	if decision.Allowed {
+		return false, nil
+	}
+
+	reason := loginattempt.ReasonThrottled
+	message := "Muitas tentativas de login; aguarde antes de tentar novamente"
+	if decision.Locked {
+		reason = loginattempt.ReasonLocked
+		message = "Conta temporariamente bloqueada por excesso de tentativas de login"
+	}
+	auditLoginFailure(ctx, client, c, email, userId, reason, false)
+
+	retryAfter := int(math.Ceil(decision.RetryAfter.Seconds()))
+	c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+	return true, c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+		"message":    message,
+		"locked":     decision.Locked,
+		"retryAfter": retryAfter,
+	})
+}
+
+// Helper que contabiliza uma falha de login e grava o registro de auditoria
+func recordLoginFailure(c fiber.Ctx, client *ent.Client, guard *loginguard.Guard, email, userId string, reason loginattempt.Reason) {
+	ctx := context.Background()
+
+	locked, err := guard.Fail(ctx, email, c.IP())
+	if err != nil {
+		log.Printf("Erro ao registrar falha de login de %s: %v", email, err)
+	}
+	if locked {
+		log.Printf("Conta %s bloqueada por excesso de tentativas de login", email)
+	}
+	auditLoginFailure(ctx, client, c, email, userId, reason, locked)
+}
+
+// Helper que libera a tentativa reservada de um login que terminou sem verificar as
+// credenciais, como em erros internos ou no desafio de 2FA
+func releaseLoginAttempt(guard *loginguard.Guard, email, ip string) {
+	if err := guard.Release(context.Background(), email, ip); err != nil {
+		log.Printf("Erro ao liberar tentativa de login de %s: %v", email, err)
+	}
+}
+
+// Helper que grava a tentativa malsucedida. Falhas na auditoria não impedem a resposta.
+func auditLoginFailure(ctx context.Context, client *ent.Client, c fiber.Ctx, email, userId string, reason loginattempt.Reason, causedLockout bool) {
+	create := client.LoginAttempt.
+		Create().
+		SetID(uuid.New().String()).
+		SetEmail(email).
+		SetIP(c.IP()).
+		SetUserAgent(c.Get("User-Agent")).
+		SetReason(reason).
+		SetCausedLockout(causedLockout)
+	if userId != "" {
+		create = create.SetUserID(userId)
+	}
+
+	if _, err := create.Save(ctx); err != nil {
+		log.Printf("Erro ao gravar auditoria de login de %s: %v", email, err)
+	}
+}
@@ -2,14 +2,24 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/cart"
-	"github.com/vtrod/veecomm-api/ent/cart_item"
-	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/inventory"
+	"github.com/vtrod/veecomm-api/payments"
+	"github.com/vtrod/veecomm-api/shipping"
+	"github.com/vtrod/veecomm-api/middleware"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
 )
@@ -19,12 +29,15 @@ type OrderRequest struct {
 	AddressID      string `json:"address_id"`
 	DeliveryType   string `json:"delivery_type"`
 	PaymentMethod  string `json:"payment_method"`
-	PaymentStatus  string `json:"payment_status"`
+	PaymentToken   string `json:"payment_token"`
+	// Dados do comprador sem cadastro (apenas sem login)
+	Guest *GuestCheckoutRequest `json:"guest,omitempty"`
 }
 
 // Estrutura para atualizar status de um pedido
 type OrderStatusUpdate struct {
 	Status string `json:"status"`
+	Note   string `json:"note"`
 }
 
 // GetUserOrders retorna todos os pedidos do usuário
@@ -67,21 +80,23 @@ func GetOrder(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Obter usuário do contexto de autenticação
-	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
+	// Verificar se o pedido existe e pertence ao usuário. Quem comprou sem cadastro
+	// consulta o pedido com o token de consulta recebido por email.
+	query := client.Order.
+		Query().
+		Where(order.ID(id))
+
+	if !hasOrderLookupToken(c, id) {
+		userId := getUserIdFromContext(c)
+		if userId == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Usuário não autenticado",
+			})
+		}
+		query = query.Where(order.UserID(userId))
 	}
 
-	// Verificar se o pedido existe e pertence ao usuário
-	orderObj, err := client.Order.
-		Query().
-		Where(
-			order.ID(id),
-			order.UserID(userId),
-		).
+	orderObj, err := query.
 		WithAddress().
 		First(ctx)
 
@@ -116,23 +131,26 @@ func GetOrder(c fiber.Ctx) error {
 	})
 }
 
-// CreateOrder cria um novo pedido a partir do carrinho
+// CreateOrder cria um novo pedido a partir do carrinho do usuário ou do visitante
 // POST /api/orders
 func CreateOrder(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Obter usuário do contexto de autenticação
+	// Obter usuário do contexto de autenticação (vazio para compras sem cadastro)
 	userId := getUserIdFromContext(c)
-	if userId == "" {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Usuário não autenticado",
-		})
+
+	// Exigir email verificado quando a loja estiver configurada para isso
+	if requireVerified, _ := c.Locals("requireVerifiedEmail").(bool); requireVerified && userId != "" {
+		if u, ok := c.Locals("user").(*ent.User); !ok || u.EmailVerifiedAt == nil {
+			return checkoutErrorResponse(c, newCheckoutError(fiber.StatusForbidden, "email_not_verified",
+				"Confirme seu email antes de finalizar a compra", nil))
+		}
 	}
 
 	// Extrair dados do request
 	var req OrderRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -153,15 +171,27 @@ func CreateOrder(c fiber.Ctx) error {
 		})
 	}
 
-	// Validar status de pagamento
-	if req.PaymentStatus == "" {
+	if !payments.IsValidMethod(req.PaymentMethod) {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Status de pagamento é obrigatório",
+			"message":       "Método de pagamento inválido",
+			"valid_methods": payments.Methods,
 		})
 	}
 
+	// Visitantes informam email, nome e, para entregas, o endereço completo
+	if userId == "" {
+		if message := validateGuestCheckout(&req); message != "" {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": message,
+			})
+		}
+	} else {
+		req.Guest = nil
+	}
+
 	// Validar endereço de entrega para delivery
-	if req.DeliveryType == "delivery" {
+	var addr *ent.Address
+	if req.DeliveryType == "delivery" && userId != "" {
 		if req.AddressID == "" {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"message": "Endereço de entrega é obrigatório para entregas",
@@ -169,170 +199,72 @@ func CreateOrder(c fiber.Ctx) error {
 		}
 
 		// Verificar se o endereço existe e pertence ao usuário
-		exists, err := client.Address.
+		found, err := client.Address.
 			Query().
 			Where(
 				address.ID(req.AddressID),
 				address.UserID(userId),
 			).
-			Exist(ctx)
+			First(ctx)
 
 		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+					"message": "Endereço não encontrado",
+				})
+			}
 			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 				"message": "Erro ao verificar endereço",
 				"error":   err.Error(),
 			})
 		}
-
-		if !exists {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"message": "Endereço não encontrado",
-			})
-		}
+		addr = found
 	}
 
-	// Buscar carrinho do usuário
-	cartObj, err := client.Cart.
-		Query().
-		Where(cart.UserID(userId)).
-		First(ctx)
-
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := currentCart(c, client, false)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"message": "Carrinho não encontrado",
-			})
+		if errors.Is(err, errCartNotFound) {
+			return checkoutErrorResponse(c, newCheckoutError(fiber.StatusNotFound, "cart_not_found", "Carrinho não encontrado", nil))
 		}
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao buscar carrinho",
-			"error":   err.Error(),
-		})
+		return checkoutErrorResponse(c, newCheckoutError(fiber.StatusInternalServerError, "cart_query_failed", "Erro ao buscar carrinho", err))
 	}
 
-	// Buscar itens do carrinho
-	cartItems, err := client.CartItem.
-		Query().
-		Where(cart_item.CartID(cartObj.ID)).
-		All(ctx)
-
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao buscar itens do carrinho",
-			"error":   err.Error(),
-		})
+	// Executar o checkout em uma única transação
+	provider := c.Locals("shippingProvider").(shipping.ShippingProvider)
+	gateway := c.Locals("paymentGateway").(payments.PaymentGateway)
+	orderObj, paymentObj, cerr := placeOrder(ctx, client, provider, gateway, userId, cartObj.ID, req, addr)
+	if cerr != nil {
+		return checkoutErrorResponse(c, cerr)
 	}
 
-	// Verificar se há itens no carrinho
-	if len(cartItems) == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Não há itens no carrinho para criar o pedido",
-		})
+	// Enviar a quem comprou sem cadastro o link de consulta do pedido
+	orderToken := ""
+	if req.Guest != nil {
+		orderToken = issueOrderLookup(c, orderObj)
 	}
 
-	// Criar o pedido
-	orderId := uuid.New().String()
-	orderBuilder := client.Order.
-		Create().
-		SetID(orderId).
-		SetUserID(userId).
-		SetDate(time.Now()).
-		SetTotal(cartObj.Total).
-		SetShipping(cartObj.Shipping).
-		SetDiscount(cartObj.Discount).
-		SetDeliveryType(req.DeliveryType).
-		SetStatus("pending").
-		SetPaymentMethod(req.PaymentMethod).
-		SetPaymentStatus(req.PaymentStatus)
-
-	// Adicionar endereço se for delivery
-	if req.DeliveryType == "delivery" && req.AddressID != "" {
-		orderBuilder = orderBuilder.SetAddressID(req.AddressID)
-	}
-
-	// Adicionar cupom se estiver aplicado
-	if cartObj.AppliedCoupon && cartObj.CouponCode != "" {
-		orderBuilder = orderBuilder.SetCouponCode(cartObj.CouponCode)
-	}
-
-	// Salvar o pedido
-	orderObj, err := orderBuilder.Save(ctx)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao criar pedido",
-			"error":   err.Error(),
-		})
-	}
-
-	// Criar itens do pedido a partir dos itens do carrinho
-	for _, item := range cartItems {
-		// Buscar produto para pegar nome atualizado
-		prod, err := client.Product.Get(ctx, item.ProductID)
-		if err != nil && !ent.IsNotFound(err) {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"message": "Erro ao buscar produto",
-				"error":   err.Error(),
-			})
-		}
-
-		// Definir nome do produto (usar nome do carrinho se produto não existir mais)
-		prodName := item.Name
-		if err == nil && prod != nil {
-			prodName = prod.Name
-		}
-
-		// Criar item do pedido
-		_, err = client.OrderItem.
-			Create().
-			SetID(uuid.New().String()).
-			SetOrderID(orderId).
-			SetProductID(item.ProductID).
-			SetName(prodName).
-			SetQuantity(item.Quantity).
-			SetPrice(item.Price).
-			Save(ctx)
-
+	// Capturar o pagamento autorizado. O pedido já foi criado, então uma falha
+	// aqui apenas mantém o pagamento como autorizado para nova tentativa.
+	if paymentObj.Status == payment.StatusAuthorized {
+		capturedOrder, capturedPayment, err := captureOrderPayment(ctx, client, gateway, paymentObj)
 		if err != nil {
-			// Se falhar, continuar tentando os outros itens
-			// No final, o usuário terá um pedido incompleto, mas é melhor que nenhum
-			continue
+			return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+				"message":    "Pedido criado, mas o pagamento ainda não foi capturado",
+				"order":      orderObj,
+				"payment":    paymentObj,
+				"orderToken": orderToken,
+				"error":      err.Error(),
+			})
 		}
-	}
-
-	// Limpar o carrinho
-	_, err = client.CartItem.
-		Delete().
-		Where(cart_item.CartID(cartObj.ID)).
-		Exec(ctx)
-
-	if err != nil {
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Pedido criado com sucesso, mas houve erro ao limpar o carrinho",
-			"order":   orderObj,
-			"error":   err.Error(),
-		})
-	}
-
-	// Resetar o carrinho
-	_, err = client.Cart.
-		UpdateOne(cartObj).
-		SetSubtotal(0).
-		SetDiscount(0).
-		SetTotal(0).
-		SetAppliedCoupon(false).
-		SetCouponCode("").
-		Save(ctx)
-
-	if err != nil {
-		return c.Status(fiber.StatusOK).JSON(fiber.Map{
-			"message": "Pedido criado com sucesso, mas houve erro ao resetar o carrinho",
-			"order":   orderObj,
-			"error":   err.Error(),
-		})
+		orderObj, paymentObj = capturedOrder, capturedPayment
 	}
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"message": "Pedido criado com sucesso",
-		"order":   orderObj,
+		"message":    "Pedido criado com sucesso",
+		"order":      orderObj,
+		"payment":    paymentObj,
+		"orderToken": orderToken,
 	})
 }
 
@@ -343,9 +275,8 @@ func UpdateOrderStatus(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 	
-	// Verificar se o usuário é admin
-	isAdmin := c.Locals("isAdmin").(bool)
-	if !isAdmin {
+	// Verificar se o usuário pode gerenciar pedidos
+	if !middleware.HasPermission(c, middleware.PermOrdersManage) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Apenas administradores podem atualizar o status de pedidos",
 		})
@@ -353,7 +284,7 @@ func UpdateOrderStatus(c fiber.Ctx) error {
 
 	// Extrair dados do request
 	var req OrderStatusUpdate
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -378,32 +309,39 @@ func UpdateOrderStatus(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar se o pedido existe
-	exists, err := client.Order.
-		Query().
-		Where(order.ID(id)).
-		Exist(ctx)
-
+	// Buscar o pedido
+	orderObj, err := client.Order.Get(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pedido não encontrado",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao verificar pedido",
+			"message": "Erro ao buscar pedido",
 			"error":   err.Error(),
 		})
 	}
 
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"message": "Pedido não encontrado",
+	// Verificar se a transição é permitida a partir do status atual
+	newStatus := order.Status(req.Status)
+	if !canTransitionOrder(orderObj.Status, newStatus) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message":        "Transição de status não permitida",
+			"current_status": orderObj.Status,
+			"allowed_status": orderStatusTransitions[orderObj.Status],
 		})
 	}
 
-	// Atualizar status do pedido
-	updatedOrder, err := client.Order.
-		UpdateOneID(id).
-		SetStatus(req.Status).
-		Save(ctx)
-
+	// Atualizar status do pedido e registrar no histórico
+	gateway := c.Locals("paymentGateway").(payments.PaymentGateway)
+	updatedOrder, err := changeOrderStatus(ctx, client, gateway, orderObj, newStatus, getUserIdFromContext(c), req.Note)
 	if err != nil {
+		if errors.Is(err, errInvalidOrderTransition) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "O status do pedido foi alterado por outra operação",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao atualizar status do pedido",
 			"error":   err.Error(),
@@ -416,6 +354,64 @@ func UpdateOrderStatus(c fiber.Ctx) error {
 	})
 }
 
+// GetOrderHistory retorna o histórico de status de um pedido
+// GET /api/orders/:id/history
+func GetOrderHistory(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	// Usuários que gerenciam pedidos podem ver qualquer pedido, clientes apenas os próprios
+	query := client.Order.
+		Query().
+		Where(order.ID(id))
+
+	if !middleware.HasPermission(c, middleware.PermOrdersManage) {
+		query = query.Where(order.UserID(userId))
+	}
+
+	orderObj, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pedido não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pedido",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar eventos de status em ordem cronológica
+	events, err := client.OrderStatusEvent.
+		Query().
+		Where(orderstatusevent.OrderID(id)).
+		Order(ent.Asc(orderstatusevent.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar histórico do pedido",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"order_id": orderObj.ID,
+		"status":   orderObj.Status,
+		"history":  events,
+	})
+}
+
 // CancelOrder cancela um pedido
 // DELETE /api/orders/:id
 func CancelOrder(c fiber.Ctx) error {
@@ -453,19 +449,21 @@ func CancelOrder(c fiber.Ctx) error {
 	}
 
 	// Verificar se o pedido pode ser cancelado
-	if orderObj.Status == "delivered" || orderObj.Status == "cancelled" {
+	if !canTransitionOrder(orderObj.Status, order.StatusCancelled) {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Não é possível cancelar um pedido entregue ou já cancelado",
+			"message": "Apenas pedidos pendentes ou em processamento podem ser cancelados",
 		})
 	}
 
-	// Apenas cancelar o pedido (não excluir)
-	updatedOrder, err := client.Order.
-		UpdateOne(orderObj).
-		SetStatus("cancelled").
-		Save(ctx)
-
+	// Apenas cancelar o pedido (não excluir), devolver os itens ao estoque e estornar o pagamento
+	gateway := c.Locals("paymentGateway").(payments.PaymentGateway)
+	updatedOrder, err := changeOrderStatus(ctx, client, gateway, orderObj, order.StatusCancelled, userId, "Cancelado pelo cliente")
 	if err != nil {
+		if errors.Is(err, errInvalidOrderTransition) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "O pedido foi alterado e não pode mais ser cancelado",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao cancelar pedido",
 			"error":   err.Error(),
@@ -476,4 +474,473 @@ func CancelOrder(c fiber.Ctx) error {
 		"message": "Pedido cancelado com sucesso",
 		"order":   updatedOrder,
 	})
-} 
\ No newline at end of file
+}
+
+// Transições de status permitidas para um pedido
+var orderStatusTransitions = map[order.Status][]order.Status{
+	order.StatusPending:    {order.StatusProcessing, order.StatusCancelled},
+	order.StatusProcessing: {order.StatusShipped, order.StatusCancelled},
+	order.StatusShipped:    {order.StatusDelivered},
+}
+
+// Erro retornado quando o pedido não pode passar para o status desejado
+var errInvalidOrderTransition = errors.New("transição de status do pedido não permitida")
+
+// Helper para verificar se um pedido pode passar de um status para outro
+func canTransitionOrder(from, to order.Status) bool {
+	for _, allowed := range orderStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper que executa transitionOrderStatus em uma transação própria,
+// liberando os pagamentos do pedido quando ele é cancelado
+func changeOrderStatus(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway, orderObj *ent.Order, to order.Status, changedBy, note string) (*ent.Order, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedOrder, err := transitionOrderStatus(ctx, tx, orderObj, to, changedBy, note)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if to == order.StatusCancelled {
+		if err := releaseOrderPayments(ctx, tx, gateway, orderObj.ID); err != nil {
+			return nil, rollback(tx, err)
+		}
+
+		// Recarregar o pedido com o status de pagamento atualizado
+		updatedOrder, err = tx.Order.Get(ctx, orderObj.ID)
+		if err != nil {
+			return nil, rollback(tx, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return updatedOrder.Unwrap(), nil
+}
+
+// Helper que aplica uma transição de status validada dentro de uma transação,
+// devolvendo o estoque em cancelamentos e registrando o evento no histórico
+func transitionOrderStatus(ctx context.Context, tx *ent.Tx, orderObj *ent.Order, to order.Status, changedBy, note string) (*ent.Order, error) {
+	if !canTransitionOrder(orderObj.Status, to) {
+		return nil, errInvalidOrderTransition
+	}
+
+	// Atualizar somente se o status não mudou desde a leitura
+	affected, err := tx.Order.
+		Update().
+		Where(
+			order.ID(orderObj.ID),
+			order.StatusEQ(orderObj.Status),
+		).
+		SetStatus(to).
+		Save(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if affected == 0 {
+		return nil, errInvalidOrderTransition
+	}
+
+	// Devolver os itens ao estoque em caso de cancelamento
+	if to == order.StatusCancelled {
+		if err := restoreOrderStock(ctx, tx, orderObj.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	from := orderObj.Status
+	if err := recordOrderStatusEvent(ctx, tx, orderObj.ID, &from, to, changedBy, note); err != nil {
+		return nil, err
+	}
+
+	return tx.Order.Get(ctx, orderObj.ID)
+}
+
+// Helper para registrar uma mudança de status no histórico do pedido
+func recordOrderStatusEvent(ctx context.Context, tx *ent.Tx, orderId string, from *order.Status, to order.Status, changedBy, note string) error {
+	event := tx.OrderStatusEvent.
+		Create().
+		SetID(uuid.New().String()).
+		SetOrderID(orderId).
+		SetToStatus(orderstatusevent.ToStatus(to)).
+		SetNillableChangedBy(nilIfEmpty(changedBy)).
+		SetNillableNote(nilIfEmpty(note))
+
+	if from != nil {
+		event = event.SetFromStatus(orderstatusevent.FromStatus(*from))
+	}
+
+	return event.Exec(ctx)
+}
+
+// Helper para devolver ao estoque os itens de um pedido
+func restoreOrderStock(ctx context.Context, tx *ent.Tx, orderId string) error {
+	items, err := tx.OrderItem.
+		Query().
+		Where(orderitem.OrderID(orderId)).
+		All(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := inventory.Restore(ctx, tx.Client(), item.ProductID, item.VariantID, item.Quantity); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper para desfazer uma transação preservando o erro original
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		err = fmt.Errorf("%w (rollback: %v)", err, rerr)
+	}
+	return err
+}
+
+// CheckoutError representa uma falha estruturada durante o checkout
+type CheckoutError struct {
+	Status  int    `json:"-"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	Err     error  `json:"-"`
+}
+
+// Error implementa a interface error
+func (e *CheckoutError) Error() string {
+	if e.Err != nil {
+		return e.Message + ": " + e.Err.Error()
+	}
+	return e.Message
+}
+
+// Unwrap retorna o erro original
+func (e *CheckoutError) Unwrap() error {
+	return e.Err
+}
+
+// Helper para criar um erro de checkout
+func newCheckoutError(status int, code, message string, err error) *CheckoutError {
+	return &CheckoutError{
+		Status:  status,
+		Code:    code,
+		Message: message,
+		Err:     err,
+	}
+}
+
+// Helper para responder com um erro de checkout
+func checkoutErrorResponse(c fiber.Ctx, err *CheckoutError) error {
+	body := fiber.Map{
+		"message": err.Message,
+		"code":    err.Code,
+	}
+	if err.Err != nil {
+		body["error"] = err.Err.Error()
+	}
+	return c.Status(err.Status).JSON(body)
+}
+
+// Helper para desfazer a transação preservando o erro de checkout
+func rollbackCheckout(tx *ent.Tx, cerr *CheckoutError) *CheckoutError {
+	if rerr := tx.Rollback(); rerr != nil {
+		if cerr.Err == nil {
+			cerr.Err = rerr
+		} else {
+			cerr.Err = fmt.Errorf("%w (rollback: %v)", cerr.Err, rerr)
+		}
+	}
+	return cerr
+}
+
+// Helper que cria o pedido, seus itens, consome o cupom, esvazia o carrinho e
+// autoriza o pagamento dentro de uma única transação. Qualquer falha desfaz todas as etapas.
+func placeOrder(ctx context.Context, client *ent.Client, provider shipping.ShippingProvider, gateway payments.PaymentGateway, userId, cartId string, req OrderRequest, addr *ent.Address) (*ent.Order, *ent.Payment, *CheckoutError) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, newCheckoutError(fiber.StatusInternalServerError, "transaction_failed", "Erro ao iniciar transação do pedido", err)
+	}
+
+	// Buscar carrinho do usuário ou do visitante
+	cartObj, err := tx.Cart.
+		Query().
+		Where(cart.ID(cartId)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusNotFound, "cart_not_found", "Carrinho não encontrado", nil))
+		}
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "cart_query_failed", "Erro ao buscar carrinho", err))
+	}
+
+	// Reprecificar o carrinho com os preços e estoques atuais antes de fechar o pedido
+	cartObj, err = updateCartTotals(ctx, tx.Client(), cartObj.ID)
+	if err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "cart_reprice_failed", "Erro ao atualizar preços do carrinho", err))
+	}
+
+	// Buscar itens do carrinho
+	cartItems, err := tx.CartItem.
+		Query().
+		Where(cartitem.CartID(cartObj.ID)).
+		All(ctx)
+
+	if err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "cart_items_query_failed", "Erro ao buscar itens do carrinho", err))
+	}
+
+	// Verificar se há itens no carrinho
+	if len(cartItems) == 0 {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusBadRequest, "cart_empty", "Não há itens no carrinho para criar o pedido", nil))
+	}
+
+	// Alterações de preço ou disponibilidade precisam ser confirmadas pelo cliente
+	// (POST /api/cart/acknowledge). A reprecificação é gravada para que ele as veja.
+	if cartHasPendingChanges(cartItems) {
+		if err := tx.Commit(); err != nil {
+			return nil, nil, newCheckoutError(fiber.StatusInternalServerError, "transaction_failed", "Erro ao atualizar preços do carrinho", err)
+		}
+		return nil, nil, newCheckoutError(fiber.StatusConflict, "cart_changed", "Preços ou disponibilidade de itens do carrinho mudaram; revise e confirme o carrinho antes de finalizar o pedido", nil)
+	}
+
+	// Conferir o cupom de novo com o subtotal reprecificado: ele pode ter expirado ou
+	// deixado de atingir o valor mínimo depois de aplicado
+	if cartObj.AppliedCoupon && cartObj.CouponCode != "" {
+		problem, err := cartCouponProblem(ctx, tx.Client(), cartObj.CouponCode, cartObj.Subtotal)
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "coupon_query_failed", "Erro ao verificar cupom", err))
+		}
+		if problem != "" {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusConflict, "coupon_unavailable", problem, nil))
+		}
+	}
+
+	// Gravar o endereço informado pelo visitante, sem vínculo com nenhum usuário
+	if req.Guest != nil && req.DeliveryType == "delivery" {
+		guestAddr := req.Guest.Address
+		addr, err = tx.Address.
+			Create().
+			SetID(uuid.New().String()).
+			SetCep(guestAddr.CEP).
+			SetLogradouro(guestAddr.Logradouro).
+			SetNumero(guestAddr.Numero).
+			SetNillableComplemento(nilIfEmpty(guestAddr.Complemento)).
+			SetBairro(guestAddr.Bairro).
+			SetCidade(guestAddr.Cidade).
+			SetEstado(guestAddr.Estado).
+			Save(ctx)
+
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "address_create_failed", "Erro ao salvar endereço de entrega", err))
+		}
+	}
+
+	// Confirmar o frete escolhido cotando novamente para o endereço do pedido
+	var shippingOption shipping.Option
+	if addr != nil {
+		if cartObj.ShippingService == "" {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusBadRequest, "shipping_not_selected", "Selecione uma modalidade de frete antes de finalizar o pedido", nil))
+		}
+
+		option, err := quoteCartShipping(ctx, tx.Client(), provider, cartItems, addr.Cep, addr.Estado, cartObj.ShippingService)
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusUnprocessableEntity, "shipping_unavailable", "Não foi possível confirmar o frete para o endereço informado", err))
+		}
+		shippingOption = option
+	}
+
+	// Criar o pedido
+	orderId := uuid.New().String()
+	orderBuilder := tx.Order.
+		Create().
+		SetID(orderId).
+		SetNillableUserID(nilIfEmpty(userId)).
+		SetDate(time.Now()).
+		SetTotal(cartObj.Subtotal - cartObj.Discount + shippingOption.Price).
+		SetShipping(shippingOption.Price).
+		SetNillableShippingService(nilIfEmpty(shippingOption.Service)).
+		SetDiscount(cartObj.Discount).
+		SetDeliveryType(order.DeliveryType(req.DeliveryType)).
+		SetStatus(order.StatusPending).
+		SetPaymentMethod(req.PaymentMethod)
+
+	// Adicionar endereço se for delivery
+	if req.DeliveryType == "delivery" && addr != nil {
+		orderBuilder = orderBuilder.SetAddressID(addr.ID)
+	}
+
+	// Identificar o comprador sem cadastro
+	if req.Guest != nil {
+		orderBuilder = orderBuilder.
+			SetGuestEmail(req.Guest.Email).
+			SetGuestName(req.Guest.Name)
+	}
+
+	// Adicionar cupom se estiver aplicado
+	if cartObj.AppliedCoupon && cartObj.CouponCode != "" {
+		orderBuilder = orderBuilder.SetCouponCode(cartObj.CouponCode)
+	}
+
+	// Salvar o pedido
+	orderObj, err := orderBuilder.Save(ctx)
+	if err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "order_create_failed", "Erro ao criar pedido", err))
+	}
+
+	// Registrar o status inicial no histórico
+	if err := recordOrderStatusEvent(ctx, tx, orderId, nil, order.StatusPending, userId, ""); err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "order_history_failed", "Erro ao registrar histórico do pedido", err))
+	}
+
+	// Criar itens do pedido a partir dos itens do carrinho
+	for _, item := range cartItems {
+		// Buscar produto para pegar nome atualizado (o preço já foi reprecificado)
+		prod, err := tx.Product.Get(ctx, item.ProductID)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusConflict, "product_unavailable", "Produto \""+item.Name+"\" não está mais disponível", nil))
+			}
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "product_query_failed", "Erro ao buscar produto", err))
+		}
+
+		// Buscar a variante escolhida para registrar o SKU e as opções
+		sku := prod.Sku
+		if item.VariantID != "" {
+			variant, err := tx.ProductVariant.Get(ctx, item.VariantID)
+			if err != nil {
+				if ent.IsNotFound(err) {
+					return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusConflict, "product_unavailable", "Variante de \""+item.Name+"\" não está mais disponível", nil))
+				}
+				return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "product_query_failed", "Erro ao buscar variante", err))
+			}
+			sku = variant.Sku
+		}
+
+		// Baixar o estoque da variante e do produto somente se houver quantidade suficiente
+		reserved, err := inventory.Reserve(ctx, tx.Client(), prod.ID, item.VariantID, item.Quantity)
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "stock_update_failed", "Erro ao atualizar estoque", err))
+		}
+
+		if !reserved {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusConflict, "insufficient_stock", "Estoque insuficiente para o produto \""+prod.Name+"\"", nil))
+		}
+
+		// Criar item do pedido
+		_, err = tx.OrderItem.
+			Create().
+			SetID(uuid.New().String()).
+			SetOrderID(orderId).
+			SetProductID(item.ProductID).
+			SetNillableVariantID(nilIfEmpty(item.VariantID)).
+			SetSku(sku).
+			SetVariantOptions(item.VariantOptions).
+			SetName(prod.Name).
+			SetImage(item.Image).
+			SetQuantity(item.Quantity).
+			SetPrice(item.Price).
+			Save(ctx)
+
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "order_item_create_failed", "Erro ao criar item do pedido", err))
+		}
+	}
+
+	// Registrar o uso do cupom, respeitando o limite máximo de usos. Sem limite (vazio ou
+	// zero) o cupom é ilimitado, como em couponProblem.
+	if cartObj.AppliedCoupon && cartObj.CouponCode != "" {
+		affected, err := tx.Coupon.
+			Update().
+			Where(
+				coupon.Code(cartObj.CouponCode),
+				coupon.IsActive(true),
+				coupon.Or(
+					coupon.MaxUsesIsNil(),
+					coupon.MaxUsesLTE(0),
+					func(s *sql.Selector) {
+						s.Where(sql.ColumnsLT(s.C(coupon.FieldTimesUsed), s.C(coupon.FieldMaxUses)))
+					},
+				),
+			).
+			AddTimesUsed(1).
+			Save(ctx)
+
+		if err != nil {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "coupon_update_failed", "Erro ao registrar uso do cupom", err))
+		}
+
+		if affected == 0 {
+			return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusConflict, "coupon_unavailable", "Cupom inativo ou com limite de uso excedido", nil))
+		}
+	}
+
+	// Limpar o carrinho
+	_, err = tx.CartItem.
+		Delete().
+		Where(cartitem.CartID(cartObj.ID)).
+		Exec(ctx)
+
+	if err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "cart_clear_failed", "Erro ao limpar o carrinho", err))
+	}
+
+	// Resetar o carrinho
+	_, err = tx.Cart.
+		UpdateOne(cartObj).
+		SetSubtotal(0).
+		SetDiscount(0).
+		SetTotal(0).
+		SetAppliedCoupon(false).
+		SetCouponCode("").
+		ClearShipping().
+		ClearShippingService().
+		ClearShippingCep().
+		Save(ctx)
+
+	if err != nil {
+		return nil, nil, rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "cart_reset_failed", "Erro ao resetar o carrinho", err))
+	}
+
+	// Autorizar o pagamento por último, depois que todas as etapas do pedido foram validadas
+	paymentObj, cerr := authorizeOrderPayment(ctx, tx, gateway, orderObj, req.PaymentToken)
+	if cerr != nil {
+		cerr = rollbackCheckout(tx, cerr)
+		// Cancelar a autorização gerada no gateway, já que o pedido não será salvo
+		if paymentObj != nil {
+			voidAbandonedAuthorization(ctx, client, gateway, paymentObj)
+		}
+		return nil, nil, cerr
+	}
+
+	// Recarregar o pedido com o status de pagamento definido pelo gateway
+	orderObj, err = tx.Order.Get(ctx, orderId)
+	if err != nil {
+		cerr := rollbackCheckout(tx, newCheckoutError(fiber.StatusInternalServerError, "order_query_failed", "Erro ao buscar pedido", err))
+		voidAbandonedAuthorization(ctx, client, gateway, paymentObj)
+		return nil, nil, cerr
+	}
+
+	// Confirmar a transação, cancelando a autorização se o pedido não for salvo
+	if err := tx.Commit(); err != nil {
+		voidAbandonedAuthorization(ctx, client, gateway, paymentObj)
+		return nil, nil, newCheckoutError(fiber.StatusInternalServerError, "transaction_failed", "Erro ao confirmar pedido", err)
+	}
+
+	return orderObj.Unwrap(), paymentObj.Unwrap(), nil
+}
@@ -0,0 +1,664 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+	"github.com/vtrod/veecomm-api/money"
+	"github.com/vtrod/veecomm-api/payments"
+
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Erros do processamento de webhooks de pagamento
+var (
+	errWebhookDuplicate       = errors.New("evento de webhook já processado")
+	errWebhookPaymentNotFound = errors.New("pagamento do webhook não encontrado")
+)
+
+// Erros de reembolso
+var (
+	errNoRefundablePayment  = errors.New("pedido sem pagamento capturado para reembolso")
+	errRefundExceedsPayment = errors.New("valor do reembolso excede o saldo pago")
+)
+
+// HandlePaymentWebhook processa notificações assíncronas do provedor de pagamento
+// POST /api/payments/webhook/:provider
+func HandlePaymentWebhook(c fiber.Ctx) error {
+	provider := c.Params("provider")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Verificar se o provedor tem webhooks configurados
+	verifiers := c.Locals("webhookVerifiers").(map[string]payments.WebhookVerifier)
+	verifier, ok := verifiers[provider]
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Provedor de pagamento desconhecido",
+		})
+	}
+
+	// Validar assinatura e decodificar o evento
+	payload := c.Body()
+	event, err := verifier.ParseEvent(payload, c.Get(payments.SignatureHeader))
+	if err != nil {
+		if errors.Is(err, payments.ErrInvalidSignature) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Assinatura inválida",
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Evento inválido",
+			"error":   err.Error(),
+		})
+	}
+
+	// Processar o evento uma única vez
+	gateway := c.Locals("paymentGateway").(payments.PaymentGateway)
+	orderObj, err := processPaymentWebhook(ctx, client, gateway, provider, event, payload)
+	if err != nil {
+		if errors.Is(err, errWebhookDuplicate) {
+			return c.Status(fiber.StatusOK).JSON(fiber.Map{
+				"message":  "Evento já processado",
+				"event_id": event.ID,
+			})
+		}
+		if errors.Is(err, errWebhookPaymentNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pagamento não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao processar evento",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":        "Evento processado com sucesso",
+		"event_id":       event.ID,
+		"order_id":       orderObj.ID,
+		"order_status":   orderObj.Status,
+		"payment_status": orderObj.PaymentStatus,
+	})
+}
+
+// GetVoidFailedPayments lista as autorizações de pedidos não salvos cujo cancelamento
+// falhou no gateway e os pagamentos recebidos que precisam de conferência manual
+// GET /api/admin/payments/void-failed
+func GetVoidFailedPayments(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	paymentsList, err := client.Payment.
+		Query().
+		Where(payment.StatusIn(payment.StatusVoidFailed, payment.StatusReviewRequired)).
+		Order(ent.Desc(payment.FieldCreatedAt)).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pagamentos",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"payments": paymentsList,
+	})
+}
+
+// RetryPaymentVoid tenta de novo cancelar no gateway uma autorização com status void_failed
+// POST /api/admin/payments/:id/void
+func RetryPaymentVoid(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	gateway := c.Locals("paymentGateway").(payments.PaymentGateway)
+	ctx := context.Background()
+
+	paymentObj, err := client.Payment.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pagamento não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pagamento",
+			"error":   err.Error(),
+		})
+	}
+
+	if paymentObj.Status != payment.StatusVoidFailed {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "O pagamento não está pendente de cancelamento",
+		})
+	}
+
+	if _, err := gateway.Void(ctx, paymentObj.TransactionID); err != nil && !errors.Is(err, payments.ErrTransactionNotFound) {
+		log.Printf("Falha ao cancelar a autorização %s do pagamento %s: %v", paymentObj.TransactionID, paymentObj.ID, err)
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"message": "Erro ao cancelar a autorização no gateway",
+			"error":   err.Error(),
+		})
+	}
+
+	paymentObj, err = client.Payment.
+		UpdateOne(paymentObj).
+		SetStatus(payment.StatusVoided).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar pagamento",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Autorização cancelada com sucesso",
+		"payment": paymentObj,
+	})
+}
+
+// ExpireUnpaidOrders cancela os pedidos cujo Pix ou boleto venceu sem pagamento,
+// devolvendo o estoque. Retorna quantos pagamentos foram expirados.
+func ExpireUnpaidOrders(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway) (int, error) {
+	// Buscar pagamentos pendentes com prazo vencido
+	paymentList, err := client.Payment.
+		Query().
+		Where(
+			payment.StatusEQ(payment.StatusPending),
+			payment.ExpiresAtLT(time.Now()),
+		).
+		All(ctx)
+
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, paymentObj := range paymentList {
+		if err := expireUnpaidPayment(ctx, client, gateway, paymentObj); err != nil {
+			log.Printf("Erro ao expirar pagamento %s: %v", paymentObj.ID, err)
+			continue
+		}
+		expired++
+	}
+
+	return expired, nil
+}
+
+// Helper que cria o registro de pagamento do pedido e autoriza o valor no gateway.
+// Deve ser chamado dentro da transação do checkout. Se o gateway gerar uma transação
+// e o checkout falhar depois disso, o pagamento também é retornado junto com o erro,
+// para que o chamador cancele a autorização depois de desfazer a transação.
+func authorizeOrderPayment(ctx context.Context, tx *ent.Tx, gateway payments.PaymentGateway, orderObj *ent.Order, token string) (*ent.Payment, *CheckoutError) {
+	paymentObj, err := tx.Payment.
+		Create().
+		SetID(uuid.New().String()).
+		SetOrderID(orderObj.ID).
+		SetProvider(gateway.Name()).
+		SetMethod(orderObj.PaymentMethod).
+		SetAmount(orderObj.Total).
+		Save(ctx)
+
+	if err != nil {
+		return nil, newCheckoutError(fiber.StatusInternalServerError, "payment_create_failed", "Erro ao registrar pagamento", err)
+	}
+
+	result, err := gateway.Authorize(ctx, payments.AuthorizeRequest{
+		OrderID: orderObj.ID,
+		Amount:  orderObj.Total,
+		Method:  orderObj.PaymentMethod,
+		Token:   token,
+	})
+
+	// Pagamento com a transação do gateway, usado para cancelar a autorização em caso de falha
+	var authorized *ent.Payment
+	if result.TransactionID != "" {
+		copied := *paymentObj
+		copied.TransactionID = result.TransactionID
+		authorized = &copied
+	}
+
+	if err != nil {
+		return authorized, newCheckoutError(fiber.StatusBadGateway, "payment_gateway_error", "Erro ao processar pagamento", err)
+	}
+
+	if result.Status == payments.StatusDeclined {
+		message := "Pagamento recusado"
+		if result.Message != "" {
+			message = result.Message
+		}
+		return authorized, newCheckoutError(fiber.StatusPaymentRequired, "payment_declined", message, nil)
+	}
+
+	// Registrar o resultado da autorização no pagamento e no pedido
+	update := tx.Payment.
+		UpdateOne(paymentObj).
+		SetTransactionID(result.TransactionID).
+		SetStatus(payment.Status(result.Status))
+
+	// Guardar as instruções de pagamento de Pix e boleto
+	if result.Instructions != nil {
+		update = update.
+			SetNillablePixPayload(nilIfEmpty(result.Instructions.PixPayload)).
+			SetNillableBoletoBarcode(nilIfEmpty(result.Instructions.BoletoBarcode)).
+			SetNillableBoletoDigitableLine(nilIfEmpty(result.Instructions.BoletoDigitableLine)).
+			SetExpiresAt(result.Instructions.ExpiresAt)
+	}
+
+	paymentObj, err = update.Save(ctx)
+
+	if err != nil {
+		return authorized, newCheckoutError(fiber.StatusInternalServerError, "payment_update_failed", "Erro ao registrar autorização do pagamento", err)
+	}
+
+	err = tx.Order.
+		UpdateOneID(orderObj.ID).
+		SetPaymentStatus(string(result.Status)).
+		Exec(ctx)
+
+	if err != nil {
+		return authorized, newCheckoutError(fiber.StatusInternalServerError, "payment_update_failed", "Erro ao registrar autorização do pagamento", err)
+	}
+
+	return paymentObj, nil
+}
+
+// Helper que captura um pagamento autorizado e move o pedido para processamento
+func captureOrderPayment(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway, paymentObj *ent.Payment) (*ent.Order, *ent.Payment, error) {
+	result, err := gateway.Capture(ctx, paymentObj.TransactionID, paymentObj.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orderObj, updatedPayment, err := markPaymentPaid(ctx, tx, paymentObj, result.Amount)
+	if err != nil {
+		return nil, nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, err
+	}
+
+	return orderObj.Unwrap(), updatedPayment.Unwrap(), nil
+}
+
+// Helper que registra um pagamento como pago e move o pedido pendente para processamento
+func markPaymentPaid(ctx context.Context, tx *ent.Tx, paymentObj *ent.Payment, capturedAmount money.Amount) (*ent.Order, *ent.Payment, error) {
+	updatedPayment, err := tx.Payment.
+		UpdateOne(paymentObj).
+		SetStatus(payment.StatusPaid).
+		SetCapturedAmount(capturedAmount).
+		Save(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orderObj, err := tx.Order.
+		UpdateOneID(paymentObj.OrderID).
+		SetPaymentStatus(string(payments.StatusPaid)).
+		Save(ctx)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if orderObj.Status == order.StatusPending {
+		orderObj, err = transitionOrderStatus(ctx, tx, orderObj, order.StatusProcessing, "", "Pagamento confirmado")
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return orderObj, updatedPayment, nil
+}
+
+// Helper que cancela autorizações e reembolsa valores capturados de um pedido cancelado.
+// Deve ser chamado dentro da transação que cancela o pedido.
+func releaseOrderPayments(ctx context.Context, tx *ent.Tx, gateway payments.PaymentGateway, orderId string) error {
+	paymentList, err := tx.Payment.
+		Query().
+		Where(
+			payment.OrderID(orderId),
+			payment.StatusIn(
+				payment.StatusPending,
+				payment.StatusAuthorized,
+				payment.StatusPaid,
+				payment.StatusPartiallyRefunded,
+			),
+		).
+		All(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	orderPaymentStatus := ""
+	refundedTotal := money.Zero
+	for _, paymentObj := range paymentList {
+		update := tx.Payment.UpdateOne(paymentObj)
+
+		switch paymentObj.Status {
+		case payment.StatusPending, payment.StatusAuthorized:
+			// Pagamentos pendentes podem não existir no gateway ainda
+			_, err := gateway.Void(ctx, paymentObj.TransactionID)
+			if err != nil && !(paymentObj.Status == payment.StatusPending && errors.Is(err, payments.ErrTransactionNotFound)) {
+				return err
+			}
+			update = update.SetStatus(payment.StatusVoided)
+			orderPaymentStatus = string(payments.StatusVoided)
+
+		default:
+			// Reembolsar o saldo capturado que ainda não foi devolvido
+			remaining := paymentObj.CapturedAmount - paymentObj.RefundedAmount
+			if remaining > 0 {
+				if _, err := gateway.Refund(ctx, paymentObj.TransactionID, remaining); err != nil {
+					return err
+				}
+				refundedTotal += remaining
+			}
+			update = update.
+				SetStatus(payment.StatusRefunded).
+				SetRefundedAmount(paymentObj.CapturedAmount)
+			orderPaymentStatus = string(payments.StatusRefunded)
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	if orderPaymentStatus == "" {
+		return nil
+	}
+
+	return tx.Order.
+		UpdateOneID(orderId).
+		SetPaymentStatus(orderPaymentStatus).
+		AddRefundedTotal(refundedTotal).
+		Exec(ctx)
+}
+
+// Helper que reembolsa parte do valor pago de um pedido pelo gateway e registra o
+// valor no pagamento e no pedido. Deve ser chamado dentro de uma transação.
+func refundOrderPayment(ctx context.Context, tx *ent.Tx, gateway payments.PaymentGateway, orderId string, amount money.Amount) error {
+	paymentObj, err := tx.Payment.
+		Query().
+		Where(
+			payment.OrderID(orderId),
+			payment.StatusIn(payment.StatusPaid, payment.StatusPartiallyRefunded),
+		).
+		Order(ent.Desc(payment.FieldCreatedAt)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return errNoRefundablePayment
+		}
+		return err
+	}
+
+	if amount > paymentObj.CapturedAmount-paymentObj.RefundedAmount {
+		return errRefundExceedsPayment
+	}
+
+	result, err := gateway.Refund(ctx, paymentObj.TransactionID, amount)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Payment.
+		UpdateOne(paymentObj).
+		SetStatus(payment.Status(result.Status)).
+		SetRefundedAmount(paymentObj.RefundedAmount + amount).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Order.
+		UpdateOneID(orderId).
+		SetPaymentStatus(string(result.Status)).
+		AddRefundedTotal(amount).
+		Exec(ctx)
+}
+
+// Helper que registra o evento de webhook e aplica seu efeito no pagamento e no pedido
+// na mesma transação. O índice único (provider, event_id) garante que reenvios do mesmo
+// evento, inclusive concorrentes, sejam aplicados apenas uma vez.
+func processPaymentWebhook(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway, provider string, event payments.WebhookEvent, payload []byte) (*ent.Order, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verificar se o evento já foi recebido
+	exists, err := tx.WebhookEvent.
+		Query().
+		Where(
+			webhookevent.Provider(provider),
+			webhookevent.EventID(event.ID),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if exists {
+		return nil, rollback(tx, errWebhookDuplicate)
+	}
+
+	err = tx.WebhookEvent.
+		Create().
+		SetID(uuid.New().String()).
+		SetProvider(provider).
+		SetEventID(event.ID).
+		SetEventType(string(event.Type)).
+		SetTransactionID(event.TransactionID).
+		SetPayload(string(payload)).
+		Exec(ctx)
+
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, rollback(tx, errWebhookDuplicate)
+		}
+		return nil, rollback(tx, err)
+	}
+
+	// Buscar o pagamento correspondente à transação
+	paymentObj, err := tx.Payment.
+		Query().
+		Where(
+			payment.Provider(provider),
+			payment.TransactionID(event.TransactionID),
+		).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, rollback(tx, errWebhookPaymentNotFound)
+		}
+		return nil, rollback(tx, err)
+	}
+
+	// Apenas pagamentos ainda em aberto mudam de estado. Um valor recebido para um
+	// pagamento já encerrado (expirado, cancelado ou recusado) vai para conferência manual.
+	isOpen := paymentObj.Status == payment.StatusPending || paymentObj.Status == payment.StatusAuthorized
+	switch {
+	case isOpen && event.Type == payments.EventPaymentPaid:
+		err = applyPaymentPaid(ctx, tx, gateway, paymentObj, event.Amount)
+	case isOpen && event.Type == payments.EventPaymentExpired:
+		err = applyPaymentExpired(ctx, tx, paymentObj)
+	case event.Type == payments.EventPaymentPaid && paymentClosedUnpaid(paymentObj.Status):
+		err = flagPaymentForReview(ctx, tx, paymentObj, event.Amount,
+			fmt.Sprintf("Pagamento de %s recebido com o pagamento já %s", event.Amount, paymentObj.Status))
+	}
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	orderObj, err := tx.Order.Get(ctx, paymentObj.OrderID)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return orderObj.Unwrap(), nil
+}
+
+// Helper que confirma um pagamento notificado como pago. Se o pedido já tiver sido
+// cancelado nesse meio tempo, o valor recebido é reembolsado. Valores menores que o
+// devido não confirmam o pedido e vão para conferência manual.
+func applyPaymentPaid(ctx context.Context, tx *ent.Tx, gateway payments.PaymentGateway, paymentObj *ent.Payment, amount money.Amount) error {
+	if amount < paymentObj.Amount {
+		return flagPaymentForReview(ctx, tx, paymentObj, amount,
+			fmt.Sprintf("Valor pago %s menor que o devido %s", amount, paymentObj.Amount))
+	}
+
+	orderObj, _, err := markPaymentPaid(ctx, tx, paymentObj, amount)
+	if err != nil {
+		return err
+	}
+
+	if orderObj.Status == order.StatusCancelled {
+		return releaseOrderPayments(ctx, tx, gateway, orderObj.ID)
+	}
+
+	return nil
+}
+
+// Helper que marca um pagamento como expirado e cancela o pedido, devolvendo o estoque
+func applyPaymentExpired(ctx context.Context, tx *ent.Tx, paymentObj *ent.Payment) error {
+	err := tx.Payment.
+		UpdateOne(paymentObj).
+		SetStatus(payment.StatusExpired).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	orderObj, err := tx.Order.
+		UpdateOneID(paymentObj.OrderID).
+		SetPaymentStatus(string(payments.StatusExpired)).
+		Save(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	if !canTransitionOrder(orderObj.Status, order.StatusCancelled) {
+		return nil
+	}
+
+	_, err = transitionOrderStatus(ctx, tx, orderObj, order.StatusCancelled, "", "Pagamento expirado")
+	return err
+}
+
+// Helper que expira um pagamento pendente em uma transação própria
+func expireUnpaidPayment(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway, paymentObj *ent.Payment) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Expirar somente se o pagamento continua pendente (um webhook pode ter chegado)
+	affected, err := tx.Payment.
+		Update().
+		Where(
+			payment.ID(paymentObj.ID),
+			payment.StatusEQ(payment.StatusPending),
+		).
+		SetStatus(payment.StatusExpired).
+		Save(ctx)
+
+	if err != nil {
+		return rollback(tx, err)
+	}
+
+	if affected == 0 {
+		return tx.Rollback()
+	}
+
+	// Invalidar a cobrança no provedor para que não seja mais paga
+	if _, err := gateway.Void(ctx, paymentObj.TransactionID); err != nil && !errors.Is(err, payments.ErrTransactionNotFound) {
+		return rollback(tx, err)
+	}
+
+	if err := applyPaymentExpired(ctx, tx, paymentObj); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+// Helper que cancela a autorização de um pedido que não chegou a ser salvo. Se o
+// gateway não cancelar, o erro é registrado no log e o pagamento é gravado sem pedido,
+// com status void_failed, para que a administração o cancele manualmente.
+func voidAbandonedAuthorization(ctx context.Context, client *ent.Client, gateway payments.PaymentGateway, paymentObj *ent.Payment) {
+	_, err := gateway.Void(ctx, paymentObj.TransactionID)
+	if err == nil || errors.Is(err, payments.ErrTransactionNotFound) {
+		return
+	}
+
+	log.Printf("Falha ao cancelar a autorização %s do pedido %s, que não foi salvo: %v", paymentObj.TransactionID, paymentObj.OrderID, err)
+
+	err = client.Payment.
+		Create().
+		SetID(paymentObj.ID).
+		SetProvider(paymentObj.Provider).
+		SetMethod(paymentObj.Method).
+		SetTransactionID(paymentObj.TransactionID).
+		SetAmount(paymentObj.Amount).
+		SetStatus(payment.StatusVoidFailed).
+		SetFailureReason("Pedido " + paymentObj.OrderID + " não foi salvo e o cancelamento da autorização falhou: " + err.Error()).
+		Exec(ctx)
+
+	if err != nil {
+		log.Printf("Erro ao registrar a autorização %s pendente de cancelamento: %v", paymentObj.TransactionID, err)
+	}
+}
+
+// Helper que indica se o pagamento foi encerrado sem que nenhum valor tenha sido recebido
+func paymentClosedUnpaid(status payment.Status) bool {
+	switch status {
+	case payment.StatusExpired, payment.StatusVoided, payment.StatusDeclined:
+		return true
+	}
+	return false
+}
+
+// Helper que marca um pagamento para conferência manual, guardando o valor recebido
+// e o motivo. O pedido não muda de status até que a administração resolva o pagamento.
+func flagPaymentForReview(ctx context.Context, tx *ent.Tx, paymentObj *ent.Payment, amount money.Amount, reason string) error {
+	log.Printf("Pagamento %s do pedido %s enviado para conferência: %s", paymentObj.ID, paymentObj.OrderID, reason)
+
+	return tx.Payment.
+		UpdateOne(paymentObj).
+		SetStatus(payment.StatusReviewRequired).
+		SetCapturedAmount(amount).
+		SetFailureReason(reason).
+		Exec(ctx)
+}
@@ -2,11 +2,21 @@ package controllers
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/avaliation"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/category"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+	"github.com/vtrod/veecomm-api/money"
+	"github.com/vtrod/veecomm-api/search"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
@@ -16,8 +26,8 @@ import (
 type ProductRequest struct {
 	Name        string                 `json:"name"`
 	Description string                 `json:"description"`
-	Price       float64                `json:"price"`
-	OldPrice    *float64               `json:"old_price,omitempty"`
+	Price       money.Amount           `json:"price"`
+	OldPrice    *money.Amount          `json:"old_price,omitempty"`
 	Image       string                 `json:"image"`
 	CategoryID  string                 `json:"category_id"`
 	Featured    bool                   `json:"featured"`
@@ -96,28 +106,11 @@ func GetProduct(c fiber.Ctx) error {
 		})
 	}
 
-	// Buscar avaliações do produto
-	avaliations, err := client.Avaliation.
-		Query().
-		Where(ent.HasProductWith(product.ID(id))).
-		Order(ent.Desc("date")).
-		Limit(10).
-		All(ctx)
-
-	if err != nil && !ent.IsNotFound(err) {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao buscar avaliações",
-			"error":   err.Error(),
-		})
-	}
-
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"product":     prod,
-		"avaliations": avaliations,
-	})
+	return productResponse(c, ctx, client, prod)
 }
 
-// GetProductsByCategory retorna produtos de uma categoria específica
+// GetProductsByCategory retorna produtos de uma categoria específica e, com
+// include_descendants=true, também os das suas subcategorias
 // GET /api/products/category/:categoryId
 func GetProductsByCategory(c fiber.Ctx) error {
 	categoryId := c.Params("categoryId")
@@ -138,6 +131,28 @@ func GetProductsByCategory(c fiber.Ctx) error {
 		})
 	}
 
+	// Incluir os produtos das subcategorias, se pedido
+	categoryIds := []string{categoryId}
+	if raw := c.Query("include_descendants"); raw != "" {
+		include, err := strconv.ParseBool(raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "include_descendants inválido: " + raw,
+			})
+		}
+
+		if include {
+			descendants, err := categoryDescendantIDs(ctx, client, categoryId)
+			if err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"message": "Erro ao buscar subcategorias",
+					"error":   err.Error(),
+				})
+			}
+			categoryIds = append(categoryIds, descendants...)
+		}
+	}
+
 	// Parâmetros de paginação
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	limit, _ := strconv.Atoi(c.Query("limit", "20"))
@@ -146,7 +161,7 @@ func GetProductsByCategory(c fiber.Ctx) error {
 	// Buscar produtos por categoria
 	products, err := client.Product.
 		Query().
-		Where(product.CategoryID(categoryId)).
+		Where(product.CategoryIDIn(categoryIds...)).
 		Limit(limit).
 		Offset(offset).
 		Order(ent.Desc("created_at")).
@@ -162,7 +177,7 @@ func GetProductsByCategory(c fiber.Ctx) error {
 	// Contar total para paginação
 	total, err := client.Product.
 		Query().
-		Where(product.CategoryID(categoryId)).
+		Where(product.CategoryIDIn(categoryIds...)).
 		Count(ctx)
 
 	if err != nil {
@@ -235,6 +250,41 @@ func GetPromotionProducts(c fiber.Ctx) error {
 	})
 }
 
+// SearchProducts busca produtos por texto, com filtros, ordenação e facetas
+// GET /api/products/search
+func SearchProducts(c fiber.Ctx) error {
+	index := c.Locals("searchIndex").(search.SearchIndex)
+	ctx := context.Background()
+
+	// Montar a busca a partir dos parâmetros
+	q, err := parseSearchQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Parâmetros de busca inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	result, err := index.Search(ctx, q)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar produtos",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"products": result.Products,
+		"facets":   result.Facets,
+		"meta": fiber.Map{
+			"total":       result.Total,
+			"page":        q.Page,
+			"limit":       q.Limit,
+			"total_pages": (result.Total + q.Limit - 1) / q.Limit,
+		},
+	})
+}
+
 // CreateProduct cria um novo produto
 // POST /api/products
 func CreateProduct(c fiber.Ctx) error {
@@ -243,7 +293,7 @@ func CreateProduct(c fiber.Ctx) error {
 
 	// Extrair dados do request
 	var req ProductRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -271,22 +321,30 @@ func CreateProduct(c fiber.Ctx) error {
 		})
 	}
 
-	// Criar produto
-	prod, err := client.Product.
-		Create().
-		SetID(uuid.New().String()).
-		SetName(req.Name).
-		SetDescription(req.Description).
-		SetPrice(req.Price).
-		SetNillableOldPrice(req.OldPrice).
-		SetImage(req.Image).
-		SetCategoryID(req.CategoryID).
-		SetCategoryName(category.Name).
-		SetFeatured(req.Featured).
-		SetNillableDetails(req.Details).
-		SetAverageRating(0).
-		SetTotalAvaliations(0).
-		Save(ctx)
+	// Criar produto com um slug gerado a partir do nome
+	productId := uuid.New().String()
+	var prod *ent.Product
+	_, err = saveWithUniqueSlug(ctx, client, slugredirect.EntityProduct, productId, req.Name, func(productSlug string) error {
+		var err error
+		prod, err = client.Product.
+			Create().
+			SetID(productId).
+			SetName(req.Name).
+			SetSlug(productSlug).
+			SetDescription(req.Description).
+			SetPrice(req.Price).
+			SetNillableOldPrice(req.OldPrice).
+			SetImage(req.Image).
+			SetCategoryID(req.CategoryID).
+			SetCategoryName(category.Name).
+			SetFeatured(req.Featured).
+			SetNillableDetails(req.Details).
+			SetAverageRating(0).
+			SetTotalAvaliations(0).
+			Save(ctx)
+
+		return err
+	})
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
@@ -309,22 +367,22 @@ func UpdateProduct(c fiber.Ctx) error {
 	ctx := context.Background()
 
 	// Verificar se o produto existe
-	exists, err := client.Product.Query().Where(product.ID(id)).Exist(ctx)
+	current, err := client.Product.Get(ctx, id)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Produto não encontrado",
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao verificar produto",
 			"error":   err.Error(),
 		})
 	}
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"message": "Produto não encontrado",
-		})
-	}
 
 	// Extrair dados do request
 	var req ProductRequest
-	if err := c.BodyParser(&req); err != nil {
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"message": "Dados inválidos",
 			"error":   err.Error(),
@@ -356,8 +414,16 @@ func UpdateProduct(c fiber.Ctx) error {
 		categoryName = category.Name
 	}
 
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
 	// Iniciar atualização
-	update := client.Product.UpdateOneID(id).
+	update := tx.Product.UpdateOneID(id).
 		SetUpdatedAt(time.Now())
 
 	// Aplicar cada campo que foi enviado
@@ -387,14 +453,47 @@ func UpdateProduct(c fiber.Ctx) error {
 	}
 
 	// Salvar atualização
-	prod, err := update.Save(ctx)
+	var prod *ent.Product
+	save := func(newSlug string) error {
+		if newSlug != current.Slug {
+			update = update.SetSlug(newSlug)
+		}
+		var err error
+		prod, err = update.Save(ctx)
+		return err
+	}
+
+	// Ao renomear, gerar um novo slug; o antigo fica no histórico apontando para o produto
+	newSlug := current.Slug
+	if req.Name != "" && req.Name != current.Name {
+		newSlug, err = saveWithUniqueSlug(ctx, tx.Client(), slugredirect.EntityProduct, id, req.Name, save)
+	} else {
+		err = save(newSlug)
+	}
+
 	if err != nil {
+		tx.Rollback()
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"message": "Erro ao atualizar produto",
 			"error":   err.Error(),
 		})
 	}
 
+	if err := recordSlugChange(ctx, tx.Client(), slugredirect.EntityProduct, id, current.Slug, newSlug); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao registrar slug antigo do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar produto",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Produto atualizado com sucesso",
 		"product": prod,
@@ -408,10 +507,10 @@ func DeleteProduct(c fiber.Ctx) error {
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
 
-	// Verificar se existem referencias ao produto em carrinhos ou pedidos
-	cartItemExists, err := client.CartItem.
+	// Verificar se existem referencias ao produto em pedidos
+	orderItemExists, err := client.OrderItem.
 		Query().
-		Where(ent.HasProductWith(product.ID(id))).
+		Where(orderitem.HasProductWith(product.ID(id))).
 		Exist(ctx)
 
 	if err != nil {
@@ -421,34 +520,32 @@ func DeleteProduct(c fiber.Ctx) error {
 		})
 	}
 
-	if cartItemExists {
+	if orderItemExists {
 		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
-			"message": "Não é possível excluir o produto pois ele está em carrinhos de compra",
+			"message": "Não é possível excluir o produto pois ele está em pedidos",
 		})
 	}
 
-	orderItemExists, err := client.OrderItem.
-		Query().
-		Where(ent.HasProductWith(product.ID(id))).
-		Exist(ctx)
+	// Desvincular o produto dos carrinhos; os itens passam a aparecer como
+	// indisponíveis até o cliente confirmar a remoção
+	_, err = client.CartItem.
+		Update().
+		Where(cartitem.ProductID(id)).
+		ClearProductID().
+		ClearVariantID().
+		Save(ctx)
 
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao verificar relações do produto",
+			"message": "Erro ao remover o produto dos carrinhos",
 			"error":   err.Error(),
 		})
 	}
 
-	if orderItemExists {
-		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
-			"message": "Não é possível excluir o produto pois ele está em pedidos",
-		})
-	}
-
 	// Excluir avaliações do produto primeiro
 	_, err = client.Avaliation.
 		Delete().
-		Where(ent.HasProductWith(product.ID(id))).
+		Where(avaliation.HasProductWith(product.ID(id))).
 		Exec(ctx)
 
 	if err != nil {
@@ -458,6 +555,39 @@ func DeleteProduct(c fiber.Ctx) error {
 		})
 	}
 
+	// Excluir variantes e opções do produto
+	_, err = client.ProductVariant.
+		Delete().
+		Where(productvariant.ProductID(id)).
+		Exec(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir variantes do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	_, err = client.ProductOption.
+		Delete().
+		Where(productoption.ProductID(id)).
+		Exec(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir opções do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	// Excluir os slugs antigos do produto
+	if err := deleteSlugRedirects(ctx, client, slugredirect.EntityProduct, id); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir slugs antigos do produto",
+			"error":   err.Error(),
+		})
+	}
+
 	// Excluir o produto
 	err = client.Product.
 		DeleteOneID(id).
@@ -478,4 +608,116 @@ func DeleteProduct(c fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"message": "Produto excluído com sucesso",
 	})
-} 
\ No newline at end of file
+} 
+
+// Helper que lê os parâmetros da busca de produtos: q, category (IDs separados por
+// vírgula), min_price, max_price, on_sale, in_stock, min_rating, sort, page e limit
+func parseSearchQuery(c fiber.Ctx) (search.Query, error) {
+	q := search.Query{
+		Text: strings.TrimSpace(c.Query("q")),
+		Sort: c.Query("sort"),
+	}
+
+	for _, id := range strings.Split(c.Query("category"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			q.CategoryIDs = append(q.CategoryIDs, id)
+		}
+	}
+
+	for _, p := range []struct {
+		name  string
+		value **money.Amount
+	}{{"min_price", &q.MinPrice}, {"max_price", &q.MaxPrice}} {
+		raw := c.Query(p.name)
+		if raw == "" {
+			continue
+		}
+		amount, err := money.Parse(raw)
+		if err != nil || amount < 0 {
+			return q, fmt.Errorf("%s inválido: %s", p.name, raw)
+		}
+		*p.value = &amount
+	}
+
+	for _, p := range []struct {
+		name  string
+		value *bool
+	}{{"on_sale", &q.OnSale}, {"in_stock", &q.InStock}} {
+		raw := c.Query(p.name)
+		if raw == "" {
+			continue
+		}
+		value, err := strconv.ParseBool(raw)
+		if err != nil {
+			return q, fmt.Errorf("%s inválido: %s", p.name, raw)
+		}
+		*p.value = value
+	}
+
+	if raw := c.Query("min_rating"); raw != "" {
+		rating, err := strconv.ParseFloat(raw, 64)
+		if err != nil || rating < 0 || rating > 5 {
+			return q, fmt.Errorf("min_rating inválido: %s", raw)
+		}
+		q.MinRating = rating
+	}
+
+	q.Page, _ = strconv.Atoi(c.Query("page", "1"))
+	q.Limit, _ = strconv.Atoi(c.Query("limit", strconv.Itoa(search.DefaultLimit)))
+	return q, q.Normalize()
+}
+
+// Helper que responde com os detalhes do produto: as avaliações mais recentes e a
+// matriz de opções e variantes
+func productResponse(c fiber.Ctx, ctx context.Context, client *ent.Client, prod *ent.Product) error {
+	// Buscar avaliações do produto
+	avaliations, err := client.Avaliation.
+		Query().
+		Where(avaliation.HasProductWith(product.ID(prod.ID))).
+		Order(ent.Desc("date")).
+		Limit(10).
+		All(ctx)
+
+	if err != nil && !ent.IsNotFound(err) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar avaliações",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar opções e variantes do produto
+	options, err := client.ProductOption.
+		Query().
+		Where(productoption.ProductID(prod.ID)).
+		Order(productoption.ByPosition()).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar opções do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	variants, err := client.ProductVariant.
+		Query().
+		Where(productvariant.ProductID(prod.ID)).
+		Order(productvariant.ByPosition(), productvariant.ByCreatedAt()).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar variantes do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	optionMatrix, variantViews := variantMatrix(prod, options, variants)
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"product":     prod,
+		"avaliations": avaliations,
+		"options":     optionMatrix,
+		"variants":    variantViews,
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/vtrod/veecomm-api/ent/product"
 	"github.com/vtrod/veecomm-api/ent/user"
 	"github.com/vtrod/veecomm-api/ent/avaliation"
+	"github.com/vtrod/veecomm-api/middleware"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
@@ -394,9 +395,8 @@ func DeleteProductReview(c fiber.Ctx) error {
 		})
 	}
 
-	// Verificar se a avaliação pertence ao usuário (ou se é admin)
-	isAdmin := c.Locals("isAdmin").(bool)
-	if review.UserID != userId && !isAdmin {
+	// Verificar se a avaliação pertence ao usuário (ou se ele pode moderar avaliações)
+	if review.UserID != userId && !middleware.HasPermission(c, middleware.PermReviewsModerate) {
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"message": "Você não tem permissão para excluir esta avaliação",
 		})
@@ -0,0 +1,438 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/session"
+	"github.com/vtrod/veecomm-api/middleware"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Erros da renovação de sessões
+var (
+	errRefreshTokenInvalid = errors.New("refresh token inválido ou expirado")
+	errRefreshTokenReused  = errors.New("refresh token reutilizado")
+)
+
+// RefreshRequest contém o refresh token a ser trocado por um novo par de tokens
+type RefreshRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LogoutRequest contém o refresh token da sessão a ser encerrada
+type LogoutRequest struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// SessionResponse representa uma sessão ativa do usuário
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	Device     string    `json:"device,omitempty"`
+	IP         string    `json:"ip,omitempty"`
+	UserAgent  string    `json:"userAgent,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	Current    bool      `json:"current"`
+}
+
+// RefreshToken troca um refresh token válido por um novo par de tokens (rotação)
+// POST /api/auth/refresh
+func RefreshToken(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	ctx := context.Background()
+
+	var req RefreshRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.RefreshToken == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Refresh token é obrigatório",
+		})
+	}
+
+	sessionObj, u, refreshToken, err := rotateSession(ctx, client, authConfig, req.RefreshToken, c.IP(), c.Get("User-Agent"))
+	if err != nil {
+		switch {
+		case errors.Is(err, errRefreshTokenReused):
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Refresh token já utilizado; todas as sessões deste login foram encerradas",
+			})
+		case errors.Is(err, errRefreshTokenInvalid):
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Refresh token inválido ou expirado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao renovar sessão",
+			"error":   err.Error(),
+		})
+	}
+
+	return tokenResponse(c, fiber.StatusOK, authConfig, u, sessionObj, refreshToken, nil)
+}
+
+// LogoutUser encerra a sessão do refresh token informado ou, na falta dele, a sessão atual
+// POST /api/auth/logout
+func LogoutUser(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	var req LogoutRequest
+	if len(c.Body()) > 0 {
+		if err := c.Bind().Body(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": "Erro ao processar requisição",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	// Identificar a família de sessões a encerrar
+	familyID, _ := c.Locals("sessionId").(string)
+	if req.RefreshToken != "" {
+		sessionObj, err := client.Session.
+			Query().
+			Where(session.TokenHash(hashToken(req.RefreshToken))).
+			Only(ctx)
+
+		if err != nil && !ent.IsNotFound(err) {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar sessão",
+				"error":   err.Error(),
+			})
+		}
+		if err == nil {
+			familyID = sessionObj.FamilyID
+		}
+	}
+
+	if familyID == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Sessão não encontrada",
+		})
+	}
+
+	if err := revokeSessionFamily(ctx, client, familyID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao encerrar sessão",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Sessão encerrada com sucesso",
+	})
+}
+
+// GetUserSessions lista as sessões ativas do usuário autenticado
+// GET /api/users/sessions
+func GetUserSessions(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+	currentID, _ := c.Locals("sessionId").(string)
+
+	// Só a sessão mais recente de cada família continua ativa
+	sessions, err := client.Session.
+		Query().
+		Where(
+			session.UserID(userId),
+			session.RevokedAtIsNil(),
+			session.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(session.FieldLastUsedAt)).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar sessões",
+			"error":   err.Error(),
+		})
+	}
+
+	response := make([]SessionResponse, 0, len(sessions))
+	for _, s := range sessions {
+		response = append(response, SessionResponse{
+			ID:         s.FamilyID,
+			Device:     s.Device,
+			IP:         s.IP,
+			UserAgent:  s.UserAgent,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.FamilyID == currentID,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"sessions": response,
+	})
+}
+
+// DeleteUserSession encerra uma sessão do usuário autenticado
+// DELETE /api/users/sessions/:id
+func DeleteUserSession(c fiber.Ctx) error {
+	familyID := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	// Verificar se a sessão pertence ao usuário
+	exists, err := client.Session.
+		Query().
+		Where(
+			session.FamilyID(familyID),
+			session.UserID(userId),
+			session.RevokedAtIsNil(),
+		).
+		Exist(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar sessão",
+			"error":   err.Error(),
+		})
+	}
+
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Sessão não encontrada",
+		})
+	}
+
+	if err := revokeSessionFamily(ctx, client, familyID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao encerrar sessão",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Sessão encerrada com sucesso",
+	})
+}
+
+// DeleteUserSessions encerra todas as sessões do usuário autenticado, exceto a atual
+// DELETE /api/users/sessions
+func DeleteUserSessions(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+	currentID, _ := c.Locals("sessionId").(string)
+
+	revoked, err := revokeUserSessions(ctx, client, userId, currentID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao encerrar sessões",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Sessões encerradas com sucesso",
+		"revoked": revoked,
+	})
+}
+
+// PurgeExpiredSessions remove do banco as sessões expiradas
+func PurgeExpiredSessions(ctx context.Context, client *ent.Client) (int, error) {
+	return client.Session.
+		Delete().
+		Where(session.ExpiresAtLT(time.Now())).
+		Exec(ctx)
+}
+
+// Helper que abre uma nova sessão (ou continua uma família existente) e devolve o
+// refresh token em texto puro, que só é conhecido pelo cliente
+func createSession(ctx context.Context, client *ent.Client, authConfig middleware.Config, userId, familyID, device, ip, userAgent string) (*ent.Session, string, error) {
+	refreshToken, err := newOpaqueToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if familyID == "" {
+		familyID = uuid.New().String()
+	}
+	if device == "" {
+		device = userAgent
+	}
+
+	sessionObj, err := client.Session.
+		Create().
+		SetID(uuid.New().String()).
+		SetUserID(userId).
+		SetFamilyID(familyID).
+		SetTokenHash(hashToken(refreshToken)).
+		SetDevice(device).
+		SetIP(ip).
+		SetUserAgent(userAgent).
+		SetExpiresAt(time.Now().Add(authConfig.RefreshTokenExpiry)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return sessionObj, refreshToken, nil
+}
+
+// Helper que troca o refresh token por um novo na mesma família. Apresentar um token
+// que já foi trocado indica roubo: a família inteira é revogada.
+func rotateSession(ctx context.Context, client *ent.Client, authConfig middleware.Config, refreshToken, ip, userAgent string) (*ent.Session, *ent.User, string, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	current, err := tx.Session.
+		Query().
+		Where(session.TokenHash(hashToken(refreshToken))).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, "", rollback(tx, errRefreshTokenInvalid)
+		}
+		return nil, nil, "", rollback(tx, err)
+	}
+
+	if current.RevokedAt != nil {
+		if current.ReplacedBy == "" {
+			return nil, nil, "", rollback(tx, errRefreshTokenInvalid)
+		}
+		return nil, nil, "", reuseDetected(ctx, client, tx, current)
+	}
+
+	if !current.ExpiresAt.After(time.Now()) {
+		return nil, nil, "", rollback(tx, errRefreshTokenInvalid)
+	}
+
+	u, err := tx.User.Get(ctx, current.UserID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, "", rollback(tx, errRefreshTokenInvalid)
+		}
+		return nil, nil, "", rollback(tx, err)
+	}
+
+	next, nextToken, err := createSession(ctx, tx.Client(), authConfig, current.UserID, current.FamilyID, current.Device, ip, userAgent)
+	if err != nil {
+		return nil, nil, "", rollback(tx, err)
+	}
+
+	// Revogar condicionalmente: se outra requisição trocou o mesmo token antes, é reuso
+	rotated, err := tx.Session.
+		Update().
+		Where(
+			session.ID(current.ID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		SetReplacedBy(next.ID).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, "", rollback(tx, err)
+	}
+	if rotated == 0 {
+		return nil, nil, "", reuseDetected(ctx, client, tx, current)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, nil, "", err
+	}
+
+	return next.Unwrap(), u.Unwrap(), nextToken, nil
+}
+
+// Helper que desfaz a rotação em andamento e revoga toda a família do token reutilizado
+func reuseDetected(ctx context.Context, client *ent.Client, tx *ent.Tx, reused *ent.Session) error {
+	if err := tx.Rollback(); err != nil {
+		return err
+	}
+
+	log.Printf("Reuso de refresh token detectado (usuário %s, família %s)", reused.UserID, reused.FamilyID)
+	if err := revokeSessionFamily(ctx, client, reused.FamilyID); err != nil {
+		return err
+	}
+	return errRefreshTokenReused
+}
+
+// Helper que revoga todas as sessões ainda ativas de uma família
+func revokeSessionFamily(ctx context.Context, client *ent.Client, familyID string) error {
+	_, err := client.Session.
+		Update().
+		Where(
+			session.FamilyID(familyID),
+			session.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	return err
+}
+
+// Helper que revoga todas as sessões ativas do usuário, exceto a família informada
+func revokeUserSessions(ctx context.Context, client *ent.Client, userId, exceptFamilyID string) (int, error) {
+	update := client.Session.
+		Update().
+		Where(
+			session.UserID(userId),
+			session.RevokedAtIsNil(),
+		)
+	if exceptFamilyID != "" {
+		update = update.Where(session.FamilyIDNEQ(exceptFamilyID))
+	}
+
+	return update.
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+}
+
+// Helper que gera um token opaco e aleatório (refresh tokens e links enviados por email)
+func newOpaqueToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Helper que calcula o hash armazenado de um token opaco
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -1,15 +1,131 @@
 package controllers
 
 import (
+	"context"
+	"errors"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/address"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+	"github.com/vtrod/veecomm-api/shipping"
+	"github.com/vtrod/veecomm-api/middleware"
+	"time"
+
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Estrutura para cotação de frete
+type ShippingQuoteRequest struct {
+	AddressID string            `json:"address_id"`
+	CEP       string            `json:"cep"`
+	Estado    string            `json:"estado"`
+	Items     []CartItemRequest `json:"items"`
+}
+
+// Estrutura para criar uma remessa
+type ShipmentRequest struct {
+	Carrier      string `json:"carrier"`
+	TrackingCode string `json:"tracking_code"`
+}
+
+// Estrutura para adicionar um evento de rastreamento
+type TrackingEventRequest struct {
+	Status      string     `json:"status"`
+	Description string     `json:"description"`
+	Location    string     `json:"location"`
+	OccurredAt  *time.Time `json:"occurred_at,omitempty"`
+}
+
+// Erros usados na cotação e seleção de frete
+var (
+	errShippingAddressNotFound    = errors.New("endereço de entrega não encontrado")
+	errShippingServiceUnavailable = errors.New("modalidade de frete indisponível para o destino")
 )
 
 // CalculateShipping calcula o custo de frete para um pedido
 // POST /api/shipping/calculate
 func CalculateShipping(c fiber.Ctx) error {
-	// TODO: Implementar cálculo de frete
+	client := c.Locals("dbClient").(*ent.Client)
+	provider := c.Locals("shippingProvider").(shipping.ShippingProvider)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req ShippingQuoteRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	// Resolver o destino a partir do endereço salvo ou do CEP informado
+	userId := getUserIdFromContext(c)
+	cep, estado, err := resolveShippingDestination(ctx, client, userId, req.AddressID, req.CEP, req.Estado)
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+
+	// Usar os itens enviados ou os itens do carrinho do usuário ou do visitante
+	items := req.Items
+	if len(items) == 0 {
+		cartObj, err := currentCart(c, client, false)
+		if err != nil && !errors.Is(err, errCartNotFound) {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar carrinho",
+				"error":   err.Error(),
+			})
+		}
+
+		if cartObj != nil {
+			cartItems, err := client.CartItem.
+				Query().
+				Where(cartitem.CartID(cartObj.ID)).
+				All(ctx)
+
+			if err != nil {
+				return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+					"message": "Erro ao buscar itens do carrinho",
+					"error":   err.Error(),
+				})
+			}
+
+			for _, item := range cartItems {
+				items = append(items, CartItemRequest{
+					ProductID: item.ProductID,
+					Quantity:  item.Quantity,
+				})
+			}
+		}
+	}
+
+	if len(items) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Informe os itens ou adicione produtos ao carrinho para calcular o frete",
+		})
+	}
+
+	// Montar a cotação com peso e subtotal atuais dos produtos
+	quoteReq, err := buildShippingQuoteRequest(ctx, client, items, cep, estado)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar produtos",
+			"error":   err.Error(),
+		})
+	}
+
+	options, err := provider.Quote(ctx, quoteReq)
+	if err != nil {
+		return shippingErrorResponse(c, err)
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Cálculo de frete será implementado aqui",
+		"cep":     cep,
+		"estado":  estado,
+		"options": options,
 	})
 }
 
@@ -17,10 +133,475 @@ func CalculateShipping(c fiber.Ctx) error {
 // GET /api/shipping/:orderId/track
 func TrackShipping(c fiber.Ctx) error {
 	orderId := c.Params("orderId")
-	
-	// TODO: Implementar rastreamento de entrega
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Usuários que gerenciam pedidos podem rastrear qualquer pedido, clientes apenas os
+	// próprios e quem comprou sem cadastro apenas o pedido do token de consulta
+	query := client.Order.
+		Query().
+		Where(order.ID(orderId))
+
+	if !hasOrderLookupToken(c, orderId) {
+		userId := getUserIdFromContext(c)
+		if userId == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Usuário não autenticado",
+			})
+		}
+
+		if !middleware.HasPermission(c, middleware.PermOrdersManage) {
+			query = query.Where(order.UserID(userId))
+		}
+	}
+
+	orderObj, err := query.First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pedido não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pedido",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar remessa do pedido
+	shipmentObj, err := client.Shipment.
+		Query().
+		Where(shipment.OrderID(orderId)).
+		First(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message":      "Pedido ainda não foi despachado",
+				"order_status": orderObj.Status,
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar remessa",
+			"error":   err.Error(),
+		})
+	}
+
+	// Buscar eventos de rastreamento em ordem cronológica
+	events, err := client.TrackingEvent.
+		Query().
+		Where(trackingevent.ShipmentID(shipmentObj.ID)).
+		Order(ent.Asc(trackingevent.FieldOccurredAt)).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar eventos de rastreamento",
+			"error":   err.Error(),
+		})
+	}
+
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"message": "Rastreamento de entrega será implementado aqui",
-		"orderId": orderId,
+		"order_id":     orderObj.ID,
+		"order_status": orderObj.Status,
+		"shipment":     shipmentObj,
+		"events":       events,
+	})
+}
+
+// CreateShipment registra a remessa de um pedido (admin)
+// POST /api/shipping/:orderId/shipment
+func CreateShipment(c fiber.Ctx) error {
+	orderId := c.Params("orderId")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req ShipmentRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.Carrier == "" || req.TrackingCode == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Transportadora e código de rastreamento são obrigatórios",
+		})
+	}
+
+	// Verificar se o pedido existe e pode ser despachado
+	orderObj, err := client.Order.Get(ctx, orderId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Pedido não encontrado",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar pedido",
+			"error":   err.Error(),
+		})
+	}
+
+	if orderObj.DeliveryType != order.DeliveryTypeDelivery {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Pedidos para retirada não possuem remessa",
+		})
+	}
+
+	if orderObj.Status != order.StatusProcessing && orderObj.Status != order.StatusShipped {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message":      "Apenas pedidos em processamento podem ser despachados",
+			"order_status": orderObj.Status,
+		})
+	}
+
+	// Verificar se já existe remessa para o pedido
+	exists, err := client.Shipment.
+		Query().
+		Where(shipment.OrderID(orderId)).
+		Exist(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar remessa",
+			"error":   err.Error(),
+		})
+	}
+
+	if exists {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "O pedido já possui uma remessa",
+		})
+	}
+
+	// Criar remessa
+	shipmentObj, err := client.Shipment.
+		Create().
+		SetID(uuid.New().String()).
+		SetOrderID(orderId).
+		SetCarrier(req.Carrier).
+		SetTrackingCode(req.TrackingCode).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao criar remessa",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":  "Remessa criada com sucesso",
+		"shipment": shipmentObj,
+	})
+}
+
+// AddTrackingEvent adiciona um evento de rastreamento à remessa (admin)
+// POST /api/shipping/:orderId/events
+func AddTrackingEvent(c fiber.Ctx) error {
+	orderId := c.Params("orderId")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req TrackingEventRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	// Validar status do evento
+	status := trackingevent.Status(req.Status)
+	if err := trackingevent.StatusValidator(status); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Status de rastreamento inválido",
+			"valid_status": []string{
+				"posted", "in_transit", "out_for_delivery", "delivered", "failed_attempt", "returned",
+			},
+		})
+	}
+
+	if req.Description == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Descrição do evento é obrigatória",
+		})
+	}
+
+	occurredAt := time.Now()
+	if req.OccurredAt != nil {
+		occurredAt = *req.OccurredAt
+	}
+
+	// Registrar evento, atualizar a remessa e avançar o pedido em uma única transação
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	shipmentObj, err := tx.Shipment.
+		Query().
+		Where(shipment.OrderID(orderId)).
+		WithOrder().
+		First(ctx)
+
+	if err != nil {
+		err = rollback(tx, err)
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Remessa não encontrada para o pedido",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar remessa",
+			"error":   err.Error(),
+		})
+	}
+
+	if shipmentObj.Edges.Order == nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Pedido não encontrado",
+		})
+	}
+
+	event, err := tx.TrackingEvent.
+		Create().
+		SetID(uuid.New().String()).
+		SetShipmentID(shipmentObj.ID).
+		SetStatus(status).
+		SetDescription(req.Description).
+		SetNillableLocation(nilIfEmpty(req.Location)).
+		SetOccurredAt(occurredAt).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao registrar evento de rastreamento",
+			"error":   rollback(tx, err).Error(),
+		})
+	}
+
+	// Atualizar status da remessa e datas de envio/entrega
+	shipmentUpdate := tx.Shipment.
+		UpdateOne(shipmentObj).
+		SetStatus(shipment.Status(status))
+
+	if shipmentObj.ShippedAt == nil && status != trackingevent.StatusReturned {
+		shipmentUpdate = shipmentUpdate.SetShippedAt(occurredAt)
+	}
+	if status == trackingevent.StatusDelivered {
+		shipmentUpdate = shipmentUpdate.SetDeliveredAt(occurredAt)
+	}
+
+	updatedShipment, err := shipmentUpdate.Save(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar remessa",
+			"error":   rollback(tx, err).Error(),
+		})
+	}
+
+	// Mover o pedido para enviado/entregue conforme o evento
+	orderObj, err := advanceOrderForTrackingEvent(ctx, tx, shipmentObj.Edges.Order, status, getUserIdFromContext(c))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar status do pedido",
+			"error":   rollback(tx, err).Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao confirmar evento de rastreamento",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":  "Evento de rastreamento registrado com sucesso",
+		"event":    event.Unwrap(),
+		"shipment": updatedShipment.Unwrap(),
+		"order":    orderObj.Unwrap(),
 	})
-} 
\ No newline at end of file
+}
+
+// Helper para resolver CEP e UF de destino a partir de um endereço salvo ou dos dados informados
+func resolveShippingDestination(ctx context.Context, client *ent.Client, userId, addressId, cep, estado string) (string, string, error) {
+	if addressId != "" {
+		if userId == "" {
+			return "", "", errShippingAddressNotFound
+		}
+
+		addr, err := client.Address.
+			Query().
+			Where(
+				address.ID(addressId),
+				address.UserID(userId),
+			).
+			First(ctx)
+
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return "", "", errShippingAddressNotFound
+			}
+			return "", "", err
+		}
+
+		cep, estado = addr.Cep, addr.Estado
+	}
+
+	normalized, err := shipping.NormalizeCEP(cep)
+	if err != nil {
+		return "", "", err
+	}
+
+	estado, err = shipping.ResolveEstado(normalized, estado)
+	if err != nil {
+		return "", "", err
+	}
+
+	return normalized, estado, nil
+}
+
+// Helper para montar a cotação somando peso e subtotal dos produtos atuais
+func buildShippingQuoteRequest(ctx context.Context, client *ent.Client, items []CartItemRequest, cep, estado string) (shipping.QuoteRequest, error) {
+	quoteReq := shipping.QuoteRequest{
+		CEP:    cep,
+		Estado: estado,
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+
+	products, err := client.Product.
+		Query().
+		Where(product.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return quoteReq, err
+	}
+
+	byID := make(map[string]*ent.Product, len(products))
+	for _, prod := range products {
+		byID[prod.ID] = prod
+	}
+
+	for _, item := range items {
+		prod, ok := byID[item.ProductID]
+		if !ok || item.Quantity <= 0 {
+			continue
+		}
+
+		quoteReq.WeightGrams += prod.Weight * item.Quantity
+		quoteReq.Subtotal += currentPrice(prod).Mul(item.Quantity)
+	}
+
+	return quoteReq, nil
+}
+
+// Helper para cotar os itens do carrinho e retornar a modalidade escolhida
+func quoteCartShipping(ctx context.Context, client *ent.Client, provider shipping.ShippingProvider, cartItems []*ent.CartItem, cep, estado, service string) (shipping.Option, error) {
+	items := make([]CartItemRequest, 0, len(cartItems))
+	for _, item := range cartItems {
+		// Itens indisponíveis não são enviados
+		if item.UnavailableReason != nil {
+			continue
+		}
+		items = append(items, CartItemRequest{
+			ProductID: item.ProductID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	quoteReq, err := buildShippingQuoteRequest(ctx, client, items, cep, estado)
+	if err != nil {
+		return shipping.Option{}, err
+	}
+
+	options, err := provider.Quote(ctx, quoteReq)
+	if err != nil {
+		return shipping.Option{}, err
+	}
+
+	option, ok := shipping.FindOption(options, service)
+	if !ok {
+		return shipping.Option{}, errShippingServiceUnavailable
+	}
+	return option, nil
+}
+
+// Status do pedido correspondente a cada evento de rastreamento
+var trackingEventOrderStatus = map[trackingevent.Status]order.Status{
+	trackingevent.StatusPosted:         order.StatusShipped,
+	trackingevent.StatusInTransit:      order.StatusShipped,
+	trackingevent.StatusOutForDelivery: order.StatusShipped,
+	trackingevent.StatusDelivered:      order.StatusDelivered,
+}
+
+// Helper que avança o pedido para enviado/entregue conforme o evento de rastreamento.
+// Eventos que não correspondem a uma transição válida mantêm o status atual.
+func advanceOrderForTrackingEvent(ctx context.Context, tx *ent.Tx, orderObj *ent.Order, status trackingevent.Status, changedBy string) (*ent.Order, error) {
+	target, ok := trackingEventOrderStatus[status]
+	if !ok || orderObj.Status == target {
+		return orderObj, nil
+	}
+
+	note := "Atualizado pelo rastreamento da entrega"
+
+	// Pedidos entregues sem evento de postagem passam antes por "enviado"
+	if target == order.StatusDelivered && orderObj.Status == order.StatusProcessing {
+		shipped, err := transitionOrderStatus(ctx, tx, orderObj, order.StatusShipped, changedBy, note)
+		if err != nil {
+			return nil, err
+		}
+		orderObj = shipped
+	}
+
+	if !canTransitionOrder(orderObj.Status, target) {
+		return orderObj, nil
+	}
+
+	return transitionOrderStatus(ctx, tx, orderObj, target, changedBy, note)
+}
+
+// Helper para responder com erros de cotação de frete
+func shippingErrorResponse(c fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, errShippingAddressNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Endereço não encontrado",
+		})
+	case errors.Is(err, shipping.ErrInvalidCEP):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "CEP inválido",
+		})
+	case errors.Is(err, shipping.ErrEstadoMismatch):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "A UF informada não corresponde ao CEP",
+		})
+	case errors.Is(err, errShippingServiceUnavailable):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"message": "Modalidade de frete indisponível para o destino informado",
+		})
+	case errors.Is(err, shipping.ErrNoRatesAvailable):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"message": "Não há opções de frete para o destino informado",
+		})
+	}
+
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"message": "Erro ao calcular frete",
+		"error":   err.Error(),
+	})
+}
@@ -0,0 +1,269 @@
+package controllers
+
+import (
+	"context"
+	"strings"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/category"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+	"github.com/vtrod/veecomm-api/slug"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// GetProductBySlug retorna um produto pelo slug. Slugs antigos, de antes de o produto
+// ser renomeado, respondem 301 apontando para o slug atual.
+// GET /api/products/slug/:slug
+func GetProductBySlug(c fiber.Ctx) error {
+	slugParam := strings.ToLower(c.Params("slug"))
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Buscar produto pelo slug atual
+	prod, err := client.Product.
+		Query().
+		Where(product.Slug(slugParam)).
+		WithCategory().
+		Only(ctx)
+
+	if err == nil {
+		return productResponse(c, ctx, client, prod)
+	}
+	if !ent.IsNotFound(err) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar produto",
+			"error":   err.Error(),
+		})
+	}
+
+	// Procurar o slug no histórico
+	targetId, err := slugRedirectTarget(ctx, client, slugredirect.EntityProduct, slugParam)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if targetId != "" {
+		current, err := client.Product.Get(ctx, targetId)
+		if err == nil {
+			return slugRedirectResponse(c, "/api/products/slug/", current.ID, current.Slug)
+		}
+		if !ent.IsNotFound(err) {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar produto",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"message": "Produto não encontrado",
+	})
+}
+
+// GetCategoryBySlug retorna uma categoria pelo slug. Slugs antigos, de antes de a
+// categoria ser renomeada, respondem 301 apontando para o slug atual.
+// GET /api/categories/slug/:slug
+func GetCategoryBySlug(c fiber.Ctx) error {
+	slugParam := strings.ToLower(c.Params("slug"))
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Buscar categoria pelo slug atual
+	cat, err := client.Category.
+		Query().
+		Where(category.Slug(slugParam)).
+		Only(ctx)
+
+	if err == nil {
+		return categoryResponse(c, ctx, client, cat)
+	}
+	if !ent.IsNotFound(err) {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	// Procurar o slug no histórico
+	targetId, err := slugRedirectTarget(ctx, client, slugredirect.EntityCategory, slugParam)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar categoria",
+			"error":   err.Error(),
+		})
+	}
+
+	if targetId != "" {
+		current, err := client.Category.Get(ctx, targetId)
+		if err == nil {
+			return slugRedirectResponse(c, "/api/categories/slug/", current.ID, current.Slug)
+		}
+		if !ent.IsNotFound(err) {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar categoria",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"message": "Categoria não encontrada",
+	})
+}
+
+// Quantas vezes a gravação é refeita com outro sufixo quando o banco recusa o slug
+const slugSaveAttempts = 5
+
+// Helper que grava um registro com um slug livre gerado a partir do nome. A verificação
+// de uniqueSlug não impede que outra requisição grave o mesmo slug antes desta; nesse
+// caso a restrição de unicidade recusa a gravação, que é refeita com o próximo sufixo.
+// Retorna o slug gravado.
+func saveWithUniqueSlug(ctx context.Context, client *ent.Client, entity slugredirect.Entity, id, name string, save func(slug string) error) (string, error) {
+	attempt := 1
+	for try := 1; ; try++ {
+		candidate, used, err := uniqueSlug(ctx, client, entity, id, name, attempt)
+		if err != nil {
+			return "", err
+		}
+
+		err = save(candidate)
+		if err == nil || !ent.IsConstraintError(err) || try == slugSaveAttempts {
+			return candidate, err
+		}
+		attempt = used + 1
+	}
+}
+
+// Helper que gera um slug livre a partir do nome. Em caso de colisão com outro
+// registro, ou com um slug antigo de outro registro, acrescenta -2, -3 etc., a partir
+// da tentativa from. O id é o do próprio registro, cujos slugs atual e antigos podem ser
+// reaproveitados. Retorna o slug e a tentativa que o gerou.
+func uniqueSlug(ctx context.Context, client *ent.Client, entity slugredirect.Entity, id, name string, from int) (string, int, error) {
+	base := slug.Make(name)
+	if base == "" {
+		base = map[slugredirect.Entity]string{
+			slugredirect.EntityProduct:  "produto",
+			slugredirect.EntityCategory: "categoria",
+		}[entity]
+	}
+
+	for attempt := from; ; attempt++ {
+		candidate := slug.WithSuffix(base, attempt)
+
+		var taken bool
+		var err error
+		switch entity {
+		case slugredirect.EntityProduct:
+			taken, err = client.Product.
+				Query().
+				Where(product.Slug(candidate), product.IDNEQ(id)).
+				Exist(ctx)
+		case slugredirect.EntityCategory:
+			taken, err = client.Category.
+				Query().
+				Where(category.Slug(candidate), category.IDNEQ(id)).
+				Exist(ctx)
+		}
+		if err != nil {
+			return "", 0, err
+		}
+
+		if !taken {
+			taken, err = client.SlugRedirect.
+				Query().
+				Where(
+					slugredirect.EntityEQ(entity),
+					slugredirect.Slug(candidate),
+					slugredirect.TargetIDNEQ(id),
+				).
+				Exist(ctx)
+
+			if err != nil {
+				return "", 0, err
+			}
+		}
+
+		if !taken {
+			return candidate, attempt, nil
+		}
+	}
+}
+
+// Helper que registra no histórico a troca de slug de um registro: o slug antigo passa
+// a apontar para ele, e o novo deixa de constar do histórico caso o registro volte a
+// usar um slug anterior
+func recordSlugChange(ctx context.Context, client *ent.Client, entity slugredirect.Entity, id, oldSlug, newSlug string) error {
+	if oldSlug == "" || oldSlug == newSlug {
+		return nil
+	}
+
+	_, err := client.SlugRedirect.
+		Delete().
+		Where(
+			slugredirect.EntityEQ(entity),
+			slugredirect.SlugIn(oldSlug, newSlug),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	return client.SlugRedirect.
+		Create().
+		SetID(uuid.New().String()).
+		SetEntity(entity).
+		SetSlug(oldSlug).
+		SetTargetID(id).
+		Exec(ctx)
+}
+
+// Helper que remove do histórico os slugs antigos de um registro excluído
+func deleteSlugRedirects(ctx context.Context, client *ent.Client, entity slugredirect.Entity, id string) error {
+	_, err := client.SlugRedirect.
+		Delete().
+		Where(
+			slugredirect.EntityEQ(entity),
+			slugredirect.TargetID(id),
+		).
+		Exec(ctx)
+
+	return err
+}
+
+// Helper que busca no histórico o registro que usava o slug. Retorna vazio se o slug
+// nunca foi usado.
+func slugRedirectTarget(ctx context.Context, client *ent.Client, entity slugredirect.Entity, oldSlug string) (string, error) {
+	redirect, err := client.SlugRedirect.
+		Query().
+		Where(
+			slugredirect.EntityEQ(entity),
+			slugredirect.Slug(oldSlug),
+		).
+		Only(ctx)
+
+	if ent.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return redirect.TargetID, nil
+}
+
+// Helper que responde 301 apontando para o endereço com o slug atual
+func slugRedirectResponse(c fiber.Ctx, prefix, id, currentSlug string) error {
+	location := prefix + currentSlug
+	c.Set(fiber.HeaderLocation, location)
+	return c.Status(fiber.StatusMovedPermanently).JSON(fiber.Map{
+		"message":  "O endereço mudou",
+		"id":       id,
+		"slug":     currentSlug,
+		"location": location,
+	})
+}
@@ -0,0 +1,478 @@
+package controllers
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"log"
+	"strings"
+	"time"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/loginguard"
+	"github.com/vtrod/veecomm-api/middleware"
+	"github.com/vtrod/veecomm-api/totp"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/gofiber/fiber/v3"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Configuração da autenticação em dois fatores
+const (
+	totpIssuer        = "VeeComm"
+	totpSkew          = 1 // passos de 30s de tolerância para relógios dessincronizados
+	recoveryCodeCount = 10
+)
+
+// TwoFactorLoginRequest contém o segundo passo do login
+type TwoFactorLoginRequest struct {
+	ChallengeToken string `json:"challengeToken"`
+	Code           string `json:"code"`
+	RecoveryCode   string `json:"recoveryCode"`
+	Device         string `json:"device"`
+}
+
+// TwoFactorCodeRequest contém um código gerado pelo aplicativo autenticador
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+// TwoFactorDisableRequest contém as confirmações para desativar a 2FA
+type TwoFactorDisableRequest struct {
+	Password     string `json:"password"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recoveryCode"`
+}
+
+// VerifyTwoFactorLogin conclui o login de uma conta com 2FA e emite os tokens
+// POST /api/auth/2fa
+func VerifyTwoFactorLogin(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	guard := c.Locals("loginGuard").(*loginguard.Guard)
+	ctx := context.Background()
+
+	var req TwoFactorLoginRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	if req.ChallengeToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Token do desafio e código são obrigatórios",
+		})
+	}
+
+	userId, err := authConfig.ParseChallengeToken(req.ChallengeToken)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Desafio de login inválido ou expirado; faça login novamente",
+		})
+	}
+
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"message": "Desafio de login inválido ou expirado; faça login novamente",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if u.TotpEnabledAt == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Autenticação em dois fatores não está ativa para esta conta",
+		})
+	}
+
+	// Os códigos contam para os mesmos limites de tentativas da senha
+	if handled, err := guardLoginAttempt(c, client, guard, u.Email, u.ID); handled {
+		return err
+	}
+
+	ok, err := verifySecondFactor(ctx, client, u, req.Code, req.RecoveryCode)
+	if err != nil {
+		releaseLoginAttempt(guard, u.Email, c.IP())
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar código",
+			"error":   err.Error(),
+		})
+	}
+	if !ok {
+		recordLoginFailure(c, client, guard, u.Email, u.ID, loginattempt.ReasonInvalidTwoFactor)
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Código inválido",
+		})
+	}
+
+	if err := guard.Succeed(ctx, u.Email, c.IP()); err != nil {
+		log.Printf("Erro ao zerar tentativas de login de %s: %v", u.Email, err)
+	}
+
+	return authResponse(c, fiber.StatusOK, client, authConfig, u, req.Device)
+}
+
+// SetupTwoFactor gera um novo segredo TOTP e códigos de recuperação para o usuário
+// POST /api/users/2fa/setup
+func SetupTwoFactor(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if u.TotpEnabledAt != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Autenticação em dois fatores já está ativa",
+		})
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao gerar segredo",
+			"error":   err.Error(),
+		})
+	}
+
+	recoveryCodes, recoveryHashes, err := newRecoveryCodes()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao gerar códigos de recuperação",
+			"error":   err.Error(),
+		})
+	}
+
+	// O segredo fica pendente até a confirmação do primeiro código
+	_, err = client.User.
+		UpdateOne(u).
+		SetTotpSecret(secret).
+		SetTotpLastStep(0).
+		SetRecoveryCodes(recoveryHashes).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar segredo",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":       "Cadastre o segredo no aplicativo autenticador e confirme com um código",
+		"secret":        secret,
+		"otpauthUri":    totp.URI(totpIssuer, u.Email, secret),
+		"recoveryCodes": recoveryCodes,
+	})
+}
+
+// EnableTwoFactor ativa a 2FA após a confirmação de um código do novo segredo
+// POST /api/users/2fa/enable
+func EnableTwoFactor(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	var req TwoFactorCodeRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if u.TotpEnabledAt != nil {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Autenticação em dois fatores já está ativa",
+		})
+	}
+	if u.TotpSecret == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Gere um segredo em /api/users/2fa/setup antes de ativar",
+		})
+	}
+
+	ok, err := verifyTOTP(ctx, client, u, req.Code)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar código",
+			"error":   err.Error(),
+		})
+	}
+	if !ok {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Código inválido",
+		})
+	}
+
+	updatedUser, err := client.User.
+		UpdateOneID(userId).
+		SetTotpEnabledAt(time.Now()).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao ativar autenticação em dois fatores",
+			"error":   err.Error(),
+		})
+	}
+
+	// Sessões abertas só com a senha deixam de valer
+	currentID, _ := c.Locals("sessionId").(string)
+	if _, err := revokeUserSessions(ctx, client, userId, currentID); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "2FA ativada, mas houve erro ao encerrar as outras sessões",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Autenticação em dois fatores ativada com sucesso",
+		"user":    newUserResponse(updatedUser),
+	})
+}
+
+// DisableTwoFactor desativa a 2FA do usuário autenticado
+// DELETE /api/users/2fa
+func DisableTwoFactor(c fiber.Ctx) error {
+	client := c.Locals("dbClient").(*ent.Client)
+	authConfig := c.Locals("authConfig").(middleware.Config)
+	ctx := context.Background()
+
+	// Obter usuário do contexto de autenticação
+	userId := getUserIdFromContext(c)
+	if userId == "" {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Usuário não autenticado",
+		})
+	}
+
+	var req TwoFactorDisableRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Erro ao processar requisição",
+			"error":   err.Error(),
+		})
+	}
+
+	u, err := client.User.Get(ctx, userId)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
+
+	if u.TotpEnabledAt == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Autenticação em dois fatores não está ativa",
+		})
+	}
+
+	// A política pode tornar a 2FA obrigatória para quem tem permissões administrativas
+	if authConfig.RequireAdminTwoFactor && len(middleware.EffectivePermissions(u)) > 0 {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Autenticação em dois fatores é obrigatória para contas com permissões administrativas",
+		})
+	}
+
+	// Exigir a senha e um segundo fator
+	if err := bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(req.Password)); err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Senha incorreta",
+		})
+	}
+
+	ok, err := verifySecondFactor(ctx, client, u, req.Code, req.RecoveryCode)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar código",
+			"error":   err.Error(),
+		})
+	}
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Código inválido",
+		})
+	}
+
+	updatedUser, err := client.User.
+		UpdateOneID(userId).
+		ClearTotpSecret().
+		ClearTotpEnabledAt().
+		ClearRecoveryCodes().
+		SetTotpLastStep(0).
+		Save(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao desativar autenticação em dois fatores",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Autenticação em dois fatores desativada",
+		"user":    newUserResponse(updatedUser),
+	})
+}
+
+// Helper que responde ao primeiro passo do login de contas com 2FA
+func twoFactorChallengeResponse(c fiber.Ctx, authConfig middleware.Config, u *ent.User) error {
+	challengeToken, expiresAt, err := authConfig.GenerateChallengeToken(u.ID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao gerar desafio de login",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":           "Informe o código do aplicativo autenticador",
+		"twoFactorRequired": true,
+		"challengeToken":    challengeToken,
+		"expiresAt":         expiresAt,
+	})
+}
+
+// Helper que aceita um código TOTP ou, na falta dele, um código de recuperação
+func verifySecondFactor(ctx context.Context, client *ent.Client, u *ent.User, code, recoveryCode string) (bool, error) {
+	if code != "" {
+		return verifyTOTP(ctx, client, u, code)
+	}
+	if recoveryCode != "" {
+		return consumeRecoveryCode(ctx, client, u, recoveryCode)
+	}
+	return false, nil
+}
+
+// Helper que valida o código TOTP e registra o passo usado. A atualização é condicional,
+// então o mesmo código não é aceito duas vezes nem em requisições simultâneas.
+func verifyTOTP(ctx context.Context, client *ent.Client, u *ent.User, code string) (bool, error) {
+	step, ok := totp.Validate(u.TotpSecret, code, time.Now(), totpSkew, u.TotpLastStep)
+	if !ok {
+		return false, nil
+	}
+
+	updated, err := client.User.
+		Update().
+		Where(
+			user.ID(u.ID),
+			user.TotpLastStepLT(step),
+		).
+		SetTotpLastStep(step).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return updated > 0, nil
+}
+
+// Helper que consome um código de recuperação, removendo-o da lista do usuário. A
+// atualização só é aplicada se a lista ainda for a que foi lida (o código continua lá
+// e nenhum outro foi removido), então o mesmo código não é aceito duas vezes em
+// logins simultâneos. Se outro código foi usado nesse meio tempo, a lista é relida.
+func consumeRecoveryCode(ctx context.Context, client *ent.Client, u *ent.User, code string) (bool, error) {
+	hash := hashToken(normalizeRecoveryCode(code))
+	codes := u.RecoveryCodes
+
+	for attempt := 0; attempt < 3; attempt++ {
+		remaining := make([]string, 0, len(codes))
+		for _, h := range codes {
+			if h != hash {
+				remaining = append(remaining, h)
+			}
+		}
+		if len(remaining) == len(codes) {
+			return false, nil
+		}
+
+		updated, err := client.User.
+			Update().
+			Where(
+				user.ID(u.ID),
+				func(s *sql.Selector) {
+					s.Where(sqljson.ValueContains(user.FieldRecoveryCodes, hash))
+					s.Where(sqljson.LenEQ(user.FieldRecoveryCodes, len(codes)))
+				},
+			).
+			SetRecoveryCodes(remaining).
+			Save(ctx)
+		if err != nil {
+			return false, err
+		}
+		if updated == 1 {
+			return true, nil
+		}
+
+		// A lista mudou: reler e tentar de novo
+		current, err := client.User.Get(ctx, u.ID)
+		if err != nil {
+			return false, err
+		}
+		codes = current.RecoveryCodes
+	}
+	return false, nil
+}
+
+// Helper que gera os códigos de recuperação e seus hashes
+func newRecoveryCodes() ([]string, []string, error) {
+	encoding := base32.StdEncoding.WithPadding(base32.NoPadding)
+
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		buf := make([]byte, 5)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(encoding.EncodeToString(buf))
+		codes = append(codes, raw[:4]+"-"+raw[4:])
+		hashes = append(hashes, hashToken(raw))
+	}
+	return codes, hashes, nil
+}
+
+// Helper para normalizar códigos de recuperação digitados pelo usuário
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(strings.TrimSpace(code))
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
@@ -2,442 +2,301 @@ package controllers
 
 import (
 	"context"
-	"os"
-	"strconv"
-	"time"
-	"github.com/vtrod/veecomm-api/database"
 	"github.com/vtrod/veecomm-api/ent"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/middleware"
 
 	"github.com/gofiber/fiber/v3"
-	"github.com/golang-jwt/jwt/v5"
-	"golang.org/x/crypto/bcrypt"
 )
 
-// LoginRequest contém as credenciais para autenticação
-type LoginRequest struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// Estrutura para alterar o papel de um usuário
+type RoleUpdateRequest struct {
+	Role string `json:"role"`
 }
 
-// RegisterRequest contém os dados para criação de usuário
-type RegisterRequest struct {
-	Name     string `json:"name"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
+// Estrutura para conceder uma permissão a um usuário
+type PermissionRequest struct {
+	Permission string `json:"permission"`
 }
 
-// UserResponse representa os dados de usuário retornados ao cliente
-type UserResponse struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	IsAdmin   bool      `json:"isAdmin"`
-	CreatedAt time.Time `json:"createdAt"`
-}
-
-// ProfileUpdateRequest contém os dados para atualização de perfil
-type ProfileUpdateRequest struct {
-	Name            string `json:"name"`
-	CurrentPassword string `json:"currentPassword"`
-	NewPassword     string `json:"newPassword"`
-}
+// UpdateUserRole altera o papel de um usuário (admin)
+// PUT /api/admin/users/:id/role
+func UpdateUserRole(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
 
-// GetAllUsers retorna todos os usuários
-func GetAllUsers(c fiber.Ctx) error {
-	client, err := database.NewClient()
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao conectar ao banco de dados",
+	// Papéis só são atribuídos por administradores, e nunca à própria conta
+	if role, _ := c.Locals("role").(string); role != string(user.RoleAdmin) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Apenas administradores podem alterar papéis",
 		})
 	}
-	defer client.Close()
-
-	users, err := client.User.Query().All(context.Background())
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao buscar usuários",
+	if isOwnAccount(c, id) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Não é possível alterar o papel da própria conta",
 		})
 	}
 
-	return c.JSON(users)
-}
+	var req RoleUpdateRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
 
-// GetUser retorna um usuário pelo ID
-func GetUser(c fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
+	// Validar papel
+	role := user.Role(req.Role)
+	if err := user.RoleValidator(role); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "ID inválido",
+			"message":     "Papel inválido",
+			"valid_roles": []user.Role{user.RoleCustomer, user.RoleAdmin},
 		})
 	}
 
-	client, err := database.NewClient()
+	tx, err := client.Tx(ctx)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao conectar ao banco de dados",
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
 		})
 	}
-	defer client.Close()
 
-	user, err := client.User.Get(context.Background(), id)
+	// Buscar usuário
+	u, err := tx.User.Get(ctx, id)
 	if err != nil {
+		tx.Rollback()
 		if ent.IsNotFound(err) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"error": "Usuário não encontrado",
+				"message": "Usuário não encontrado",
 			})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao buscar usuário",
-		})
-	}
-
-	return c.JSON(user)
-}
-
-// LoginUser autentica um usuário e retorna um token JWT
-func LoginUser(c fiber.Ctx) error {
-	var loginReq LoginRequest
-	if err := c.BodyParser(&loginReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Erro ao processar requisição",
+			"message": "Erro ao buscar usuário",
 			"error":   err.Error(),
 		})
 	}
 
-	// Validar dados
-	if loginReq.Email == "" || loginReq.Password == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Email e senha são obrigatórios",
-		})
-	}
+	// Impedir que a loja fique sem nenhum administrador. Os administradores ficam
+	// bloqueados até o commit, para que dois rebaixamentos simultâneos não removam
+	// os dois últimos.
+	if u.Role == user.RoleAdmin && role != user.RoleAdmin {
+		adminIDs, err := tx.User.
+			Query().
+			Where(user.RoleEQ(user.RoleAdmin), forUpdate).
+			IDs(ctx)
 
-	// Buscar usuário pelo email
-	client := c.Locals("dbClient").(*ent.Client)
-	ctx := context.Background()
-	
-	u, err := client.User.Query().
-		Where(user.Email(loginReq.Email)).
-		First(ctx)
-	if err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Credenciais inválidas",
-		})
-	}
+		if err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao contar administradores",
+				"error":   err.Error(),
+			})
+		}
 
-	// Verificar senha
-	if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(loginReq.Password)); err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Credenciais inválidas",
-		})
+		if len(adminIDs) <= 1 {
+			tx.Rollback()
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "Não é possível remover o último administrador",
+			})
+		}
 	}
 
-	// Gerar token JWT
-	token := jwt.New(jwt.SigningMethodHS256)
-	claims := token.Claims.(jwt.MapClaims)
-	claims["userId"] = u.ID
-	claims["email"] = u.Email
-	claims["isAdmin"] = u.IsAdmin
-	claims["exp"] = time.Now().Add(24 * time.Hour).Unix() // Expira em 24h
+	// Atualizar somente se o papel não mudou desde a leitura
+	affected, err := tx.User.
+		Update().
+		Where(user.ID(id), user.RoleEQ(u.Role)).
+		SetRole(role).
+		Save(ctx)
 
-	// Assinar token
-	tokenString, err := token.SignedString([]byte(os.Getenv("JWT_SECRET")))
 	if err != nil {
+		tx.Rollback()
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao gerar token",
+			"message": "Erro ao atualizar papel do usuário",
 			"error":   err.Error(),
 		})
 	}
 
-	// Retornar token e dados do usuário
-	return c.JSON(fiber.Map{
-		"token": tokenString,
-		"user": UserResponse{
-			ID:        u.ID,
-			Name:      u.Name,
-			Email:     u.Email,
-			IsAdmin:   u.IsAdmin,
-			CreatedAt: u.CreatedAt,
-		},
-	})
-}
-
-// RegisterUser cria um novo usuário
-func RegisterUser(c fiber.Ctx) error {
-	var registerReq RegisterRequest
-	if err := c.BodyParser(&registerReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Erro ao processar requisição",
-			"error":   err.Error(),
-		})
-	}
-
-	// Validar dados
-	if registerReq.Email == "" || registerReq.Password == "" || registerReq.Name == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Nome, email e senha são obrigatórios",
-		})
-	}
-
-	// Verificar se email já existe
-	client := c.Locals("dbClient").(*ent.Client)
-	ctx := context.Background()
-	
-	exists, err := client.User.Query().
-		Where(user.Email(registerReq.Email)).
-		Exist(ctx)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao verificar email",
-			"error":   err.Error(),
-		})
-	}
-	if exists {
+	if affected == 0 {
+		tx.Rollback()
 		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
-			"message": "Email já está em uso",
+			"message": "O papel do usuário foi alterado por outra requisição; tente novamente",
 		})
 	}
 
-	// Hash da senha
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(registerReq.Password), bcrypt.DefaultCost)
+	updatedUser, err := tx.User.Get(ctx, id)
 	if err != nil {
+		tx.Rollback()
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao processar senha",
+			"message": "Erro ao buscar usuário",
 			"error":   err.Error(),
 		})
 	}
 
-	// Criar usuário
-	u, err := client.User.Create().
-		SetName(registerReq.Name).
-		SetEmail(registerReq.Email).
-		SetPasswordHash(string(hashedPassword)).
-		SetIsAdmin(false).
-		Save(ctx)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao criar usuário",
-			"error":   err.Error(),
-		})
-	}
-
-	// Gerar token JWT
-	token := jwt.New(jwt.SigningMethodHS256)
-	claims := token.Claims.(jwt.MapClaims)
-	claims["userId"] = u.ID
-	claims["email"] = u.Email
-	claims["isAdmin"] = u.IsAdmin
-	claims["exp"] = time.Now().Add(24 * time.Hour).Unix() // Expira em 24h
-
-	// Assinar token
-	tokenString, err := token.SignedString([]byte(os.Getenv("JWT_SECRET")))
-	if err != nil {
+	if err := tx.Commit(); err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao gerar token",
+			"message": "Erro ao confirmar alteração do papel",
 			"error":   err.Error(),
 		})
 	}
 
-	// Retornar token e dados do usuário
-	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
-		"token": tokenString,
-		"user": UserResponse{
-			ID:        u.ID,
-			Name:      u.Name,
-			Email:     u.Email,
-			IsAdmin:   u.IsAdmin,
-			CreatedAt: u.CreatedAt,
-		},
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Papel do usuário atualizado com sucesso",
+		"user":    newUserResponse(updatedUser),
 	})
 }
 
-// GetUserProfile retorna o perfil do usuário autenticado
-func GetUserProfile(c fiber.Ctx) error {
-	// Obter ID do usuário a partir do middleware de autenticação
-	userId, ok := c.Locals("userId").(int)
-	if !ok {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Não autenticado",
-		})
-	}
-
-	// Buscar usuário no banco
+// GrantUserPermission concede uma permissão a um usuário (admin)
+// POST /api/admin/users/:id/permissions
+func GrantUserPermission(c fiber.Ctx) error {
+	id := c.Params("id")
 	client := c.Locals("dbClient").(*ent.Client)
 	ctx := context.Background()
-	
-	u, err := client.User.Get(ctx, userId)
-	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"message": "Usuário não encontrado",
-			"error":   err.Error(),
-		})
-	}
 
-	// Retornar dados do usuário (sem senha)
-	return c.JSON(UserResponse{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		IsAdmin:   u.IsAdmin,
-		CreatedAt: u.CreatedAt,
-	})
-}
-
-// UpdateUserProfile atualiza o perfil do usuário autenticado
-func UpdateUserProfile(c fiber.Ctx) error {
-	var updateReq ProfileUpdateRequest
-	if err := c.BodyParser(&updateReq); err != nil {
+	var req PermissionRequest
+	if err := c.Bind().Body(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"message": "Erro ao processar requisição",
+			"message": "Dados inválidos",
 			"error":   err.Error(),
 		})
 	}
 
-	// Obter ID do usuário a partir do middleware de autenticação
-	userId, ok := c.Locals("userId").(int)
-	if !ok {
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"message": "Não autenticado",
+	// Validar permissão
+	if !middleware.IsValidPermission(req.Permission) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message":           "Permissão inválida",
+			"valid_permissions": middleware.AllPermissions,
 		})
 	}
 
-	// Buscar usuário no banco
-	client := c.Locals("dbClient").(*ent.Client)
-	ctx := context.Background()
-	
-	u, err := client.User.Get(ctx, userId)
-	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"message": "Usuário não encontrado",
-			"error":   err.Error(),
+	// Só é possível conceder permissões que o próprio usuário autenticado possui,
+	// e nunca à própria conta
+	if !middleware.HasPermission(c, req.Permission) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message":    "Não é possível conceder uma permissão que você não possui",
+			"permission": req.Permission,
 		})
 	}
-
-	// Iniciar construção da atualização
-	update := client.User.UpdateOneID(userId)
-	
-	// Atualizar nome se fornecido
-	if updateReq.Name != "" {
-		update = update.SetName(updateReq.Name)
+	if isOwnAccount(c, id) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Não é possível alterar as permissões da própria conta",
+		})
 	}
 
-	// Atualizar senha se fornecida
-	if updateReq.CurrentPassword != "" && updateReq.NewPassword != "" {
-		// Verificar senha atual
-		if err := bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(updateReq.CurrentPassword)); err != nil {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"message": "Senha atual incorreta",
+	// Buscar usuário
+	u, err := client.User.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Usuário não encontrado",
 			})
 		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
+		})
+	}
 
-		// Gerar hash da nova senha
-		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(updateReq.NewPassword), bcrypt.DefaultCost)
-		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-				"message": "Erro ao processar nova senha",
-				"error":   err.Error(),
+	// Conceder somente se ainda não estiver na lista do usuário
+	for _, p := range u.Permissions {
+		if p == req.Permission {
+			return c.Status(fiber.StatusOK).JSON(fiber.Map{
+				"message": "Usuário já possui esta permissão",
+				"user":    newUserResponse(u),
 			})
 		}
-
-		update = update.SetPasswordHash(string(hashedPassword))
 	}
 
-	// Executar atualização
-	updatedUser, err := update.Save(ctx)
+	updatedUser, err := client.User.
+		UpdateOne(u).
+		AppendPermissions([]string{req.Permission}).
+		Save(ctx)
+
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"message": "Erro ao atualizar perfil",
+			"message": "Erro ao conceder permissão",
 			"error":   err.Error(),
 		})
 	}
 
-	// Retornar dados atualizados
-	return c.JSON(UserResponse{
-		ID:        updatedUser.ID,
-		Name:      updatedUser.Name,
-		Email:     updatedUser.Email,
-		IsAdmin:   updatedUser.IsAdmin,
-		CreatedAt: updatedUser.CreatedAt,
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Permissão concedida com sucesso",
+		"user":    newUserResponse(updatedUser),
 	})
 }
 
-// UpdateUser atualiza um usuário existente
-func UpdateUser(c fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "ID inválido",
-		})
-	}
-
-	type userRequest struct {
-		Nome  string `json:"nome"`
-		Email string `json:"email"`
-		Senha string `json:"senha"`
-	}
-
-	var input userRequest
-	if err := c.Bind().Body(&input); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Dados inválidos",
-		})
-	}
+// RevokeUserPermission revoga uma permissão concedida a um usuário (admin)
+// DELETE /api/admin/users/:id/permissions/:permission
+func RevokeUserPermission(c fiber.Ctx) error {
+	id := c.Params("id")
+	permission := c.Params("permission")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
 
-	client, err := database.NewClient()
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao conectar ao banco de dados",
+	if isOwnAccount(c, id) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"message": "Não é possível alterar as permissões da própria conta",
 		})
 	}
-	defer client.Close()
 
-	user, err := client.User.UpdateOneID(id).
-		SetNome(input.Nome).
-		SetEmail(input.Email).
-		SetSenha(input.Senha).
-		Save(context.Background())
+	// Buscar usuário
+	u, err := client.User.Get(ctx, id)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"error": "Usuário não encontrado",
+				"message": "Usuário não encontrado",
 			})
 		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao atualizar usuário",
+			"message": "Erro ao buscar usuário",
+			"error":   err.Error(),
 		})
 	}
 
-	return c.JSON(user)
-}
-
-// DeleteUser remove um usuário
-func DeleteUser(c fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "ID inválido",
-		})
+	// Remover a permissão da lista individual do usuário
+	remaining := make([]string, 0, len(u.Permissions))
+	for _, p := range u.Permissions {
+		if p != permission {
+			remaining = append(remaining, p)
+		}
 	}
 
-	client, err := database.NewClient()
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao conectar ao banco de dados",
+	if len(remaining) == len(u.Permissions) {
+		// Permissões herdadas do papel só podem ser removidas trocando o papel
+		for _, p := range middleware.EffectivePermissions(u) {
+			if p == permission {
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+					"message": "Permissão concedida pelo papel do usuário; altere o papel para revogá-la",
+					"role":    u.Role,
+				})
+			}
+		}
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Usuário não possui esta permissão",
 		})
 	}
-	defer client.Close()
 
-	err = client.User.DeleteOneID(id).Exec(context.Background())
+	updatedUser, err := client.User.
+		UpdateOne(u).
+		SetPermissions(remaining).
+		Save(ctx)
+
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-				"error": "Usuário não encontrado",
-			})
-		}
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": "Erro ao remover usuário",
+			"message": "Erro ao revogar permissão",
+			"error":   err.Error(),
 		})
 	}
 
-	return c.SendStatus(fiber.StatusNoContent)
-} 
\ No newline at end of file
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Permissão revogada com sucesso",
+		"user":    newUserResponse(updatedUser),
+	})
+}
+
+// Helper que indica se o usuário alvo é o próprio usuário autenticado
+func isOwnAccount(c fiber.Ctx, id string) bool {
+	userId, _ := c.Locals("userId").(string)
+	return userId != "" && userId == id
+}
@@ -0,0 +1,742 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/inventory"
+	"github.com/vtrod/veecomm-api/money"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+)
+
+// Erros da escolha de variantes no carrinho
+var (
+	errVariantRequired = errors.New("escolha uma variante do produto")
+	errVariantNotFound = errors.New("variante não encontrada")
+)
+
+// Estrutura de uma opção do produto (eixo de variação)
+type ProductOptionRequest struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// Estrutura para criar/atualizar variante. Preço zero remove o preço próprio da
+// variante, que volta a usar o do produto.
+type ProductVariantRequest struct {
+	SKU     string            `json:"sku"`
+	Options map[string]string `json:"options"`
+	Price   *money.Amount     `json:"price,omitempty"`
+	Stock   *int              `json:"stock,omitempty"`
+	Images  []string          `json:"images,omitempty"`
+}
+
+// VariantOptionValue indica se um valor da opção tem alguma variante em estoque
+type VariantOptionValue struct {
+	Value     string `json:"value"`
+	Available bool   `json:"available"`
+}
+
+// VariantOption é um eixo de variação com a disponibilidade de cada valor
+type VariantOption struct {
+	Name   string               `json:"name"`
+	Values []VariantOptionValue `json:"values"`
+}
+
+// VariantView é uma variante com o preço de venda já resolvido
+type VariantView struct {
+	ID        string            `json:"id"`
+	SKU       string            `json:"sku"`
+	Options   map[string]string `json:"options"`
+	Price     money.Amount      `json:"price"`
+	Stock     int               `json:"stock"`
+	Available bool              `json:"available"`
+	Images    []string          `json:"images"`
+}
+
+// SetProductOptions substitui as opções (eixos de variação) de um produto. As
+// variantes existentes precisam continuar válidas com as novas opções.
+// PUT /api/products/:id/options
+func SetProductOptions(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req []ProductOptionRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	if message := normalizeProductOptions(req); message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": message,
+		})
+	}
+
+	// Verificar se o produto existe
+	exists, err := client.Product.Query().Where(product.ID(id)).Exist(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar produto",
+			"error":   err.Error(),
+		})
+	}
+	if !exists {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Produto não encontrado",
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	_, err = tx.ProductOption.
+		Delete().
+		Where(productoption.ProductID(id)).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao remover opções do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	options := make([]*ent.ProductOption, 0, len(req))
+	for i, opt := range req {
+		created, err := tx.ProductOption.
+			Create().
+			SetID(uuid.New().String()).
+			SetProductID(id).
+			SetName(opt.Name).
+			SetValues(opt.Values).
+			SetPosition(i).
+			Save(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao criar opção do produto",
+				"error":   err.Error(),
+			})
+		}
+		options = append(options, created)
+	}
+
+	// As variantes existentes precisam ter um valor válido para cada nova opção
+	variants, err := tx.ProductVariant.
+		Query().
+		Where(productvariant.ProductID(id)).
+		All(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar variantes do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	for _, variant := range variants {
+		normalized, combination, message := normalizeVariantOptions(options, variant.Options)
+		if message != "" {
+			tx.Rollback()
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "A variante " + variant.Sku + " não é compatível com as novas opções: " + message,
+			})
+		}
+
+		err := tx.ProductVariant.
+			UpdateOne(variant).
+			SetOptions(normalized).
+			SetCombination(combination).
+			Exec(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			if ent.IsConstraintError(err) {
+				return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+					"message": "As novas opções deixariam duas variantes com a mesma combinação",
+				})
+			}
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao atualizar variante",
+				"error":   err.Error(),
+			})
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar opções do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Opções do produto atualizadas com sucesso",
+		"options": options,
+	})
+}
+
+// CreateProductVariant cria uma variante com uma combinação de valores das opções
+// POST /api/products/:id/variants
+func CreateProductVariant(c fiber.Ctx) error {
+	id := c.Params("id")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req ProductVariantRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	req.SKU = strings.TrimSpace(req.SKU)
+	if req.SKU == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "SKU da variante é obrigatório",
+		})
+	}
+	if message := validateVariantRequest(req); message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": message,
+		})
+	}
+
+	options, err := client.ProductOption.
+		Query().
+		Where(productoption.ProductID(id)).
+		Order(productoption.ByPosition()).
+		All(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar opções do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if len(options) == 0 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Defina as opções do produto antes de criar variantes",
+		})
+	}
+
+	normalized, combination, message := normalizeVariantOptions(options, req.Options)
+	if message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": message,
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	position, err := tx.ProductVariant.
+		Query().
+		Where(productvariant.ProductID(id)).
+		Count(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao contar variantes do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	create := tx.ProductVariant.
+		Create().
+		SetID(uuid.New().String()).
+		SetProductID(id).
+		SetSku(req.SKU).
+		SetOptions(normalized).
+		SetCombination(combination).
+		SetPosition(position)
+	if req.Price != nil && *req.Price > 0 {
+		create = create.SetPrice(*req.Price)
+	}
+	if req.Stock != nil {
+		create = create.SetStock(*req.Stock)
+	}
+	if req.Images != nil {
+		create = create.SetImages(req.Images)
+	}
+
+	variant, err := create.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "Já existe uma variante com este SKU ou com esta combinação",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao criar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := inventory.SyncProduct(ctx, tx.Client(), id); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar estoque do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Variante criada com sucesso",
+		"variant": variant.Unwrap(),
+	})
+}
+
+// UpdateProductVariant atualiza SKU, combinação, preço, estoque ou imagens de uma variante
+// PUT /api/products/:id/variants/:variantId
+func UpdateProductVariant(c fiber.Ctx) error {
+	id := c.Params("id")
+	variantId := c.Params("variantId")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Extrair dados do request
+	var req ProductVariantRequest
+	if err := c.Bind().Body(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": "Dados inválidos",
+			"error":   err.Error(),
+		})
+	}
+
+	if message := validateVariantRequest(req); message != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"message": message,
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	variant, err := tx.ProductVariant.
+		Query().
+		Where(
+			productvariant.ID(variantId),
+			productvariant.ProductID(id),
+		).
+		Only(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"message": "Variante não encontrada",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao buscar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	update := tx.ProductVariant.UpdateOne(variant)
+
+	// Aplicar cada campo que foi enviado
+	if sku := strings.TrimSpace(req.SKU); sku != "" {
+		update = update.SetSku(sku)
+	}
+	if req.Options != nil {
+		options, err := tx.ProductOption.
+			Query().
+			Where(productoption.ProductID(id)).
+			Order(productoption.ByPosition()).
+			All(ctx)
+
+		if err != nil {
+			tx.Rollback()
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"message": "Erro ao buscar opções do produto",
+				"error":   err.Error(),
+			})
+		}
+
+		normalized, combination, message := normalizeVariantOptions(options, req.Options)
+		if message != "" {
+			tx.Rollback()
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"message": message,
+			})
+		}
+		update = update.SetOptions(normalized).SetCombination(combination)
+	}
+	if req.Price != nil {
+		if *req.Price > 0 {
+			update = update.SetPrice(*req.Price)
+		} else {
+			update = update.ClearPrice()
+		}
+	}
+	if req.Stock != nil {
+		update = update.SetStock(*req.Stock)
+	}
+	if req.Images != nil {
+		update = update.SetImages(req.Images)
+	}
+
+	updatedVariant, err := update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"message": "Já existe uma variante com este SKU ou com esta combinação",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := inventory.SyncProduct(ctx, tx.Client(), id); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar estoque do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao salvar variante",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Variante atualizada com sucesso",
+		"variant": updatedVariant.Unwrap(),
+	})
+}
+
+// DeleteProductVariant remove uma variante que não esteja em pedidos. Itens de
+// carrinho com a variante passam a aparecer como indisponíveis.
+// DELETE /api/products/:id/variants/:variantId
+func DeleteProductVariant(c fiber.Ctx) error {
+	id := c.Params("id")
+	variantId := c.Params("variantId")
+	client := c.Locals("dbClient").(*ent.Client)
+	ctx := context.Background()
+
+	// Verificar se existem referencias à variante em pedidos
+	inOrders, err := client.OrderItem.
+		Query().
+		Where(orderitem.VariantID(variantId)).
+		Exist(ctx)
+
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao verificar relações da variante",
+			"error":   err.Error(),
+		})
+	}
+
+	if inOrders {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"message": "Não é possível excluir a variante pois ela está em pedidos; zere o estoque para deixá-la indisponível",
+		})
+	}
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao iniciar transação",
+			"error":   err.Error(),
+		})
+	}
+
+	// Desvincular dos carrinhos; os itens ficam indisponíveis até o cliente confirmar
+	_, err = tx.CartItem.
+		Update().
+		Where(cartitem.VariantID(variantId)).
+		ClearVariantID().
+		ClearProductID().
+		Save(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao remover a variante dos carrinhos",
+			"error":   err.Error(),
+		})
+	}
+
+	removed, err := tx.ProductVariant.
+		Delete().
+		Where(
+			productvariant.ID(variantId),
+			productvariant.ProductID(id),
+		).
+		Exec(ctx)
+
+	if err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir variante",
+			"error":   err.Error(),
+		})
+	}
+
+	if removed == 0 {
+		tx.Rollback()
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"message": "Variante não encontrada",
+		})
+	}
+
+	if err := inventory.SyncProduct(ctx, tx.Client(), id); err != nil {
+		tx.Rollback()
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao atualizar estoque do produto",
+			"error":   err.Error(),
+		})
+	}
+
+	if err := tx.Commit(); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"message": "Erro ao excluir variante",
+			"error":   err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Variante excluída com sucesso",
+	})
+}
+
+// Helper que valida e normaliza as opções enviadas: nomes e valores sem espaços nas
+// pontas, sem repetição (ignorando maiúsculas) e com ao menos um valor cada
+func normalizeProductOptions(options []ProductOptionRequest) string {
+	names := make(map[string]bool, len(options))
+	for i := range options {
+		opt := &options[i]
+		opt.Name = strings.TrimSpace(opt.Name)
+		if opt.Name == "" {
+			return "Nome da opção é obrigatório"
+		}
+		if names[strings.ToLower(opt.Name)] {
+			return "Opção repetida: " + opt.Name
+		}
+		names[strings.ToLower(opt.Name)] = true
+
+		values := make(map[string]bool, len(opt.Values))
+		for j, value := range opt.Values {
+			value = strings.TrimSpace(value)
+			if value == "" {
+				return "A opção " + opt.Name + " tem um valor vazio"
+			}
+			if values[strings.ToLower(value)] {
+				return "Valor repetido na opção " + opt.Name + ": " + value
+			}
+			values[strings.ToLower(value)] = true
+			opt.Values[j] = value
+		}
+		if len(opt.Values) == 0 {
+			return "A opção " + opt.Name + " precisa de ao menos um valor"
+		}
+	}
+	return ""
+}
+
+// Helper que valida os campos numéricos da variante
+func validateVariantRequest(req ProductVariantRequest) string {
+	if req.Price != nil && *req.Price < 0 {
+		return "O preço da variante não pode ser negativo"
+	}
+	if req.Stock != nil && *req.Stock < 0 {
+		return "O estoque da variante não pode ser negativo"
+	}
+	return ""
+}
+
+// Helper que confere a combinação da variante com as opções do produto: é preciso um
+// valor para cada opção, e os nomes e valores são gravados como cadastrados nas
+// opções. Retorna também a chave da combinação, ou a mensagem de erro.
+func normalizeVariantOptions(options []*ent.ProductOption, input map[string]string) (map[string]string, string, string) {
+	byName := make(map[string]string, len(input))
+	for name, value := range input {
+		byName[strings.ToLower(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+	if len(byName) != len(options) {
+		return nil, "", "Informe um valor para cada opção do produto"
+	}
+
+	normalized := make(map[string]string, len(options))
+	keys := make([]string, 0, len(options))
+	for _, opt := range options {
+		value, ok := byName[strings.ToLower(opt.Name)]
+		if !ok {
+			return nil, "", "Valor da opção " + opt.Name + " é obrigatório"
+		}
+
+		canonical := ""
+		for _, allowed := range opt.Values {
+			if strings.EqualFold(allowed, value) {
+				canonical = allowed
+				break
+			}
+		}
+		if canonical == "" {
+			return nil, "", "Valor inválido para a opção " + opt.Name + ": " + value
+		}
+
+		normalized[opt.Name] = canonical
+		keys = append(keys, strings.ToLower(opt.Name)+"="+strings.ToLower(canonical))
+	}
+
+	sort.Strings(keys)
+	return normalized, strings.Join(keys, ";"), ""
+}
+
+// Helper que busca a variante escolhida para o carrinho. Produtos com variantes exigem
+// a escolha de uma delas; produtos sem variantes não aceitam variante.
+func cartVariant(ctx context.Context, client *ent.Client, prod *ent.Product, variantId string) (*ent.ProductVariant, error) {
+	if variantId == "" {
+		hasVariants, err := client.ProductVariant.
+			Query().
+			Where(productvariant.ProductID(prod.ID)).
+			Exist(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		if hasVariants {
+			return nil, errVariantRequired
+		}
+		return nil, nil
+	}
+
+	variant, err := client.ProductVariant.
+		Query().
+		Where(
+			productvariant.ID(variantId),
+			productvariant.ProductID(prod.ID),
+		).
+		Only(ctx)
+
+	if ent.IsNotFound(err) {
+		return nil, errVariantNotFound
+	}
+	return variant, err
+}
+
+// Helper que retorna o preço de venda da variante: o preço próprio, se houver, ou o
+// preço atual do produto
+func variantPrice(prod *ent.Product, variant *ent.ProductVariant) money.Amount {
+	if variant != nil && variant.Price != nil {
+		return *variant.Price
+	}
+	return currentPrice(prod)
+}
+
+// Helper que retorna o estoque disponível da variante ou, sem variante, do produto
+func variantStock(prod *ent.Product, variant *ent.ProductVariant) int {
+	if variant != nil {
+		return variant.Stock
+	}
+	return prod.Stock
+}
+
+// Helper que retorna a primeira imagem da variante, ou a do produto se ela não tiver
+// imagens
+func variantImage(prod *ent.Product, variant *ent.ProductVariant) string {
+	if variant != nil && len(variant.Images) > 0 {
+		return variant.Images[0]
+	}
+	if len(prod.Images) > 0 {
+		return prod.Images[0]
+	}
+	return ""
+}
+
+// Helper que monta a matriz de variantes do produto: as opções com a disponibilidade
+// de cada valor e as variantes com preço e estoque
+func variantMatrix(prod *ent.Product, options []*ent.ProductOption, variants []*ent.ProductVariant) ([]VariantOption, []VariantView) {
+	// Valores com ao menos uma variante em estoque
+	inStock := make(map[string]bool)
+	views := make([]VariantView, 0, len(variants))
+	for _, variant := range variants {
+		available := variant.Stock > 0
+		if available {
+			for name, value := range variant.Options {
+				inStock[name+"\x00"+value] = true
+			}
+		}
+
+		images := variant.Images
+		if len(images) == 0 {
+			images = prod.Images
+		}
+		views = append(views, VariantView{
+			ID:        variant.ID,
+			SKU:       variant.Sku,
+			Options:   variant.Options,
+			Price:     variantPrice(prod, variant),
+			Stock:     variant.Stock,
+			Available: available,
+			Images:    images,
+		})
+	}
+
+	axes := make([]VariantOption, 0, len(options))
+	for _, opt := range options {
+		values := make([]VariantOptionValue, 0, len(opt.Values))
+		for _, value := range opt.Values {
+			values = append(values, VariantOptionValue{
+				Value:     value,
+				Available: inStock[opt.Name+"\x00"+value],
+			})
+		}
+		axes = append(axes, VariantOption{Name: opt.Name, Values: values})
+	}
+
+	return axes, views
+}
@@ -1,21 +1,31 @@
 package database
 
 import (
+	"context"
 	"log"
 	"github.com/vtrod/veecomm-api/ent"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
 	_ "github.com/go-sql-driver/mysql"
 )
 
-// NewClient cria uma nova conexão com o banco de dados
+// NewClient cria uma nova conexão com o banco de dados e converte para centavos os
+// valores monetários gravados por versões anteriores, antes da migração automática
 func NewClient() (*ent.Client, error) {
 	// Conexão com MariaDB
 	// DSN formato: [username[:password]@][protocol[(address)]]/dbname[?param=value]
 	dsn := "root:root@tcp(localhost:3306)/veecomm?parseTime=True"
-	client, err := ent.Open("mysql", dsn)
+	drv, err := entsql.Open(dialect.MySQL, dsn)
 	if err != nil {
 		log.Fatalf("Falha ao abrir conexão com MariaDB: %v", err)
 		return nil, err
 	}
+
+	if err := MigrateMoneyToCents(context.Background(), drv.DB()); err != nil {
+		drv.Close()
+		return nil, err
+	}
 	
-	return client, nil
+	return ent.NewClient(ent.Driver(drv)), nil
 } 
\ No newline at end of file
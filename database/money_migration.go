@@ -0,0 +1,142 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+)
+
+// Colunas monetárias que eram DOUBLE em reais e passaram a BIGINT em centavos
+var moneyColumns = []struct {
+	Table  string
+	Column string
+}{
+	{"carts", "subtotal"},
+	{"carts", "shipping"},
+	{"carts", "discount"},
+	{"carts", "total"},
+	{"cart_items", "price"},
+	{"cart_items", "previous_price"},
+	{"coupons", "discount_value"},
+	{"coupons", "min_purchase"},
+	{"orders", "total"},
+	{"orders", "shipping"},
+	{"orders", "discount"},
+	{"orders", "refunded_total"},
+	{"order_items", "price"},
+	{"payments", "amount"},
+	{"payments", "captured_amount"},
+	{"payments", "refunded_amount"},
+	{"products", "price"},
+	{"products", "sale_price"},
+	{"return_items", "unit_price"},
+	{"return_requests", "refund_amount"},
+}
+
+// Tabela que registra as colunas cujos valores já foram convertidos para centavos
+const moneyMigrationTable = "money_cents_migrations"
+
+// MigrateMoneyToCents converte os valores monetários gravados em reais (DOUBLE) para
+// centavos (BIGINT), arredondando para o centavo mais próximo. Deve rodar antes da
+// migração automática do ent, que mudaria o tipo da coluna sem converter os valores.
+//
+// Como o MySQL não desfaz alterações de tipo em transações, cada coluna é convertida
+// em duas etapas: os valores são multiplicados na mesma transação que registra a
+// conversão, e só depois o tipo é alterado. Se o processo parar no meio, a próxima
+// execução apenas altera o tipo, sem multiplicar de novo.
+func MigrateMoneyToCents(ctx context.Context, db *sql.DB) error {
+	return migrateMoneyToCents(ctx, db, mysqlMoneyDialect)
+}
+
+// moneyDialect reúne o SQL da conversão que depende do banco
+type moneyDialect struct {
+	// columnType retorna o tipo da coluna e se ela aceita NULL, ou sql.ErrNoRows se ela não existir
+	columnType func(ctx context.Context, db *sql.DB, table, column string) (dataType string, nullable bool, err error)
+	// insertMarker registra a coluna convertida, sem erro se ela já estiver registrada
+	insertMarker string
+	// scaleColumn multiplica os valores por 100, arredondando para o inteiro mais próximo
+	scaleColumn func(table, column string) string
+	// alterColumn muda o tipo da coluna para inteiro
+	alterColumn func(table, column string, nullable bool) string
+}
+
+var mysqlMoneyDialect = moneyDialect{
+	columnType: func(ctx context.Context, db *sql.DB, table, column string) (string, bool, error) {
+		var dataType, nullable string
+		err := db.QueryRowContext(ctx,
+			"SELECT DATA_TYPE, IS_NULLABLE FROM information_schema.COLUMNS WHERE TABLE_SCHEMA = DATABASE() AND TABLE_NAME = ? AND COLUMN_NAME = ?",
+			table, column).Scan(&dataType, &nullable)
+		return dataType, nullable == "YES", err
+	},
+	insertMarker: "INSERT IGNORE INTO " + moneyMigrationTable + " (table_name, column_name, migrated_at) VALUES (?, ?, NOW())",
+	// O valor passa por DECIMAL para que o arredondamento seja exato (metades para longe do zero)
+	scaleColumn: func(table, column string) string {
+		return fmt.Sprintf("UPDATE `%s` SET `%s` = ROUND(CAST(`%s` AS DECIMAL(20,4)) * 100) WHERE `%s` IS NOT NULL", table, column, column, column)
+	},
+	alterColumn: func(table, column string, nullable bool) string {
+		null := "NOT NULL"
+		if nullable {
+			null = "NULL"
+		}
+		return fmt.Sprintf("ALTER TABLE `%s` MODIFY `%s` BIGINT %s", table, column, null)
+	},
+}
+
+func migrateMoneyToCents(ctx context.Context, db *sql.DB, dialect moneyDialect) error {
+	_, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+moneyMigrationTable+
+		" (table_name VARCHAR(64) NOT NULL, column_name VARCHAR(64) NOT NULL, migrated_at DATETIME NOT NULL, PRIMARY KEY (table_name, column_name))")
+	if err != nil {
+		return err
+	}
+
+	for _, col := range moneyColumns {
+		// Colunas inexistentes (banco novo) ou já inteiras não precisam de conversão
+		dataType, nullable, err := dialect.columnType(ctx, db, col.Table, col.Column)
+		if err == sql.ErrNoRows {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if dataType != "double" && dataType != "float" && dataType != "decimal" {
+			continue
+		}
+
+		if err := scaleMoneyColumn(ctx, db, dialect, col.Table, col.Column); err != nil {
+			return fmt.Errorf("%s.%s: %w", col.Table, col.Column, err)
+		}
+
+		if _, err := db.ExecContext(ctx, dialect.alterColumn(col.Table, col.Column, nullable)); err != nil {
+			return fmt.Errorf("%s.%s: %w", col.Table, col.Column, err)
+		}
+		log.Printf("Coluna %s.%s convertida para centavos", col.Table, col.Column)
+	}
+	return nil
+}
+
+// scaleMoneyColumn multiplica os valores da coluna por 100, uma única vez
+func scaleMoneyColumn(ctx context.Context, db *sql.DB, dialect moneyDialect, table, column string) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, dialect.insertMarker, table, column)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	// Já registrada: os valores foram multiplicados em uma execução anterior
+	if inserted, err := result.RowsAffected(); err != nil || inserted == 0 {
+		tx.Rollback()
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, dialect.scaleColumn(table, column)); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,160 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Dialeto equivalente ao do MySQL para rodar a conversão em um SQLite em memória
+var sqliteMoneyDialect = moneyDialect{
+	columnType: func(ctx context.Context, db *sql.DB, table, column string) (string, bool, error) {
+		var dataType string
+		var notNull bool
+		err := db.QueryRowContext(ctx,
+			"SELECT type, \"notnull\" FROM pragma_table_info(?) WHERE name = ?",
+			table, column).Scan(&dataType, &notNull)
+		if strings.EqualFold(dataType, "REAL") {
+			dataType = "double"
+		}
+		return strings.ToLower(dataType), !notNull, err
+	},
+	insertMarker: "INSERT OR IGNORE INTO " + moneyMigrationTable + " (table_name, column_name, migrated_at) VALUES (?, ?, CURRENT_TIMESTAMP)",
+	scaleColumn: func(table, column string) string {
+		return fmt.Sprintf("UPDATE `%s` SET `%s` = ROUND(`%s` * 100) WHERE `%s` IS NOT NULL", table, column, column, column)
+	},
+	// O SQLite não muda o tipo de uma coluna, então ela é recriada
+	alterColumn: func(table, column string, nullable bool) string {
+		def := "BIGINT"
+		if !nullable {
+			def += " NOT NULL DEFAULT 0"
+		}
+		return fmt.Sprintf("ALTER TABLE `%[1]s` RENAME COLUMN `%[2]s` TO `%[2]s_old`; "+
+			"ALTER TABLE `%[1]s` ADD COLUMN `%[2]s` %[3]s; "+
+			"UPDATE `%[1]s` SET `%[2]s` = CAST(`%[2]s_old` AS INTEGER); "+
+			"ALTER TABLE `%[1]s` DROP COLUMN `%[2]s_old`", table, column, def)
+	},
+}
+
+func newMoneyTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared")
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { db.Close() })
+
+	// Apenas parte das tabelas: as colunas ausentes devem ser ignoradas
+	_, err = db.Exec(`
+		CREATE TABLE products (id TEXT PRIMARY KEY, price REAL NOT NULL, sale_price REAL);
+		CREATE TABLE orders (id TEXT PRIMARY KEY, total REAL NOT NULL, shipping REAL NOT NULL, discount REAL NOT NULL);
+		INSERT INTO products VALUES ('p1', 19.9, NULL), ('p2', 12.5, 9.99), ('p3', 0.01, 0);
+		INSERT INTO orders VALUES ('o1', 1234.56, 15, -3.45);
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func assertCents(t *testing.T, db *sql.DB, query string, want []sql.NullInt64) {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []sql.NullInt64
+	for rows.Next() {
+		var v sql.NullInt64
+		if err := rows.Scan(&v); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, v)
+	}
+
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("%s = %v, want %v", query, got, want)
+	}
+}
+
+func cents(v int64) sql.NullInt64 { return sql.NullInt64{Int64: v, Valid: true} }
+
+func assertMigrated(t *testing.T, db *sql.DB) {
+	t.Helper()
+
+	assertCents(t, db, "SELECT price FROM products ORDER BY id", []sql.NullInt64{cents(1990), cents(1250), cents(1)})
+	assertCents(t, db, "SELECT sale_price FROM products ORDER BY id", []sql.NullInt64{{}, cents(999), cents(0)})
+	assertCents(t, db, "SELECT total FROM orders", []sql.NullInt64{cents(123456)})
+	assertCents(t, db, "SELECT shipping FROM orders", []sql.NullInt64{cents(1500)})
+	assertCents(t, db, "SELECT discount FROM orders", []sql.NullInt64{cents(-345)})
+
+	for _, col := range []string{"price", "sale_price"} {
+		dataType, _, err := sqliteMoneyDialect.columnType(context.Background(), db, "products", col)
+		if err != nil || dataType != "bigint" {
+			t.Errorf("products.%s type = %q, %v; want bigint", col, dataType, err)
+		}
+	}
+}
+
+func TestMigrateMoneyToCents(t *testing.T) {
+	db := newMoneyTestDB(t)
+	ctx := context.Background()
+
+	if err := migrateMoneyToCents(ctx, db, sqliteMoneyDialect); err != nil {
+		t.Fatal(err)
+	}
+	assertMigrated(t, db)
+
+	_, nullable, _ := sqliteMoneyDialect.columnType(ctx, db, "products", "sale_price")
+	if !nullable {
+		t.Error("products.sale_price should stay nullable")
+	}
+
+	var markers int
+	if err := db.QueryRow("SELECT COUNT(*) FROM " + moneyMigrationTable).Scan(&markers); err != nil || markers != 5 {
+		t.Errorf("markers = %d, %v; want 5", markers, err)
+	}
+}
+
+func TestMigrateMoneyToCentsTwice(t *testing.T) {
+	db := newMoneyTestDB(t)
+	ctx := context.Background()
+
+	for run := 1; run <= 2; run++ {
+		if err := migrateMoneyToCents(ctx, db, sqliteMoneyDialect); err != nil {
+			t.Fatalf("run %d: %v", run, err)
+		}
+	}
+	assertMigrated(t, db)
+}
+
+func TestMigrateMoneyToCentsResumesBeforeAlter(t *testing.T) {
+	db := newMoneyTestDB(t)
+	ctx := context.Background()
+
+	// Simula uma execução interrompida depois de multiplicar os valores e antes de
+	// mudar o tipo: as colunas continuam REAL, mas já estão em centavos
+	if _, err := db.Exec("CREATE TABLE " + moneyMigrationTable +
+		" (table_name VARCHAR(64) NOT NULL, column_name VARCHAR(64) NOT NULL, migrated_at DATETIME NOT NULL, PRIMARY KEY (table_name, column_name))"); err != nil {
+		t.Fatal(err)
+	}
+	for _, col := range []struct{ table, column string }{{"products", "price"}, {"orders", "total"}} {
+		if err := scaleMoneyColumn(ctx, db, sqliteMoneyDialect, col.table, col.column); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := migrateMoneyToCents(ctx, db, sqliteMoneyDialect); err != nil {
+		t.Fatal(err)
+	}
+	assertMigrated(t, db)
+}
@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
+	"github.com/vtrod/veecomm-api/ent/user"
+)
+
+// AccountToken is the model entity for the AccountToken schema.
+type AccountToken struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Purpose holds the value of the "purpose" field.
+	Purpose accounttoken.Purpose `json:"purpose,omitempty"`
+	// TokenHash holds the value of the "token_hash" field.
+	TokenHash string `json:"-"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the AccountTokenQuery when eager-loading is set.
+	Edges        AccountTokenEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// AccountTokenEdges holds the relations/edges for other nodes in the graph.
+type AccountTokenEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountTokenEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AccountToken) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case accounttoken.FieldID, accounttoken.FieldUserID, accounttoken.FieldPurpose, accounttoken.FieldTokenHash, accounttoken.FieldEmail:
+			values[i] = new(sql.NullString)
+		case accounttoken.FieldExpiresAt, accounttoken.FieldUsedAt, accounttoken.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AccountToken fields.
+func (at *AccountToken) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case accounttoken.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				at.ID = value.String
+			}
+		case accounttoken.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				at.UserID = value.String
+			}
+		case accounttoken.FieldPurpose:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field purpose", values[i])
+			} else if value.Valid {
+				at.Purpose = accounttoken.Purpose(value.String)
+			}
+		case accounttoken.FieldTokenHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token_hash", values[i])
+			} else if value.Valid {
+				at.TokenHash = value.String
+			}
+		case accounttoken.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				at.Email = value.String
+			}
+		case accounttoken.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				at.ExpiresAt = value.Time
+			}
+		case accounttoken.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				at.UsedAt = new(time.Time)
+				*at.UsedAt = value.Time
+			}
+		case accounttoken.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the AccountToken.
+// This includes values selected through modifiers, order, etc.
+func (at *AccountToken) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the AccountToken entity.
+func (at *AccountToken) QueryUser() *UserQuery {
+	return NewAccountTokenClient(at.config).QueryUser(at)
+}
+
+// Update returns a builder for updating this AccountToken.
+// Note that you need to call AccountToken.Unwrap() before calling this method if this AccountToken
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *AccountToken) Update() *AccountTokenUpdateOne {
+	return NewAccountTokenClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the AccountToken entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *AccountToken) Unwrap() *AccountToken {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: AccountToken is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *AccountToken) String() string {
+	var builder strings.Builder
+	builder.WriteString("AccountToken(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(at.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("purpose=")
+	builder.WriteString(fmt.Sprintf("%v", at.Purpose))
+	builder.WriteString(", ")
+	builder.WriteString("token_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(at.Email)
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(at.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := at.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AccountTokens is a parsable slice of AccountToken.
+type AccountTokens []*AccountToken
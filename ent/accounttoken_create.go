@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
+	"github.com/vtrod/veecomm-api/ent/user"
+)
+
+// AccountTokenCreate is the builder for creating a AccountToken entity.
+type AccountTokenCreate struct {
+	config
+	mutation *AccountTokenMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (atc *AccountTokenCreate) SetUserID(s string) *AccountTokenCreate {
+	atc.mutation.SetUserID(s)
+	return atc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (atc *AccountTokenCreate) SetNillableUserID(s *string) *AccountTokenCreate {
+	if s != nil {
+		atc.SetUserID(*s)
+	}
+	return atc
+}
+
+// SetPurpose sets the "purpose" field.
+func (atc *AccountTokenCreate) SetPurpose(a accounttoken.Purpose) *AccountTokenCreate {
+	atc.mutation.SetPurpose(a)
+	return atc
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (atc *AccountTokenCreate) SetTokenHash(s string) *AccountTokenCreate {
+	atc.mutation.SetTokenHash(s)
+	return atc
+}
+
+// SetEmail sets the "email" field.
+func (atc *AccountTokenCreate) SetEmail(s string) *AccountTokenCreate {
+	atc.mutation.SetEmail(s)
+	return atc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (atc *AccountTokenCreate) SetExpiresAt(t time.Time) *AccountTokenCreate {
+	atc.mutation.SetExpiresAt(t)
+	return atc
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atc *AccountTokenCreate) SetUsedAt(t time.Time) *AccountTokenCreate {
+	atc.mutation.SetUsedAt(t)
+	return atc
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atc *AccountTokenCreate) SetNillableUsedAt(t *time.Time) *AccountTokenCreate {
+	if t != nil {
+		atc.SetUsedAt(*t)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *AccountTokenCreate) SetCreatedAt(t time.Time) *AccountTokenCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *AccountTokenCreate) SetNillableCreatedAt(t *time.Time) *AccountTokenCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetID sets the "id" field.
+func (atc *AccountTokenCreate) SetID(s string) *AccountTokenCreate {
+	atc.mutation.SetID(s)
+	return atc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atc *AccountTokenCreate) SetUser(u *User) *AccountTokenCreate {
+	return atc.SetUserID(u.ID)
+}
+
+// Mutation returns the AccountTokenMutation object of the builder.
+func (atc *AccountTokenCreate) Mutation() *AccountTokenMutation {
+	return atc.mutation
+}
+
+// Save creates the AccountToken in the database.
+func (atc *AccountTokenCreate) Save(ctx context.Context) (*AccountToken, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *AccountTokenCreate) SaveX(ctx context.Context) *AccountToken {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *AccountTokenCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *AccountTokenCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *AccountTokenCreate) defaults() {
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := accounttoken.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *AccountTokenCreate) check() error {
+	if _, ok := atc.mutation.Purpose(); !ok {
+		return &ValidationError{Name: "purpose", err: errors.New(`ent: missing required field "AccountToken.purpose"`)}
+	}
+	if v, ok := atc.mutation.Purpose(); ok {
+		if err := accounttoken.PurposeValidator(v); err != nil {
+			return &ValidationError{Name: "purpose", err: fmt.Errorf(`ent: validator failed for field "AccountToken.purpose": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.TokenHash(); !ok {
+		return &ValidationError{Name: "token_hash", err: errors.New(`ent: missing required field "AccountToken.token_hash"`)}
+	}
+	if _, ok := atc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "AccountToken.email"`)}
+	}
+	if _, ok := atc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "AccountToken.expires_at"`)}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AccountToken.created_at"`)}
+	}
+	return nil
+}
+
+func (atc *AccountTokenCreate) sqlSave(ctx context.Context) (*AccountToken, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AccountToken.ID type: %T", _spec.ID.Value)
+		}
+	}
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *AccountTokenCreate) createSpec() (*AccountToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AccountToken{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(accounttoken.Table, sqlgraph.NewFieldSpec(accounttoken.FieldID, field.TypeString))
+	)
+	if id, ok := atc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := atc.mutation.Purpose(); ok {
+		_spec.SetField(accounttoken.FieldPurpose, field.TypeEnum, value)
+		_node.Purpose = value
+	}
+	if value, ok := atc.mutation.TokenHash(); ok {
+		_spec.SetField(accounttoken.FieldTokenHash, field.TypeString, value)
+		_node.TokenHash = value
+	}
+	if value, ok := atc.mutation.Email(); ok {
+		_spec.SetField(accounttoken.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := atc.mutation.ExpiresAt(); ok {
+		_spec.SetField(accounttoken.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := atc.mutation.UsedAt(); ok {
+		_spec.SetField(accounttoken.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(accounttoken.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := atc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accounttoken.UserTable,
+			Columns: []string{accounttoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// AccountTokenCreateBulk is the builder for creating many AccountToken entities in bulk.
+type AccountTokenCreateBulk struct {
+	config
+	err      error
+	builders []*AccountTokenCreate
+}
+
+// Save creates the AccountToken entities in the database.
+func (atcb *AccountTokenCreateBulk) Save(ctx context.Context) ([]*AccountToken, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*AccountToken, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AccountTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *AccountTokenCreateBulk) SaveX(ctx context.Context) []*AccountToken {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *AccountTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *AccountTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/user"
+)
+
+// AccountTokenUpdate is the builder for updating AccountToken entities.
+type AccountTokenUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AccountTokenMutation
+}
+
+// Where appends a list predicates to the AccountTokenUpdate builder.
+func (atu *AccountTokenUpdate) Where(ps ...predicate.AccountToken) *AccountTokenUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetUserID sets the "user_id" field.
+func (atu *AccountTokenUpdate) SetUserID(s string) *AccountTokenUpdate {
+	atu.mutation.SetUserID(s)
+	return atu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (atu *AccountTokenUpdate) SetNillableUserID(s *string) *AccountTokenUpdate {
+	if s != nil {
+		atu.SetUserID(*s)
+	}
+	return atu
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (atu *AccountTokenUpdate) ClearUserID() *AccountTokenUpdate {
+	atu.mutation.ClearUserID()
+	return atu
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atu *AccountTokenUpdate) SetUsedAt(t time.Time) *AccountTokenUpdate {
+	atu.mutation.SetUsedAt(t)
+	return atu
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atu *AccountTokenUpdate) SetNillableUsedAt(t *time.Time) *AccountTokenUpdate {
+	if t != nil {
+		atu.SetUsedAt(*t)
+	}
+	return atu
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (atu *AccountTokenUpdate) ClearUsedAt() *AccountTokenUpdate {
+	atu.mutation.ClearUsedAt()
+	return atu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atu *AccountTokenUpdate) SetUser(u *User) *AccountTokenUpdate {
+	return atu.SetUserID(u.ID)
+}
+
+// Mutation returns the AccountTokenMutation object of the builder.
+func (atu *AccountTokenUpdate) Mutation() *AccountTokenMutation {
+	return atu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atu *AccountTokenUpdate) ClearUser() *AccountTokenUpdate {
+	atu.mutation.ClearUser()
+	return atu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *AccountTokenUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *AccountTokenUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *AccountTokenUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *AccountTokenUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atu *AccountTokenUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accounttoken.Table, accounttoken.Columns, sqlgraph.NewFieldSpec(accounttoken.FieldID, field.TypeString))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.UsedAt(); ok {
+		_spec.SetField(accounttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if atu.mutation.UsedAtCleared() {
+		_spec.ClearField(accounttoken.FieldUsedAt, field.TypeTime)
+	}
+	if atu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accounttoken.UserTable,
+			Columns: []string{accounttoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accounttoken.UserTable,
+			Columns: []string{accounttoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// AccountTokenUpdateOne is the builder for updating a single AccountToken entity.
+type AccountTokenUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AccountTokenMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (atuo *AccountTokenUpdateOne) SetUserID(s string) *AccountTokenUpdateOne {
+	atuo.mutation.SetUserID(s)
+	return atuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (atuo *AccountTokenUpdateOne) SetNillableUserID(s *string) *AccountTokenUpdateOne {
+	if s != nil {
+		atuo.SetUserID(*s)
+	}
+	return atuo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (atuo *AccountTokenUpdateOne) ClearUserID() *AccountTokenUpdateOne {
+	atuo.mutation.ClearUserID()
+	return atuo
+}
+
+// SetUsedAt sets the "used_at" field.
+func (atuo *AccountTokenUpdateOne) SetUsedAt(t time.Time) *AccountTokenUpdateOne {
+	atuo.mutation.SetUsedAt(t)
+	return atuo
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (atuo *AccountTokenUpdateOne) SetNillableUsedAt(t *time.Time) *AccountTokenUpdateOne {
+	if t != nil {
+		atuo.SetUsedAt(*t)
+	}
+	return atuo
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (atuo *AccountTokenUpdateOne) ClearUsedAt() *AccountTokenUpdateOne {
+	atuo.mutation.ClearUsedAt()
+	return atuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (atuo *AccountTokenUpdateOne) SetUser(u *User) *AccountTokenUpdateOne {
+	return atuo.SetUserID(u.ID)
+}
+
+// Mutation returns the AccountTokenMutation object of the builder.
+func (atuo *AccountTokenUpdateOne) Mutation() *AccountTokenMutation {
+	return atuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (atuo *AccountTokenUpdateOne) ClearUser() *AccountTokenUpdateOne {
+	atuo.mutation.ClearUser()
+	return atuo
+}
+
+// Where appends a list predicates to the AccountTokenUpdate builder.
+func (atuo *AccountTokenUpdateOne) Where(ps ...predicate.AccountToken) *AccountTokenUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *AccountTokenUpdateOne) Select(field string, fields ...string) *AccountTokenUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated AccountToken entity.
+func (atuo *AccountTokenUpdateOne) Save(ctx context.Context) (*AccountToken, error) {
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *AccountTokenUpdateOne) SaveX(ctx context.Context) *AccountToken {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *AccountTokenUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *AccountTokenUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (atuo *AccountTokenUpdateOne) sqlSave(ctx context.Context) (_node *AccountToken, err error) {
+	_spec := sqlgraph.NewUpdateSpec(accounttoken.Table, accounttoken.Columns, sqlgraph.NewFieldSpec(accounttoken.FieldID, field.TypeString))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AccountToken.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, accounttoken.FieldID)
+		for _, f := range fields {
+			if !accounttoken.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != accounttoken.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.UsedAt(); ok {
+		_spec.SetField(accounttoken.FieldUsedAt, field.TypeTime, value)
+	}
+	if atuo.mutation.UsedAtCleared() {
+		_spec.ClearField(accounttoken.FieldUsedAt, field.TypeTime)
+	}
+	if atuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accounttoken.UserTable,
+			Columns: []string{accounttoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := atuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   accounttoken.UserTable,
+			Columns: []string{accounttoken.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AccountToken{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{accounttoken.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Cart is the model entity for the Cart schema.
@@ -21,17 +22,23 @@ type Cart struct {
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
 	// Subtotal holds the value of the "subtotal" field.
-	Subtotal float64 `json:"subtotal,omitempty"`
+	Subtotal money.Amount `json:"subtotal,omitempty"`
 	// Shipping holds the value of the "shipping" field.
-	Shipping float64 `json:"shipping,omitempty"`
+	Shipping money.Amount `json:"shipping,omitempty"`
+	// ShippingService holds the value of the "shipping_service" field.
+	ShippingService string `json:"shipping_service,omitempty"`
+	// ShippingCep holds the value of the "shipping_cep" field.
+	ShippingCep string `json:"shipping_cep,omitempty"`
 	// Discount holds the value of the "discount" field.
-	Discount float64 `json:"discount,omitempty"`
+	Discount money.Amount `json:"discount,omitempty"`
 	// Total holds the value of the "total" field.
-	Total float64 `json:"total,omitempty"`
+	Total money.Amount `json:"total,omitempty"`
 	// AppliedCoupon holds the value of the "applied_coupon" field.
 	AppliedCoupon bool `json:"applied_coupon,omitempty"`
 	// CouponCode holds the value of the "coupon_code" field.
 	CouponCode string `json:"coupon_code,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -78,13 +85,13 @@ func (*Cart) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case cart.FieldSubtotal, cart.FieldShipping, cart.FieldDiscount, cart.FieldTotal:
+			values[i] = new(money.Amount)
 		case cart.FieldAppliedCoupon:
 			values[i] = new(sql.NullBool)
-		case cart.FieldSubtotal, cart.FieldShipping, cart.FieldDiscount, cart.FieldTotal:
-			values[i] = new(sql.NullFloat64)
-		case cart.FieldID, cart.FieldUserID, cart.FieldCouponCode:
+		case cart.FieldID, cart.FieldUserID, cart.FieldShippingService, cart.FieldShippingCep, cart.FieldCouponCode:
 			values[i] = new(sql.NullString)
-		case cart.FieldCreatedAt, cart.FieldUpdatedAt:
+		case cart.FieldExpiresAt, cart.FieldCreatedAt, cart.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -114,28 +121,40 @@ func (c *Cart) assignValues(columns []string, values []any) error {
 				c.UserID = value.String
 			}
 		case cart.FieldSubtotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field subtotal", values[i])
-			} else if value.Valid {
-				c.Subtotal = value.Float64
+			} else if value != nil {
+				c.Subtotal = *value
 			}
 		case cart.FieldShipping:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field shipping", values[i])
+			} else if value != nil {
+				c.Shipping = *value
+			}
+		case cart.FieldShippingService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_service", values[i])
 			} else if value.Valid {
-				c.Shipping = value.Float64
+				c.ShippingService = value.String
+			}
+		case cart.FieldShippingCep:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_cep", values[i])
+			} else if value.Valid {
+				c.ShippingCep = value.String
 			}
 		case cart.FieldDiscount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field discount", values[i])
-			} else if value.Valid {
-				c.Discount = value.Float64
+			} else if value != nil {
+				c.Discount = *value
 			}
 		case cart.FieldTotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[i])
-			} else if value.Valid {
-				c.Total = value.Float64
+			} else if value != nil {
+				c.Total = *value
 			}
 		case cart.FieldAppliedCoupon:
 			if value, ok := values[i].(*sql.NullBool); !ok {
@@ -149,6 +168,13 @@ func (c *Cart) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.CouponCode = value.String
 			}
+		case cart.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				c.ExpiresAt = new(time.Time)
+				*c.ExpiresAt = value.Time
+			}
 		case cart.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -216,6 +242,12 @@ func (c *Cart) String() string {
 	builder.WriteString("shipping=")
 	builder.WriteString(fmt.Sprintf("%v", c.Shipping))
 	builder.WriteString(", ")
+	builder.WriteString("shipping_service=")
+	builder.WriteString(c.ShippingService)
+	builder.WriteString(", ")
+	builder.WriteString("shipping_cep=")
+	builder.WriteString(c.ShippingCep)
+	builder.WriteString(", ")
 	builder.WriteString("discount=")
 	builder.WriteString(fmt.Sprintf("%v", c.Discount))
 	builder.WriteString(", ")
@@ -228,6 +260,11 @@ func (c *Cart) String() string {
 	builder.WriteString("coupon_code=")
 	builder.WriteString(c.CouponCode)
 	builder.WriteString(", ")
+	if v := c.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 const (
@@ -20,6 +21,10 @@ const (
 	FieldSubtotal = "subtotal"
 	// FieldShipping holds the string denoting the shipping field in the database.
 	FieldShipping = "shipping"
+	// FieldShippingService holds the string denoting the shipping_service field in the database.
+	FieldShippingService = "shipping_service"
+	// FieldShippingCep holds the string denoting the shipping_cep field in the database.
+	FieldShippingCep = "shipping_cep"
 	// FieldDiscount holds the string denoting the discount field in the database.
 	FieldDiscount = "discount"
 	// FieldTotal holds the string denoting the total field in the database.
@@ -28,6 +33,8 @@ const (
 	FieldAppliedCoupon = "applied_coupon"
 	// FieldCouponCode holds the string denoting the coupon_code field in the database.
 	FieldCouponCode = "coupon_code"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -60,10 +67,13 @@ var Columns = []string{
 	FieldUserID,
 	FieldSubtotal,
 	FieldShipping,
+	FieldShippingService,
+	FieldShippingCep,
 	FieldDiscount,
 	FieldTotal,
 	FieldAppliedCoupon,
 	FieldCouponCode,
+	FieldExpiresAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -80,11 +90,11 @@ func ValidColumn(column string) bool {
 
 var (
 	// DefaultSubtotal holds the default value on creation for the "subtotal" field.
-	DefaultSubtotal float64
+	DefaultSubtotal money.Amount
 	// DefaultDiscount holds the default value on creation for the "discount" field.
-	DefaultDiscount float64
+	DefaultDiscount money.Amount
 	// DefaultTotal holds the default value on creation for the "total" field.
-	DefaultTotal float64
+	DefaultTotal money.Amount
 	// DefaultAppliedCoupon holds the default value on creation for the "applied_coupon" field.
 	DefaultAppliedCoupon bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -118,6 +128,16 @@ func ByShipping(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldShipping, opts...).ToFunc()
 }
 
+// ByShippingService orders the results by the shipping_service field.
+func ByShippingService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingService, opts...).ToFunc()
+}
+
+// ByShippingCep orders the results by the shipping_cep field.
+func ByShippingCep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingCep, opts...).ToFunc()
+}
+
 // ByDiscount orders the results by the discount field.
 func ByDiscount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDiscount, opts...).ToFunc()
@@ -138,6 +158,11 @@ func ByCouponCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCouponCode, opts...).ToFunc()
 }
 
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -71,22 +72,32 @@ func UserID(v string) predicate.Cart {
 }
 
 // Subtotal applies equality check predicate on the "subtotal" field. It's identical to SubtotalEQ.
-func Subtotal(v float64) predicate.Cart {
+func Subtotal(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldSubtotal, v))
 }
 
 // Shipping applies equality check predicate on the "shipping" field. It's identical to ShippingEQ.
-func Shipping(v float64) predicate.Cart {
+func Shipping(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldShipping, v))
 }
 
+// ShippingService applies equality check predicate on the "shipping_service" field. It's identical to ShippingServiceEQ.
+func ShippingService(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldShippingService, v))
+}
+
+// ShippingCep applies equality check predicate on the "shipping_cep" field. It's identical to ShippingCepEQ.
+func ShippingCep(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldShippingCep, v))
+}
+
 // Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
-func Discount(v float64) predicate.Cart {
+func Discount(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldDiscount, v))
 }
 
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
-func Total(v float64) predicate.Cart {
+func Total(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldTotal, v))
 }
 
@@ -100,6 +111,11 @@ func CouponCode(v string) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldCouponCode, v))
 }
 
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldExpiresAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldCreatedAt, v))
@@ -186,82 +202,82 @@ func UserIDContainsFold(v string) predicate.Cart {
 }
 
 // SubtotalEQ applies the EQ predicate on the "subtotal" field.
-func SubtotalEQ(v float64) predicate.Cart {
+func SubtotalEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldSubtotal, v))
 }
 
 // SubtotalNEQ applies the NEQ predicate on the "subtotal" field.
-func SubtotalNEQ(v float64) predicate.Cart {
+func SubtotalNEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNEQ(FieldSubtotal, v))
 }
 
 // SubtotalIn applies the In predicate on the "subtotal" field.
-func SubtotalIn(vs ...float64) predicate.Cart {
+func SubtotalIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldIn(FieldSubtotal, vs...))
 }
 
 // SubtotalNotIn applies the NotIn predicate on the "subtotal" field.
-func SubtotalNotIn(vs ...float64) predicate.Cart {
+func SubtotalNotIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNotIn(FieldSubtotal, vs...))
 }
 
 // SubtotalGT applies the GT predicate on the "subtotal" field.
-func SubtotalGT(v float64) predicate.Cart {
+func SubtotalGT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGT(FieldSubtotal, v))
 }
 
 // SubtotalGTE applies the GTE predicate on the "subtotal" field.
-func SubtotalGTE(v float64) predicate.Cart {
+func SubtotalGTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGTE(FieldSubtotal, v))
 }
 
 // SubtotalLT applies the LT predicate on the "subtotal" field.
-func SubtotalLT(v float64) predicate.Cart {
+func SubtotalLT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLT(FieldSubtotal, v))
 }
 
 // SubtotalLTE applies the LTE predicate on the "subtotal" field.
-func SubtotalLTE(v float64) predicate.Cart {
+func SubtotalLTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLTE(FieldSubtotal, v))
 }
 
 // ShippingEQ applies the EQ predicate on the "shipping" field.
-func ShippingEQ(v float64) predicate.Cart {
+func ShippingEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldShipping, v))
 }
 
 // ShippingNEQ applies the NEQ predicate on the "shipping" field.
-func ShippingNEQ(v float64) predicate.Cart {
+func ShippingNEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNEQ(FieldShipping, v))
 }
 
 // ShippingIn applies the In predicate on the "shipping" field.
-func ShippingIn(vs ...float64) predicate.Cart {
+func ShippingIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldIn(FieldShipping, vs...))
 }
 
 // ShippingNotIn applies the NotIn predicate on the "shipping" field.
-func ShippingNotIn(vs ...float64) predicate.Cart {
+func ShippingNotIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNotIn(FieldShipping, vs...))
 }
 
 // ShippingGT applies the GT predicate on the "shipping" field.
-func ShippingGT(v float64) predicate.Cart {
+func ShippingGT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGT(FieldShipping, v))
 }
 
 // ShippingGTE applies the GTE predicate on the "shipping" field.
-func ShippingGTE(v float64) predicate.Cart {
+func ShippingGTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGTE(FieldShipping, v))
 }
 
 // ShippingLT applies the LT predicate on the "shipping" field.
-func ShippingLT(v float64) predicate.Cart {
+func ShippingLT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLT(FieldShipping, v))
 }
 
 // ShippingLTE applies the LTE predicate on the "shipping" field.
-func ShippingLTE(v float64) predicate.Cart {
+func ShippingLTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLTE(FieldShipping, v))
 }
 
@@ -275,83 +291,233 @@ func ShippingNotNil() predicate.Cart {
 	return predicate.Cart(sql.FieldNotNull(FieldShipping))
 }
 
+// ShippingServiceEQ applies the EQ predicate on the "shipping_service" field.
+func ShippingServiceEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldShippingService, v))
+}
+
+// ShippingServiceNEQ applies the NEQ predicate on the "shipping_service" field.
+func ShippingServiceNEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldNEQ(FieldShippingService, v))
+}
+
+// ShippingServiceIn applies the In predicate on the "shipping_service" field.
+func ShippingServiceIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldIn(FieldShippingService, vs...))
+}
+
+// ShippingServiceNotIn applies the NotIn predicate on the "shipping_service" field.
+func ShippingServiceNotIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldNotIn(FieldShippingService, vs...))
+}
+
+// ShippingServiceGT applies the GT predicate on the "shipping_service" field.
+func ShippingServiceGT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGT(FieldShippingService, v))
+}
+
+// ShippingServiceGTE applies the GTE predicate on the "shipping_service" field.
+func ShippingServiceGTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGTE(FieldShippingService, v))
+}
+
+// ShippingServiceLT applies the LT predicate on the "shipping_service" field.
+func ShippingServiceLT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLT(FieldShippingService, v))
+}
+
+// ShippingServiceLTE applies the LTE predicate on the "shipping_service" field.
+func ShippingServiceLTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLTE(FieldShippingService, v))
+}
+
+// ShippingServiceContains applies the Contains predicate on the "shipping_service" field.
+func ShippingServiceContains(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContains(FieldShippingService, v))
+}
+
+// ShippingServiceHasPrefix applies the HasPrefix predicate on the "shipping_service" field.
+func ShippingServiceHasPrefix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasPrefix(FieldShippingService, v))
+}
+
+// ShippingServiceHasSuffix applies the HasSuffix predicate on the "shipping_service" field.
+func ShippingServiceHasSuffix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasSuffix(FieldShippingService, v))
+}
+
+// ShippingServiceIsNil applies the IsNil predicate on the "shipping_service" field.
+func ShippingServiceIsNil() predicate.Cart {
+	return predicate.Cart(sql.FieldIsNull(FieldShippingService))
+}
+
+// ShippingServiceNotNil applies the NotNil predicate on the "shipping_service" field.
+func ShippingServiceNotNil() predicate.Cart {
+	return predicate.Cart(sql.FieldNotNull(FieldShippingService))
+}
+
+// ShippingServiceEqualFold applies the EqualFold predicate on the "shipping_service" field.
+func ShippingServiceEqualFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEqualFold(FieldShippingService, v))
+}
+
+// ShippingServiceContainsFold applies the ContainsFold predicate on the "shipping_service" field.
+func ShippingServiceContainsFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContainsFold(FieldShippingService, v))
+}
+
+// ShippingCepEQ applies the EQ predicate on the "shipping_cep" field.
+func ShippingCepEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldShippingCep, v))
+}
+
+// ShippingCepNEQ applies the NEQ predicate on the "shipping_cep" field.
+func ShippingCepNEQ(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldNEQ(FieldShippingCep, v))
+}
+
+// ShippingCepIn applies the In predicate on the "shipping_cep" field.
+func ShippingCepIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldIn(FieldShippingCep, vs...))
+}
+
+// ShippingCepNotIn applies the NotIn predicate on the "shipping_cep" field.
+func ShippingCepNotIn(vs ...string) predicate.Cart {
+	return predicate.Cart(sql.FieldNotIn(FieldShippingCep, vs...))
+}
+
+// ShippingCepGT applies the GT predicate on the "shipping_cep" field.
+func ShippingCepGT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGT(FieldShippingCep, v))
+}
+
+// ShippingCepGTE applies the GTE predicate on the "shipping_cep" field.
+func ShippingCepGTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldGTE(FieldShippingCep, v))
+}
+
+// ShippingCepLT applies the LT predicate on the "shipping_cep" field.
+func ShippingCepLT(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLT(FieldShippingCep, v))
+}
+
+// ShippingCepLTE applies the LTE predicate on the "shipping_cep" field.
+func ShippingCepLTE(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldLTE(FieldShippingCep, v))
+}
+
+// ShippingCepContains applies the Contains predicate on the "shipping_cep" field.
+func ShippingCepContains(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContains(FieldShippingCep, v))
+}
+
+// ShippingCepHasPrefix applies the HasPrefix predicate on the "shipping_cep" field.
+func ShippingCepHasPrefix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasPrefix(FieldShippingCep, v))
+}
+
+// ShippingCepHasSuffix applies the HasSuffix predicate on the "shipping_cep" field.
+func ShippingCepHasSuffix(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldHasSuffix(FieldShippingCep, v))
+}
+
+// ShippingCepIsNil applies the IsNil predicate on the "shipping_cep" field.
+func ShippingCepIsNil() predicate.Cart {
+	return predicate.Cart(sql.FieldIsNull(FieldShippingCep))
+}
+
+// ShippingCepNotNil applies the NotNil predicate on the "shipping_cep" field.
+func ShippingCepNotNil() predicate.Cart {
+	return predicate.Cart(sql.FieldNotNull(FieldShippingCep))
+}
+
+// ShippingCepEqualFold applies the EqualFold predicate on the "shipping_cep" field.
+func ShippingCepEqualFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldEqualFold(FieldShippingCep, v))
+}
+
+// ShippingCepContainsFold applies the ContainsFold predicate on the "shipping_cep" field.
+func ShippingCepContainsFold(v string) predicate.Cart {
+	return predicate.Cart(sql.FieldContainsFold(FieldShippingCep, v))
+}
+
 // DiscountEQ applies the EQ predicate on the "discount" field.
-func DiscountEQ(v float64) predicate.Cart {
+func DiscountEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldDiscount, v))
 }
 
 // DiscountNEQ applies the NEQ predicate on the "discount" field.
-func DiscountNEQ(v float64) predicate.Cart {
+func DiscountNEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNEQ(FieldDiscount, v))
 }
 
 // DiscountIn applies the In predicate on the "discount" field.
-func DiscountIn(vs ...float64) predicate.Cart {
+func DiscountIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldIn(FieldDiscount, vs...))
 }
 
 // DiscountNotIn applies the NotIn predicate on the "discount" field.
-func DiscountNotIn(vs ...float64) predicate.Cart {
+func DiscountNotIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNotIn(FieldDiscount, vs...))
 }
 
 // DiscountGT applies the GT predicate on the "discount" field.
-func DiscountGT(v float64) predicate.Cart {
+func DiscountGT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGT(FieldDiscount, v))
 }
 
 // DiscountGTE applies the GTE predicate on the "discount" field.
-func DiscountGTE(v float64) predicate.Cart {
+func DiscountGTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGTE(FieldDiscount, v))
 }
 
 // DiscountLT applies the LT predicate on the "discount" field.
-func DiscountLT(v float64) predicate.Cart {
+func DiscountLT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLT(FieldDiscount, v))
 }
 
 // DiscountLTE applies the LTE predicate on the "discount" field.
-func DiscountLTE(v float64) predicate.Cart {
+func DiscountLTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLTE(FieldDiscount, v))
 }
 
 // TotalEQ applies the EQ predicate on the "total" field.
-func TotalEQ(v float64) predicate.Cart {
+func TotalEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldTotal, v))
 }
 
 // TotalNEQ applies the NEQ predicate on the "total" field.
-func TotalNEQ(v float64) predicate.Cart {
+func TotalNEQ(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNEQ(FieldTotal, v))
 }
 
 // TotalIn applies the In predicate on the "total" field.
-func TotalIn(vs ...float64) predicate.Cart {
+func TotalIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldIn(FieldTotal, vs...))
 }
 
 // TotalNotIn applies the NotIn predicate on the "total" field.
-func TotalNotIn(vs ...float64) predicate.Cart {
+func TotalNotIn(vs ...money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldNotIn(FieldTotal, vs...))
 }
 
 // TotalGT applies the GT predicate on the "total" field.
-func TotalGT(v float64) predicate.Cart {
+func TotalGT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGT(FieldTotal, v))
 }
 
 // TotalGTE applies the GTE predicate on the "total" field.
-func TotalGTE(v float64) predicate.Cart {
+func TotalGTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldGTE(FieldTotal, v))
 }
 
 // TotalLT applies the LT predicate on the "total" field.
-func TotalLT(v float64) predicate.Cart {
+func TotalLT(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLT(FieldTotal, v))
 }
 
 // TotalLTE applies the LTE predicate on the "total" field.
-func TotalLTE(v float64) predicate.Cart {
+func TotalLTE(v money.Amount) predicate.Cart {
 	return predicate.Cart(sql.FieldLTE(FieldTotal, v))
 }
 
@@ -440,6 +606,56 @@ func CouponCodeContainsFold(v string) predicate.Cart {
 	return predicate.Cart(sql.FieldContainsFold(FieldCouponCode, v))
 }
 
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Cart {
+	return predicate.Cart(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Cart {
+	return predicate.Cart(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Cart {
+	return predicate.Cart(sql.FieldNotNull(FieldExpiresAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Cart {
 	return predicate.Cart(sql.FieldEQ(FieldCreatedAt, v))
@@ -13,6 +13,7 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartCreate is the builder for creating a Cart entity.
@@ -37,57 +38,85 @@ func (cc *CartCreate) SetNillableUserID(s *string) *CartCreate {
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (cc *CartCreate) SetSubtotal(f float64) *CartCreate {
-	cc.mutation.SetSubtotal(f)
+func (cc *CartCreate) SetSubtotal(m money.Amount) *CartCreate {
+	cc.mutation.SetSubtotal(m)
 	return cc
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (cc *CartCreate) SetNillableSubtotal(f *float64) *CartCreate {
-	if f != nil {
-		cc.SetSubtotal(*f)
+func (cc *CartCreate) SetNillableSubtotal(m *money.Amount) *CartCreate {
+	if m != nil {
+		cc.SetSubtotal(*m)
 	}
 	return cc
 }
 
 // SetShipping sets the "shipping" field.
-func (cc *CartCreate) SetShipping(f float64) *CartCreate {
-	cc.mutation.SetShipping(f)
+func (cc *CartCreate) SetShipping(m money.Amount) *CartCreate {
+	cc.mutation.SetShipping(m)
 	return cc
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (cc *CartCreate) SetNillableShipping(f *float64) *CartCreate {
-	if f != nil {
-		cc.SetShipping(*f)
+func (cc *CartCreate) SetNillableShipping(m *money.Amount) *CartCreate {
+	if m != nil {
+		cc.SetShipping(*m)
+	}
+	return cc
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (cc *CartCreate) SetShippingService(s string) *CartCreate {
+	cc.mutation.SetShippingService(s)
+	return cc
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (cc *CartCreate) SetNillableShippingService(s *string) *CartCreate {
+	if s != nil {
+		cc.SetShippingService(*s)
+	}
+	return cc
+}
+
+// SetShippingCep sets the "shipping_cep" field.
+func (cc *CartCreate) SetShippingCep(s string) *CartCreate {
+	cc.mutation.SetShippingCep(s)
+	return cc
+}
+
+// SetNillableShippingCep sets the "shipping_cep" field if the given value is not nil.
+func (cc *CartCreate) SetNillableShippingCep(s *string) *CartCreate {
+	if s != nil {
+		cc.SetShippingCep(*s)
 	}
 	return cc
 }
 
 // SetDiscount sets the "discount" field.
-func (cc *CartCreate) SetDiscount(f float64) *CartCreate {
-	cc.mutation.SetDiscount(f)
+func (cc *CartCreate) SetDiscount(m money.Amount) *CartCreate {
+	cc.mutation.SetDiscount(m)
 	return cc
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (cc *CartCreate) SetNillableDiscount(f *float64) *CartCreate {
-	if f != nil {
-		cc.SetDiscount(*f)
+func (cc *CartCreate) SetNillableDiscount(m *money.Amount) *CartCreate {
+	if m != nil {
+		cc.SetDiscount(*m)
 	}
 	return cc
 }
 
 // SetTotal sets the "total" field.
-func (cc *CartCreate) SetTotal(f float64) *CartCreate {
-	cc.mutation.SetTotal(f)
+func (cc *CartCreate) SetTotal(m money.Amount) *CartCreate {
+	cc.mutation.SetTotal(m)
 	return cc
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (cc *CartCreate) SetNillableTotal(f *float64) *CartCreate {
-	if f != nil {
-		cc.SetTotal(*f)
+func (cc *CartCreate) SetNillableTotal(m *money.Amount) *CartCreate {
+	if m != nil {
+		cc.SetTotal(*m)
 	}
 	return cc
 }
@@ -120,6 +149,20 @@ func (cc *CartCreate) SetNillableCouponCode(s *string) *CartCreate {
 	return cc
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (cc *CartCreate) SetExpiresAt(t time.Time) *CartCreate {
+	cc.mutation.SetExpiresAt(t)
+	return cc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *CartCreate) SetNillableExpiresAt(t *time.Time) *CartCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *CartCreate) SetCreatedAt(t time.Time) *CartCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -291,19 +334,27 @@ func (cc *CartCreate) createSpec() (*Cart, *sqlgraph.CreateSpec) {
 		_spec.ID.Value = id
 	}
 	if value, ok := cc.mutation.Subtotal(); ok {
-		_spec.SetField(cart.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldSubtotal, field.TypeInt64, value)
 		_node.Subtotal = value
 	}
 	if value, ok := cc.mutation.Shipping(); ok {
-		_spec.SetField(cart.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldShipping, field.TypeInt64, value)
 		_node.Shipping = value
 	}
+	if value, ok := cc.mutation.ShippingService(); ok {
+		_spec.SetField(cart.FieldShippingService, field.TypeString, value)
+		_node.ShippingService = value
+	}
+	if value, ok := cc.mutation.ShippingCep(); ok {
+		_spec.SetField(cart.FieldShippingCep, field.TypeString, value)
+		_node.ShippingCep = value
+	}
 	if value, ok := cc.mutation.Discount(); ok {
-		_spec.SetField(cart.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldDiscount, field.TypeInt64, value)
 		_node.Discount = value
 	}
 	if value, ok := cc.mutation.Total(); ok {
-		_spec.SetField(cart.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldTotal, field.TypeInt64, value)
 		_node.Total = value
 	}
 	if value, ok := cc.mutation.AppliedCoupon(); ok {
@@ -314,6 +365,10 @@ func (cc *CartCreate) createSpec() (*Cart, *sqlgraph.CreateSpec) {
 		_spec.SetField(cart.FieldCouponCode, field.TypeString, value)
 		_node.CouponCode = value
 	}
+	if value, ok := cc.mutation.ExpiresAt(); ok {
+		_spec.SetField(cart.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(cart.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -15,6 +15,7 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartUpdate is the builder for updating Cart entities.
@@ -51,44 +52,44 @@ func (cu *CartUpdate) ClearUserID() *CartUpdate {
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (cu *CartUpdate) SetSubtotal(f float64) *CartUpdate {
+func (cu *CartUpdate) SetSubtotal(m money.Amount) *CartUpdate {
 	cu.mutation.ResetSubtotal()
-	cu.mutation.SetSubtotal(f)
+	cu.mutation.SetSubtotal(m)
 	return cu
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (cu *CartUpdate) SetNillableSubtotal(f *float64) *CartUpdate {
-	if f != nil {
-		cu.SetSubtotal(*f)
+func (cu *CartUpdate) SetNillableSubtotal(m *money.Amount) *CartUpdate {
+	if m != nil {
+		cu.SetSubtotal(*m)
 	}
 	return cu
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (cu *CartUpdate) AddSubtotal(f float64) *CartUpdate {
-	cu.mutation.AddSubtotal(f)
+// AddSubtotal adds m to the "subtotal" field.
+func (cu *CartUpdate) AddSubtotal(m money.Amount) *CartUpdate {
+	cu.mutation.AddSubtotal(m)
 	return cu
 }
 
 // SetShipping sets the "shipping" field.
-func (cu *CartUpdate) SetShipping(f float64) *CartUpdate {
+func (cu *CartUpdate) SetShipping(m money.Amount) *CartUpdate {
 	cu.mutation.ResetShipping()
-	cu.mutation.SetShipping(f)
+	cu.mutation.SetShipping(m)
 	return cu
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (cu *CartUpdate) SetNillableShipping(f *float64) *CartUpdate {
-	if f != nil {
-		cu.SetShipping(*f)
+func (cu *CartUpdate) SetNillableShipping(m *money.Amount) *CartUpdate {
+	if m != nil {
+		cu.SetShipping(*m)
 	}
 	return cu
 }
 
-// AddShipping adds f to the "shipping" field.
-func (cu *CartUpdate) AddShipping(f float64) *CartUpdate {
-	cu.mutation.AddShipping(f)
+// AddShipping adds m to the "shipping" field.
+func (cu *CartUpdate) AddShipping(m money.Amount) *CartUpdate {
+	cu.mutation.AddShipping(m)
 	return cu
 }
 
@@ -98,45 +99,85 @@ func (cu *CartUpdate) ClearShipping() *CartUpdate {
 	return cu
 }
 
+// SetShippingService sets the "shipping_service" field.
+func (cu *CartUpdate) SetShippingService(s string) *CartUpdate {
+	cu.mutation.SetShippingService(s)
+	return cu
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (cu *CartUpdate) SetNillableShippingService(s *string) *CartUpdate {
+	if s != nil {
+		cu.SetShippingService(*s)
+	}
+	return cu
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (cu *CartUpdate) ClearShippingService() *CartUpdate {
+	cu.mutation.ClearShippingService()
+	return cu
+}
+
+// SetShippingCep sets the "shipping_cep" field.
+func (cu *CartUpdate) SetShippingCep(s string) *CartUpdate {
+	cu.mutation.SetShippingCep(s)
+	return cu
+}
+
+// SetNillableShippingCep sets the "shipping_cep" field if the given value is not nil.
+func (cu *CartUpdate) SetNillableShippingCep(s *string) *CartUpdate {
+	if s != nil {
+		cu.SetShippingCep(*s)
+	}
+	return cu
+}
+
+// ClearShippingCep clears the value of the "shipping_cep" field.
+func (cu *CartUpdate) ClearShippingCep() *CartUpdate {
+	cu.mutation.ClearShippingCep()
+	return cu
+}
+
 // SetDiscount sets the "discount" field.
-func (cu *CartUpdate) SetDiscount(f float64) *CartUpdate {
+func (cu *CartUpdate) SetDiscount(m money.Amount) *CartUpdate {
 	cu.mutation.ResetDiscount()
-	cu.mutation.SetDiscount(f)
+	cu.mutation.SetDiscount(m)
 	return cu
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (cu *CartUpdate) SetNillableDiscount(f *float64) *CartUpdate {
-	if f != nil {
-		cu.SetDiscount(*f)
+func (cu *CartUpdate) SetNillableDiscount(m *money.Amount) *CartUpdate {
+	if m != nil {
+		cu.SetDiscount(*m)
 	}
 	return cu
 }
 
-// AddDiscount adds f to the "discount" field.
-func (cu *CartUpdate) AddDiscount(f float64) *CartUpdate {
-	cu.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (cu *CartUpdate) AddDiscount(m money.Amount) *CartUpdate {
+	cu.mutation.AddDiscount(m)
 	return cu
 }
 
 // SetTotal sets the "total" field.
-func (cu *CartUpdate) SetTotal(f float64) *CartUpdate {
+func (cu *CartUpdate) SetTotal(m money.Amount) *CartUpdate {
 	cu.mutation.ResetTotal()
-	cu.mutation.SetTotal(f)
+	cu.mutation.SetTotal(m)
 	return cu
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (cu *CartUpdate) SetNillableTotal(f *float64) *CartUpdate {
-	if f != nil {
-		cu.SetTotal(*f)
+func (cu *CartUpdate) SetNillableTotal(m *money.Amount) *CartUpdate {
+	if m != nil {
+		cu.SetTotal(*m)
 	}
 	return cu
 }
 
-// AddTotal adds f to the "total" field.
-func (cu *CartUpdate) AddTotal(f float64) *CartUpdate {
-	cu.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (cu *CartUpdate) AddTotal(m money.Amount) *CartUpdate {
+	cu.mutation.AddTotal(m)
 	return cu
 }
 
@@ -174,6 +215,26 @@ func (cu *CartUpdate) ClearCouponCode() *CartUpdate {
 	return cu
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CartUpdate) SetExpiresAt(t time.Time) *CartUpdate {
+	cu.mutation.SetExpiresAt(t)
+	return cu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CartUpdate) SetNillableExpiresAt(t *time.Time) *CartUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
+	return cu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CartUpdate) ClearExpiresAt() *CartUpdate {
+	cu.mutation.ClearExpiresAt()
+	return cu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cu *CartUpdate) SetCreatedAt(t time.Time) *CartUpdate {
 	cu.mutation.SetCreatedAt(t)
@@ -292,31 +353,43 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 	}
 	if value, ok := cu.mutation.Subtotal(); ok {
-		_spec.SetField(cart.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldSubtotal, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AddedSubtotal(); ok {
-		_spec.AddField(cart.FieldSubtotal, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldSubtotal, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.Shipping(); ok {
-		_spec.SetField(cart.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldShipping, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AddedShipping(); ok {
-		_spec.AddField(cart.FieldShipping, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldShipping, field.TypeInt64, value)
 	}
 	if cu.mutation.ShippingCleared() {
-		_spec.ClearField(cart.FieldShipping, field.TypeFloat64)
+		_spec.ClearField(cart.FieldShipping, field.TypeInt64)
+	}
+	if value, ok := cu.mutation.ShippingService(); ok {
+		_spec.SetField(cart.FieldShippingService, field.TypeString, value)
+	}
+	if cu.mutation.ShippingServiceCleared() {
+		_spec.ClearField(cart.FieldShippingService, field.TypeString)
+	}
+	if value, ok := cu.mutation.ShippingCep(); ok {
+		_spec.SetField(cart.FieldShippingCep, field.TypeString, value)
+	}
+	if cu.mutation.ShippingCepCleared() {
+		_spec.ClearField(cart.FieldShippingCep, field.TypeString)
 	}
 	if value, ok := cu.mutation.Discount(); ok {
-		_spec.SetField(cart.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AddedDiscount(); ok {
-		_spec.AddField(cart.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.Total(); ok {
-		_spec.SetField(cart.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AddedTotal(); ok {
-		_spec.AddField(cart.FieldTotal, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AppliedCoupon(); ok {
 		_spec.SetField(cart.FieldAppliedCoupon, field.TypeBool, value)
@@ -327,6 +400,12 @@ func (cu *CartUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if cu.mutation.CouponCodeCleared() {
 		_spec.ClearField(cart.FieldCouponCode, field.TypeString)
 	}
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(cart.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(cart.FieldExpiresAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.CreatedAt(); ok {
 		_spec.SetField(cart.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -448,44 +527,44 @@ func (cuo *CartUpdateOne) ClearUserID() *CartUpdateOne {
 }
 
 // SetSubtotal sets the "subtotal" field.
-func (cuo *CartUpdateOne) SetSubtotal(f float64) *CartUpdateOne {
+func (cuo *CartUpdateOne) SetSubtotal(m money.Amount) *CartUpdateOne {
 	cuo.mutation.ResetSubtotal()
-	cuo.mutation.SetSubtotal(f)
+	cuo.mutation.SetSubtotal(m)
 	return cuo
 }
 
 // SetNillableSubtotal sets the "subtotal" field if the given value is not nil.
-func (cuo *CartUpdateOne) SetNillableSubtotal(f *float64) *CartUpdateOne {
-	if f != nil {
-		cuo.SetSubtotal(*f)
+func (cuo *CartUpdateOne) SetNillableSubtotal(m *money.Amount) *CartUpdateOne {
+	if m != nil {
+		cuo.SetSubtotal(*m)
 	}
 	return cuo
 }
 
-// AddSubtotal adds f to the "subtotal" field.
-func (cuo *CartUpdateOne) AddSubtotal(f float64) *CartUpdateOne {
-	cuo.mutation.AddSubtotal(f)
+// AddSubtotal adds m to the "subtotal" field.
+func (cuo *CartUpdateOne) AddSubtotal(m money.Amount) *CartUpdateOne {
+	cuo.mutation.AddSubtotal(m)
 	return cuo
 }
 
 // SetShipping sets the "shipping" field.
-func (cuo *CartUpdateOne) SetShipping(f float64) *CartUpdateOne {
+func (cuo *CartUpdateOne) SetShipping(m money.Amount) *CartUpdateOne {
 	cuo.mutation.ResetShipping()
-	cuo.mutation.SetShipping(f)
+	cuo.mutation.SetShipping(m)
 	return cuo
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (cuo *CartUpdateOne) SetNillableShipping(f *float64) *CartUpdateOne {
-	if f != nil {
-		cuo.SetShipping(*f)
+func (cuo *CartUpdateOne) SetNillableShipping(m *money.Amount) *CartUpdateOne {
+	if m != nil {
+		cuo.SetShipping(*m)
 	}
 	return cuo
 }
 
-// AddShipping adds f to the "shipping" field.
-func (cuo *CartUpdateOne) AddShipping(f float64) *CartUpdateOne {
-	cuo.mutation.AddShipping(f)
+// AddShipping adds m to the "shipping" field.
+func (cuo *CartUpdateOne) AddShipping(m money.Amount) *CartUpdateOne {
+	cuo.mutation.AddShipping(m)
 	return cuo
 }
 
@@ -495,45 +574,85 @@ func (cuo *CartUpdateOne) ClearShipping() *CartUpdateOne {
 	return cuo
 }
 
+// SetShippingService sets the "shipping_service" field.
+func (cuo *CartUpdateOne) SetShippingService(s string) *CartUpdateOne {
+	cuo.mutation.SetShippingService(s)
+	return cuo
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableShippingService(s *string) *CartUpdateOne {
+	if s != nil {
+		cuo.SetShippingService(*s)
+	}
+	return cuo
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (cuo *CartUpdateOne) ClearShippingService() *CartUpdateOne {
+	cuo.mutation.ClearShippingService()
+	return cuo
+}
+
+// SetShippingCep sets the "shipping_cep" field.
+func (cuo *CartUpdateOne) SetShippingCep(s string) *CartUpdateOne {
+	cuo.mutation.SetShippingCep(s)
+	return cuo
+}
+
+// SetNillableShippingCep sets the "shipping_cep" field if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableShippingCep(s *string) *CartUpdateOne {
+	if s != nil {
+		cuo.SetShippingCep(*s)
+	}
+	return cuo
+}
+
+// ClearShippingCep clears the value of the "shipping_cep" field.
+func (cuo *CartUpdateOne) ClearShippingCep() *CartUpdateOne {
+	cuo.mutation.ClearShippingCep()
+	return cuo
+}
+
 // SetDiscount sets the "discount" field.
-func (cuo *CartUpdateOne) SetDiscount(f float64) *CartUpdateOne {
+func (cuo *CartUpdateOne) SetDiscount(m money.Amount) *CartUpdateOne {
 	cuo.mutation.ResetDiscount()
-	cuo.mutation.SetDiscount(f)
+	cuo.mutation.SetDiscount(m)
 	return cuo
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (cuo *CartUpdateOne) SetNillableDiscount(f *float64) *CartUpdateOne {
-	if f != nil {
-		cuo.SetDiscount(*f)
+func (cuo *CartUpdateOne) SetNillableDiscount(m *money.Amount) *CartUpdateOne {
+	if m != nil {
+		cuo.SetDiscount(*m)
 	}
 	return cuo
 }
 
-// AddDiscount adds f to the "discount" field.
-func (cuo *CartUpdateOne) AddDiscount(f float64) *CartUpdateOne {
-	cuo.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (cuo *CartUpdateOne) AddDiscount(m money.Amount) *CartUpdateOne {
+	cuo.mutation.AddDiscount(m)
 	return cuo
 }
 
 // SetTotal sets the "total" field.
-func (cuo *CartUpdateOne) SetTotal(f float64) *CartUpdateOne {
+func (cuo *CartUpdateOne) SetTotal(m money.Amount) *CartUpdateOne {
 	cuo.mutation.ResetTotal()
-	cuo.mutation.SetTotal(f)
+	cuo.mutation.SetTotal(m)
 	return cuo
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (cuo *CartUpdateOne) SetNillableTotal(f *float64) *CartUpdateOne {
-	if f != nil {
-		cuo.SetTotal(*f)
+func (cuo *CartUpdateOne) SetNillableTotal(m *money.Amount) *CartUpdateOne {
+	if m != nil {
+		cuo.SetTotal(*m)
 	}
 	return cuo
 }
 
-// AddTotal adds f to the "total" field.
-func (cuo *CartUpdateOne) AddTotal(f float64) *CartUpdateOne {
-	cuo.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (cuo *CartUpdateOne) AddTotal(m money.Amount) *CartUpdateOne {
+	cuo.mutation.AddTotal(m)
 	return cuo
 }
 
@@ -571,6 +690,26 @@ func (cuo *CartUpdateOne) ClearCouponCode() *CartUpdateOne {
 	return cuo
 }
 
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CartUpdateOne) SetExpiresAt(t time.Time) *CartUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
+	return cuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CartUpdateOne) SetNillableExpiresAt(t *time.Time) *CartUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
+	return cuo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CartUpdateOne) ClearExpiresAt() *CartUpdateOne {
+	cuo.mutation.ClearExpiresAt()
+	return cuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cuo *CartUpdateOne) SetCreatedAt(t time.Time) *CartUpdateOne {
 	cuo.mutation.SetCreatedAt(t)
@@ -719,31 +858,43 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 		}
 	}
 	if value, ok := cuo.mutation.Subtotal(); ok {
-		_spec.SetField(cart.FieldSubtotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldSubtotal, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AddedSubtotal(); ok {
-		_spec.AddField(cart.FieldSubtotal, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldSubtotal, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.Shipping(); ok {
-		_spec.SetField(cart.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldShipping, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AddedShipping(); ok {
-		_spec.AddField(cart.FieldShipping, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldShipping, field.TypeInt64, value)
 	}
 	if cuo.mutation.ShippingCleared() {
-		_spec.ClearField(cart.FieldShipping, field.TypeFloat64)
+		_spec.ClearField(cart.FieldShipping, field.TypeInt64)
+	}
+	if value, ok := cuo.mutation.ShippingService(); ok {
+		_spec.SetField(cart.FieldShippingService, field.TypeString, value)
+	}
+	if cuo.mutation.ShippingServiceCleared() {
+		_spec.ClearField(cart.FieldShippingService, field.TypeString)
+	}
+	if value, ok := cuo.mutation.ShippingCep(); ok {
+		_spec.SetField(cart.FieldShippingCep, field.TypeString, value)
+	}
+	if cuo.mutation.ShippingCepCleared() {
+		_spec.ClearField(cart.FieldShippingCep, field.TypeString)
 	}
 	if value, ok := cuo.mutation.Discount(); ok {
-		_spec.SetField(cart.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AddedDiscount(); ok {
-		_spec.AddField(cart.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.Total(); ok {
-		_spec.SetField(cart.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(cart.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AddedTotal(); ok {
-		_spec.AddField(cart.FieldTotal, field.TypeFloat64, value)
+		_spec.AddField(cart.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AppliedCoupon(); ok {
 		_spec.SetField(cart.FieldAppliedCoupon, field.TypeBool, value)
@@ -754,6 +905,12 @@ func (cuo *CartUpdateOne) sqlSave(ctx context.Context) (_node *Cart, err error)
 	if cuo.mutation.CouponCodeCleared() {
 		_spec.ClearField(cart.FieldCouponCode, field.TypeString)
 	}
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(cart.FieldExpiresAt, field.TypeTime, value)
+	}
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(cart.FieldExpiresAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.CreatedAt(); ok {
 		_spec.SetField(cart.FieldCreatedAt, field.TypeTime, value)
 	}
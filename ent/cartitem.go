@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartItem is the model entity for the CartItem schema.
@@ -23,14 +26,24 @@ type CartItem struct {
 	CartID string `json:"cart_id,omitempty"`
 	// ProductID holds the value of the "product_id" field.
 	ProductID string `json:"product_id,omitempty"`
+	// VariantID holds the value of the "variant_id" field.
+	VariantID string `json:"variant_id,omitempty"`
+	// VariantOptions holds the value of the "variant_options" field.
+	VariantOptions map[string]string `json:"variant_options,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Price holds the value of the "price" field.
-	Price float64 `json:"price,omitempty"`
+	Price money.Amount `json:"price,omitempty"`
 	// Image holds the value of the "image" field.
 	Image string `json:"image,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity int `json:"quantity,omitempty"`
+	// PreviousPrice holds the value of the "previous_price" field.
+	PreviousPrice *money.Amount `json:"previous_price,omitempty"`
+	// PreviousQuantity holds the value of the "previous_quantity" field.
+	PreviousQuantity *int `json:"previous_quantity,omitempty"`
+	// UnavailableReason holds the value of the "unavailable_reason" field.
+	UnavailableReason *cartitem.UnavailableReason `json:"unavailable_reason,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -47,9 +60,11 @@ type CartItemEdges struct {
 	Cart *Cart `json:"cart,omitempty"`
 	// Product holds the value of the product edge.
 	Product *Product `json:"product,omitempty"`
+	// Variant holds the value of the variant edge.
+	Variant *ProductVariant `json:"variant,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // CartOrErr returns the Cart value or an error if the edge
@@ -74,16 +89,31 @@ func (e CartItemEdges) ProductOrErr() (*Product, error) {
 	return nil, &NotLoadedError{edge: "product"}
 }
 
+// VariantOrErr returns the Variant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CartItemEdges) VariantOrErr() (*ProductVariant, error) {
+	if e.Variant != nil {
+		return e.Variant, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: productvariant.Label}
+	}
+	return nil, &NotLoadedError{edge: "variant"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*CartItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case cartitem.FieldPreviousPrice:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case cartitem.FieldVariantOptions:
+			values[i] = new([]byte)
 		case cartitem.FieldPrice:
-			values[i] = new(sql.NullFloat64)
-		case cartitem.FieldQuantity:
+			values[i] = new(money.Amount)
+		case cartitem.FieldQuantity, cartitem.FieldPreviousQuantity:
 			values[i] = new(sql.NullInt64)
-		case cartitem.FieldID, cartitem.FieldCartID, cartitem.FieldProductID, cartitem.FieldName, cartitem.FieldImage:
+		case cartitem.FieldID, cartitem.FieldCartID, cartitem.FieldProductID, cartitem.FieldVariantID, cartitem.FieldName, cartitem.FieldImage, cartitem.FieldUnavailableReason:
 			values[i] = new(sql.NullString)
 		case cartitem.FieldCreatedAt, cartitem.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -120,6 +150,20 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ci.ProductID = value.String
 			}
+		case cartitem.FieldVariantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				ci.VariantID = value.String
+			}
+		case cartitem.FieldVariantOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ci.VariantOptions); err != nil {
+					return fmt.Errorf("unmarshal field variant_options: %w", err)
+				}
+			}
 		case cartitem.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -127,10 +171,10 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 				ci.Name = value.String
 			}
 		case cartitem.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
-			} else if value.Valid {
-				ci.Price = value.Float64
+			} else if value != nil {
+				ci.Price = *value
 			}
 		case cartitem.FieldImage:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -144,6 +188,27 @@ func (ci *CartItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ci.Quantity = int(value.Int64)
 			}
+		case cartitem.FieldPreviousPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_price", values[i])
+			} else if value.Valid {
+				ci.PreviousPrice = new(money.Amount)
+				*ci.PreviousPrice = *value.S.(*money.Amount)
+			}
+		case cartitem.FieldPreviousQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_quantity", values[i])
+			} else if value.Valid {
+				ci.PreviousQuantity = new(int)
+				*ci.PreviousQuantity = int(value.Int64)
+			}
+		case cartitem.FieldUnavailableReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unavailable_reason", values[i])
+			} else if value.Valid {
+				ci.UnavailableReason = new(cartitem.UnavailableReason)
+				*ci.UnavailableReason = cartitem.UnavailableReason(value.String)
+			}
 		case cartitem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -179,6 +244,11 @@ func (ci *CartItem) QueryProduct() *ProductQuery {
 	return NewCartItemClient(ci.config).QueryProduct(ci)
 }
 
+// QueryVariant queries the "variant" edge of the CartItem entity.
+func (ci *CartItem) QueryVariant() *ProductVariantQuery {
+	return NewCartItemClient(ci.config).QueryVariant(ci)
+}
+
 // Update returns a builder for updating this CartItem.
 // Note that you need to call CartItem.Unwrap() before calling this method if this CartItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -208,6 +278,12 @@ func (ci *CartItem) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(ci.ProductID)
 	builder.WriteString(", ")
+	builder.WriteString("variant_id=")
+	builder.WriteString(ci.VariantID)
+	builder.WriteString(", ")
+	builder.WriteString("variant_options=")
+	builder.WriteString(fmt.Sprintf("%v", ci.VariantOptions))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(ci.Name)
 	builder.WriteString(", ")
@@ -220,6 +296,21 @@ func (ci *CartItem) String() string {
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", ci.Quantity))
 	builder.WriteString(", ")
+	if v := ci.PreviousPrice; v != nil {
+		builder.WriteString("previous_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ci.PreviousQuantity; v != nil {
+		builder.WriteString("previous_quantity=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := ci.UnavailableReason; v != nil {
+		builder.WriteString("unavailable_reason=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ci.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
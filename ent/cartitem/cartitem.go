@@ -3,6 +3,7 @@
 package cartitem
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -18,6 +19,10 @@ const (
 	FieldCartID = "cart_id"
 	// FieldProductID holds the string denoting the product_id field in the database.
 	FieldProductID = "product_id"
+	// FieldVariantID holds the string denoting the variant_id field in the database.
+	FieldVariantID = "variant_id"
+	// FieldVariantOptions holds the string denoting the variant_options field in the database.
+	FieldVariantOptions = "variant_options"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldPrice holds the string denoting the price field in the database.
@@ -26,6 +31,12 @@ const (
 	FieldImage = "image"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
+	// FieldPreviousPrice holds the string denoting the previous_price field in the database.
+	FieldPreviousPrice = "previous_price"
+	// FieldPreviousQuantity holds the string denoting the previous_quantity field in the database.
+	FieldPreviousQuantity = "previous_quantity"
+	// FieldUnavailableReason holds the string denoting the unavailable_reason field in the database.
+	FieldUnavailableReason = "unavailable_reason"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +45,8 @@ const (
 	EdgeCart = "cart"
 	// EdgeProduct holds the string denoting the product edge name in mutations.
 	EdgeProduct = "product"
+	// EdgeVariant holds the string denoting the variant edge name in mutations.
+	EdgeVariant = "variant"
 	// Table holds the table name of the cartitem in the database.
 	Table = "cart_items"
 	// CartTable is the table that holds the cart relation/edge.
@@ -50,6 +63,13 @@ const (
 	ProductInverseTable = "products"
 	// ProductColumn is the table column denoting the product relation/edge.
 	ProductColumn = "product_id"
+	// VariantTable is the table that holds the variant relation/edge.
+	VariantTable = "cart_items"
+	// VariantInverseTable is the table name for the ProductVariant entity.
+	// It exists in this package in order to avoid circular dependency with the "productvariant" package.
+	VariantInverseTable = "product_variants"
+	// VariantColumn is the table column denoting the variant relation/edge.
+	VariantColumn = "variant_id"
 )
 
 // Columns holds all SQL columns for cartitem fields.
@@ -57,10 +77,15 @@ var Columns = []string{
 	FieldID,
 	FieldCartID,
 	FieldProductID,
+	FieldVariantID,
+	FieldVariantOptions,
 	FieldName,
 	FieldPrice,
 	FieldImage,
 	FieldQuantity,
+	FieldPreviousPrice,
+	FieldPreviousQuantity,
+	FieldUnavailableReason,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -79,7 +104,7 @@ var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	PriceValidator func(int64) error
 	// ImageValidator is a validator for the "image" field. It is called by the builders before save.
 	ImageValidator func(string) error
 	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
@@ -92,6 +117,29 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
+// UnavailableReason defines the type for the "unavailable_reason" enum field.
+type UnavailableReason string
+
+// UnavailableReason values.
+const (
+	UnavailableReasonOutOfStock UnavailableReason = "out_of_stock"
+	UnavailableReasonRemoved    UnavailableReason = "removed"
+)
+
+func (ur UnavailableReason) String() string {
+	return string(ur)
+}
+
+// UnavailableReasonValidator is a validator for the "unavailable_reason" field enum values. It is called by the builders before save.
+func UnavailableReasonValidator(ur UnavailableReason) error {
+	switch ur {
+	case UnavailableReasonOutOfStock, UnavailableReasonRemoved:
+		return nil
+	default:
+		return fmt.Errorf("cartitem: invalid enum value for unavailable_reason field: %q", ur)
+	}
+}
+
 // OrderOption defines the ordering options for the CartItem queries.
 type OrderOption func(*sql.Selector)
 
@@ -110,6 +158,11 @@ func ByProductID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProductID, opts...).ToFunc()
 }
 
+// ByVariantID orders the results by the variant_id field.
+func ByVariantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -130,6 +183,21 @@ func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
 }
 
+// ByPreviousPrice orders the results by the previous_price field.
+func ByPreviousPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousPrice, opts...).ToFunc()
+}
+
+// ByPreviousQuantity orders the results by the previous_quantity field.
+func ByPreviousQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousQuantity, opts...).ToFunc()
+}
+
+// ByUnavailableReason orders the results by the unavailable_reason field.
+func ByUnavailableReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnavailableReason, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -153,6 +221,13 @@ func ByProductField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newProductStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByVariantField orders the results by variant field.
+func ByVariantField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVariantStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newCartStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -167,3 +242,10 @@ func newProductStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
 	)
 }
+func newVariantStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VariantInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VariantTable, VariantColumn),
+	)
+}
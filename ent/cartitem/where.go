@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -75,13 +76,18 @@ func ProductID(v string) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldProductID, v))
 }
 
+// VariantID applies equality check predicate on the "variant_id" field. It's identical to VariantIDEQ.
+func VariantID(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldVariantID, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldName, v))
 }
 
 // Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.CartItem {
+func Price(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldPrice, v))
 }
 
@@ -95,6 +101,16 @@ func Quantity(v int) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldQuantity, v))
 }
 
+// PreviousPrice applies equality check predicate on the "previous_price" field. It's identical to PreviousPriceEQ.
+func PreviousPrice(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldPreviousPrice, v))
+}
+
+// PreviousQuantity applies equality check predicate on the "previous_quantity" field. It's identical to PreviousQuantityEQ.
+func PreviousQuantity(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldPreviousQuantity, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldCreatedAt, v))
@@ -255,6 +271,91 @@ func ProductIDContainsFold(v string) predicate.CartItem {
 	return predicate.CartItem(sql.FieldContainsFold(FieldProductID, v))
 }
 
+// VariantIDEQ applies the EQ predicate on the "variant_id" field.
+func VariantIDEQ(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantIDNEQ applies the NEQ predicate on the "variant_id" field.
+func VariantIDNEQ(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldVariantID, v))
+}
+
+// VariantIDIn applies the In predicate on the "variant_id" field.
+func VariantIDIn(vs ...string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldVariantID, vs...))
+}
+
+// VariantIDNotIn applies the NotIn predicate on the "variant_id" field.
+func VariantIDNotIn(vs ...string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldVariantID, vs...))
+}
+
+// VariantIDGT applies the GT predicate on the "variant_id" field.
+func VariantIDGT(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGT(FieldVariantID, v))
+}
+
+// VariantIDGTE applies the GTE predicate on the "variant_id" field.
+func VariantIDGTE(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGTE(FieldVariantID, v))
+}
+
+// VariantIDLT applies the LT predicate on the "variant_id" field.
+func VariantIDLT(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLT(FieldVariantID, v))
+}
+
+// VariantIDLTE applies the LTE predicate on the "variant_id" field.
+func VariantIDLTE(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLTE(FieldVariantID, v))
+}
+
+// VariantIDContains applies the Contains predicate on the "variant_id" field.
+func VariantIDContains(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldContains(FieldVariantID, v))
+}
+
+// VariantIDHasPrefix applies the HasPrefix predicate on the "variant_id" field.
+func VariantIDHasPrefix(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldHasPrefix(FieldVariantID, v))
+}
+
+// VariantIDHasSuffix applies the HasSuffix predicate on the "variant_id" field.
+func VariantIDHasSuffix(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldHasSuffix(FieldVariantID, v))
+}
+
+// VariantIDIsNil applies the IsNil predicate on the "variant_id" field.
+func VariantIDIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldVariantID))
+}
+
+// VariantIDNotNil applies the NotNil predicate on the "variant_id" field.
+func VariantIDNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldVariantID))
+}
+
+// VariantIDEqualFold applies the EqualFold predicate on the "variant_id" field.
+func VariantIDEqualFold(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEqualFold(FieldVariantID, v))
+}
+
+// VariantIDContainsFold applies the ContainsFold predicate on the "variant_id" field.
+func VariantIDContainsFold(v string) predicate.CartItem {
+	return predicate.CartItem(sql.FieldContainsFold(FieldVariantID, v))
+}
+
+// VariantOptionsIsNil applies the IsNil predicate on the "variant_options" field.
+func VariantOptionsIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldVariantOptions))
+}
+
+// VariantOptionsNotNil applies the NotNil predicate on the "variant_options" field.
+func VariantOptionsNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldVariantOptions))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldName, v))
@@ -321,42 +422,42 @@ func NameContainsFold(v string) predicate.CartItem {
 }
 
 // PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.CartItem {
+func PriceEQ(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldPrice, v))
 }
 
 // PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.CartItem {
+func PriceNEQ(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldNEQ(FieldPrice, v))
 }
 
 // PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.CartItem {
+func PriceIn(vs ...money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldIn(FieldPrice, vs...))
 }
 
 // PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.CartItem {
+func PriceNotIn(vs ...money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldNotIn(FieldPrice, vs...))
 }
 
 // PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.CartItem {
+func PriceGT(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldGT(FieldPrice, v))
 }
 
 // PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.CartItem {
+func PriceGTE(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldGTE(FieldPrice, v))
 }
 
 // PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.CartItem {
+func PriceLT(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldLT(FieldPrice, v))
 }
 
 // PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.CartItem {
+func PriceLTE(v money.Amount) predicate.CartItem {
 	return predicate.CartItem(sql.FieldLTE(FieldPrice, v))
 }
 
@@ -465,6 +566,136 @@ func QuantityLTE(v int) predicate.CartItem {
 	return predicate.CartItem(sql.FieldLTE(FieldQuantity, v))
 }
 
+// PreviousPriceEQ applies the EQ predicate on the "previous_price" field.
+func PreviousPriceEQ(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldPreviousPrice, v))
+}
+
+// PreviousPriceNEQ applies the NEQ predicate on the "previous_price" field.
+func PreviousPriceNEQ(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldPreviousPrice, v))
+}
+
+// PreviousPriceIn applies the In predicate on the "previous_price" field.
+func PreviousPriceIn(vs ...money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldPreviousPrice, vs...))
+}
+
+// PreviousPriceNotIn applies the NotIn predicate on the "previous_price" field.
+func PreviousPriceNotIn(vs ...money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldPreviousPrice, vs...))
+}
+
+// PreviousPriceGT applies the GT predicate on the "previous_price" field.
+func PreviousPriceGT(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGT(FieldPreviousPrice, v))
+}
+
+// PreviousPriceGTE applies the GTE predicate on the "previous_price" field.
+func PreviousPriceGTE(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGTE(FieldPreviousPrice, v))
+}
+
+// PreviousPriceLT applies the LT predicate on the "previous_price" field.
+func PreviousPriceLT(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLT(FieldPreviousPrice, v))
+}
+
+// PreviousPriceLTE applies the LTE predicate on the "previous_price" field.
+func PreviousPriceLTE(v money.Amount) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLTE(FieldPreviousPrice, v))
+}
+
+// PreviousPriceIsNil applies the IsNil predicate on the "previous_price" field.
+func PreviousPriceIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldPreviousPrice))
+}
+
+// PreviousPriceNotNil applies the NotNil predicate on the "previous_price" field.
+func PreviousPriceNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldPreviousPrice))
+}
+
+// PreviousQuantityEQ applies the EQ predicate on the "previous_quantity" field.
+func PreviousQuantityEQ(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityNEQ applies the NEQ predicate on the "previous_quantity" field.
+func PreviousQuantityNEQ(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityIn applies the In predicate on the "previous_quantity" field.
+func PreviousQuantityIn(vs ...int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldPreviousQuantity, vs...))
+}
+
+// PreviousQuantityNotIn applies the NotIn predicate on the "previous_quantity" field.
+func PreviousQuantityNotIn(vs ...int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldPreviousQuantity, vs...))
+}
+
+// PreviousQuantityGT applies the GT predicate on the "previous_quantity" field.
+func PreviousQuantityGT(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGT(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityGTE applies the GTE predicate on the "previous_quantity" field.
+func PreviousQuantityGTE(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldGTE(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityLT applies the LT predicate on the "previous_quantity" field.
+func PreviousQuantityLT(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLT(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityLTE applies the LTE predicate on the "previous_quantity" field.
+func PreviousQuantityLTE(v int) predicate.CartItem {
+	return predicate.CartItem(sql.FieldLTE(FieldPreviousQuantity, v))
+}
+
+// PreviousQuantityIsNil applies the IsNil predicate on the "previous_quantity" field.
+func PreviousQuantityIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldPreviousQuantity))
+}
+
+// PreviousQuantityNotNil applies the NotNil predicate on the "previous_quantity" field.
+func PreviousQuantityNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldPreviousQuantity))
+}
+
+// UnavailableReasonEQ applies the EQ predicate on the "unavailable_reason" field.
+func UnavailableReasonEQ(v UnavailableReason) predicate.CartItem {
+	return predicate.CartItem(sql.FieldEQ(FieldUnavailableReason, v))
+}
+
+// UnavailableReasonNEQ applies the NEQ predicate on the "unavailable_reason" field.
+func UnavailableReasonNEQ(v UnavailableReason) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNEQ(FieldUnavailableReason, v))
+}
+
+// UnavailableReasonIn applies the In predicate on the "unavailable_reason" field.
+func UnavailableReasonIn(vs ...UnavailableReason) predicate.CartItem {
+	return predicate.CartItem(sql.FieldIn(FieldUnavailableReason, vs...))
+}
+
+// UnavailableReasonNotIn applies the NotIn predicate on the "unavailable_reason" field.
+func UnavailableReasonNotIn(vs ...UnavailableReason) predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotIn(FieldUnavailableReason, vs...))
+}
+
+// UnavailableReasonIsNil applies the IsNil predicate on the "unavailable_reason" field.
+func UnavailableReasonIsNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldIsNull(FieldUnavailableReason))
+}
+
+// UnavailableReasonNotNil applies the NotNil predicate on the "unavailable_reason" field.
+func UnavailableReasonNotNil() predicate.CartItem {
+	return predicate.CartItem(sql.FieldNotNull(FieldUnavailableReason))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.CartItem {
 	return predicate.CartItem(sql.FieldEQ(FieldCreatedAt, v))
@@ -591,6 +822,29 @@ func HasProductWith(preds ...predicate.Product) predicate.CartItem {
 	})
 }
 
+// HasVariant applies the HasEdge predicate on the "variant" edge.
+func HasVariant() predicate.CartItem {
+	return predicate.CartItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VariantTable, VariantColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVariantWith applies the HasEdge predicate on the "variant" edge with a given conditions (other predicates).
+func HasVariantWith(preds ...predicate.ProductVariant) predicate.CartItem {
+	return predicate.CartItem(func(s *sql.Selector) {
+		step := newVariantStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CartItem) predicate.CartItem {
 	return predicate.CartItem(sql.AndPredicates(predicates...))
@@ -13,6 +13,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartItemCreate is the builder for creating a CartItem entity.
@@ -50,6 +52,26 @@ func (cic *CartItemCreate) SetNillableProductID(s *string) *CartItemCreate {
 	return cic
 }
 
+// SetVariantID sets the "variant_id" field.
+func (cic *CartItemCreate) SetVariantID(s string) *CartItemCreate {
+	cic.mutation.SetVariantID(s)
+	return cic
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (cic *CartItemCreate) SetNillableVariantID(s *string) *CartItemCreate {
+	if s != nil {
+		cic.SetVariantID(*s)
+	}
+	return cic
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (cic *CartItemCreate) SetVariantOptions(m map[string]string) *CartItemCreate {
+	cic.mutation.SetVariantOptions(m)
+	return cic
+}
+
 // SetName sets the "name" field.
 func (cic *CartItemCreate) SetName(s string) *CartItemCreate {
 	cic.mutation.SetName(s)
@@ -57,8 +79,8 @@ func (cic *CartItemCreate) SetName(s string) *CartItemCreate {
 }
 
 // SetPrice sets the "price" field.
-func (cic *CartItemCreate) SetPrice(f float64) *CartItemCreate {
-	cic.mutation.SetPrice(f)
+func (cic *CartItemCreate) SetPrice(m money.Amount) *CartItemCreate {
+	cic.mutation.SetPrice(m)
 	return cic
 }
 
@@ -74,6 +96,48 @@ func (cic *CartItemCreate) SetQuantity(i int) *CartItemCreate {
 	return cic
 }
 
+// SetPreviousPrice sets the "previous_price" field.
+func (cic *CartItemCreate) SetPreviousPrice(m money.Amount) *CartItemCreate {
+	cic.mutation.SetPreviousPrice(m)
+	return cic
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (cic *CartItemCreate) SetNillablePreviousPrice(m *money.Amount) *CartItemCreate {
+	if m != nil {
+		cic.SetPreviousPrice(*m)
+	}
+	return cic
+}
+
+// SetPreviousQuantity sets the "previous_quantity" field.
+func (cic *CartItemCreate) SetPreviousQuantity(i int) *CartItemCreate {
+	cic.mutation.SetPreviousQuantity(i)
+	return cic
+}
+
+// SetNillablePreviousQuantity sets the "previous_quantity" field if the given value is not nil.
+func (cic *CartItemCreate) SetNillablePreviousQuantity(i *int) *CartItemCreate {
+	if i != nil {
+		cic.SetPreviousQuantity(*i)
+	}
+	return cic
+}
+
+// SetUnavailableReason sets the "unavailable_reason" field.
+func (cic *CartItemCreate) SetUnavailableReason(cr cartitem.UnavailableReason) *CartItemCreate {
+	cic.mutation.SetUnavailableReason(cr)
+	return cic
+}
+
+// SetNillableUnavailableReason sets the "unavailable_reason" field if the given value is not nil.
+func (cic *CartItemCreate) SetNillableUnavailableReason(cr *cartitem.UnavailableReason) *CartItemCreate {
+	if cr != nil {
+		cic.SetUnavailableReason(*cr)
+	}
+	return cic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cic *CartItemCreate) SetCreatedAt(t time.Time) *CartItemCreate {
 	cic.mutation.SetCreatedAt(t)
@@ -118,6 +182,11 @@ func (cic *CartItemCreate) SetProduct(p *Product) *CartItemCreate {
 	return cic.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (cic *CartItemCreate) SetVariant(p *ProductVariant) *CartItemCreate {
+	return cic.SetVariantID(p.ID)
+}
+
 // Mutation returns the CartItemMutation object of the builder.
 func (cic *CartItemCreate) Mutation() *CartItemMutation {
 	return cic.mutation
@@ -177,7 +246,7 @@ func (cic *CartItemCreate) check() error {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "CartItem.price"`)}
 	}
 	if v, ok := cic.mutation.Price(); ok {
-		if err := cartitem.PriceValidator(v); err != nil {
+		if err := cartitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "CartItem.price": %w`, err)}
 		}
 	}
@@ -197,6 +266,11 @@ func (cic *CartItemCreate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartItem.quantity": %w`, err)}
 		}
 	}
+	if v, ok := cic.mutation.UnavailableReason(); ok {
+		if err := cartitem.UnavailableReasonValidator(v); err != nil {
+			return &ValidationError{Name: "unavailable_reason", err: fmt.Errorf(`ent: validator failed for field "CartItem.unavailable_reason": %w`, err)}
+		}
+	}
 	if _, ok := cic.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CartItem.created_at"`)}
 	}
@@ -238,12 +312,16 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := cic.mutation.VariantOptions(); ok {
+		_spec.SetField(cartitem.FieldVariantOptions, field.TypeJSON, value)
+		_node.VariantOptions = value
+	}
 	if value, ok := cic.mutation.Name(); ok {
 		_spec.SetField(cartitem.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
 	if value, ok := cic.mutation.Price(); ok {
-		_spec.SetField(cartitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(cartitem.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
 	if value, ok := cic.mutation.Image(); ok {
@@ -254,6 +332,18 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		_spec.SetField(cartitem.FieldQuantity, field.TypeInt, value)
 		_node.Quantity = value
 	}
+	if value, ok := cic.mutation.PreviousPrice(); ok {
+		_spec.SetField(cartitem.FieldPreviousPrice, field.TypeInt64, value)
+		_node.PreviousPrice = &value
+	}
+	if value, ok := cic.mutation.PreviousQuantity(); ok {
+		_spec.SetField(cartitem.FieldPreviousQuantity, field.TypeInt, value)
+		_node.PreviousQuantity = &value
+	}
+	if value, ok := cic.mutation.UnavailableReason(); ok {
+		_spec.SetField(cartitem.FieldUnavailableReason, field.TypeEnum, value)
+		_node.UnavailableReason = &value
+	}
 	if value, ok := cic.mutation.CreatedAt(); ok {
 		_spec.SetField(cartitem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -296,6 +386,23 @@ func (cic *CartItemCreate) createSpec() (*CartItem, *sqlgraph.CreateSpec) {
 		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := cic.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartitem.VariantTable,
+			Columns: []string{cartitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VariantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
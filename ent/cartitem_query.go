@@ -15,6 +15,7 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
 )
 
 // CartItemQuery is the builder for querying CartItem entities.
@@ -26,6 +27,7 @@ type CartItemQuery struct {
 	predicates  []predicate.CartItem
 	withCart    *CartQuery
 	withProduct *ProductQuery
+	withVariant *ProductVariantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (ciq *CartItemQuery) QueryProduct() *ProductQuery {
 	return query
 }
 
+// QueryVariant chains the current query on the "variant" edge.
+func (ciq *CartItemQuery) QueryVariant() *ProductVariantQuery {
+	query := (&ProductVariantClient{config: ciq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ciq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ciq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(cartitem.Table, cartitem.FieldID, selector),
+			sqlgraph.To(productvariant.Table, productvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, cartitem.VariantTable, cartitem.VariantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ciq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first CartItem entity from the query.
 // Returns a *NotFoundError when no CartItem was found.
 func (ciq *CartItemQuery) First(ctx context.Context) (*CartItem, error) {
@@ -300,6 +324,7 @@ func (ciq *CartItemQuery) Clone() *CartItemQuery {
 		predicates:  append([]predicate.CartItem{}, ciq.predicates...),
 		withCart:    ciq.withCart.Clone(),
 		withProduct: ciq.withProduct.Clone(),
+		withVariant: ciq.withVariant.Clone(),
 		// clone intermediate query.
 		sql:  ciq.sql.Clone(),
 		path: ciq.path,
@@ -328,6 +353,17 @@ func (ciq *CartItemQuery) WithProduct(opts ...func(*ProductQuery)) *CartItemQuer
 	return ciq
 }
 
+// WithVariant tells the query-builder to eager-load the nodes that are connected to
+// the "variant" edge. The optional arguments are used to configure the query builder of the edge.
+func (ciq *CartItemQuery) WithVariant(opts ...func(*ProductVariantQuery)) *CartItemQuery {
+	query := (&ProductVariantClient{config: ciq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ciq.withVariant = query
+	return ciq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (ciq *CartItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ca
 	var (
 		nodes       = []*CartItem{}
 		_spec       = ciq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			ciq.withCart != nil,
 			ciq.withProduct != nil,
+			ciq.withVariant != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +478,12 @@ func (ciq *CartItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Ca
 			return nil, err
 		}
 	}
+	if query := ciq.withVariant; query != nil {
+		if err := ciq.loadVariant(ctx, query, nodes, nil,
+			func(n *CartItem, e *ProductVariant) { n.Edges.Variant = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +545,35 @@ func (ciq *CartItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	}
 	return nil
 }
+func (ciq *CartItemQuery) loadVariant(ctx context.Context, query *ProductVariantQuery, nodes []*CartItem, init func(*CartItem), assign func(*CartItem, *ProductVariant)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*CartItem)
+	for i := range nodes {
+		fk := nodes[i].VariantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(productvariant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "variant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (ciq *CartItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := ciq.querySpec()
@@ -534,6 +606,9 @@ func (ciq *CartItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if ciq.withProduct != nil {
 			_spec.Node.AddColumnOnce(cartitem.FieldProductID)
 		}
+		if ciq.withVariant != nil {
+			_spec.Node.AddColumnOnce(cartitem.FieldVariantID)
+		}
 	}
 	if ps := ciq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -15,6 +15,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartItemUpdate is the builder for updating CartItem entities.
@@ -70,6 +72,38 @@ func (ciu *CartItemUpdate) ClearProductID() *CartItemUpdate {
 	return ciu
 }
 
+// SetVariantID sets the "variant_id" field.
+func (ciu *CartItemUpdate) SetVariantID(s string) *CartItemUpdate {
+	ciu.mutation.SetVariantID(s)
+	return ciu
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillableVariantID(s *string) *CartItemUpdate {
+	if s != nil {
+		ciu.SetVariantID(*s)
+	}
+	return ciu
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (ciu *CartItemUpdate) ClearVariantID() *CartItemUpdate {
+	ciu.mutation.ClearVariantID()
+	return ciu
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (ciu *CartItemUpdate) SetVariantOptions(m map[string]string) *CartItemUpdate {
+	ciu.mutation.SetVariantOptions(m)
+	return ciu
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (ciu *CartItemUpdate) ClearVariantOptions() *CartItemUpdate {
+	ciu.mutation.ClearVariantOptions()
+	return ciu
+}
+
 // SetName sets the "name" field.
 func (ciu *CartItemUpdate) SetName(s string) *CartItemUpdate {
 	ciu.mutation.SetName(s)
@@ -85,23 +119,23 @@ func (ciu *CartItemUpdate) SetNillableName(s *string) *CartItemUpdate {
 }
 
 // SetPrice sets the "price" field.
-func (ciu *CartItemUpdate) SetPrice(f float64) *CartItemUpdate {
+func (ciu *CartItemUpdate) SetPrice(m money.Amount) *CartItemUpdate {
 	ciu.mutation.ResetPrice()
-	ciu.mutation.SetPrice(f)
+	ciu.mutation.SetPrice(m)
 	return ciu
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (ciu *CartItemUpdate) SetNillablePrice(f *float64) *CartItemUpdate {
-	if f != nil {
-		ciu.SetPrice(*f)
+func (ciu *CartItemUpdate) SetNillablePrice(m *money.Amount) *CartItemUpdate {
+	if m != nil {
+		ciu.SetPrice(*m)
 	}
 	return ciu
 }
 
-// AddPrice adds f to the "price" field.
-func (ciu *CartItemUpdate) AddPrice(f float64) *CartItemUpdate {
-	ciu.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (ciu *CartItemUpdate) AddPrice(m money.Amount) *CartItemUpdate {
+	ciu.mutation.AddPrice(m)
 	return ciu
 }
 
@@ -140,6 +174,80 @@ func (ciu *CartItemUpdate) AddQuantity(i int) *CartItemUpdate {
 	return ciu
 }
 
+// SetPreviousPrice sets the "previous_price" field.
+func (ciu *CartItemUpdate) SetPreviousPrice(m money.Amount) *CartItemUpdate {
+	ciu.mutation.ResetPreviousPrice()
+	ciu.mutation.SetPreviousPrice(m)
+	return ciu
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillablePreviousPrice(m *money.Amount) *CartItemUpdate {
+	if m != nil {
+		ciu.SetPreviousPrice(*m)
+	}
+	return ciu
+}
+
+// AddPreviousPrice adds m to the "previous_price" field.
+func (ciu *CartItemUpdate) AddPreviousPrice(m money.Amount) *CartItemUpdate {
+	ciu.mutation.AddPreviousPrice(m)
+	return ciu
+}
+
+// ClearPreviousPrice clears the value of the "previous_price" field.
+func (ciu *CartItemUpdate) ClearPreviousPrice() *CartItemUpdate {
+	ciu.mutation.ClearPreviousPrice()
+	return ciu
+}
+
+// SetPreviousQuantity sets the "previous_quantity" field.
+func (ciu *CartItemUpdate) SetPreviousQuantity(i int) *CartItemUpdate {
+	ciu.mutation.ResetPreviousQuantity()
+	ciu.mutation.SetPreviousQuantity(i)
+	return ciu
+}
+
+// SetNillablePreviousQuantity sets the "previous_quantity" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillablePreviousQuantity(i *int) *CartItemUpdate {
+	if i != nil {
+		ciu.SetPreviousQuantity(*i)
+	}
+	return ciu
+}
+
+// AddPreviousQuantity adds i to the "previous_quantity" field.
+func (ciu *CartItemUpdate) AddPreviousQuantity(i int) *CartItemUpdate {
+	ciu.mutation.AddPreviousQuantity(i)
+	return ciu
+}
+
+// ClearPreviousQuantity clears the value of the "previous_quantity" field.
+func (ciu *CartItemUpdate) ClearPreviousQuantity() *CartItemUpdate {
+	ciu.mutation.ClearPreviousQuantity()
+	return ciu
+}
+
+// SetUnavailableReason sets the "unavailable_reason" field.
+func (ciu *CartItemUpdate) SetUnavailableReason(cr cartitem.UnavailableReason) *CartItemUpdate {
+	ciu.mutation.SetUnavailableReason(cr)
+	return ciu
+}
+
+// SetNillableUnavailableReason sets the "unavailable_reason" field if the given value is not nil.
+func (ciu *CartItemUpdate) SetNillableUnavailableReason(cr *cartitem.UnavailableReason) *CartItemUpdate {
+	if cr != nil {
+		ciu.SetUnavailableReason(*cr)
+	}
+	return ciu
+}
+
+// ClearUnavailableReason clears the value of the "unavailable_reason" field.
+func (ciu *CartItemUpdate) ClearUnavailableReason() *CartItemUpdate {
+	ciu.mutation.ClearUnavailableReason()
+	return ciu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ciu *CartItemUpdate) SetCreatedAt(t time.Time) *CartItemUpdate {
 	ciu.mutation.SetCreatedAt(t)
@@ -170,6 +278,11 @@ func (ciu *CartItemUpdate) SetProduct(p *Product) *CartItemUpdate {
 	return ciu.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (ciu *CartItemUpdate) SetVariant(p *ProductVariant) *CartItemUpdate {
+	return ciu.SetVariantID(p.ID)
+}
+
 // Mutation returns the CartItemMutation object of the builder.
 func (ciu *CartItemUpdate) Mutation() *CartItemMutation {
 	return ciu.mutation
@@ -187,6 +300,12 @@ func (ciu *CartItemUpdate) ClearProduct() *CartItemUpdate {
 	return ciu
 }
 
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (ciu *CartItemUpdate) ClearVariant() *CartItemUpdate {
+	ciu.mutation.ClearVariant()
+	return ciu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ciu *CartItemUpdate) Save(ctx context.Context) (int, error) {
 	ciu.defaults()
@@ -231,7 +350,7 @@ func (ciu *CartItemUpdate) check() error {
 		}
 	}
 	if v, ok := ciu.mutation.Price(); ok {
-		if err := cartitem.PriceValidator(v); err != nil {
+		if err := cartitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "CartItem.price": %w`, err)}
 		}
 	}
@@ -245,6 +364,11 @@ func (ciu *CartItemUpdate) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartItem.quantity": %w`, err)}
 		}
 	}
+	if v, ok := ciu.mutation.UnavailableReason(); ok {
+		if err := cartitem.UnavailableReasonValidator(v); err != nil {
+			return &ValidationError{Name: "unavailable_reason", err: fmt.Errorf(`ent: validator failed for field "CartItem.unavailable_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -260,14 +384,20 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := ciu.mutation.VariantOptions(); ok {
+		_spec.SetField(cartitem.FieldVariantOptions, field.TypeJSON, value)
+	}
+	if ciu.mutation.VariantOptionsCleared() {
+		_spec.ClearField(cartitem.FieldVariantOptions, field.TypeJSON)
+	}
 	if value, ok := ciu.mutation.Name(); ok {
 		_spec.SetField(cartitem.FieldName, field.TypeString, value)
 	}
 	if value, ok := ciu.mutation.Price(); ok {
-		_spec.SetField(cartitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(cartitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := ciu.mutation.AddedPrice(); ok {
-		_spec.AddField(cartitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(cartitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := ciu.mutation.Image(); ok {
 		_spec.SetField(cartitem.FieldImage, field.TypeString, value)
@@ -278,6 +408,30 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ciu.mutation.AddedQuantity(); ok {
 		_spec.AddField(cartitem.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := ciu.mutation.PreviousPrice(); ok {
+		_spec.SetField(cartitem.FieldPreviousPrice, field.TypeInt64, value)
+	}
+	if value, ok := ciu.mutation.AddedPreviousPrice(); ok {
+		_spec.AddField(cartitem.FieldPreviousPrice, field.TypeInt64, value)
+	}
+	if ciu.mutation.PreviousPriceCleared() {
+		_spec.ClearField(cartitem.FieldPreviousPrice, field.TypeInt64)
+	}
+	if value, ok := ciu.mutation.PreviousQuantity(); ok {
+		_spec.SetField(cartitem.FieldPreviousQuantity, field.TypeInt, value)
+	}
+	if value, ok := ciu.mutation.AddedPreviousQuantity(); ok {
+		_spec.AddField(cartitem.FieldPreviousQuantity, field.TypeInt, value)
+	}
+	if ciu.mutation.PreviousQuantityCleared() {
+		_spec.ClearField(cartitem.FieldPreviousQuantity, field.TypeInt)
+	}
+	if value, ok := ciu.mutation.UnavailableReason(); ok {
+		_spec.SetField(cartitem.FieldUnavailableReason, field.TypeEnum, value)
+	}
+	if ciu.mutation.UnavailableReasonCleared() {
+		_spec.ClearField(cartitem.FieldUnavailableReason, field.TypeEnum)
+	}
 	if value, ok := ciu.mutation.CreatedAt(); ok {
 		_spec.SetField(cartitem.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -342,6 +496,35 @@ func (ciu *CartItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ciu.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartitem.VariantTable,
+			Columns: []string{cartitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciu.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartitem.VariantTable,
+			Columns: []string{cartitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ciu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{cartitem.Label}
@@ -402,6 +585,38 @@ func (ciuo *CartItemUpdateOne) ClearProductID() *CartItemUpdateOne {
 	return ciuo
 }
 
+// SetVariantID sets the "variant_id" field.
+func (ciuo *CartItemUpdateOne) SetVariantID(s string) *CartItemUpdateOne {
+	ciuo.mutation.SetVariantID(s)
+	return ciuo
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillableVariantID(s *string) *CartItemUpdateOne {
+	if s != nil {
+		ciuo.SetVariantID(*s)
+	}
+	return ciuo
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (ciuo *CartItemUpdateOne) ClearVariantID() *CartItemUpdateOne {
+	ciuo.mutation.ClearVariantID()
+	return ciuo
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (ciuo *CartItemUpdateOne) SetVariantOptions(m map[string]string) *CartItemUpdateOne {
+	ciuo.mutation.SetVariantOptions(m)
+	return ciuo
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (ciuo *CartItemUpdateOne) ClearVariantOptions() *CartItemUpdateOne {
+	ciuo.mutation.ClearVariantOptions()
+	return ciuo
+}
+
 // SetName sets the "name" field.
 func (ciuo *CartItemUpdateOne) SetName(s string) *CartItemUpdateOne {
 	ciuo.mutation.SetName(s)
@@ -417,23 +632,23 @@ func (ciuo *CartItemUpdateOne) SetNillableName(s *string) *CartItemUpdateOne {
 }
 
 // SetPrice sets the "price" field.
-func (ciuo *CartItemUpdateOne) SetPrice(f float64) *CartItemUpdateOne {
+func (ciuo *CartItemUpdateOne) SetPrice(m money.Amount) *CartItemUpdateOne {
 	ciuo.mutation.ResetPrice()
-	ciuo.mutation.SetPrice(f)
+	ciuo.mutation.SetPrice(m)
 	return ciuo
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (ciuo *CartItemUpdateOne) SetNillablePrice(f *float64) *CartItemUpdateOne {
-	if f != nil {
-		ciuo.SetPrice(*f)
+func (ciuo *CartItemUpdateOne) SetNillablePrice(m *money.Amount) *CartItemUpdateOne {
+	if m != nil {
+		ciuo.SetPrice(*m)
 	}
 	return ciuo
 }
 
-// AddPrice adds f to the "price" field.
-func (ciuo *CartItemUpdateOne) AddPrice(f float64) *CartItemUpdateOne {
-	ciuo.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (ciuo *CartItemUpdateOne) AddPrice(m money.Amount) *CartItemUpdateOne {
+	ciuo.mutation.AddPrice(m)
 	return ciuo
 }
 
@@ -472,6 +687,80 @@ func (ciuo *CartItemUpdateOne) AddQuantity(i int) *CartItemUpdateOne {
 	return ciuo
 }
 
+// SetPreviousPrice sets the "previous_price" field.
+func (ciuo *CartItemUpdateOne) SetPreviousPrice(m money.Amount) *CartItemUpdateOne {
+	ciuo.mutation.ResetPreviousPrice()
+	ciuo.mutation.SetPreviousPrice(m)
+	return ciuo
+}
+
+// SetNillablePreviousPrice sets the "previous_price" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillablePreviousPrice(m *money.Amount) *CartItemUpdateOne {
+	if m != nil {
+		ciuo.SetPreviousPrice(*m)
+	}
+	return ciuo
+}
+
+// AddPreviousPrice adds m to the "previous_price" field.
+func (ciuo *CartItemUpdateOne) AddPreviousPrice(m money.Amount) *CartItemUpdateOne {
+	ciuo.mutation.AddPreviousPrice(m)
+	return ciuo
+}
+
+// ClearPreviousPrice clears the value of the "previous_price" field.
+func (ciuo *CartItemUpdateOne) ClearPreviousPrice() *CartItemUpdateOne {
+	ciuo.mutation.ClearPreviousPrice()
+	return ciuo
+}
+
+// SetPreviousQuantity sets the "previous_quantity" field.
+func (ciuo *CartItemUpdateOne) SetPreviousQuantity(i int) *CartItemUpdateOne {
+	ciuo.mutation.ResetPreviousQuantity()
+	ciuo.mutation.SetPreviousQuantity(i)
+	return ciuo
+}
+
+// SetNillablePreviousQuantity sets the "previous_quantity" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillablePreviousQuantity(i *int) *CartItemUpdateOne {
+	if i != nil {
+		ciuo.SetPreviousQuantity(*i)
+	}
+	return ciuo
+}
+
+// AddPreviousQuantity adds i to the "previous_quantity" field.
+func (ciuo *CartItemUpdateOne) AddPreviousQuantity(i int) *CartItemUpdateOne {
+	ciuo.mutation.AddPreviousQuantity(i)
+	return ciuo
+}
+
+// ClearPreviousQuantity clears the value of the "previous_quantity" field.
+func (ciuo *CartItemUpdateOne) ClearPreviousQuantity() *CartItemUpdateOne {
+	ciuo.mutation.ClearPreviousQuantity()
+	return ciuo
+}
+
+// SetUnavailableReason sets the "unavailable_reason" field.
+func (ciuo *CartItemUpdateOne) SetUnavailableReason(cr cartitem.UnavailableReason) *CartItemUpdateOne {
+	ciuo.mutation.SetUnavailableReason(cr)
+	return ciuo
+}
+
+// SetNillableUnavailableReason sets the "unavailable_reason" field if the given value is not nil.
+func (ciuo *CartItemUpdateOne) SetNillableUnavailableReason(cr *cartitem.UnavailableReason) *CartItemUpdateOne {
+	if cr != nil {
+		ciuo.SetUnavailableReason(*cr)
+	}
+	return ciuo
+}
+
+// ClearUnavailableReason clears the value of the "unavailable_reason" field.
+func (ciuo *CartItemUpdateOne) ClearUnavailableReason() *CartItemUpdateOne {
+	ciuo.mutation.ClearUnavailableReason()
+	return ciuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ciuo *CartItemUpdateOne) SetCreatedAt(t time.Time) *CartItemUpdateOne {
 	ciuo.mutation.SetCreatedAt(t)
@@ -502,6 +791,11 @@ func (ciuo *CartItemUpdateOne) SetProduct(p *Product) *CartItemUpdateOne {
 	return ciuo.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (ciuo *CartItemUpdateOne) SetVariant(p *ProductVariant) *CartItemUpdateOne {
+	return ciuo.SetVariantID(p.ID)
+}
+
 // Mutation returns the CartItemMutation object of the builder.
 func (ciuo *CartItemUpdateOne) Mutation() *CartItemMutation {
 	return ciuo.mutation
@@ -519,6 +813,12 @@ func (ciuo *CartItemUpdateOne) ClearProduct() *CartItemUpdateOne {
 	return ciuo
 }
 
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (ciuo *CartItemUpdateOne) ClearVariant() *CartItemUpdateOne {
+	ciuo.mutation.ClearVariant()
+	return ciuo
+}
+
 // Where appends a list predicates to the CartItemUpdate builder.
 func (ciuo *CartItemUpdateOne) Where(ps ...predicate.CartItem) *CartItemUpdateOne {
 	ciuo.mutation.Where(ps...)
@@ -576,7 +876,7 @@ func (ciuo *CartItemUpdateOne) check() error {
 		}
 	}
 	if v, ok := ciuo.mutation.Price(); ok {
-		if err := cartitem.PriceValidator(v); err != nil {
+		if err := cartitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "CartItem.price": %w`, err)}
 		}
 	}
@@ -590,6 +890,11 @@ func (ciuo *CartItemUpdateOne) check() error {
 			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "CartItem.quantity": %w`, err)}
 		}
 	}
+	if v, ok := ciuo.mutation.UnavailableReason(); ok {
+		if err := cartitem.UnavailableReasonValidator(v); err != nil {
+			return &ValidationError{Name: "unavailable_reason", err: fmt.Errorf(`ent: validator failed for field "CartItem.unavailable_reason": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -622,14 +927,20 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 			}
 		}
 	}
+	if value, ok := ciuo.mutation.VariantOptions(); ok {
+		_spec.SetField(cartitem.FieldVariantOptions, field.TypeJSON, value)
+	}
+	if ciuo.mutation.VariantOptionsCleared() {
+		_spec.ClearField(cartitem.FieldVariantOptions, field.TypeJSON)
+	}
 	if value, ok := ciuo.mutation.Name(); ok {
 		_spec.SetField(cartitem.FieldName, field.TypeString, value)
 	}
 	if value, ok := ciuo.mutation.Price(); ok {
-		_spec.SetField(cartitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(cartitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := ciuo.mutation.AddedPrice(); ok {
-		_spec.AddField(cartitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(cartitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := ciuo.mutation.Image(); ok {
 		_spec.SetField(cartitem.FieldImage, field.TypeString, value)
@@ -640,6 +951,30 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 	if value, ok := ciuo.mutation.AddedQuantity(); ok {
 		_spec.AddField(cartitem.FieldQuantity, field.TypeInt, value)
 	}
+	if value, ok := ciuo.mutation.PreviousPrice(); ok {
+		_spec.SetField(cartitem.FieldPreviousPrice, field.TypeInt64, value)
+	}
+	if value, ok := ciuo.mutation.AddedPreviousPrice(); ok {
+		_spec.AddField(cartitem.FieldPreviousPrice, field.TypeInt64, value)
+	}
+	if ciuo.mutation.PreviousPriceCleared() {
+		_spec.ClearField(cartitem.FieldPreviousPrice, field.TypeInt64)
+	}
+	if value, ok := ciuo.mutation.PreviousQuantity(); ok {
+		_spec.SetField(cartitem.FieldPreviousQuantity, field.TypeInt, value)
+	}
+	if value, ok := ciuo.mutation.AddedPreviousQuantity(); ok {
+		_spec.AddField(cartitem.FieldPreviousQuantity, field.TypeInt, value)
+	}
+	if ciuo.mutation.PreviousQuantityCleared() {
+		_spec.ClearField(cartitem.FieldPreviousQuantity, field.TypeInt)
+	}
+	if value, ok := ciuo.mutation.UnavailableReason(); ok {
+		_spec.SetField(cartitem.FieldUnavailableReason, field.TypeEnum, value)
+	}
+	if ciuo.mutation.UnavailableReasonCleared() {
+		_spec.ClearField(cartitem.FieldUnavailableReason, field.TypeEnum)
+	}
 	if value, ok := ciuo.mutation.CreatedAt(); ok {
 		_spec.SetField(cartitem.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -704,6 +1039,35 @@ func (ciuo *CartItemUpdateOne) sqlSave(ctx context.Context) (_node *CartItem, er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ciuo.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartitem.VariantTable,
+			Columns: []string{cartitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ciuo.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cartitem.VariantTable,
+			Columns: []string{cartitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &CartItem{config: ciuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Coupon is the model entity for the Coupon schema.
@@ -19,20 +20,20 @@ type Coupon struct {
 	ID string `json:"id,omitempty"`
 	// Code holds the value of the "code" field.
 	Code string `json:"code,omitempty"`
-	// Type holds the value of the "type" field.
-	Type coupon.Type `json:"type,omitempty"`
-	// Value holds the value of the "value" field.
-	Value float64 `json:"value,omitempty"`
+	// DiscountType holds the value of the "discount_type" field.
+	DiscountType coupon.DiscountType `json:"discount_type,omitempty"`
+	// DiscountValue holds the value of the "discount_value" field.
+	DiscountValue money.Amount `json:"discount_value,omitempty"`
 	// MinPurchase holds the value of the "min_purchase" field.
-	MinPurchase float64 `json:"min_purchase,omitempty"`
-	// UsageLimit holds the value of the "usage_limit" field.
-	UsageLimit int `json:"usage_limit,omitempty"`
-	// UsedCount holds the value of the "used_count" field.
-	UsedCount int `json:"used_count,omitempty"`
+	MinPurchase money.Amount `json:"min_purchase,omitempty"`
 	// ExpiresAt holds the value of the "expires_at" field.
 	ExpiresAt time.Time `json:"expires_at,omitempty"`
-	// Active holds the value of the "active" field.
-	Active bool `json:"active,omitempty"`
+	// IsActive holds the value of the "is_active" field.
+	IsActive bool `json:"is_active,omitempty"`
+	// MaxUses holds the value of the "max_uses" field.
+	MaxUses int `json:"max_uses,omitempty"`
+	// TimesUsed holds the value of the "times_used" field.
+	TimesUsed int `json:"times_used,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -45,13 +46,13 @@ func (*Coupon) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case coupon.FieldActive:
+		case coupon.FieldDiscountValue, coupon.FieldMinPurchase:
+			values[i] = new(money.Amount)
+		case coupon.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case coupon.FieldValue, coupon.FieldMinPurchase:
-			values[i] = new(sql.NullFloat64)
-		case coupon.FieldUsageLimit, coupon.FieldUsedCount:
+		case coupon.FieldMaxUses, coupon.FieldTimesUsed:
 			values[i] = new(sql.NullInt64)
-		case coupon.FieldID, coupon.FieldCode, coupon.FieldType:
+		case coupon.FieldID, coupon.FieldCode, coupon.FieldDiscountType:
 			values[i] = new(sql.NullString)
 		case coupon.FieldExpiresAt, coupon.FieldCreatedAt, coupon.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -82,35 +83,23 @@ func (c *Coupon) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.Code = value.String
 			}
-		case coupon.FieldType:
+		case coupon.FieldDiscountType:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field type", values[i])
+				return fmt.Errorf("unexpected type %T for field discount_type", values[i])
 			} else if value.Valid {
-				c.Type = coupon.Type(value.String)
+				c.DiscountType = coupon.DiscountType(value.String)
 			}
-		case coupon.FieldValue:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
-				return fmt.Errorf("unexpected type %T for field value", values[i])
-			} else if value.Valid {
-				c.Value = value.Float64
+		case coupon.FieldDiscountValue:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field discount_value", values[i])
+			} else if value != nil {
+				c.DiscountValue = *value
 			}
 		case coupon.FieldMinPurchase:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field min_purchase", values[i])
-			} else if value.Valid {
-				c.MinPurchase = value.Float64
-			}
-		case coupon.FieldUsageLimit:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field usage_limit", values[i])
-			} else if value.Valid {
-				c.UsageLimit = int(value.Int64)
-			}
-		case coupon.FieldUsedCount:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field used_count", values[i])
-			} else if value.Valid {
-				c.UsedCount = int(value.Int64)
+			} else if value != nil {
+				c.MinPurchase = *value
 			}
 		case coupon.FieldExpiresAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -118,11 +107,23 @@ func (c *Coupon) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.ExpiresAt = value.Time
 			}
-		case coupon.FieldActive:
+		case coupon.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
-				return fmt.Errorf("unexpected type %T for field active", values[i])
+				return fmt.Errorf("unexpected type %T for field is_active", values[i])
+			} else if value.Valid {
+				c.IsActive = value.Bool
+			}
+		case coupon.FieldMaxUses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_uses", values[i])
+			} else if value.Valid {
+				c.MaxUses = int(value.Int64)
+			}
+		case coupon.FieldTimesUsed:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field times_used", values[i])
 			} else if value.Valid {
-				c.Active = value.Bool
+				c.TimesUsed = int(value.Int64)
 			}
 		case coupon.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -143,9 +144,9 @@ func (c *Coupon) assignValues(columns []string, values []any) error {
 	return nil
 }
 
-// GetValue returns the ent.Value that was dynamically selected and assigned to the Coupon.
+// Value returns the ent.Value that was dynamically selected and assigned to the Coupon.
 // This includes values selected through modifiers, order, etc.
-func (c *Coupon) GetValue(name string) (ent.Value, error) {
+func (c *Coupon) Value(name string) (ent.Value, error) {
 	return c.selectValues.Get(name)
 }
 
@@ -175,26 +176,26 @@ func (c *Coupon) String() string {
 	builder.WriteString("code=")
 	builder.WriteString(c.Code)
 	builder.WriteString(", ")
-	builder.WriteString("type=")
-	builder.WriteString(fmt.Sprintf("%v", c.Type))
+	builder.WriteString("discount_type=")
+	builder.WriteString(fmt.Sprintf("%v", c.DiscountType))
 	builder.WriteString(", ")
-	builder.WriteString("value=")
-	builder.WriteString(fmt.Sprintf("%v", c.Value))
+	builder.WriteString("discount_value=")
+	builder.WriteString(fmt.Sprintf("%v", c.DiscountValue))
 	builder.WriteString(", ")
 	builder.WriteString("min_purchase=")
 	builder.WriteString(fmt.Sprintf("%v", c.MinPurchase))
 	builder.WriteString(", ")
-	builder.WriteString("usage_limit=")
-	builder.WriteString(fmt.Sprintf("%v", c.UsageLimit))
-	builder.WriteString(", ")
-	builder.WriteString("used_count=")
-	builder.WriteString(fmt.Sprintf("%v", c.UsedCount))
-	builder.WriteString(", ")
 	builder.WriteString("expires_at=")
 	builder.WriteString(c.ExpiresAt.Format(time.ANSIC))
 	builder.WriteString(", ")
-	builder.WriteString("active=")
-	builder.WriteString(fmt.Sprintf("%v", c.Active))
+	builder.WriteString("is_active=")
+	builder.WriteString(fmt.Sprintf("%v", c.IsActive))
+	builder.WriteString(", ")
+	builder.WriteString("max_uses=")
+	builder.WriteString(fmt.Sprintf("%v", c.MaxUses))
+	builder.WriteString(", ")
+	builder.WriteString("times_used=")
+	builder.WriteString(fmt.Sprintf("%v", c.TimesUsed))
 	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
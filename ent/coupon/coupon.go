@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 const (
@@ -16,20 +17,20 @@ const (
 	FieldID = "id"
 	// FieldCode holds the string denoting the code field in the database.
 	FieldCode = "code"
-	// FieldType holds the string denoting the type field in the database.
-	FieldType = "type"
-	// FieldValue holds the string denoting the value field in the database.
-	FieldValue = "value"
+	// FieldDiscountType holds the string denoting the discount_type field in the database.
+	FieldDiscountType = "discount_type"
+	// FieldDiscountValue holds the string denoting the discount_value field in the database.
+	FieldDiscountValue = "discount_value"
 	// FieldMinPurchase holds the string denoting the min_purchase field in the database.
 	FieldMinPurchase = "min_purchase"
-	// FieldUsageLimit holds the string denoting the usage_limit field in the database.
-	FieldUsageLimit = "usage_limit"
-	// FieldUsedCount holds the string denoting the used_count field in the database.
-	FieldUsedCount = "used_count"
 	// FieldExpiresAt holds the string denoting the expires_at field in the database.
 	FieldExpiresAt = "expires_at"
-	// FieldActive holds the string denoting the active field in the database.
-	FieldActive = "active"
+	// FieldIsActive holds the string denoting the is_active field in the database.
+	FieldIsActive = "is_active"
+	// FieldMaxUses holds the string denoting the max_uses field in the database.
+	FieldMaxUses = "max_uses"
+	// FieldTimesUsed holds the string denoting the times_used field in the database.
+	FieldTimesUsed = "times_used"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -42,13 +43,13 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldCode,
-	FieldType,
-	FieldValue,
+	FieldDiscountType,
+	FieldDiscountValue,
 	FieldMinPurchase,
-	FieldUsageLimit,
-	FieldUsedCount,
 	FieldExpiresAt,
-	FieldActive,
+	FieldIsActive,
+	FieldMaxUses,
+	FieldTimesUsed,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -66,12 +67,14 @@ func ValidColumn(column string) bool {
 var (
 	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	CodeValidator func(string) error
-	// ValueValidator is a validator for the "value" field. It is called by the builders before save.
-	ValueValidator func(float64) error
-	// DefaultUsedCount holds the default value on creation for the "used_count" field.
-	DefaultUsedCount int
-	// DefaultActive holds the default value on creation for the "active" field.
-	DefaultActive bool
+	// DiscountValueValidator is a validator for the "discount_value" field. It is called by the builders before save.
+	DiscountValueValidator func(int64) error
+	// DefaultMinPurchase holds the default value on creation for the "min_purchase" field.
+	DefaultMinPurchase money.Amount
+	// DefaultIsActive holds the default value on creation for the "is_active" field.
+	DefaultIsActive bool
+	// DefaultTimesUsed holds the default value on creation for the "times_used" field.
+	DefaultTimesUsed int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -80,26 +83,26 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 )
 
-// Type defines the type for the "type" enum field.
-type Type string
+// DiscountType defines the type for the "discount_type" enum field.
+type DiscountType string
 
-// Type values.
+// DiscountType values.
 const (
-	TypePercentage Type = "percentage"
-	TypeFixed      Type = "fixed"
+	DiscountTypePercentage DiscountType = "percentage"
+	DiscountTypeFixed      DiscountType = "fixed"
 )
 
-func (_type Type) String() string {
-	return string(_type)
+func (dt DiscountType) String() string {
+	return string(dt)
 }
 
-// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
-func TypeValidator(_type Type) error {
-	switch _type {
-	case TypePercentage, TypeFixed:
+// DiscountTypeValidator is a validator for the "discount_type" field enum values. It is called by the builders before save.
+func DiscountTypeValidator(dt DiscountType) error {
+	switch dt {
+	case DiscountTypePercentage, DiscountTypeFixed:
 		return nil
 	default:
-		return fmt.Errorf("coupon: invalid enum value for type field: %q", _type)
+		return fmt.Errorf("coupon: invalid enum value for discount_type field: %q", dt)
 	}
 }
 
@@ -116,14 +119,14 @@ func ByCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCode, opts...).ToFunc()
 }
 
-// ByType orders the results by the type field.
-func ByType(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldType, opts...).ToFunc()
+// ByDiscountType orders the results by the discount_type field.
+func ByDiscountType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountType, opts...).ToFunc()
 }
 
-// ByValue orders the results by the value field.
-func ByValue(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldValue, opts...).ToFunc()
+// ByDiscountValue orders the results by the discount_value field.
+func ByDiscountValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDiscountValue, opts...).ToFunc()
 }
 
 // ByMinPurchase orders the results by the min_purchase field.
@@ -131,24 +134,24 @@ func ByMinPurchase(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMinPurchase, opts...).ToFunc()
 }
 
-// ByUsageLimit orders the results by the usage_limit field.
-func ByUsageLimit(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUsageLimit, opts...).ToFunc()
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
 }
 
-// ByUsedCount orders the results by the used_count field.
-func ByUsedCount(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldUsedCount, opts...).ToFunc()
+// ByIsActive orders the results by the is_active field.
+func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
-// ByExpiresAt orders the results by the expires_at field.
-func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+// ByMaxUses orders the results by the max_uses field.
+func ByMaxUses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxUses, opts...).ToFunc()
 }
 
-// ByActive orders the results by the active field.
-func ByActive(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldActive, opts...).ToFunc()
+// ByTimesUsed orders the results by the times_used field.
+func ByTimesUsed(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimesUsed, opts...).ToFunc()
 }
 
 // ByCreatedAt orders the results by the created_at field.
@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -69,34 +70,34 @@ func Code(v string) predicate.Coupon {
 	return predicate.Coupon(sql.FieldEQ(FieldCode, v))
 }
 
-// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
-func Value(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldValue, v))
+// DiscountValue applies equality check predicate on the "discount_value" field. It's identical to DiscountValueEQ.
+func DiscountValue(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountValue, v))
 }
 
 // MinPurchase applies equality check predicate on the "min_purchase" field. It's identical to MinPurchaseEQ.
-func MinPurchase(v float64) predicate.Coupon {
+func MinPurchase(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldEQ(FieldMinPurchase, v))
 }
 
-// UsageLimit applies equality check predicate on the "usage_limit" field. It's identical to UsageLimitEQ.
-func UsageLimit(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldUsageLimit, v))
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldExpiresAt, v))
 }
 
-// UsedCount applies equality check predicate on the "used_count" field. It's identical to UsedCountEQ.
-func UsedCount(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldUsedCount, v))
+// IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
+func IsActive(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldIsActive, v))
 }
 
-// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
-func ExpiresAt(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldExpiresAt, v))
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxUses, v))
 }
 
-// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
-func Active(v bool) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldActive, v))
+// TimesUsed applies equality check predicate on the "times_used" field. It's identical to TimesUsedEQ.
+func TimesUsed(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTimesUsed, v))
 }
 
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
@@ -174,264 +175,254 @@ func CodeContainsFold(v string) predicate.Coupon {
 	return predicate.Coupon(sql.FieldContainsFold(FieldCode, v))
 }
 
-// TypeEQ applies the EQ predicate on the "type" field.
-func TypeEQ(v Type) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldType, v))
+// DiscountTypeEQ applies the EQ predicate on the "discount_type" field.
+func DiscountTypeEQ(v DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountType, v))
 }
 
-// TypeNEQ applies the NEQ predicate on the "type" field.
-func TypeNEQ(v Type) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldType, v))
+// DiscountTypeNEQ applies the NEQ predicate on the "discount_type" field.
+func DiscountTypeNEQ(v DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDiscountType, v))
 }
 
-// TypeIn applies the In predicate on the "type" field.
-func TypeIn(vs ...Type) predicate.Coupon {
-	return predicate.Coupon(sql.FieldIn(FieldType, vs...))
+// DiscountTypeIn applies the In predicate on the "discount_type" field.
+func DiscountTypeIn(vs ...DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDiscountType, vs...))
 }
 
-// TypeNotIn applies the NotIn predicate on the "type" field.
-func TypeNotIn(vs ...Type) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotIn(FieldType, vs...))
+// DiscountTypeNotIn applies the NotIn predicate on the "discount_type" field.
+func DiscountTypeNotIn(vs ...DiscountType) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDiscountType, vs...))
 }
 
-// ValueEQ applies the EQ predicate on the "value" field.
-func ValueEQ(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldValue, v))
+// DiscountValueEQ applies the EQ predicate on the "discount_value" field.
+func DiscountValueEQ(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldDiscountValue, v))
 }
 
-// ValueNEQ applies the NEQ predicate on the "value" field.
-func ValueNEQ(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldValue, v))
+// DiscountValueNEQ applies the NEQ predicate on the "discount_value" field.
+func DiscountValueNEQ(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldDiscountValue, v))
 }
 
-// ValueIn applies the In predicate on the "value" field.
-func ValueIn(vs ...float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldIn(FieldValue, vs...))
+// DiscountValueIn applies the In predicate on the "discount_value" field.
+func DiscountValueIn(vs ...money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldDiscountValue, vs...))
 }
 
-// ValueNotIn applies the NotIn predicate on the "value" field.
-func ValueNotIn(vs ...float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotIn(FieldValue, vs...))
+// DiscountValueNotIn applies the NotIn predicate on the "discount_value" field.
+func DiscountValueNotIn(vs ...money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldDiscountValue, vs...))
 }
 
-// ValueGT applies the GT predicate on the "value" field.
-func ValueGT(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGT(FieldValue, v))
+// DiscountValueGT applies the GT predicate on the "discount_value" field.
+func DiscountValueGT(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldDiscountValue, v))
 }
 
-// ValueGTE applies the GTE predicate on the "value" field.
-func ValueGTE(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGTE(FieldValue, v))
+// DiscountValueGTE applies the GTE predicate on the "discount_value" field.
+func DiscountValueGTE(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldDiscountValue, v))
 }
 
-// ValueLT applies the LT predicate on the "value" field.
-func ValueLT(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLT(FieldValue, v))
+// DiscountValueLT applies the LT predicate on the "discount_value" field.
+func DiscountValueLT(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldDiscountValue, v))
 }
 
-// ValueLTE applies the LTE predicate on the "value" field.
-func ValueLTE(v float64) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLTE(FieldValue, v))
+// DiscountValueLTE applies the LTE predicate on the "discount_value" field.
+func DiscountValueLTE(v money.Amount) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldDiscountValue, v))
 }
 
 // MinPurchaseEQ applies the EQ predicate on the "min_purchase" field.
-func MinPurchaseEQ(v float64) predicate.Coupon {
+func MinPurchaseEQ(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldEQ(FieldMinPurchase, v))
 }
 
 // MinPurchaseNEQ applies the NEQ predicate on the "min_purchase" field.
-func MinPurchaseNEQ(v float64) predicate.Coupon {
+func MinPurchaseNEQ(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldNEQ(FieldMinPurchase, v))
 }
 
 // MinPurchaseIn applies the In predicate on the "min_purchase" field.
-func MinPurchaseIn(vs ...float64) predicate.Coupon {
+func MinPurchaseIn(vs ...money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldIn(FieldMinPurchase, vs...))
 }
 
 // MinPurchaseNotIn applies the NotIn predicate on the "min_purchase" field.
-func MinPurchaseNotIn(vs ...float64) predicate.Coupon {
+func MinPurchaseNotIn(vs ...money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldNotIn(FieldMinPurchase, vs...))
 }
 
 // MinPurchaseGT applies the GT predicate on the "min_purchase" field.
-func MinPurchaseGT(v float64) predicate.Coupon {
+func MinPurchaseGT(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldGT(FieldMinPurchase, v))
 }
 
 // MinPurchaseGTE applies the GTE predicate on the "min_purchase" field.
-func MinPurchaseGTE(v float64) predicate.Coupon {
+func MinPurchaseGTE(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldGTE(FieldMinPurchase, v))
 }
 
 // MinPurchaseLT applies the LT predicate on the "min_purchase" field.
-func MinPurchaseLT(v float64) predicate.Coupon {
+func MinPurchaseLT(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldLT(FieldMinPurchase, v))
 }
 
 // MinPurchaseLTE applies the LTE predicate on the "min_purchase" field.
-func MinPurchaseLTE(v float64) predicate.Coupon {
+func MinPurchaseLTE(v money.Amount) predicate.Coupon {
 	return predicate.Coupon(sql.FieldLTE(FieldMinPurchase, v))
 }
 
-// MinPurchaseIsNil applies the IsNil predicate on the "min_purchase" field.
-func MinPurchaseIsNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldIsNull(FieldMinPurchase))
-}
-
-// MinPurchaseNotNil applies the NotNil predicate on the "min_purchase" field.
-func MinPurchaseNotNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotNull(FieldMinPurchase))
-}
-
-// UsageLimitEQ applies the EQ predicate on the "usage_limit" field.
-func UsageLimitEQ(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldUsageLimit, v))
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldExpiresAt, v))
 }
 
-// UsageLimitNEQ applies the NEQ predicate on the "usage_limit" field.
-func UsageLimitNEQ(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldUsageLimit, v))
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldExpiresAt, v))
 }
 
-// UsageLimitIn applies the In predicate on the "usage_limit" field.
-func UsageLimitIn(vs ...int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldIn(FieldUsageLimit, vs...))
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldExpiresAt, vs...))
 }
 
-// UsageLimitNotIn applies the NotIn predicate on the "usage_limit" field.
-func UsageLimitNotIn(vs ...int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotIn(FieldUsageLimit, vs...))
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldExpiresAt, vs...))
 }
 
-// UsageLimitGT applies the GT predicate on the "usage_limit" field.
-func UsageLimitGT(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGT(FieldUsageLimit, v))
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldExpiresAt, v))
 }
 
-// UsageLimitGTE applies the GTE predicate on the "usage_limit" field.
-func UsageLimitGTE(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGTE(FieldUsageLimit, v))
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldExpiresAt, v))
 }
 
-// UsageLimitLT applies the LT predicate on the "usage_limit" field.
-func UsageLimitLT(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLT(FieldUsageLimit, v))
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldExpiresAt, v))
 }
 
-// UsageLimitLTE applies the LTE predicate on the "usage_limit" field.
-func UsageLimitLTE(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLTE(FieldUsageLimit, v))
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldExpiresAt, v))
 }
 
-// UsageLimitIsNil applies the IsNil predicate on the "usage_limit" field.
-func UsageLimitIsNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldIsNull(FieldUsageLimit))
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldExpiresAt))
 }
 
-// UsageLimitNotNil applies the NotNil predicate on the "usage_limit" field.
-func UsageLimitNotNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotNull(FieldUsageLimit))
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldExpiresAt))
 }
 
-// UsedCountEQ applies the EQ predicate on the "used_count" field.
-func UsedCountEQ(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldUsedCount, v))
+// IsActiveEQ applies the EQ predicate on the "is_active" field.
+func IsActiveEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldIsActive, v))
 }
 
-// UsedCountNEQ applies the NEQ predicate on the "used_count" field.
-func UsedCountNEQ(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldUsedCount, v))
+// IsActiveNEQ applies the NEQ predicate on the "is_active" field.
+func IsActiveNEQ(v bool) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldIsActive, v))
 }
 
-// UsedCountIn applies the In predicate on the "used_count" field.
-func UsedCountIn(vs ...int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldIn(FieldUsedCount, vs...))
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldMaxUses, v))
 }
 
-// UsedCountNotIn applies the NotIn predicate on the "used_count" field.
-func UsedCountNotIn(vs ...int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotIn(FieldUsedCount, vs...))
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldMaxUses, v))
 }
 
-// UsedCountGT applies the GT predicate on the "used_count" field.
-func UsedCountGT(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGT(FieldUsedCount, v))
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldMaxUses, vs...))
 }
 
-// UsedCountGTE applies the GTE predicate on the "used_count" field.
-func UsedCountGTE(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGTE(FieldUsedCount, v))
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldMaxUses, vs...))
 }
 
-// UsedCountLT applies the LT predicate on the "used_count" field.
-func UsedCountLT(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLT(FieldUsedCount, v))
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldMaxUses, v))
 }
 
-// UsedCountLTE applies the LTE predicate on the "used_count" field.
-func UsedCountLTE(v int) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLTE(FieldUsedCount, v))
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldMaxUses, v))
 }
 
-// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
-func ExpiresAtEQ(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldExpiresAt, v))
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldMaxUses, v))
 }
 
-// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
-func ExpiresAtNEQ(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldExpiresAt, v))
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldMaxUses, v))
 }
 
-// ExpiresAtIn applies the In predicate on the "expires_at" field.
-func ExpiresAtIn(vs ...time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldIn(FieldExpiresAt, vs...))
+// MaxUsesIsNil applies the IsNil predicate on the "max_uses" field.
+func MaxUsesIsNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldIsNull(FieldMaxUses))
 }
 
-// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
-func ExpiresAtNotIn(vs ...time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotIn(FieldExpiresAt, vs...))
+// MaxUsesNotNil applies the NotNil predicate on the "max_uses" field.
+func MaxUsesNotNil() predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotNull(FieldMaxUses))
 }
 
-// ExpiresAtGT applies the GT predicate on the "expires_at" field.
-func ExpiresAtGT(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGT(FieldExpiresAt, v))
+// TimesUsedEQ applies the EQ predicate on the "times_used" field.
+func TimesUsedEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldEQ(FieldTimesUsed, v))
 }
 
-// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
-func ExpiresAtGTE(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldGTE(FieldExpiresAt, v))
+// TimesUsedNEQ applies the NEQ predicate on the "times_used" field.
+func TimesUsedNEQ(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNEQ(FieldTimesUsed, v))
 }
 
-// ExpiresAtLT applies the LT predicate on the "expires_at" field.
-func ExpiresAtLT(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLT(FieldExpiresAt, v))
+// TimesUsedIn applies the In predicate on the "times_used" field.
+func TimesUsedIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldIn(FieldTimesUsed, vs...))
 }
 
-// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
-func ExpiresAtLTE(v time.Time) predicate.Coupon {
-	return predicate.Coupon(sql.FieldLTE(FieldExpiresAt, v))
+// TimesUsedNotIn applies the NotIn predicate on the "times_used" field.
+func TimesUsedNotIn(vs ...int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldNotIn(FieldTimesUsed, vs...))
 }
 
-// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
-func ExpiresAtIsNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldIsNull(FieldExpiresAt))
+// TimesUsedGT applies the GT predicate on the "times_used" field.
+func TimesUsedGT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGT(FieldTimesUsed, v))
 }
 
-// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
-func ExpiresAtNotNil() predicate.Coupon {
-	return predicate.Coupon(sql.FieldNotNull(FieldExpiresAt))
+// TimesUsedGTE applies the GTE predicate on the "times_used" field.
+func TimesUsedGTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldGTE(FieldTimesUsed, v))
 }
 
-// ActiveEQ applies the EQ predicate on the "active" field.
-func ActiveEQ(v bool) predicate.Coupon {
-	return predicate.Coupon(sql.FieldEQ(FieldActive, v))
+// TimesUsedLT applies the LT predicate on the "times_used" field.
+func TimesUsedLT(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLT(FieldTimesUsed, v))
 }
 
-// ActiveNEQ applies the NEQ predicate on the "active" field.
-func ActiveNEQ(v bool) predicate.Coupon {
-	return predicate.Coupon(sql.FieldNEQ(FieldActive, v))
+// TimesUsedLTE applies the LTE predicate on the "times_used" field.
+func TimesUsedLTE(v int) predicate.Coupon {
+	return predicate.Coupon(sql.FieldLTE(FieldTimesUsed, v))
 }
 
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CouponCreate is the builder for creating a Coupon entity.
@@ -26,84 +27,84 @@ func (cc *CouponCreate) SetCode(s string) *CouponCreate {
 	return cc
 }
 
-// SetType sets the "type" field.
-func (cc *CouponCreate) SetType(c coupon.Type) *CouponCreate {
-	cc.mutation.SetType(c)
+// SetDiscountType sets the "discount_type" field.
+func (cc *CouponCreate) SetDiscountType(ct coupon.DiscountType) *CouponCreate {
+	cc.mutation.SetDiscountType(ct)
 	return cc
 }
 
-// SetValue sets the "value" field.
-func (cc *CouponCreate) SetValue(f float64) *CouponCreate {
-	cc.mutation.SetValue(f)
+// SetDiscountValue sets the "discount_value" field.
+func (cc *CouponCreate) SetDiscountValue(m money.Amount) *CouponCreate {
+	cc.mutation.SetDiscountValue(m)
 	return cc
 }
 
 // SetMinPurchase sets the "min_purchase" field.
-func (cc *CouponCreate) SetMinPurchase(f float64) *CouponCreate {
-	cc.mutation.SetMinPurchase(f)
+func (cc *CouponCreate) SetMinPurchase(m money.Amount) *CouponCreate {
+	cc.mutation.SetMinPurchase(m)
 	return cc
 }
 
 // SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
-func (cc *CouponCreate) SetNillableMinPurchase(f *float64) *CouponCreate {
-	if f != nil {
-		cc.SetMinPurchase(*f)
+func (cc *CouponCreate) SetNillableMinPurchase(m *money.Amount) *CouponCreate {
+	if m != nil {
+		cc.SetMinPurchase(*m)
 	}
 	return cc
 }
 
-// SetUsageLimit sets the "usage_limit" field.
-func (cc *CouponCreate) SetUsageLimit(i int) *CouponCreate {
-	cc.mutation.SetUsageLimit(i)
+// SetExpiresAt sets the "expires_at" field.
+func (cc *CouponCreate) SetExpiresAt(t time.Time) *CouponCreate {
+	cc.mutation.SetExpiresAt(t)
 	return cc
 }
 
-// SetNillableUsageLimit sets the "usage_limit" field if the given value is not nil.
-func (cc *CouponCreate) SetNillableUsageLimit(i *int) *CouponCreate {
-	if i != nil {
-		cc.SetUsageLimit(*i)
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableExpiresAt(t *time.Time) *CouponCreate {
+	if t != nil {
+		cc.SetExpiresAt(*t)
 	}
 	return cc
 }
 
-// SetUsedCount sets the "used_count" field.
-func (cc *CouponCreate) SetUsedCount(i int) *CouponCreate {
-	cc.mutation.SetUsedCount(i)
+// SetIsActive sets the "is_active" field.
+func (cc *CouponCreate) SetIsActive(b bool) *CouponCreate {
+	cc.mutation.SetIsActive(b)
 	return cc
 }
 
-// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
-func (cc *CouponCreate) SetNillableUsedCount(i *int) *CouponCreate {
-	if i != nil {
-		cc.SetUsedCount(*i)
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableIsActive(b *bool) *CouponCreate {
+	if b != nil {
+		cc.SetIsActive(*b)
 	}
 	return cc
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (cc *CouponCreate) SetExpiresAt(t time.Time) *CouponCreate {
-	cc.mutation.SetExpiresAt(t)
+// SetMaxUses sets the "max_uses" field.
+func (cc *CouponCreate) SetMaxUses(i int) *CouponCreate {
+	cc.mutation.SetMaxUses(i)
 	return cc
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (cc *CouponCreate) SetNillableExpiresAt(t *time.Time) *CouponCreate {
-	if t != nil {
-		cc.SetExpiresAt(*t)
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableMaxUses(i *int) *CouponCreate {
+	if i != nil {
+		cc.SetMaxUses(*i)
 	}
 	return cc
 }
 
-// SetActive sets the "active" field.
-func (cc *CouponCreate) SetActive(b bool) *CouponCreate {
-	cc.mutation.SetActive(b)
+// SetTimesUsed sets the "times_used" field.
+func (cc *CouponCreate) SetTimesUsed(i int) *CouponCreate {
+	cc.mutation.SetTimesUsed(i)
 	return cc
 }
 
-// SetNillableActive sets the "active" field if the given value is not nil.
-func (cc *CouponCreate) SetNillableActive(b *bool) *CouponCreate {
-	if b != nil {
-		cc.SetActive(*b)
+// SetNillableTimesUsed sets the "times_used" field if the given value is not nil.
+func (cc *CouponCreate) SetNillableTimesUsed(i *int) *CouponCreate {
+	if i != nil {
+		cc.SetTimesUsed(*i)
 	}
 	return cc
 }
@@ -177,13 +178,17 @@ func (cc *CouponCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (cc *CouponCreate) defaults() {
-	if _, ok := cc.mutation.UsedCount(); !ok {
-		v := coupon.DefaultUsedCount
-		cc.mutation.SetUsedCount(v)
+	if _, ok := cc.mutation.MinPurchase(); !ok {
+		v := coupon.DefaultMinPurchase
+		cc.mutation.SetMinPurchase(v)
 	}
-	if _, ok := cc.mutation.Active(); !ok {
-		v := coupon.DefaultActive
-		cc.mutation.SetActive(v)
+	if _, ok := cc.mutation.IsActive(); !ok {
+		v := coupon.DefaultIsActive
+		cc.mutation.SetIsActive(v)
+	}
+	if _, ok := cc.mutation.TimesUsed(); !ok {
+		v := coupon.DefaultTimesUsed
+		cc.mutation.SetTimesUsed(v)
 	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		v := coupon.DefaultCreatedAt()
@@ -205,27 +210,30 @@ func (cc *CouponCreate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
 		}
 	}
-	if _, ok := cc.mutation.GetType(); !ok {
-		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "Coupon.type"`)}
+	if _, ok := cc.mutation.DiscountType(); !ok {
+		return &ValidationError{Name: "discount_type", err: errors.New(`ent: missing required field "Coupon.discount_type"`)}
 	}
-	if v, ok := cc.mutation.GetType(); ok {
-		if err := coupon.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+	if v, ok := cc.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
 		}
 	}
-	if _, ok := cc.mutation.Value(); !ok {
-		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Coupon.value"`)}
+	if _, ok := cc.mutation.DiscountValue(); !ok {
+		return &ValidationError{Name: "discount_value", err: errors.New(`ent: missing required field "Coupon.discount_value"`)}
 	}
-	if v, ok := cc.mutation.Value(); ok {
-		if err := coupon.ValueValidator(v); err != nil {
-			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Coupon.value": %w`, err)}
+	if v, ok := cc.mutation.DiscountValue(); ok {
+		if err := coupon.DiscountValueValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_value": %w`, err)}
 		}
 	}
-	if _, ok := cc.mutation.UsedCount(); !ok {
-		return &ValidationError{Name: "used_count", err: errors.New(`ent: missing required field "Coupon.used_count"`)}
+	if _, ok := cc.mutation.MinPurchase(); !ok {
+		return &ValidationError{Name: "min_purchase", err: errors.New(`ent: missing required field "Coupon.min_purchase"`)}
+	}
+	if _, ok := cc.mutation.IsActive(); !ok {
+		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Coupon.is_active"`)}
 	}
-	if _, ok := cc.mutation.Active(); !ok {
-		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Coupon.active"`)}
+	if _, ok := cc.mutation.TimesUsed(); !ok {
+		return &ValidationError{Name: "times_used", err: errors.New(`ent: missing required field "Coupon.times_used"`)}
 	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Coupon.created_at"`)}
@@ -272,33 +280,33 @@ func (cc *CouponCreate) createSpec() (*Coupon, *sqlgraph.CreateSpec) {
 		_spec.SetField(coupon.FieldCode, field.TypeString, value)
 		_node.Code = value
 	}
-	if value, ok := cc.mutation.GetType(); ok {
-		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
-		_node.Type = value
+	if value, ok := cc.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
+		_node.DiscountType = value
 	}
-	if value, ok := cc.mutation.Value(); ok {
-		_spec.SetField(coupon.FieldValue, field.TypeFloat64, value)
-		_node.Value = value
+	if value, ok := cc.mutation.DiscountValue(); ok {
+		_spec.SetField(coupon.FieldDiscountValue, field.TypeInt64, value)
+		_node.DiscountValue = value
 	}
 	if value, ok := cc.mutation.MinPurchase(); ok {
-		_spec.SetField(coupon.FieldMinPurchase, field.TypeFloat64, value)
+		_spec.SetField(coupon.FieldMinPurchase, field.TypeInt64, value)
 		_node.MinPurchase = value
 	}
-	if value, ok := cc.mutation.UsageLimit(); ok {
-		_spec.SetField(coupon.FieldUsageLimit, field.TypeInt, value)
-		_node.UsageLimit = value
-	}
-	if value, ok := cc.mutation.UsedCount(); ok {
-		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
-		_node.UsedCount = value
-	}
 	if value, ok := cc.mutation.ExpiresAt(); ok {
 		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
 		_node.ExpiresAt = value
 	}
-	if value, ok := cc.mutation.Active(); ok {
-		_spec.SetField(coupon.FieldActive, field.TypeBool, value)
-		_node.Active = value
+	if value, ok := cc.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
+		_node.IsActive = value
+	}
+	if value, ok := cc.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := cc.mutation.TimesUsed(); ok {
+		_spec.SetField(coupon.FieldTimesUsed, field.TypeInt, value)
+		_node.TimesUsed = value
 	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
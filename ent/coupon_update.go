@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/vtrod/veecomm-api/ent/coupon"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CouponUpdate is the builder for updating Coupon entities.
@@ -42,147 +43,141 @@ func (cu *CouponUpdate) SetNillableCode(s *string) *CouponUpdate {
 	return cu
 }
 
-// SetType sets the "type" field.
-func (cu *CouponUpdate) SetType(c coupon.Type) *CouponUpdate {
-	cu.mutation.SetType(c)
+// SetDiscountType sets the "discount_type" field.
+func (cu *CouponUpdate) SetDiscountType(ct coupon.DiscountType) *CouponUpdate {
+	cu.mutation.SetDiscountType(ct)
 	return cu
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableType(c *coupon.Type) *CouponUpdate {
-	if c != nil {
-		cu.SetType(*c)
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableDiscountType(ct *coupon.DiscountType) *CouponUpdate {
+	if ct != nil {
+		cu.SetDiscountType(*ct)
 	}
 	return cu
 }
 
-// SetValue sets the "value" field.
-func (cu *CouponUpdate) SetValue(f float64) *CouponUpdate {
-	cu.mutation.ResetValue()
-	cu.mutation.SetValue(f)
+// SetDiscountValue sets the "discount_value" field.
+func (cu *CouponUpdate) SetDiscountValue(m money.Amount) *CouponUpdate {
+	cu.mutation.ResetDiscountValue()
+	cu.mutation.SetDiscountValue(m)
 	return cu
 }
 
-// SetNillableValue sets the "value" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableValue(f *float64) *CouponUpdate {
-	if f != nil {
-		cu.SetValue(*f)
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableDiscountValue(m *money.Amount) *CouponUpdate {
+	if m != nil {
+		cu.SetDiscountValue(*m)
 	}
 	return cu
 }
 
-// AddValue adds f to the "value" field.
-func (cu *CouponUpdate) AddValue(f float64) *CouponUpdate {
-	cu.mutation.AddValue(f)
+// AddDiscountValue adds m to the "discount_value" field.
+func (cu *CouponUpdate) AddDiscountValue(m money.Amount) *CouponUpdate {
+	cu.mutation.AddDiscountValue(m)
 	return cu
 }
 
 // SetMinPurchase sets the "min_purchase" field.
-func (cu *CouponUpdate) SetMinPurchase(f float64) *CouponUpdate {
+func (cu *CouponUpdate) SetMinPurchase(m money.Amount) *CouponUpdate {
 	cu.mutation.ResetMinPurchase()
-	cu.mutation.SetMinPurchase(f)
+	cu.mutation.SetMinPurchase(m)
 	return cu
 }
 
 // SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableMinPurchase(f *float64) *CouponUpdate {
-	if f != nil {
-		cu.SetMinPurchase(*f)
+func (cu *CouponUpdate) SetNillableMinPurchase(m *money.Amount) *CouponUpdate {
+	if m != nil {
+		cu.SetMinPurchase(*m)
 	}
 	return cu
 }
 
-// AddMinPurchase adds f to the "min_purchase" field.
-func (cu *CouponUpdate) AddMinPurchase(f float64) *CouponUpdate {
-	cu.mutation.AddMinPurchase(f)
+// AddMinPurchase adds m to the "min_purchase" field.
+func (cu *CouponUpdate) AddMinPurchase(m money.Amount) *CouponUpdate {
+	cu.mutation.AddMinPurchase(m)
 	return cu
 }
 
-// ClearMinPurchase clears the value of the "min_purchase" field.
-func (cu *CouponUpdate) ClearMinPurchase() *CouponUpdate {
-	cu.mutation.ClearMinPurchase()
+// SetExpiresAt sets the "expires_at" field.
+func (cu *CouponUpdate) SetExpiresAt(t time.Time) *CouponUpdate {
+	cu.mutation.SetExpiresAt(t)
 	return cu
 }
 
-// SetUsageLimit sets the "usage_limit" field.
-func (cu *CouponUpdate) SetUsageLimit(i int) *CouponUpdate {
-	cu.mutation.ResetUsageLimit()
-	cu.mutation.SetUsageLimit(i)
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableExpiresAt(t *time.Time) *CouponUpdate {
+	if t != nil {
+		cu.SetExpiresAt(*t)
+	}
 	return cu
 }
 
-// SetNillableUsageLimit sets the "usage_limit" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableUsageLimit(i *int) *CouponUpdate {
-	if i != nil {
-		cu.SetUsageLimit(*i)
-	}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cu *CouponUpdate) ClearExpiresAt() *CouponUpdate {
+	cu.mutation.ClearExpiresAt()
 	return cu
 }
 
-// AddUsageLimit adds i to the "usage_limit" field.
-func (cu *CouponUpdate) AddUsageLimit(i int) *CouponUpdate {
-	cu.mutation.AddUsageLimit(i)
+// SetIsActive sets the "is_active" field.
+func (cu *CouponUpdate) SetIsActive(b bool) *CouponUpdate {
+	cu.mutation.SetIsActive(b)
 	return cu
 }
 
-// ClearUsageLimit clears the value of the "usage_limit" field.
-func (cu *CouponUpdate) ClearUsageLimit() *CouponUpdate {
-	cu.mutation.ClearUsageLimit()
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableIsActive(b *bool) *CouponUpdate {
+	if b != nil {
+		cu.SetIsActive(*b)
+	}
 	return cu
 }
 
-// SetUsedCount sets the "used_count" field.
-func (cu *CouponUpdate) SetUsedCount(i int) *CouponUpdate {
-	cu.mutation.ResetUsedCount()
-	cu.mutation.SetUsedCount(i)
+// SetMaxUses sets the "max_uses" field.
+func (cu *CouponUpdate) SetMaxUses(i int) *CouponUpdate {
+	cu.mutation.ResetMaxUses()
+	cu.mutation.SetMaxUses(i)
 	return cu
 }
 
-// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableUsedCount(i *int) *CouponUpdate {
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableMaxUses(i *int) *CouponUpdate {
 	if i != nil {
-		cu.SetUsedCount(*i)
+		cu.SetMaxUses(*i)
 	}
 	return cu
 }
 
-// AddUsedCount adds i to the "used_count" field.
-func (cu *CouponUpdate) AddUsedCount(i int) *CouponUpdate {
-	cu.mutation.AddUsedCount(i)
-	return cu
-}
-
-// SetExpiresAt sets the "expires_at" field.
-func (cu *CouponUpdate) SetExpiresAt(t time.Time) *CouponUpdate {
-	cu.mutation.SetExpiresAt(t)
+// AddMaxUses adds i to the "max_uses" field.
+func (cu *CouponUpdate) AddMaxUses(i int) *CouponUpdate {
+	cu.mutation.AddMaxUses(i)
 	return cu
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableExpiresAt(t *time.Time) *CouponUpdate {
-	if t != nil {
-		cu.SetExpiresAt(*t)
-	}
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cu *CouponUpdate) ClearMaxUses() *CouponUpdate {
+	cu.mutation.ClearMaxUses()
 	return cu
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (cu *CouponUpdate) ClearExpiresAt() *CouponUpdate {
-	cu.mutation.ClearExpiresAt()
+// SetTimesUsed sets the "times_used" field.
+func (cu *CouponUpdate) SetTimesUsed(i int) *CouponUpdate {
+	cu.mutation.ResetTimesUsed()
+	cu.mutation.SetTimesUsed(i)
 	return cu
 }
 
-// SetActive sets the "active" field.
-func (cu *CouponUpdate) SetActive(b bool) *CouponUpdate {
-	cu.mutation.SetActive(b)
+// SetNillableTimesUsed sets the "times_used" field if the given value is not nil.
+func (cu *CouponUpdate) SetNillableTimesUsed(i *int) *CouponUpdate {
+	if i != nil {
+		cu.SetTimesUsed(*i)
+	}
 	return cu
 }
 
-// SetNillableActive sets the "active" field if the given value is not nil.
-func (cu *CouponUpdate) SetNillableActive(b *bool) *CouponUpdate {
-	if b != nil {
-		cu.SetActive(*b)
-	}
+// AddTimesUsed adds i to the "times_used" field.
+func (cu *CouponUpdate) AddTimesUsed(i int) *CouponUpdate {
+	cu.mutation.AddTimesUsed(i)
 	return cu
 }
 
@@ -254,14 +249,14 @@ func (cu *CouponUpdate) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
 		}
 	}
-	if v, ok := cu.mutation.GetType(); ok {
-		if err := coupon.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+	if v, ok := cu.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
 		}
 	}
-	if v, ok := cu.mutation.Value(); ok {
-		if err := coupon.ValueValidator(v); err != nil {
-			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Coupon.value": %w`, err)}
+	if v, ok := cu.mutation.DiscountValue(); ok {
+		if err := coupon.DiscountValueValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_value": %w`, err)}
 		}
 	}
 	return nil
@@ -282,47 +277,44 @@ func (cu *CouponUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.Code(); ok {
 		_spec.SetField(coupon.FieldCode, field.TypeString, value)
 	}
-	if value, ok := cu.mutation.GetType(); ok {
-		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
+	if value, ok := cu.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
 	}
-	if value, ok := cu.mutation.Value(); ok {
-		_spec.SetField(coupon.FieldValue, field.TypeFloat64, value)
+	if value, ok := cu.mutation.DiscountValue(); ok {
+		_spec.SetField(coupon.FieldDiscountValue, field.TypeInt64, value)
 	}
-	if value, ok := cu.mutation.AddedValue(); ok {
-		_spec.AddField(coupon.FieldValue, field.TypeFloat64, value)
+	if value, ok := cu.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(coupon.FieldDiscountValue, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.MinPurchase(); ok {
-		_spec.SetField(coupon.FieldMinPurchase, field.TypeFloat64, value)
+		_spec.SetField(coupon.FieldMinPurchase, field.TypeInt64, value)
 	}
 	if value, ok := cu.mutation.AddedMinPurchase(); ok {
-		_spec.AddField(coupon.FieldMinPurchase, field.TypeFloat64, value)
-	}
-	if cu.mutation.MinPurchaseCleared() {
-		_spec.ClearField(coupon.FieldMinPurchase, field.TypeFloat64)
+		_spec.AddField(coupon.FieldMinPurchase, field.TypeInt64, value)
 	}
-	if value, ok := cu.mutation.UsageLimit(); ok {
-		_spec.SetField(coupon.FieldUsageLimit, field.TypeInt, value)
+	if value, ok := cu.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := cu.mutation.AddedUsageLimit(); ok {
-		_spec.AddField(coupon.FieldUsageLimit, field.TypeInt, value)
+	if cu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
 	}
-	if cu.mutation.UsageLimitCleared() {
-		_spec.ClearField(coupon.FieldUsageLimit, field.TypeInt)
+	if value, ok := cu.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
 	}
-	if value, ok := cu.mutation.UsedCount(); ok {
-		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	if value, ok := cu.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
 	}
-	if value, ok := cu.mutation.AddedUsedCount(); ok {
-		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	if value, ok := cu.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
 	}
-	if value, ok := cu.mutation.ExpiresAt(); ok {
-		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	if cu.mutation.MaxUsesCleared() {
+		_spec.ClearField(coupon.FieldMaxUses, field.TypeInt)
 	}
-	if cu.mutation.ExpiresAtCleared() {
-		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	if value, ok := cu.mutation.TimesUsed(); ok {
+		_spec.SetField(coupon.FieldTimesUsed, field.TypeInt, value)
 	}
-	if value, ok := cu.mutation.Active(); ok {
-		_spec.SetField(coupon.FieldActive, field.TypeBool, value)
+	if value, ok := cu.mutation.AddedTimesUsed(); ok {
+		_spec.AddField(coupon.FieldTimesUsed, field.TypeInt, value)
 	}
 	if value, ok := cu.mutation.CreatedAt(); ok {
 		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
@@ -364,147 +356,141 @@ func (cuo *CouponUpdateOne) SetNillableCode(s *string) *CouponUpdateOne {
 	return cuo
 }
 
-// SetType sets the "type" field.
-func (cuo *CouponUpdateOne) SetType(c coupon.Type) *CouponUpdateOne {
-	cuo.mutation.SetType(c)
+// SetDiscountType sets the "discount_type" field.
+func (cuo *CouponUpdateOne) SetDiscountType(ct coupon.DiscountType) *CouponUpdateOne {
+	cuo.mutation.SetDiscountType(ct)
 	return cuo
 }
 
-// SetNillableType sets the "type" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableType(c *coupon.Type) *CouponUpdateOne {
-	if c != nil {
-		cuo.SetType(*c)
+// SetNillableDiscountType sets the "discount_type" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableDiscountType(ct *coupon.DiscountType) *CouponUpdateOne {
+	if ct != nil {
+		cuo.SetDiscountType(*ct)
 	}
 	return cuo
 }
 
-// SetValue sets the "value" field.
-func (cuo *CouponUpdateOne) SetValue(f float64) *CouponUpdateOne {
-	cuo.mutation.ResetValue()
-	cuo.mutation.SetValue(f)
+// SetDiscountValue sets the "discount_value" field.
+func (cuo *CouponUpdateOne) SetDiscountValue(m money.Amount) *CouponUpdateOne {
+	cuo.mutation.ResetDiscountValue()
+	cuo.mutation.SetDiscountValue(m)
 	return cuo
 }
 
-// SetNillableValue sets the "value" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableValue(f *float64) *CouponUpdateOne {
-	if f != nil {
-		cuo.SetValue(*f)
+// SetNillableDiscountValue sets the "discount_value" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableDiscountValue(m *money.Amount) *CouponUpdateOne {
+	if m != nil {
+		cuo.SetDiscountValue(*m)
 	}
 	return cuo
 }
 
-// AddValue adds f to the "value" field.
-func (cuo *CouponUpdateOne) AddValue(f float64) *CouponUpdateOne {
-	cuo.mutation.AddValue(f)
+// AddDiscountValue adds m to the "discount_value" field.
+func (cuo *CouponUpdateOne) AddDiscountValue(m money.Amount) *CouponUpdateOne {
+	cuo.mutation.AddDiscountValue(m)
 	return cuo
 }
 
 // SetMinPurchase sets the "min_purchase" field.
-func (cuo *CouponUpdateOne) SetMinPurchase(f float64) *CouponUpdateOne {
+func (cuo *CouponUpdateOne) SetMinPurchase(m money.Amount) *CouponUpdateOne {
 	cuo.mutation.ResetMinPurchase()
-	cuo.mutation.SetMinPurchase(f)
+	cuo.mutation.SetMinPurchase(m)
 	return cuo
 }
 
 // SetNillableMinPurchase sets the "min_purchase" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableMinPurchase(f *float64) *CouponUpdateOne {
-	if f != nil {
-		cuo.SetMinPurchase(*f)
+func (cuo *CouponUpdateOne) SetNillableMinPurchase(m *money.Amount) *CouponUpdateOne {
+	if m != nil {
+		cuo.SetMinPurchase(*m)
 	}
 	return cuo
 }
 
-// AddMinPurchase adds f to the "min_purchase" field.
-func (cuo *CouponUpdateOne) AddMinPurchase(f float64) *CouponUpdateOne {
-	cuo.mutation.AddMinPurchase(f)
+// AddMinPurchase adds m to the "min_purchase" field.
+func (cuo *CouponUpdateOne) AddMinPurchase(m money.Amount) *CouponUpdateOne {
+	cuo.mutation.AddMinPurchase(m)
 	return cuo
 }
 
-// ClearMinPurchase clears the value of the "min_purchase" field.
-func (cuo *CouponUpdateOne) ClearMinPurchase() *CouponUpdateOne {
-	cuo.mutation.ClearMinPurchase()
+// SetExpiresAt sets the "expires_at" field.
+func (cuo *CouponUpdateOne) SetExpiresAt(t time.Time) *CouponUpdateOne {
+	cuo.mutation.SetExpiresAt(t)
 	return cuo
 }
 
-// SetUsageLimit sets the "usage_limit" field.
-func (cuo *CouponUpdateOne) SetUsageLimit(i int) *CouponUpdateOne {
-	cuo.mutation.ResetUsageLimit()
-	cuo.mutation.SetUsageLimit(i)
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableExpiresAt(t *time.Time) *CouponUpdateOne {
+	if t != nil {
+		cuo.SetExpiresAt(*t)
+	}
 	return cuo
 }
 
-// SetNillableUsageLimit sets the "usage_limit" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableUsageLimit(i *int) *CouponUpdateOne {
-	if i != nil {
-		cuo.SetUsageLimit(*i)
-	}
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (cuo *CouponUpdateOne) ClearExpiresAt() *CouponUpdateOne {
+	cuo.mutation.ClearExpiresAt()
 	return cuo
 }
 
-// AddUsageLimit adds i to the "usage_limit" field.
-func (cuo *CouponUpdateOne) AddUsageLimit(i int) *CouponUpdateOne {
-	cuo.mutation.AddUsageLimit(i)
+// SetIsActive sets the "is_active" field.
+func (cuo *CouponUpdateOne) SetIsActive(b bool) *CouponUpdateOne {
+	cuo.mutation.SetIsActive(b)
 	return cuo
 }
 
-// ClearUsageLimit clears the value of the "usage_limit" field.
-func (cuo *CouponUpdateOne) ClearUsageLimit() *CouponUpdateOne {
-	cuo.mutation.ClearUsageLimit()
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableIsActive(b *bool) *CouponUpdateOne {
+	if b != nil {
+		cuo.SetIsActive(*b)
+	}
 	return cuo
 }
 
-// SetUsedCount sets the "used_count" field.
-func (cuo *CouponUpdateOne) SetUsedCount(i int) *CouponUpdateOne {
-	cuo.mutation.ResetUsedCount()
-	cuo.mutation.SetUsedCount(i)
+// SetMaxUses sets the "max_uses" field.
+func (cuo *CouponUpdateOne) SetMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.ResetMaxUses()
+	cuo.mutation.SetMaxUses(i)
 	return cuo
 }
 
-// SetNillableUsedCount sets the "used_count" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableUsedCount(i *int) *CouponUpdateOne {
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableMaxUses(i *int) *CouponUpdateOne {
 	if i != nil {
-		cuo.SetUsedCount(*i)
+		cuo.SetMaxUses(*i)
 	}
 	return cuo
 }
 
-// AddUsedCount adds i to the "used_count" field.
-func (cuo *CouponUpdateOne) AddUsedCount(i int) *CouponUpdateOne {
-	cuo.mutation.AddUsedCount(i)
-	return cuo
-}
-
-// SetExpiresAt sets the "expires_at" field.
-func (cuo *CouponUpdateOne) SetExpiresAt(t time.Time) *CouponUpdateOne {
-	cuo.mutation.SetExpiresAt(t)
+// AddMaxUses adds i to the "max_uses" field.
+func (cuo *CouponUpdateOne) AddMaxUses(i int) *CouponUpdateOne {
+	cuo.mutation.AddMaxUses(i)
 	return cuo
 }
 
-// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableExpiresAt(t *time.Time) *CouponUpdateOne {
-	if t != nil {
-		cuo.SetExpiresAt(*t)
-	}
+// ClearMaxUses clears the value of the "max_uses" field.
+func (cuo *CouponUpdateOne) ClearMaxUses() *CouponUpdateOne {
+	cuo.mutation.ClearMaxUses()
 	return cuo
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (cuo *CouponUpdateOne) ClearExpiresAt() *CouponUpdateOne {
-	cuo.mutation.ClearExpiresAt()
+// SetTimesUsed sets the "times_used" field.
+func (cuo *CouponUpdateOne) SetTimesUsed(i int) *CouponUpdateOne {
+	cuo.mutation.ResetTimesUsed()
+	cuo.mutation.SetTimesUsed(i)
 	return cuo
 }
 
-// SetActive sets the "active" field.
-func (cuo *CouponUpdateOne) SetActive(b bool) *CouponUpdateOne {
-	cuo.mutation.SetActive(b)
+// SetNillableTimesUsed sets the "times_used" field if the given value is not nil.
+func (cuo *CouponUpdateOne) SetNillableTimesUsed(i *int) *CouponUpdateOne {
+	if i != nil {
+		cuo.SetTimesUsed(*i)
+	}
 	return cuo
 }
 
-// SetNillableActive sets the "active" field if the given value is not nil.
-func (cuo *CouponUpdateOne) SetNillableActive(b *bool) *CouponUpdateOne {
-	if b != nil {
-		cuo.SetActive(*b)
-	}
+// AddTimesUsed adds i to the "times_used" field.
+func (cuo *CouponUpdateOne) AddTimesUsed(i int) *CouponUpdateOne {
+	cuo.mutation.AddTimesUsed(i)
 	return cuo
 }
 
@@ -589,14 +575,14 @@ func (cuo *CouponUpdateOne) check() error {
 			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Coupon.code": %w`, err)}
 		}
 	}
-	if v, ok := cuo.mutation.GetType(); ok {
-		if err := coupon.TypeValidator(v); err != nil {
-			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Coupon.type": %w`, err)}
+	if v, ok := cuo.mutation.DiscountType(); ok {
+		if err := coupon.DiscountTypeValidator(v); err != nil {
+			return &ValidationError{Name: "discount_type", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_type": %w`, err)}
 		}
 	}
-	if v, ok := cuo.mutation.Value(); ok {
-		if err := coupon.ValueValidator(v); err != nil {
-			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Coupon.value": %w`, err)}
+	if v, ok := cuo.mutation.DiscountValue(); ok {
+		if err := coupon.DiscountValueValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "discount_value", err: fmt.Errorf(`ent: validator failed for field "Coupon.discount_value": %w`, err)}
 		}
 	}
 	return nil
@@ -634,47 +620,44 @@ func (cuo *CouponUpdateOne) sqlSave(ctx context.Context) (_node *Coupon, err err
 	if value, ok := cuo.mutation.Code(); ok {
 		_spec.SetField(coupon.FieldCode, field.TypeString, value)
 	}
-	if value, ok := cuo.mutation.GetType(); ok {
-		_spec.SetField(coupon.FieldType, field.TypeEnum, value)
+	if value, ok := cuo.mutation.DiscountType(); ok {
+		_spec.SetField(coupon.FieldDiscountType, field.TypeEnum, value)
 	}
-	if value, ok := cuo.mutation.Value(); ok {
-		_spec.SetField(coupon.FieldValue, field.TypeFloat64, value)
+	if value, ok := cuo.mutation.DiscountValue(); ok {
+		_spec.SetField(coupon.FieldDiscountValue, field.TypeInt64, value)
 	}
-	if value, ok := cuo.mutation.AddedValue(); ok {
-		_spec.AddField(coupon.FieldValue, field.TypeFloat64, value)
+	if value, ok := cuo.mutation.AddedDiscountValue(); ok {
+		_spec.AddField(coupon.FieldDiscountValue, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.MinPurchase(); ok {
-		_spec.SetField(coupon.FieldMinPurchase, field.TypeFloat64, value)
+		_spec.SetField(coupon.FieldMinPurchase, field.TypeInt64, value)
 	}
 	if value, ok := cuo.mutation.AddedMinPurchase(); ok {
-		_spec.AddField(coupon.FieldMinPurchase, field.TypeFloat64, value)
-	}
-	if cuo.mutation.MinPurchaseCleared() {
-		_spec.ClearField(coupon.FieldMinPurchase, field.TypeFloat64)
+		_spec.AddField(coupon.FieldMinPurchase, field.TypeInt64, value)
 	}
-	if value, ok := cuo.mutation.UsageLimit(); ok {
-		_spec.SetField(coupon.FieldUsageLimit, field.TypeInt, value)
+	if value, ok := cuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
 	}
-	if value, ok := cuo.mutation.AddedUsageLimit(); ok {
-		_spec.AddField(coupon.FieldUsageLimit, field.TypeInt, value)
+	if cuo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
 	}
-	if cuo.mutation.UsageLimitCleared() {
-		_spec.ClearField(coupon.FieldUsageLimit, field.TypeInt)
+	if value, ok := cuo.mutation.IsActive(); ok {
+		_spec.SetField(coupon.FieldIsActive, field.TypeBool, value)
 	}
-	if value, ok := cuo.mutation.UsedCount(); ok {
-		_spec.SetField(coupon.FieldUsedCount, field.TypeInt, value)
+	if value, ok := cuo.mutation.MaxUses(); ok {
+		_spec.SetField(coupon.FieldMaxUses, field.TypeInt, value)
 	}
-	if value, ok := cuo.mutation.AddedUsedCount(); ok {
-		_spec.AddField(coupon.FieldUsedCount, field.TypeInt, value)
+	if value, ok := cuo.mutation.AddedMaxUses(); ok {
+		_spec.AddField(coupon.FieldMaxUses, field.TypeInt, value)
 	}
-	if value, ok := cuo.mutation.ExpiresAt(); ok {
-		_spec.SetField(coupon.FieldExpiresAt, field.TypeTime, value)
+	if cuo.mutation.MaxUsesCleared() {
+		_spec.ClearField(coupon.FieldMaxUses, field.TypeInt)
 	}
-	if cuo.mutation.ExpiresAtCleared() {
-		_spec.ClearField(coupon.FieldExpiresAt, field.TypeTime)
+	if value, ok := cuo.mutation.TimesUsed(); ok {
+		_spec.SetField(coupon.FieldTimesUsed, field.TypeInt, value)
 	}
-	if value, ok := cuo.mutation.Active(); ok {
-		_spec.SetField(coupon.FieldActive, field.TypeBool, value)
+	if value, ok := cuo.mutation.AddedTimesUsed(); ok {
+		_spec.AddField(coupon.FieldTimesUsed, field.TypeInt, value)
 	}
 	if value, ok := cuo.mutation.CreatedAt(); ok {
 		_spec.SetField(coupon.FieldCreatedAt, field.TypeTime, value)
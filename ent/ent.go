@@ -12,16 +12,29 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/avaliation"
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/ent/session"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -82,16 +95,29 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			address.Table:    address.ValidColumn,
-			avaliation.Table: avaliation.ValidColumn,
-			cart.Table:       cart.ValidColumn,
-			cartitem.Table:   cartitem.ValidColumn,
-			category.Table:   category.ValidColumn,
-			coupon.Table:     coupon.ValidColumn,
-			order.Table:      order.ValidColumn,
-			orderitem.Table:  orderitem.ValidColumn,
-			product.Table:    product.ValidColumn,
-			user.Table:       user.ValidColumn,
+			accounttoken.Table:     accounttoken.ValidColumn,
+			address.Table:          address.ValidColumn,
+			avaliation.Table:       avaliation.ValidColumn,
+			cart.Table:             cart.ValidColumn,
+			cartitem.Table:         cartitem.ValidColumn,
+			category.Table:         category.ValidColumn,
+			coupon.Table:           coupon.ValidColumn,
+			loginattempt.Table:     loginattempt.ValidColumn,
+			order.Table:            order.ValidColumn,
+			orderitem.Table:        orderitem.ValidColumn,
+			orderstatusevent.Table: orderstatusevent.ValidColumn,
+			payment.Table:          payment.ValidColumn,
+			product.Table:          product.ValidColumn,
+			productoption.Table:    productoption.ValidColumn,
+			productvariant.Table:   productvariant.ValidColumn,
+			returnitem.Table:       returnitem.ValidColumn,
+			returnrequest.Table:    returnrequest.ValidColumn,
+			session.Table:          session.ValidColumn,
+			shipment.Table:         shipment.ValidColumn,
+			slugredirect.Table:     slugredirect.ValidColumn,
+			trackingevent.Table:    trackingevent.ValidColumn,
+			user.Table:             user.ValidColumn,
+			webhookevent.Table:     webhookevent.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -0,0 +1,172 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+)
+
+// LoginAttempt is the model entity for the LoginAttempt schema.
+type LoginAttempt struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// UserAgent holds the value of the "user_agent" field.
+	UserAgent string `json:"user_agent,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason loginattempt.Reason `json:"reason,omitempty"`
+	// CausedLockout holds the value of the "caused_lockout" field.
+	CausedLockout bool `json:"caused_lockout,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LoginAttempt) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case loginattempt.FieldCausedLockout:
+			values[i] = new(sql.NullBool)
+		case loginattempt.FieldID, loginattempt.FieldEmail, loginattempt.FieldUserID, loginattempt.FieldIP, loginattempt.FieldUserAgent, loginattempt.FieldReason:
+			values[i] = new(sql.NullString)
+		case loginattempt.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LoginAttempt fields.
+func (la *LoginAttempt) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case loginattempt.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				la.ID = value.String
+			}
+		case loginattempt.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				la.Email = value.String
+			}
+		case loginattempt.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				la.UserID = value.String
+			}
+		case loginattempt.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				la.IP = value.String
+			}
+		case loginattempt.FieldUserAgent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_agent", values[i])
+			} else if value.Valid {
+				la.UserAgent = value.String
+			}
+		case loginattempt.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				la.Reason = loginattempt.Reason(value.String)
+			}
+		case loginattempt.FieldCausedLockout:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field caused_lockout", values[i])
+			} else if value.Valid {
+				la.CausedLockout = value.Bool
+			}
+		case loginattempt.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				la.CreatedAt = value.Time
+			}
+		default:
+			la.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LoginAttempt.
+// This includes values selected through modifiers, order, etc.
+func (la *LoginAttempt) Value(name string) (ent.Value, error) {
+	return la.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LoginAttempt.
+// Note that you need to call LoginAttempt.Unwrap() before calling this method if this LoginAttempt
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (la *LoginAttempt) Update() *LoginAttemptUpdateOne {
+	return NewLoginAttemptClient(la.config).UpdateOne(la)
+}
+
+// Unwrap unwraps the LoginAttempt entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (la *LoginAttempt) Unwrap() *LoginAttempt {
+	_tx, ok := la.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LoginAttempt is not a transactional entity")
+	}
+	la.config.driver = _tx.drv
+	return la
+}
+
+// String implements the fmt.Stringer.
+func (la *LoginAttempt) String() string {
+	var builder strings.Builder
+	builder.WriteString("LoginAttempt(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", la.ID))
+	builder.WriteString("email=")
+	builder.WriteString(la.Email)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(la.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(la.IP)
+	builder.WriteString(", ")
+	builder.WriteString("user_agent=")
+	builder.WriteString(la.UserAgent)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(fmt.Sprintf("%v", la.Reason))
+	builder.WriteString(", ")
+	builder.WriteString("caused_lockout=")
+	builder.WriteString(fmt.Sprintf("%v", la.CausedLockout))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(la.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LoginAttempts is a parsable slice of LoginAttempt.
+type LoginAttempts []*LoginAttempt
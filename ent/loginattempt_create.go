@@ -0,0 +1,323 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+)
+
+// LoginAttemptCreate is the builder for creating a LoginAttempt entity.
+type LoginAttemptCreate struct {
+	config
+	mutation *LoginAttemptMutation
+	hooks    []Hook
+}
+
+// SetEmail sets the "email" field.
+func (lac *LoginAttemptCreate) SetEmail(s string) *LoginAttemptCreate {
+	lac.mutation.SetEmail(s)
+	return lac
+}
+
+// SetUserID sets the "user_id" field.
+func (lac *LoginAttemptCreate) SetUserID(s string) *LoginAttemptCreate {
+	lac.mutation.SetUserID(s)
+	return lac
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableUserID(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetUserID(*s)
+	}
+	return lac
+}
+
+// SetIP sets the "ip" field.
+func (lac *LoginAttemptCreate) SetIP(s string) *LoginAttemptCreate {
+	lac.mutation.SetIP(s)
+	return lac
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableIP(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetIP(*s)
+	}
+	return lac
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (lac *LoginAttemptCreate) SetUserAgent(s string) *LoginAttemptCreate {
+	lac.mutation.SetUserAgent(s)
+	return lac
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableUserAgent(s *string) *LoginAttemptCreate {
+	if s != nil {
+		lac.SetUserAgent(*s)
+	}
+	return lac
+}
+
+// SetReason sets the "reason" field.
+func (lac *LoginAttemptCreate) SetReason(l loginattempt.Reason) *LoginAttemptCreate {
+	lac.mutation.SetReason(l)
+	return lac
+}
+
+// SetCausedLockout sets the "caused_lockout" field.
+func (lac *LoginAttemptCreate) SetCausedLockout(b bool) *LoginAttemptCreate {
+	lac.mutation.SetCausedLockout(b)
+	return lac
+}
+
+// SetNillableCausedLockout sets the "caused_lockout" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCausedLockout(b *bool) *LoginAttemptCreate {
+	if b != nil {
+		lac.SetCausedLockout(*b)
+	}
+	return lac
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (lac *LoginAttemptCreate) SetCreatedAt(t time.Time) *LoginAttemptCreate {
+	lac.mutation.SetCreatedAt(t)
+	return lac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (lac *LoginAttemptCreate) SetNillableCreatedAt(t *time.Time) *LoginAttemptCreate {
+	if t != nil {
+		lac.SetCreatedAt(*t)
+	}
+	return lac
+}
+
+// SetID sets the "id" field.
+func (lac *LoginAttemptCreate) SetID(s string) *LoginAttemptCreate {
+	lac.mutation.SetID(s)
+	return lac
+}
+
+// Mutation returns the LoginAttemptMutation object of the builder.
+func (lac *LoginAttemptCreate) Mutation() *LoginAttemptMutation {
+	return lac.mutation
+}
+
+// Save creates the LoginAttempt in the database.
+func (lac *LoginAttemptCreate) Save(ctx context.Context) (*LoginAttempt, error) {
+	lac.defaults()
+	return withHooks(ctx, lac.sqlSave, lac.mutation, lac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (lac *LoginAttemptCreate) SaveX(ctx context.Context) *LoginAttempt {
+	v, err := lac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lac *LoginAttemptCreate) Exec(ctx context.Context) error {
+	_, err := lac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lac *LoginAttemptCreate) ExecX(ctx context.Context) {
+	if err := lac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (lac *LoginAttemptCreate) defaults() {
+	if _, ok := lac.mutation.CausedLockout(); !ok {
+		v := loginattempt.DefaultCausedLockout
+		lac.mutation.SetCausedLockout(v)
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		v := loginattempt.DefaultCreatedAt()
+		lac.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (lac *LoginAttemptCreate) check() error {
+	if _, ok := lac.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "LoginAttempt.email"`)}
+	}
+	if _, ok := lac.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "LoginAttempt.reason"`)}
+	}
+	if v, ok := lac.mutation.Reason(); ok {
+		if err := loginattempt.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "LoginAttempt.reason": %w`, err)}
+		}
+	}
+	if _, ok := lac.mutation.CausedLockout(); !ok {
+		return &ValidationError{Name: "caused_lockout", err: errors.New(`ent: missing required field "LoginAttempt.caused_lockout"`)}
+	}
+	if _, ok := lac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "LoginAttempt.created_at"`)}
+	}
+	return nil
+}
+
+func (lac *LoginAttemptCreate) sqlSave(ctx context.Context) (*LoginAttempt, error) {
+	if err := lac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := lac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, lac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LoginAttempt.ID type: %T", _spec.ID.Value)
+		}
+	}
+	lac.mutation.id = &_node.ID
+	lac.mutation.done = true
+	return _node, nil
+}
+
+func (lac *LoginAttemptCreate) createSpec() (*LoginAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LoginAttempt{config: lac.config}
+		_spec = sqlgraph.NewCreateSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeString))
+	)
+	if id, ok := lac.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := lac.mutation.Email(); ok {
+		_spec.SetField(loginattempt.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := lac.mutation.UserID(); ok {
+		_spec.SetField(loginattempt.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := lac.mutation.IP(); ok {
+		_spec.SetField(loginattempt.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := lac.mutation.UserAgent(); ok {
+		_spec.SetField(loginattempt.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := lac.mutation.Reason(); ok {
+		_spec.SetField(loginattempt.FieldReason, field.TypeEnum, value)
+		_node.Reason = value
+	}
+	if value, ok := lac.mutation.CausedLockout(); ok {
+		_spec.SetField(loginattempt.FieldCausedLockout, field.TypeBool, value)
+		_node.CausedLockout = value
+	}
+	if value, ok := lac.mutation.CreatedAt(); ok {
+		_spec.SetField(loginattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// LoginAttemptCreateBulk is the builder for creating many LoginAttempt entities in bulk.
+type LoginAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*LoginAttemptCreate
+}
+
+// Save creates the LoginAttempt entities in the database.
+func (lacb *LoginAttemptCreateBulk) Save(ctx context.Context) ([]*LoginAttempt, error) {
+	if lacb.err != nil {
+		return nil, lacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(lacb.builders))
+	nodes := make([]*LoginAttempt, len(lacb.builders))
+	mutators := make([]Mutator, len(lacb.builders))
+	for i := range lacb.builders {
+		func(i int, root context.Context) {
+			builder := lacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LoginAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, lacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, lacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, lacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) SaveX(ctx context.Context) []*LoginAttempt {
+	v, err := lacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (lacb *LoginAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := lacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lacb *LoginAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := lacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
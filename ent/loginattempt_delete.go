@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// LoginAttemptDelete is the builder for deleting a LoginAttempt entity.
+type LoginAttemptDelete struct {
+	config
+	hooks    []Hook
+	mutation *LoginAttemptMutation
+}
+
+// Where appends a list predicates to the LoginAttemptDelete builder.
+func (lad *LoginAttemptDelete) Where(ps ...predicate.LoginAttempt) *LoginAttemptDelete {
+	lad.mutation.Where(ps...)
+	return lad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (lad *LoginAttemptDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, lad.sqlExec, lad.mutation, lad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lad *LoginAttemptDelete) ExecX(ctx context.Context) int {
+	n, err := lad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (lad *LoginAttemptDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(loginattempt.Table, sqlgraph.NewFieldSpec(loginattempt.FieldID, field.TypeString))
+	if ps := lad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, lad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	lad.mutation.done = true
+	return affected, err
+}
+
+// LoginAttemptDeleteOne is the builder for deleting a single LoginAttempt entity.
+type LoginAttemptDeleteOne struct {
+	lad *LoginAttemptDelete
+}
+
+// Where appends a list predicates to the LoginAttemptDelete builder.
+func (lado *LoginAttemptDeleteOne) Where(ps ...predicate.LoginAttempt) *LoginAttemptDeleteOne {
+	lado.lad.mutation.Where(ps...)
+	return lado
+}
+
+// Exec executes the deletion query.
+func (lado *LoginAttemptDeleteOne) Exec(ctx context.Context) error {
+	n, err := lado.lad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{loginattempt.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (lado *LoginAttemptDeleteOne) ExecX(ctx context.Context) {
+	if err := lado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -8,6 +8,31 @@ import (
 )
 
 var (
+	// AccountTokensColumns holds the columns for the "account_tokens" table.
+	AccountTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"password_reset", "email_verification"}},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+	}
+	// AccountTokensTable holds the schema information for the "account_tokens" table.
+	AccountTokensTable = &schema.Table{
+		Name:       "account_tokens",
+		Columns:    AccountTokensColumns,
+		PrimaryKey: []*schema.Column{AccountTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "account_tokens_users_account_tokens",
+				Columns:    []*schema.Column{AccountTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// AddressesColumns holds the columns for the "addresses" table.
 	AddressesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
@@ -73,12 +98,15 @@ var (
 	// CartsColumns holds the columns for the "carts" table.
 	CartsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
-		{Name: "subtotal", Type: field.TypeFloat64, Default: 0},
-		{Name: "shipping", Type: field.TypeFloat64, Nullable: true},
-		{Name: "discount", Type: field.TypeFloat64, Default: 0},
-		{Name: "total", Type: field.TypeFloat64, Default: 0},
+		{Name: "subtotal", Type: field.TypeInt64, Default: 0},
+		{Name: "shipping", Type: field.TypeInt64, Nullable: true},
+		{Name: "shipping_service", Type: field.TypeString, Nullable: true},
+		{Name: "shipping_cep", Type: field.TypeString, Nullable: true},
+		{Name: "discount", Type: field.TypeInt64, Default: 0},
+		{Name: "total", Type: field.TypeInt64, Default: 0},
 		{Name: "applied_coupon", Type: field.TypeBool, Default: false},
 		{Name: "coupon_code", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeString, Unique: true, Nullable: true},
@@ -91,23 +119,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "carts_users_cart",
-				Columns:    []*schema.Column{CartsColumns[9]},
+				Columns:    []*schema.Column{CartsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cart_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{CartsColumns[9]},
+			},
+		},
 	}
 	// CartItemsColumns holds the columns for the "cart_items" table.
 	CartItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "variant_options", Type: field.TypeJSON, Nullable: true},
 		{Name: "name", Type: field.TypeString},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "price", Type: field.TypeInt64},
 		{Name: "image", Type: field.TypeString},
 		{Name: "quantity", Type: field.TypeInt},
+		{Name: "previous_price", Type: field.TypeInt64, Nullable: true},
+		{Name: "previous_quantity", Type: field.TypeInt, Nullable: true},
+		{Name: "unavailable_reason", Type: field.TypeEnum, Nullable: true, Enums: []string{"out_of_stock", "removed"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "cart_id", Type: field.TypeString, Nullable: true},
 		{Name: "product_id", Type: field.TypeString, Nullable: true},
+		{Name: "variant_id", Type: field.TypeString, Nullable: true},
 	}
 	// CartItemsTable holds the schema information for the "cart_items" table.
 	CartItemsTable = &schema.Table{
@@ -117,16 +157,22 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "cart_items_carts_cart_items",
-				Columns:    []*schema.Column{CartItemsColumns[7]},
+				Columns:    []*schema.Column{CartItemsColumns[11]},
 				RefColumns: []*schema.Column{CartsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "cart_items_products_cart_items",
-				Columns:    []*schema.Column{CartItemsColumns[8]},
+				Columns:    []*schema.Column{CartItemsColumns[12]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "cart_items_product_variants_cart_items",
+				Columns:    []*schema.Column{CartItemsColumns[13]},
+				RefColumns: []*schema.Column{ProductVariantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// CategoriesColumns holds the columns for the "categories" table.
@@ -137,24 +183,33 @@ var (
 		{Name: "image", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true},
 	}
 	// CategoriesTable holds the schema information for the "categories" table.
 	CategoriesTable = &schema.Table{
 		Name:       "categories",
 		Columns:    CategoriesColumns,
 		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[6]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 	}
 	// CouponsColumns holds the columns for the "coupons" table.
 	CouponsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "code", Type: field.TypeString, Unique: true},
-		{Name: "type", Type: field.TypeEnum, Enums: []string{"percentage", "fixed"}},
-		{Name: "value", Type: field.TypeFloat64},
-		{Name: "min_purchase", Type: field.TypeFloat64, Nullable: true},
-		{Name: "usage_limit", Type: field.TypeInt, Nullable: true},
-		{Name: "used_count", Type: field.TypeInt, Default: 0},
+		{Name: "discount_type", Type: field.TypeEnum, Enums: []string{"percentage", "fixed"}},
+		{Name: "discount_value", Type: field.TypeInt64},
+		{Name: "min_purchase", Type: field.TypeInt64, Default: 0},
 		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
-		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "max_uses", Type: field.TypeInt, Nullable: true},
+		{Name: "times_used", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -164,17 +219,50 @@ var (
 		Columns:    CouponsColumns,
 		PrimaryKey: []*schema.Column{CouponsColumns[0]},
 	}
+	// LoginAttemptsColumns holds the columns for the "login_attempts" table.
+	LoginAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "reason", Type: field.TypeEnum, Enums: []string{"invalid_credentials", "invalid_two_factor", "throttled", "locked"}},
+		{Name: "caused_lockout", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// LoginAttemptsTable holds the schema information for the "login_attempts" table.
+	LoginAttemptsTable = &schema.Table{
+		Name:       "login_attempts",
+		Columns:    LoginAttemptsColumns,
+		PrimaryKey: []*schema.Column{LoginAttemptsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "loginattempt_email_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[1], LoginAttemptsColumns[7]},
+			},
+			{
+				Name:    "loginattempt_ip_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{LoginAttemptsColumns[3], LoginAttemptsColumns[7]},
+			},
+		},
+	}
 	// OrdersColumns holds the columns for the "orders" table.
 	OrdersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "guest_email", Type: field.TypeString, Nullable: true},
+		{Name: "guest_name", Type: field.TypeString, Nullable: true},
 		{Name: "date", Type: field.TypeTime},
-		{Name: "total", Type: field.TypeFloat64},
-		{Name: "shipping", Type: field.TypeFloat64, Default: 0},
-		{Name: "discount", Type: field.TypeFloat64, Default: 0},
+		{Name: "total", Type: field.TypeInt64},
+		{Name: "shipping", Type: field.TypeInt64, Default: 0},
+		{Name: "shipping_service", Type: field.TypeString, Nullable: true},
+		{Name: "discount", Type: field.TypeInt64, Default: 0},
+		{Name: "refunded_total", Type: field.TypeInt64, Default: 0},
 		{Name: "delivery_type", Type: field.TypeEnum, Enums: []string{"pickup", "delivery"}},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}},
 		{Name: "payment_method", Type: field.TypeString},
-		{Name: "payment_status", Type: field.TypeString},
+		{Name: "payment_status", Type: field.TypeString, Default: "pending"},
 		{Name: "coupon_code", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -189,29 +277,39 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "orders_addresses_orders",
-				Columns:    []*schema.Column{OrdersColumns[12]},
+				Columns:    []*schema.Column{OrdersColumns[16]},
 				RefColumns: []*schema.Column{AddressesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "orders_users_orders",
-				Columns:    []*schema.Column{OrdersColumns[13]},
+				Columns:    []*schema.Column{OrdersColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_guest_email",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[1]},
+			},
+		},
 	}
 	// OrderItemsColumns holds the columns for the "order_items" table.
 	OrderItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "sku", Type: field.TypeString, Nullable: true},
+		{Name: "variant_options", Type: field.TypeJSON, Nullable: true},
 		{Name: "name", Type: field.TypeString},
-		{Name: "price", Type: field.TypeFloat64},
+		{Name: "price", Type: field.TypeInt64},
 		{Name: "image", Type: field.TypeString},
 		{Name: "quantity", Type: field.TypeInt},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "order_id", Type: field.TypeString, Nullable: true},
 		{Name: "product_id", Type: field.TypeString, Nullable: true},
+		{Name: "variant_id", Type: field.TypeString, Nullable: true},
 	}
 	// OrderItemsTable holds the schema information for the "order_items" table.
 	OrderItemsTable = &schema.Table{
@@ -221,16 +319,79 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "order_items_orders_order_items",
-				Columns:    []*schema.Column{OrderItemsColumns[7]},
+				Columns:    []*schema.Column{OrderItemsColumns[9]},
 				RefColumns: []*schema.Column{OrdersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "order_items_products_order_items",
-				Columns:    []*schema.Column{OrderItemsColumns[8]},
+				Columns:    []*schema.Column{OrderItemsColumns[10]},
 				RefColumns: []*schema.Column{ProductsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "order_items_product_variants_order_items",
+				Columns:    []*schema.Column{OrderItemsColumns[11]},
+				RefColumns: []*schema.Column{ProductVariantsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// OrderStatusEventsColumns holds the columns for the "order_status_events" table.
+	OrderStatusEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "from_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}},
+		{Name: "to_status", Type: field.TypeEnum, Enums: []string{"pending", "processing", "shipped", "delivered", "cancelled"}},
+		{Name: "changed_by", Type: field.TypeString, Nullable: true},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString, Nullable: true},
+	}
+	// OrderStatusEventsTable holds the schema information for the "order_status_events" table.
+	OrderStatusEventsTable = &schema.Table{
+		Name:       "order_status_events",
+		Columns:    OrderStatusEventsColumns,
+		PrimaryKey: []*schema.Column{OrderStatusEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "order_status_events_orders_status_events",
+				Columns:    []*schema.Column{OrderStatusEventsColumns[6]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// PaymentsColumns holds the columns for the "payments" table.
+	PaymentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "method", Type: field.TypeString},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "authorized", "paid", "declined", "refunded", "partially_refunded", "voided", "expired", "void_failed", "review_required"}, Default: "pending"},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "captured_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "refunded_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "failure_reason", Type: field.TypeString, Nullable: true},
+		{Name: "pix_payload", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "boleto_barcode", Type: field.TypeString, Nullable: true},
+		{Name: "boleto_digitable_line", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString, Nullable: true},
+	}
+	// PaymentsTable holds the schema information for the "payments" table.
+	PaymentsTable = &schema.Table{
+		Name:       "payments",
+		Columns:    PaymentsColumns,
+		PrimaryKey: []*schema.Column{PaymentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "payments_orders_payments",
+				Columns:    []*schema.Column{PaymentsColumns[15]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// ProductsColumns holds the columns for the "products" table.
@@ -239,11 +400,12 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
 		{Name: "description", Type: field.TypeString, Size: 2147483647},
-		{Name: "price", Type: field.TypeFloat64},
-		{Name: "sale_price", Type: field.TypeFloat64, Nullable: true},
+		{Name: "price", Type: field.TypeInt64},
+		{Name: "sale_price", Type: field.TypeInt64, Nullable: true},
 		{Name: "on_sale", Type: field.TypeBool, Default: false},
 		{Name: "stock", Type: field.TypeInt, Default: 0},
 		{Name: "sku", Type: field.TypeString},
+		{Name: "weight", Type: field.TypeInt, Default: 0},
 		{Name: "images", Type: field.TypeJSON},
 		{Name: "rating", Type: field.TypeFloat64, Default: 0},
 		{Name: "review_count", Type: field.TypeInt, Default: 0},
@@ -259,20 +421,262 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "products_categories_products",
-				Columns:    []*schema.Column{ProductsColumns[14]},
+				Columns:    []*schema.Column{ProductsColumns[15]},
 				RefColumns: []*schema.Column{CategoriesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// ProductOptionsColumns holds the columns for the "product_options" table.
+	ProductOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "values", Type: field.TypeJSON},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeString, Nullable: true},
+	}
+	// ProductOptionsTable holds the schema information for the "product_options" table.
+	ProductOptionsTable = &schema.Table{
+		Name:       "product_options",
+		Columns:    ProductOptionsColumns,
+		PrimaryKey: []*schema.Column{ProductOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_options_products_options",
+				Columns:    []*schema.Column{ProductOptionsColumns[6]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productoption_product_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ProductOptionsColumns[6], ProductOptionsColumns[1]},
+			},
+		},
+	}
+	// ProductVariantsColumns holds the columns for the "product_variants" table.
+	ProductVariantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "sku", Type: field.TypeString, Unique: true},
+		{Name: "options", Type: field.TypeJSON},
+		{Name: "combination", Type: field.TypeString},
+		{Name: "price", Type: field.TypeInt64, Nullable: true},
+		{Name: "stock", Type: field.TypeInt, Default: 0},
+		{Name: "images", Type: field.TypeJSON},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "product_id", Type: field.TypeString, Nullable: true},
+	}
+	// ProductVariantsTable holds the schema information for the "product_variants" table.
+	ProductVariantsTable = &schema.Table{
+		Name:       "product_variants",
+		Columns:    ProductVariantsColumns,
+		PrimaryKey: []*schema.Column{ProductVariantsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "product_variants_products_variants",
+				Columns:    []*schema.Column{ProductVariantsColumns[10]},
+				RefColumns: []*schema.Column{ProductsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "productvariant_product_id_combination",
+				Unique:  true,
+				Columns: []*schema.Column{ProductVariantsColumns[10], ProductVariantsColumns[3]},
+			},
+		},
+	}
+	// ReturnItemsColumns holds the columns for the "return_items" table.
+	ReturnItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "order_item_id", Type: field.TypeString},
+		{Name: "product_id", Type: field.TypeString, Nullable: true},
+		{Name: "variant_id", Type: field.TypeString, Nullable: true},
+		{Name: "quantity", Type: field.TypeInt},
+		{Name: "unit_price", Type: field.TypeInt64},
+		{Name: "return_id", Type: field.TypeString, Nullable: true},
+	}
+	// ReturnItemsTable holds the schema information for the "return_items" table.
+	ReturnItemsTable = &schema.Table{
+		Name:       "return_items",
+		Columns:    ReturnItemsColumns,
+		PrimaryKey: []*schema.Column{ReturnItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "return_items_return_requests_items",
+				Columns:    []*schema.Column{ReturnItemsColumns[6]},
+				RefColumns: []*schema.Column{ReturnRequestsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// ReturnRequestsColumns holds the columns for the "return_requests" table.
+	ReturnRequestsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"requested", "approved", "rejected"}, Default: "requested"},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "refund_amount", Type: field.TypeInt64, Default: 0},
+		{Name: "reviewed_by", Type: field.TypeString, Nullable: true},
+		{Name: "review_note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString, Nullable: true},
+	}
+	// ReturnRequestsTable holds the schema information for the "return_requests" table.
+	ReturnRequestsTable = &schema.Table{
+		Name:       "return_requests",
+		Columns:    ReturnRequestsColumns,
+		PrimaryKey: []*schema.Column{ReturnRequestsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "return_requests_orders_returns",
+				Columns:    []*schema.Column{ReturnRequestsColumns[10]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "family_id", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "device", Type: field.TypeString, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "replaced_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeString, Nullable: true},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sessions_users_sessions",
+				Columns:    []*schema.Column{SessionsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "session_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[1]},
+			},
+			{
+				Name:    "session_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[11]},
+			},
+		},
+	}
+	// ShipmentsColumns holds the columns for the "shipments" table.
+	ShipmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "carrier", Type: field.TypeString},
+		{Name: "tracking_code", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"created", "posted", "in_transit", "out_for_delivery", "delivered", "failed_attempt", "returned"}, Default: "created"},
+		{Name: "shipped_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "order_id", Type: field.TypeString, Unique: true, Nullable: true},
+	}
+	// ShipmentsTable holds the schema information for the "shipments" table.
+	ShipmentsTable = &schema.Table{
+		Name:       "shipments",
+		Columns:    ShipmentsColumns,
+		PrimaryKey: []*schema.Column{ShipmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "shipments_orders_shipment",
+				Columns:    []*schema.Column{ShipmentsColumns[8]},
+				RefColumns: []*schema.Column{OrdersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// SlugRedirectsColumns holds the columns for the "slug_redirects" table.
+	SlugRedirectsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "entity", Type: field.TypeEnum, Enums: []string{"product", "category"}},
+		{Name: "slug", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// SlugRedirectsTable holds the schema information for the "slug_redirects" table.
+	SlugRedirectsTable = &schema.Table{
+		Name:       "slug_redirects",
+		Columns:    SlugRedirectsColumns,
+		PrimaryKey: []*schema.Column{SlugRedirectsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "slugredirect_entity_slug",
+				Unique:  true,
+				Columns: []*schema.Column{SlugRedirectsColumns[1], SlugRedirectsColumns[2]},
+			},
+			{
+				Name:    "slugredirect_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{SlugRedirectsColumns[3]},
+			},
+		},
+	}
+	// TrackingEventsColumns holds the columns for the "tracking_events" table.
+	TrackingEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"posted", "in_transit", "out_for_delivery", "delivered", "failed_attempt", "returned"}},
+		{Name: "description", Type: field.TypeString},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "occurred_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "shipment_id", Type: field.TypeString, Nullable: true},
+	}
+	// TrackingEventsTable holds the schema information for the "tracking_events" table.
+	TrackingEventsTable = &schema.Table{
+		Name:       "tracking_events",
+		Columns:    TrackingEventsColumns,
+		PrimaryKey: []*schema.Column{TrackingEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tracking_events_shipments_events",
+				Columns:    []*schema.Column{TrackingEventsColumns[6]},
+				RefColumns: []*schema.Column{ShipmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, Unique: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeString},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
 		{Name: "phone", Type: field.TypeString, Nullable: true},
 		{Name: "profile_image", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"customer", "admin"}, Default: "customer"},
+		{Name: "permissions", Type: field.TypeJSON, Nullable: true},
+		{Name: "totp_secret", Type: field.TypeString, Nullable: true},
+		{Name: "totp_enabled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_last_step", Type: field.TypeInt64, Default: 0},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -282,31 +686,80 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// WebhookEventsColumns holds the columns for the "webhook_events" table.
+	WebhookEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "event_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "transaction_id", Type: field.TypeString, Nullable: true},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookEventsTable holds the schema information for the "webhook_events" table.
+	WebhookEventsTable = &schema.Table{
+		Name:       "webhook_events",
+		Columns:    WebhookEventsColumns,
+		PrimaryKey: []*schema.Column{WebhookEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookevent_provider_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{WebhookEventsColumns[1], WebhookEventsColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccountTokensTable,
 		AddressesTable,
 		AvaliationsTable,
 		CartsTable,
 		CartItemsTable,
 		CategoriesTable,
 		CouponsTable,
+		LoginAttemptsTable,
 		OrdersTable,
 		OrderItemsTable,
+		OrderStatusEventsTable,
+		PaymentsTable,
 		ProductsTable,
+		ProductOptionsTable,
+		ProductVariantsTable,
+		ReturnItemsTable,
+		ReturnRequestsTable,
+		SessionsTable,
+		ShipmentsTable,
+		SlugRedirectsTable,
+		TrackingEventsTable,
 		UsersTable,
+		WebhookEventsTable,
 	}
 )
 
 func init() {
+	AccountTokensTable.ForeignKeys[0].RefTable = UsersTable
 	AddressesTable.ForeignKeys[0].RefTable = UsersTable
 	AvaliationsTable.ForeignKeys[0].RefTable = ProductsTable
 	AvaliationsTable.ForeignKeys[1].RefTable = UsersTable
 	CartsTable.ForeignKeys[0].RefTable = UsersTable
 	CartItemsTable.ForeignKeys[0].RefTable = CartsTable
 	CartItemsTable.ForeignKeys[1].RefTable = ProductsTable
+	CartItemsTable.ForeignKeys[2].RefTable = ProductVariantsTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
 	OrdersTable.ForeignKeys[0].RefTable = AddressesTable
 	OrdersTable.ForeignKeys[1].RefTable = UsersTable
 	OrderItemsTable.ForeignKeys[0].RefTable = OrdersTable
 	OrderItemsTable.ForeignKeys[1].RefTable = ProductsTable
+	OrderItemsTable.ForeignKeys[2].RefTable = ProductVariantsTable
+	OrderStatusEventsTable.ForeignKeys[0].RefTable = OrdersTable
+	PaymentsTable.ForeignKeys[0].RefTable = OrdersTable
 	ProductsTable.ForeignKeys[0].RefTable = CategoriesTable
+	ProductOptionsTable.ForeignKeys[0].RefTable = ProductsTable
+	ProductVariantsTable.ForeignKeys[0].RefTable = ProductsTable
+	ReturnItemsTable.ForeignKeys[0].RefTable = ReturnRequestsTable
+	ReturnRequestsTable.ForeignKeys[0].RefTable = OrdersTable
+	SessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ShipmentsTable.ForeignKeys[0].RefTable = OrdersTable
+	TrackingEventsTable.ForeignKeys[0].RefTable = ShipmentsTable
 }
@@ -11,17 +11,31 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/avaliation"
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/ent/session"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 const (
@@ -33,56 +47,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAddress    = "Address"
-	TypeAvaliation = "Avaliation"
-	TypeCart       = "Cart"
-	TypeCartItem   = "CartItem"
-	TypeCategory   = "Category"
-	TypeCoupon     = "Coupon"
-	TypeOrder      = "Order"
-	TypeOrderItem  = "OrderItem"
-	TypeProduct    = "Product"
-	TypeUser       = "User"
+	TypeAccountToken     = "AccountToken"
+	TypeAddress          = "Address"
+	TypeAvaliation       = "Avaliation"
+	TypeCart             = "Cart"
+	TypeCartItem         = "CartItem"
+	TypeCategory         = "Category"
+	TypeCoupon           = "Coupon"
+	TypeLoginAttempt     = "LoginAttempt"
+	TypeOrder            = "Order"
+	TypeOrderItem        = "OrderItem"
+	TypeOrderStatusEvent = "OrderStatusEvent"
+	TypePayment          = "Payment"
+	TypeProduct          = "Product"
+	TypeProductOption    = "ProductOption"
+	TypeProductVariant   = "ProductVariant"
+	TypeReturnItem       = "ReturnItem"
+	TypeReturnRequest    = "ReturnRequest"
+	TypeSession          = "Session"
+	TypeShipment         = "Shipment"
+	TypeSlugRedirect     = "SlugRedirect"
+	TypeTrackingEvent    = "TrackingEvent"
+	TypeUser             = "User"
+	TypeWebhookEvent     = "WebhookEvent"
 )
 
-// AddressMutation represents an operation that mutates the Address nodes in the graph.
-type AddressMutation struct {
+// AccountTokenMutation represents an operation that mutates the AccountToken nodes in the graph.
+type AccountTokenMutation struct {
 	config
 	op            Op
 	typ           string
 	id            *string
-	cep           *string
-	logradouro    *string
-	numero        *string
-	complemento   *string
-	bairro        *string
-	cidade        *string
-	estado        *string
-	is_default    *bool
+	purpose       *accounttoken.Purpose
+	token_hash    *string
+	email         *string
+	expires_at    *time.Time
+	used_at       *time.Time
 	created_at    *time.Time
-	updated_at    *time.Time
 	clearedFields map[string]struct{}
 	user          *string
 	cleareduser   bool
-	orders        map[string]struct{}
-	removedorders map[string]struct{}
-	clearedorders bool
 	done          bool
-	oldValue      func(context.Context) (*Address, error)
-	predicates    []predicate.Address
+	oldValue      func(context.Context) (*AccountToken, error)
+	predicates    []predicate.AccountToken
 }
 
-var _ ent.Mutation = (*AddressMutation)(nil)
+var _ ent.Mutation = (*AccountTokenMutation)(nil)
 
-// addressOption allows management of the mutation configuration using functional options.
-type addressOption func(*AddressMutation)
+// accounttokenOption allows management of the mutation configuration using functional options.
+type accounttokenOption func(*AccountTokenMutation)
 
-// newAddressMutation creates new mutation for the Address entity.
-func newAddressMutation(c config, op Op, opts ...addressOption) *AddressMutation {
-	m := &AddressMutation{
+// newAccountTokenMutation creates new mutation for the AccountToken entity.
+func newAccountTokenMutation(c config, op Op, opts ...accounttokenOption) *AccountTokenMutation {
+	m := &AccountTokenMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAddress,
+		typ:           TypeAccountToken,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -91,20 +111,20 @@ func newAddressMutation(c config, op Op, opts ...addressOption) *AddressMutation
 	return m
 }
 
-// withAddressID sets the ID field of the mutation.
-func withAddressID(id string) addressOption {
-	return func(m *AddressMutation) {
+// withAccountTokenID sets the ID field of the mutation.
+func withAccountTokenID(id string) accounttokenOption {
+	return func(m *AccountTokenMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Address
+			value *AccountToken
 		)
-		m.oldValue = func(ctx context.Context) (*Address, error) {
+		m.oldValue = func(ctx context.Context) (*AccountToken, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Address.Get(ctx, id)
+					value, err = m.Client().AccountToken.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -113,10 +133,10 @@ func withAddressID(id string) addressOption {
 	}
 }
 
-// withAddress sets the old Address of the mutation.
-func withAddress(node *Address) addressOption {
-	return func(m *AddressMutation) {
-		m.oldValue = func(context.Context) (*Address, error) {
+// withAccountToken sets the old AccountToken of the mutation.
+func withAccountToken(node *AccountToken) accounttokenOption {
+	return func(m *AccountTokenMutation) {
+		m.oldValue = func(context.Context) (*AccountToken, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -125,7 +145,7 @@ func withAddress(node *Address) addressOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AddressMutation) Client() *Client {
+func (m AccountTokenMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -133,7 +153,7 @@ func (m AddressMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AddressMutation) Tx() (*Tx, error) {
+func (m AccountTokenMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -143,14 +163,14 @@ func (m AddressMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Address entities.
-func (m *AddressMutation) SetID(id string) {
+// operation is only accepted on creation of AccountToken entities.
+func (m *AccountTokenMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *AddressMutation) ID() (id string, exists bool) {
+func (m *AccountTokenMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -161,7 +181,7 @@ func (m *AddressMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *AddressMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *AccountTokenMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -170,19 +190,19 @@ func (m *AddressMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Address.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AccountToken.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *AddressMutation) SetUserID(s string) {
+func (m *AccountTokenMutation) SetUserID(s string) {
 	m.user = &s
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *AddressMutation) UserID() (r string, exists bool) {
+func (m *AccountTokenMutation) UserID() (r string, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -190,10 +210,10 @@ func (m *AddressMutation) UserID() (r string, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -208,331 +228,13955 @@ func (m *AddressMutation) OldUserID(ctx context.Context) (v string, err error) {
 }
 
 // ClearUserID clears the value of the "user_id" field.
-func (m *AddressMutation) ClearUserID() {
+func (m *AccountTokenMutation) ClearUserID() {
 	m.user = nil
-	m.clearedFields[address.FieldUserID] = struct{}{}
+	m.clearedFields[accounttoken.FieldUserID] = struct{}{}
 }
 
 // UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *AddressMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[address.FieldUserID]
+func (m *AccountTokenMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[accounttoken.FieldUserID]
 	return ok
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *AddressMutation) ResetUserID() {
+func (m *AccountTokenMutation) ResetUserID() {
 	m.user = nil
-	delete(m.clearedFields, address.FieldUserID)
+	delete(m.clearedFields, accounttoken.FieldUserID)
 }
 
-// SetCep sets the "cep" field.
-func (m *AddressMutation) SetCep(s string) {
-	m.cep = &s
+// SetPurpose sets the "purpose" field.
+func (m *AccountTokenMutation) SetPurpose(a accounttoken.Purpose) {
+	m.purpose = &a
 }
 
-// Cep returns the value of the "cep" field in the mutation.
-func (m *AddressMutation) Cep() (r string, exists bool) {
-	v := m.cep
+// Purpose returns the value of the "purpose" field in the mutation.
+func (m *AccountTokenMutation) Purpose() (r accounttoken.Purpose, exists bool) {
+	v := m.purpose
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCep returns the old "cep" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldPurpose returns the old "purpose" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldCep(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldPurpose(ctx context.Context) (v accounttoken.Purpose, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCep is only allowed on UpdateOne operations")
+		return v, errors.New("OldPurpose is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCep requires an ID field in the mutation")
+		return v, errors.New("OldPurpose requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCep: %w", err)
+		return v, fmt.Errorf("querying old value for OldPurpose: %w", err)
 	}
-	return oldValue.Cep, nil
+	return oldValue.Purpose, nil
 }
 
-// ResetCep resets all changes to the "cep" field.
-func (m *AddressMutation) ResetCep() {
-	m.cep = nil
+// ResetPurpose resets all changes to the "purpose" field.
+func (m *AccountTokenMutation) ResetPurpose() {
+	m.purpose = nil
 }
 
-// SetLogradouro sets the "logradouro" field.
-func (m *AddressMutation) SetLogradouro(s string) {
-	m.logradouro = &s
+// SetTokenHash sets the "token_hash" field.
+func (m *AccountTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Logradouro returns the value of the "logradouro" field in the mutation.
-func (m *AddressMutation) Logradouro() (r string, exists bool) {
-	v := m.logradouro
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *AccountTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLogradouro returns the old "logradouro" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldLogradouro(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogradouro is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogradouro requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogradouro: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Logradouro, nil
+	return oldValue.TokenHash, nil
 }
 
-// ResetLogradouro resets all changes to the "logradouro" field.
-func (m *AddressMutation) ResetLogradouro() {
-	m.logradouro = nil
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *AccountTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// SetNumero sets the "numero" field.
-func (m *AddressMutation) SetNumero(s string) {
-	m.numero = &s
+// SetEmail sets the "email" field.
+func (m *AccountTokenMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Numero returns the value of the "numero" field in the mutation.
-func (m *AddressMutation) Numero() (r string, exists bool) {
-	v := m.numero
+// Email returns the value of the "email" field in the mutation.
+func (m *AccountTokenMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNumero returns the old "numero" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldNumero(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNumero is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNumero requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNumero: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Numero, nil
+	return oldValue.Email, nil
 }
 
-// ResetNumero resets all changes to the "numero" field.
-func (m *AddressMutation) ResetNumero() {
-	m.numero = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *AccountTokenMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetComplemento sets the "complemento" field.
-func (m *AddressMutation) SetComplemento(s string) {
-	m.complemento = &s
+// SetExpiresAt sets the "expires_at" field.
+func (m *AccountTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// Complemento returns the value of the "complemento" field in the mutation.
-func (m *AddressMutation) Complemento() (r string, exists bool) {
-	v := m.complemento
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *AccountTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComplemento returns the old "complemento" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldComplemento(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComplemento is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComplemento requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComplemento: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.Complemento, nil
-}
-
-// ClearComplemento clears the value of the "complemento" field.
-func (m *AddressMutation) ClearComplemento() {
-	m.complemento = nil
-	m.clearedFields[address.FieldComplemento] = struct{}{}
-}
-
-// ComplementoCleared returns if the "complemento" field was cleared in this mutation.
-func (m *AddressMutation) ComplementoCleared() bool {
-	_, ok := m.clearedFields[address.FieldComplemento]
-	return ok
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetComplemento resets all changes to the "complemento" field.
-func (m *AddressMutation) ResetComplemento() {
-	m.complemento = nil
-	delete(m.clearedFields, address.FieldComplemento)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *AccountTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// SetBairro sets the "bairro" field.
-func (m *AddressMutation) SetBairro(s string) {
-	m.bairro = &s
+// SetUsedAt sets the "used_at" field.
+func (m *AccountTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
 }
 
-// Bairro returns the value of the "bairro" field in the mutation.
-func (m *AddressMutation) Bairro() (r string, exists bool) {
-	v := m.bairro
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *AccountTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBairro returns the old "bairro" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldUsedAt returns the old "used_at" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldBairro(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBairro is only allowed on UpdateOne operations")
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBairro requires an ID field in the mutation")
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBairro: %w", err)
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
 	}
-	return oldValue.Bairro, nil
+	return oldValue.UsedAt, nil
 }
 
-// ResetBairro resets all changes to the "bairro" field.
-func (m *AddressMutation) ResetBairro() {
-	m.bairro = nil
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *AccountTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[accounttoken.FieldUsedAt] = struct{}{}
 }
 
-// SetCidade sets the "cidade" field.
-func (m *AddressMutation) SetCidade(s string) {
-	m.cidade = &s
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *AccountTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[accounttoken.FieldUsedAt]
+	return ok
 }
 
-// Cidade returns the value of the "cidade" field in the mutation.
-func (m *AddressMutation) Cidade() (r string, exists bool) {
-	v := m.cidade
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *AccountTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, accounttoken.FieldUsedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AccountTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AccountTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCidade returns the old "cidade" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the AccountToken entity.
+// If the AccountToken object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldCidade(ctx context.Context) (v string, err error) {
+func (m *AccountTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCidade is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCidade requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCidade: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Cidade, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCidade resets all changes to the "cidade" field.
-func (m *AddressMutation) ResetCidade() {
-	m.cidade = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AccountTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetEstado sets the "estado" field.
-func (m *AddressMutation) SetEstado(s string) {
-	m.estado = &s
+// ClearUser clears the "user" edge to the User entity.
+func (m *AccountTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[accounttoken.FieldUserID] = struct{}{}
 }
 
-// Estado returns the value of the "estado" field in the mutation.
-func (m *AddressMutation) Estado() (r string, exists bool) {
-	v := m.estado
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AccountTokenMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
-// OldEstado returns the old "estado" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldEstado(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEstado is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEstado requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEstado: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AccountTokenMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Estado, nil
+	return
 }
 
-// ResetEstado resets all changes to the "estado" field.
-func (m *AddressMutation) ResetEstado() {
-	m.estado = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *AccountTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetIsDefault sets the "is_default" field.
-func (m *AddressMutation) SetIsDefault(b bool) {
-	m.is_default = &b
+// Where appends a list predicates to the AccountTokenMutation builder.
+func (m *AccountTokenMutation) Where(ps ...predicate.AccountToken) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// IsDefault returns the value of the "is_default" field in the mutation.
-func (m *AddressMutation) IsDefault() (r bool, exists bool) {
-	v := m.is_default
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the AccountTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AccountTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AccountToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldIsDefault returns the old "is_default" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
-	}
+// Op returns the operation name.
+func (m *AccountTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AccountTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AccountToken).
+func (m *AccountTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AccountTokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, accounttoken.FieldUserID)
+	}
+	if m.purpose != nil {
+		fields = append(fields, accounttoken.FieldPurpose)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, accounttoken.FieldTokenHash)
+	}
+	if m.email != nil {
+		fields = append(fields, accounttoken.FieldEmail)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, accounttoken.FieldExpiresAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, accounttoken.FieldUsedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, accounttoken.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AccountTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case accounttoken.FieldUserID:
+		return m.UserID()
+	case accounttoken.FieldPurpose:
+		return m.Purpose()
+	case accounttoken.FieldTokenHash:
+		return m.TokenHash()
+	case accounttoken.FieldEmail:
+		return m.Email()
+	case accounttoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case accounttoken.FieldUsedAt:
+		return m.UsedAt()
+	case accounttoken.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AccountTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case accounttoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case accounttoken.FieldPurpose:
+		return m.OldPurpose(ctx)
+	case accounttoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case accounttoken.FieldEmail:
+		return m.OldEmail(ctx)
+	case accounttoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case accounttoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case accounttoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AccountToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case accounttoken.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case accounttoken.FieldPurpose:
+		v, ok := value.(accounttoken.Purpose)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPurpose(v)
+		return nil
+	case accounttoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case accounttoken.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case accounttoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case accounttoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case accounttoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AccountToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AccountTokenMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AccountTokenMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AccountTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AccountToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AccountTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(accounttoken.FieldUserID) {
+		fields = append(fields, accounttoken.FieldUserID)
+	}
+	if m.FieldCleared(accounttoken.FieldUsedAt) {
+		fields = append(fields, accounttoken.FieldUsedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AccountTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AccountTokenMutation) ClearField(name string) error {
+	switch name {
+	case accounttoken.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case accounttoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AccountToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AccountTokenMutation) ResetField(name string) error {
+	switch name {
+	case accounttoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case accounttoken.FieldPurpose:
+		m.ResetPurpose()
+		return nil
+	case accounttoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case accounttoken.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case accounttoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case accounttoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case accounttoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AccountToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AccountTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, accounttoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AccountTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case accounttoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AccountTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AccountTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AccountTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, accounttoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AccountTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case accounttoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AccountTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case accounttoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AccountToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AccountTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case accounttoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown AccountToken edge %s", name)
+}
+
+// AddressMutation represents an operation that mutates the Address nodes in the graph.
+type AddressMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	cep           *string
+	logradouro    *string
+	numero        *string
+	complemento   *string
+	bairro        *string
+	cidade        *string
+	estado        *string
+	is_default    *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	orders        map[string]struct{}
+	removedorders map[string]struct{}
+	clearedorders bool
+	done          bool
+	oldValue      func(context.Context) (*Address, error)
+	predicates    []predicate.Address
+}
+
+var _ ent.Mutation = (*AddressMutation)(nil)
+
+// addressOption allows management of the mutation configuration using functional options.
+type addressOption func(*AddressMutation)
+
+// newAddressMutation creates new mutation for the Address entity.
+func newAddressMutation(c config, op Op, opts ...addressOption) *AddressMutation {
+	m := &AddressMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAddress,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAddressID sets the ID field of the mutation.
+func withAddressID(id string) addressOption {
+	return func(m *AddressMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Address
+		)
+		m.oldValue = func(ctx context.Context) (*Address, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Address.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAddress sets the old Address of the mutation.
+func withAddress(node *Address) addressOption {
+	return func(m *AddressMutation) {
+		m.oldValue = func(context.Context) (*Address, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AddressMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AddressMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Address entities.
+func (m *AddressMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AddressMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AddressMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Address.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AddressMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AddressMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AddressMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[address.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AddressMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[address.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AddressMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, address.FieldUserID)
+}
+
+// SetCep sets the "cep" field.
+func (m *AddressMutation) SetCep(s string) {
+	m.cep = &s
+}
+
+// Cep returns the value of the "cep" field in the mutation.
+func (m *AddressMutation) Cep() (r string, exists bool) {
+	v := m.cep
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCep returns the old "cep" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldCep(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCep: %w", err)
+	}
+	return oldValue.Cep, nil
+}
+
+// ResetCep resets all changes to the "cep" field.
+func (m *AddressMutation) ResetCep() {
+	m.cep = nil
+}
+
+// SetLogradouro sets the "logradouro" field.
+func (m *AddressMutation) SetLogradouro(s string) {
+	m.logradouro = &s
+}
+
+// Logradouro returns the value of the "logradouro" field in the mutation.
+func (m *AddressMutation) Logradouro() (r string, exists bool) {
+	v := m.logradouro
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogradouro returns the old "logradouro" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldLogradouro(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogradouro is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogradouro requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogradouro: %w", err)
+	}
+	return oldValue.Logradouro, nil
+}
+
+// ResetLogradouro resets all changes to the "logradouro" field.
+func (m *AddressMutation) ResetLogradouro() {
+	m.logradouro = nil
+}
+
+// SetNumero sets the "numero" field.
+func (m *AddressMutation) SetNumero(s string) {
+	m.numero = &s
+}
+
+// Numero returns the value of the "numero" field in the mutation.
+func (m *AddressMutation) Numero() (r string, exists bool) {
+	v := m.numero
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNumero returns the old "numero" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldNumero(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNumero is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNumero requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNumero: %w", err)
+	}
+	return oldValue.Numero, nil
+}
+
+// ResetNumero resets all changes to the "numero" field.
+func (m *AddressMutation) ResetNumero() {
+	m.numero = nil
+}
+
+// SetComplemento sets the "complemento" field.
+func (m *AddressMutation) SetComplemento(s string) {
+	m.complemento = &s
+}
+
+// Complemento returns the value of the "complemento" field in the mutation.
+func (m *AddressMutation) Complemento() (r string, exists bool) {
+	v := m.complemento
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComplemento returns the old "complemento" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldComplemento(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComplemento is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComplemento requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComplemento: %w", err)
+	}
+	return oldValue.Complemento, nil
+}
+
+// ClearComplemento clears the value of the "complemento" field.
+func (m *AddressMutation) ClearComplemento() {
+	m.complemento = nil
+	m.clearedFields[address.FieldComplemento] = struct{}{}
+}
+
+// ComplementoCleared returns if the "complemento" field was cleared in this mutation.
+func (m *AddressMutation) ComplementoCleared() bool {
+	_, ok := m.clearedFields[address.FieldComplemento]
+	return ok
+}
+
+// ResetComplemento resets all changes to the "complemento" field.
+func (m *AddressMutation) ResetComplemento() {
+	m.complemento = nil
+	delete(m.clearedFields, address.FieldComplemento)
+}
+
+// SetBairro sets the "bairro" field.
+func (m *AddressMutation) SetBairro(s string) {
+	m.bairro = &s
+}
+
+// Bairro returns the value of the "bairro" field in the mutation.
+func (m *AddressMutation) Bairro() (r string, exists bool) {
+	v := m.bairro
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBairro returns the old "bairro" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldBairro(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBairro is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBairro requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBairro: %w", err)
+	}
+	return oldValue.Bairro, nil
+}
+
+// ResetBairro resets all changes to the "bairro" field.
+func (m *AddressMutation) ResetBairro() {
+	m.bairro = nil
+}
+
+// SetCidade sets the "cidade" field.
+func (m *AddressMutation) SetCidade(s string) {
+	m.cidade = &s
+}
+
+// Cidade returns the value of the "cidade" field in the mutation.
+func (m *AddressMutation) Cidade() (r string, exists bool) {
+	v := m.cidade
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCidade returns the old "cidade" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldCidade(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCidade is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCidade requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCidade: %w", err)
+	}
+	return oldValue.Cidade, nil
+}
+
+// ResetCidade resets all changes to the "cidade" field.
+func (m *AddressMutation) ResetCidade() {
+	m.cidade = nil
+}
+
+// SetEstado sets the "estado" field.
+func (m *AddressMutation) SetEstado(s string) {
+	m.estado = &s
+}
+
+// Estado returns the value of the "estado" field in the mutation.
+func (m *AddressMutation) Estado() (r string, exists bool) {
+	v := m.estado
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEstado returns the old "estado" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldEstado(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEstado is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEstado requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEstado: %w", err)
+	}
+	return oldValue.Estado, nil
+}
+
+// ResetEstado resets all changes to the "estado" field.
+func (m *AddressMutation) ResetEstado() {
+	m.estado = nil
+}
+
+// SetIsDefault sets the "is_default" field.
+func (m *AddressMutation) SetIsDefault(b bool) {
+	m.is_default = &b
+}
+
+// IsDefault returns the value of the "is_default" field in the mutation.
+func (m *AddressMutation) IsDefault() (r bool, exists bool) {
+	v := m.is_default
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDefault returns the old "is_default" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldIsDefault(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDefault is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+	}
+	return oldValue.IsDefault, nil
+}
+
+// ResetIsDefault resets all changes to the "is_default" field.
+func (m *AddressMutation) ResetIsDefault() {
+	m.is_default = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AddressMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AddressMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AddressMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AddressMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AddressMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Address entity.
+// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AddressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AddressMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *AddressMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[address.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AddressMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AddressMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *AddressMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by ids.
+func (m *AddressMutation) AddOrderIDs(ids ...string) {
+	if m.orders == nil {
+		m.orders = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.orders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrders clears the "orders" edge to the Order entity.
+func (m *AddressMutation) ClearOrders() {
+	m.clearedorders = true
+}
+
+// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
+func (m *AddressMutation) OrdersCleared() bool {
+	return m.clearedorders
+}
+
+// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
+func (m *AddressMutation) RemoveOrderIDs(ids ...string) {
+	if m.removedorders == nil {
+		m.removedorders = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.orders, ids[i])
+		m.removedorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
+func (m *AddressMutation) RemovedOrdersIDs() (ids []string) {
+	for id := range m.removedorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrdersIDs returns the "orders" edge IDs in the mutation.
+func (m *AddressMutation) OrdersIDs() (ids []string) {
+	for id := range m.orders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrders resets all changes to the "orders" edge.
+func (m *AddressMutation) ResetOrders() {
+	m.orders = nil
+	m.clearedorders = false
+	m.removedorders = nil
+}
+
+// Where appends a list predicates to the AddressMutation builder.
+func (m *AddressMutation) Where(ps ...predicate.Address) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AddressMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AddressMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Address, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AddressMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AddressMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Address).
+func (m *AddressMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AddressMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user != nil {
+		fields = append(fields, address.FieldUserID)
+	}
+	if m.cep != nil {
+		fields = append(fields, address.FieldCep)
+	}
+	if m.logradouro != nil {
+		fields = append(fields, address.FieldLogradouro)
+	}
+	if m.numero != nil {
+		fields = append(fields, address.FieldNumero)
+	}
+	if m.complemento != nil {
+		fields = append(fields, address.FieldComplemento)
+	}
+	if m.bairro != nil {
+		fields = append(fields, address.FieldBairro)
+	}
+	if m.cidade != nil {
+		fields = append(fields, address.FieldCidade)
+	}
+	if m.estado != nil {
+		fields = append(fields, address.FieldEstado)
+	}
+	if m.is_default != nil {
+		fields = append(fields, address.FieldIsDefault)
+	}
+	if m.created_at != nil {
+		fields = append(fields, address.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, address.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AddressMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case address.FieldUserID:
+		return m.UserID()
+	case address.FieldCep:
+		return m.Cep()
+	case address.FieldLogradouro:
+		return m.Logradouro()
+	case address.FieldNumero:
+		return m.Numero()
+	case address.FieldComplemento:
+		return m.Complemento()
+	case address.FieldBairro:
+		return m.Bairro()
+	case address.FieldCidade:
+		return m.Cidade()
+	case address.FieldEstado:
+		return m.Estado()
+	case address.FieldIsDefault:
+		return m.IsDefault()
+	case address.FieldCreatedAt:
+		return m.CreatedAt()
+	case address.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case address.FieldUserID:
+		return m.OldUserID(ctx)
+	case address.FieldCep:
+		return m.OldCep(ctx)
+	case address.FieldLogradouro:
+		return m.OldLogradouro(ctx)
+	case address.FieldNumero:
+		return m.OldNumero(ctx)
+	case address.FieldComplemento:
+		return m.OldComplemento(ctx)
+	case address.FieldBairro:
+		return m.OldBairro(ctx)
+	case address.FieldCidade:
+		return m.OldCidade(ctx)
+	case address.FieldEstado:
+		return m.OldEstado(ctx)
+	case address.FieldIsDefault:
+		return m.OldIsDefault(ctx)
+	case address.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case address.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Address field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AddressMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case address.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case address.FieldCep:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCep(v)
+		return nil
+	case address.FieldLogradouro:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogradouro(v)
+		return nil
+	case address.FieldNumero:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNumero(v)
+		return nil
+	case address.FieldComplemento:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComplemento(v)
+		return nil
+	case address.FieldBairro:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBairro(v)
+		return nil
+	case address.FieldCidade:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCidade(v)
+		return nil
+	case address.FieldEstado:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstado(v)
+		return nil
+	case address.FieldIsDefault:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDefault(v)
+		return nil
+	case address.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case address.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Address field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AddressMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AddressMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AddressMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Address numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AddressMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(address.FieldUserID) {
+		fields = append(fields, address.FieldUserID)
+	}
+	if m.FieldCleared(address.FieldComplemento) {
+		fields = append(fields, address.FieldComplemento)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AddressMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AddressMutation) ClearField(name string) error {
+	switch name {
+	case address.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case address.FieldComplemento:
+		m.ClearComplemento()
+		return nil
+	}
+	return fmt.Errorf("unknown Address nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AddressMutation) ResetField(name string) error {
+	switch name {
+	case address.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case address.FieldCep:
+		m.ResetCep()
+		return nil
+	case address.FieldLogradouro:
+		m.ResetLogradouro()
+		return nil
+	case address.FieldNumero:
+		m.ResetNumero()
+		return nil
+	case address.FieldComplemento:
+		m.ResetComplemento()
+		return nil
+	case address.FieldBairro:
+		m.ResetBairro()
+		return nil
+	case address.FieldCidade:
+		m.ResetCidade()
+		return nil
+	case address.FieldEstado:
+		m.ResetEstado()
+		return nil
+	case address.FieldIsDefault:
+		m.ResetIsDefault()
+		return nil
+	case address.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case address.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Address field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AddressMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, address.EdgeUser)
+	}
+	if m.orders != nil {
+		edges = append(edges, address.EdgeOrders)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AddressMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case address.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case address.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.orders))
+		for id := range m.orders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AddressMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedorders != nil {
+		edges = append(edges, address.EdgeOrders)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AddressMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case address.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.removedorders))
+		for id := range m.removedorders {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AddressMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, address.EdgeUser)
+	}
+	if m.clearedorders {
+		edges = append(edges, address.EdgeOrders)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AddressMutation) EdgeCleared(name string) bool {
+	switch name {
+	case address.EdgeUser:
+		return m.cleareduser
+	case address.EdgeOrders:
+		return m.clearedorders
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AddressMutation) ClearEdge(name string) error {
+	switch name {
+	case address.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Address unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AddressMutation) ResetEdge(name string) error {
+	switch name {
+	case address.EdgeUser:
+		m.ResetUser()
+		return nil
+	case address.EdgeOrders:
+		m.ResetOrders()
+		return nil
+	}
+	return fmt.Errorf("unknown Address edge %s", name)
+}
+
+// AvaliationMutation represents an operation that mutates the Avaliation nodes in the graph.
+type AvaliationMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	user_name      *string
+	rating         *int
+	addrating      *int
+	comment        *string
+	date           *time.Time
+	images         *[]string
+	appendimages   []string
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	product        *string
+	clearedproduct bool
+	user           *string
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*Avaliation, error)
+	predicates     []predicate.Avaliation
+}
+
+var _ ent.Mutation = (*AvaliationMutation)(nil)
+
+// avaliationOption allows management of the mutation configuration using functional options.
+type avaliationOption func(*AvaliationMutation)
+
+// newAvaliationMutation creates new mutation for the Avaliation entity.
+func newAvaliationMutation(c config, op Op, opts ...avaliationOption) *AvaliationMutation {
+	m := &AvaliationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAvaliation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withAvaliationID sets the ID field of the mutation.
+func withAvaliationID(id string) avaliationOption {
+	return func(m *AvaliationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Avaliation
+		)
+		m.oldValue = func(ctx context.Context) (*Avaliation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Avaliation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withAvaliation sets the old Avaliation of the mutation.
+func withAvaliation(node *Avaliation) avaliationOption {
+	return func(m *AvaliationMutation) {
+		m.oldValue = func(context.Context) (*Avaliation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AvaliationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AvaliationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Avaliation entities.
+func (m *AvaliationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AvaliationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AvaliationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Avaliation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProductID sets the "product_id" field.
+func (m *AvaliationMutation) SetProductID(s string) {
+	m.product = &s
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *AvaliationMutation) ProductID() (r string, exists bool) {
+	v := m.product
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldProductID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (m *AvaliationMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[avaliation.FieldProductID] = struct{}{}
+}
+
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *AvaliationMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[avaliation.FieldProductID]
+	return ok
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *AvaliationMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, avaliation.FieldProductID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *AvaliationMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *AvaliationMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *AvaliationMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[avaliation.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *AvaliationMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[avaliation.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *AvaliationMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, avaliation.FieldUserID)
+}
+
+// SetUserName sets the "user_name" field.
+func (m *AvaliationMutation) SetUserName(s string) {
+	m.user_name = &s
+}
+
+// UserName returns the value of the "user_name" field in the mutation.
+func (m *AvaliationMutation) UserName() (r string, exists bool) {
+	v := m.user_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserName returns the old "user_name" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldUserName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+	}
+	return oldValue.UserName, nil
+}
+
+// ResetUserName resets all changes to the "user_name" field.
+func (m *AvaliationMutation) ResetUserName() {
+	m.user_name = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *AvaliationMutation) SetRating(i int) {
+	m.rating = &i
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *AvaliationMutation) Rating() (r int, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldRating(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds i to the "rating" field.
+func (m *AvaliationMutation) AddRating(i int) {
+	if m.addrating != nil {
+		*m.addrating += i
+	} else {
+		m.addrating = &i
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *AvaliationMutation) AddedRating() (r int, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *AvaliationMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetComment sets the "comment" field.
+func (m *AvaliationMutation) SetComment(s string) {
+	m.comment = &s
+}
+
+// Comment returns the value of the "comment" field in the mutation.
+func (m *AvaliationMutation) Comment() (r string, exists bool) {
+	v := m.comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldComment returns the old "comment" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+	}
+	return oldValue.Comment, nil
+}
+
+// ResetComment resets all changes to the "comment" field.
+func (m *AvaliationMutation) ResetComment() {
+	m.comment = nil
+}
+
+// SetDate sets the "date" field.
+func (m *AvaliationMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *AvaliationMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *AvaliationMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetImages sets the "images" field.
+func (m *AvaliationMutation) SetImages(s []string) {
+	m.images = &s
+	m.appendimages = nil
+}
+
+// Images returns the value of the "images" field in the mutation.
+func (m *AvaliationMutation) Images() (r []string, exists bool) {
+	v := m.images
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImages returns the old "images" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldImages(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+	}
+	return oldValue.Images, nil
+}
+
+// AppendImages adds s to the "images" field.
+func (m *AvaliationMutation) AppendImages(s []string) {
+	m.appendimages = append(m.appendimages, s...)
+}
+
+// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
+func (m *AvaliationMutation) AppendedImages() ([]string, bool) {
+	if len(m.appendimages) == 0 {
+		return nil, false
+	}
+	return m.appendimages, true
+}
+
+// ClearImages clears the value of the "images" field.
+func (m *AvaliationMutation) ClearImages() {
+	m.images = nil
+	m.appendimages = nil
+	m.clearedFields[avaliation.FieldImages] = struct{}{}
+}
+
+// ImagesCleared returns if the "images" field was cleared in this mutation.
+func (m *AvaliationMutation) ImagesCleared() bool {
+	_, ok := m.clearedFields[avaliation.FieldImages]
+	return ok
+}
+
+// ResetImages resets all changes to the "images" field.
+func (m *AvaliationMutation) ResetImages() {
+	m.images = nil
+	m.appendimages = nil
+	delete(m.clearedFields, avaliation.FieldImages)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AvaliationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AvaliationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AvaliationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *AvaliationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *AvaliationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Avaliation entity.
+// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AvaliationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *AvaliationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *AvaliationMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[avaliation.FieldProductID] = struct{}{}
+}
+
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *AvaliationMutation) ProductCleared() bool {
+	return m.ProductIDCleared() || m.clearedproduct
+}
+
+// ProductIDs returns the "product" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *AvaliationMutation) ProductIDs() (ids []string) {
+	if id := m.product; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProduct resets all changes to the "product" edge.
+func (m *AvaliationMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *AvaliationMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[avaliation.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *AvaliationMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *AvaliationMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *AvaliationMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the AvaliationMutation builder.
+func (m *AvaliationMutation) Where(ps ...predicate.Avaliation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AvaliationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AvaliationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Avaliation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AvaliationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AvaliationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Avaliation).
+func (m *AvaliationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AvaliationMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.product != nil {
+		fields = append(fields, avaliation.FieldProductID)
+	}
+	if m.user != nil {
+		fields = append(fields, avaliation.FieldUserID)
+	}
+	if m.user_name != nil {
+		fields = append(fields, avaliation.FieldUserName)
+	}
+	if m.rating != nil {
+		fields = append(fields, avaliation.FieldRating)
+	}
+	if m.comment != nil {
+		fields = append(fields, avaliation.FieldComment)
+	}
+	if m.date != nil {
+		fields = append(fields, avaliation.FieldDate)
+	}
+	if m.images != nil {
+		fields = append(fields, avaliation.FieldImages)
+	}
+	if m.created_at != nil {
+		fields = append(fields, avaliation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, avaliation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AvaliationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case avaliation.FieldProductID:
+		return m.ProductID()
+	case avaliation.FieldUserID:
+		return m.UserID()
+	case avaliation.FieldUserName:
+		return m.UserName()
+	case avaliation.FieldRating:
+		return m.Rating()
+	case avaliation.FieldComment:
+		return m.Comment()
+	case avaliation.FieldDate:
+		return m.Date()
+	case avaliation.FieldImages:
+		return m.Images()
+	case avaliation.FieldCreatedAt:
+		return m.CreatedAt()
+	case avaliation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AvaliationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case avaliation.FieldProductID:
+		return m.OldProductID(ctx)
+	case avaliation.FieldUserID:
+		return m.OldUserID(ctx)
+	case avaliation.FieldUserName:
+		return m.OldUserName(ctx)
+	case avaliation.FieldRating:
+		return m.OldRating(ctx)
+	case avaliation.FieldComment:
+		return m.OldComment(ctx)
+	case avaliation.FieldDate:
+		return m.OldDate(ctx)
+	case avaliation.FieldImages:
+		return m.OldImages(ctx)
+	case avaliation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case avaliation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Avaliation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AvaliationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case avaliation.FieldProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case avaliation.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case avaliation.FieldUserName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserName(v)
+		return nil
+	case avaliation.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRating(v)
+		return nil
+	case avaliation.FieldComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetComment(v)
+		return nil
+	case avaliation.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case avaliation.FieldImages:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImages(v)
+		return nil
+	case avaliation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case avaliation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AvaliationMutation) AddedFields() []string {
+	var fields []string
+	if m.addrating != nil {
+		fields = append(fields, avaliation.FieldRating)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AvaliationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case avaliation.FieldRating:
+		return m.AddedRating()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AvaliationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case avaliation.FieldRating:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AvaliationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(avaliation.FieldProductID) {
+		fields = append(fields, avaliation.FieldProductID)
+	}
+	if m.FieldCleared(avaliation.FieldUserID) {
+		fields = append(fields, avaliation.FieldUserID)
+	}
+	if m.FieldCleared(avaliation.FieldImages) {
+		fields = append(fields, avaliation.FieldImages)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AvaliationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AvaliationMutation) ClearField(name string) error {
+	switch name {
+	case avaliation.FieldProductID:
+		m.ClearProductID()
+		return nil
+	case avaliation.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case avaliation.FieldImages:
+		m.ClearImages()
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AvaliationMutation) ResetField(name string) error {
+	switch name {
+	case avaliation.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case avaliation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case avaliation.FieldUserName:
+		m.ResetUserName()
+		return nil
+	case avaliation.FieldRating:
+		m.ResetRating()
+		return nil
+	case avaliation.FieldComment:
+		m.ResetComment()
+		return nil
+	case avaliation.FieldDate:
+		m.ResetDate()
+		return nil
+	case avaliation.FieldImages:
+		m.ResetImages()
+		return nil
+	case avaliation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case avaliation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AvaliationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.product != nil {
+		edges = append(edges, avaliation.EdgeProduct)
+	}
+	if m.user != nil {
+		edges = append(edges, avaliation.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AvaliationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case avaliation.EdgeProduct:
+		if id := m.product; id != nil {
+			return []ent.Value{*id}
+		}
+	case avaliation.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AvaliationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AvaliationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AvaliationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedproduct {
+		edges = append(edges, avaliation.EdgeProduct)
+	}
+	if m.cleareduser {
+		edges = append(edges, avaliation.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AvaliationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case avaliation.EdgeProduct:
+		return m.clearedproduct
+	case avaliation.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AvaliationMutation) ClearEdge(name string) error {
+	switch name {
+	case avaliation.EdgeProduct:
+		m.ClearProduct()
+		return nil
+	case avaliation.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AvaliationMutation) ResetEdge(name string) error {
+	switch name {
+	case avaliation.EdgeProduct:
+		m.ResetProduct()
+		return nil
+	case avaliation.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Avaliation edge %s", name)
+}
+
+// CartMutation represents an operation that mutates the Cart nodes in the graph.
+type CartMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	subtotal          *money.Amount
+	addsubtotal       *money.Amount
+	shipping          *money.Amount
+	addshipping       *money.Amount
+	shipping_service  *string
+	shipping_cep      *string
+	discount          *money.Amount
+	adddiscount       *money.Amount
+	total             *money.Amount
+	addtotal          *money.Amount
+	applied_coupon    *bool
+	coupon_code       *string
+	expires_at        *time.Time
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *string
+	cleareduser       bool
+	cart_items        map[string]struct{}
+	removedcart_items map[string]struct{}
+	clearedcart_items bool
+	done              bool
+	oldValue          func(context.Context) (*Cart, error)
+	predicates        []predicate.Cart
+}
+
+var _ ent.Mutation = (*CartMutation)(nil)
+
+// cartOption allows management of the mutation configuration using functional options.
+type cartOption func(*CartMutation)
+
+// newCartMutation creates new mutation for the Cart entity.
+func newCartMutation(c config, op Op, opts ...cartOption) *CartMutation {
+	m := &CartMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCart,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCartID sets the ID field of the mutation.
+func withCartID(id string) cartOption {
+	return func(m *CartMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Cart
+		)
+		m.oldValue = func(ctx context.Context) (*Cart, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Cart.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCart sets the old Cart of the mutation.
+func withCart(node *Cart) cartOption {
+	return func(m *CartMutation) {
+		m.oldValue = func(context.Context) (*Cart, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CartMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CartMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Cart entities.
+func (m *CartMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CartMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CartMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Cart.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *CartMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *CartMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *CartMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[cart.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *CartMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[cart.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *CartMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, cart.FieldUserID)
+}
+
+// SetSubtotal sets the "subtotal" field.
+func (m *CartMutation) SetSubtotal(value money.Amount) {
+	m.subtotal = &value
+	m.addsubtotal = nil
+}
+
+// Subtotal returns the value of the "subtotal" field in the mutation.
+func (m *CartMutation) Subtotal() (r money.Amount, exists bool) {
+	v := m.subtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubtotal returns the old "subtotal" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldSubtotal(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubtotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubtotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
+	}
+	return oldValue.Subtotal, nil
+}
+
+// AddSubtotal adds value to the "subtotal" field.
+func (m *CartMutation) AddSubtotal(value money.Amount) {
+	if m.addsubtotal != nil {
+		*m.addsubtotal += value
+	} else {
+		m.addsubtotal = &value
+	}
+}
+
+// AddedSubtotal returns the value that was added to the "subtotal" field in this mutation.
+func (m *CartMutation) AddedSubtotal() (r money.Amount, exists bool) {
+	v := m.addsubtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSubtotal resets all changes to the "subtotal" field.
+func (m *CartMutation) ResetSubtotal() {
+	m.subtotal = nil
+	m.addsubtotal = nil
+}
+
+// SetShipping sets the "shipping" field.
+func (m *CartMutation) SetShipping(value money.Amount) {
+	m.shipping = &value
+	m.addshipping = nil
+}
+
+// Shipping returns the value of the "shipping" field in the mutation.
+func (m *CartMutation) Shipping() (r money.Amount, exists bool) {
+	v := m.shipping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShipping returns the old "shipping" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldShipping(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShipping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShipping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShipping: %w", err)
+	}
+	return oldValue.Shipping, nil
+}
+
+// AddShipping adds value to the "shipping" field.
+func (m *CartMutation) AddShipping(value money.Amount) {
+	if m.addshipping != nil {
+		*m.addshipping += value
+	} else {
+		m.addshipping = &value
+	}
+}
+
+// AddedShipping returns the value that was added to the "shipping" field in this mutation.
+func (m *CartMutation) AddedShipping() (r money.Amount, exists bool) {
+	v := m.addshipping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearShipping clears the value of the "shipping" field.
+func (m *CartMutation) ClearShipping() {
+	m.shipping = nil
+	m.addshipping = nil
+	m.clearedFields[cart.FieldShipping] = struct{}{}
+}
+
+// ShippingCleared returns if the "shipping" field was cleared in this mutation.
+func (m *CartMutation) ShippingCleared() bool {
+	_, ok := m.clearedFields[cart.FieldShipping]
+	return ok
+}
+
+// ResetShipping resets all changes to the "shipping" field.
+func (m *CartMutation) ResetShipping() {
+	m.shipping = nil
+	m.addshipping = nil
+	delete(m.clearedFields, cart.FieldShipping)
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (m *CartMutation) SetShippingService(s string) {
+	m.shipping_service = &s
+}
+
+// ShippingService returns the value of the "shipping_service" field in the mutation.
+func (m *CartMutation) ShippingService() (r string, exists bool) {
+	v := m.shipping_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShippingService returns the old "shipping_service" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldShippingService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShippingService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShippingService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShippingService: %w", err)
+	}
+	return oldValue.ShippingService, nil
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (m *CartMutation) ClearShippingService() {
+	m.shipping_service = nil
+	m.clearedFields[cart.FieldShippingService] = struct{}{}
+}
+
+// ShippingServiceCleared returns if the "shipping_service" field was cleared in this mutation.
+func (m *CartMutation) ShippingServiceCleared() bool {
+	_, ok := m.clearedFields[cart.FieldShippingService]
+	return ok
+}
+
+// ResetShippingService resets all changes to the "shipping_service" field.
+func (m *CartMutation) ResetShippingService() {
+	m.shipping_service = nil
+	delete(m.clearedFields, cart.FieldShippingService)
+}
+
+// SetShippingCep sets the "shipping_cep" field.
+func (m *CartMutation) SetShippingCep(s string) {
+	m.shipping_cep = &s
+}
+
+// ShippingCep returns the value of the "shipping_cep" field in the mutation.
+func (m *CartMutation) ShippingCep() (r string, exists bool) {
+	v := m.shipping_cep
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShippingCep returns the old "shipping_cep" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldShippingCep(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShippingCep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShippingCep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShippingCep: %w", err)
+	}
+	return oldValue.ShippingCep, nil
+}
+
+// ClearShippingCep clears the value of the "shipping_cep" field.
+func (m *CartMutation) ClearShippingCep() {
+	m.shipping_cep = nil
+	m.clearedFields[cart.FieldShippingCep] = struct{}{}
+}
+
+// ShippingCepCleared returns if the "shipping_cep" field was cleared in this mutation.
+func (m *CartMutation) ShippingCepCleared() bool {
+	_, ok := m.clearedFields[cart.FieldShippingCep]
+	return ok
+}
+
+// ResetShippingCep resets all changes to the "shipping_cep" field.
+func (m *CartMutation) ResetShippingCep() {
+	m.shipping_cep = nil
+	delete(m.clearedFields, cart.FieldShippingCep)
+}
+
+// SetDiscount sets the "discount" field.
+func (m *CartMutation) SetDiscount(value money.Amount) {
+	m.discount = &value
+	m.adddiscount = nil
+}
+
+// Discount returns the value of the "discount" field in the mutation.
+func (m *CartMutation) Discount() (r money.Amount, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscount returns the old "discount" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldDiscount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	}
+	return oldValue.Discount, nil
+}
+
+// AddDiscount adds value to the "discount" field.
+func (m *CartMutation) AddDiscount(value money.Amount) {
+	if m.adddiscount != nil {
+		*m.adddiscount += value
+	} else {
+		m.adddiscount = &value
+	}
+}
+
+// AddedDiscount returns the value that was added to the "discount" field in this mutation.
+func (m *CartMutation) AddedDiscount() (r money.Amount, exists bool) {
+	v := m.adddiscount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscount resets all changes to the "discount" field.
+func (m *CartMutation) ResetDiscount() {
+	m.discount = nil
+	m.adddiscount = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *CartMutation) SetTotal(value money.Amount) {
+	m.total = &value
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *CartMutation) Total() (r money.Amount, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldTotal(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds value to the "total" field.
+func (m *CartMutation) AddTotal(value money.Amount) {
+	if m.addtotal != nil {
+		*m.addtotal += value
+	} else {
+		m.addtotal = &value
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *CartMutation) AddedTotal() (r money.Amount, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *CartMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetAppliedCoupon sets the "applied_coupon" field.
+func (m *CartMutation) SetAppliedCoupon(b bool) {
+	m.applied_coupon = &b
+}
+
+// AppliedCoupon returns the value of the "applied_coupon" field in the mutation.
+func (m *CartMutation) AppliedCoupon() (r bool, exists bool) {
+	v := m.applied_coupon
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppliedCoupon returns the old "applied_coupon" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldAppliedCoupon(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppliedCoupon is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppliedCoupon requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppliedCoupon: %w", err)
+	}
+	return oldValue.AppliedCoupon, nil
+}
+
+// ResetAppliedCoupon resets all changes to the "applied_coupon" field.
+func (m *CartMutation) ResetAppliedCoupon() {
+	m.applied_coupon = nil
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (m *CartMutation) SetCouponCode(s string) {
+	m.coupon_code = &s
+}
+
+// CouponCode returns the value of the "coupon_code" field in the mutation.
+func (m *CartMutation) CouponCode() (r string, exists bool) {
+	v := m.coupon_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponCode returns the old "coupon_code" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldCouponCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponCode: %w", err)
+	}
+	return oldValue.CouponCode, nil
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (m *CartMutation) ClearCouponCode() {
+	m.coupon_code = nil
+	m.clearedFields[cart.FieldCouponCode] = struct{}{}
+}
+
+// CouponCodeCleared returns if the "coupon_code" field was cleared in this mutation.
+func (m *CartMutation) CouponCodeCleared() bool {
+	_, ok := m.clearedFields[cart.FieldCouponCode]
+	return ok
+}
+
+// ResetCouponCode resets all changes to the "coupon_code" field.
+func (m *CartMutation) ResetCouponCode() {
+	m.coupon_code = nil
+	delete(m.clearedFields, cart.FieldCouponCode)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *CartMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CartMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *CartMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[cart.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *CartMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[cart.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CartMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, cart.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CartMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CartMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CartMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CartMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CartMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Cart entity.
+// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CartMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *CartMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[cart.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *CartMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *CartMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *CartMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by ids.
+func (m *CartMutation) AddCartItemIDs(ids ...string) {
+	if m.cart_items == nil {
+		m.cart_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.cart_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCartItems clears the "cart_items" edge to the CartItem entity.
+func (m *CartMutation) ClearCartItems() {
+	m.clearedcart_items = true
+}
+
+// CartItemsCleared reports if the "cart_items" edge to the CartItem entity was cleared.
+func (m *CartMutation) CartItemsCleared() bool {
+	return m.clearedcart_items
+}
+
+// RemoveCartItemIDs removes the "cart_items" edge to the CartItem entity by IDs.
+func (m *CartMutation) RemoveCartItemIDs(ids ...string) {
+	if m.removedcart_items == nil {
+		m.removedcart_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.cart_items, ids[i])
+		m.removedcart_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCartItems returns the removed IDs of the "cart_items" edge to the CartItem entity.
+func (m *CartMutation) RemovedCartItemsIDs() (ids []string) {
+	for id := range m.removedcart_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CartItemsIDs returns the "cart_items" edge IDs in the mutation.
+func (m *CartMutation) CartItemsIDs() (ids []string) {
+	for id := range m.cart_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCartItems resets all changes to the "cart_items" edge.
+func (m *CartMutation) ResetCartItems() {
+	m.cart_items = nil
+	m.clearedcart_items = false
+	m.removedcart_items = nil
+}
+
+// Where appends a list predicates to the CartMutation builder.
+func (m *CartMutation) Where(ps ...predicate.Cart) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CartMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CartMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Cart, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CartMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CartMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Cart).
+func (m *CartMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CartMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user != nil {
+		fields = append(fields, cart.FieldUserID)
+	}
+	if m.subtotal != nil {
+		fields = append(fields, cart.FieldSubtotal)
+	}
+	if m.shipping != nil {
+		fields = append(fields, cart.FieldShipping)
+	}
+	if m.shipping_service != nil {
+		fields = append(fields, cart.FieldShippingService)
+	}
+	if m.shipping_cep != nil {
+		fields = append(fields, cart.FieldShippingCep)
+	}
+	if m.discount != nil {
+		fields = append(fields, cart.FieldDiscount)
+	}
+	if m.total != nil {
+		fields = append(fields, cart.FieldTotal)
+	}
+	if m.applied_coupon != nil {
+		fields = append(fields, cart.FieldAppliedCoupon)
+	}
+	if m.coupon_code != nil {
+		fields = append(fields, cart.FieldCouponCode)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, cart.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, cart.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, cart.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CartMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cart.FieldUserID:
+		return m.UserID()
+	case cart.FieldSubtotal:
+		return m.Subtotal()
+	case cart.FieldShipping:
+		return m.Shipping()
+	case cart.FieldShippingService:
+		return m.ShippingService()
+	case cart.FieldShippingCep:
+		return m.ShippingCep()
+	case cart.FieldDiscount:
+		return m.Discount()
+	case cart.FieldTotal:
+		return m.Total()
+	case cart.FieldAppliedCoupon:
+		return m.AppliedCoupon()
+	case cart.FieldCouponCode:
+		return m.CouponCode()
+	case cart.FieldExpiresAt:
+		return m.ExpiresAt()
+	case cart.FieldCreatedAt:
+		return m.CreatedAt()
+	case cart.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cart.FieldUserID:
+		return m.OldUserID(ctx)
+	case cart.FieldSubtotal:
+		return m.OldSubtotal(ctx)
+	case cart.FieldShipping:
+		return m.OldShipping(ctx)
+	case cart.FieldShippingService:
+		return m.OldShippingService(ctx)
+	case cart.FieldShippingCep:
+		return m.OldShippingCep(ctx)
+	case cart.FieldDiscount:
+		return m.OldDiscount(ctx)
+	case cart.FieldTotal:
+		return m.OldTotal(ctx)
+	case cart.FieldAppliedCoupon:
+		return m.OldAppliedCoupon(ctx)
+	case cart.FieldCouponCode:
+		return m.OldCouponCode(ctx)
+	case cart.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case cart.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cart.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Cart field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CartMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cart.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case cart.FieldSubtotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubtotal(v)
+		return nil
+	case cart.FieldShipping:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShipping(v)
+		return nil
+	case cart.FieldShippingService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShippingService(v)
+		return nil
+	case cart.FieldShippingCep:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShippingCep(v)
+		return nil
+	case cart.FieldDiscount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	case cart.FieldTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case cart.FieldAppliedCoupon:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppliedCoupon(v)
+		return nil
+	case cart.FieldCouponCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponCode(v)
+		return nil
+	case cart.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case cart.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cart.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Cart field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CartMutation) AddedFields() []string {
+	var fields []string
+	if m.addsubtotal != nil {
+		fields = append(fields, cart.FieldSubtotal)
+	}
+	if m.addshipping != nil {
+		fields = append(fields, cart.FieldShipping)
+	}
+	if m.adddiscount != nil {
+		fields = append(fields, cart.FieldDiscount)
+	}
+	if m.addtotal != nil {
+		fields = append(fields, cart.FieldTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CartMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cart.FieldSubtotal:
+		return m.AddedSubtotal()
+	case cart.FieldShipping:
+		return m.AddedShipping()
+	case cart.FieldDiscount:
+		return m.AddedDiscount()
+	case cart.FieldTotal:
+		return m.AddedTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CartMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cart.FieldSubtotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSubtotal(v)
+		return nil
+	case cart.FieldShipping:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShipping(v)
+		return nil
+	case cart.FieldDiscount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscount(v)
+		return nil
+	case cart.FieldTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Cart numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CartMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cart.FieldUserID) {
+		fields = append(fields, cart.FieldUserID)
+	}
+	if m.FieldCleared(cart.FieldShipping) {
+		fields = append(fields, cart.FieldShipping)
+	}
+	if m.FieldCleared(cart.FieldShippingService) {
+		fields = append(fields, cart.FieldShippingService)
+	}
+	if m.FieldCleared(cart.FieldShippingCep) {
+		fields = append(fields, cart.FieldShippingCep)
+	}
+	if m.FieldCleared(cart.FieldCouponCode) {
+		fields = append(fields, cart.FieldCouponCode)
+	}
+	if m.FieldCleared(cart.FieldExpiresAt) {
+		fields = append(fields, cart.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CartMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CartMutation) ClearField(name string) error {
+	switch name {
+	case cart.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case cart.FieldShipping:
+		m.ClearShipping()
+		return nil
+	case cart.FieldShippingService:
+		m.ClearShippingService()
+		return nil
+	case cart.FieldShippingCep:
+		m.ClearShippingCep()
+		return nil
+	case cart.FieldCouponCode:
+		m.ClearCouponCode()
+		return nil
+	case cart.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Cart nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CartMutation) ResetField(name string) error {
+	switch name {
+	case cart.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case cart.FieldSubtotal:
+		m.ResetSubtotal()
+		return nil
+	case cart.FieldShipping:
+		m.ResetShipping()
+		return nil
+	case cart.FieldShippingService:
+		m.ResetShippingService()
+		return nil
+	case cart.FieldShippingCep:
+		m.ResetShippingCep()
+		return nil
+	case cart.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	case cart.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case cart.FieldAppliedCoupon:
+		m.ResetAppliedCoupon()
+		return nil
+	case cart.FieldCouponCode:
+		m.ResetCouponCode()
+		return nil
+	case cart.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case cart.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cart.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Cart field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CartMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, cart.EdgeUser)
+	}
+	if m.cart_items != nil {
+		edges = append(edges, cart.EdgeCartItems)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CartMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cart.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case cart.EdgeCartItems:
+		ids := make([]ent.Value, 0, len(m.cart_items))
+		for id := range m.cart_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CartMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedcart_items != nil {
+		edges = append(edges, cart.EdgeCartItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CartMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case cart.EdgeCartItems:
+		ids := make([]ent.Value, 0, len(m.removedcart_items))
+		for id := range m.removedcart_items {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CartMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, cart.EdgeUser)
+	}
+	if m.clearedcart_items {
+		edges = append(edges, cart.EdgeCartItems)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CartMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cart.EdgeUser:
+		return m.cleareduser
+	case cart.EdgeCartItems:
+		return m.clearedcart_items
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CartMutation) ClearEdge(name string) error {
+	switch name {
+	case cart.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Cart unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CartMutation) ResetEdge(name string) error {
+	switch name {
+	case cart.EdgeUser:
+		m.ResetUser()
+		return nil
+	case cart.EdgeCartItems:
+		m.ResetCartItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Cart edge %s", name)
+}
+
+// CartItemMutation represents an operation that mutates the CartItem nodes in the graph.
+type CartItemMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	variant_options      *map[string]string
+	name                 *string
+	price                *money.Amount
+	addprice             *money.Amount
+	image                *string
+	quantity             *int
+	addquantity          *int
+	previous_price       *money.Amount
+	addprevious_price    *money.Amount
+	previous_quantity    *int
+	addprevious_quantity *int
+	unavailable_reason   *cartitem.UnavailableReason
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	cart                 *string
+	clearedcart          bool
+	product              *string
+	clearedproduct       bool
+	variant              *string
+	clearedvariant       bool
+	done                 bool
+	oldValue             func(context.Context) (*CartItem, error)
+	predicates           []predicate.CartItem
+}
+
+var _ ent.Mutation = (*CartItemMutation)(nil)
+
+// cartitemOption allows management of the mutation configuration using functional options.
+type cartitemOption func(*CartItemMutation)
+
+// newCartItemMutation creates new mutation for the CartItem entity.
+func newCartItemMutation(c config, op Op, opts ...cartitemOption) *CartItemMutation {
+	m := &CartItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCartItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCartItemID sets the ID field of the mutation.
+func withCartItemID(id string) cartitemOption {
+	return func(m *CartItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CartItem
+		)
+		m.oldValue = func(ctx context.Context) (*CartItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CartItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCartItem sets the old CartItem of the mutation.
+func withCartItem(node *CartItem) cartitemOption {
+	return func(m *CartItemMutation) {
+		m.oldValue = func(context.Context) (*CartItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CartItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CartItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CartItem entities.
+func (m *CartItemMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CartItemMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CartItemMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CartItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCartID sets the "cart_id" field.
+func (m *CartItemMutation) SetCartID(s string) {
+	m.cart = &s
+}
+
+// CartID returns the value of the "cart_id" field in the mutation.
+func (m *CartItemMutation) CartID() (r string, exists bool) {
+	v := m.cart
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCartID returns the old "cart_id" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldCartID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCartID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCartID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCartID: %w", err)
+	}
+	return oldValue.CartID, nil
+}
+
+// ClearCartID clears the value of the "cart_id" field.
+func (m *CartItemMutation) ClearCartID() {
+	m.cart = nil
+	m.clearedFields[cartitem.FieldCartID] = struct{}{}
+}
+
+// CartIDCleared returns if the "cart_id" field was cleared in this mutation.
+func (m *CartItemMutation) CartIDCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldCartID]
+	return ok
+}
+
+// ResetCartID resets all changes to the "cart_id" field.
+func (m *CartItemMutation) ResetCartID() {
+	m.cart = nil
+	delete(m.clearedFields, cartitem.FieldCartID)
+}
+
+// SetProductID sets the "product_id" field.
+func (m *CartItemMutation) SetProductID(s string) {
+	m.product = &s
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *CartItemMutation) ProductID() (r string, exists bool) {
+	v := m.product
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldProductID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (m *CartItemMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[cartitem.FieldProductID] = struct{}{}
+}
+
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *CartItemMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldProductID]
+	return ok
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *CartItemMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, cartitem.FieldProductID)
+}
+
+// SetVariantID sets the "variant_id" field.
+func (m *CartItemMutation) SetVariantID(s string) {
+	m.variant = &s
+}
+
+// VariantID returns the value of the "variant_id" field in the mutation.
+func (m *CartItemMutation) VariantID() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariantID returns the old "variant_id" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldVariantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariantID: %w", err)
+	}
+	return oldValue.VariantID, nil
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (m *CartItemMutation) ClearVariantID() {
+	m.variant = nil
+	m.clearedFields[cartitem.FieldVariantID] = struct{}{}
+}
+
+// VariantIDCleared returns if the "variant_id" field was cleared in this mutation.
+func (m *CartItemMutation) VariantIDCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldVariantID]
+	return ok
+}
+
+// ResetVariantID resets all changes to the "variant_id" field.
+func (m *CartItemMutation) ResetVariantID() {
+	m.variant = nil
+	delete(m.clearedFields, cartitem.FieldVariantID)
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (m *CartItemMutation) SetVariantOptions(value map[string]string) {
+	m.variant_options = &value
+}
+
+// VariantOptions returns the value of the "variant_options" field in the mutation.
+func (m *CartItemMutation) VariantOptions() (r map[string]string, exists bool) {
+	v := m.variant_options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariantOptions returns the old "variant_options" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldVariantOptions(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariantOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariantOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariantOptions: %w", err)
+	}
+	return oldValue.VariantOptions, nil
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (m *CartItemMutation) ClearVariantOptions() {
+	m.variant_options = nil
+	m.clearedFields[cartitem.FieldVariantOptions] = struct{}{}
+}
+
+// VariantOptionsCleared returns if the "variant_options" field was cleared in this mutation.
+func (m *CartItemMutation) VariantOptionsCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldVariantOptions]
+	return ok
+}
+
+// ResetVariantOptions resets all changes to the "variant_options" field.
+func (m *CartItemMutation) ResetVariantOptions() {
+	m.variant_options = nil
+	delete(m.clearedFields, cartitem.FieldVariantOptions)
+}
+
+// SetName sets the "name" field.
+func (m *CartItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CartItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CartItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *CartItemMutation) SetPrice(value money.Amount) {
+	m.price = &value
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *CartItemMutation) Price() (r money.Amount, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldPrice(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds value to the "price" field.
+func (m *CartItemMutation) AddPrice(value money.Amount) {
+	if m.addprice != nil {
+		*m.addprice += value
+	} else {
+		m.addprice = &value
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *CartItemMutation) AddedPrice() (r money.Amount, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *CartItemMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetImage sets the "image" field.
+func (m *CartItemMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *CartItemMutation) Image() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImage returns the old "image" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+	}
+	return oldValue.Image, nil
+}
+
+// ResetImage resets all changes to the "image" field.
+func (m *CartItemMutation) ResetImage() {
+	m.image = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *CartItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *CartItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *CartItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *CartItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *CartItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetPreviousPrice sets the "previous_price" field.
+func (m *CartItemMutation) SetPreviousPrice(value money.Amount) {
+	m.previous_price = &value
+	m.addprevious_price = nil
+}
+
+// PreviousPrice returns the value of the "previous_price" field in the mutation.
+func (m *CartItemMutation) PreviousPrice() (r money.Amount, exists bool) {
+	v := m.previous_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousPrice returns the old "previous_price" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldPreviousPrice(ctx context.Context) (v *money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousPrice: %w", err)
+	}
+	return oldValue.PreviousPrice, nil
+}
+
+// AddPreviousPrice adds value to the "previous_price" field.
+func (m *CartItemMutation) AddPreviousPrice(value money.Amount) {
+	if m.addprevious_price != nil {
+		*m.addprevious_price += value
+	} else {
+		m.addprevious_price = &value
+	}
+}
+
+// AddedPreviousPrice returns the value that was added to the "previous_price" field in this mutation.
+func (m *CartItemMutation) AddedPreviousPrice() (r money.Amount, exists bool) {
+	v := m.addprevious_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreviousPrice clears the value of the "previous_price" field.
+func (m *CartItemMutation) ClearPreviousPrice() {
+	m.previous_price = nil
+	m.addprevious_price = nil
+	m.clearedFields[cartitem.FieldPreviousPrice] = struct{}{}
+}
+
+// PreviousPriceCleared returns if the "previous_price" field was cleared in this mutation.
+func (m *CartItemMutation) PreviousPriceCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldPreviousPrice]
+	return ok
+}
+
+// ResetPreviousPrice resets all changes to the "previous_price" field.
+func (m *CartItemMutation) ResetPreviousPrice() {
+	m.previous_price = nil
+	m.addprevious_price = nil
+	delete(m.clearedFields, cartitem.FieldPreviousPrice)
+}
+
+// SetPreviousQuantity sets the "previous_quantity" field.
+func (m *CartItemMutation) SetPreviousQuantity(i int) {
+	m.previous_quantity = &i
+	m.addprevious_quantity = nil
+}
+
+// PreviousQuantity returns the value of the "previous_quantity" field in the mutation.
+func (m *CartItemMutation) PreviousQuantity() (r int, exists bool) {
+	v := m.previous_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousQuantity returns the old "previous_quantity" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldPreviousQuantity(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousQuantity: %w", err)
+	}
+	return oldValue.PreviousQuantity, nil
+}
+
+// AddPreviousQuantity adds i to the "previous_quantity" field.
+func (m *CartItemMutation) AddPreviousQuantity(i int) {
+	if m.addprevious_quantity != nil {
+		*m.addprevious_quantity += i
+	} else {
+		m.addprevious_quantity = &i
+	}
+}
+
+// AddedPreviousQuantity returns the value that was added to the "previous_quantity" field in this mutation.
+func (m *CartItemMutation) AddedPreviousQuantity() (r int, exists bool) {
+	v := m.addprevious_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreviousQuantity clears the value of the "previous_quantity" field.
+func (m *CartItemMutation) ClearPreviousQuantity() {
+	m.previous_quantity = nil
+	m.addprevious_quantity = nil
+	m.clearedFields[cartitem.FieldPreviousQuantity] = struct{}{}
+}
+
+// PreviousQuantityCleared returns if the "previous_quantity" field was cleared in this mutation.
+func (m *CartItemMutation) PreviousQuantityCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldPreviousQuantity]
+	return ok
+}
+
+// ResetPreviousQuantity resets all changes to the "previous_quantity" field.
+func (m *CartItemMutation) ResetPreviousQuantity() {
+	m.previous_quantity = nil
+	m.addprevious_quantity = nil
+	delete(m.clearedFields, cartitem.FieldPreviousQuantity)
+}
+
+// SetUnavailableReason sets the "unavailable_reason" field.
+func (m *CartItemMutation) SetUnavailableReason(cr cartitem.UnavailableReason) {
+	m.unavailable_reason = &cr
+}
+
+// UnavailableReason returns the value of the "unavailable_reason" field in the mutation.
+func (m *CartItemMutation) UnavailableReason() (r cartitem.UnavailableReason, exists bool) {
+	v := m.unavailable_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnavailableReason returns the old "unavailable_reason" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldUnavailableReason(ctx context.Context) (v *cartitem.UnavailableReason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnavailableReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnavailableReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnavailableReason: %w", err)
+	}
+	return oldValue.UnavailableReason, nil
+}
+
+// ClearUnavailableReason clears the value of the "unavailable_reason" field.
+func (m *CartItemMutation) ClearUnavailableReason() {
+	m.unavailable_reason = nil
+	m.clearedFields[cartitem.FieldUnavailableReason] = struct{}{}
+}
+
+// UnavailableReasonCleared returns if the "unavailable_reason" field was cleared in this mutation.
+func (m *CartItemMutation) UnavailableReasonCleared() bool {
+	_, ok := m.clearedFields[cartitem.FieldUnavailableReason]
+	return ok
+}
+
+// ResetUnavailableReason resets all changes to the "unavailable_reason" field.
+func (m *CartItemMutation) ResetUnavailableReason() {
+	m.unavailable_reason = nil
+	delete(m.clearedFields, cartitem.FieldUnavailableReason)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CartItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CartItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CartItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CartItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CartItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CartItem entity.
+// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CartItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CartItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCart clears the "cart" edge to the Cart entity.
+func (m *CartItemMutation) ClearCart() {
+	m.clearedcart = true
+	m.clearedFields[cartitem.FieldCartID] = struct{}{}
+}
+
+// CartCleared reports if the "cart" edge to the Cart entity was cleared.
+func (m *CartItemMutation) CartCleared() bool {
+	return m.CartIDCleared() || m.clearedcart
+}
+
+// CartIDs returns the "cart" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CartID instead. It exists only for internal usage by the builders.
+func (m *CartItemMutation) CartIDs() (ids []string) {
+	if id := m.cart; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCart resets all changes to the "cart" edge.
+func (m *CartItemMutation) ResetCart() {
+	m.cart = nil
+	m.clearedcart = false
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *CartItemMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[cartitem.FieldProductID] = struct{}{}
+}
+
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *CartItemMutation) ProductCleared() bool {
+	return m.ProductIDCleared() || m.clearedproduct
+}
+
+// ProductIDs returns the "product" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *CartItemMutation) ProductIDs() (ids []string) {
+	if id := m.product; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProduct resets all changes to the "product" edge.
+func (m *CartItemMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
+}
+
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (m *CartItemMutation) ClearVariant() {
+	m.clearedvariant = true
+	m.clearedFields[cartitem.FieldVariantID] = struct{}{}
+}
+
+// VariantCleared reports if the "variant" edge to the ProductVariant entity was cleared.
+func (m *CartItemMutation) VariantCleared() bool {
+	return m.VariantIDCleared() || m.clearedvariant
+}
+
+// VariantIDs returns the "variant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VariantID instead. It exists only for internal usage by the builders.
+func (m *CartItemMutation) VariantIDs() (ids []string) {
+	if id := m.variant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVariant resets all changes to the "variant" edge.
+func (m *CartItemMutation) ResetVariant() {
+	m.variant = nil
+	m.clearedvariant = false
+}
+
+// Where appends a list predicates to the CartItemMutation builder.
+func (m *CartItemMutation) Where(ps ...predicate.CartItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CartItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CartItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CartItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CartItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CartItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CartItem).
+func (m *CartItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CartItemMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.cart != nil {
+		fields = append(fields, cartitem.FieldCartID)
+	}
+	if m.product != nil {
+		fields = append(fields, cartitem.FieldProductID)
+	}
+	if m.variant != nil {
+		fields = append(fields, cartitem.FieldVariantID)
+	}
+	if m.variant_options != nil {
+		fields = append(fields, cartitem.FieldVariantOptions)
+	}
+	if m.name != nil {
+		fields = append(fields, cartitem.FieldName)
+	}
+	if m.price != nil {
+		fields = append(fields, cartitem.FieldPrice)
+	}
+	if m.image != nil {
+		fields = append(fields, cartitem.FieldImage)
+	}
+	if m.quantity != nil {
+		fields = append(fields, cartitem.FieldQuantity)
+	}
+	if m.previous_price != nil {
+		fields = append(fields, cartitem.FieldPreviousPrice)
+	}
+	if m.previous_quantity != nil {
+		fields = append(fields, cartitem.FieldPreviousQuantity)
+	}
+	if m.unavailable_reason != nil {
+		fields = append(fields, cartitem.FieldUnavailableReason)
+	}
+	if m.created_at != nil {
+		fields = append(fields, cartitem.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, cartitem.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CartItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cartitem.FieldCartID:
+		return m.CartID()
+	case cartitem.FieldProductID:
+		return m.ProductID()
+	case cartitem.FieldVariantID:
+		return m.VariantID()
+	case cartitem.FieldVariantOptions:
+		return m.VariantOptions()
+	case cartitem.FieldName:
+		return m.Name()
+	case cartitem.FieldPrice:
+		return m.Price()
+	case cartitem.FieldImage:
+		return m.Image()
+	case cartitem.FieldQuantity:
+		return m.Quantity()
+	case cartitem.FieldPreviousPrice:
+		return m.PreviousPrice()
+	case cartitem.FieldPreviousQuantity:
+		return m.PreviousQuantity()
+	case cartitem.FieldUnavailableReason:
+		return m.UnavailableReason()
+	case cartitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case cartitem.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CartItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cartitem.FieldCartID:
+		return m.OldCartID(ctx)
+	case cartitem.FieldProductID:
+		return m.OldProductID(ctx)
+	case cartitem.FieldVariantID:
+		return m.OldVariantID(ctx)
+	case cartitem.FieldVariantOptions:
+		return m.OldVariantOptions(ctx)
+	case cartitem.FieldName:
+		return m.OldName(ctx)
+	case cartitem.FieldPrice:
+		return m.OldPrice(ctx)
+	case cartitem.FieldImage:
+		return m.OldImage(ctx)
+	case cartitem.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case cartitem.FieldPreviousPrice:
+		return m.OldPreviousPrice(ctx)
+	case cartitem.FieldPreviousQuantity:
+		return m.OldPreviousQuantity(ctx)
+	case cartitem.FieldUnavailableReason:
+		return m.OldUnavailableReason(ctx)
+	case cartitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cartitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CartItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CartItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cartitem.FieldCartID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCartID(v)
+		return nil
+	case cartitem.FieldProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case cartitem.FieldVariantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantID(v)
+		return nil
+	case cartitem.FieldVariantOptions:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantOptions(v)
+		return nil
+	case cartitem.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case cartitem.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case cartitem.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case cartitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case cartitem.FieldPreviousPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousPrice(v)
+		return nil
+	case cartitem.FieldPreviousQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousQuantity(v)
+		return nil
+	case cartitem.FieldUnavailableReason:
+		v, ok := value.(cartitem.UnavailableReason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnavailableReason(v)
+		return nil
+	case cartitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cartitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CartItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, cartitem.FieldPrice)
+	}
+	if m.addquantity != nil {
+		fields = append(fields, cartitem.FieldQuantity)
+	}
+	if m.addprevious_price != nil {
+		fields = append(fields, cartitem.FieldPreviousPrice)
+	}
+	if m.addprevious_quantity != nil {
+		fields = append(fields, cartitem.FieldPreviousQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CartItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cartitem.FieldPrice:
+		return m.AddedPrice()
+	case cartitem.FieldQuantity:
+		return m.AddedQuantity()
+	case cartitem.FieldPreviousPrice:
+		return m.AddedPreviousPrice()
+	case cartitem.FieldPreviousQuantity:
+		return m.AddedPreviousQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CartItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cartitem.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case cartitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	case cartitem.FieldPreviousPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviousPrice(v)
+		return nil
+	case cartitem.FieldPreviousQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviousQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CartItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cartitem.FieldCartID) {
+		fields = append(fields, cartitem.FieldCartID)
+	}
+	if m.FieldCleared(cartitem.FieldProductID) {
+		fields = append(fields, cartitem.FieldProductID)
+	}
+	if m.FieldCleared(cartitem.FieldVariantID) {
+		fields = append(fields, cartitem.FieldVariantID)
+	}
+	if m.FieldCleared(cartitem.FieldVariantOptions) {
+		fields = append(fields, cartitem.FieldVariantOptions)
+	}
+	if m.FieldCleared(cartitem.FieldPreviousPrice) {
+		fields = append(fields, cartitem.FieldPreviousPrice)
+	}
+	if m.FieldCleared(cartitem.FieldPreviousQuantity) {
+		fields = append(fields, cartitem.FieldPreviousQuantity)
+	}
+	if m.FieldCleared(cartitem.FieldUnavailableReason) {
+		fields = append(fields, cartitem.FieldUnavailableReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CartItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CartItemMutation) ClearField(name string) error {
+	switch name {
+	case cartitem.FieldCartID:
+		m.ClearCartID()
+		return nil
+	case cartitem.FieldProductID:
+		m.ClearProductID()
+		return nil
+	case cartitem.FieldVariantID:
+		m.ClearVariantID()
+		return nil
+	case cartitem.FieldVariantOptions:
+		m.ClearVariantOptions()
+		return nil
+	case cartitem.FieldPreviousPrice:
+		m.ClearPreviousPrice()
+		return nil
+	case cartitem.FieldPreviousQuantity:
+		m.ClearPreviousQuantity()
+		return nil
+	case cartitem.FieldUnavailableReason:
+		m.ClearUnavailableReason()
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CartItemMutation) ResetField(name string) error {
+	switch name {
+	case cartitem.FieldCartID:
+		m.ResetCartID()
+		return nil
+	case cartitem.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case cartitem.FieldVariantID:
+		m.ResetVariantID()
+		return nil
+	case cartitem.FieldVariantOptions:
+		m.ResetVariantOptions()
+		return nil
+	case cartitem.FieldName:
+		m.ResetName()
+		return nil
+	case cartitem.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case cartitem.FieldImage:
+		m.ResetImage()
+		return nil
+	case cartitem.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case cartitem.FieldPreviousPrice:
+		m.ResetPreviousPrice()
+		return nil
+	case cartitem.FieldPreviousQuantity:
+		m.ResetPreviousQuantity()
+		return nil
+	case cartitem.FieldUnavailableReason:
+		m.ResetUnavailableReason()
+		return nil
+	case cartitem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cartitem.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CartItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cart != nil {
+		edges = append(edges, cartitem.EdgeCart)
+	}
+	if m.product != nil {
+		edges = append(edges, cartitem.EdgeProduct)
+	}
+	if m.variant != nil {
+		edges = append(edges, cartitem.EdgeVariant)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CartItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cartitem.EdgeCart:
+		if id := m.cart; id != nil {
+			return []ent.Value{*id}
+		}
+	case cartitem.EdgeProduct:
+		if id := m.product; id != nil {
+			return []ent.Value{*id}
+		}
+	case cartitem.EdgeVariant:
+		if id := m.variant; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CartItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CartItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CartItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedcart {
+		edges = append(edges, cartitem.EdgeCart)
+	}
+	if m.clearedproduct {
+		edges = append(edges, cartitem.EdgeProduct)
+	}
+	if m.clearedvariant {
+		edges = append(edges, cartitem.EdgeVariant)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CartItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cartitem.EdgeCart:
+		return m.clearedcart
+	case cartitem.EdgeProduct:
+		return m.clearedproduct
+	case cartitem.EdgeVariant:
+		return m.clearedvariant
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CartItemMutation) ClearEdge(name string) error {
+	switch name {
+	case cartitem.EdgeCart:
+		m.ClearCart()
+		return nil
+	case cartitem.EdgeProduct:
+		m.ClearProduct()
+		return nil
+	case cartitem.EdgeVariant:
+		m.ClearVariant()
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CartItemMutation) ResetEdge(name string) error {
+	switch name {
+	case cartitem.EdgeCart:
+		m.ResetCart()
+		return nil
+	case cartitem.EdgeProduct:
+		m.ResetProduct()
+		return nil
+	case cartitem.EdgeVariant:
+		m.ResetVariant()
+		return nil
+	}
+	return fmt.Errorf("unknown CartItem edge %s", name)
+}
+
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	name            *string
+	slug            *string
+	image           *string
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	products        map[string]struct{}
+	removedproducts map[string]struct{}
+	clearedproducts bool
+	parent          *string
+	clearedparent   bool
+	children        map[string]struct{}
+	removedchildren map[string]struct{}
+	clearedchildren bool
+	done            bool
+	oldValue        func(context.Context) (*Category, error)
+	predicates      []predicate.Category
+}
+
+var _ ent.Mutation = (*CategoryMutation)(nil)
+
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
+
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id string) categoryOption {
+	return func(m *CategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Category
+		)
+		m.oldValue = func(ctx context.Context) (*Category, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Category.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Category entities.
+func (m *CategoryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetImage sets the "image" field.
+func (m *CategoryMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *CategoryMutation) Image() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImage returns the old "image" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+	}
+	return oldValue.Image, nil
+}
+
+// ClearImage clears the value of the "image" field.
+func (m *CategoryMutation) ClearImage() {
+	m.image = nil
+	m.clearedFields[category.FieldImage] = struct{}{}
+}
+
+// ImageCleared returns if the "image" field was cleared in this mutation.
+func (m *CategoryMutation) ImageCleared() bool {
+	_, ok := m.clearedFields[category.FieldImage]
+	return ok
+}
+
+// ResetImage resets all changes to the "image" field.
+func (m *CategoryMutation) ResetImage() {
+	m.image = nil
+	delete(m.clearedFields, category.FieldImage)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CategoryMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CategoryMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldParentID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CategoryMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CategoryMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[category.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CategoryMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, category.FieldParentID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CategoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CategoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CategoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddProductIDs adds the "products" edge to the Product entity by ids.
+func (m *CategoryMutation) AddProductIDs(ids ...string) {
+	if m.products == nil {
+		m.products = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.products[ids[i]] = struct{}{}
+	}
+}
+
+// ClearProducts clears the "products" edge to the Product entity.
+func (m *CategoryMutation) ClearProducts() {
+	m.clearedproducts = true
+}
+
+// ProductsCleared reports if the "products" edge to the Product entity was cleared.
+func (m *CategoryMutation) ProductsCleared() bool {
+	return m.clearedproducts
+}
+
+// RemoveProductIDs removes the "products" edge to the Product entity by IDs.
+func (m *CategoryMutation) RemoveProductIDs(ids ...string) {
+	if m.removedproducts == nil {
+		m.removedproducts = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.products, ids[i])
+		m.removedproducts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedProducts returns the removed IDs of the "products" edge to the Product entity.
+func (m *CategoryMutation) RemovedProductsIDs() (ids []string) {
+	for id := range m.removedproducts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ProductsIDs returns the "products" edge IDs in the mutation.
+func (m *CategoryMutation) ProductsIDs() (ids []string) {
+	for id := range m.products {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetProducts resets all changes to the "products" edge.
+func (m *CategoryMutation) ResetProducts() {
+	m.products = nil
+	m.clearedproducts = false
+	m.removedproducts = nil
+}
+
+// ClearParent clears the "parent" edge to the Category entity.
+func (m *CategoryMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Category entity was cleared.
+func (m *CategoryMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CategoryMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by ids.
+func (m *CategoryMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Category entity.
+func (m *CategoryMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Category entity was cleared.
+func (m *CategoryMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Category entity by IDs.
+func (m *CategoryMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Category entity.
+func (m *CategoryMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CategoryMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CategoryMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
+	if m.image != nil {
+		fields = append(fields, category.FieldImage)
+	}
+	if m.parent != nil {
+		fields = append(fields, category.FieldParentID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, category.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, category.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldName:
+		return m.Name()
+	case category.FieldSlug:
+		return m.Slug()
+	case category.FieldImage:
+		return m.Image()
+	case category.FieldParentID:
+		return m.ParentID()
+	case category.FieldCreatedAt:
+		return m.CreatedAt()
+	case category.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldName:
+		return m.OldName(ctx)
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
+	case category.FieldImage:
+		return m.OldImage(ctx)
+	case category.FieldParentID:
+		return m.OldParentID(ctx)
+	case category.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case category.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case category.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case category.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case category.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case category.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(category.FieldImage) {
+		fields = append(fields, category.FieldImage)
+	}
+	if m.FieldCleared(category.FieldParentID) {
+		fields = append(fields, category.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	switch name {
+	case category.FieldImage:
+		m.ClearImage()
+		return nil
+	case category.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Category nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case category.FieldImage:
+		m.ResetImage()
+		return nil
+	case category.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case category.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case category.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.products != nil {
+		edges = append(edges, category.EdgeProducts)
+	}
+	if m.parent != nil {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeProducts:
+		ids := make([]ent.Value, 0, len(m.products))
+		for id := range m.products {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedproducts != nil {
+		edges = append(edges, category.EdgeProducts)
+	}
+	if m.removedchildren != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeProducts:
+		ids := make([]ent.Value, 0, len(m.removedproducts))
+		for id := range m.removedproducts {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedproducts {
+		edges = append(edges, category.EdgeProducts)
+	}
+	if m.clearedparent {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, category.EdgeChildren)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgeProducts:
+		return m.clearedproducts
+	case category.EdgeParent:
+		return m.clearedparent
+	case category.EdgeChildren:
+		return m.clearedchildren
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
+	case category.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Category unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgeProducts:
+		m.ResetProducts()
+		return nil
+	case category.EdgeParent:
+		m.ResetParent()
+		return nil
+	case category.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	}
+	return fmt.Errorf("unknown Category edge %s", name)
+}
+
+// CouponMutation represents an operation that mutates the Coupon nodes in the graph.
+type CouponMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	code              *string
+	discount_type     *coupon.DiscountType
+	discount_value    *money.Amount
+	adddiscount_value *money.Amount
+	min_purchase      *money.Amount
+	addmin_purchase   *money.Amount
+	expires_at        *time.Time
+	is_active         *bool
+	max_uses          *int
+	addmax_uses       *int
+	times_used        *int
+	addtimes_used     *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*Coupon, error)
+	predicates        []predicate.Coupon
+}
+
+var _ ent.Mutation = (*CouponMutation)(nil)
+
+// couponOption allows management of the mutation configuration using functional options.
+type couponOption func(*CouponMutation)
+
+// newCouponMutation creates new mutation for the Coupon entity.
+func newCouponMutation(c config, op Op, opts ...couponOption) *CouponMutation {
+	m := &CouponMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCoupon,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCouponID sets the ID field of the mutation.
+func withCouponID(id string) couponOption {
+	return func(m *CouponMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Coupon
+		)
+		m.oldValue = func(ctx context.Context) (*Coupon, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Coupon.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCoupon sets the old Coupon of the mutation.
+func withCoupon(node *Coupon) couponOption {
+	return func(m *CouponMutation) {
+		m.oldValue = func(context.Context) (*Coupon, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CouponMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CouponMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Coupon entities.
+func (m *CouponMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CouponMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CouponMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Coupon.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCode sets the "code" field.
+func (m *CouponMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *CouponMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *CouponMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetDiscountType sets the "discount_type" field.
+func (m *CouponMutation) SetDiscountType(ct coupon.DiscountType) {
+	m.discount_type = &ct
+}
+
+// DiscountType returns the value of the "discount_type" field in the mutation.
+func (m *CouponMutation) DiscountType() (r coupon.DiscountType, exists bool) {
+	v := m.discount_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscountType returns the old "discount_type" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldDiscountType(ctx context.Context) (v coupon.DiscountType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscountType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscountType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscountType: %w", err)
+	}
+	return oldValue.DiscountType, nil
+}
+
+// ResetDiscountType resets all changes to the "discount_type" field.
+func (m *CouponMutation) ResetDiscountType() {
+	m.discount_type = nil
+}
+
+// SetDiscountValue sets the "discount_value" field.
+func (m *CouponMutation) SetDiscountValue(value money.Amount) {
+	m.discount_value = &value
+	m.adddiscount_value = nil
+}
+
+// DiscountValue returns the value of the "discount_value" field in the mutation.
+func (m *CouponMutation) DiscountValue() (r money.Amount, exists bool) {
+	v := m.discount_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscountValue returns the old "discount_value" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldDiscountValue(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscountValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscountValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscountValue: %w", err)
+	}
+	return oldValue.DiscountValue, nil
+}
+
+// AddDiscountValue adds value to the "discount_value" field.
+func (m *CouponMutation) AddDiscountValue(value money.Amount) {
+	if m.adddiscount_value != nil {
+		*m.adddiscount_value += value
+	} else {
+		m.adddiscount_value = &value
+	}
+}
+
+// AddedDiscountValue returns the value that was added to the "discount_value" field in this mutation.
+func (m *CouponMutation) AddedDiscountValue() (r money.Amount, exists bool) {
+	v := m.adddiscount_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscountValue resets all changes to the "discount_value" field.
+func (m *CouponMutation) ResetDiscountValue() {
+	m.discount_value = nil
+	m.adddiscount_value = nil
+}
+
+// SetMinPurchase sets the "min_purchase" field.
+func (m *CouponMutation) SetMinPurchase(value money.Amount) {
+	m.min_purchase = &value
+	m.addmin_purchase = nil
+}
+
+// MinPurchase returns the value of the "min_purchase" field in the mutation.
+func (m *CouponMutation) MinPurchase() (r money.Amount, exists bool) {
+	v := m.min_purchase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinPurchase returns the old "min_purchase" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldMinPurchase(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinPurchase is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinPurchase requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinPurchase: %w", err)
+	}
+	return oldValue.MinPurchase, nil
+}
+
+// AddMinPurchase adds value to the "min_purchase" field.
+func (m *CouponMutation) AddMinPurchase(value money.Amount) {
+	if m.addmin_purchase != nil {
+		*m.addmin_purchase += value
+	} else {
+		m.addmin_purchase = &value
+	}
+}
+
+// AddedMinPurchase returns the value that was added to the "min_purchase" field in this mutation.
+func (m *CouponMutation) AddedMinPurchase() (r money.Amount, exists bool) {
+	v := m.addmin_purchase
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMinPurchase resets all changes to the "min_purchase" field.
+func (m *CouponMutation) ResetMinPurchase() {
+	m.min_purchase = nil
+	m.addmin_purchase = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *CouponMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *CouponMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *CouponMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[coupon.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *CouponMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[coupon.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *CouponMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, coupon.FieldExpiresAt)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *CouponMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *CouponMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *CouponMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *CouponMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *CouponMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *CouponMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *CouponMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxUses clears the value of the "max_uses" field.
+func (m *CouponMutation) ClearMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	m.clearedFields[coupon.FieldMaxUses] = struct{}{}
+}
+
+// MaxUsesCleared returns if the "max_uses" field was cleared in this mutation.
+func (m *CouponMutation) MaxUsesCleared() bool {
+	_, ok := m.clearedFields[coupon.FieldMaxUses]
+	return ok
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *CouponMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+	delete(m.clearedFields, coupon.FieldMaxUses)
+}
+
+// SetTimesUsed sets the "times_used" field.
+func (m *CouponMutation) SetTimesUsed(i int) {
+	m.times_used = &i
+	m.addtimes_used = nil
+}
+
+// TimesUsed returns the value of the "times_used" field in the mutation.
+func (m *CouponMutation) TimesUsed() (r int, exists bool) {
+	v := m.times_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimesUsed returns the old "times_used" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldTimesUsed(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimesUsed is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimesUsed requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimesUsed: %w", err)
+	}
+	return oldValue.TimesUsed, nil
+}
+
+// AddTimesUsed adds i to the "times_used" field.
+func (m *CouponMutation) AddTimesUsed(i int) {
+	if m.addtimes_used != nil {
+		*m.addtimes_used += i
+	} else {
+		m.addtimes_used = &i
+	}
+}
+
+// AddedTimesUsed returns the value that was added to the "times_used" field in this mutation.
+func (m *CouponMutation) AddedTimesUsed() (r int, exists bool) {
+	v := m.addtimes_used
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimesUsed resets all changes to the "times_used" field.
+func (m *CouponMutation) ResetTimesUsed() {
+	m.times_used = nil
+	m.addtimes_used = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CouponMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CouponMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CouponMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CouponMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CouponMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Coupon entity.
+// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CouponMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CouponMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the CouponMutation builder.
+func (m *CouponMutation) Where(ps ...predicate.Coupon) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CouponMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CouponMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Coupon, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CouponMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CouponMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Coupon).
+func (m *CouponMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CouponMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.code != nil {
+		fields = append(fields, coupon.FieldCode)
+	}
+	if m.discount_type != nil {
+		fields = append(fields, coupon.FieldDiscountType)
+	}
+	if m.discount_value != nil {
+		fields = append(fields, coupon.FieldDiscountValue)
+	}
+	if m.min_purchase != nil {
+		fields = append(fields, coupon.FieldMinPurchase)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, coupon.FieldExpiresAt)
+	}
+	if m.is_active != nil {
+		fields = append(fields, coupon.FieldIsActive)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, coupon.FieldMaxUses)
+	}
+	if m.times_used != nil {
+		fields = append(fields, coupon.FieldTimesUsed)
+	}
+	if m.created_at != nil {
+		fields = append(fields, coupon.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, coupon.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CouponMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case coupon.FieldCode:
+		return m.Code()
+	case coupon.FieldDiscountType:
+		return m.DiscountType()
+	case coupon.FieldDiscountValue:
+		return m.DiscountValue()
+	case coupon.FieldMinPurchase:
+		return m.MinPurchase()
+	case coupon.FieldExpiresAt:
+		return m.ExpiresAt()
+	case coupon.FieldIsActive:
+		return m.IsActive()
+	case coupon.FieldMaxUses:
+		return m.MaxUses()
+	case coupon.FieldTimesUsed:
+		return m.TimesUsed()
+	case coupon.FieldCreatedAt:
+		return m.CreatedAt()
+	case coupon.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CouponMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case coupon.FieldCode:
+		return m.OldCode(ctx)
+	case coupon.FieldDiscountType:
+		return m.OldDiscountType(ctx)
+	case coupon.FieldDiscountValue:
+		return m.OldDiscountValue(ctx)
+	case coupon.FieldMinPurchase:
+		return m.OldMinPurchase(ctx)
+	case coupon.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case coupon.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case coupon.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case coupon.FieldTimesUsed:
+		return m.OldTimesUsed(ctx)
+	case coupon.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case coupon.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Coupon field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CouponMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case coupon.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case coupon.FieldDiscountType:
+		v, ok := value.(coupon.DiscountType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscountType(v)
+		return nil
+	case coupon.FieldDiscountValue:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscountValue(v)
+		return nil
+	case coupon.FieldMinPurchase:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinPurchase(v)
+		return nil
+	case coupon.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case coupon.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case coupon.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case coupon.FieldTimesUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimesUsed(v)
+		return nil
+	case coupon.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case coupon.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Coupon field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CouponMutation) AddedFields() []string {
+	var fields []string
+	if m.adddiscount_value != nil {
+		fields = append(fields, coupon.FieldDiscountValue)
+	}
+	if m.addmin_purchase != nil {
+		fields = append(fields, coupon.FieldMinPurchase)
+	}
+	if m.addmax_uses != nil {
+		fields = append(fields, coupon.FieldMaxUses)
+	}
+	if m.addtimes_used != nil {
+		fields = append(fields, coupon.FieldTimesUsed)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CouponMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case coupon.FieldDiscountValue:
+		return m.AddedDiscountValue()
+	case coupon.FieldMinPurchase:
+		return m.AddedMinPurchase()
+	case coupon.FieldMaxUses:
+		return m.AddedMaxUses()
+	case coupon.FieldTimesUsed:
+		return m.AddedTimesUsed()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CouponMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case coupon.FieldDiscountValue:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscountValue(v)
+		return nil
+	case coupon.FieldMinPurchase:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinPurchase(v)
+		return nil
+	case coupon.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case coupon.FieldTimesUsed:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimesUsed(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Coupon numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CouponMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(coupon.FieldExpiresAt) {
+		fields = append(fields, coupon.FieldExpiresAt)
+	}
+	if m.FieldCleared(coupon.FieldMaxUses) {
+		fields = append(fields, coupon.FieldMaxUses)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CouponMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CouponMutation) ClearField(name string) error {
+	switch name {
+	case coupon.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case coupon.FieldMaxUses:
+		m.ClearMaxUses()
+		return nil
+	}
+	return fmt.Errorf("unknown Coupon nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CouponMutation) ResetField(name string) error {
+	switch name {
+	case coupon.FieldCode:
+		m.ResetCode()
+		return nil
+	case coupon.FieldDiscountType:
+		m.ResetDiscountType()
+		return nil
+	case coupon.FieldDiscountValue:
+		m.ResetDiscountValue()
+		return nil
+	case coupon.FieldMinPurchase:
+		m.ResetMinPurchase()
+		return nil
+	case coupon.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case coupon.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case coupon.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case coupon.FieldTimesUsed:
+		m.ResetTimesUsed()
+		return nil
+	case coupon.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case coupon.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Coupon field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CouponMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CouponMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CouponMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CouponMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CouponMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CouponMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CouponMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Coupon unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CouponMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Coupon edge %s", name)
+}
+
+// LoginAttemptMutation represents an operation that mutates the LoginAttempt nodes in the graph.
+type LoginAttemptMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	email          *string
+	user_id        *string
+	ip             *string
+	user_agent     *string
+	reason         *loginattempt.Reason
+	caused_lockout *bool
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*LoginAttempt, error)
+	predicates     []predicate.LoginAttempt
+}
+
+var _ ent.Mutation = (*LoginAttemptMutation)(nil)
+
+// loginattemptOption allows management of the mutation configuration using functional options.
+type loginattemptOption func(*LoginAttemptMutation)
+
+// newLoginAttemptMutation creates new mutation for the LoginAttempt entity.
+func newLoginAttemptMutation(c config, op Op, opts ...loginattemptOption) *LoginAttemptMutation {
+	m := &LoginAttemptMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginAttempt,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginAttemptID sets the ID field of the mutation.
+func withLoginAttemptID(id string) loginattemptOption {
+	return func(m *LoginAttemptMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginAttempt
+		)
+		m.oldValue = func(ctx context.Context) (*LoginAttempt, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginAttempt.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginAttempt sets the old LoginAttempt of the mutation.
+func withLoginAttempt(node *LoginAttempt) loginattemptOption {
+	return func(m *LoginAttemptMutation) {
+		m.oldValue = func(context.Context) (*LoginAttempt, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginAttemptMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginAttemptMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginAttempt entities.
+func (m *LoginAttemptMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginAttemptMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginAttemptMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginAttempt.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *LoginAttemptMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *LoginAttemptMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *LoginAttemptMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *LoginAttemptMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *LoginAttemptMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *LoginAttemptMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[loginattempt.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *LoginAttemptMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[loginattempt.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *LoginAttemptMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, loginattempt.FieldUserID)
+}
+
+// SetIP sets the "ip" field.
+func (m *LoginAttemptMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *LoginAttemptMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *LoginAttemptMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[loginattempt.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *LoginAttemptMutation) IPCleared() bool {
+	_, ok := m.clearedFields[loginattempt.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *LoginAttemptMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, loginattempt.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *LoginAttemptMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *LoginAttemptMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *LoginAttemptMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[loginattempt.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *LoginAttemptMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[loginattempt.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *LoginAttemptMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, loginattempt.FieldUserAgent)
+}
+
+// SetReason sets the "reason" field.
+func (m *LoginAttemptMutation) SetReason(l loginattempt.Reason) {
+	m.reason = &l
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *LoginAttemptMutation) Reason() (r loginattempt.Reason, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldReason(ctx context.Context) (v loginattempt.Reason, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *LoginAttemptMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetCausedLockout sets the "caused_lockout" field.
+func (m *LoginAttemptMutation) SetCausedLockout(b bool) {
+	m.caused_lockout = &b
+}
+
+// CausedLockout returns the value of the "caused_lockout" field in the mutation.
+func (m *LoginAttemptMutation) CausedLockout() (r bool, exists bool) {
+	v := m.caused_lockout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCausedLockout returns the old "caused_lockout" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldCausedLockout(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCausedLockout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCausedLockout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCausedLockout: %w", err)
+	}
+	return oldValue.CausedLockout, nil
+}
+
+// ResetCausedLockout resets all changes to the "caused_lockout" field.
+func (m *LoginAttemptMutation) ResetCausedLockout() {
+	m.caused_lockout = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoginAttemptMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoginAttemptMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoginAttempt entity.
+// If the LoginAttempt object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginAttemptMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoginAttemptMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the LoginAttemptMutation builder.
+func (m *LoginAttemptMutation) Where(ps ...predicate.LoginAttempt) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginAttemptMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginAttemptMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginAttempt, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginAttemptMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginAttemptMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginAttempt).
+func (m *LoginAttemptMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginAttemptMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.email != nil {
+		fields = append(fields, loginattempt.FieldEmail)
+	}
+	if m.user_id != nil {
+		fields = append(fields, loginattempt.FieldUserID)
+	}
+	if m.ip != nil {
+		fields = append(fields, loginattempt.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, loginattempt.FieldUserAgent)
+	}
+	if m.reason != nil {
+		fields = append(fields, loginattempt.FieldReason)
+	}
+	if m.caused_lockout != nil {
+		fields = append(fields, loginattempt.FieldCausedLockout)
+	}
+	if m.created_at != nil {
+		fields = append(fields, loginattempt.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginAttemptMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginattempt.FieldEmail:
+		return m.Email()
+	case loginattempt.FieldUserID:
+		return m.UserID()
+	case loginattempt.FieldIP:
+		return m.IP()
+	case loginattempt.FieldUserAgent:
+		return m.UserAgent()
+	case loginattempt.FieldReason:
+		return m.Reason()
+	case loginattempt.FieldCausedLockout:
+		return m.CausedLockout()
+	case loginattempt.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginAttemptMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginattempt.FieldEmail:
+		return m.OldEmail(ctx)
+	case loginattempt.FieldUserID:
+		return m.OldUserID(ctx)
+	case loginattempt.FieldIP:
+		return m.OldIP(ctx)
+	case loginattempt.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case loginattempt.FieldReason:
+		return m.OldReason(ctx)
+	case loginattempt.FieldCausedLockout:
+		return m.OldCausedLockout(ctx)
+	case loginattempt.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginAttempt field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginattempt.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case loginattempt.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case loginattempt.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case loginattempt.FieldUserAgent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserAgent(v)
+		return nil
+	case loginattempt.FieldReason:
+		v, ok := value.(loginattempt.Reason)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case loginattempt.FieldCausedLockout:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCausedLockout(v)
+		return nil
+	case loginattempt.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempt field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginAttemptMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginAttemptMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginAttemptMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown LoginAttempt numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginAttemptMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(loginattempt.FieldUserID) {
+		fields = append(fields, loginattempt.FieldUserID)
+	}
+	if m.FieldCleared(loginattempt.FieldIP) {
+		fields = append(fields, loginattempt.FieldIP)
+	}
+	if m.FieldCleared(loginattempt.FieldUserAgent) {
+		fields = append(fields, loginattempt.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginAttemptMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginAttemptMutation) ClearField(name string) error {
+	switch name {
+	case loginattempt.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case loginattempt.FieldIP:
+		m.ClearIP()
+		return nil
+	case loginattempt.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempt nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginAttemptMutation) ResetField(name string) error {
+	switch name {
+	case loginattempt.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case loginattempt.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case loginattempt.FieldIP:
+		m.ResetIP()
+		return nil
+	case loginattempt.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case loginattempt.FieldReason:
+		m.ResetReason()
+		return nil
+	case loginattempt.FieldCausedLockout:
+		m.ResetCausedLockout()
+		return nil
+	case loginattempt.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginAttempt field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginAttemptMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginAttemptMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginAttemptMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginAttemptMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginAttemptMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginAttemptMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginAttemptMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempt unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginAttemptMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LoginAttempt edge %s", name)
+}
+
+// OrderMutation represents an operation that mutates the Order nodes in the graph.
+type OrderMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *string
+	guest_email          *string
+	guest_name           *string
+	date                 *time.Time
+	total                *money.Amount
+	addtotal             *money.Amount
+	shipping             *money.Amount
+	addshipping          *money.Amount
+	shipping_service     *string
+	discount             *money.Amount
+	adddiscount          *money.Amount
+	refunded_total       *money.Amount
+	addrefunded_total    *money.Amount
+	delivery_type        *order.DeliveryType
+	status               *order.Status
+	payment_method       *string
+	payment_status       *string
+	coupon_code          *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	user                 *string
+	cleareduser          bool
+	address              *string
+	clearedaddress       bool
+	order_items          map[string]struct{}
+	removedorder_items   map[string]struct{}
+	clearedorder_items   bool
+	status_events        map[string]struct{}
+	removedstatus_events map[string]struct{}
+	clearedstatus_events bool
+	shipment             *string
+	clearedshipment      bool
+	payments             map[string]struct{}
+	removedpayments      map[string]struct{}
+	clearedpayments      bool
+	returns              map[string]struct{}
+	removedreturns       map[string]struct{}
+	clearedreturns       bool
+	done                 bool
+	oldValue             func(context.Context) (*Order, error)
+	predicates           []predicate.Order
+}
+
+var _ ent.Mutation = (*OrderMutation)(nil)
+
+// orderOption allows management of the mutation configuration using functional options.
+type orderOption func(*OrderMutation)
+
+// newOrderMutation creates new mutation for the Order entity.
+func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
+	m := &OrderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderID sets the ID field of the mutation.
+func withOrderID(id string) orderOption {
+	return func(m *OrderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Order
+		)
+		m.oldValue = func(ctx context.Context) (*Order, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Order.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrder sets the old Order of the mutation.
+func withOrder(node *Order) orderOption {
+	return func(m *OrderMutation) {
+		m.oldValue = func(context.Context) (*Order, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Order entities.
+func (m *OrderMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OrderMutation) SetUserID(s string) {
+	m.user = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OrderMutation) UserID() (r string, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *OrderMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[order.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *OrderMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[order.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OrderMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, order.FieldUserID)
+}
+
+// SetGuestEmail sets the "guest_email" field.
+func (m *OrderMutation) SetGuestEmail(s string) {
+	m.guest_email = &s
+}
+
+// GuestEmail returns the value of the "guest_email" field in the mutation.
+func (m *OrderMutation) GuestEmail() (r string, exists bool) {
+	v := m.guest_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuestEmail returns the old "guest_email" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldGuestEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuestEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuestEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuestEmail: %w", err)
+	}
+	return oldValue.GuestEmail, nil
+}
+
+// ClearGuestEmail clears the value of the "guest_email" field.
+func (m *OrderMutation) ClearGuestEmail() {
+	m.guest_email = nil
+	m.clearedFields[order.FieldGuestEmail] = struct{}{}
+}
+
+// GuestEmailCleared returns if the "guest_email" field was cleared in this mutation.
+func (m *OrderMutation) GuestEmailCleared() bool {
+	_, ok := m.clearedFields[order.FieldGuestEmail]
+	return ok
+}
+
+// ResetGuestEmail resets all changes to the "guest_email" field.
+func (m *OrderMutation) ResetGuestEmail() {
+	m.guest_email = nil
+	delete(m.clearedFields, order.FieldGuestEmail)
+}
+
+// SetGuestName sets the "guest_name" field.
+func (m *OrderMutation) SetGuestName(s string) {
+	m.guest_name = &s
+}
+
+// GuestName returns the value of the "guest_name" field in the mutation.
+func (m *OrderMutation) GuestName() (r string, exists bool) {
+	v := m.guest_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGuestName returns the old "guest_name" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldGuestName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGuestName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGuestName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGuestName: %w", err)
+	}
+	return oldValue.GuestName, nil
+}
+
+// ClearGuestName clears the value of the "guest_name" field.
+func (m *OrderMutation) ClearGuestName() {
+	m.guest_name = nil
+	m.clearedFields[order.FieldGuestName] = struct{}{}
+}
+
+// GuestNameCleared returns if the "guest_name" field was cleared in this mutation.
+func (m *OrderMutation) GuestNameCleared() bool {
+	_, ok := m.clearedFields[order.FieldGuestName]
+	return ok
+}
+
+// ResetGuestName resets all changes to the "guest_name" field.
+func (m *OrderMutation) ResetGuestName() {
+	m.guest_name = nil
+	delete(m.clearedFields, order.FieldGuestName)
+}
+
+// SetDate sets the "date" field.
+func (m *OrderMutation) SetDate(t time.Time) {
+	m.date = &t
+}
+
+// Date returns the value of the "date" field in the mutation.
+func (m *OrderMutation) Date() (r time.Time, exists bool) {
+	v := m.date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDate returns the old "date" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+	}
+	return oldValue.Date, nil
+}
+
+// ResetDate resets all changes to the "date" field.
+func (m *OrderMutation) ResetDate() {
+	m.date = nil
+}
+
+// SetTotal sets the "total" field.
+func (m *OrderMutation) SetTotal(value money.Amount) {
+	m.total = &value
+	m.addtotal = nil
+}
+
+// Total returns the value of the "total" field in the mutation.
+func (m *OrderMutation) Total() (r money.Amount, exists bool) {
+	v := m.total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotal returns the old "total" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldTotal(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+	}
+	return oldValue.Total, nil
+}
+
+// AddTotal adds value to the "total" field.
+func (m *OrderMutation) AddTotal(value money.Amount) {
+	if m.addtotal != nil {
+		*m.addtotal += value
+	} else {
+		m.addtotal = &value
+	}
+}
+
+// AddedTotal returns the value that was added to the "total" field in this mutation.
+func (m *OrderMutation) AddedTotal() (r money.Amount, exists bool) {
+	v := m.addtotal
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotal resets all changes to the "total" field.
+func (m *OrderMutation) ResetTotal() {
+	m.total = nil
+	m.addtotal = nil
+}
+
+// SetShipping sets the "shipping" field.
+func (m *OrderMutation) SetShipping(value money.Amount) {
+	m.shipping = &value
+	m.addshipping = nil
+}
+
+// Shipping returns the value of the "shipping" field in the mutation.
+func (m *OrderMutation) Shipping() (r money.Amount, exists bool) {
+	v := m.shipping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShipping returns the old "shipping" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldShipping(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShipping is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShipping requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShipping: %w", err)
+	}
+	return oldValue.Shipping, nil
+}
+
+// AddShipping adds value to the "shipping" field.
+func (m *OrderMutation) AddShipping(value money.Amount) {
+	if m.addshipping != nil {
+		*m.addshipping += value
+	} else {
+		m.addshipping = &value
+	}
+}
+
+// AddedShipping returns the value that was added to the "shipping" field in this mutation.
+func (m *OrderMutation) AddedShipping() (r money.Amount, exists bool) {
+	v := m.addshipping
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetShipping resets all changes to the "shipping" field.
+func (m *OrderMutation) ResetShipping() {
+	m.shipping = nil
+	m.addshipping = nil
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (m *OrderMutation) SetShippingService(s string) {
+	m.shipping_service = &s
+}
+
+// ShippingService returns the value of the "shipping_service" field in the mutation.
+func (m *OrderMutation) ShippingService() (r string, exists bool) {
+	v := m.shipping_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShippingService returns the old "shipping_service" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldShippingService(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShippingService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShippingService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShippingService: %w", err)
+	}
+	return oldValue.ShippingService, nil
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (m *OrderMutation) ClearShippingService() {
+	m.shipping_service = nil
+	m.clearedFields[order.FieldShippingService] = struct{}{}
+}
+
+// ShippingServiceCleared returns if the "shipping_service" field was cleared in this mutation.
+func (m *OrderMutation) ShippingServiceCleared() bool {
+	_, ok := m.clearedFields[order.FieldShippingService]
+	return ok
+}
+
+// ResetShippingService resets all changes to the "shipping_service" field.
+func (m *OrderMutation) ResetShippingService() {
+	m.shipping_service = nil
+	delete(m.clearedFields, order.FieldShippingService)
+}
+
+// SetDiscount sets the "discount" field.
+func (m *OrderMutation) SetDiscount(value money.Amount) {
+	m.discount = &value
+	m.adddiscount = nil
+}
+
+// Discount returns the value of the "discount" field in the mutation.
+func (m *OrderMutation) Discount() (r money.Amount, exists bool) {
+	v := m.discount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDiscount returns the old "discount" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldDiscount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDiscount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	}
+	return oldValue.Discount, nil
+}
+
+// AddDiscount adds value to the "discount" field.
+func (m *OrderMutation) AddDiscount(value money.Amount) {
+	if m.adddiscount != nil {
+		*m.adddiscount += value
+	} else {
+		m.adddiscount = &value
+	}
+}
+
+// AddedDiscount returns the value that was added to the "discount" field in this mutation.
+func (m *OrderMutation) AddedDiscount() (r money.Amount, exists bool) {
+	v := m.adddiscount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDiscount resets all changes to the "discount" field.
+func (m *OrderMutation) ResetDiscount() {
+	m.discount = nil
+	m.adddiscount = nil
+}
+
+// SetRefundedTotal sets the "refunded_total" field.
+func (m *OrderMutation) SetRefundedTotal(value money.Amount) {
+	m.refunded_total = &value
+	m.addrefunded_total = nil
+}
+
+// RefundedTotal returns the value of the "refunded_total" field in the mutation.
+func (m *OrderMutation) RefundedTotal() (r money.Amount, exists bool) {
+	v := m.refunded_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundedTotal returns the old "refunded_total" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRefundedTotal(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundedTotal is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundedTotal requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundedTotal: %w", err)
+	}
+	return oldValue.RefundedTotal, nil
+}
+
+// AddRefundedTotal adds value to the "refunded_total" field.
+func (m *OrderMutation) AddRefundedTotal(value money.Amount) {
+	if m.addrefunded_total != nil {
+		*m.addrefunded_total += value
+	} else {
+		m.addrefunded_total = &value
+	}
+}
+
+// AddedRefundedTotal returns the value that was added to the "refunded_total" field in this mutation.
+func (m *OrderMutation) AddedRefundedTotal() (r money.Amount, exists bool) {
+	v := m.addrefunded_total
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRefundedTotal resets all changes to the "refunded_total" field.
+func (m *OrderMutation) ResetRefundedTotal() {
+	m.refunded_total = nil
+	m.addrefunded_total = nil
+}
+
+// SetDeliveryType sets the "delivery_type" field.
+func (m *OrderMutation) SetDeliveryType(ot order.DeliveryType) {
+	m.delivery_type = &ot
+}
+
+// DeliveryType returns the value of the "delivery_type" field in the mutation.
+func (m *OrderMutation) DeliveryType() (r order.DeliveryType, exists bool) {
+	v := m.delivery_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveryType returns the old "delivery_type" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldDeliveryType(ctx context.Context) (v order.DeliveryType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveryType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveryType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveryType: %w", err)
+	}
+	return oldValue.DeliveryType, nil
+}
+
+// ResetDeliveryType resets all changes to the "delivery_type" field.
+func (m *OrderMutation) ResetDeliveryType() {
+	m.delivery_type = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OrderMutation) SetStatus(o order.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrderMutation) Status() (r order.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldStatus(ctx context.Context) (v order.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAddressID sets the "address_id" field.
+func (m *OrderMutation) SetAddressID(s string) {
+	m.address = &s
+}
+
+// AddressID returns the value of the "address_id" field in the mutation.
+func (m *OrderMutation) AddressID() (r string, exists bool) {
+	v := m.address
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressID returns the old "address_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldAddressID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressID: %w", err)
+	}
+	return oldValue.AddressID, nil
+}
+
+// ClearAddressID clears the value of the "address_id" field.
+func (m *OrderMutation) ClearAddressID() {
+	m.address = nil
+	m.clearedFields[order.FieldAddressID] = struct{}{}
+}
+
+// AddressIDCleared returns if the "address_id" field was cleared in this mutation.
+func (m *OrderMutation) AddressIDCleared() bool {
+	_, ok := m.clearedFields[order.FieldAddressID]
+	return ok
+}
+
+// ResetAddressID resets all changes to the "address_id" field.
+func (m *OrderMutation) ResetAddressID() {
+	m.address = nil
+	delete(m.clearedFields, order.FieldAddressID)
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (m *OrderMutation) SetPaymentMethod(s string) {
+	m.payment_method = &s
+}
+
+// PaymentMethod returns the value of the "payment_method" field in the mutation.
+func (m *OrderMutation) PaymentMethod() (r string, exists bool) {
+	v := m.payment_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentMethod returns the old "payment_method" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPaymentMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentMethod: %w", err)
+	}
+	return oldValue.PaymentMethod, nil
+}
+
+// ResetPaymentMethod resets all changes to the "payment_method" field.
+func (m *OrderMutation) ResetPaymentMethod() {
+	m.payment_method = nil
+}
+
+// SetPaymentStatus sets the "payment_status" field.
+func (m *OrderMutation) SetPaymentStatus(s string) {
+	m.payment_status = &s
+}
+
+// PaymentStatus returns the value of the "payment_status" field in the mutation.
+func (m *OrderMutation) PaymentStatus() (r string, exists bool) {
+	v := m.payment_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentStatus returns the old "payment_status" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPaymentStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentStatus: %w", err)
+	}
+	return oldValue.PaymentStatus, nil
+}
+
+// ResetPaymentStatus resets all changes to the "payment_status" field.
+func (m *OrderMutation) ResetPaymentStatus() {
+	m.payment_status = nil
+}
+
+// SetCouponCode sets the "coupon_code" field.
+func (m *OrderMutation) SetCouponCode(s string) {
+	m.coupon_code = &s
+}
+
+// CouponCode returns the value of the "coupon_code" field in the mutation.
+func (m *OrderMutation) CouponCode() (r string, exists bool) {
+	v := m.coupon_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCouponCode returns the old "coupon_code" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCouponCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCouponCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCouponCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCouponCode: %w", err)
+	}
+	return oldValue.CouponCode, nil
+}
+
+// ClearCouponCode clears the value of the "coupon_code" field.
+func (m *OrderMutation) ClearCouponCode() {
+	m.coupon_code = nil
+	m.clearedFields[order.FieldCouponCode] = struct{}{}
+}
+
+// CouponCodeCleared returns if the "coupon_code" field was cleared in this mutation.
+func (m *OrderMutation) CouponCodeCleared() bool {
+	_, ok := m.clearedFields[order.FieldCouponCode]
+	return ok
+}
+
+// ResetCouponCode resets all changes to the "coupon_code" field.
+func (m *OrderMutation) ResetCouponCode() {
+	m.coupon_code = nil
+	delete(m.clearedFields, order.FieldCouponCode)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrderMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *OrderMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[order.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *OrderMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearAddress clears the "address" edge to the Address entity.
+func (m *OrderMutation) ClearAddress() {
+	m.clearedaddress = true
+	m.clearedFields[order.FieldAddressID] = struct{}{}
+}
+
+// AddressCleared reports if the "address" edge to the Address entity was cleared.
+func (m *OrderMutation) AddressCleared() bool {
+	return m.AddressIDCleared() || m.clearedaddress
+}
+
+// AddressIDs returns the "address" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AddressID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) AddressIDs() (ids []string) {
+	if id := m.address; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAddress resets all changes to the "address" edge.
+func (m *OrderMutation) ResetAddress() {
+	m.address = nil
+	m.clearedaddress = false
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by ids.
+func (m *OrderMutation) AddOrderItemIDs(ids ...string) {
+	if m.order_items == nil {
+		m.order_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.order_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrderItems clears the "order_items" edge to the OrderItem entity.
+func (m *OrderMutation) ClearOrderItems() {
+	m.clearedorder_items = true
+}
+
+// OrderItemsCleared reports if the "order_items" edge to the OrderItem entity was cleared.
+func (m *OrderMutation) OrderItemsCleared() bool {
+	return m.clearedorder_items
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to the OrderItem entity by IDs.
+func (m *OrderMutation) RemoveOrderItemIDs(ids ...string) {
+	if m.removedorder_items == nil {
+		m.removedorder_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.order_items, ids[i])
+		m.removedorder_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrderItems returns the removed IDs of the "order_items" edge to the OrderItem entity.
+func (m *OrderMutation) RemovedOrderItemsIDs() (ids []string) {
+	for id := range m.removedorder_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrderItemsIDs returns the "order_items" edge IDs in the mutation.
+func (m *OrderMutation) OrderItemsIDs() (ids []string) {
+	for id := range m.order_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrderItems resets all changes to the "order_items" edge.
+func (m *OrderMutation) ResetOrderItems() {
+	m.order_items = nil
+	m.clearedorder_items = false
+	m.removedorder_items = nil
+}
+
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by ids.
+func (m *OrderMutation) AddStatusEventIDs(ids ...string) {
+	if m.status_events == nil {
+		m.status_events = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.status_events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStatusEvents clears the "status_events" edge to the OrderStatusEvent entity.
+func (m *OrderMutation) ClearStatusEvents() {
+	m.clearedstatus_events = true
+}
+
+// StatusEventsCleared reports if the "status_events" edge to the OrderStatusEvent entity was cleared.
+func (m *OrderMutation) StatusEventsCleared() bool {
+	return m.clearedstatus_events
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (m *OrderMutation) RemoveStatusEventIDs(ids ...string) {
+	if m.removedstatus_events == nil {
+		m.removedstatus_events = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.status_events, ids[i])
+		m.removedstatus_events[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStatusEvents returns the removed IDs of the "status_events" edge to the OrderStatusEvent entity.
+func (m *OrderMutation) RemovedStatusEventsIDs() (ids []string) {
+	for id := range m.removedstatus_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StatusEventsIDs returns the "status_events" edge IDs in the mutation.
+func (m *OrderMutation) StatusEventsIDs() (ids []string) {
+	for id := range m.status_events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStatusEvents resets all changes to the "status_events" edge.
+func (m *OrderMutation) ResetStatusEvents() {
+	m.status_events = nil
+	m.clearedstatus_events = false
+	m.removedstatus_events = nil
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by id.
+func (m *OrderMutation) SetShipmentID(id string) {
+	m.shipment = &id
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (m *OrderMutation) ClearShipment() {
+	m.clearedshipment = true
+}
+
+// ShipmentCleared reports if the "shipment" edge to the Shipment entity was cleared.
+func (m *OrderMutation) ShipmentCleared() bool {
+	return m.clearedshipment
+}
+
+// ShipmentID returns the "shipment" edge ID in the mutation.
+func (m *OrderMutation) ShipmentID() (id string, exists bool) {
+	if m.shipment != nil {
+		return *m.shipment, true
+	}
+	return
+}
+
+// ShipmentIDs returns the "shipment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ShipmentID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) ShipmentIDs() (ids []string) {
+	if id := m.shipment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetShipment resets all changes to the "shipment" edge.
+func (m *OrderMutation) ResetShipment() {
+	m.shipment = nil
+	m.clearedshipment = false
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
+func (m *OrderMutation) AddPaymentIDs(ids ...string) {
+	if m.payments == nil {
+		m.payments = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.payments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayments clears the "payments" edge to the Payment entity.
+func (m *OrderMutation) ClearPayments() {
+	m.clearedpayments = true
+}
+
+// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
+func (m *OrderMutation) PaymentsCleared() bool {
+	return m.clearedpayments
+}
+
+// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
+func (m *OrderMutation) RemovePaymentIDs(ids ...string) {
+	if m.removedpayments == nil {
+		m.removedpayments = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.payments, ids[i])
+		m.removedpayments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
+func (m *OrderMutation) RemovedPaymentsIDs() (ids []string) {
+	for id := range m.removedpayments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PaymentsIDs returns the "payments" edge IDs in the mutation.
+func (m *OrderMutation) PaymentsIDs() (ids []string) {
+	for id := range m.payments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayments resets all changes to the "payments" edge.
+func (m *OrderMutation) ResetPayments() {
+	m.payments = nil
+	m.clearedpayments = false
+	m.removedpayments = nil
+}
+
+// AddReturnIDs adds the "returns" edge to the ReturnRequest entity by ids.
+func (m *OrderMutation) AddReturnIDs(ids ...string) {
+	if m.returns == nil {
+		m.returns = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.returns[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReturns clears the "returns" edge to the ReturnRequest entity.
+func (m *OrderMutation) ClearReturns() {
+	m.clearedreturns = true
+}
+
+// ReturnsCleared reports if the "returns" edge to the ReturnRequest entity was cleared.
+func (m *OrderMutation) ReturnsCleared() bool {
+	return m.clearedreturns
+}
+
+// RemoveReturnIDs removes the "returns" edge to the ReturnRequest entity by IDs.
+func (m *OrderMutation) RemoveReturnIDs(ids ...string) {
+	if m.removedreturns == nil {
+		m.removedreturns = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.returns, ids[i])
+		m.removedreturns[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReturns returns the removed IDs of the "returns" edge to the ReturnRequest entity.
+func (m *OrderMutation) RemovedReturnsIDs() (ids []string) {
+	for id := range m.removedreturns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReturnsIDs returns the "returns" edge IDs in the mutation.
+func (m *OrderMutation) ReturnsIDs() (ids []string) {
+	for id := range m.returns {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReturns resets all changes to the "returns" edge.
+func (m *OrderMutation) ResetReturns() {
+	m.returns = nil
+	m.clearedreturns = false
+	m.removedreturns = nil
+}
+
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.user != nil {
+		fields = append(fields, order.FieldUserID)
+	}
+	if m.guest_email != nil {
+		fields = append(fields, order.FieldGuestEmail)
+	}
+	if m.guest_name != nil {
+		fields = append(fields, order.FieldGuestName)
+	}
+	if m.date != nil {
+		fields = append(fields, order.FieldDate)
+	}
+	if m.total != nil {
+		fields = append(fields, order.FieldTotal)
+	}
+	if m.shipping != nil {
+		fields = append(fields, order.FieldShipping)
+	}
+	if m.shipping_service != nil {
+		fields = append(fields, order.FieldShippingService)
+	}
+	if m.discount != nil {
+		fields = append(fields, order.FieldDiscount)
+	}
+	if m.refunded_total != nil {
+		fields = append(fields, order.FieldRefundedTotal)
+	}
+	if m.delivery_type != nil {
+		fields = append(fields, order.FieldDeliveryType)
+	}
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
+	}
+	if m.address != nil {
+		fields = append(fields, order.FieldAddressID)
+	}
+	if m.payment_method != nil {
+		fields = append(fields, order.FieldPaymentMethod)
+	}
+	if m.payment_status != nil {
+		fields = append(fields, order.FieldPaymentStatus)
+	}
+	if m.coupon_code != nil {
+		fields = append(fields, order.FieldCouponCode)
+	}
+	if m.created_at != nil {
+		fields = append(fields, order.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, order.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldUserID:
+		return m.UserID()
+	case order.FieldGuestEmail:
+		return m.GuestEmail()
+	case order.FieldGuestName:
+		return m.GuestName()
+	case order.FieldDate:
+		return m.Date()
+	case order.FieldTotal:
+		return m.Total()
+	case order.FieldShipping:
+		return m.Shipping()
+	case order.FieldShippingService:
+		return m.ShippingService()
+	case order.FieldDiscount:
+		return m.Discount()
+	case order.FieldRefundedTotal:
+		return m.RefundedTotal()
+	case order.FieldDeliveryType:
+		return m.DeliveryType()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldAddressID:
+		return m.AddressID()
+	case order.FieldPaymentMethod:
+		return m.PaymentMethod()
+	case order.FieldPaymentStatus:
+		return m.PaymentStatus()
+	case order.FieldCouponCode:
+		return m.CouponCode()
+	case order.FieldCreatedAt:
+		return m.CreatedAt()
+	case order.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldUserID:
+		return m.OldUserID(ctx)
+	case order.FieldGuestEmail:
+		return m.OldGuestEmail(ctx)
+	case order.FieldGuestName:
+		return m.OldGuestName(ctx)
+	case order.FieldDate:
+		return m.OldDate(ctx)
+	case order.FieldTotal:
+		return m.OldTotal(ctx)
+	case order.FieldShipping:
+		return m.OldShipping(ctx)
+	case order.FieldShippingService:
+		return m.OldShippingService(ctx)
+	case order.FieldDiscount:
+		return m.OldDiscount(ctx)
+	case order.FieldRefundedTotal:
+		return m.OldRefundedTotal(ctx)
+	case order.FieldDeliveryType:
+		return m.OldDeliveryType(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldAddressID:
+		return m.OldAddressID(ctx)
+	case order.FieldPaymentMethod:
+		return m.OldPaymentMethod(ctx)
+	case order.FieldPaymentStatus:
+		return m.OldPaymentStatus(ctx)
+	case order.FieldCouponCode:
+		return m.OldCouponCode(ctx)
+	case order.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case order.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldUserID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case order.FieldGuestEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuestEmail(v)
+		return nil
+	case order.FieldGuestName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGuestName(v)
+		return nil
+	case order.FieldDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDate(v)
+		return nil
+	case order.FieldTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotal(v)
+		return nil
+	case order.FieldShipping:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShipping(v)
+		return nil
+	case order.FieldShippingService:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShippingService(v)
+		return nil
+	case order.FieldDiscount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDiscount(v)
+		return nil
+	case order.FieldRefundedTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundedTotal(v)
+		return nil
+	case order.FieldDeliveryType:
+		v, ok := value.(order.DeliveryType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveryType(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(order.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldAddressID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressID(v)
+		return nil
+	case order.FieldPaymentMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentMethod(v)
+		return nil
+	case order.FieldPaymentStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentStatus(v)
+		return nil
+	case order.FieldCouponCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCouponCode(v)
+		return nil
+	case order.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case order.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal != nil {
+		fields = append(fields, order.FieldTotal)
+	}
+	if m.addshipping != nil {
+		fields = append(fields, order.FieldShipping)
+	}
+	if m.adddiscount != nil {
+		fields = append(fields, order.FieldDiscount)
+	}
+	if m.addrefunded_total != nil {
+		fields = append(fields, order.FieldRefundedTotal)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldTotal:
+		return m.AddedTotal()
+	case order.FieldShipping:
+		return m.AddedShipping()
+	case order.FieldDiscount:
+		return m.AddedDiscount()
+	case order.FieldRefundedTotal:
+		return m.AddedRefundedTotal()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotal(v)
+		return nil
+	case order.FieldShipping:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShipping(v)
+		return nil
+	case order.FieldDiscount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDiscount(v)
+		return nil
+	case order.FieldRefundedTotal:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefundedTotal(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(order.FieldUserID) {
+		fields = append(fields, order.FieldUserID)
+	}
+	if m.FieldCleared(order.FieldGuestEmail) {
+		fields = append(fields, order.FieldGuestEmail)
+	}
+	if m.FieldCleared(order.FieldGuestName) {
+		fields = append(fields, order.FieldGuestName)
+	}
+	if m.FieldCleared(order.FieldShippingService) {
+		fields = append(fields, order.FieldShippingService)
+	}
+	if m.FieldCleared(order.FieldAddressID) {
+		fields = append(fields, order.FieldAddressID)
+	}
+	if m.FieldCleared(order.FieldCouponCode) {
+		fields = append(fields, order.FieldCouponCode)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	switch name {
+	case order.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case order.FieldGuestEmail:
+		m.ClearGuestEmail()
+		return nil
+	case order.FieldGuestName:
+		m.ClearGuestName()
+		return nil
+	case order.FieldShippingService:
+		m.ClearShippingService()
+		return nil
+	case order.FieldAddressID:
+		m.ClearAddressID()
+		return nil
+	case order.FieldCouponCode:
+		m.ClearCouponCode()
+		return nil
+	}
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case order.FieldGuestEmail:
+		m.ResetGuestEmail()
+		return nil
+	case order.FieldGuestName:
+		m.ResetGuestName()
+		return nil
+	case order.FieldDate:
+		m.ResetDate()
+		return nil
+	case order.FieldTotal:
+		m.ResetTotal()
+		return nil
+	case order.FieldShipping:
+		m.ResetShipping()
+		return nil
+	case order.FieldShippingService:
+		m.ResetShippingService()
+		return nil
+	case order.FieldDiscount:
+		m.ResetDiscount()
+		return nil
+	case order.FieldRefundedTotal:
+		m.ResetRefundedTotal()
+		return nil
+	case order.FieldDeliveryType:
+		m.ResetDeliveryType()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldAddressID:
+		m.ResetAddressID()
+		return nil
+	case order.FieldPaymentMethod:
+		m.ResetPaymentMethod()
+		return nil
+	case order.FieldPaymentStatus:
+		m.ResetPaymentStatus()
+		return nil
+	case order.FieldCouponCode:
+		m.ResetCouponCode()
+		return nil
+	case order.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case order.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.user != nil {
+		edges = append(edges, order.EdgeUser)
+	}
+	if m.address != nil {
+		edges = append(edges, order.EdgeAddress)
+	}
+	if m.order_items != nil {
+		edges = append(edges, order.EdgeOrderItems)
+	}
+	if m.status_events != nil {
+		edges = append(edges, order.EdgeStatusEvents)
+	}
+	if m.shipment != nil {
+		edges = append(edges, order.EdgeShipment)
+	}
+	if m.payments != nil {
+		edges = append(edges, order.EdgePayments)
+	}
+	if m.returns != nil {
+		edges = append(edges, order.EdgeReturns)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case order.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeAddress:
+		if id := m.address; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.order_items))
+		for id := range m.order_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeStatusEvents:
+		ids := make([]ent.Value, 0, len(m.status_events))
+		for id := range m.status_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeShipment:
+		if id := m.shipment; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.payments))
+		for id := range m.payments {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeReturns:
+		ids := make([]ent.Value, 0, len(m.returns))
+		for id := range m.returns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedorder_items != nil {
+		edges = append(edges, order.EdgeOrderItems)
+	}
+	if m.removedstatus_events != nil {
+		edges = append(edges, order.EdgeStatusEvents)
+	}
+	if m.removedpayments != nil {
+		edges = append(edges, order.EdgePayments)
+	}
+	if m.removedreturns != nil {
+		edges = append(edges, order.EdgeReturns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case order.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.removedorder_items))
+		for id := range m.removedorder_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeStatusEvents:
+		ids := make([]ent.Value, 0, len(m.removedstatus_events))
+		for id := range m.removedstatus_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.removedpayments))
+		for id := range m.removedpayments {
+			ids = append(ids, id)
+		}
+		return ids
+	case order.EdgeReturns:
+		ids := make([]ent.Value, 0, len(m.removedreturns))
+		for id := range m.removedreturns {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.cleareduser {
+		edges = append(edges, order.EdgeUser)
+	}
+	if m.clearedaddress {
+		edges = append(edges, order.EdgeAddress)
+	}
+	if m.clearedorder_items {
+		edges = append(edges, order.EdgeOrderItems)
+	}
+	if m.clearedstatus_events {
+		edges = append(edges, order.EdgeStatusEvents)
+	}
+	if m.clearedshipment {
+		edges = append(edges, order.EdgeShipment)
+	}
+	if m.clearedpayments {
+		edges = append(edges, order.EdgePayments)
+	}
+	if m.clearedreturns {
+		edges = append(edges, order.EdgeReturns)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case order.EdgeUser:
+		return m.cleareduser
+	case order.EdgeAddress:
+		return m.clearedaddress
+	case order.EdgeOrderItems:
+		return m.clearedorder_items
+	case order.EdgeStatusEvents:
+		return m.clearedstatus_events
+	case order.EdgeShipment:
+		return m.clearedshipment
+	case order.EdgePayments:
+		return m.clearedpayments
+	case order.EdgeReturns:
+		return m.clearedreturns
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	switch name {
+	case order.EdgeUser:
+		m.ClearUser()
+		return nil
+	case order.EdgeAddress:
+		m.ClearAddress()
+		return nil
+	case order.EdgeShipment:
+		m.ClearShipment()
+		return nil
+	}
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	switch name {
+	case order.EdgeUser:
+		m.ResetUser()
+		return nil
+	case order.EdgeAddress:
+		m.ResetAddress()
+		return nil
+	case order.EdgeOrderItems:
+		m.ResetOrderItems()
+		return nil
+	case order.EdgeStatusEvents:
+		m.ResetStatusEvents()
+		return nil
+	case order.EdgeShipment:
+		m.ResetShipment()
+		return nil
+	case order.EdgePayments:
+		m.ResetPayments()
+		return nil
+	case order.EdgeReturns:
+		m.ResetReturns()
+		return nil
+	}
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// OrderItemMutation represents an operation that mutates the OrderItem nodes in the graph.
+type OrderItemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *string
+	sku             *string
+	variant_options *map[string]string
+	name            *string
+	price           *money.Amount
+	addprice        *money.Amount
+	image           *string
+	quantity        *int
+	addquantity     *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	_order          *string
+	cleared_order   bool
+	product         *string
+	clearedproduct  bool
+	variant         *string
+	clearedvariant  bool
+	done            bool
+	oldValue        func(context.Context) (*OrderItem, error)
+	predicates      []predicate.OrderItem
+}
+
+var _ ent.Mutation = (*OrderItemMutation)(nil)
+
+// orderitemOption allows management of the mutation configuration using functional options.
+type orderitemOption func(*OrderItemMutation)
+
+// newOrderItemMutation creates new mutation for the OrderItem entity.
+func newOrderItemMutation(c config, op Op, opts ...orderitemOption) *OrderItemMutation {
+	m := &OrderItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrderItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderItemID sets the ID field of the mutation.
+func withOrderItemID(id string) orderitemOption {
+	return func(m *OrderItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrderItem
+		)
+		m.oldValue = func(ctx context.Context) (*OrderItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrderItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrderItem sets the old OrderItem of the mutation.
+func withOrderItem(node *OrderItem) orderitemOption {
+	return func(m *OrderItemMutation) {
+		m.oldValue = func(context.Context) (*OrderItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrderItem entities.
+func (m *OrderItemMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderItemMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderItemMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrderItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrderID sets the "order_id" field.
+func (m *OrderItemMutation) SetOrderID(s string) {
+	m._order = &s
+}
+
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *OrderItemMutation) OrderID() (r string, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderID returns the old "order_id" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+	}
+	return oldValue.OrderID, nil
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (m *OrderItemMutation) ClearOrderID() {
+	m._order = nil
+	m.clearedFields[orderitem.FieldOrderID] = struct{}{}
+}
+
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *OrderItemMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[orderitem.FieldOrderID]
+	return ok
+}
+
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *OrderItemMutation) ResetOrderID() {
+	m._order = nil
+	delete(m.clearedFields, orderitem.FieldOrderID)
+}
+
+// SetProductID sets the "product_id" field.
+func (m *OrderItemMutation) SetProductID(s string) {
+	m.product = &s
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *OrderItemMutation) ProductID() (r string, exists bool) {
+	v := m.product
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldProductID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (m *OrderItemMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[orderitem.FieldProductID] = struct{}{}
+}
+
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *OrderItemMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[orderitem.FieldProductID]
+	return ok
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *OrderItemMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, orderitem.FieldProductID)
+}
+
+// SetVariantID sets the "variant_id" field.
+func (m *OrderItemMutation) SetVariantID(s string) {
+	m.variant = &s
+}
+
+// VariantID returns the value of the "variant_id" field in the mutation.
+func (m *OrderItemMutation) VariantID() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariantID returns the old "variant_id" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldVariantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariantID: %w", err)
+	}
+	return oldValue.VariantID, nil
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (m *OrderItemMutation) ClearVariantID() {
+	m.variant = nil
+	m.clearedFields[orderitem.FieldVariantID] = struct{}{}
+}
+
+// VariantIDCleared returns if the "variant_id" field was cleared in this mutation.
+func (m *OrderItemMutation) VariantIDCleared() bool {
+	_, ok := m.clearedFields[orderitem.FieldVariantID]
+	return ok
+}
+
+// ResetVariantID resets all changes to the "variant_id" field.
+func (m *OrderItemMutation) ResetVariantID() {
+	m.variant = nil
+	delete(m.clearedFields, orderitem.FieldVariantID)
+}
+
+// SetSku sets the "sku" field.
+func (m *OrderItemMutation) SetSku(s string) {
+	m.sku = &s
+}
+
+// Sku returns the value of the "sku" field in the mutation.
+func (m *OrderItemMutation) Sku() (r string, exists bool) {
+	v := m.sku
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSku returns the old "sku" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldSku(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSku is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSku requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSku: %w", err)
+	}
+	return oldValue.Sku, nil
+}
+
+// ClearSku clears the value of the "sku" field.
+func (m *OrderItemMutation) ClearSku() {
+	m.sku = nil
+	m.clearedFields[orderitem.FieldSku] = struct{}{}
+}
+
+// SkuCleared returns if the "sku" field was cleared in this mutation.
+func (m *OrderItemMutation) SkuCleared() bool {
+	_, ok := m.clearedFields[orderitem.FieldSku]
+	return ok
+}
+
+// ResetSku resets all changes to the "sku" field.
+func (m *OrderItemMutation) ResetSku() {
+	m.sku = nil
+	delete(m.clearedFields, orderitem.FieldSku)
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (m *OrderItemMutation) SetVariantOptions(value map[string]string) {
+	m.variant_options = &value
+}
+
+// VariantOptions returns the value of the "variant_options" field in the mutation.
+func (m *OrderItemMutation) VariantOptions() (r map[string]string, exists bool) {
+	v := m.variant_options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariantOptions returns the old "variant_options" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldVariantOptions(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariantOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariantOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariantOptions: %w", err)
+	}
+	return oldValue.VariantOptions, nil
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (m *OrderItemMutation) ClearVariantOptions() {
+	m.variant_options = nil
+	m.clearedFields[orderitem.FieldVariantOptions] = struct{}{}
+}
+
+// VariantOptionsCleared returns if the "variant_options" field was cleared in this mutation.
+func (m *OrderItemMutation) VariantOptionsCleared() bool {
+	_, ok := m.clearedFields[orderitem.FieldVariantOptions]
+	return ok
+}
+
+// ResetVariantOptions resets all changes to the "variant_options" field.
+func (m *OrderItemMutation) ResetVariantOptions() {
+	m.variant_options = nil
+	delete(m.clearedFields, orderitem.FieldVariantOptions)
+}
+
+// SetName sets the "name" field.
+func (m *OrderItemMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrderItemMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrderItemMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *OrderItemMutation) SetPrice(value money.Amount) {
+	m.price = &value
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *OrderItemMutation) Price() (r money.Amount, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldPrice(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds value to the "price" field.
+func (m *OrderItemMutation) AddPrice(value money.Amount) {
+	if m.addprice != nil {
+		*m.addprice += value
+	} else {
+		m.addprice = &value
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *OrderItemMutation) AddedPrice() (r money.Amount, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *OrderItemMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetImage sets the "image" field.
+func (m *OrderItemMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *OrderItemMutation) Image() (r string, exists bool) {
+	v := m.image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImage returns the old "image" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldImage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+	}
+	return oldValue.Image, nil
+}
+
+// ResetImage resets all changes to the "image" field.
+func (m *OrderItemMutation) ResetImage() {
+	m.image = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *OrderItemMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *OrderItemMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *OrderItemMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *OrderItemMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *OrderItemMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrderItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrderItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrderItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrderItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrderItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OrderItem entity.
+// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrderItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *OrderItemMutation) ClearOrder() {
+	m.cleared_order = true
+	m.clearedFields[orderitem.FieldOrderID] = struct{}{}
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *OrderItemMutation) OrderCleared() bool {
+	return m.OrderIDCleared() || m.cleared_order
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *OrderItemMutation) OrderIDs() (ids []string) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *OrderItemMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *OrderItemMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[orderitem.FieldProductID] = struct{}{}
+}
+
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *OrderItemMutation) ProductCleared() bool {
+	return m.ProductIDCleared() || m.clearedproduct
+}
+
+// ProductIDs returns the "product" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *OrderItemMutation) ProductIDs() (ids []string) {
+	if id := m.product; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProduct resets all changes to the "product" edge.
+func (m *OrderItemMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
+}
+
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (m *OrderItemMutation) ClearVariant() {
+	m.clearedvariant = true
+	m.clearedFields[orderitem.FieldVariantID] = struct{}{}
+}
+
+// VariantCleared reports if the "variant" edge to the ProductVariant entity was cleared.
+func (m *OrderItemMutation) VariantCleared() bool {
+	return m.VariantIDCleared() || m.clearedvariant
+}
+
+// VariantIDs returns the "variant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VariantID instead. It exists only for internal usage by the builders.
+func (m *OrderItemMutation) VariantIDs() (ids []string) {
+	if id := m.variant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetVariant resets all changes to the "variant" edge.
+func (m *OrderItemMutation) ResetVariant() {
+	m.variant = nil
+	m.clearedvariant = false
+}
+
+// Where appends a list predicates to the OrderItemMutation builder.
+func (m *OrderItemMutation) Where(ps ...predicate.OrderItem) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrderItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrderItem).
+func (m *OrderItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderItemMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m._order != nil {
+		fields = append(fields, orderitem.FieldOrderID)
+	}
+	if m.product != nil {
+		fields = append(fields, orderitem.FieldProductID)
+	}
+	if m.variant != nil {
+		fields = append(fields, orderitem.FieldVariantID)
+	}
+	if m.sku != nil {
+		fields = append(fields, orderitem.FieldSku)
+	}
+	if m.variant_options != nil {
+		fields = append(fields, orderitem.FieldVariantOptions)
+	}
+	if m.name != nil {
+		fields = append(fields, orderitem.FieldName)
+	}
+	if m.price != nil {
+		fields = append(fields, orderitem.FieldPrice)
+	}
+	if m.image != nil {
+		fields = append(fields, orderitem.FieldImage)
+	}
+	if m.quantity != nil {
+		fields = append(fields, orderitem.FieldQuantity)
+	}
+	if m.created_at != nil {
+		fields = append(fields, orderitem.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, orderitem.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orderitem.FieldOrderID:
+		return m.OrderID()
+	case orderitem.FieldProductID:
+		return m.ProductID()
+	case orderitem.FieldVariantID:
+		return m.VariantID()
+	case orderitem.FieldSku:
+		return m.Sku()
+	case orderitem.FieldVariantOptions:
+		return m.VariantOptions()
+	case orderitem.FieldName:
+		return m.Name()
+	case orderitem.FieldPrice:
+		return m.Price()
+	case orderitem.FieldImage:
+		return m.Image()
+	case orderitem.FieldQuantity:
+		return m.Quantity()
+	case orderitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case orderitem.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orderitem.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case orderitem.FieldProductID:
+		return m.OldProductID(ctx)
+	case orderitem.FieldVariantID:
+		return m.OldVariantID(ctx)
+	case orderitem.FieldSku:
+		return m.OldSku(ctx)
+	case orderitem.FieldVariantOptions:
+		return m.OldVariantOptions(ctx)
+	case orderitem.FieldName:
+		return m.OldName(ctx)
+	case orderitem.FieldPrice:
+		return m.OldPrice(ctx)
+	case orderitem.FieldImage:
+		return m.OldImage(ctx)
+	case orderitem.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case orderitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case orderitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrderItem field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orderitem.FieldOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderID(v)
+		return nil
+	case orderitem.FieldProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case orderitem.FieldVariantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantID(v)
+		return nil
+	case orderitem.FieldSku:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSku(v)
+		return nil
+	case orderitem.FieldVariantOptions:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariantOptions(v)
+		return nil
+	case orderitem.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case orderitem.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case orderitem.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case orderitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case orderitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case orderitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, orderitem.FieldPrice)
+	}
+	if m.addquantity != nil {
+		fields = append(fields, orderitem.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case orderitem.FieldPrice:
+		return m.AddedPrice()
+	case orderitem.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case orderitem.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case orderitem.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orderitem.FieldOrderID) {
+		fields = append(fields, orderitem.FieldOrderID)
+	}
+	if m.FieldCleared(orderitem.FieldProductID) {
+		fields = append(fields, orderitem.FieldProductID)
+	}
+	if m.FieldCleared(orderitem.FieldVariantID) {
+		fields = append(fields, orderitem.FieldVariantID)
+	}
+	if m.FieldCleared(orderitem.FieldSku) {
+		fields = append(fields, orderitem.FieldSku)
+	}
+	if m.FieldCleared(orderitem.FieldVariantOptions) {
+		fields = append(fields, orderitem.FieldVariantOptions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderItemMutation) ClearField(name string) error {
+	switch name {
+	case orderitem.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case orderitem.FieldProductID:
+		m.ClearProductID()
+		return nil
+	case orderitem.FieldVariantID:
+		m.ClearVariantID()
+		return nil
+	case orderitem.FieldSku:
+		m.ClearSku()
+		return nil
+	case orderitem.FieldVariantOptions:
+		m.ClearVariantOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderItemMutation) ResetField(name string) error {
+	switch name {
+	case orderitem.FieldOrderID:
+		m.ResetOrderID()
+		return nil
+	case orderitem.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case orderitem.FieldVariantID:
+		m.ResetVariantID()
+		return nil
+	case orderitem.FieldSku:
+		m.ResetSku()
+		return nil
+	case orderitem.FieldVariantOptions:
+		m.ResetVariantOptions()
+		return nil
+	case orderitem.FieldName:
+		m.ResetName()
+		return nil
+	case orderitem.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case orderitem.FieldImage:
+		m.ResetImage()
+		return nil
+	case orderitem.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case orderitem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case orderitem.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m._order != nil {
+		edges = append(edges, orderitem.EdgeOrder)
+	}
+	if m.product != nil {
+		edges = append(edges, orderitem.EdgeProduct)
+	}
+	if m.variant != nil {
+		edges = append(edges, orderitem.EdgeVariant)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case orderitem.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	case orderitem.EdgeProduct:
+		if id := m.product; id != nil {
+			return []ent.Value{*id}
+		}
+	case orderitem.EdgeVariant:
+		if id := m.variant; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleared_order {
+		edges = append(edges, orderitem.EdgeOrder)
+	}
+	if m.clearedproduct {
+		edges = append(edges, orderitem.EdgeProduct)
+	}
+	if m.clearedvariant {
+		edges = append(edges, orderitem.EdgeVariant)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case orderitem.EdgeOrder:
+		return m.cleared_order
+	case orderitem.EdgeProduct:
+		return m.clearedproduct
+	case orderitem.EdgeVariant:
+		return m.clearedvariant
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderItemMutation) ClearEdge(name string) error {
+	switch name {
+	case orderitem.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	case orderitem.EdgeProduct:
+		m.ClearProduct()
+		return nil
+	case orderitem.EdgeVariant:
+		m.ClearVariant()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderItemMutation) ResetEdge(name string) error {
+	switch name {
+	case orderitem.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	case orderitem.EdgeProduct:
+		m.ResetProduct()
+		return nil
+	case orderitem.EdgeVariant:
+		m.ResetVariant()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderItem edge %s", name)
+}
+
+// OrderStatusEventMutation represents an operation that mutates the OrderStatusEvent nodes in the graph.
+type OrderStatusEventMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	from_status   *orderstatusevent.FromStatus
+	to_status     *orderstatusevent.ToStatus
+	changed_by    *string
+	note          *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	_order        *string
+	cleared_order bool
+	done          bool
+	oldValue      func(context.Context) (*OrderStatusEvent, error)
+	predicates    []predicate.OrderStatusEvent
+}
+
+var _ ent.Mutation = (*OrderStatusEventMutation)(nil)
+
+// orderstatuseventOption allows management of the mutation configuration using functional options.
+type orderstatuseventOption func(*OrderStatusEventMutation)
+
+// newOrderStatusEventMutation creates new mutation for the OrderStatusEvent entity.
+func newOrderStatusEventMutation(c config, op Op, opts ...orderstatuseventOption) *OrderStatusEventMutation {
+	m := &OrderStatusEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrderStatusEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderStatusEventID sets the ID field of the mutation.
+func withOrderStatusEventID(id string) orderstatuseventOption {
+	return func(m *OrderStatusEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrderStatusEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OrderStatusEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrderStatusEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrderStatusEvent sets the old OrderStatusEvent of the mutation.
+func withOrderStatusEvent(node *OrderStatusEvent) orderstatuseventOption {
+	return func(m *OrderStatusEventMutation) {
+		m.oldValue = func(context.Context) (*OrderStatusEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderStatusEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderStatusEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrderStatusEvent entities.
+func (m *OrderStatusEventMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderStatusEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderStatusEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrderStatusEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrderID sets the "order_id" field.
+func (m *OrderStatusEventMutation) SetOrderID(s string) {
+	m._order = &s
+}
+
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *OrderStatusEventMutation) OrderID() (r string, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderID returns the old "order_id" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+	}
+	return oldValue.OrderID, nil
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (m *OrderStatusEventMutation) ClearOrderID() {
+	m._order = nil
+	m.clearedFields[orderstatusevent.FieldOrderID] = struct{}{}
+}
+
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *OrderStatusEventMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[orderstatusevent.FieldOrderID]
+	return ok
+}
+
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *OrderStatusEventMutation) ResetOrderID() {
+	m._order = nil
+	delete(m.clearedFields, orderstatusevent.FieldOrderID)
+}
+
+// SetFromStatus sets the "from_status" field.
+func (m *OrderStatusEventMutation) SetFromStatus(os orderstatusevent.FromStatus) {
+	m.from_status = &os
+}
+
+// FromStatus returns the value of the "from_status" field in the mutation.
+func (m *OrderStatusEventMutation) FromStatus() (r orderstatusevent.FromStatus, exists bool) {
+	v := m.from_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromStatus returns the old "from_status" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldFromStatus(ctx context.Context) (v *orderstatusevent.FromStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromStatus: %w", err)
+	}
+	return oldValue.FromStatus, nil
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (m *OrderStatusEventMutation) ClearFromStatus() {
+	m.from_status = nil
+	m.clearedFields[orderstatusevent.FieldFromStatus] = struct{}{}
+}
+
+// FromStatusCleared returns if the "from_status" field was cleared in this mutation.
+func (m *OrderStatusEventMutation) FromStatusCleared() bool {
+	_, ok := m.clearedFields[orderstatusevent.FieldFromStatus]
+	return ok
+}
+
+// ResetFromStatus resets all changes to the "from_status" field.
+func (m *OrderStatusEventMutation) ResetFromStatus() {
+	m.from_status = nil
+	delete(m.clearedFields, orderstatusevent.FieldFromStatus)
+}
+
+// SetToStatus sets the "to_status" field.
+func (m *OrderStatusEventMutation) SetToStatus(os orderstatusevent.ToStatus) {
+	m.to_status = &os
+}
+
+// ToStatus returns the value of the "to_status" field in the mutation.
+func (m *OrderStatusEventMutation) ToStatus() (r orderstatusevent.ToStatus, exists bool) {
+	v := m.to_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToStatus returns the old "to_status" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldToStatus(ctx context.Context) (v orderstatusevent.ToStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToStatus: %w", err)
+	}
+	return oldValue.ToStatus, nil
+}
+
+// ResetToStatus resets all changes to the "to_status" field.
+func (m *OrderStatusEventMutation) ResetToStatus() {
+	m.to_status = nil
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (m *OrderStatusEventMutation) SetChangedBy(s string) {
+	m.changed_by = &s
+}
+
+// ChangedBy returns the value of the "changed_by" field in the mutation.
+func (m *OrderStatusEventMutation) ChangedBy() (r string, exists bool) {
+	v := m.changed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedBy returns the old "changed_by" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldChangedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedBy: %w", err)
+	}
+	return oldValue.ChangedBy, nil
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (m *OrderStatusEventMutation) ClearChangedBy() {
+	m.changed_by = nil
+	m.clearedFields[orderstatusevent.FieldChangedBy] = struct{}{}
+}
+
+// ChangedByCleared returns if the "changed_by" field was cleared in this mutation.
+func (m *OrderStatusEventMutation) ChangedByCleared() bool {
+	_, ok := m.clearedFields[orderstatusevent.FieldChangedBy]
+	return ok
+}
+
+// ResetChangedBy resets all changes to the "changed_by" field.
+func (m *OrderStatusEventMutation) ResetChangedBy() {
+	m.changed_by = nil
+	delete(m.clearedFields, orderstatusevent.FieldChangedBy)
+}
+
+// SetNote sets the "note" field.
+func (m *OrderStatusEventMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *OrderStatusEventMutation) Note() (r string, exists bool) {
+	v := m.note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNote returns the old "note" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
+	}
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *OrderStatusEventMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[orderstatusevent.FieldNote] = struct{}{}
+}
+
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *OrderStatusEventMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[orderstatusevent.FieldNote]
+	return ok
+}
+
+// ResetNote resets all changes to the "note" field.
+func (m *OrderStatusEventMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, orderstatusevent.FieldNote)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrderStatusEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrderStatusEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OrderStatusEvent entity.
+// If the OrderStatusEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderStatusEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrderStatusEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *OrderStatusEventMutation) ClearOrder() {
+	m.cleared_order = true
+	m.clearedFields[orderstatusevent.FieldOrderID] = struct{}{}
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *OrderStatusEventMutation) OrderCleared() bool {
+	return m.OrderIDCleared() || m.cleared_order
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *OrderStatusEventMutation) OrderIDs() (ids []string) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *OrderStatusEventMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// Where appends a list predicates to the OrderStatusEventMutation builder.
+func (m *OrderStatusEventMutation) Where(ps ...predicate.OrderStatusEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderStatusEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderStatusEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrderStatusEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderStatusEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderStatusEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrderStatusEvent).
+func (m *OrderStatusEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderStatusEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._order != nil {
+		fields = append(fields, orderstatusevent.FieldOrderID)
+	}
+	if m.from_status != nil {
+		fields = append(fields, orderstatusevent.FieldFromStatus)
+	}
+	if m.to_status != nil {
+		fields = append(fields, orderstatusevent.FieldToStatus)
+	}
+	if m.changed_by != nil {
+		fields = append(fields, orderstatusevent.FieldChangedBy)
+	}
+	if m.note != nil {
+		fields = append(fields, orderstatusevent.FieldNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, orderstatusevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderStatusEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case orderstatusevent.FieldOrderID:
+		return m.OrderID()
+	case orderstatusevent.FieldFromStatus:
+		return m.FromStatus()
+	case orderstatusevent.FieldToStatus:
+		return m.ToStatus()
+	case orderstatusevent.FieldChangedBy:
+		return m.ChangedBy()
+	case orderstatusevent.FieldNote:
+		return m.Note()
+	case orderstatusevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderStatusEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case orderstatusevent.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case orderstatusevent.FieldFromStatus:
+		return m.OldFromStatus(ctx)
+	case orderstatusevent.FieldToStatus:
+		return m.OldToStatus(ctx)
+	case orderstatusevent.FieldChangedBy:
+		return m.OldChangedBy(ctx)
+	case orderstatusevent.FieldNote:
+		return m.OldNote(ctx)
+	case orderstatusevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrderStatusEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderStatusEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case orderstatusevent.FieldOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderID(v)
+		return nil
+	case orderstatusevent.FieldFromStatus:
+		v, ok := value.(orderstatusevent.FromStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromStatus(v)
+		return nil
+	case orderstatusevent.FieldToStatus:
+		v, ok := value.(orderstatusevent.ToStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToStatus(v)
+		return nil
+	case orderstatusevent.FieldChangedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedBy(v)
+		return nil
+	case orderstatusevent.FieldNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNote(v)
+		return nil
+	case orderstatusevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrderStatusEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderStatusEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderStatusEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderStatusEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrderStatusEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderStatusEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(orderstatusevent.FieldOrderID) {
+		fields = append(fields, orderstatusevent.FieldOrderID)
+	}
+	if m.FieldCleared(orderstatusevent.FieldFromStatus) {
+		fields = append(fields, orderstatusevent.FieldFromStatus)
+	}
+	if m.FieldCleared(orderstatusevent.FieldChangedBy) {
+		fields = append(fields, orderstatusevent.FieldChangedBy)
+	}
+	if m.FieldCleared(orderstatusevent.FieldNote) {
+		fields = append(fields, orderstatusevent.FieldNote)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderStatusEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderStatusEventMutation) ClearField(name string) error {
+	switch name {
+	case orderstatusevent.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case orderstatusevent.FieldFromStatus:
+		m.ClearFromStatus()
+		return nil
+	case orderstatusevent.FieldChangedBy:
+		m.ClearChangedBy()
+		return nil
+	case orderstatusevent.FieldNote:
+		m.ClearNote()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderStatusEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderStatusEventMutation) ResetField(name string) error {
+	switch name {
+	case orderstatusevent.FieldOrderID:
+		m.ResetOrderID()
+		return nil
+	case orderstatusevent.FieldFromStatus:
+		m.ResetFromStatus()
+		return nil
+	case orderstatusevent.FieldToStatus:
+		m.ResetToStatus()
+		return nil
+	case orderstatusevent.FieldChangedBy:
+		m.ResetChangedBy()
+		return nil
+	case orderstatusevent.FieldNote:
+		m.ResetNote()
+		return nil
+	case orderstatusevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderStatusEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderStatusEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._order != nil {
+		edges = append(edges, orderstatusevent.EdgeOrder)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderStatusEventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case orderstatusevent.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderStatusEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderStatusEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderStatusEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_order {
+		edges = append(edges, orderstatusevent.EdgeOrder)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderStatusEventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case orderstatusevent.EdgeOrder:
+		return m.cleared_order
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderStatusEventMutation) ClearEdge(name string) error {
+	switch name {
+	case orderstatusevent.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderStatusEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderStatusEventMutation) ResetEdge(name string) error {
+	switch name {
+	case orderstatusevent.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown OrderStatusEvent edge %s", name)
+}
+
+// PaymentMutation represents an operation that mutates the Payment nodes in the graph.
+type PaymentMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *string
+	provider              *string
+	method                *string
+	transaction_id        *string
+	status                *payment.Status
+	amount                *money.Amount
+	addamount             *money.Amount
+	captured_amount       *money.Amount
+	addcaptured_amount    *money.Amount
+	refunded_amount       *money.Amount
+	addrefunded_amount    *money.Amount
+	failure_reason        *string
+	pix_payload           *string
+	boleto_barcode        *string
+	boleto_digitable_line *string
+	expires_at            *time.Time
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	_order                *string
+	cleared_order         bool
+	done                  bool
+	oldValue              func(context.Context) (*Payment, error)
+	predicates            []predicate.Payment
+}
+
+var _ ent.Mutation = (*PaymentMutation)(nil)
+
+// paymentOption allows management of the mutation configuration using functional options.
+type paymentOption func(*PaymentMutation)
+
+// newPaymentMutation creates new mutation for the Payment entity.
+func newPaymentMutation(c config, op Op, opts ...paymentOption) *PaymentMutation {
+	m := &PaymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentID sets the ID field of the mutation.
+func withPaymentID(id string) paymentOption {
+	return func(m *PaymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Payment
+		)
+		m.oldValue = func(ctx context.Context) (*Payment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Payment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayment sets the old Payment of the mutation.
+func withPayment(node *Payment) paymentOption {
+	return func(m *PaymentMutation) {
+		m.oldValue = func(context.Context) (*Payment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Payment entities.
+func (m *PaymentMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Payment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrderID sets the "order_id" field.
+func (m *PaymentMutation) SetOrderID(s string) {
+	m._order = &s
+}
+
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *PaymentMutation) OrderID() (r string, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderID returns the old "order_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+	}
+	return oldValue.OrderID, nil
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (m *PaymentMutation) ClearOrderID() {
+	m._order = nil
+	m.clearedFields[payment.FieldOrderID] = struct{}{}
+}
+
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *PaymentMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[payment.FieldOrderID]
+	return ok
+}
+
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *PaymentMutation) ResetOrderID() {
+	m._order = nil
+	delete(m.clearedFields, payment.FieldOrderID)
+}
+
+// SetProvider sets the "provider" field.
+func (m *PaymentMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PaymentMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *PaymentMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *PaymentMutation) SetMethod(s string) {
+	m.method = &s
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *PaymentMutation) Method() (r string, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldMethod(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *PaymentMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *PaymentMutation) SetTransactionID(s string) {
+	m.transaction_id = &s
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *PaymentMutation) TransactionID() (r string, exists bool) {
+	v := m.transaction_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldTransactionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *PaymentMutation) ClearTransactionID() {
+	m.transaction_id = nil
+	m.clearedFields[payment.FieldTransactionID] = struct{}{}
+}
+
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *PaymentMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[payment.FieldTransactionID]
+	return ok
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *PaymentMutation) ResetTransactionID() {
+	m.transaction_id = nil
+	delete(m.clearedFields, payment.FieldTransactionID)
+}
+
+// SetStatus sets the "status" field.
+func (m *PaymentMutation) SetStatus(pa payment.Status) {
+	m.status = &pa
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PaymentMutation) Status() (r payment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldStatus(ctx context.Context) (v payment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PaymentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAmount sets the "amount" field.
+func (m *PaymentMutation) SetAmount(value money.Amount) {
+	m.amount = &value
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PaymentMutation) Amount() (r money.Amount, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds value to the "amount" field.
+func (m *PaymentMutation) AddAmount(value money.Amount) {
+	if m.addamount != nil {
+		*m.addamount += value
+	} else {
+		m.addamount = &value
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PaymentMutation) AddedAmount() (r money.Amount, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PaymentMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (m *PaymentMutation) SetCapturedAmount(value money.Amount) {
+	m.captured_amount = &value
+	m.addcaptured_amount = nil
+}
+
+// CapturedAmount returns the value of the "captured_amount" field in the mutation.
+func (m *PaymentMutation) CapturedAmount() (r money.Amount, exists bool) {
+	v := m.captured_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCapturedAmount returns the old "captured_amount" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCapturedAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapturedAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapturedAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapturedAmount: %w", err)
+	}
+	return oldValue.CapturedAmount, nil
+}
+
+// AddCapturedAmount adds value to the "captured_amount" field.
+func (m *PaymentMutation) AddCapturedAmount(value money.Amount) {
+	if m.addcaptured_amount != nil {
+		*m.addcaptured_amount += value
+	} else {
+		m.addcaptured_amount = &value
+	}
+}
+
+// AddedCapturedAmount returns the value that was added to the "captured_amount" field in this mutation.
+func (m *PaymentMutation) AddedCapturedAmount() (r money.Amount, exists bool) {
+	v := m.addcaptured_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCapturedAmount resets all changes to the "captured_amount" field.
+func (m *PaymentMutation) ResetCapturedAmount() {
+	m.captured_amount = nil
+	m.addcaptured_amount = nil
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (m *PaymentMutation) SetRefundedAmount(value money.Amount) {
+	m.refunded_amount = &value
+	m.addrefunded_amount = nil
+}
+
+// RefundedAmount returns the value of the "refunded_amount" field in the mutation.
+func (m *PaymentMutation) RefundedAmount() (r money.Amount, exists bool) {
+	v := m.refunded_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundedAmount returns the old "refunded_amount" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldRefundedAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundedAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundedAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundedAmount: %w", err)
+	}
+	return oldValue.RefundedAmount, nil
+}
+
+// AddRefundedAmount adds value to the "refunded_amount" field.
+func (m *PaymentMutation) AddRefundedAmount(value money.Amount) {
+	if m.addrefunded_amount != nil {
+		*m.addrefunded_amount += value
+	} else {
+		m.addrefunded_amount = &value
+	}
+}
+
+// AddedRefundedAmount returns the value that was added to the "refunded_amount" field in this mutation.
+func (m *PaymentMutation) AddedRefundedAmount() (r money.Amount, exists bool) {
+	v := m.addrefunded_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRefundedAmount resets all changes to the "refunded_amount" field.
+func (m *PaymentMutation) ResetRefundedAmount() {
+	m.refunded_amount = nil
+	m.addrefunded_amount = nil
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (m *PaymentMutation) SetFailureReason(s string) {
+	m.failure_reason = &s
+}
+
+// FailureReason returns the value of the "failure_reason" field in the mutation.
+func (m *PaymentMutation) FailureReason() (r string, exists bool) {
+	v := m.failure_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailureReason returns the old "failure_reason" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldFailureReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailureReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailureReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailureReason: %w", err)
+	}
+	return oldValue.FailureReason, nil
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (m *PaymentMutation) ClearFailureReason() {
+	m.failure_reason = nil
+	m.clearedFields[payment.FieldFailureReason] = struct{}{}
+}
+
+// FailureReasonCleared returns if the "failure_reason" field was cleared in this mutation.
+func (m *PaymentMutation) FailureReasonCleared() bool {
+	_, ok := m.clearedFields[payment.FieldFailureReason]
+	return ok
+}
+
+// ResetFailureReason resets all changes to the "failure_reason" field.
+func (m *PaymentMutation) ResetFailureReason() {
+	m.failure_reason = nil
+	delete(m.clearedFields, payment.FieldFailureReason)
+}
+
+// SetPixPayload sets the "pix_payload" field.
+func (m *PaymentMutation) SetPixPayload(s string) {
+	m.pix_payload = &s
+}
+
+// PixPayload returns the value of the "pix_payload" field in the mutation.
+func (m *PaymentMutation) PixPayload() (r string, exists bool) {
+	v := m.pix_payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPixPayload returns the old "pix_payload" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldPixPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPixPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPixPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPixPayload: %w", err)
+	}
+	return oldValue.PixPayload, nil
+}
+
+// ClearPixPayload clears the value of the "pix_payload" field.
+func (m *PaymentMutation) ClearPixPayload() {
+	m.pix_payload = nil
+	m.clearedFields[payment.FieldPixPayload] = struct{}{}
+}
+
+// PixPayloadCleared returns if the "pix_payload" field was cleared in this mutation.
+func (m *PaymentMutation) PixPayloadCleared() bool {
+	_, ok := m.clearedFields[payment.FieldPixPayload]
+	return ok
+}
+
+// ResetPixPayload resets all changes to the "pix_payload" field.
+func (m *PaymentMutation) ResetPixPayload() {
+	m.pix_payload = nil
+	delete(m.clearedFields, payment.FieldPixPayload)
+}
+
+// SetBoletoBarcode sets the "boleto_barcode" field.
+func (m *PaymentMutation) SetBoletoBarcode(s string) {
+	m.boleto_barcode = &s
+}
+
+// BoletoBarcode returns the value of the "boleto_barcode" field in the mutation.
+func (m *PaymentMutation) BoletoBarcode() (r string, exists bool) {
+	v := m.boleto_barcode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoletoBarcode returns the old "boleto_barcode" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBoletoBarcode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoletoBarcode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoletoBarcode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoletoBarcode: %w", err)
+	}
+	return oldValue.BoletoBarcode, nil
+}
+
+// ClearBoletoBarcode clears the value of the "boleto_barcode" field.
+func (m *PaymentMutation) ClearBoletoBarcode() {
+	m.boleto_barcode = nil
+	m.clearedFields[payment.FieldBoletoBarcode] = struct{}{}
+}
+
+// BoletoBarcodeCleared returns if the "boleto_barcode" field was cleared in this mutation.
+func (m *PaymentMutation) BoletoBarcodeCleared() bool {
+	_, ok := m.clearedFields[payment.FieldBoletoBarcode]
+	return ok
+}
+
+// ResetBoletoBarcode resets all changes to the "boleto_barcode" field.
+func (m *PaymentMutation) ResetBoletoBarcode() {
+	m.boleto_barcode = nil
+	delete(m.clearedFields, payment.FieldBoletoBarcode)
+}
+
+// SetBoletoDigitableLine sets the "boleto_digitable_line" field.
+func (m *PaymentMutation) SetBoletoDigitableLine(s string) {
+	m.boleto_digitable_line = &s
+}
+
+// BoletoDigitableLine returns the value of the "boleto_digitable_line" field in the mutation.
+func (m *PaymentMutation) BoletoDigitableLine() (r string, exists bool) {
+	v := m.boleto_digitable_line
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoletoDigitableLine returns the old "boleto_digitable_line" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBoletoDigitableLine(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoletoDigitableLine is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoletoDigitableLine requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoletoDigitableLine: %w", err)
+	}
+	return oldValue.BoletoDigitableLine, nil
+}
+
+// ClearBoletoDigitableLine clears the value of the "boleto_digitable_line" field.
+func (m *PaymentMutation) ClearBoletoDigitableLine() {
+	m.boleto_digitable_line = nil
+	m.clearedFields[payment.FieldBoletoDigitableLine] = struct{}{}
+}
+
+// BoletoDigitableLineCleared returns if the "boleto_digitable_line" field was cleared in this mutation.
+func (m *PaymentMutation) BoletoDigitableLineCleared() bool {
+	_, ok := m.clearedFields[payment.FieldBoletoDigitableLine]
+	return ok
+}
+
+// ResetBoletoDigitableLine resets all changes to the "boleto_digitable_line" field.
+func (m *PaymentMutation) ResetBoletoDigitableLine() {
+	m.boleto_digitable_line = nil
+	delete(m.clearedFields, payment.FieldBoletoDigitableLine)
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *PaymentMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *PaymentMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *PaymentMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[payment.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *PaymentMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[payment.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *PaymentMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, payment.FieldExpiresAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *PaymentMutation) ClearOrder() {
+	m.cleared_order = true
+	m.clearedFields[payment.FieldOrderID] = struct{}{}
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *PaymentMutation) OrderCleared() bool {
+	return m.OrderIDCleared() || m.cleared_order
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *PaymentMutation) OrderIDs() (ids []string) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *PaymentMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// Where appends a list predicates to the PaymentMutation builder.
+func (m *PaymentMutation) Where(ps ...predicate.Payment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Payment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Payment).
+func (m *PaymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m._order != nil {
+		fields = append(fields, payment.FieldOrderID)
+	}
+	if m.provider != nil {
+		fields = append(fields, payment.FieldProvider)
+	}
+	if m.method != nil {
+		fields = append(fields, payment.FieldMethod)
+	}
+	if m.transaction_id != nil {
+		fields = append(fields, payment.FieldTransactionID)
+	}
+	if m.status != nil {
+		fields = append(fields, payment.FieldStatus)
+	}
+	if m.amount != nil {
+		fields = append(fields, payment.FieldAmount)
+	}
+	if m.captured_amount != nil {
+		fields = append(fields, payment.FieldCapturedAmount)
+	}
+	if m.refunded_amount != nil {
+		fields = append(fields, payment.FieldRefundedAmount)
+	}
+	if m.failure_reason != nil {
+		fields = append(fields, payment.FieldFailureReason)
+	}
+	if m.pix_payload != nil {
+		fields = append(fields, payment.FieldPixPayload)
+	}
+	if m.boleto_barcode != nil {
+		fields = append(fields, payment.FieldBoletoBarcode)
+	}
+	if m.boleto_digitable_line != nil {
+		fields = append(fields, payment.FieldBoletoDigitableLine)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, payment.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, payment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, payment.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldOrderID:
+		return m.OrderID()
+	case payment.FieldProvider:
+		return m.Provider()
+	case payment.FieldMethod:
+		return m.Method()
+	case payment.FieldTransactionID:
+		return m.TransactionID()
+	case payment.FieldStatus:
+		return m.Status()
+	case payment.FieldAmount:
+		return m.Amount()
+	case payment.FieldCapturedAmount:
+		return m.CapturedAmount()
+	case payment.FieldRefundedAmount:
+		return m.RefundedAmount()
+	case payment.FieldFailureReason:
+		return m.FailureReason()
+	case payment.FieldPixPayload:
+		return m.PixPayload()
+	case payment.FieldBoletoBarcode:
+		return m.BoletoBarcode()
+	case payment.FieldBoletoDigitableLine:
+		return m.BoletoDigitableLine()
+	case payment.FieldExpiresAt:
+		return m.ExpiresAt()
+	case payment.FieldCreatedAt:
+		return m.CreatedAt()
+	case payment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payment.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case payment.FieldProvider:
+		return m.OldProvider(ctx)
+	case payment.FieldMethod:
+		return m.OldMethod(ctx)
+	case payment.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case payment.FieldStatus:
+		return m.OldStatus(ctx)
+	case payment.FieldAmount:
+		return m.OldAmount(ctx)
+	case payment.FieldCapturedAmount:
+		return m.OldCapturedAmount(ctx)
+	case payment.FieldRefundedAmount:
+		return m.OldRefundedAmount(ctx)
+	case payment.FieldFailureReason:
+		return m.OldFailureReason(ctx)
+	case payment.FieldPixPayload:
+		return m.OldPixPayload(ctx)
+	case payment.FieldBoletoBarcode:
+		return m.OldBoletoBarcode(ctx)
+	case payment.FieldBoletoDigitableLine:
+		return m.OldBoletoDigitableLine(ctx)
+	case payment.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case payment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case payment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Payment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderID(v)
+		return nil
+	case payment.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case payment.FieldMethod:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case payment.FieldTransactionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case payment.FieldStatus:
+		v, ok := value.(payment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case payment.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case payment.FieldCapturedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapturedAmount(v)
+		return nil
+	case payment.FieldRefundedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundedAmount(v)
+		return nil
+	case payment.FieldFailureReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailureReason(v)
+		return nil
+	case payment.FieldPixPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPixPayload(v)
+		return nil
+	case payment.FieldBoletoBarcode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoletoBarcode(v)
+		return nil
+	case payment.FieldBoletoDigitableLine:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoletoDigitableLine(v)
+		return nil
+	case payment.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case payment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case payment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, payment.FieldAmount)
+	}
+	if m.addcaptured_amount != nil {
+		fields = append(fields, payment.FieldCapturedAmount)
+	}
+	if m.addrefunded_amount != nil {
+		fields = append(fields, payment.FieldRefundedAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldAmount:
+		return m.AddedAmount()
+	case payment.FieldCapturedAmount:
+		return m.AddedCapturedAmount()
+	case payment.FieldRefundedAmount:
+		return m.AddedRefundedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	case payment.FieldCapturedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapturedAmount(v)
+		return nil
+	case payment.FieldRefundedAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefundedAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(payment.FieldOrderID) {
+		fields = append(fields, payment.FieldOrderID)
+	}
+	if m.FieldCleared(payment.FieldTransactionID) {
+		fields = append(fields, payment.FieldTransactionID)
+	}
+	if m.FieldCleared(payment.FieldFailureReason) {
+		fields = append(fields, payment.FieldFailureReason)
+	}
+	if m.FieldCleared(payment.FieldPixPayload) {
+		fields = append(fields, payment.FieldPixPayload)
+	}
+	if m.FieldCleared(payment.FieldBoletoBarcode) {
+		fields = append(fields, payment.FieldBoletoBarcode)
+	}
+	if m.FieldCleared(payment.FieldBoletoDigitableLine) {
+		fields = append(fields, payment.FieldBoletoDigitableLine)
+	}
+	if m.FieldCleared(payment.FieldExpiresAt) {
+		fields = append(fields, payment.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentMutation) ClearField(name string) error {
+	switch name {
+	case payment.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case payment.FieldTransactionID:
+		m.ClearTransactionID()
+		return nil
+	case payment.FieldFailureReason:
+		m.ClearFailureReason()
+		return nil
+	case payment.FieldPixPayload:
+		m.ClearPixPayload()
+		return nil
+	case payment.FieldBoletoBarcode:
+		m.ClearBoletoBarcode()
+		return nil
+	case payment.FieldBoletoDigitableLine:
+		m.ClearBoletoDigitableLine()
+		return nil
+	case payment.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentMutation) ResetField(name string) error {
+	switch name {
+	case payment.FieldOrderID:
+		m.ResetOrderID()
+		return nil
+	case payment.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case payment.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case payment.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case payment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case payment.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case payment.FieldCapturedAmount:
+		m.ResetCapturedAmount()
+		return nil
+	case payment.FieldRefundedAmount:
+		m.ResetRefundedAmount()
+		return nil
+	case payment.FieldFailureReason:
+		m.ResetFailureReason()
+		return nil
+	case payment.FieldPixPayload:
+		m.ResetPixPayload()
+		return nil
+	case payment.FieldBoletoBarcode:
+		m.ResetBoletoBarcode()
+		return nil
+	case payment.FieldBoletoDigitableLine:
+		m.ResetBoletoDigitableLine()
+		return nil
+	case payment.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case payment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case payment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._order != nil {
+		edges = append(edges, payment.EdgeOrder)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case payment.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_order {
+		edges = append(edges, payment.EdgeOrder)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case payment.EdgeOrder:
+		return m.cleared_order
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentMutation) ClearEdge(name string) error {
+	switch name {
+	case payment.EdgeOrder:
+		m.ClearOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentMutation) ResetEdge(name string) error {
+	switch name {
+	case payment.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment edge %s", name)
+}
+
+// ProductMutation represents an operation that mutates the Product nodes in the graph.
+type ProductMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	name               *string
+	slug               *string
+	description        *string
+	price              *money.Amount
+	addprice           *money.Amount
+	sale_price         *money.Amount
+	addsale_price      *money.Amount
+	on_sale            *bool
+	stock              *int
+	addstock           *int
+	sku                *string
+	weight             *int
+	addweight          *int
+	images             *[]string
+	appendimages       []string
+	rating             *float64
+	addrating          *float64
+	review_count       *int
+	addreview_count    *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	avaliations        map[string]struct{}
+	removedavaliations map[string]struct{}
+	clearedavaliations bool
+	category           *string
+	clearedcategory    bool
+	order_items        map[string]struct{}
+	removedorder_items map[string]struct{}
+	clearedorder_items bool
+	cart_items         map[string]struct{}
+	removedcart_items  map[string]struct{}
+	clearedcart_items  bool
+	options            map[string]struct{}
+	removedoptions     map[string]struct{}
+	clearedoptions     bool
+	variants           map[string]struct{}
+	removedvariants    map[string]struct{}
+	clearedvariants    bool
+	done               bool
+	oldValue           func(context.Context) (*Product, error)
+	predicates         []predicate.Product
+}
+
+var _ ent.Mutation = (*ProductMutation)(nil)
+
+// productOption allows management of the mutation configuration using functional options.
+type productOption func(*ProductMutation)
+
+// newProductMutation creates new mutation for the Product entity.
+func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
+	m := &ProductMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProduct,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProductID sets the ID field of the mutation.
+func withProductID(id string) productOption {
+	return func(m *ProductMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Product
+		)
+		m.oldValue = func(ctx context.Context) (*Product, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Product.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProduct sets the old Product of the mutation.
+func withProduct(node *Product) productOption {
+	return func(m *ProductMutation) {
+		m.oldValue = func(context.Context) (*Product, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProductMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProductMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Product entities.
+func (m *ProductMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProductMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProductMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProductMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProductMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProductMutation) ResetName() {
+	m.name = nil
+}
+
+// SetSlug sets the "slug" field.
+func (m *ProductMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *ProductMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *ProductMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProductMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProductMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProductMutation) ResetDescription() {
+	m.description = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *ProductMutation) SetPrice(value money.Amount) {
+	m.price = &value
+	m.addprice = nil
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *ProductMutation) Price() (r money.Amount, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldPrice(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// AddPrice adds value to the "price" field.
+func (m *ProductMutation) AddPrice(value money.Amount) {
+	if m.addprice != nil {
+		*m.addprice += value
+	} else {
+		m.addprice = &value
+	}
+}
+
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ProductMutation) AddedPrice() (r money.Amount, exists bool) {
+	v := m.addprice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *ProductMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+}
+
+// SetSalePrice sets the "sale_price" field.
+func (m *ProductMutation) SetSalePrice(value money.Amount) {
+	m.sale_price = &value
+	m.addsale_price = nil
+}
+
+// SalePrice returns the value of the "sale_price" field in the mutation.
+func (m *ProductMutation) SalePrice() (r money.Amount, exists bool) {
+	v := m.sale_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSalePrice returns the old "sale_price" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSalePrice(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSalePrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSalePrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSalePrice: %w", err)
+	}
+	return oldValue.SalePrice, nil
+}
+
+// AddSalePrice adds value to the "sale_price" field.
+func (m *ProductMutation) AddSalePrice(value money.Amount) {
+	if m.addsale_price != nil {
+		*m.addsale_price += value
+	} else {
+		m.addsale_price = &value
+	}
+}
+
+// AddedSalePrice returns the value that was added to the "sale_price" field in this mutation.
+func (m *ProductMutation) AddedSalePrice() (r money.Amount, exists bool) {
+	v := m.addsale_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearSalePrice clears the value of the "sale_price" field.
+func (m *ProductMutation) ClearSalePrice() {
+	m.sale_price = nil
+	m.addsale_price = nil
+	m.clearedFields[product.FieldSalePrice] = struct{}{}
+}
+
+// SalePriceCleared returns if the "sale_price" field was cleared in this mutation.
+func (m *ProductMutation) SalePriceCleared() bool {
+	_, ok := m.clearedFields[product.FieldSalePrice]
+	return ok
+}
+
+// ResetSalePrice resets all changes to the "sale_price" field.
+func (m *ProductMutation) ResetSalePrice() {
+	m.sale_price = nil
+	m.addsale_price = nil
+	delete(m.clearedFields, product.FieldSalePrice)
+}
+
+// SetOnSale sets the "on_sale" field.
+func (m *ProductMutation) SetOnSale(b bool) {
+	m.on_sale = &b
+}
+
+// OnSale returns the value of the "on_sale" field in the mutation.
+func (m *ProductMutation) OnSale() (r bool, exists bool) {
+	v := m.on_sale
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOnSale returns the old "on_sale" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldOnSale(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOnSale is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOnSale requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOnSale: %w", err)
+	}
+	return oldValue.OnSale, nil
+}
+
+// ResetOnSale resets all changes to the "on_sale" field.
+func (m *ProductMutation) ResetOnSale() {
+	m.on_sale = nil
+}
+
+// SetStock sets the "stock" field.
+func (m *ProductMutation) SetStock(i int) {
+	m.stock = &i
+	m.addstock = nil
+}
+
+// Stock returns the value of the "stock" field in the mutation.
+func (m *ProductMutation) Stock() (r int, exists bool) {
+	v := m.stock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStock returns the old "stock" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldStock(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStock is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStock requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStock: %w", err)
+	}
+	return oldValue.Stock, nil
+}
+
+// AddStock adds i to the "stock" field.
+func (m *ProductMutation) AddStock(i int) {
+	if m.addstock != nil {
+		*m.addstock += i
+	} else {
+		m.addstock = &i
+	}
+}
+
+// AddedStock returns the value that was added to the "stock" field in this mutation.
+func (m *ProductMutation) AddedStock() (r int, exists bool) {
+	v := m.addstock
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStock resets all changes to the "stock" field.
+func (m *ProductMutation) ResetStock() {
+	m.stock = nil
+	m.addstock = nil
+}
+
+// SetSku sets the "sku" field.
+func (m *ProductMutation) SetSku(s string) {
+	m.sku = &s
+}
+
+// Sku returns the value of the "sku" field in the mutation.
+func (m *ProductMutation) Sku() (r string, exists bool) {
+	v := m.sku
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSku returns the old "sku" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldSku(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSku is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSku requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSku: %w", err)
+	}
+	return oldValue.Sku, nil
+}
+
+// ResetSku resets all changes to the "sku" field.
+func (m *ProductMutation) ResetSku() {
+	m.sku = nil
+}
+
+// SetWeight sets the "weight" field.
+func (m *ProductMutation) SetWeight(i int) {
+	m.weight = &i
+	m.addweight = nil
+}
+
+// Weight returns the value of the "weight" field in the mutation.
+func (m *ProductMutation) Weight() (r int, exists bool) {
+	v := m.weight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWeight returns the old "weight" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldWeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWeight: %w", err)
+	}
+	return oldValue.Weight, nil
+}
+
+// AddWeight adds i to the "weight" field.
+func (m *ProductMutation) AddWeight(i int) {
+	if m.addweight != nil {
+		*m.addweight += i
+	} else {
+		m.addweight = &i
+	}
+}
+
+// AddedWeight returns the value that was added to the "weight" field in this mutation.
+func (m *ProductMutation) AddedWeight() (r int, exists bool) {
+	v := m.addweight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWeight resets all changes to the "weight" field.
+func (m *ProductMutation) ResetWeight() {
+	m.weight = nil
+	m.addweight = nil
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *ProductMutation) SetCategoryID(s string) {
+	m.category = &s
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *ProductMutation) CategoryID() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldCategoryID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *ProductMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[product.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *ProductMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[product.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *ProductMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, product.FieldCategoryID)
+}
+
+// SetImages sets the "images" field.
+func (m *ProductMutation) SetImages(s []string) {
+	m.images = &s
+	m.appendimages = nil
+}
+
+// Images returns the value of the "images" field in the mutation.
+func (m *ProductMutation) Images() (r []string, exists bool) {
+	v := m.images
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImages returns the old "images" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldImages(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImages requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+	}
+	return oldValue.Images, nil
+}
+
+// AppendImages adds s to the "images" field.
+func (m *ProductMutation) AppendImages(s []string) {
+	m.appendimages = append(m.appendimages, s...)
+}
+
+// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
+func (m *ProductMutation) AppendedImages() ([]string, bool) {
+	if len(m.appendimages) == 0 {
+		return nil, false
+	}
+	return m.appendimages, true
+}
+
+// ResetImages resets all changes to the "images" field.
+func (m *ProductMutation) ResetImages() {
+	m.images = nil
+	m.appendimages = nil
+}
+
+// SetRating sets the "rating" field.
+func (m *ProductMutation) SetRating(f float64) {
+	m.rating = &f
+	m.addrating = nil
+}
+
+// Rating returns the value of the "rating" field in the mutation.
+func (m *ProductMutation) Rating() (r float64, exists bool) {
+	v := m.rating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRating returns the old "rating" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldRating(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRating requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+	}
+	return oldValue.Rating, nil
+}
+
+// AddRating adds f to the "rating" field.
+func (m *ProductMutation) AddRating(f float64) {
+	if m.addrating != nil {
+		*m.addrating += f
+	} else {
+		m.addrating = &f
+	}
+}
+
+// AddedRating returns the value that was added to the "rating" field in this mutation.
+func (m *ProductMutation) AddedRating() (r float64, exists bool) {
+	v := m.addrating
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRating resets all changes to the "rating" field.
+func (m *ProductMutation) ResetRating() {
+	m.rating = nil
+	m.addrating = nil
+}
+
+// SetReviewCount sets the "review_count" field.
+func (m *ProductMutation) SetReviewCount(i int) {
+	m.review_count = &i
+	m.addreview_count = nil
+}
+
+// ReviewCount returns the value of the "review_count" field in the mutation.
+func (m *ProductMutation) ReviewCount() (r int, exists bool) {
+	v := m.review_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewCount returns the old "review_count" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDefault requires an ID field in the mutation")
+		return v, errors.New("OldReviewCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDefault: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
 	}
-	return oldValue.IsDefault, nil
+	return oldValue.ReviewCount, nil
 }
 
-// ResetIsDefault resets all changes to the "is_default" field.
-func (m *AddressMutation) ResetIsDefault() {
-	m.is_default = nil
+// AddReviewCount adds i to the "review_count" field.
+func (m *ProductMutation) AddReviewCount(i int) {
+	if m.addreview_count != nil {
+		*m.addreview_count += i
+	} else {
+		m.addreview_count = &i
+	}
+}
+
+// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
+func (m *ProductMutation) AddedReviewCount() (r int, exists bool) {
+	v := m.addreview_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReviewCount resets all changes to the "review_count" field.
+func (m *ProductMutation) ResetReviewCount() {
+	m.review_count = nil
+	m.addreview_count = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AddressMutation) SetCreatedAt(t time.Time) {
+func (m *ProductMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AddressMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProductMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -540,10 +14184,10 @@ func (m *AddressMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -558,17 +14202,17 @@ func (m *AddressMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AddressMutation) ResetCreatedAt() {
+func (m *ProductMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *AddressMutation) SetUpdatedAt(t time.Time) {
+func (m *ProductMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AddressMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ProductMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -576,10 +14220,10 @@ func (m *AddressMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Address entity.
-// If the Address object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Product entity.
+// If the Product object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AddressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -594,100 +14238,316 @@ func (m *AddressMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AddressMutation) ResetUpdatedAt() {
+func (m *ProductMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *AddressMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[address.FieldUserID] = struct{}{}
+// AddAvaliationIDs adds the "avaliations" edge to the Avaliation entity by ids.
+func (m *ProductMutation) AddAvaliationIDs(ids ...string) {
+	if m.avaliations == nil {
+		m.avaliations = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.avaliations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAvaliations clears the "avaliations" edge to the Avaliation entity.
+func (m *ProductMutation) ClearAvaliations() {
+	m.clearedavaliations = true
+}
+
+// AvaliationsCleared reports if the "avaliations" edge to the Avaliation entity was cleared.
+func (m *ProductMutation) AvaliationsCleared() bool {
+	return m.clearedavaliations
+}
+
+// RemoveAvaliationIDs removes the "avaliations" edge to the Avaliation entity by IDs.
+func (m *ProductMutation) RemoveAvaliationIDs(ids ...string) {
+	if m.removedavaliations == nil {
+		m.removedavaliations = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.avaliations, ids[i])
+		m.removedavaliations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAvaliations returns the removed IDs of the "avaliations" edge to the Avaliation entity.
+func (m *ProductMutation) RemovedAvaliationsIDs() (ids []string) {
+	for id := range m.removedavaliations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AvaliationsIDs returns the "avaliations" edge IDs in the mutation.
+func (m *ProductMutation) AvaliationsIDs() (ids []string) {
+	for id := range m.avaliations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAvaliations resets all changes to the "avaliations" edge.
+func (m *ProductMutation) ResetAvaliations() {
+	m.avaliations = nil
+	m.clearedavaliations = false
+	m.removedavaliations = nil
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *ProductMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[product.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *ProductMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *ProductMutation) CategoryIDs() (ids []string) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *ProductMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by ids.
+func (m *ProductMutation) AddOrderItemIDs(ids ...string) {
+	if m.order_items == nil {
+		m.order_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.order_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrderItems clears the "order_items" edge to the OrderItem entity.
+func (m *ProductMutation) ClearOrderItems() {
+	m.clearedorder_items = true
+}
+
+// OrderItemsCleared reports if the "order_items" edge to the OrderItem entity was cleared.
+func (m *ProductMutation) OrderItemsCleared() bool {
+	return m.clearedorder_items
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to the OrderItem entity by IDs.
+func (m *ProductMutation) RemoveOrderItemIDs(ids ...string) {
+	if m.removedorder_items == nil {
+		m.removedorder_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.order_items, ids[i])
+		m.removedorder_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrderItems returns the removed IDs of the "order_items" edge to the OrderItem entity.
+func (m *ProductMutation) RemovedOrderItemsIDs() (ids []string) {
+	for id := range m.removedorder_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrderItemsIDs returns the "order_items" edge IDs in the mutation.
+func (m *ProductMutation) OrderItemsIDs() (ids []string) {
+	for id := range m.order_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrderItems resets all changes to the "order_items" edge.
+func (m *ProductMutation) ResetOrderItems() {
+	m.order_items = nil
+	m.clearedorder_items = false
+	m.removedorder_items = nil
+}
+
+// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by ids.
+func (m *ProductMutation) AddCartItemIDs(ids ...string) {
+	if m.cart_items == nil {
+		m.cart_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.cart_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCartItems clears the "cart_items" edge to the CartItem entity.
+func (m *ProductMutation) ClearCartItems() {
+	m.clearedcart_items = true
+}
+
+// CartItemsCleared reports if the "cart_items" edge to the CartItem entity was cleared.
+func (m *ProductMutation) CartItemsCleared() bool {
+	return m.clearedcart_items
+}
+
+// RemoveCartItemIDs removes the "cart_items" edge to the CartItem entity by IDs.
+func (m *ProductMutation) RemoveCartItemIDs(ids ...string) {
+	if m.removedcart_items == nil {
+		m.removedcart_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.cart_items, ids[i])
+		m.removedcart_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCartItems returns the removed IDs of the "cart_items" edge to the CartItem entity.
+func (m *ProductMutation) RemovedCartItemsIDs() (ids []string) {
+	for id := range m.removedcart_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CartItemsIDs returns the "cart_items" edge IDs in the mutation.
+func (m *ProductMutation) CartItemsIDs() (ids []string) {
+	for id := range m.cart_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCartItems resets all changes to the "cart_items" edge.
+func (m *ProductMutation) ResetCartItems() {
+	m.cart_items = nil
+	m.clearedcart_items = false
+	m.removedcart_items = nil
+}
+
+// AddOptionIDs adds the "options" edge to the ProductOption entity by ids.
+func (m *ProductMutation) AddOptionIDs(ids ...string) {
+	if m.options == nil {
+		m.options = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.options[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOptions clears the "options" edge to the ProductOption entity.
+func (m *ProductMutation) ClearOptions() {
+	m.clearedoptions = true
+}
+
+// OptionsCleared reports if the "options" edge to the ProductOption entity was cleared.
+func (m *ProductMutation) OptionsCleared() bool {
+	return m.clearedoptions
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *AddressMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// RemoveOptionIDs removes the "options" edge to the ProductOption entity by IDs.
+func (m *ProductMutation) RemoveOptionIDs(ids ...string) {
+	if m.removedoptions == nil {
+		m.removedoptions = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.options, ids[i])
+		m.removedoptions[ids[i]] = struct{}{}
+	}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *AddressMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// RemovedOptions returns the removed IDs of the "options" edge to the ProductOption entity.
+func (m *ProductMutation) RemovedOptionsIDs() (ids []string) {
+	for id := range m.removedoptions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *AddressMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OptionsIDs returns the "options" edge IDs in the mutation.
+func (m *ProductMutation) OptionsIDs() (ids []string) {
+	for id := range m.options {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddOrderIDs adds the "orders" edge to the Order entity by ids.
-func (m *AddressMutation) AddOrderIDs(ids ...string) {
-	if m.orders == nil {
-		m.orders = make(map[string]struct{})
+// ResetOptions resets all changes to the "options" edge.
+func (m *ProductMutation) ResetOptions() {
+	m.options = nil
+	m.clearedoptions = false
+	m.removedoptions = nil
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by ids.
+func (m *ProductMutation) AddVariantIDs(ids ...string) {
+	if m.variants == nil {
+		m.variants = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.orders[ids[i]] = struct{}{}
+		m.variants[ids[i]] = struct{}{}
 	}
 }
 
-// ClearOrders clears the "orders" edge to the Order entity.
-func (m *AddressMutation) ClearOrders() {
-	m.clearedorders = true
+// ClearVariants clears the "variants" edge to the ProductVariant entity.
+func (m *ProductMutation) ClearVariants() {
+	m.clearedvariants = true
 }
 
-// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
-func (m *AddressMutation) OrdersCleared() bool {
-	return m.clearedorders
+// VariantsCleared reports if the "variants" edge to the ProductVariant entity was cleared.
+func (m *ProductMutation) VariantsCleared() bool {
+	return m.clearedvariants
 }
 
-// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
-func (m *AddressMutation) RemoveOrderIDs(ids ...string) {
-	if m.removedorders == nil {
-		m.removedorders = make(map[string]struct{})
+// RemoveVariantIDs removes the "variants" edge to the ProductVariant entity by IDs.
+func (m *ProductMutation) RemoveVariantIDs(ids ...string) {
+	if m.removedvariants == nil {
+		m.removedvariants = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.orders, ids[i])
-		m.removedorders[ids[i]] = struct{}{}
+		delete(m.variants, ids[i])
+		m.removedvariants[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
-func (m *AddressMutation) RemovedOrdersIDs() (ids []string) {
-	for id := range m.removedorders {
+// RemovedVariants returns the removed IDs of the "variants" edge to the ProductVariant entity.
+func (m *ProductMutation) RemovedVariantsIDs() (ids []string) {
+	for id := range m.removedvariants {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// OrdersIDs returns the "orders" edge IDs in the mutation.
-func (m *AddressMutation) OrdersIDs() (ids []string) {
-	for id := range m.orders {
+// VariantsIDs returns the "variants" edge IDs in the mutation.
+func (m *ProductMutation) VariantsIDs() (ids []string) {
+	for id := range m.variants {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOrders resets all changes to the "orders" edge.
-func (m *AddressMutation) ResetOrders() {
-	m.orders = nil
-	m.clearedorders = false
-	m.removedorders = nil
+// ResetVariants resets all changes to the "variants" edge.
+func (m *ProductMutation) ResetVariants() {
+	m.variants = nil
+	m.clearedvariants = false
+	m.removedvariants = nil
 }
 
-// Where appends a list predicates to the AddressMutation builder.
-func (m *AddressMutation) Where(ps ...predicate.Address) {
+// Where appends a list predicates to the ProductMutation builder.
+func (m *ProductMutation) Where(ps ...predicate.Product) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AddressMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AddressMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Address, len(ps))
+func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Product, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -695,57 +14555,69 @@ func (m *AddressMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AddressMutation) Op() Op {
+func (m *ProductMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AddressMutation) SetOp(op Op) {
+func (m *ProductMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Address).
-func (m *AddressMutation) Type() string {
+// Type returns the node type of this mutation (Product).
+func (m *ProductMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *AddressMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.user != nil {
-		fields = append(fields, address.FieldUserID)
+func (m *ProductMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.name != nil {
+		fields = append(fields, product.FieldName)
 	}
-	if m.cep != nil {
-		fields = append(fields, address.FieldCep)
+	if m.slug != nil {
+		fields = append(fields, product.FieldSlug)
 	}
-	if m.logradouro != nil {
-		fields = append(fields, address.FieldLogradouro)
+	if m.description != nil {
+		fields = append(fields, product.FieldDescription)
 	}
-	if m.numero != nil {
-		fields = append(fields, address.FieldNumero)
+	if m.price != nil {
+		fields = append(fields, product.FieldPrice)
 	}
-	if m.complemento != nil {
-		fields = append(fields, address.FieldComplemento)
+	if m.sale_price != nil {
+		fields = append(fields, product.FieldSalePrice)
 	}
-	if m.bairro != nil {
-		fields = append(fields, address.FieldBairro)
+	if m.on_sale != nil {
+		fields = append(fields, product.FieldOnSale)
 	}
-	if m.cidade != nil {
-		fields = append(fields, address.FieldCidade)
+	if m.stock != nil {
+		fields = append(fields, product.FieldStock)
 	}
-	if m.estado != nil {
-		fields = append(fields, address.FieldEstado)
+	if m.sku != nil {
+		fields = append(fields, product.FieldSku)
 	}
-	if m.is_default != nil {
-		fields = append(fields, address.FieldIsDefault)
+	if m.weight != nil {
+		fields = append(fields, product.FieldWeight)
+	}
+	if m.category != nil {
+		fields = append(fields, product.FieldCategoryID)
+	}
+	if m.images != nil {
+		fields = append(fields, product.FieldImages)
+	}
+	if m.rating != nil {
+		fields = append(fields, product.FieldRating)
+	}
+	if m.review_count != nil {
+		fields = append(fields, product.FieldReviewCount)
 	}
 	if m.created_at != nil {
-		fields = append(fields, address.FieldCreatedAt)
+		fields = append(fields, product.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, address.FieldUpdatedAt)
+		fields = append(fields, product.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -753,29 +14625,37 @@ func (m *AddressMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AddressMutation) Field(name string) (ent.Value, bool) {
+func (m *ProductMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case address.FieldUserID:
-		return m.UserID()
-	case address.FieldCep:
-		return m.Cep()
-	case address.FieldLogradouro:
-		return m.Logradouro()
-	case address.FieldNumero:
-		return m.Numero()
-	case address.FieldComplemento:
-		return m.Complemento()
-	case address.FieldBairro:
-		return m.Bairro()
-	case address.FieldCidade:
-		return m.Cidade()
-	case address.FieldEstado:
-		return m.Estado()
-	case address.FieldIsDefault:
-		return m.IsDefault()
-	case address.FieldCreatedAt:
+	case product.FieldName:
+		return m.Name()
+	case product.FieldSlug:
+		return m.Slug()
+	case product.FieldDescription:
+		return m.Description()
+	case product.FieldPrice:
+		return m.Price()
+	case product.FieldSalePrice:
+		return m.SalePrice()
+	case product.FieldOnSale:
+		return m.OnSale()
+	case product.FieldStock:
+		return m.Stock()
+	case product.FieldSku:
+		return m.Sku()
+	case product.FieldWeight:
+		return m.Weight()
+	case product.FieldCategoryID:
+		return m.CategoryID()
+	case product.FieldImages:
+		return m.Images()
+	case product.FieldRating:
+		return m.Rating()
+	case product.FieldReviewCount:
+		return m.ReviewCount()
+	case product.FieldCreatedAt:
 		return m.CreatedAt()
-	case address.FieldUpdatedAt:
+	case product.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -784,110 +14664,146 @@ func (m *AddressMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AddressMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case address.FieldUserID:
-		return m.OldUserID(ctx)
-	case address.FieldCep:
-		return m.OldCep(ctx)
-	case address.FieldLogradouro:
-		return m.OldLogradouro(ctx)
-	case address.FieldNumero:
-		return m.OldNumero(ctx)
-	case address.FieldComplemento:
-		return m.OldComplemento(ctx)
-	case address.FieldBairro:
-		return m.OldBairro(ctx)
-	case address.FieldCidade:
-		return m.OldCidade(ctx)
-	case address.FieldEstado:
-		return m.OldEstado(ctx)
-	case address.FieldIsDefault:
-		return m.OldIsDefault(ctx)
-	case address.FieldCreatedAt:
+	case product.FieldName:
+		return m.OldName(ctx)
+	case product.FieldSlug:
+		return m.OldSlug(ctx)
+	case product.FieldDescription:
+		return m.OldDescription(ctx)
+	case product.FieldPrice:
+		return m.OldPrice(ctx)
+	case product.FieldSalePrice:
+		return m.OldSalePrice(ctx)
+	case product.FieldOnSale:
+		return m.OldOnSale(ctx)
+	case product.FieldStock:
+		return m.OldStock(ctx)
+	case product.FieldSku:
+		return m.OldSku(ctx)
+	case product.FieldWeight:
+		return m.OldWeight(ctx)
+	case product.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case product.FieldImages:
+		return m.OldImages(ctx)
+	case product.FieldRating:
+		return m.OldRating(ctx)
+	case product.FieldReviewCount:
+		return m.OldReviewCount(ctx)
+	case product.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case address.FieldUpdatedAt:
+	case product.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Address field %s", name)
+	return nil, fmt.Errorf("unknown Product field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AddressMutation) SetField(name string, value ent.Value) error {
+func (m *ProductMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case address.FieldUserID:
+	case product.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetName(v)
 		return nil
-	case address.FieldCep:
+	case product.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCep(v)
+		m.SetSlug(v)
 		return nil
-	case address.FieldLogradouro:
+	case product.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLogradouro(v)
+		m.SetDescription(v)
+		return nil
+	case product.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case product.FieldSalePrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSalePrice(v)
+		return nil
+	case product.FieldOnSale:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOnSale(v)
+		return nil
+	case product.FieldStock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStock(v)
 		return nil
-	case address.FieldNumero:
+	case product.FieldSku:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNumero(v)
+		m.SetSku(v)
 		return nil
-	case address.FieldComplemento:
-		v, ok := value.(string)
+	case product.FieldWeight:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetComplemento(v)
+		m.SetWeight(v)
 		return nil
-	case address.FieldBairro:
+	case product.FieldCategoryID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBairro(v)
+		m.SetCategoryID(v)
 		return nil
-	case address.FieldCidade:
-		v, ok := value.(string)
+	case product.FieldImages:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCidade(v)
+		m.SetImages(v)
 		return nil
-	case address.FieldEstado:
-		v, ok := value.(string)
+	case product.FieldRating:
+		v, ok := value.(float64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEstado(v)
+		m.SetRating(v)
 		return nil
-	case address.FieldIsDefault:
-		v, ok := value.(bool)
+	case product.FieldReviewCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDefault(v)
+		m.SetReviewCount(v)
 		return nil
-	case address.FieldCreatedAt:
+	case product.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case address.FieldUpdatedAt:
+	case product.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -895,129 +14811,252 @@ func (m *AddressMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Address field %s", name)
+	return fmt.Errorf("unknown Product field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AddressMutation) AddedFields() []string {
-	return nil
+func (m *ProductMutation) AddedFields() []string {
+	var fields []string
+	if m.addprice != nil {
+		fields = append(fields, product.FieldPrice)
+	}
+	if m.addsale_price != nil {
+		fields = append(fields, product.FieldSalePrice)
+	}
+	if m.addstock != nil {
+		fields = append(fields, product.FieldStock)
+	}
+	if m.addweight != nil {
+		fields = append(fields, product.FieldWeight)
+	}
+	if m.addrating != nil {
+		fields = append(fields, product.FieldRating)
+	}
+	if m.addreview_count != nil {
+		fields = append(fields, product.FieldReviewCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AddressMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case product.FieldPrice:
+		return m.AddedPrice()
+	case product.FieldSalePrice:
+		return m.AddedSalePrice()
+	case product.FieldStock:
+		return m.AddedStock()
+	case product.FieldWeight:
+		return m.AddedWeight()
+	case product.FieldRating:
+		return m.AddedRating()
+	case product.FieldReviewCount:
+		return m.AddedReviewCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AddressMutation) AddField(name string, value ent.Value) error {
+func (m *ProductMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case product.FieldPrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPrice(v)
+		return nil
+	case product.FieldSalePrice:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSalePrice(v)
+		return nil
+	case product.FieldStock:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStock(v)
+		return nil
+	case product.FieldWeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWeight(v)
+		return nil
+	case product.FieldRating:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRating(v)
+		return nil
+	case product.FieldReviewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Address numeric field %s", name)
+	return fmt.Errorf("unknown Product numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AddressMutation) ClearedFields() []string {
+func (m *ProductMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(address.FieldUserID) {
-		fields = append(fields, address.FieldUserID)
+	if m.FieldCleared(product.FieldSalePrice) {
+		fields = append(fields, product.FieldSalePrice)
 	}
-	if m.FieldCleared(address.FieldComplemento) {
-		fields = append(fields, address.FieldComplemento)
+	if m.FieldCleared(product.FieldCategoryID) {
+		fields = append(fields, product.FieldCategoryID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AddressMutation) FieldCleared(name string) bool {
+func (m *ProductMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AddressMutation) ClearField(name string) error {
+func (m *ProductMutation) ClearField(name string) error {
 	switch name {
-	case address.FieldUserID:
-		m.ClearUserID()
+	case product.FieldSalePrice:
+		m.ClearSalePrice()
 		return nil
-	case address.FieldComplemento:
-		m.ClearComplemento()
+	case product.FieldCategoryID:
+		m.ClearCategoryID()
 		return nil
 	}
-	return fmt.Errorf("unknown Address nullable field %s", name)
+	return fmt.Errorf("unknown Product nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AddressMutation) ResetField(name string) error {
+func (m *ProductMutation) ResetField(name string) error {
 	switch name {
-	case address.FieldUserID:
-		m.ResetUserID()
+	case product.FieldName:
+		m.ResetName()
 		return nil
-	case address.FieldCep:
-		m.ResetCep()
+	case product.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case address.FieldLogradouro:
-		m.ResetLogradouro()
+	case product.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case address.FieldNumero:
-		m.ResetNumero()
+	case product.FieldPrice:
+		m.ResetPrice()
 		return nil
-	case address.FieldComplemento:
-		m.ResetComplemento()
+	case product.FieldSalePrice:
+		m.ResetSalePrice()
 		return nil
-	case address.FieldBairro:
-		m.ResetBairro()
+	case product.FieldOnSale:
+		m.ResetOnSale()
 		return nil
-	case address.FieldCidade:
-		m.ResetCidade()
+	case product.FieldStock:
+		m.ResetStock()
 		return nil
-	case address.FieldEstado:
-		m.ResetEstado()
+	case product.FieldSku:
+		m.ResetSku()
 		return nil
-	case address.FieldIsDefault:
-		m.ResetIsDefault()
+	case product.FieldWeight:
+		m.ResetWeight()
 		return nil
-	case address.FieldCreatedAt:
+	case product.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case product.FieldImages:
+		m.ResetImages()
+		return nil
+	case product.FieldRating:
+		m.ResetRating()
+		return nil
+	case product.FieldReviewCount:
+		m.ResetReviewCount()
+		return nil
+	case product.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case address.FieldUpdatedAt:
+	case product.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Address field %s", name)
+	return fmt.Errorf("unknown Product field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AddressMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, address.EdgeUser)
+func (m *ProductMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.avaliations != nil {
+		edges = append(edges, product.EdgeAvaliations)
 	}
-	if m.orders != nil {
-		edges = append(edges, address.EdgeOrders)
+	if m.category != nil {
+		edges = append(edges, product.EdgeCategory)
+	}
+	if m.order_items != nil {
+		edges = append(edges, product.EdgeOrderItems)
+	}
+	if m.cart_items != nil {
+		edges = append(edges, product.EdgeCartItems)
+	}
+	if m.options != nil {
+		edges = append(edges, product.EdgeOptions)
+	}
+	if m.variants != nil {
+		edges = append(edges, product.EdgeVariants)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AddressMutation) AddedIDs(name string) []ent.Value {
+func (m *ProductMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case address.EdgeUser:
-		if id := m.user; id != nil {
+	case product.EdgeAvaliations:
+		ids := make([]ent.Value, 0, len(m.avaliations))
+		for id := range m.avaliations {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeCategory:
+		if id := m.category; id != nil {
 			return []ent.Value{*id}
 		}
-	case address.EdgeOrders:
-		ids := make([]ent.Value, 0, len(m.orders))
-		for id := range m.orders {
+	case product.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.order_items))
+		for id := range m.order_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeCartItems:
+		ids := make([]ent.Value, 0, len(m.cart_items))
+		for id := range m.cart_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.options))
+		for id := range m.options {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeVariants:
+		ids := make([]ent.Value, 0, len(m.variants))
+		for id := range m.variants {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1026,21 +15065,57 @@ func (m *AddressMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AddressMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedorders != nil {
-		edges = append(edges, address.EdgeOrders)
+func (m *ProductMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedavaliations != nil {
+		edges = append(edges, product.EdgeAvaliations)
+	}
+	if m.removedorder_items != nil {
+		edges = append(edges, product.EdgeOrderItems)
+	}
+	if m.removedcart_items != nil {
+		edges = append(edges, product.EdgeCartItems)
+	}
+	if m.removedoptions != nil {
+		edges = append(edges, product.EdgeOptions)
+	}
+	if m.removedvariants != nil {
+		edges = append(edges, product.EdgeVariants)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AddressMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case address.EdgeOrders:
-		ids := make([]ent.Value, 0, len(m.removedorders))
-		for id := range m.removedorders {
+	case product.EdgeAvaliations:
+		ids := make([]ent.Value, 0, len(m.removedavaliations))
+		for id := range m.removedavaliations {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.removedorder_items))
+		for id := range m.removedorder_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeCartItems:
+		ids := make([]ent.Value, 0, len(m.removedcart_items))
+		for id := range m.removedcart_items {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.removedoptions))
+		for id := range m.removedoptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case product.EdgeVariants:
+		ids := make([]ent.Value, 0, len(m.removedvariants))
+		for id := range m.removedvariants {
 			ids = append(ids, id)
 		}
 		return ids
@@ -1049,90 +15124,118 @@ func (m *AddressMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AddressMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, address.EdgeUser)
+func (m *ProductMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedavaliations {
+		edges = append(edges, product.EdgeAvaliations)
 	}
-	if m.clearedorders {
-		edges = append(edges, address.EdgeOrders)
+	if m.clearedcategory {
+		edges = append(edges, product.EdgeCategory)
+	}
+	if m.clearedorder_items {
+		edges = append(edges, product.EdgeOrderItems)
+	}
+	if m.clearedcart_items {
+		edges = append(edges, product.EdgeCartItems)
+	}
+	if m.clearedoptions {
+		edges = append(edges, product.EdgeOptions)
+	}
+	if m.clearedvariants {
+		edges = append(edges, product.EdgeVariants)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AddressMutation) EdgeCleared(name string) bool {
+func (m *ProductMutation) EdgeCleared(name string) bool {
 	switch name {
-	case address.EdgeUser:
-		return m.cleareduser
-	case address.EdgeOrders:
-		return m.clearedorders
+	case product.EdgeAvaliations:
+		return m.clearedavaliations
+	case product.EdgeCategory:
+		return m.clearedcategory
+	case product.EdgeOrderItems:
+		return m.clearedorder_items
+	case product.EdgeCartItems:
+		return m.clearedcart_items
+	case product.EdgeOptions:
+		return m.clearedoptions
+	case product.EdgeVariants:
+		return m.clearedvariants
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AddressMutation) ClearEdge(name string) error {
+func (m *ProductMutation) ClearEdge(name string) error {
 	switch name {
-	case address.EdgeUser:
-		m.ClearUser()
+	case product.EdgeCategory:
+		m.ClearCategory()
 		return nil
 	}
-	return fmt.Errorf("unknown Address unique edge %s", name)
+	return fmt.Errorf("unknown Product unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AddressMutation) ResetEdge(name string) error {
+func (m *ProductMutation) ResetEdge(name string) error {
 	switch name {
-	case address.EdgeUser:
-		m.ResetUser()
+	case product.EdgeAvaliations:
+		m.ResetAvaliations()
 		return nil
-	case address.EdgeOrders:
-		m.ResetOrders()
+	case product.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	case product.EdgeOrderItems:
+		m.ResetOrderItems()
+		return nil
+	case product.EdgeCartItems:
+		m.ResetCartItems()
+		return nil
+	case product.EdgeOptions:
+		m.ResetOptions()
+		return nil
+	case product.EdgeVariants:
+		m.ResetVariants()
 		return nil
 	}
-	return fmt.Errorf("unknown Address edge %s", name)
+	return fmt.Errorf("unknown Product edge %s", name)
 }
 
-// AvaliationMutation represents an operation that mutates the Avaliation nodes in the graph.
-type AvaliationMutation struct {
+// ProductOptionMutation represents an operation that mutates the ProductOption nodes in the graph.
+type ProductOptionMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *string
-	user_name      *string
-	rating         *int
-	addrating      *int
-	comment        *string
-	date           *time.Time
-	images         *[]string
-	appendimages   []string
+	name           *string
+	values         *[]string
+	appendvalues   []string
+	position       *int
+	addposition    *int
 	created_at     *time.Time
 	updated_at     *time.Time
 	clearedFields  map[string]struct{}
 	product        *string
 	clearedproduct bool
-	user           *string
-	cleareduser    bool
 	done           bool
-	oldValue       func(context.Context) (*Avaliation, error)
-	predicates     []predicate.Avaliation
+	oldValue       func(context.Context) (*ProductOption, error)
+	predicates     []predicate.ProductOption
 }
 
-var _ ent.Mutation = (*AvaliationMutation)(nil)
+var _ ent.Mutation = (*ProductOptionMutation)(nil)
 
-// avaliationOption allows management of the mutation configuration using functional options.
-type avaliationOption func(*AvaliationMutation)
+// productoptionOption allows management of the mutation configuration using functional options.
+type productoptionOption func(*ProductOptionMutation)
 
-// newAvaliationMutation creates new mutation for the Avaliation entity.
-func newAvaliationMutation(c config, op Op, opts ...avaliationOption) *AvaliationMutation {
-	m := &AvaliationMutation{
+// newProductOptionMutation creates new mutation for the ProductOption entity.
+func newProductOptionMutation(c config, op Op, opts ...productoptionOption) *ProductOptionMutation {
+	m := &ProductOptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeAvaliation,
+		typ:           TypeProductOption,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1141,20 +15244,20 @@ func newAvaliationMutation(c config, op Op, opts ...avaliationOption) *Avaliatio
 	return m
 }
 
-// withAvaliationID sets the ID field of the mutation.
-func withAvaliationID(id string) avaliationOption {
-	return func(m *AvaliationMutation) {
+// withProductOptionID sets the ID field of the mutation.
+func withProductOptionID(id string) productoptionOption {
+	return func(m *ProductOptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Avaliation
+			value *ProductOption
 		)
-		m.oldValue = func(ctx context.Context) (*Avaliation, error) {
+		m.oldValue = func(ctx context.Context) (*ProductOption, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Avaliation.Get(ctx, id)
+					value, err = m.Client().ProductOption.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1163,10 +15266,10 @@ func withAvaliationID(id string) avaliationOption {
 	}
 }
 
-// withAvaliation sets the old Avaliation of the mutation.
-func withAvaliation(node *Avaliation) avaliationOption {
-	return func(m *AvaliationMutation) {
-		m.oldValue = func(context.Context) (*Avaliation, error) {
+// withProductOption sets the old ProductOption of the mutation.
+func withProductOption(node *ProductOption) productoptionOption {
+	return func(m *ProductOptionMutation) {
+		m.oldValue = func(context.Context) (*ProductOption, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1175,7 +15278,7 @@ func withAvaliation(node *Avaliation) avaliationOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m AvaliationMutation) Client() *Client {
+func (m ProductOptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1183,7 +15286,7 @@ func (m AvaliationMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m AvaliationMutation) Tx() (*Tx, error) {
+func (m ProductOptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1193,373 +15296,238 @@ func (m AvaliationMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Avaliation entities.
-func (m *AvaliationMutation) SetID(id string) {
-	m.id = &id
-}
-
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *AvaliationMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *AvaliationMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Avaliation.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetProductID sets the "product_id" field.
-func (m *AvaliationMutation) SetProductID(s string) {
-	m.product = &s
-}
-
-// ProductID returns the value of the "product_id" field in the mutation.
-func (m *AvaliationMutation) ProductID() (r string, exists bool) {
-	v := m.product
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProductID returns the old "product_id" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldProductID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
-	}
-	return oldValue.ProductID, nil
-}
-
-// ClearProductID clears the value of the "product_id" field.
-func (m *AvaliationMutation) ClearProductID() {
-	m.product = nil
-	m.clearedFields[avaliation.FieldProductID] = struct{}{}
-}
-
-// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
-func (m *AvaliationMutation) ProductIDCleared() bool {
-	_, ok := m.clearedFields[avaliation.FieldProductID]
-	return ok
-}
-
-// ResetProductID resets all changes to the "product_id" field.
-func (m *AvaliationMutation) ResetProductID() {
-	m.product = nil
-	delete(m.clearedFields, avaliation.FieldProductID)
-}
-
-// SetUserID sets the "user_id" field.
-func (m *AvaliationMutation) SetUserID(s string) {
-	m.user = &s
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *AvaliationMutation) UserID() (r string, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldUserID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *AvaliationMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[avaliation.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *AvaliationMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[avaliation.FieldUserID]
-	return ok
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *AvaliationMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, avaliation.FieldUserID)
-}
-
-// SetUserName sets the "user_name" field.
-func (m *AvaliationMutation) SetUserName(s string) {
-	m.user_name = &s
+// operation is only accepted on creation of ProductOption entities.
+func (m *ProductOptionMutation) SetID(id string) {
+	m.id = &id
 }
 
-// UserName returns the value of the "user_name" field in the mutation.
-func (m *AvaliationMutation) UserName() (r string, exists bool) {
-	v := m.user_name
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProductOptionMutation) ID() (id string, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldUserName returns the old "user_name" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldUserName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserName: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProductOptionMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProductOption.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.UserName, nil
-}
-
-// ResetUserName resets all changes to the "user_name" field.
-func (m *AvaliationMutation) ResetUserName() {
-	m.user_name = nil
 }
 
-// SetRating sets the "rating" field.
-func (m *AvaliationMutation) SetRating(i int) {
-	m.rating = &i
-	m.addrating = nil
+// SetProductID sets the "product_id" field.
+func (m *ProductOptionMutation) SetProductID(s string) {
+	m.product = &s
 }
 
-// Rating returns the value of the "rating" field in the mutation.
-func (m *AvaliationMutation) Rating() (r int, exists bool) {
-	v := m.rating
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *ProductOptionMutation) ProductID() (r string, exists bool) {
+	v := m.product
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRating returns the old "rating" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldProductID returns the old "product_id" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldRating(ctx context.Context) (v int, err error) {
+func (m *ProductOptionMutation) OldProductID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRating requires an ID field in the mutation")
+		return v, errors.New("OldProductID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
 	}
-	return oldValue.Rating, nil
+	return oldValue.ProductID, nil
 }
 
-// AddRating adds i to the "rating" field.
-func (m *AvaliationMutation) AddRating(i int) {
-	if m.addrating != nil {
-		*m.addrating += i
-	} else {
-		m.addrating = &i
-	}
+// ClearProductID clears the value of the "product_id" field.
+func (m *ProductOptionMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[productoption.FieldProductID] = struct{}{}
 }
 
-// AddedRating returns the value that was added to the "rating" field in this mutation.
-func (m *AvaliationMutation) AddedRating() (r int, exists bool) {
-	v := m.addrating
-	if v == nil {
-		return
-	}
-	return *v, true
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *ProductOptionMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[productoption.FieldProductID]
+	return ok
 }
 
-// ResetRating resets all changes to the "rating" field.
-func (m *AvaliationMutation) ResetRating() {
-	m.rating = nil
-	m.addrating = nil
+// ResetProductID resets all changes to the "product_id" field.
+func (m *ProductOptionMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, productoption.FieldProductID)
 }
 
-// SetComment sets the "comment" field.
-func (m *AvaliationMutation) SetComment(s string) {
-	m.comment = &s
+// SetName sets the "name" field.
+func (m *ProductOptionMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Comment returns the value of the "comment" field in the mutation.
-func (m *AvaliationMutation) Comment() (r string, exists bool) {
-	v := m.comment
+// Name returns the value of the "name" field in the mutation.
+func (m *ProductOptionMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldComment returns the old "comment" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldComment(ctx context.Context) (v string, err error) {
+func (m *ProductOptionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldComment is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldComment requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldComment: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Comment, nil
+	return oldValue.Name, nil
 }
 
-// ResetComment resets all changes to the "comment" field.
-func (m *AvaliationMutation) ResetComment() {
-	m.comment = nil
+// ResetName resets all changes to the "name" field.
+func (m *ProductOptionMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDate sets the "date" field.
-func (m *AvaliationMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetValues sets the "values" field.
+func (m *ProductOptionMutation) SetValues(s []string) {
+	m.values = &s
+	m.appendvalues = nil
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *AvaliationMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// Values returns the value of the "values" field in the mutation.
+func (m *ProductOptionMutation) Values() (r []string, exists bool) {
+	v := m.values
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldValues returns the old "values" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *ProductOptionMutation) OldValues(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldValues requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.Values, nil
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *AvaliationMutation) ResetDate() {
-	m.date = nil
+// AppendValues adds s to the "values" field.
+func (m *ProductOptionMutation) AppendValues(s []string) {
+	m.appendvalues = append(m.appendvalues, s...)
 }
 
-// SetImages sets the "images" field.
-func (m *AvaliationMutation) SetImages(s []string) {
-	m.images = &s
-	m.appendimages = nil
+// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
+func (m *ProductOptionMutation) AppendedValues() ([]string, bool) {
+	if len(m.appendvalues) == 0 {
+		return nil, false
+	}
+	return m.appendvalues, true
 }
 
-// Images returns the value of the "images" field in the mutation.
-func (m *AvaliationMutation) Images() (r []string, exists bool) {
-	v := m.images
+// ResetValues resets all changes to the "values" field.
+func (m *ProductOptionMutation) ResetValues() {
+	m.values = nil
+	m.appendvalues = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ProductOptionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ProductOptionMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImages returns the old "images" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldImages(ctx context.Context) (v []string, err error) {
+func (m *ProductOptionMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImages requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Images, nil
-}
-
-// AppendImages adds s to the "images" field.
-func (m *AvaliationMutation) AppendImages(s []string) {
-	m.appendimages = append(m.appendimages, s...)
+	return oldValue.Position, nil
 }
 
-// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
-func (m *AvaliationMutation) AppendedImages() ([]string, bool) {
-	if len(m.appendimages) == 0 {
-		return nil, false
+// AddPosition adds i to the "position" field.
+func (m *ProductOptionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
 	}
-	return m.appendimages, true
-}
-
-// ClearImages clears the value of the "images" field.
-func (m *AvaliationMutation) ClearImages() {
-	m.images = nil
-	m.appendimages = nil
-	m.clearedFields[avaliation.FieldImages] = struct{}{}
 }
 
-// ImagesCleared returns if the "images" field was cleared in this mutation.
-func (m *AvaliationMutation) ImagesCleared() bool {
-	_, ok := m.clearedFields[avaliation.FieldImages]
-	return ok
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ProductOptionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetImages resets all changes to the "images" field.
-func (m *AvaliationMutation) ResetImages() {
-	m.images = nil
-	m.appendimages = nil
-	delete(m.clearedFields, avaliation.FieldImages)
+// ResetPosition resets all changes to the "position" field.
+func (m *ProductOptionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *AvaliationMutation) SetCreatedAt(t time.Time) {
+func (m *ProductOptionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AvaliationMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProductOptionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1567,10 +15535,10 @@ func (m *AvaliationMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductOptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1585,17 +15553,17 @@ func (m *AvaliationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AvaliationMutation) ResetCreatedAt() {
+func (m *ProductOptionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *AvaliationMutation) SetUpdatedAt(t time.Time) {
+func (m *ProductOptionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *AvaliationMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ProductOptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -1603,10 +15571,10 @@ func (m *AvaliationMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Avaliation entity.
-// If the Avaliation object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ProductOption entity.
+// If the ProductOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AvaliationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductOptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -1621,25 +15589,25 @@ func (m *AvaliationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *AvaliationMutation) ResetUpdatedAt() {
+func (m *ProductOptionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // ClearProduct clears the "product" edge to the Product entity.
-func (m *AvaliationMutation) ClearProduct() {
+func (m *ProductOptionMutation) ClearProduct() {
 	m.clearedproduct = true
-	m.clearedFields[avaliation.FieldProductID] = struct{}{}
+	m.clearedFields[productoption.FieldProductID] = struct{}{}
 }
 
 // ProductCleared reports if the "product" edge to the Product entity was cleared.
-func (m *AvaliationMutation) ProductCleared() bool {
+func (m *ProductOptionMutation) ProductCleared() bool {
 	return m.ProductIDCleared() || m.clearedproduct
 }
 
 // ProductIDs returns the "product" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProductID instead. It exists only for internal usage by the builders.
-func (m *AvaliationMutation) ProductIDs() (ids []string) {
+func (m *ProductOptionMutation) ProductIDs() (ids []string) {
 	if id := m.product; id != nil {
 		ids = append(ids, *id)
 	}
@@ -1647,47 +15615,20 @@ func (m *AvaliationMutation) ProductIDs() (ids []string) {
 }
 
 // ResetProduct resets all changes to the "product" edge.
-func (m *AvaliationMutation) ResetProduct() {
+func (m *ProductOptionMutation) ResetProduct() {
 	m.product = nil
 	m.clearedproduct = false
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *AvaliationMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[avaliation.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *AvaliationMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *AvaliationMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *AvaliationMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the AvaliationMutation builder.
-func (m *AvaliationMutation) Where(ps ...predicate.Avaliation) {
+// Where appends a list predicates to the ProductOptionMutation builder.
+func (m *ProductOptionMutation) Where(ps ...predicate.ProductOption) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the AvaliationMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProductOptionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AvaliationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Avaliation, len(ps))
+func (m *ProductOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProductOption, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1695,51 +15636,42 @@ func (m *AvaliationMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *AvaliationMutation) Op() Op {
+func (m *ProductOptionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *AvaliationMutation) SetOp(op Op) {
+func (m *ProductOptionMutation) SetOp(op Op) {
 	m.op = op
-}
-
-// Type returns the node type of this mutation (Avaliation).
-func (m *AvaliationMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AvaliationMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.product != nil {
-		fields = append(fields, avaliation.FieldProductID)
-	}
-	if m.user != nil {
-		fields = append(fields, avaliation.FieldUserID)
-	}
-	if m.user_name != nil {
-		fields = append(fields, avaliation.FieldUserName)
-	}
-	if m.rating != nil {
-		fields = append(fields, avaliation.FieldRating)
+}
+
+// Type returns the node type of this mutation (ProductOption).
+func (m *ProductOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProductOptionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.product != nil {
+		fields = append(fields, productoption.FieldProductID)
 	}
-	if m.comment != nil {
-		fields = append(fields, avaliation.FieldComment)
+	if m.name != nil {
+		fields = append(fields, productoption.FieldName)
 	}
-	if m.date != nil {
-		fields = append(fields, avaliation.FieldDate)
+	if m.values != nil {
+		fields = append(fields, productoption.FieldValues)
 	}
-	if m.images != nil {
-		fields = append(fields, avaliation.FieldImages)
+	if m.position != nil {
+		fields = append(fields, productoption.FieldPosition)
 	}
 	if m.created_at != nil {
-		fields = append(fields, avaliation.FieldCreatedAt)
+		fields = append(fields, productoption.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, avaliation.FieldUpdatedAt)
+		fields = append(fields, productoption.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1747,25 +15679,19 @@ func (m *AvaliationMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AvaliationMutation) Field(name string) (ent.Value, bool) {
+func (m *ProductOptionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case avaliation.FieldProductID:
+	case productoption.FieldProductID:
 		return m.ProductID()
-	case avaliation.FieldUserID:
-		return m.UserID()
-	case avaliation.FieldUserName:
-		return m.UserName()
-	case avaliation.FieldRating:
-		return m.Rating()
-	case avaliation.FieldComment:
-		return m.Comment()
-	case avaliation.FieldDate:
-		return m.Date()
-	case avaliation.FieldImages:
-		return m.Images()
-	case avaliation.FieldCreatedAt:
+	case productoption.FieldName:
+		return m.Name()
+	case productoption.FieldValues:
+		return m.Values()
+	case productoption.FieldPosition:
+		return m.Position()
+	case productoption.FieldCreatedAt:
 		return m.CreatedAt()
-	case avaliation.FieldUpdatedAt:
+	case productoption.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1774,92 +15700,65 @@ func (m *AvaliationMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AvaliationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProductOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case avaliation.FieldProductID:
+	case productoption.FieldProductID:
 		return m.OldProductID(ctx)
-	case avaliation.FieldUserID:
-		return m.OldUserID(ctx)
-	case avaliation.FieldUserName:
-		return m.OldUserName(ctx)
-	case avaliation.FieldRating:
-		return m.OldRating(ctx)
-	case avaliation.FieldComment:
-		return m.OldComment(ctx)
-	case avaliation.FieldDate:
-		return m.OldDate(ctx)
-	case avaliation.FieldImages:
-		return m.OldImages(ctx)
-	case avaliation.FieldCreatedAt:
+	case productoption.FieldName:
+		return m.OldName(ctx)
+	case productoption.FieldValues:
+		return m.OldValues(ctx)
+	case productoption.FieldPosition:
+		return m.OldPosition(ctx)
+	case productoption.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case avaliation.FieldUpdatedAt:
+	case productoption.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Avaliation field %s", name)
+	return nil, fmt.Errorf("unknown ProductOption field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AvaliationMutation) SetField(name string, value ent.Value) error {
+func (m *ProductOptionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case avaliation.FieldProductID:
+	case productoption.FieldProductID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProductID(v)
 		return nil
-	case avaliation.FieldUserID:
+	case productoption.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetName(v)
 		return nil
-	case avaliation.FieldUserName:
-		v, ok := value.(string)
+	case productoption.FieldValues:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserName(v)
+		m.SetValues(v)
 		return nil
-	case avaliation.FieldRating:
+	case productoption.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRating(v)
-		return nil
-	case avaliation.FieldComment:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetComment(v)
-		return nil
-	case avaliation.FieldDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDate(v)
-		return nil
-	case avaliation.FieldImages:
-		v, ok := value.([]string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImages(v)
+		m.SetPosition(v)
 		return nil
-	case avaliation.FieldCreatedAt:
+	case productoption.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case avaliation.FieldUpdatedAt:
+	case productoption.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1867,15 +15766,15 @@ func (m *AvaliationMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Avaliation field %s", name)
+	return fmt.Errorf("unknown ProductOption field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AvaliationMutation) AddedFields() []string {
+func (m *ProductOptionMutation) AddedFields() []string {
 	var fields []string
-	if m.addrating != nil {
-		fields = append(fields, avaliation.FieldRating)
+	if m.addposition != nil {
+		fields = append(fields, productoption.FieldPosition)
 	}
 	return fields
 }
@@ -1883,10 +15782,10 @@ func (m *AvaliationMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AvaliationMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProductOptionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case avaliation.FieldRating:
-		return m.AddedRating()
+	case productoption.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -1894,226 +15793,191 @@ func (m *AvaliationMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AvaliationMutation) AddField(name string, value ent.Value) error {
+func (m *ProductOptionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case avaliation.FieldRating:
+	case productoption.FieldPosition:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRating(v)
+		m.AddPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Avaliation numeric field %s", name)
+	return fmt.Errorf("unknown ProductOption numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AvaliationMutation) ClearedFields() []string {
+func (m *ProductOptionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(avaliation.FieldProductID) {
-		fields = append(fields, avaliation.FieldProductID)
-	}
-	if m.FieldCleared(avaliation.FieldUserID) {
-		fields = append(fields, avaliation.FieldUserID)
-	}
-	if m.FieldCleared(avaliation.FieldImages) {
-		fields = append(fields, avaliation.FieldImages)
+	if m.FieldCleared(productoption.FieldProductID) {
+		fields = append(fields, productoption.FieldProductID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AvaliationMutation) FieldCleared(name string) bool {
+func (m *ProductOptionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AvaliationMutation) ClearField(name string) error {
+func (m *ProductOptionMutation) ClearField(name string) error {
 	switch name {
-	case avaliation.FieldProductID:
+	case productoption.FieldProductID:
 		m.ClearProductID()
 		return nil
-	case avaliation.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case avaliation.FieldImages:
-		m.ClearImages()
-		return nil
 	}
-	return fmt.Errorf("unknown Avaliation nullable field %s", name)
+	return fmt.Errorf("unknown ProductOption nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AvaliationMutation) ResetField(name string) error {
+func (m *ProductOptionMutation) ResetField(name string) error {
 	switch name {
-	case avaliation.FieldProductID:
+	case productoption.FieldProductID:
 		m.ResetProductID()
 		return nil
-	case avaliation.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case avaliation.FieldUserName:
-		m.ResetUserName()
-		return nil
-	case avaliation.FieldRating:
-		m.ResetRating()
-		return nil
-	case avaliation.FieldComment:
-		m.ResetComment()
+	case productoption.FieldName:
+		m.ResetName()
 		return nil
-	case avaliation.FieldDate:
-		m.ResetDate()
+	case productoption.FieldValues:
+		m.ResetValues()
 		return nil
-	case avaliation.FieldImages:
-		m.ResetImages()
+	case productoption.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case avaliation.FieldCreatedAt:
+	case productoption.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case avaliation.FieldUpdatedAt:
+	case productoption.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Avaliation field %s", name)
+	return fmt.Errorf("unknown ProductOption field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AvaliationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProductOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.product != nil {
-		edges = append(edges, avaliation.EdgeProduct)
-	}
-	if m.user != nil {
-		edges = append(edges, avaliation.EdgeUser)
+		edges = append(edges, productoption.EdgeProduct)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AvaliationMutation) AddedIDs(name string) []ent.Value {
+func (m *ProductOptionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case avaliation.EdgeProduct:
+	case productoption.EdgeProduct:
 		if id := m.product; id != nil {
 			return []ent.Value{*id}
 		}
-	case avaliation.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AvaliationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProductOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AvaliationMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProductOptionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AvaliationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProductOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedproduct {
-		edges = append(edges, avaliation.EdgeProduct)
-	}
-	if m.cleareduser {
-		edges = append(edges, avaliation.EdgeUser)
+		edges = append(edges, productoption.EdgeProduct)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AvaliationMutation) EdgeCleared(name string) bool {
+func (m *ProductOptionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case avaliation.EdgeProduct:
+	case productoption.EdgeProduct:
 		return m.clearedproduct
-	case avaliation.EdgeUser:
-		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AvaliationMutation) ClearEdge(name string) error {
+func (m *ProductOptionMutation) ClearEdge(name string) error {
 	switch name {
-	case avaliation.EdgeProduct:
+	case productoption.EdgeProduct:
 		m.ClearProduct()
 		return nil
-	case avaliation.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown Avaliation unique edge %s", name)
+	return fmt.Errorf("unknown ProductOption unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AvaliationMutation) ResetEdge(name string) error {
+func (m *ProductOptionMutation) ResetEdge(name string) error {
 	switch name {
-	case avaliation.EdgeProduct:
+	case productoption.EdgeProduct:
 		m.ResetProduct()
 		return nil
-	case avaliation.EdgeUser:
-		m.ResetUser()
-		return nil
 	}
-	return fmt.Errorf("unknown Avaliation edge %s", name)
+	return fmt.Errorf("unknown ProductOption edge %s", name)
 }
 
-// CartMutation represents an operation that mutates the Cart nodes in the graph.
-type CartMutation struct {
+// ProductVariantMutation represents an operation that mutates the ProductVariant nodes in the graph.
+type ProductVariantMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *string
-	subtotal          *float64
-	addsubtotal       *float64
-	shipping          *float64
-	addshipping       *float64
-	discount          *float64
-	adddiscount       *float64
-	total             *float64
-	addtotal          *float64
-	applied_coupon    *bool
-	coupon_code       *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	user              *string
-	cleareduser       bool
-	cart_items        map[string]struct{}
-	removedcart_items map[string]struct{}
-	clearedcart_items bool
-	done              bool
-	oldValue          func(context.Context) (*Cart, error)
-	predicates        []predicate.Cart
+	op                 Op
+	typ                string
+	id                 *string
+	sku                *string
+	options            *map[string]string
+	combination        *string
+	price              *money.Amount
+	addprice           *money.Amount
+	stock              *int
+	addstock           *int
+	images             *[]string
+	appendimages       []string
+	position           *int
+	addposition        *int
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	product            *string
+	clearedproduct     bool
+	cart_items         map[string]struct{}
+	removedcart_items  map[string]struct{}
+	clearedcart_items  bool
+	order_items        map[string]struct{}
+	removedorder_items map[string]struct{}
+	clearedorder_items bool
+	done               bool
+	oldValue           func(context.Context) (*ProductVariant, error)
+	predicates         []predicate.ProductVariant
 }
 
-var _ ent.Mutation = (*CartMutation)(nil)
+var _ ent.Mutation = (*ProductVariantMutation)(nil)
 
-// cartOption allows management of the mutation configuration using functional options.
-type cartOption func(*CartMutation)
+// productvariantOption allows management of the mutation configuration using functional options.
+type productvariantOption func(*ProductVariantMutation)
 
-// newCartMutation creates new mutation for the Cart entity.
-func newCartMutation(c config, op Op, opts ...cartOption) *CartMutation {
-	m := &CartMutation{
+// newProductVariantMutation creates new mutation for the ProductVariant entity.
+func newProductVariantMutation(c config, op Op, opts ...productvariantOption) *ProductVariantMutation {
+	m := &ProductVariantMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCart,
+		typ:           TypeProductVariant,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2122,20 +15986,20 @@ func newCartMutation(c config, op Op, opts ...cartOption) *CartMutation {
 	return m
 }
 
-// withCartID sets the ID field of the mutation.
-func withCartID(id string) cartOption {
-	return func(m *CartMutation) {
+// withProductVariantID sets the ID field of the mutation.
+func withProductVariantID(id string) productvariantOption {
+	return func(m *ProductVariantMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Cart
+			value *ProductVariant
 		)
-		m.oldValue = func(ctx context.Context) (*Cart, error) {
+		m.oldValue = func(ctx context.Context) (*ProductVariant, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Cart.Get(ctx, id)
+					value, err = m.Client().ProductVariant.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2144,10 +16008,10 @@ func withCartID(id string) cartOption {
 	}
 }
 
-// withCart sets the old Cart of the mutation.
-func withCart(node *Cart) cartOption {
-	return func(m *CartMutation) {
-		m.oldValue = func(context.Context) (*Cart, error) {
+// withProductVariant sets the old ProductVariant of the mutation.
+func withProductVariant(node *ProductVariant) productvariantOption {
+	return func(m *ProductVariantMutation) {
+		m.oldValue = func(context.Context) (*ProductVariant, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2156,7 +16020,7 @@ func withCart(node *Cart) cartOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CartMutation) Client() *Client {
+func (m ProductVariantMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2164,7 +16028,7 @@ func (m CartMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CartMutation) Tx() (*Tx, error) {
+func (m ProductVariantMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2174,14 +16038,14 @@ func (m CartMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Cart entities.
-func (m *CartMutation) SetID(id string) {
+// operation is only accepted on creation of ProductVariant entities.
+func (m *ProductVariantMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CartMutation) ID() (id string, exists bool) {
+func (m *ProductVariantMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2192,7 +16056,7 @@ func (m *CartMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CartMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ProductVariantMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2201,391 +16065,409 @@ func (m *CartMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Cart.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ProductVariant.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *CartMutation) SetUserID(s string) {
-	m.user = &s
+// SetProductID sets the "product_id" field.
+func (m *ProductVariantMutation) SetProductID(s string) {
+	m.product = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *CartMutation) UserID() (r string, exists bool) {
-	v := m.user
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *ProductVariantMutation) ProductID() (r string, exists bool) {
+	v := m.product
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldProductID returns the old "product_id" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ProductVariantMutation) OldProductID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldProductID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ProductID, nil
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *CartMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[cart.FieldUserID] = struct{}{}
+// ClearProductID clears the value of the "product_id" field.
+func (m *ProductVariantMutation) ClearProductID() {
+	m.product = nil
+	m.clearedFields[productvariant.FieldProductID] = struct{}{}
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *CartMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[cart.FieldUserID]
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *ProductVariantMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[productvariant.FieldProductID]
 	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *CartMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, cart.FieldUserID)
+// ResetProductID resets all changes to the "product_id" field.
+func (m *ProductVariantMutation) ResetProductID() {
+	m.product = nil
+	delete(m.clearedFields, productvariant.FieldProductID)
 }
 
-// SetSubtotal sets the "subtotal" field.
-func (m *CartMutation) SetSubtotal(f float64) {
-	m.subtotal = &f
-	m.addsubtotal = nil
+// SetSku sets the "sku" field.
+func (m *ProductVariantMutation) SetSku(s string) {
+	m.sku = &s
 }
 
-// Subtotal returns the value of the "subtotal" field in the mutation.
-func (m *CartMutation) Subtotal() (r float64, exists bool) {
-	v := m.subtotal
+// Sku returns the value of the "sku" field in the mutation.
+func (m *ProductVariantMutation) Sku() (r string, exists bool) {
+	v := m.sku
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSubtotal returns the old "subtotal" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldSku returns the old "sku" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldSubtotal(ctx context.Context) (v float64, err error) {
+func (m *ProductVariantMutation) OldSku(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSubtotal is only allowed on UpdateOne operations")
+		return v, errors.New("OldSku is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSubtotal requires an ID field in the mutation")
+		return v, errors.New("OldSku requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSubtotal: %w", err)
-	}
-	return oldValue.Subtotal, nil
-}
-
-// AddSubtotal adds f to the "subtotal" field.
-func (m *CartMutation) AddSubtotal(f float64) {
-	if m.addsubtotal != nil {
-		*m.addsubtotal += f
-	} else {
-		m.addsubtotal = &f
-	}
-}
-
-// AddedSubtotal returns the value that was added to the "subtotal" field in this mutation.
-func (m *CartMutation) AddedSubtotal() (r float64, exists bool) {
-	v := m.addsubtotal
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSku: %w", err)
 	}
-	return *v, true
+	return oldValue.Sku, nil
 }
 
-// ResetSubtotal resets all changes to the "subtotal" field.
-func (m *CartMutation) ResetSubtotal() {
-	m.subtotal = nil
-	m.addsubtotal = nil
+// ResetSku resets all changes to the "sku" field.
+func (m *ProductVariantMutation) ResetSku() {
+	m.sku = nil
 }
 
-// SetShipping sets the "shipping" field.
-func (m *CartMutation) SetShipping(f float64) {
-	m.shipping = &f
-	m.addshipping = nil
+// SetOptions sets the "options" field.
+func (m *ProductVariantMutation) SetOptions(value map[string]string) {
+	m.options = &value
 }
 
-// Shipping returns the value of the "shipping" field in the mutation.
-func (m *CartMutation) Shipping() (r float64, exists bool) {
-	v := m.shipping
+// Options returns the value of the "options" field in the mutation.
+func (m *ProductVariantMutation) Options() (r map[string]string, exists bool) {
+	v := m.options
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldShipping returns the old "shipping" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldOptions returns the old "options" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldShipping(ctx context.Context) (v float64, err error) {
+func (m *ProductVariantMutation) OldOptions(ctx context.Context) (v map[string]string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShipping is only allowed on UpdateOne operations")
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShipping requires an ID field in the mutation")
+		return v, errors.New("OldOptions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShipping: %w", err)
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
 	}
-	return oldValue.Shipping, nil
+	return oldValue.Options, nil
 }
 
-// AddShipping adds f to the "shipping" field.
-func (m *CartMutation) AddShipping(f float64) {
-	if m.addshipping != nil {
-		*m.addshipping += f
-	} else {
-		m.addshipping = &f
-	}
+// ResetOptions resets all changes to the "options" field.
+func (m *ProductVariantMutation) ResetOptions() {
+	m.options = nil
 }
 
-// AddedShipping returns the value that was added to the "shipping" field in this mutation.
-func (m *CartMutation) AddedShipping() (r float64, exists bool) {
-	v := m.addshipping
+// SetCombination sets the "combination" field.
+func (m *ProductVariantMutation) SetCombination(s string) {
+	m.combination = &s
+}
+
+// Combination returns the value of the "combination" field in the mutation.
+func (m *ProductVariantMutation) Combination() (r string, exists bool) {
+	v := m.combination
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearShipping clears the value of the "shipping" field.
-func (m *CartMutation) ClearShipping() {
-	m.shipping = nil
-	m.addshipping = nil
-	m.clearedFields[cart.FieldShipping] = struct{}{}
-}
-
-// ShippingCleared returns if the "shipping" field was cleared in this mutation.
-func (m *CartMutation) ShippingCleared() bool {
-	_, ok := m.clearedFields[cart.FieldShipping]
-	return ok
+// OldCombination returns the old "combination" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProductVariantMutation) OldCombination(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCombination is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCombination requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCombination: %w", err)
+	}
+	return oldValue.Combination, nil
 }
 
-// ResetShipping resets all changes to the "shipping" field.
-func (m *CartMutation) ResetShipping() {
-	m.shipping = nil
-	m.addshipping = nil
-	delete(m.clearedFields, cart.FieldShipping)
+// ResetCombination resets all changes to the "combination" field.
+func (m *ProductVariantMutation) ResetCombination() {
+	m.combination = nil
 }
 
-// SetDiscount sets the "discount" field.
-func (m *CartMutation) SetDiscount(f float64) {
-	m.discount = &f
-	m.adddiscount = nil
+// SetPrice sets the "price" field.
+func (m *ProductVariantMutation) SetPrice(value money.Amount) {
+	m.price = &value
+	m.addprice = nil
 }
 
-// Discount returns the value of the "discount" field in the mutation.
-func (m *CartMutation) Discount() (r float64, exists bool) {
-	v := m.discount
+// Price returns the value of the "price" field in the mutation.
+func (m *ProductVariantMutation) Price() (r money.Amount, exists bool) {
+	v := m.price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDiscount returns the old "discount" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldPrice returns the old "price" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldDiscount(ctx context.Context) (v float64, err error) {
+func (m *ProductVariantMutation) OldPrice(ctx context.Context) (v *money.Amount, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiscount requires an ID field in the mutation")
+		return v, errors.New("OldPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
 	}
-	return oldValue.Discount, nil
+	return oldValue.Price, nil
 }
 
-// AddDiscount adds f to the "discount" field.
-func (m *CartMutation) AddDiscount(f float64) {
-	if m.adddiscount != nil {
-		*m.adddiscount += f
+// AddPrice adds value to the "price" field.
+func (m *ProductVariantMutation) AddPrice(value money.Amount) {
+	if m.addprice != nil {
+		*m.addprice += value
 	} else {
-		m.adddiscount = &f
+		m.addprice = &value
 	}
 }
 
-// AddedDiscount returns the value that was added to the "discount" field in this mutation.
-func (m *CartMutation) AddedDiscount() (r float64, exists bool) {
-	v := m.adddiscount
+// AddedPrice returns the value that was added to the "price" field in this mutation.
+func (m *ProductVariantMutation) AddedPrice() (r money.Amount, exists bool) {
+	v := m.addprice
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetDiscount resets all changes to the "discount" field.
-func (m *CartMutation) ResetDiscount() {
-	m.discount = nil
-	m.adddiscount = nil
+// ClearPrice clears the value of the "price" field.
+func (m *ProductVariantMutation) ClearPrice() {
+	m.price = nil
+	m.addprice = nil
+	m.clearedFields[productvariant.FieldPrice] = struct{}{}
 }
 
-// SetTotal sets the "total" field.
-func (m *CartMutation) SetTotal(f float64) {
-	m.total = &f
-	m.addtotal = nil
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *ProductVariantMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[productvariant.FieldPrice]
+	return ok
 }
 
-// Total returns the value of the "total" field in the mutation.
-func (m *CartMutation) Total() (r float64, exists bool) {
-	v := m.total
+// ResetPrice resets all changes to the "price" field.
+func (m *ProductVariantMutation) ResetPrice() {
+	m.price = nil
+	m.addprice = nil
+	delete(m.clearedFields, productvariant.FieldPrice)
+}
+
+// SetStock sets the "stock" field.
+func (m *ProductVariantMutation) SetStock(i int) {
+	m.stock = &i
+	m.addstock = nil
+}
+
+// Stock returns the value of the "stock" field in the mutation.
+func (m *ProductVariantMutation) Stock() (r int, exists bool) {
+	v := m.stock
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotal returns the old "total" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldStock returns the old "stock" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldTotal(ctx context.Context) (v float64, err error) {
+func (m *ProductVariantMutation) OldStock(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+		return v, errors.New("OldStock is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotal requires an ID field in the mutation")
+		return v, errors.New("OldStock requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+		return v, fmt.Errorf("querying old value for OldStock: %w", err)
 	}
-	return oldValue.Total, nil
+	return oldValue.Stock, nil
 }
 
-// AddTotal adds f to the "total" field.
-func (m *CartMutation) AddTotal(f float64) {
-	if m.addtotal != nil {
-		*m.addtotal += f
+// AddStock adds i to the "stock" field.
+func (m *ProductVariantMutation) AddStock(i int) {
+	if m.addstock != nil {
+		*m.addstock += i
 	} else {
-		m.addtotal = &f
+		m.addstock = &i
 	}
 }
 
-// AddedTotal returns the value that was added to the "total" field in this mutation.
-func (m *CartMutation) AddedTotal() (r float64, exists bool) {
-	v := m.addtotal
+// AddedStock returns the value that was added to the "stock" field in this mutation.
+func (m *ProductVariantMutation) AddedStock() (r int, exists bool) {
+	v := m.addstock
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotal resets all changes to the "total" field.
-func (m *CartMutation) ResetTotal() {
-	m.total = nil
-	m.addtotal = nil
+// ResetStock resets all changes to the "stock" field.
+func (m *ProductVariantMutation) ResetStock() {
+	m.stock = nil
+	m.addstock = nil
 }
 
-// SetAppliedCoupon sets the "applied_coupon" field.
-func (m *CartMutation) SetAppliedCoupon(b bool) {
-	m.applied_coupon = &b
+// SetImages sets the "images" field.
+func (m *ProductVariantMutation) SetImages(s []string) {
+	m.images = &s
+	m.appendimages = nil
 }
 
-// AppliedCoupon returns the value of the "applied_coupon" field in the mutation.
-func (m *CartMutation) AppliedCoupon() (r bool, exists bool) {
-	v := m.applied_coupon
+// Images returns the value of the "images" field in the mutation.
+func (m *ProductVariantMutation) Images() (r []string, exists bool) {
+	v := m.images
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAppliedCoupon returns the old "applied_coupon" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldImages returns the old "images" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldAppliedCoupon(ctx context.Context) (v bool, err error) {
+func (m *ProductVariantMutation) OldImages(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAppliedCoupon is only allowed on UpdateOne operations")
+		return v, errors.New("OldImages is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAppliedCoupon requires an ID field in the mutation")
+		return v, errors.New("OldImages requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAppliedCoupon: %w", err)
+		return v, fmt.Errorf("querying old value for OldImages: %w", err)
 	}
-	return oldValue.AppliedCoupon, nil
+	return oldValue.Images, nil
 }
 
-// ResetAppliedCoupon resets all changes to the "applied_coupon" field.
-func (m *CartMutation) ResetAppliedCoupon() {
-	m.applied_coupon = nil
+// AppendImages adds s to the "images" field.
+func (m *ProductVariantMutation) AppendImages(s []string) {
+	m.appendimages = append(m.appendimages, s...)
 }
 
-// SetCouponCode sets the "coupon_code" field.
-func (m *CartMutation) SetCouponCode(s string) {
-	m.coupon_code = &s
+// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
+func (m *ProductVariantMutation) AppendedImages() ([]string, bool) {
+	if len(m.appendimages) == 0 {
+		return nil, false
+	}
+	return m.appendimages, true
 }
 
-// CouponCode returns the value of the "coupon_code" field in the mutation.
-func (m *CartMutation) CouponCode() (r string, exists bool) {
-	v := m.coupon_code
+// ResetImages resets all changes to the "images" field.
+func (m *ProductVariantMutation) ResetImages() {
+	m.images = nil
+	m.appendimages = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *ProductVariantMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *ProductVariantMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCouponCode returns the old "coupon_code" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldCouponCode(ctx context.Context) (v string, err error) {
+func (m *ProductVariantMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCouponCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCouponCode requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCouponCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.CouponCode, nil
+	return oldValue.Position, nil
 }
 
-// ClearCouponCode clears the value of the "coupon_code" field.
-func (m *CartMutation) ClearCouponCode() {
-	m.coupon_code = nil
-	m.clearedFields[cart.FieldCouponCode] = struct{}{}
+// AddPosition adds i to the "position" field.
+func (m *ProductVariantMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// CouponCodeCleared returns if the "coupon_code" field was cleared in this mutation.
-func (m *CartMutation) CouponCodeCleared() bool {
-	_, ok := m.clearedFields[cart.FieldCouponCode]
-	return ok
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *ProductVariantMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCouponCode resets all changes to the "coupon_code" field.
-func (m *CartMutation) ResetCouponCode() {
-	m.coupon_code = nil
-	delete(m.clearedFields, cart.FieldCouponCode)
+// ResetPosition resets all changes to the "position" field.
+func (m *ProductVariantMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CartMutation) SetCreatedAt(t time.Time) {
+func (m *ProductVariantMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CartMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProductVariantMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2593,10 +16475,10 @@ func (m *CartMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductVariantMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2611,17 +16493,17 @@ func (m *CartMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CartMutation) ResetCreatedAt() {
+func (m *ProductVariantMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CartMutation) SetUpdatedAt(t time.Time) {
+func (m *ProductVariantMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CartMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ProductVariantMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2629,10 +16511,10 @@ func (m *CartMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Cart entity.
-// If the Cart object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ProductVariant entity.
+// If the ProductVariant object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProductVariantMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2647,39 +16529,39 @@ func (m *CartMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CartMutation) ResetUpdatedAt() {
+func (m *ProductVariantMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *CartMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[cart.FieldUserID] = struct{}{}
+// ClearProduct clears the "product" edge to the Product entity.
+func (m *ProductVariantMutation) ClearProduct() {
+	m.clearedproduct = true
+	m.clearedFields[productvariant.FieldProductID] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *CartMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// ProductCleared reports if the "product" edge to the Product entity was cleared.
+func (m *ProductVariantMutation) ProductCleared() bool {
+	return m.ProductIDCleared() || m.clearedproduct
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// ProductIDs returns the "product" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *CartMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
+// ProductID instead. It exists only for internal usage by the builders.
+func (m *ProductVariantMutation) ProductIDs() (ids []string) {
+	if id := m.product; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *CartMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetProduct resets all changes to the "product" edge.
+func (m *ProductVariantMutation) ResetProduct() {
+	m.product = nil
+	m.clearedproduct = false
 }
 
 // AddCartItemIDs adds the "cart_items" edge to the CartItem entity by ids.
-func (m *CartMutation) AddCartItemIDs(ids ...string) {
+func (m *ProductVariantMutation) AddCartItemIDs(ids ...string) {
 	if m.cart_items == nil {
 		m.cart_items = make(map[string]struct{})
 	}
@@ -2689,17 +16571,17 @@ func (m *CartMutation) AddCartItemIDs(ids ...string) {
 }
 
 // ClearCartItems clears the "cart_items" edge to the CartItem entity.
-func (m *CartMutation) ClearCartItems() {
+func (m *ProductVariantMutation) ClearCartItems() {
 	m.clearedcart_items = true
 }
 
 // CartItemsCleared reports if the "cart_items" edge to the CartItem entity was cleared.
-func (m *CartMutation) CartItemsCleared() bool {
+func (m *ProductVariantMutation) CartItemsCleared() bool {
 	return m.clearedcart_items
 }
 
 // RemoveCartItemIDs removes the "cart_items" edge to the CartItem entity by IDs.
-func (m *CartMutation) RemoveCartItemIDs(ids ...string) {
+func (m *ProductVariantMutation) RemoveCartItemIDs(ids ...string) {
 	if m.removedcart_items == nil {
 		m.removedcart_items = make(map[string]struct{})
 	}
@@ -2710,7 +16592,7 @@ func (m *CartMutation) RemoveCartItemIDs(ids ...string) {
 }
 
 // RemovedCartItems returns the removed IDs of the "cart_items" edge to the CartItem entity.
-func (m *CartMutation) RemovedCartItemsIDs() (ids []string) {
+func (m *ProductVariantMutation) RemovedCartItemsIDs() (ids []string) {
 	for id := range m.removedcart_items {
 		ids = append(ids, id)
 	}
@@ -2718,7 +16600,7 @@ func (m *CartMutation) RemovedCartItemsIDs() (ids []string) {
 }
 
 // CartItemsIDs returns the "cart_items" edge IDs in the mutation.
-func (m *CartMutation) CartItemsIDs() (ids []string) {
+func (m *ProductVariantMutation) CartItemsIDs() (ids []string) {
 	for id := range m.cart_items {
 		ids = append(ids, id)
 	}
@@ -2726,21 +16608,75 @@ func (m *CartMutation) CartItemsIDs() (ids []string) {
 }
 
 // ResetCartItems resets all changes to the "cart_items" edge.
-func (m *CartMutation) ResetCartItems() {
+func (m *ProductVariantMutation) ResetCartItems() {
 	m.cart_items = nil
 	m.clearedcart_items = false
 	m.removedcart_items = nil
 }
 
-// Where appends a list predicates to the CartMutation builder.
-func (m *CartMutation) Where(ps ...predicate.Cart) {
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by ids.
+func (m *ProductVariantMutation) AddOrderItemIDs(ids ...string) {
+	if m.order_items == nil {
+		m.order_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.order_items[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrderItems clears the "order_items" edge to the OrderItem entity.
+func (m *ProductVariantMutation) ClearOrderItems() {
+	m.clearedorder_items = true
+}
+
+// OrderItemsCleared reports if the "order_items" edge to the OrderItem entity was cleared.
+func (m *ProductVariantMutation) OrderItemsCleared() bool {
+	return m.clearedorder_items
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to the OrderItem entity by IDs.
+func (m *ProductVariantMutation) RemoveOrderItemIDs(ids ...string) {
+	if m.removedorder_items == nil {
+		m.removedorder_items = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.order_items, ids[i])
+		m.removedorder_items[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrderItems returns the removed IDs of the "order_items" edge to the OrderItem entity.
+func (m *ProductVariantMutation) RemovedOrderItemsIDs() (ids []string) {
+	for id := range m.removedorder_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrderItemsIDs returns the "order_items" edge IDs in the mutation.
+func (m *ProductVariantMutation) OrderItemsIDs() (ids []string) {
+	for id := range m.order_items {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrderItems resets all changes to the "order_items" edge.
+func (m *ProductVariantMutation) ResetOrderItems() {
+	m.order_items = nil
+	m.clearedorder_items = false
+	m.removedorder_items = nil
+}
+
+// Where appends a list predicates to the ProductVariantMutation builder.
+func (m *ProductVariantMutation) Where(ps ...predicate.ProductVariant) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CartMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProductVariantMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CartMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Cart, len(ps))
+func (m *ProductVariantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProductVariant, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2748,51 +16684,54 @@ func (m *CartMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CartMutation) Op() Op {
+func (m *ProductVariantMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CartMutation) SetOp(op Op) {
+func (m *ProductVariantMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Cart).
-func (m *CartMutation) Type() string {
+// Type returns the node type of this mutation (ProductVariant).
+func (m *ProductVariantMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CartMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.user != nil {
-		fields = append(fields, cart.FieldUserID)
+func (m *ProductVariantMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.product != nil {
+		fields = append(fields, productvariant.FieldProductID)
 	}
-	if m.subtotal != nil {
-		fields = append(fields, cart.FieldSubtotal)
+	if m.sku != nil {
+		fields = append(fields, productvariant.FieldSku)
 	}
-	if m.shipping != nil {
-		fields = append(fields, cart.FieldShipping)
+	if m.options != nil {
+		fields = append(fields, productvariant.FieldOptions)
 	}
-	if m.discount != nil {
-		fields = append(fields, cart.FieldDiscount)
+	if m.combination != nil {
+		fields = append(fields, productvariant.FieldCombination)
 	}
-	if m.total != nil {
-		fields = append(fields, cart.FieldTotal)
+	if m.price != nil {
+		fields = append(fields, productvariant.FieldPrice)
 	}
-	if m.applied_coupon != nil {
-		fields = append(fields, cart.FieldAppliedCoupon)
+	if m.stock != nil {
+		fields = append(fields, productvariant.FieldStock)
 	}
-	if m.coupon_code != nil {
-		fields = append(fields, cart.FieldCouponCode)
+	if m.images != nil {
+		fields = append(fields, productvariant.FieldImages)
+	}
+	if m.position != nil {
+		fields = append(fields, productvariant.FieldPosition)
 	}
 	if m.created_at != nil {
-		fields = append(fields, cart.FieldCreatedAt)
+		fields = append(fields, productvariant.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, cart.FieldUpdatedAt)
+		fields = append(fields, productvariant.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2800,25 +16739,27 @@ func (m *CartMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CartMutation) Field(name string) (ent.Value, bool) {
+func (m *ProductVariantMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cart.FieldUserID:
-		return m.UserID()
-	case cart.FieldSubtotal:
-		return m.Subtotal()
-	case cart.FieldShipping:
-		return m.Shipping()
-	case cart.FieldDiscount:
-		return m.Discount()
-	case cart.FieldTotal:
-		return m.Total()
-	case cart.FieldAppliedCoupon:
-		return m.AppliedCoupon()
-	case cart.FieldCouponCode:
-		return m.CouponCode()
-	case cart.FieldCreatedAt:
+	case productvariant.FieldProductID:
+		return m.ProductID()
+	case productvariant.FieldSku:
+		return m.Sku()
+	case productvariant.FieldOptions:
+		return m.Options()
+	case productvariant.FieldCombination:
+		return m.Combination()
+	case productvariant.FieldPrice:
+		return m.Price()
+	case productvariant.FieldStock:
+		return m.Stock()
+	case productvariant.FieldImages:
+		return m.Images()
+	case productvariant.FieldPosition:
+		return m.Position()
+	case productvariant.FieldCreatedAt:
 		return m.CreatedAt()
-	case cart.FieldUpdatedAt:
+	case productvariant.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2827,92 +16768,101 @@ func (m *CartMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CartMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProductVariantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cart.FieldUserID:
-		return m.OldUserID(ctx)
-	case cart.FieldSubtotal:
-		return m.OldSubtotal(ctx)
-	case cart.FieldShipping:
-		return m.OldShipping(ctx)
-	case cart.FieldDiscount:
-		return m.OldDiscount(ctx)
-	case cart.FieldTotal:
-		return m.OldTotal(ctx)
-	case cart.FieldAppliedCoupon:
-		return m.OldAppliedCoupon(ctx)
-	case cart.FieldCouponCode:
-		return m.OldCouponCode(ctx)
-	case cart.FieldCreatedAt:
+	case productvariant.FieldProductID:
+		return m.OldProductID(ctx)
+	case productvariant.FieldSku:
+		return m.OldSku(ctx)
+	case productvariant.FieldOptions:
+		return m.OldOptions(ctx)
+	case productvariant.FieldCombination:
+		return m.OldCombination(ctx)
+	case productvariant.FieldPrice:
+		return m.OldPrice(ctx)
+	case productvariant.FieldStock:
+		return m.OldStock(ctx)
+	case productvariant.FieldImages:
+		return m.OldImages(ctx)
+	case productvariant.FieldPosition:
+		return m.OldPosition(ctx)
+	case productvariant.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case cart.FieldUpdatedAt:
+	case productvariant.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Cart field %s", name)
+	return nil, fmt.Errorf("unknown ProductVariant field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CartMutation) SetField(name string, value ent.Value) error {
+func (m *ProductVariantMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cart.FieldUserID:
+	case productvariant.FieldProductID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetProductID(v)
 		return nil
-	case cart.FieldSubtotal:
-		v, ok := value.(float64)
+	case productvariant.FieldSku:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSubtotal(v)
+		m.SetSku(v)
 		return nil
-	case cart.FieldShipping:
-		v, ok := value.(float64)
+	case productvariant.FieldOptions:
+		v, ok := value.(map[string]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetShipping(v)
+		m.SetOptions(v)
 		return nil
-	case cart.FieldDiscount:
-		v, ok := value.(float64)
+	case productvariant.FieldCombination:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDiscount(v)
+		m.SetCombination(v)
 		return nil
-	case cart.FieldTotal:
-		v, ok := value.(float64)
+	case productvariant.FieldPrice:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotal(v)
+		m.SetPrice(v)
 		return nil
-	case cart.FieldAppliedCoupon:
-		v, ok := value.(bool)
+	case productvariant.FieldStock:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAppliedCoupon(v)
+		m.SetStock(v)
 		return nil
-	case cart.FieldCouponCode:
-		v, ok := value.(string)
+	case productvariant.FieldImages:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCouponCode(v)
+		m.SetImages(v)
 		return nil
-	case cart.FieldCreatedAt:
+	case productvariant.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case productvariant.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case cart.FieldUpdatedAt:
+	case productvariant.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2920,24 +16870,21 @@ func (m *CartMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Cart field %s", name)
+	return fmt.Errorf("unknown ProductVariant field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CartMutation) AddedFields() []string {
+func (m *ProductVariantMutation) AddedFields() []string {
 	var fields []string
-	if m.addsubtotal != nil {
-		fields = append(fields, cart.FieldSubtotal)
-	}
-	if m.addshipping != nil {
-		fields = append(fields, cart.FieldShipping)
+	if m.addprice != nil {
+		fields = append(fields, productvariant.FieldPrice)
 	}
-	if m.adddiscount != nil {
-		fields = append(fields, cart.FieldDiscount)
+	if m.addstock != nil {
+		fields = append(fields, productvariant.FieldStock)
 	}
-	if m.addtotal != nil {
-		fields = append(fields, cart.FieldTotal)
+	if m.addposition != nil {
+		fields = append(fields, productvariant.FieldPosition)
 	}
 	return fields
 }
@@ -2945,16 +16892,14 @@ func (m *CartMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CartMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProductVariantMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case cart.FieldSubtotal:
-		return m.AddedSubtotal()
-	case cart.FieldShipping:
-		return m.AddedShipping()
-	case cart.FieldDiscount:
-		return m.AddedDiscount()
-	case cart.FieldTotal:
-		return m.AddedTotal()
+	case productvariant.FieldPrice:
+		return m.AddedPrice()
+	case productvariant.FieldStock:
+		return m.AddedStock()
+	case productvariant.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -2962,252 +16907,265 @@ func (m *CartMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CartMutation) AddField(name string, value ent.Value) error {
+func (m *ProductVariantMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cart.FieldSubtotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSubtotal(v)
-		return nil
-	case cart.FieldShipping:
-		v, ok := value.(float64)
+	case productvariant.FieldPrice:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddShipping(v)
+		m.AddPrice(v)
 		return nil
-	case cart.FieldDiscount:
-		v, ok := value.(float64)
+	case productvariant.FieldStock:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddDiscount(v)
+		m.AddStock(v)
 		return nil
-	case cart.FieldTotal:
-		v, ok := value.(float64)
+	case productvariant.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotal(v)
+		m.AddPosition(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Cart numeric field %s", name)
+	return fmt.Errorf("unknown ProductVariant numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CartMutation) ClearedFields() []string {
+func (m *ProductVariantMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(cart.FieldUserID) {
-		fields = append(fields, cart.FieldUserID)
+	if m.FieldCleared(productvariant.FieldProductID) {
+		fields = append(fields, productvariant.FieldProductID)
 	}
-	if m.FieldCleared(cart.FieldShipping) {
-		fields = append(fields, cart.FieldShipping)
-	}
-	if m.FieldCleared(cart.FieldCouponCode) {
-		fields = append(fields, cart.FieldCouponCode)
+	if m.FieldCleared(productvariant.FieldPrice) {
+		fields = append(fields, productvariant.FieldPrice)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CartMutation) FieldCleared(name string) bool {
+func (m *ProductVariantMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *CartMutation) ClearField(name string) error {
-	switch name {
-	case cart.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case cart.FieldShipping:
-		m.ClearShipping()
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProductVariantMutation) ClearField(name string) error {
+	switch name {
+	case productvariant.FieldProductID:
+		m.ClearProductID()
 		return nil
-	case cart.FieldCouponCode:
-		m.ClearCouponCode()
+	case productvariant.FieldPrice:
+		m.ClearPrice()
 		return nil
 	}
-	return fmt.Errorf("unknown Cart nullable field %s", name)
+	return fmt.Errorf("unknown ProductVariant nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CartMutation) ResetField(name string) error {
+func (m *ProductVariantMutation) ResetField(name string) error {
 	switch name {
-	case cart.FieldUserID:
-		m.ResetUserID()
+	case productvariant.FieldProductID:
+		m.ResetProductID()
 		return nil
-	case cart.FieldSubtotal:
-		m.ResetSubtotal()
+	case productvariant.FieldSku:
+		m.ResetSku()
 		return nil
-	case cart.FieldShipping:
-		m.ResetShipping()
+	case productvariant.FieldOptions:
+		m.ResetOptions()
 		return nil
-	case cart.FieldDiscount:
-		m.ResetDiscount()
+	case productvariant.FieldCombination:
+		m.ResetCombination()
 		return nil
-	case cart.FieldTotal:
-		m.ResetTotal()
+	case productvariant.FieldPrice:
+		m.ResetPrice()
 		return nil
-	case cart.FieldAppliedCoupon:
-		m.ResetAppliedCoupon()
+	case productvariant.FieldStock:
+		m.ResetStock()
 		return nil
-	case cart.FieldCouponCode:
-		m.ResetCouponCode()
+	case productvariant.FieldImages:
+		m.ResetImages()
 		return nil
-	case cart.FieldCreatedAt:
+	case productvariant.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case productvariant.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case cart.FieldUpdatedAt:
+	case productvariant.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Cart field %s", name)
+	return fmt.Errorf("unknown ProductVariant field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CartMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, cart.EdgeUser)
+func (m *ProductVariantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.product != nil {
+		edges = append(edges, productvariant.EdgeProduct)
 	}
 	if m.cart_items != nil {
-		edges = append(edges, cart.EdgeCartItems)
+		edges = append(edges, productvariant.EdgeCartItems)
+	}
+	if m.order_items != nil {
+		edges = append(edges, productvariant.EdgeOrderItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CartMutation) AddedIDs(name string) []ent.Value {
+func (m *ProductVariantMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case cart.EdgeUser:
-		if id := m.user; id != nil {
+	case productvariant.EdgeProduct:
+		if id := m.product; id != nil {
 			return []ent.Value{*id}
 		}
-	case cart.EdgeCartItems:
+	case productvariant.EdgeCartItems:
 		ids := make([]ent.Value, 0, len(m.cart_items))
 		for id := range m.cart_items {
 			ids = append(ids, id)
 		}
 		return ids
+	case productvariant.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.order_items))
+		for id := range m.order_items {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CartMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ProductVariantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.removedcart_items != nil {
-		edges = append(edges, cart.EdgeCartItems)
+		edges = append(edges, productvariant.EdgeCartItems)
+	}
+	if m.removedorder_items != nil {
+		edges = append(edges, productvariant.EdgeOrderItems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CartMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProductVariantMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case cart.EdgeCartItems:
+	case productvariant.EdgeCartItems:
 		ids := make([]ent.Value, 0, len(m.removedcart_items))
 		for id := range m.removedcart_items {
 			ids = append(ids, id)
 		}
 		return ids
+	case productvariant.EdgeOrderItems:
+		ids := make([]ent.Value, 0, len(m.removedorder_items))
+		for id := range m.removedorder_items {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CartMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, cart.EdgeUser)
+func (m *ProductVariantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedproduct {
+		edges = append(edges, productvariant.EdgeProduct)
 	}
 	if m.clearedcart_items {
-		edges = append(edges, cart.EdgeCartItems)
+		edges = append(edges, productvariant.EdgeCartItems)
+	}
+	if m.clearedorder_items {
+		edges = append(edges, productvariant.EdgeOrderItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CartMutation) EdgeCleared(name string) bool {
+func (m *ProductVariantMutation) EdgeCleared(name string) bool {
 	switch name {
-	case cart.EdgeUser:
-		return m.cleareduser
-	case cart.EdgeCartItems:
+	case productvariant.EdgeProduct:
+		return m.clearedproduct
+	case productvariant.EdgeCartItems:
 		return m.clearedcart_items
+	case productvariant.EdgeOrderItems:
+		return m.clearedorder_items
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CartMutation) ClearEdge(name string) error {
+func (m *ProductVariantMutation) ClearEdge(name string) error {
 	switch name {
-	case cart.EdgeUser:
-		m.ClearUser()
+	case productvariant.EdgeProduct:
+		m.ClearProduct()
 		return nil
 	}
-	return fmt.Errorf("unknown Cart unique edge %s", name)
+	return fmt.Errorf("unknown ProductVariant unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CartMutation) ResetEdge(name string) error {
+func (m *ProductVariantMutation) ResetEdge(name string) error {
 	switch name {
-	case cart.EdgeUser:
-		m.ResetUser()
+	case productvariant.EdgeProduct:
+		m.ResetProduct()
 		return nil
-	case cart.EdgeCartItems:
+	case productvariant.EdgeCartItems:
 		m.ResetCartItems()
 		return nil
+	case productvariant.EdgeOrderItems:
+		m.ResetOrderItems()
+		return nil
 	}
-	return fmt.Errorf("unknown Cart edge %s", name)
+	return fmt.Errorf("unknown ProductVariant edge %s", name)
 }
 
-// CartItemMutation represents an operation that mutates the CartItem nodes in the graph.
-type CartItemMutation struct {
+// ReturnItemMutation represents an operation that mutates the ReturnItem nodes in the graph.
+type ReturnItemMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *string
-	name           *string
-	price          *float64
-	addprice       *float64
-	image          *string
+	order_item_id  *string
+	product_id     *string
+	variant_id     *string
 	quantity       *int
 	addquantity    *int
-	created_at     *time.Time
-	updated_at     *time.Time
+	unit_price     *money.Amount
+	addunit_price  *money.Amount
 	clearedFields  map[string]struct{}
-	cart           *string
-	clearedcart    bool
-	product        *string
-	clearedproduct bool
+	_return        *string
+	cleared_return bool
 	done           bool
-	oldValue       func(context.Context) (*CartItem, error)
-	predicates     []predicate.CartItem
+	oldValue       func(context.Context) (*ReturnItem, error)
+	predicates     []predicate.ReturnItem
 }
 
-var _ ent.Mutation = (*CartItemMutation)(nil)
+var _ ent.Mutation = (*ReturnItemMutation)(nil)
 
-// cartitemOption allows management of the mutation configuration using functional options.
-type cartitemOption func(*CartItemMutation)
+// returnitemOption allows management of the mutation configuration using functional options.
+type returnitemOption func(*ReturnItemMutation)
 
-// newCartItemMutation creates new mutation for the CartItem entity.
-func newCartItemMutation(c config, op Op, opts ...cartitemOption) *CartItemMutation {
-	m := &CartItemMutation{
+// newReturnItemMutation creates new mutation for the ReturnItem entity.
+func newReturnItemMutation(c config, op Op, opts ...returnitemOption) *ReturnItemMutation {
+	m := &ReturnItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCartItem,
+		typ:           TypeReturnItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3216,20 +17174,20 @@ func newCartItemMutation(c config, op Op, opts ...cartitemOption) *CartItemMutat
 	return m
 }
 
-// withCartItemID sets the ID field of the mutation.
-func withCartItemID(id string) cartitemOption {
-	return func(m *CartItemMutation) {
+// withReturnItemID sets the ID field of the mutation.
+func withReturnItemID(id string) returnitemOption {
+	return func(m *ReturnItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *CartItem
+			value *ReturnItem
 		)
-		m.oldValue = func(ctx context.Context) (*CartItem, error) {
+		m.oldValue = func(ctx context.Context) (*ReturnItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().CartItem.Get(ctx, id)
+					value, err = m.Client().ReturnItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3238,10 +17196,10 @@ func withCartItemID(id string) cartitemOption {
 	}
 }
 
-// withCartItem sets the old CartItem of the mutation.
-func withCartItem(node *CartItem) cartitemOption {
-	return func(m *CartItemMutation) {
-		m.oldValue = func(context.Context) (*CartItem, error) {
+// withReturnItem sets the old ReturnItem of the mutation.
+func withReturnItem(node *ReturnItem) returnitemOption {
+	return func(m *ReturnItemMutation) {
+		m.oldValue = func(context.Context) (*ReturnItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3250,7 +17208,7 @@ func withCartItem(node *CartItem) cartitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CartItemMutation) Client() *Client {
+func (m ReturnItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3258,7 +17216,7 @@ func (m CartItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CartItemMutation) Tx() (*Tx, error) {
+func (m ReturnItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3268,14 +17226,14 @@ func (m CartItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of CartItem entities.
-func (m *CartItemMutation) SetID(id string) {
+// operation is only accepted on creation of ReturnItem entities.
+func (m *ReturnItemMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CartItemMutation) ID() (id string, exists bool) {
+func (m *ReturnItemMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3286,7 +17244,7 @@ func (m *CartItemMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CartItemMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ReturnItemMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3295,246 +17253,203 @@ func (m *CartItemMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().CartItem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ReturnItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCartID sets the "cart_id" field.
-func (m *CartItemMutation) SetCartID(s string) {
-	m.cart = &s
-}
-
-// CartID returns the value of the "cart_id" field in the mutation.
-func (m *CartItemMutation) CartID() (r string, exists bool) {
-	v := m.cart
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCartID returns the old "cart_id" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldCartID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCartID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCartID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCartID: %w", err)
-	}
-	return oldValue.CartID, nil
-}
-
-// ClearCartID clears the value of the "cart_id" field.
-func (m *CartItemMutation) ClearCartID() {
-	m.cart = nil
-	m.clearedFields[cartitem.FieldCartID] = struct{}{}
-}
-
-// CartIDCleared returns if the "cart_id" field was cleared in this mutation.
-func (m *CartItemMutation) CartIDCleared() bool {
-	_, ok := m.clearedFields[cartitem.FieldCartID]
-	return ok
-}
-
-// ResetCartID resets all changes to the "cart_id" field.
-func (m *CartItemMutation) ResetCartID() {
-	m.cart = nil
-	delete(m.clearedFields, cartitem.FieldCartID)
-}
-
-// SetProductID sets the "product_id" field.
-func (m *CartItemMutation) SetProductID(s string) {
-	m.product = &s
+// SetReturnID sets the "return_id" field.
+func (m *ReturnItemMutation) SetReturnID(s string) {
+	m._return = &s
 }
 
-// ProductID returns the value of the "product_id" field in the mutation.
-func (m *CartItemMutation) ProductID() (r string, exists bool) {
-	v := m.product
+// ReturnID returns the value of the "return_id" field in the mutation.
+func (m *ReturnItemMutation) ReturnID() (r string, exists bool) {
+	v := m._return
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProductID returns the old "product_id" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldReturnID returns the old "return_id" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldProductID(ctx context.Context) (v string, err error) {
+func (m *ReturnItemMutation) OldReturnID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+		return v, errors.New("OldReturnID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductID requires an ID field in the mutation")
+		return v, errors.New("OldReturnID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+		return v, fmt.Errorf("querying old value for OldReturnID: %w", err)
 	}
-	return oldValue.ProductID, nil
+	return oldValue.ReturnID, nil
 }
 
-// ClearProductID clears the value of the "product_id" field.
-func (m *CartItemMutation) ClearProductID() {
-	m.product = nil
-	m.clearedFields[cartitem.FieldProductID] = struct{}{}
+// ClearReturnID clears the value of the "return_id" field.
+func (m *ReturnItemMutation) ClearReturnID() {
+	m._return = nil
+	m.clearedFields[returnitem.FieldReturnID] = struct{}{}
 }
 
-// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
-func (m *CartItemMutation) ProductIDCleared() bool {
-	_, ok := m.clearedFields[cartitem.FieldProductID]
+// ReturnIDCleared returns if the "return_id" field was cleared in this mutation.
+func (m *ReturnItemMutation) ReturnIDCleared() bool {
+	_, ok := m.clearedFields[returnitem.FieldReturnID]
 	return ok
 }
 
-// ResetProductID resets all changes to the "product_id" field.
-func (m *CartItemMutation) ResetProductID() {
-	m.product = nil
-	delete(m.clearedFields, cartitem.FieldProductID)
+// ResetReturnID resets all changes to the "return_id" field.
+func (m *ReturnItemMutation) ResetReturnID() {
+	m._return = nil
+	delete(m.clearedFields, returnitem.FieldReturnID)
 }
 
-// SetName sets the "name" field.
-func (m *CartItemMutation) SetName(s string) {
-	m.name = &s
+// SetOrderItemID sets the "order_item_id" field.
+func (m *ReturnItemMutation) SetOrderItemID(s string) {
+	m.order_item_id = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CartItemMutation) Name() (r string, exists bool) {
-	v := m.name
+// OrderItemID returns the value of the "order_item_id" field in the mutation.
+func (m *ReturnItemMutation) OrderItemID() (r string, exists bool) {
+	v := m.order_item_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderItemID returns the old "order_item_id" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ReturnItemMutation) OldOrderItemID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldOrderItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderItemID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.OrderItemID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CartItemMutation) ResetName() {
-	m.name = nil
+// ResetOrderItemID resets all changes to the "order_item_id" field.
+func (m *ReturnItemMutation) ResetOrderItemID() {
+	m.order_item_id = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *CartItemMutation) SetPrice(f float64) {
-	m.price = &f
-	m.addprice = nil
+// SetProductID sets the "product_id" field.
+func (m *ReturnItemMutation) SetProductID(s string) {
+	m.product_id = &s
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *CartItemMutation) Price() (r float64, exists bool) {
-	v := m.price
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *ReturnItemMutation) ProductID() (r string, exists bool) {
+	v := m.product_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldProductID returns the old "product_id" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldPrice(ctx context.Context) (v float64, err error) {
+func (m *ReturnItemMutation) OldProductID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldProductID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.ProductID, nil
 }
 
-// AddPrice adds f to the "price" field.
-func (m *CartItemMutation) AddPrice(f float64) {
-	if m.addprice != nil {
-		*m.addprice += f
-	} else {
-		m.addprice = &f
-	}
+// ClearProductID clears the value of the "product_id" field.
+func (m *ReturnItemMutation) ClearProductID() {
+	m.product_id = nil
+	m.clearedFields[returnitem.FieldProductID] = struct{}{}
 }
 
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *CartItemMutation) AddedPrice() (r float64, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
-	}
-	return *v, true
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *ReturnItemMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[returnitem.FieldProductID]
+	return ok
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *CartItemMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// ResetProductID resets all changes to the "product_id" field.
+func (m *ReturnItemMutation) ResetProductID() {
+	m.product_id = nil
+	delete(m.clearedFields, returnitem.FieldProductID)
 }
 
-// SetImage sets the "image" field.
-func (m *CartItemMutation) SetImage(s string) {
-	m.image = &s
+// SetVariantID sets the "variant_id" field.
+func (m *ReturnItemMutation) SetVariantID(s string) {
+	m.variant_id = &s
 }
 
-// Image returns the value of the "image" field in the mutation.
-func (m *CartItemMutation) Image() (r string, exists bool) {
-	v := m.image
+// VariantID returns the value of the "variant_id" field in the mutation.
+func (m *ReturnItemMutation) VariantID() (r string, exists bool) {
+	v := m.variant_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImage returns the old "image" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldVariantID returns the old "variant_id" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldImage(ctx context.Context) (v string, err error) {
+func (m *ReturnItemMutation) OldVariantID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldVariantID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImage requires an ID field in the mutation")
+		return v, errors.New("OldVariantID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldVariantID: %w", err)
 	}
-	return oldValue.Image, nil
+	return oldValue.VariantID, nil
 }
 
-// ResetImage resets all changes to the "image" field.
-func (m *CartItemMutation) ResetImage() {
-	m.image = nil
+// ClearVariantID clears the value of the "variant_id" field.
+func (m *ReturnItemMutation) ClearVariantID() {
+	m.variant_id = nil
+	m.clearedFields[returnitem.FieldVariantID] = struct{}{}
+}
+
+// VariantIDCleared returns if the "variant_id" field was cleared in this mutation.
+func (m *ReturnItemMutation) VariantIDCleared() bool {
+	_, ok := m.clearedFields[returnitem.FieldVariantID]
+	return ok
+}
+
+// ResetVariantID resets all changes to the "variant_id" field.
+func (m *ReturnItemMutation) ResetVariantID() {
+	m.variant_id = nil
+	delete(m.clearedFields, returnitem.FieldVariantID)
 }
 
 // SetQuantity sets the "quantity" field.
-func (m *CartItemMutation) SetQuantity(i int) {
+func (m *ReturnItemMutation) SetQuantity(i int) {
 	m.quantity = &i
 	m.addquantity = nil
 }
 
 // Quantity returns the value of the "quantity" field in the mutation.
-func (m *CartItemMutation) Quantity() (r int, exists bool) {
+func (m *ReturnItemMutation) Quantity() (r int, exists bool) {
 	v := m.quantity
 	if v == nil {
 		return
@@ -3542,10 +17457,10 @@ func (m *CartItemMutation) Quantity() (r int, exists bool) {
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldQuantity returns the old "quantity" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+func (m *ReturnItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
@@ -3560,7 +17475,7 @@ func (m *CartItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
 }
 
 // AddQuantity adds i to the "quantity" field.
-func (m *CartItemMutation) AddQuantity(i int) {
+func (m *ReturnItemMutation) AddQuantity(i int) {
 	if m.addquantity != nil {
 		*m.addquantity += i
 	} else {
@@ -3569,7 +17484,7 @@ func (m *CartItemMutation) AddQuantity(i int) {
 }
 
 // AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *CartItemMutation) AddedQuantity() (r int, exists bool) {
+func (m *ReturnItemMutation) AddedQuantity() (r int, exists bool) {
 	v := m.addquantity
 	if v == nil {
 		return
@@ -3578,146 +17493,103 @@ func (m *CartItemMutation) AddedQuantity() (r int, exists bool) {
 }
 
 // ResetQuantity resets all changes to the "quantity" field.
-func (m *CartItemMutation) ResetQuantity() {
+func (m *ReturnItemMutation) ResetQuantity() {
 	m.quantity = nil
 	m.addquantity = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *CartItemMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUnitPrice sets the "unit_price" field.
+func (m *ReturnItemMutation) SetUnitPrice(value money.Amount) {
+	m.unit_price = &value
+	m.addunit_price = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CartItemMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UnitPrice returns the value of the "unit_price" field in the mutation.
+func (m *ReturnItemMutation) UnitPrice() (r money.Amount, exists bool) {
+	v := m.unit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
+// OldUnitPrice returns the old "unit_price" field's value of the ReturnItem entity.
+// If the ReturnItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReturnItemMutation) OldUnitPrice(ctx context.Context) (v money.Amount, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUnitPrice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUnitPrice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUnitPrice: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CartItemMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.UnitPrice, nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CartItemMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddUnitPrice adds value to the "unit_price" field.
+func (m *ReturnItemMutation) AddUnitPrice(value money.Amount) {
+	if m.addunit_price != nil {
+		*m.addunit_price += value
+	} else {
+		m.addunit_price = &value
+	}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CartItemMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// AddedUnitPrice returns the value that was added to the "unit_price" field in this mutation.
+func (m *ReturnItemMutation) AddedUnitPrice() (r money.Amount, exists bool) {
+	v := m.addunit_price
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the CartItem entity.
-// If the CartItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CartItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CartItemMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearCart clears the "cart" edge to the Cart entity.
-func (m *CartItemMutation) ClearCart() {
-	m.clearedcart = true
-	m.clearedFields[cartitem.FieldCartID] = struct{}{}
-}
-
-// CartCleared reports if the "cart" edge to the Cart entity was cleared.
-func (m *CartItemMutation) CartCleared() bool {
-	return m.CartIDCleared() || m.clearedcart
-}
-
-// CartIDs returns the "cart" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CartID instead. It exists only for internal usage by the builders.
-func (m *CartItemMutation) CartIDs() (ids []string) {
-	if id := m.cart; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetCart resets all changes to the "cart" edge.
-func (m *CartItemMutation) ResetCart() {
-	m.cart = nil
-	m.clearedcart = false
+// ResetUnitPrice resets all changes to the "unit_price" field.
+func (m *ReturnItemMutation) ResetUnitPrice() {
+	m.unit_price = nil
+	m.addunit_price = nil
 }
 
-// ClearProduct clears the "product" edge to the Product entity.
-func (m *CartItemMutation) ClearProduct() {
-	m.clearedproduct = true
-	m.clearedFields[cartitem.FieldProductID] = struct{}{}
+// ClearReturn clears the "return" edge to the ReturnRequest entity.
+func (m *ReturnItemMutation) ClearReturn() {
+	m.cleared_return = true
+	m.clearedFields[returnitem.FieldReturnID] = struct{}{}
 }
 
-// ProductCleared reports if the "product" edge to the Product entity was cleared.
-func (m *CartItemMutation) ProductCleared() bool {
-	return m.ProductIDCleared() || m.clearedproduct
+// ReturnCleared reports if the "return" edge to the ReturnRequest entity was cleared.
+func (m *ReturnItemMutation) ReturnCleared() bool {
+	return m.ReturnIDCleared() || m.cleared_return
 }
 
-// ProductIDs returns the "product" edge IDs in the mutation.
+// ReturnIDs returns the "return" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProductID instead. It exists only for internal usage by the builders.
-func (m *CartItemMutation) ProductIDs() (ids []string) {
-	if id := m.product; id != nil {
+// ReturnID instead. It exists only for internal usage by the builders.
+func (m *ReturnItemMutation) ReturnIDs() (ids []string) {
+	if id := m._return; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProduct resets all changes to the "product" edge.
-func (m *CartItemMutation) ResetProduct() {
-	m.product = nil
-	m.clearedproduct = false
+// ResetReturn resets all changes to the "return" edge.
+func (m *ReturnItemMutation) ResetReturn() {
+	m._return = nil
+	m.cleared_return = false
 }
 
-// Where appends a list predicates to the CartItemMutation builder.
-func (m *CartItemMutation) Where(ps ...predicate.CartItem) {
+// Where appends a list predicates to the ReturnItemMutation builder.
+func (m *ReturnItemMutation) Where(ps ...predicate.ReturnItem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CartItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReturnItemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CartItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.CartItem, len(ps))
+func (m *ReturnItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReturnItem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3725,48 +17597,42 @@ func (m *CartItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CartItemMutation) Op() Op {
+func (m *ReturnItemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CartItemMutation) SetOp(op Op) {
+func (m *ReturnItemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (CartItem).
-func (m *CartItemMutation) Type() string {
+// Type returns the node type of this mutation (ReturnItem).
+func (m *ReturnItemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CartItemMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.cart != nil {
-		fields = append(fields, cartitem.FieldCartID)
-	}
-	if m.product != nil {
-		fields = append(fields, cartitem.FieldProductID)
+func (m *ReturnItemMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m._return != nil {
+		fields = append(fields, returnitem.FieldReturnID)
 	}
-	if m.name != nil {
-		fields = append(fields, cartitem.FieldName)
+	if m.order_item_id != nil {
+		fields = append(fields, returnitem.FieldOrderItemID)
 	}
-	if m.price != nil {
-		fields = append(fields, cartitem.FieldPrice)
+	if m.product_id != nil {
+		fields = append(fields, returnitem.FieldProductID)
 	}
-	if m.image != nil {
-		fields = append(fields, cartitem.FieldImage)
+	if m.variant_id != nil {
+		fields = append(fields, returnitem.FieldVariantID)
 	}
 	if m.quantity != nil {
-		fields = append(fields, cartitem.FieldQuantity)
-	}
-	if m.created_at != nil {
-		fields = append(fields, cartitem.FieldCreatedAt)
+		fields = append(fields, returnitem.FieldQuantity)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, cartitem.FieldUpdatedAt)
+	if m.unit_price != nil {
+		fields = append(fields, returnitem.FieldUnitPrice)
 	}
 	return fields
 }
@@ -3774,24 +17640,20 @@ func (m *CartItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CartItemMutation) Field(name string) (ent.Value, bool) {
+func (m *ReturnItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case cartitem.FieldCartID:
-		return m.CartID()
-	case cartitem.FieldProductID:
+	case returnitem.FieldReturnID:
+		return m.ReturnID()
+	case returnitem.FieldOrderItemID:
+		return m.OrderItemID()
+	case returnitem.FieldProductID:
 		return m.ProductID()
-	case cartitem.FieldName:
-		return m.Name()
-	case cartitem.FieldPrice:
-		return m.Price()
-	case cartitem.FieldImage:
-		return m.Image()
-	case cartitem.FieldQuantity:
+	case returnitem.FieldVariantID:
+		return m.VariantID()
+	case returnitem.FieldQuantity:
 		return m.Quantity()
-	case cartitem.FieldCreatedAt:
-		return m.CreatedAt()
-	case cartitem.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case returnitem.FieldUnitPrice:
+		return m.UnitPrice()
 	}
 	return nil, false
 }
@@ -3799,102 +17661,84 @@ func (m *CartItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CartItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReturnItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case cartitem.FieldCartID:
-		return m.OldCartID(ctx)
-	case cartitem.FieldProductID:
+	case returnitem.FieldReturnID:
+		return m.OldReturnID(ctx)
+	case returnitem.FieldOrderItemID:
+		return m.OldOrderItemID(ctx)
+	case returnitem.FieldProductID:
 		return m.OldProductID(ctx)
-	case cartitem.FieldName:
-		return m.OldName(ctx)
-	case cartitem.FieldPrice:
-		return m.OldPrice(ctx)
-	case cartitem.FieldImage:
-		return m.OldImage(ctx)
-	case cartitem.FieldQuantity:
+	case returnitem.FieldVariantID:
+		return m.OldVariantID(ctx)
+	case returnitem.FieldQuantity:
 		return m.OldQuantity(ctx)
-	case cartitem.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case cartitem.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case returnitem.FieldUnitPrice:
+		return m.OldUnitPrice(ctx)
 	}
-	return nil, fmt.Errorf("unknown CartItem field %s", name)
+	return nil, fmt.Errorf("unknown ReturnItem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CartItemMutation) SetField(name string, value ent.Value) error {
+func (m *ReturnItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case cartitem.FieldCartID:
+	case returnitem.FieldReturnID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCartID(v)
+		m.SetReturnID(v)
 		return nil
-	case cartitem.FieldProductID:
+	case returnitem.FieldOrderItemID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProductID(v)
+		m.SetOrderItemID(v)
 		return nil
-	case cartitem.FieldName:
+	case returnitem.FieldProductID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case cartitem.FieldPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPrice(v)
+		m.SetProductID(v)
 		return nil
-	case cartitem.FieldImage:
+	case returnitem.FieldVariantID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImage(v)
+		m.SetVariantID(v)
 		return nil
-	case cartitem.FieldQuantity:
+	case returnitem.FieldQuantity:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetQuantity(v)
 		return nil
-	case cartitem.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case cartitem.FieldUpdatedAt:
-		v, ok := value.(time.Time)
+	case returnitem.FieldUnitPrice:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetUnitPrice(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CartItem field %s", name)
+	return fmt.Errorf("unknown ReturnItem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CartItemMutation) AddedFields() []string {
+func (m *ReturnItemMutation) AddedFields() []string {
 	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, cartitem.FieldPrice)
-	}
 	if m.addquantity != nil {
-		fields = append(fields, cartitem.FieldQuantity)
+		fields = append(fields, returnitem.FieldQuantity)
+	}
+	if m.addunit_price != nil {
+		fields = append(fields, returnitem.FieldUnitPrice)
 	}
 	return fields
 }
@@ -3902,12 +17746,12 @@ func (m *CartItemMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CartItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReturnItemMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case cartitem.FieldPrice:
-		return m.AddedPrice()
-	case cartitem.FieldQuantity:
+	case returnitem.FieldQuantity:
 		return m.AddedQuantity()
+	case returnitem.FieldUnitPrice:
+		return m.AddedUnitPrice()
 	}
 	return nil, false
 }
@@ -3915,114 +17759,107 @@ func (m *CartItemMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CartItemMutation) AddField(name string, value ent.Value) error {
+func (m *ReturnItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case cartitem.FieldPrice:
-		v, ok := value.(float64)
+	case returnitem.FieldQuantity:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPrice(v)
+		m.AddQuantity(v)
 		return nil
-	case cartitem.FieldQuantity:
-		v, ok := value.(int)
+	case returnitem.FieldUnitPrice:
+		v, ok := value.(money.Amount)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuantity(v)
+		m.AddUnitPrice(v)
 		return nil
 	}
-	return fmt.Errorf("unknown CartItem numeric field %s", name)
+	return fmt.Errorf("unknown ReturnItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CartItemMutation) ClearedFields() []string {
+func (m *ReturnItemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(cartitem.FieldCartID) {
-		fields = append(fields, cartitem.FieldCartID)
+	if m.FieldCleared(returnitem.FieldReturnID) {
+		fields = append(fields, returnitem.FieldReturnID)
 	}
-	if m.FieldCleared(cartitem.FieldProductID) {
-		fields = append(fields, cartitem.FieldProductID)
+	if m.FieldCleared(returnitem.FieldProductID) {
+		fields = append(fields, returnitem.FieldProductID)
+	}
+	if m.FieldCleared(returnitem.FieldVariantID) {
+		fields = append(fields, returnitem.FieldVariantID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CartItemMutation) FieldCleared(name string) bool {
+func (m *ReturnItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CartItemMutation) ClearField(name string) error {
+func (m *ReturnItemMutation) ClearField(name string) error {
 	switch name {
-	case cartitem.FieldCartID:
-		m.ClearCartID()
+	case returnitem.FieldReturnID:
+		m.ClearReturnID()
 		return nil
-	case cartitem.FieldProductID:
+	case returnitem.FieldProductID:
 		m.ClearProductID()
 		return nil
+	case returnitem.FieldVariantID:
+		m.ClearVariantID()
+		return nil
 	}
-	return fmt.Errorf("unknown CartItem nullable field %s", name)
+	return fmt.Errorf("unknown ReturnItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CartItemMutation) ResetField(name string) error {
+func (m *ReturnItemMutation) ResetField(name string) error {
 	switch name {
-	case cartitem.FieldCartID:
-		m.ResetCartID()
-		return nil
-	case cartitem.FieldProductID:
-		m.ResetProductID()
+	case returnitem.FieldReturnID:
+		m.ResetReturnID()
 		return nil
-	case cartitem.FieldName:
-		m.ResetName()
+	case returnitem.FieldOrderItemID:
+		m.ResetOrderItemID()
 		return nil
-	case cartitem.FieldPrice:
-		m.ResetPrice()
+	case returnitem.FieldProductID:
+		m.ResetProductID()
 		return nil
-	case cartitem.FieldImage:
-		m.ResetImage()
+	case returnitem.FieldVariantID:
+		m.ResetVariantID()
 		return nil
-	case cartitem.FieldQuantity:
+	case returnitem.FieldQuantity:
 		m.ResetQuantity()
 		return nil
-	case cartitem.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case cartitem.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown CartItem field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CartItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cart != nil {
-		edges = append(edges, cartitem.EdgeCart)
+	case returnitem.FieldUnitPrice:
+		m.ResetUnitPrice()
+		return nil
 	}
-	if m.product != nil {
-		edges = append(edges, cartitem.EdgeProduct)
+	return fmt.Errorf("unknown ReturnItem field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ReturnItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m._return != nil {
+		edges = append(edges, returnitem.EdgeReturn)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CartItemMutation) AddedIDs(name string) []ent.Value {
+func (m *ReturnItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case cartitem.EdgeCart:
-		if id := m.cart; id != nil {
-			return []ent.Value{*id}
-		}
-	case cartitem.EdgeProduct:
-		if id := m.product; id != nil {
+	case returnitem.EdgeReturn:
+		if id := m._return; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4030,100 +17867,96 @@ func (m *CartItemMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CartItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ReturnItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CartItemMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReturnItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CartItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedcart {
-		edges = append(edges, cartitem.EdgeCart)
-	}
-	if m.clearedproduct {
-		edges = append(edges, cartitem.EdgeProduct)
+func (m *ReturnItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleared_return {
+		edges = append(edges, returnitem.EdgeReturn)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CartItemMutation) EdgeCleared(name string) bool {
+func (m *ReturnItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case cartitem.EdgeCart:
-		return m.clearedcart
-	case cartitem.EdgeProduct:
-		return m.clearedproduct
+	case returnitem.EdgeReturn:
+		return m.cleared_return
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CartItemMutation) ClearEdge(name string) error {
+func (m *ReturnItemMutation) ClearEdge(name string) error {
 	switch name {
-	case cartitem.EdgeCart:
-		m.ClearCart()
-		return nil
-	case cartitem.EdgeProduct:
-		m.ClearProduct()
+	case returnitem.EdgeReturn:
+		m.ClearReturn()
 		return nil
 	}
-	return fmt.Errorf("unknown CartItem unique edge %s", name)
+	return fmt.Errorf("unknown ReturnItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CartItemMutation) ResetEdge(name string) error {
+func (m *ReturnItemMutation) ResetEdge(name string) error {
 	switch name {
-	case cartitem.EdgeCart:
-		m.ResetCart()
-		return nil
-	case cartitem.EdgeProduct:
-		m.ResetProduct()
+	case returnitem.EdgeReturn:
+		m.ResetReturn()
 		return nil
 	}
-	return fmt.Errorf("unknown CartItem edge %s", name)
+	return fmt.Errorf("unknown ReturnItem edge %s", name)
 }
 
-// CategoryMutation represents an operation that mutates the Category nodes in the graph.
-type CategoryMutation struct {
+// ReturnRequestMutation represents an operation that mutates the ReturnRequest nodes in the graph.
+type ReturnRequestMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	name            *string
-	slug            *string
-	image           *string
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	products        map[string]struct{}
-	removedproducts map[string]struct{}
-	clearedproducts bool
-	done            bool
-	oldValue        func(context.Context) (*Category, error)
-	predicates      []predicate.Category
-}
-
-var _ ent.Mutation = (*CategoryMutation)(nil)
-
-// categoryOption allows management of the mutation configuration using functional options.
-type categoryOption func(*CategoryMutation)
-
-// newCategoryMutation creates new mutation for the Category entity.
-func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
-	m := &CategoryMutation{
+	op               Op
+	typ              string
+	id               *string
+	user_id          *string
+	status           *returnrequest.Status
+	reason           *string
+	refund_amount    *money.Amount
+	addrefund_amount *money.Amount
+	reviewed_by      *string
+	review_note      *string
+	reviewed_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	_order           *string
+	cleared_order    bool
+	items            map[string]struct{}
+	removeditems     map[string]struct{}
+	cleareditems     bool
+	done             bool
+	oldValue         func(context.Context) (*ReturnRequest, error)
+	predicates       []predicate.ReturnRequest
+}
+
+var _ ent.Mutation = (*ReturnRequestMutation)(nil)
+
+// returnrequestOption allows management of the mutation configuration using functional options.
+type returnrequestOption func(*ReturnRequestMutation)
+
+// newReturnRequestMutation creates new mutation for the ReturnRequest entity.
+func newReturnRequestMutation(c config, op Op, opts ...returnrequestOption) *ReturnRequestMutation {
+	m := &ReturnRequestMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCategory,
+		typ:           TypeReturnRequest,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4132,20 +17965,20 @@ func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutat
 	return m
 }
 
-// withCategoryID sets the ID field of the mutation.
-func withCategoryID(id string) categoryOption {
-	return func(m *CategoryMutation) {
+// withReturnRequestID sets the ID field of the mutation.
+func withReturnRequestID(id string) returnrequestOption {
+	return func(m *ReturnRequestMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Category
+			value *ReturnRequest
 		)
-		m.oldValue = func(ctx context.Context) (*Category, error) {
+		m.oldValue = func(ctx context.Context) (*ReturnRequest, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Category.Get(ctx, id)
+					value, err = m.Client().ReturnRequest.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4154,10 +17987,10 @@ func withCategoryID(id string) categoryOption {
 	}
 }
 
-// withCategory sets the old Category of the mutation.
-func withCategory(node *Category) categoryOption {
-	return func(m *CategoryMutation) {
-		m.oldValue = func(context.Context) (*Category, error) {
+// withReturnRequest sets the old ReturnRequest of the mutation.
+func withReturnRequest(node *ReturnRequest) returnrequestOption {
+	return func(m *ReturnRequestMutation) {
+		m.oldValue = func(context.Context) (*ReturnRequest, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4166,7 +17999,7 @@ func withCategory(node *Category) categoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CategoryMutation) Client() *Client {
+func (m ReturnRequestMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4174,7 +18007,7 @@ func (m CategoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CategoryMutation) Tx() (*Tx, error) {
+func (m ReturnRequestMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4184,167 +18017,432 @@ func (m CategoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Category entities.
-func (m *CategoryMutation) SetID(id string) {
+// operation is only accepted on creation of ReturnRequest entities.
+func (m *ReturnRequestMutation) SetID(id string) {
 	m.id = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *CategoryMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ReturnRequestMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ReturnRequestMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ReturnRequest.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrderID sets the "order_id" field.
+func (m *ReturnRequestMutation) SetOrderID(s string) {
+	m._order = &s
+}
+
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *ReturnRequestMutation) OrderID() (r string, exists bool) {
+	v := m._order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderID returns the old "order_id" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReturnRequestMutation) OldOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+	}
+	return oldValue.OrderID, nil
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (m *ReturnRequestMutation) ClearOrderID() {
+	m._order = nil
+	m.clearedFields[returnrequest.FieldOrderID] = struct{}{}
+}
+
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *ReturnRequestMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldOrderID]
+	return ok
+}
+
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *ReturnRequestMutation) ResetOrderID() {
+	m._order = nil
+	delete(m.clearedFields, returnrequest.FieldOrderID)
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ReturnRequestMutation) SetUserID(s string) {
+	m.user_id = &s
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ReturnRequestMutation) UserID() (r string, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReturnRequestMutation) OldUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *ReturnRequestMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[returnrequest.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *ReturnRequestMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ReturnRequestMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, returnrequest.FieldUserID)
+}
+
+// SetStatus sets the "status" field.
+func (m *ReturnRequestMutation) SetStatus(r returnrequest.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReturnRequestMutation) Status() (r returnrequest.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReturnRequestMutation) OldStatus(ctx context.Context) (v returnrequest.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReturnRequestMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *ReturnRequestMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *ReturnRequestMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReturnRequestMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *ReturnRequestMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[returnrequest.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *ReturnRequestMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *ReturnRequestMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, returnrequest.FieldReason)
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (m *ReturnRequestMutation) SetRefundAmount(value money.Amount) {
+	m.refund_amount = &value
+	m.addrefund_amount = nil
+}
+
+// RefundAmount returns the value of the "refund_amount" field in the mutation.
+func (m *ReturnRequestMutation) RefundAmount() (r money.Amount, exists bool) {
+	v := m.refund_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundAmount returns the old "refund_amount" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReturnRequestMutation) OldRefundAmount(ctx context.Context) (v money.Amount, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundAmount: %w", err)
+	}
+	return oldValue.RefundAmount, nil
+}
+
+// AddRefundAmount adds value to the "refund_amount" field.
+func (m *ReturnRequestMutation) AddRefundAmount(value money.Amount) {
+	if m.addrefund_amount != nil {
+		*m.addrefund_amount += value
+	} else {
+		m.addrefund_amount = &value
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *CategoryMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddedRefundAmount returns the value that was added to the "refund_amount" field in this mutation.
+func (m *ReturnRequestMutation) AddedRefundAmount() (r money.Amount, exists bool) {
+	v := m.addrefund_amount
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// SetName sets the "name" field.
-func (m *CategoryMutation) SetName(s string) {
-	m.name = &s
+// ResetRefundAmount resets all changes to the "refund_amount" field.
+func (m *ReturnRequestMutation) ResetRefundAmount() {
+	m.refund_amount = nil
+	m.addrefund_amount = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *CategoryMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *ReturnRequestMutation) SetReviewedBy(s string) {
+	m.reviewed_by = &s
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *ReturnRequestMutation) ReviewedBy() (r string, exists bool) {
+	v := m.reviewed_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewedBy returns the old "reviewed_by" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ReturnRequestMutation) OldReviewedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ReviewedBy, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *CategoryMutation) ResetName() {
-	m.name = nil
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *ReturnRequestMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[returnrequest.FieldReviewedBy] = struct{}{}
 }
 
-// SetSlug sets the "slug" field.
-func (m *CategoryMutation) SetSlug(s string) {
-	m.slug = &s
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *ReturnRequestMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldReviewedBy]
+	return ok
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *CategoryMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *ReturnRequestMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, returnrequest.FieldReviewedBy)
+}
+
+// SetReviewNote sets the "review_note" field.
+func (m *ReturnRequestMutation) SetReviewNote(s string) {
+	m.review_note = &s
+}
+
+// ReviewNote returns the value of the "review_note" field in the mutation.
+func (m *ReturnRequestMutation) ReviewNote() (r string, exists bool) {
+	v := m.review_note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewNote returns the old "review_note" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *ReturnRequestMutation) OldReviewNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldReviewNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewNote: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.ReviewNote, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *CategoryMutation) ResetSlug() {
-	m.slug = nil
+// ClearReviewNote clears the value of the "review_note" field.
+func (m *ReturnRequestMutation) ClearReviewNote() {
+	m.review_note = nil
+	m.clearedFields[returnrequest.FieldReviewNote] = struct{}{}
 }
 
-// SetImage sets the "image" field.
-func (m *CategoryMutation) SetImage(s string) {
-	m.image = &s
+// ReviewNoteCleared returns if the "review_note" field was cleared in this mutation.
+func (m *ReturnRequestMutation) ReviewNoteCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldReviewNote]
+	return ok
 }
 
-// Image returns the value of the "image" field in the mutation.
-func (m *CategoryMutation) Image() (r string, exists bool) {
-	v := m.image
+// ResetReviewNote resets all changes to the "review_note" field.
+func (m *ReturnRequestMutation) ResetReviewNote() {
+	m.review_note = nil
+	delete(m.clearedFields, returnrequest.FieldReviewNote)
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *ReturnRequestMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *ReturnRequestMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImage returns the old "image" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewedAt returns the old "reviewed_at" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldImage(ctx context.Context) (v string, err error) {
+func (m *ReturnRequestMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImage requires an ID field in the mutation")
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
 	}
-	return oldValue.Image, nil
+	return oldValue.ReviewedAt, nil
 }
 
-// ClearImage clears the value of the "image" field.
-func (m *CategoryMutation) ClearImage() {
-	m.image = nil
-	m.clearedFields[category.FieldImage] = struct{}{}
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *ReturnRequestMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[returnrequest.FieldReviewedAt] = struct{}{}
 }
 
-// ImageCleared returns if the "image" field was cleared in this mutation.
-func (m *CategoryMutation) ImageCleared() bool {
-	_, ok := m.clearedFields[category.FieldImage]
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *ReturnRequestMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[returnrequest.FieldReviewedAt]
 	return ok
 }
 
-// ResetImage resets all changes to the "image" field.
-func (m *CategoryMutation) ResetImage() {
-	m.image = nil
-	delete(m.clearedFields, category.FieldImage)
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *ReturnRequestMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, returnrequest.FieldReviewedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CategoryMutation) SetCreatedAt(t time.Time) {
+func (m *ReturnRequestMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ReturnRequestMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4352,10 +18450,10 @@ func (m *CategoryMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReturnRequestMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4370,17 +18468,17 @@ func (m *CategoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CategoryMutation) ResetCreatedAt() {
+func (m *ReturnRequestMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *CategoryMutation) SetUpdatedAt(t time.Time) {
+func (m *ReturnRequestMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ReturnRequestMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4388,10 +18486,10 @@ func (m *CategoryMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Category entity.
-// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ReturnRequest entity.
+// If the ReturnRequest object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReturnRequestMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -4406,73 +18504,100 @@ func (m *CategoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err e
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CategoryMutation) ResetUpdatedAt() {
+func (m *ReturnRequestMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddProductIDs adds the "products" edge to the Product entity by ids.
-func (m *CategoryMutation) AddProductIDs(ids ...string) {
-	if m.products == nil {
-		m.products = make(map[string]struct{})
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *ReturnRequestMutation) ClearOrder() {
+	m.cleared_order = true
+	m.clearedFields[returnrequest.FieldOrderID] = struct{}{}
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *ReturnRequestMutation) OrderCleared() bool {
+	return m.OrderIDCleared() || m.cleared_order
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *ReturnRequestMutation) OrderIDs() (ids []string) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrder resets all changes to the "order" edge.
+func (m *ReturnRequestMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
+}
+
+// AddItemIDs adds the "items" edge to the ReturnItem entity by ids.
+func (m *ReturnRequestMutation) AddItemIDs(ids ...string) {
+	if m.items == nil {
+		m.items = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.products[ids[i]] = struct{}{}
+		m.items[ids[i]] = struct{}{}
 	}
 }
 
-// ClearProducts clears the "products" edge to the Product entity.
-func (m *CategoryMutation) ClearProducts() {
-	m.clearedproducts = true
+// ClearItems clears the "items" edge to the ReturnItem entity.
+func (m *ReturnRequestMutation) ClearItems() {
+	m.cleareditems = true
 }
 
-// ProductsCleared reports if the "products" edge to the Product entity was cleared.
-func (m *CategoryMutation) ProductsCleared() bool {
-	return m.clearedproducts
+// ItemsCleared reports if the "items" edge to the ReturnItem entity was cleared.
+func (m *ReturnRequestMutation) ItemsCleared() bool {
+	return m.cleareditems
 }
 
-// RemoveProductIDs removes the "products" edge to the Product entity by IDs.
-func (m *CategoryMutation) RemoveProductIDs(ids ...string) {
-	if m.removedproducts == nil {
-		m.removedproducts = make(map[string]struct{})
+// RemoveItemIDs removes the "items" edge to the ReturnItem entity by IDs.
+func (m *ReturnRequestMutation) RemoveItemIDs(ids ...string) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.products, ids[i])
-		m.removedproducts[ids[i]] = struct{}{}
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedProducts returns the removed IDs of the "products" edge to the Product entity.
-func (m *CategoryMutation) RemovedProductsIDs() (ids []string) {
-	for id := range m.removedproducts {
+// RemovedItems returns the removed IDs of the "items" edge to the ReturnItem entity.
+func (m *ReturnRequestMutation) RemovedItemsIDs() (ids []string) {
+	for id := range m.removeditems {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ProductsIDs returns the "products" edge IDs in the mutation.
-func (m *CategoryMutation) ProductsIDs() (ids []string) {
-	for id := range m.products {
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *ReturnRequestMutation) ItemsIDs() (ids []string) {
+	for id := range m.items {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProducts resets all changes to the "products" edge.
-func (m *CategoryMutation) ResetProducts() {
-	m.products = nil
-	m.clearedproducts = false
-	m.removedproducts = nil
+// ResetItems resets all changes to the "items" edge.
+func (m *ReturnRequestMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// Where appends a list predicates to the CategoryMutation builder.
-func (m *CategoryMutation) Where(ps ...predicate.Category) {
+// Where appends a list predicates to the ReturnRequestMutation builder.
+func (m *ReturnRequestMutation) Where(ps ...predicate.ReturnRequest) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReturnRequestMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Category, len(ps))
+func (m *ReturnRequestMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ReturnRequest, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4480,39 +18605,54 @@ func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CategoryMutation) Op() Op {
+func (m *ReturnRequestMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CategoryMutation) SetOp(op Op) {
+func (m *ReturnRequestMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Category).
-func (m *CategoryMutation) Type() string {
+// Type returns the node type of this mutation (ReturnRequest).
+func (m *ReturnRequestMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CategoryMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.name != nil {
-		fields = append(fields, category.FieldName)
+func (m *ReturnRequestMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m._order != nil {
+		fields = append(fields, returnrequest.FieldOrderID)
 	}
-	if m.slug != nil {
-		fields = append(fields, category.FieldSlug)
+	if m.user_id != nil {
+		fields = append(fields, returnrequest.FieldUserID)
 	}
-	if m.image != nil {
-		fields = append(fields, category.FieldImage)
+	if m.status != nil {
+		fields = append(fields, returnrequest.FieldStatus)
+	}
+	if m.reason != nil {
+		fields = append(fields, returnrequest.FieldReason)
+	}
+	if m.refund_amount != nil {
+		fields = append(fields, returnrequest.FieldRefundAmount)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, returnrequest.FieldReviewedBy)
+	}
+	if m.review_note != nil {
+		fields = append(fields, returnrequest.FieldReviewNote)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, returnrequest.FieldReviewedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, category.FieldCreatedAt)
+		fields = append(fields, returnrequest.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, category.FieldUpdatedAt)
+		fields = append(fields, returnrequest.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4520,17 +18660,27 @@ func (m *CategoryMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+func (m *ReturnRequestMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case category.FieldName:
-		return m.Name()
-	case category.FieldSlug:
-		return m.Slug()
-	case category.FieldImage:
-		return m.Image()
-	case category.FieldCreatedAt:
+	case returnrequest.FieldOrderID:
+		return m.OrderID()
+	case returnrequest.FieldUserID:
+		return m.UserID()
+	case returnrequest.FieldStatus:
+		return m.Status()
+	case returnrequest.FieldReason:
+		return m.Reason()
+	case returnrequest.FieldRefundAmount:
+		return m.RefundAmount()
+	case returnrequest.FieldReviewedBy:
+		return m.ReviewedBy()
+	case returnrequest.FieldReviewNote:
+		return m.ReviewNote()
+	case returnrequest.FieldReviewedAt:
+		return m.ReviewedAt()
+	case returnrequest.FieldCreatedAt:
 		return m.CreatedAt()
-	case category.FieldUpdatedAt:
+	case returnrequest.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4539,56 +18689,101 @@ func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReturnRequestMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case category.FieldName:
-		return m.OldName(ctx)
-	case category.FieldSlug:
-		return m.OldSlug(ctx)
-	case category.FieldImage:
-		return m.OldImage(ctx)
-	case category.FieldCreatedAt:
+	case returnrequest.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case returnrequest.FieldUserID:
+		return m.OldUserID(ctx)
+	case returnrequest.FieldStatus:
+		return m.OldStatus(ctx)
+	case returnrequest.FieldReason:
+		return m.OldReason(ctx)
+	case returnrequest.FieldRefundAmount:
+		return m.OldRefundAmount(ctx)
+	case returnrequest.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case returnrequest.FieldReviewNote:
+		return m.OldReviewNote(ctx)
+	case returnrequest.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case returnrequest.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case category.FieldUpdatedAt:
+	case returnrequest.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Category field %s", name)
+	return nil, fmt.Errorf("unknown ReturnRequest field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+func (m *ReturnRequestMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case category.FieldName:
+	case returnrequest.FieldOrderID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetOrderID(v)
 		return nil
-	case category.FieldSlug:
+	case returnrequest.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetUserID(v)
 		return nil
-	case category.FieldImage:
+	case returnrequest.FieldStatus:
+		v, ok := value.(returnrequest.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case returnrequest.FieldReason:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImage(v)
+		m.SetReason(v)
 		return nil
-	case category.FieldCreatedAt:
+	case returnrequest.FieldRefundAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundAmount(v)
+		return nil
+	case returnrequest.FieldReviewedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case returnrequest.FieldReviewNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewNote(v)
+		return nil
+	case returnrequest.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	case returnrequest.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case category.FieldUpdatedAt:
+	case returnrequest.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4596,98 +18791,165 @@ func (m *CategoryMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown ReturnRequest field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CategoryMutation) AddedFields() []string {
-	return nil
+func (m *ReturnRequestMutation) AddedFields() []string {
+	var fields []string
+	if m.addrefund_amount != nil {
+		fields = append(fields, returnrequest.FieldRefundAmount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReturnRequestMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case returnrequest.FieldRefundAmount:
+		return m.AddedRefundAmount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+func (m *ReturnRequestMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case returnrequest.FieldRefundAmount:
+		v, ok := value.(money.Amount)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefundAmount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Category numeric field %s", name)
+	return fmt.Errorf("unknown ReturnRequest numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CategoryMutation) ClearedFields() []string {
+func (m *ReturnRequestMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(category.FieldImage) {
-		fields = append(fields, category.FieldImage)
+	if m.FieldCleared(returnrequest.FieldOrderID) {
+		fields = append(fields, returnrequest.FieldOrderID)
+	}
+	if m.FieldCleared(returnrequest.FieldUserID) {
+		fields = append(fields, returnrequest.FieldUserID)
+	}
+	if m.FieldCleared(returnrequest.FieldReason) {
+		fields = append(fields, returnrequest.FieldReason)
+	}
+	if m.FieldCleared(returnrequest.FieldReviewedBy) {
+		fields = append(fields, returnrequest.FieldReviewedBy)
+	}
+	if m.FieldCleared(returnrequest.FieldReviewNote) {
+		fields = append(fields, returnrequest.FieldReviewNote)
+	}
+	if m.FieldCleared(returnrequest.FieldReviewedAt) {
+		fields = append(fields, returnrequest.FieldReviewedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CategoryMutation) FieldCleared(name string) bool {
+func (m *ReturnRequestMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CategoryMutation) ClearField(name string) error {
+func (m *ReturnRequestMutation) ClearField(name string) error {
 	switch name {
-	case category.FieldImage:
-		m.ClearImage()
+	case returnrequest.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case returnrequest.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case returnrequest.FieldReason:
+		m.ClearReason()
+		return nil
+	case returnrequest.FieldReviewedBy:
+		m.ClearReviewedBy()
+		return nil
+	case returnrequest.FieldReviewNote:
+		m.ClearReviewNote()
+		return nil
+	case returnrequest.FieldReviewedAt:
+		m.ClearReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category nullable field %s", name)
+	return fmt.Errorf("unknown ReturnRequest nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CategoryMutation) ResetField(name string) error {
+func (m *ReturnRequestMutation) ResetField(name string) error {
 	switch name {
-	case category.FieldName:
-		m.ResetName()
+	case returnrequest.FieldOrderID:
+		m.ResetOrderID()
 		return nil
-	case category.FieldSlug:
-		m.ResetSlug()
+	case returnrequest.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case category.FieldImage:
-		m.ResetImage()
+	case returnrequest.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case category.FieldCreatedAt:
+	case returnrequest.FieldReason:
+		m.ResetReason()
+		return nil
+	case returnrequest.FieldRefundAmount:
+		m.ResetRefundAmount()
+		return nil
+	case returnrequest.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case returnrequest.FieldReviewNote:
+		m.ResetReviewNote()
+		return nil
+	case returnrequest.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case returnrequest.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case category.FieldUpdatedAt:
+	case returnrequest.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Category field %s", name)
+	return fmt.Errorf("unknown ReturnRequest field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CategoryMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.products != nil {
-		edges = append(edges, category.EdgeProducts)
+func (m *ReturnRequestMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._order != nil {
+		edges = append(edges, returnrequest.EdgeOrder)
+	}
+	if m.items != nil {
+		edges = append(edges, returnrequest.EdgeItems)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+func (m *ReturnRequestMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case category.EdgeProducts:
-		ids := make([]ent.Value, 0, len(m.products))
-		for id := range m.products {
+	case returnrequest.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	case returnrequest.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4696,21 +18958,21 @@ func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CategoryMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedproducts != nil {
-		edges = append(edges, category.EdgeProducts)
+func (m *ReturnRequestMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, returnrequest.EdgeItems)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReturnRequestMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case category.EdgeProducts:
-		ids := make([]ent.Value, 0, len(m.removedproducts))
-		for id := range m.removedproducts {
+	case returnrequest.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
 			ids = append(ids, id)
 		}
 		return ids
@@ -4719,80 +18981,89 @@ func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CategoryMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedproducts {
-		edges = append(edges, category.EdgeProducts)
+func (m *ReturnRequestMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_order {
+		edges = append(edges, returnrequest.EdgeOrder)
+	}
+	if m.cleareditems {
+		edges = append(edges, returnrequest.EdgeItems)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CategoryMutation) EdgeCleared(name string) bool {
+func (m *ReturnRequestMutation) EdgeCleared(name string) bool {
 	switch name {
-	case category.EdgeProducts:
-		return m.clearedproducts
+	case returnrequest.EdgeOrder:
+		return m.cleared_order
+	case returnrequest.EdgeItems:
+		return m.cleareditems
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CategoryMutation) ClearEdge(name string) error {
+func (m *ReturnRequestMutation) ClearEdge(name string) error {
 	switch name {
+	case returnrequest.EdgeOrder:
+		m.ClearOrder()
+		return nil
 	}
-	return fmt.Errorf("unknown Category unique edge %s", name)
+	return fmt.Errorf("unknown ReturnRequest unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *CategoryMutation) ResetEdge(name string) error {
+func (m *ReturnRequestMutation) ResetEdge(name string) error {
 	switch name {
-	case category.EdgeProducts:
-		m.ResetProducts()
+	case returnrequest.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	case returnrequest.EdgeItems:
+		m.ResetItems()
 		return nil
 	}
-	return fmt.Errorf("unknown Category edge %s", name)
+	return fmt.Errorf("unknown ReturnRequest edge %s", name)
 }
 
-// CouponMutation represents an operation that mutates the Coupon nodes in the graph.
-type CouponMutation struct {
+// SessionMutation represents an operation that mutates the Session nodes in the graph.
+type SessionMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *string
-	code            *string
-	_type           *coupon.Type
-	value           *float64
-	addvalue        *float64
-	min_purchase    *float64
-	addmin_purchase *float64
-	usage_limit     *int
-	addusage_limit  *int
-	used_count      *int
-	addused_count   *int
-	expires_at      *time.Time
-	active          *bool
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Coupon, error)
-	predicates      []predicate.Coupon
+	op            Op
+	typ           string
+	id            *string
+	family_id     *string
+	token_hash    *string
+	device        *string
+	ip            *string
+	user_agent    *string
+	expires_at    *time.Time
+	last_used_at  *time.Time
+	revoked_at    *time.Time
+	replaced_by   *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *string
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*Session, error)
+	predicates    []predicate.Session
 }
 
-var _ ent.Mutation = (*CouponMutation)(nil)
+var _ ent.Mutation = (*SessionMutation)(nil)
 
-// couponOption allows management of the mutation configuration using functional options.
-type couponOption func(*CouponMutation)
+// sessionOption allows management of the mutation configuration using functional options.
+type sessionOption func(*SessionMutation)
 
-// newCouponMutation creates new mutation for the Coupon entity.
-func newCouponMutation(c config, op Op, opts ...couponOption) *CouponMutation {
-	m := &CouponMutation{
+// newSessionMutation creates new mutation for the Session entity.
+func newSessionMutation(c config, op Op, opts ...sessionOption) *SessionMutation {
+	m := &SessionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeCoupon,
+		typ:           TypeSession,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4801,20 +19072,20 @@ func newCouponMutation(c config, op Op, opts ...couponOption) *CouponMutation {
 	return m
 }
 
-// withCouponID sets the ID field of the mutation.
-func withCouponID(id string) couponOption {
-	return func(m *CouponMutation) {
+// withSessionID sets the ID field of the mutation.
+func withSessionID(id string) sessionOption {
+	return func(m *SessionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Coupon
+			value *Session
 		)
-		m.oldValue = func(ctx context.Context) (*Coupon, error) {
+		m.oldValue = func(ctx context.Context) (*Session, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Coupon.Get(ctx, id)
+					value, err = m.Client().Session.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4823,10 +19094,10 @@ func withCouponID(id string) couponOption {
 	}
 }
 
-// withCoupon sets the old Coupon of the mutation.
-func withCoupon(node *Coupon) couponOption {
-	return func(m *CouponMutation) {
-		m.oldValue = func(context.Context) (*Coupon, error) {
+// withSession sets the old Session of the mutation.
+func withSession(node *Session) sessionOption {
+	return func(m *SessionMutation) {
+		m.oldValue = func(context.Context) (*Session, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4835,7 +19106,7 @@ func withCoupon(node *Coupon) couponOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m CouponMutation) Client() *Client {
+func (m SessionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4843,7 +19114,7 @@ func (m CouponMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m CouponMutation) Tx() (*Tx, error) {
+func (m SessionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4853,14 +19124,14 @@ func (m CouponMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Coupon entities.
-func (m *CouponMutation) SetID(id string) {
+// operation is only accepted on creation of Session entities.
+func (m *SessionMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *CouponMutation) ID() (id string, exists bool) {
+func (m *SessionMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4871,7 +19142,7 @@ func (m *CouponMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *CouponMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *SessionMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4880,428 +19151,457 @@ func (m *CouponMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Coupon.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Session.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetCode sets the "code" field.
-func (m *CouponMutation) SetCode(s string) {
-	m.code = &s
+// SetUserID sets the "user_id" field.
+func (m *SessionMutation) SetUserID(s string) {
+	m.user = &s
 }
 
-// Code returns the value of the "code" field in the mutation.
-func (m *CouponMutation) Code() (r string, exists bool) {
-	v := m.code
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SessionMutation) UserID() (r string, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCode returns the old "code" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldCode(ctx context.Context) (v string, err error) {
+func (m *SessionMutation) OldUserID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCode requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Code, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCode resets all changes to the "code" field.
-func (m *CouponMutation) ResetCode() {
-	m.code = nil
+// ClearUserID clears the value of the "user_id" field.
+func (m *SessionMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[session.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SessionMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SessionMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, session.FieldUserID)
 }
 
-// SetType sets the "type" field.
-func (m *CouponMutation) SetType(c coupon.Type) {
-	m._type = &c
+// SetFamilyID sets the "family_id" field.
+func (m *SessionMutation) SetFamilyID(s string) {
+	m.family_id = &s
 }
 
-// GetType returns the value of the "type" field in the mutation.
-func (m *CouponMutation) GetType() (r coupon.Type, exists bool) {
-	v := m._type
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *SessionMutation) FamilyID() (r string, exists bool) {
+	v := m.family_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilyID returns the old "family_id" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldType(ctx context.Context) (v coupon.Type, err error) {
+func (m *SessionMutation) OldFamilyID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldType is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldType requires an ID field in the mutation")
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldType: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
 	}
-	return oldValue.Type, nil
+	return oldValue.FamilyID, nil
 }
 
-// ResetType resets all changes to the "type" field.
-func (m *CouponMutation) ResetType() {
-	m._type = nil
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *SessionMutation) ResetFamilyID() {
+	m.family_id = nil
 }
 
-// SetValue sets the "value" field.
-func (m *CouponMutation) SetValue(f float64) {
-	m.value = &f
-	m.addvalue = nil
+// SetTokenHash sets the "token_hash" field.
+func (m *SessionMutation) SetTokenHash(s string) {
+	m.token_hash = &s
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *CouponMutation) Value() (r float64, exists bool) {
-	v := m.value
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *SessionMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldTokenHash returns the old "token_hash" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldValue(ctx context.Context) (v float64, err error) {
+func (m *SessionMutation) OldTokenHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.TokenHash, nil
 }
 
-// AddValue adds f to the "value" field.
-func (m *CouponMutation) AddValue(f float64) {
-	if m.addvalue != nil {
-		*m.addvalue += f
-	} else {
-		m.addvalue = &f
-	}
-}
-
-// AddedValue returns the value that was added to the "value" field in this mutation.
-func (m *CouponMutation) AddedValue() (r float64, exists bool) {
-	v := m.addvalue
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *SessionMutation) ResetTokenHash() {
+	m.token_hash = nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *CouponMutation) ResetValue() {
-	m.value = nil
-	m.addvalue = nil
+// SetDevice sets the "device" field.
+func (m *SessionMutation) SetDevice(s string) {
+	m.device = &s
 }
 
-// SetMinPurchase sets the "min_purchase" field.
-func (m *CouponMutation) SetMinPurchase(f float64) {
-	m.min_purchase = &f
-	m.addmin_purchase = nil
-}
-
-// MinPurchase returns the value of the "min_purchase" field in the mutation.
-func (m *CouponMutation) MinPurchase() (r float64, exists bool) {
-	v := m.min_purchase
+// Device returns the value of the "device" field in the mutation.
+func (m *SessionMutation) Device() (r string, exists bool) {
+	v := m.device
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMinPurchase returns the old "min_purchase" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldDevice returns the old "device" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldMinPurchase(ctx context.Context) (v float64, err error) {
+func (m *SessionMutation) OldDevice(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMinPurchase is only allowed on UpdateOne operations")
+		return v, errors.New("OldDevice is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMinPurchase requires an ID field in the mutation")
+		return v, errors.New("OldDevice requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMinPurchase: %w", err)
-	}
-	return oldValue.MinPurchase, nil
-}
-
-// AddMinPurchase adds f to the "min_purchase" field.
-func (m *CouponMutation) AddMinPurchase(f float64) {
-	if m.addmin_purchase != nil {
-		*m.addmin_purchase += f
-	} else {
-		m.addmin_purchase = &f
-	}
-}
-
-// AddedMinPurchase returns the value that was added to the "min_purchase" field in this mutation.
-func (m *CouponMutation) AddedMinPurchase() (r float64, exists bool) {
-	v := m.addmin_purchase
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldDevice: %w", err)
 	}
-	return *v, true
+	return oldValue.Device, nil
 }
 
-// ClearMinPurchase clears the value of the "min_purchase" field.
-func (m *CouponMutation) ClearMinPurchase() {
-	m.min_purchase = nil
-	m.addmin_purchase = nil
-	m.clearedFields[coupon.FieldMinPurchase] = struct{}{}
+// ClearDevice clears the value of the "device" field.
+func (m *SessionMutation) ClearDevice() {
+	m.device = nil
+	m.clearedFields[session.FieldDevice] = struct{}{}
 }
 
-// MinPurchaseCleared returns if the "min_purchase" field was cleared in this mutation.
-func (m *CouponMutation) MinPurchaseCleared() bool {
-	_, ok := m.clearedFields[coupon.FieldMinPurchase]
+// DeviceCleared returns if the "device" field was cleared in this mutation.
+func (m *SessionMutation) DeviceCleared() bool {
+	_, ok := m.clearedFields[session.FieldDevice]
 	return ok
 }
 
-// ResetMinPurchase resets all changes to the "min_purchase" field.
-func (m *CouponMutation) ResetMinPurchase() {
-	m.min_purchase = nil
-	m.addmin_purchase = nil
-	delete(m.clearedFields, coupon.FieldMinPurchase)
+// ResetDevice resets all changes to the "device" field.
+func (m *SessionMutation) ResetDevice() {
+	m.device = nil
+	delete(m.clearedFields, session.FieldDevice)
 }
 
-// SetUsageLimit sets the "usage_limit" field.
-func (m *CouponMutation) SetUsageLimit(i int) {
-	m.usage_limit = &i
-	m.addusage_limit = nil
+// SetIP sets the "ip" field.
+func (m *SessionMutation) SetIP(s string) {
+	m.ip = &s
 }
 
-// UsageLimit returns the value of the "usage_limit" field in the mutation.
-func (m *CouponMutation) UsageLimit() (r int, exists bool) {
-	v := m.usage_limit
+// IP returns the value of the "ip" field in the mutation.
+func (m *SessionMutation) IP() (r string, exists bool) {
+	v := m.ip
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsageLimit returns the old "usage_limit" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldIP returns the old "ip" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldUsageLimit(ctx context.Context) (v int, err error) {
+func (m *SessionMutation) OldIP(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsageLimit is only allowed on UpdateOne operations")
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsageLimit requires an ID field in the mutation")
+		return v, errors.New("OldIP requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsageLimit: %w", err)
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
 	}
-	return oldValue.UsageLimit, nil
+	return oldValue.IP, nil
 }
 
-// AddUsageLimit adds i to the "usage_limit" field.
-func (m *CouponMutation) AddUsageLimit(i int) {
-	if m.addusage_limit != nil {
-		*m.addusage_limit += i
-	} else {
-		m.addusage_limit = &i
-	}
+// ClearIP clears the value of the "ip" field.
+func (m *SessionMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[session.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *SessionMutation) IPCleared() bool {
+	_, ok := m.clearedFields[session.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *SessionMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, session.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *SessionMutation) SetUserAgent(s string) {
+	m.user_agent = &s
 }
 
-// AddedUsageLimit returns the value that was added to the "usage_limit" field in this mutation.
-func (m *CouponMutation) AddedUsageLimit() (r int, exists bool) {
-	v := m.addusage_limit
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *SessionMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearUsageLimit clears the value of the "usage_limit" field.
-func (m *CouponMutation) ClearUsageLimit() {
-	m.usage_limit = nil
-	m.addusage_limit = nil
-	m.clearedFields[coupon.FieldUsageLimit] = struct{}{}
+// OldUserAgent returns the old "user_agent" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *SessionMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[session.FieldUserAgent] = struct{}{}
 }
 
-// UsageLimitCleared returns if the "usage_limit" field was cleared in this mutation.
-func (m *CouponMutation) UsageLimitCleared() bool {
-	_, ok := m.clearedFields[coupon.FieldUsageLimit]
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *SessionMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[session.FieldUserAgent]
 	return ok
 }
 
-// ResetUsageLimit resets all changes to the "usage_limit" field.
-func (m *CouponMutation) ResetUsageLimit() {
-	m.usage_limit = nil
-	m.addusage_limit = nil
-	delete(m.clearedFields, coupon.FieldUsageLimit)
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *SessionMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, session.FieldUserAgent)
 }
 
-// SetUsedCount sets the "used_count" field.
-func (m *CouponMutation) SetUsedCount(i int) {
-	m.used_count = &i
-	m.addused_count = nil
+// SetExpiresAt sets the "expires_at" field.
+func (m *SessionMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// UsedCount returns the value of the "used_count" field in the mutation.
-func (m *CouponMutation) UsedCount() (r int, exists bool) {
-	v := m.used_count
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SessionMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUsedCount returns the old "used_count" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldUsedCount(ctx context.Context) (v int, err error) {
+func (m *SessionMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUsedCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUsedCount requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUsedCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.UsedCount, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// AddUsedCount adds i to the "used_count" field.
-func (m *CouponMutation) AddUsedCount(i int) {
-	if m.addused_count != nil {
-		*m.addused_count += i
-	} else {
-		m.addused_count = &i
-	}
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SessionMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// AddedUsedCount returns the value that was added to the "used_count" field in this mutation.
-func (m *CouponMutation) AddedUsedCount() (r int, exists bool) {
-	v := m.addused_count
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *SessionMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *SessionMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetUsedCount resets all changes to the "used_count" field.
-func (m *CouponMutation) ResetUsedCount() {
-	m.used_count = nil
-	m.addused_count = nil
+// OldLastUsedAt returns the old "last_used_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SessionMutation) OldLastUsedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
+	}
+	return oldValue.LastUsedAt, nil
+}
+
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *SessionMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *CouponMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// SetRevokedAt sets the "revoked_at" field.
+func (m *SessionMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *CouponMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *SessionMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *CouponMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[coupon.FieldExpiresAt] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *SessionMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[session.FieldRevokedAt] = struct{}{}
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *CouponMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[coupon.FieldExpiresAt]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *SessionMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[session.FieldRevokedAt]
 	return ok
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *CouponMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, coupon.FieldExpiresAt)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *SessionMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, session.FieldRevokedAt)
 }
 
-// SetActive sets the "active" field.
-func (m *CouponMutation) SetActive(b bool) {
-	m.active = &b
+// SetReplacedBy sets the "replaced_by" field.
+func (m *SessionMutation) SetReplacedBy(s string) {
+	m.replaced_by = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *CouponMutation) Active() (r bool, exists bool) {
-	v := m.active
+// ReplacedBy returns the value of the "replaced_by" field in the mutation.
+func (m *SessionMutation) ReplacedBy() (r string, exists bool) {
+	v := m.replaced_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldReplacedBy returns the old "replaced_by" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *SessionMutation) OldReplacedBy(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldReplacedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldReplacedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldReplacedBy: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.ReplacedBy, nil
+}
+
+// ClearReplacedBy clears the value of the "replaced_by" field.
+func (m *SessionMutation) ClearReplacedBy() {
+	m.replaced_by = nil
+	m.clearedFields[session.FieldReplacedBy] = struct{}{}
+}
+
+// ReplacedByCleared returns if the "replaced_by" field was cleared in this mutation.
+func (m *SessionMutation) ReplacedByCleared() bool {
+	_, ok := m.clearedFields[session.FieldReplacedBy]
+	return ok
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *CouponMutation) ResetActive() {
-	m.active = nil
+// ResetReplacedBy resets all changes to the "replaced_by" field.
+func (m *SessionMutation) ResetReplacedBy() {
+	m.replaced_by = nil
+	delete(m.clearedFields, session.FieldReplacedBy)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *CouponMutation) SetCreatedAt(t time.Time) {
+func (m *SessionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *CouponMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SessionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5309,10 +19609,10 @@ func (m *CouponMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Session entity.
+// If the Session object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SessionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5327,55 +19627,46 @@ func (m *CouponMutation) OldCreatedAt(ctx context.Context) (v time.Time, err err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *CouponMutation) ResetCreatedAt() {
+func (m *SessionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *CouponMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearUser clears the "user" edge to the User entity.
+func (m *SessionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[session.FieldUserID] = struct{}{}
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *CouponMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SessionMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Coupon entity.
-// If the Coupon object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *CouponMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SessionMutation) UserIDs() (ids []string) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *CouponMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *SessionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the CouponMutation builder.
-func (m *CouponMutation) Where(ps ...predicate.Coupon) {
+// Where appends a list predicates to the SessionMutation builder.
+func (m *SessionMutation) Where(ps ...predicate.Session) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the CouponMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SessionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *CouponMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Coupon, len(ps))
+func (m *SessionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Session, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5383,54 +19674,57 @@ func (m *CouponMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *CouponMutation) Op() Op {
+func (m *SessionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *CouponMutation) SetOp(op Op) {
+func (m *SessionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Coupon).
-func (m *CouponMutation) Type() string {
+// Type returns the node type of this mutation (Session).
+func (m *SessionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *CouponMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.code != nil {
-		fields = append(fields, coupon.FieldCode)
+func (m *SessionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user != nil {
+		fields = append(fields, session.FieldUserID)
 	}
-	if m._type != nil {
-		fields = append(fields, coupon.FieldType)
+	if m.family_id != nil {
+		fields = append(fields, session.FieldFamilyID)
 	}
-	if m.value != nil {
-		fields = append(fields, coupon.FieldValue)
+	if m.token_hash != nil {
+		fields = append(fields, session.FieldTokenHash)
 	}
-	if m.min_purchase != nil {
-		fields = append(fields, coupon.FieldMinPurchase)
+	if m.device != nil {
+		fields = append(fields, session.FieldDevice)
 	}
-	if m.usage_limit != nil {
-		fields = append(fields, coupon.FieldUsageLimit)
+	if m.ip != nil {
+		fields = append(fields, session.FieldIP)
 	}
-	if m.used_count != nil {
-		fields = append(fields, coupon.FieldUsedCount)
+	if m.user_agent != nil {
+		fields = append(fields, session.FieldUserAgent)
 	}
 	if m.expires_at != nil {
-		fields = append(fields, coupon.FieldExpiresAt)
+		fields = append(fields, session.FieldExpiresAt)
 	}
-	if m.active != nil {
-		fields = append(fields, coupon.FieldActive)
+	if m.last_used_at != nil {
+		fields = append(fields, session.FieldLastUsedAt)
 	}
-	if m.created_at != nil {
-		fields = append(fields, coupon.FieldCreatedAt)
+	if m.revoked_at != nil {
+		fields = append(fields, session.FieldRevokedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, coupon.FieldUpdatedAt)
+	if m.replaced_by != nil {
+		fields = append(fields, session.FieldReplacedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, session.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5438,28 +19732,30 @@ func (m *CouponMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *CouponMutation) Field(name string) (ent.Value, bool) {
+func (m *SessionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case coupon.FieldCode:
-		return m.Code()
-	case coupon.FieldType:
-		return m.GetType()
-	case coupon.FieldValue:
-		return m.Value()
-	case coupon.FieldMinPurchase:
-		return m.MinPurchase()
-	case coupon.FieldUsageLimit:
-		return m.UsageLimit()
-	case coupon.FieldUsedCount:
-		return m.UsedCount()
-	case coupon.FieldExpiresAt:
+	case session.FieldUserID:
+		return m.UserID()
+	case session.FieldFamilyID:
+		return m.FamilyID()
+	case session.FieldTokenHash:
+		return m.TokenHash()
+	case session.FieldDevice:
+		return m.Device()
+	case session.FieldIP:
+		return m.IP()
+	case session.FieldUserAgent:
+		return m.UserAgent()
+	case session.FieldExpiresAt:
 		return m.ExpiresAt()
-	case coupon.FieldActive:
-		return m.Active()
-	case coupon.FieldCreatedAt:
+	case session.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case session.FieldRevokedAt:
+		return m.RevokedAt()
+	case session.FieldReplacedBy:
+		return m.ReplacedBy()
+	case session.FieldCreatedAt:
 		return m.CreatedAt()
-	case coupon.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5467,354 +19763,350 @@ func (m *CouponMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *CouponMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SessionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case coupon.FieldCode:
-		return m.OldCode(ctx)
-	case coupon.FieldType:
-		return m.OldType(ctx)
-	case coupon.FieldValue:
-		return m.OldValue(ctx)
-	case coupon.FieldMinPurchase:
-		return m.OldMinPurchase(ctx)
-	case coupon.FieldUsageLimit:
-		return m.OldUsageLimit(ctx)
-	case coupon.FieldUsedCount:
-		return m.OldUsedCount(ctx)
-	case coupon.FieldExpiresAt:
+	case session.FieldUserID:
+		return m.OldUserID(ctx)
+	case session.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case session.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case session.FieldDevice:
+		return m.OldDevice(ctx)
+	case session.FieldIP:
+		return m.OldIP(ctx)
+	case session.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case session.FieldExpiresAt:
 		return m.OldExpiresAt(ctx)
-	case coupon.FieldActive:
-		return m.OldActive(ctx)
-	case coupon.FieldCreatedAt:
+	case session.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case session.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case session.FieldReplacedBy:
+		return m.OldReplacedBy(ctx)
+	case session.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case coupon.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Coupon field %s", name)
+	return nil, fmt.Errorf("unknown Session field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CouponMutation) SetField(name string, value ent.Value) error {
+func (m *SessionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case coupon.FieldCode:
+	case session.FieldUserID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCode(v)
+		m.SetUserID(v)
 		return nil
-	case coupon.FieldType:
-		v, ok := value.(coupon.Type)
+	case session.FieldFamilyID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetType(v)
+		m.SetFamilyID(v)
 		return nil
-	case coupon.FieldValue:
-		v, ok := value.(float64)
+	case session.FieldTokenHash:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetTokenHash(v)
 		return nil
-	case coupon.FieldMinPurchase:
-		v, ok := value.(float64)
+	case session.FieldDevice:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMinPurchase(v)
+		m.SetDevice(v)
 		return nil
-	case coupon.FieldUsageLimit:
-		v, ok := value.(int)
+	case session.FieldIP:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsageLimit(v)
+		m.SetIP(v)
 		return nil
-	case coupon.FieldUsedCount:
-		v, ok := value.(int)
+	case session.FieldUserAgent:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUsedCount(v)
+		m.SetUserAgent(v)
 		return nil
-	case coupon.FieldExpiresAt:
+	case session.FieldExpiresAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetExpiresAt(v)
 		return nil
-	case coupon.FieldActive:
-		v, ok := value.(bool)
+	case session.FieldLastUsedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetLastUsedAt(v)
 		return nil
-	case coupon.FieldCreatedAt:
+	case session.FieldRevokedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetRevokedAt(v)
 		return nil
-	case coupon.FieldUpdatedAt:
+	case session.FieldReplacedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReplacedBy(v)
+		return nil
+	case session.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Coupon field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *CouponMutation) AddedFields() []string {
-	var fields []string
-	if m.addvalue != nil {
-		fields = append(fields, coupon.FieldValue)
-	}
-	if m.addmin_purchase != nil {
-		fields = append(fields, coupon.FieldMinPurchase)
-	}
-	if m.addusage_limit != nil {
-		fields = append(fields, coupon.FieldUsageLimit)
-	}
-	if m.addused_count != nil {
-		fields = append(fields, coupon.FieldUsedCount)
-	}
-	return fields
+func (m *SessionMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *CouponMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case coupon.FieldValue:
-		return m.AddedValue()
-	case coupon.FieldMinPurchase:
-		return m.AddedMinPurchase()
-	case coupon.FieldUsageLimit:
-		return m.AddedUsageLimit()
-	case coupon.FieldUsedCount:
-		return m.AddedUsedCount()
-	}
+func (m *SessionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *CouponMutation) AddField(name string, value ent.Value) error {
+func (m *SessionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case coupon.FieldValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddValue(v)
-		return nil
-	case coupon.FieldMinPurchase:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMinPurchase(v)
-		return nil
-	case coupon.FieldUsageLimit:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUsageLimit(v)
-		return nil
-	case coupon.FieldUsedCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUsedCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Coupon numeric field %s", name)
+	return fmt.Errorf("unknown Session numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *CouponMutation) ClearedFields() []string {
+func (m *SessionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(coupon.FieldMinPurchase) {
-		fields = append(fields, coupon.FieldMinPurchase)
+	if m.FieldCleared(session.FieldUserID) {
+		fields = append(fields, session.FieldUserID)
 	}
-	if m.FieldCleared(coupon.FieldUsageLimit) {
-		fields = append(fields, coupon.FieldUsageLimit)
+	if m.FieldCleared(session.FieldDevice) {
+		fields = append(fields, session.FieldDevice)
 	}
-	if m.FieldCleared(coupon.FieldExpiresAt) {
-		fields = append(fields, coupon.FieldExpiresAt)
+	if m.FieldCleared(session.FieldIP) {
+		fields = append(fields, session.FieldIP)
+	}
+	if m.FieldCleared(session.FieldUserAgent) {
+		fields = append(fields, session.FieldUserAgent)
+	}
+	if m.FieldCleared(session.FieldRevokedAt) {
+		fields = append(fields, session.FieldRevokedAt)
+	}
+	if m.FieldCleared(session.FieldReplacedBy) {
+		fields = append(fields, session.FieldReplacedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *CouponMutation) FieldCleared(name string) bool {
+func (m *SessionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *CouponMutation) ClearField(name string) error {
+func (m *SessionMutation) ClearField(name string) error {
 	switch name {
-	case coupon.FieldMinPurchase:
-		m.ClearMinPurchase()
+	case session.FieldUserID:
+		m.ClearUserID()
 		return nil
-	case coupon.FieldUsageLimit:
-		m.ClearUsageLimit()
+	case session.FieldDevice:
+		m.ClearDevice()
 		return nil
-	case coupon.FieldExpiresAt:
-		m.ClearExpiresAt()
+	case session.FieldIP:
+		m.ClearIP()
+		return nil
+	case session.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	case session.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	case session.FieldReplacedBy:
+		m.ClearReplacedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown Coupon nullable field %s", name)
+	return fmt.Errorf("unknown Session nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *CouponMutation) ResetField(name string) error {
+func (m *SessionMutation) ResetField(name string) error {
 	switch name {
-	case coupon.FieldCode:
-		m.ResetCode()
+	case session.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case coupon.FieldType:
-		m.ResetType()
+	case session.FieldFamilyID:
+		m.ResetFamilyID()
 		return nil
-	case coupon.FieldValue:
-		m.ResetValue()
+	case session.FieldTokenHash:
+		m.ResetTokenHash()
 		return nil
-	case coupon.FieldMinPurchase:
-		m.ResetMinPurchase()
+	case session.FieldDevice:
+		m.ResetDevice()
 		return nil
-	case coupon.FieldUsageLimit:
-		m.ResetUsageLimit()
+	case session.FieldIP:
+		m.ResetIP()
 		return nil
-	case coupon.FieldUsedCount:
-		m.ResetUsedCount()
+	case session.FieldUserAgent:
+		m.ResetUserAgent()
 		return nil
-	case coupon.FieldExpiresAt:
+	case session.FieldExpiresAt:
 		m.ResetExpiresAt()
 		return nil
-	case coupon.FieldActive:
-		m.ResetActive()
+	case session.FieldLastUsedAt:
+		m.ResetLastUsedAt()
 		return nil
-	case coupon.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case session.FieldRevokedAt:
+		m.ResetRevokedAt()
 		return nil
-	case coupon.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case session.FieldReplacedBy:
+		m.ResetReplacedBy()
+		return nil
+	case session.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Coupon field %s", name)
+	return fmt.Errorf("unknown Session field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *CouponMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SessionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, session.EdgeUser)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *CouponMutation) AddedIDs(name string) []ent.Value {
+func (m *SessionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case session.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *CouponMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SessionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *CouponMutation) RemovedIDs(name string) []ent.Value {
+func (m *SessionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *CouponMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *SessionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, session.EdgeUser)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *CouponMutation) EdgeCleared(name string) bool {
+func (m *SessionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case session.EdgeUser:
+		return m.cleareduser
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *CouponMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Coupon unique edge %s", name)
+func (m *SessionMutation) ClearEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *CouponMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Coupon edge %s", name)
-}
-
-// OrderMutation represents an operation that mutates the Order nodes in the graph.
-type OrderMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *string
-	date               *time.Time
-	total              *float64
-	addtotal           *float64
-	shipping           *float64
-	addshipping        *float64
-	discount           *float64
-	adddiscount        *float64
-	delivery_type      *order.DeliveryType
-	status             *order.Status
-	payment_method     *string
-	payment_status     *string
-	coupon_code        *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	user               *string
-	cleareduser        bool
-	address            *string
-	clearedaddress     bool
-	order_items        map[string]struct{}
-	removedorder_items map[string]struct{}
-	clearedorder_items bool
-	done               bool
-	oldValue           func(context.Context) (*Order, error)
-	predicates         []predicate.Order
+// It returns an error if the edge is not defined in the schema.
+func (m *SessionMutation) ResetEdge(name string) error {
+	switch name {
+	case session.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Session edge %s", name)
 }
 
-var _ ent.Mutation = (*OrderMutation)(nil)
+// ShipmentMutation represents an operation that mutates the Shipment nodes in the graph.
+type ShipmentMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	carrier       *string
+	tracking_code *string
+	status        *shipment.Status
+	shipped_at    *time.Time
+	delivered_at  *time.Time
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	_order        *string
+	cleared_order bool
+	events        map[string]struct{}
+	removedevents map[string]struct{}
+	clearedevents bool
+	done          bool
+	oldValue      func(context.Context) (*Shipment, error)
+	predicates    []predicate.Shipment
+}
 
-// orderOption allows management of the mutation configuration using functional options.
-type orderOption func(*OrderMutation)
+var _ ent.Mutation = (*ShipmentMutation)(nil)
 
-// newOrderMutation creates new mutation for the Order entity.
-func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
-	m := &OrderMutation{
+// shipmentOption allows management of the mutation configuration using functional options.
+type shipmentOption func(*ShipmentMutation)
+
+// newShipmentMutation creates new mutation for the Shipment entity.
+func newShipmentMutation(c config, op Op, opts ...shipmentOption) *ShipmentMutation {
+	m := &ShipmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrder,
+		typ:           TypeShipment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5823,20 +20115,20 @@ func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
 	return m
 }
 
-// withOrderID sets the ID field of the mutation.
-func withOrderID(id string) orderOption {
-	return func(m *OrderMutation) {
+// withShipmentID sets the ID field of the mutation.
+func withShipmentID(id string) shipmentOption {
+	return func(m *ShipmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Order
+			value *Shipment
 		)
-		m.oldValue = func(ctx context.Context) (*Order, error) {
+		m.oldValue = func(ctx context.Context) (*Shipment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Order.Get(ctx, id)
+					value, err = m.Client().Shipment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5845,10 +20137,10 @@ func withOrderID(id string) orderOption {
 	}
 }
 
-// withOrder sets the old Order of the mutation.
-func withOrder(node *Order) orderOption {
-	return func(m *OrderMutation) {
-		m.oldValue = func(context.Context) (*Order, error) {
+// withShipment sets the old Shipment of the mutation.
+func withShipment(node *Shipment) shipmentOption {
+	return func(m *ShipmentMutation) {
+		m.oldValue = func(context.Context) (*Shipment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5857,7 +20149,7 @@ func withOrder(node *Order) orderOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrderMutation) Client() *Client {
+func (m ShipmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5865,7 +20157,7 @@ func (m OrderMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrderMutation) Tx() (*Tx, error) {
+func (m ShipmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5875,14 +20167,14 @@ func (m OrderMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Order entities.
-func (m *OrderMutation) SetID(id string) {
+// operation is only accepted on creation of Shipment entities.
+func (m *ShipmentMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrderMutation) ID() (id string, exists bool) {
+func (m *ShipmentMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5893,7 +20185,7 @@ func (m *OrderMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrderMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *ShipmentMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5902,696 +20194,1066 @@ func (m *OrderMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Shipment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *OrderMutation) SetUserID(s string) {
-	m.user = &s
+// SetOrderID sets the "order_id" field.
+func (m *ShipmentMutation) SetOrderID(s string) {
+	m._order = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *OrderMutation) UserID() (r string, exists bool) {
-	v := m.user
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *ShipmentMutation) OrderID() (r string, exists bool) {
+	v := m._order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldOrderID returns the old "order_id" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldUserID(ctx context.Context) (v string, err error) {
+func (m *ShipmentMutation) OldOrderID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.OrderID, nil
 }
 
-// ClearUserID clears the value of the "user_id" field.
-func (m *OrderMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[order.FieldUserID] = struct{}{}
+// ClearOrderID clears the value of the "order_id" field.
+func (m *ShipmentMutation) ClearOrderID() {
+	m._order = nil
+	m.clearedFields[shipment.FieldOrderID] = struct{}{}
 }
 
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *OrderMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[order.FieldUserID]
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *ShipmentMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[shipment.FieldOrderID]
 	return ok
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *OrderMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, order.FieldUserID)
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *ShipmentMutation) ResetOrderID() {
+	m._order = nil
+	delete(m.clearedFields, shipment.FieldOrderID)
 }
 
-// SetDate sets the "date" field.
-func (m *OrderMutation) SetDate(t time.Time) {
-	m.date = &t
+// SetCarrier sets the "carrier" field.
+func (m *ShipmentMutation) SetCarrier(s string) {
+	m.carrier = &s
 }
 
-// Date returns the value of the "date" field in the mutation.
-func (m *OrderMutation) Date() (r time.Time, exists bool) {
-	v := m.date
+// Carrier returns the value of the "carrier" field in the mutation.
+func (m *ShipmentMutation) Carrier() (r string, exists bool) {
+	v := m.carrier
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDate returns the old "date" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldCarrier returns the old "carrier" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldDate(ctx context.Context) (v time.Time, err error) {
+func (m *ShipmentMutation) OldCarrier(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCarrier is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDate requires an ID field in the mutation")
+		return v, errors.New("OldCarrier requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCarrier: %w", err)
 	}
-	return oldValue.Date, nil
+	return oldValue.Carrier, nil
 }
 
-// ResetDate resets all changes to the "date" field.
-func (m *OrderMutation) ResetDate() {
-	m.date = nil
+// ResetCarrier resets all changes to the "carrier" field.
+func (m *ShipmentMutation) ResetCarrier() {
+	m.carrier = nil
 }
 
-// SetTotal sets the "total" field.
-func (m *OrderMutation) SetTotal(f float64) {
-	m.total = &f
-	m.addtotal = nil
+// SetTrackingCode sets the "tracking_code" field.
+func (m *ShipmentMutation) SetTrackingCode(s string) {
+	m.tracking_code = &s
 }
 
-// Total returns the value of the "total" field in the mutation.
-func (m *OrderMutation) Total() (r float64, exists bool) {
-	v := m.total
+// TrackingCode returns the value of the "tracking_code" field in the mutation.
+func (m *ShipmentMutation) TrackingCode() (r string, exists bool) {
+	v := m.tracking_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotal returns the old "total" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldTrackingCode returns the old "tracking_code" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldTotal(ctx context.Context) (v float64, err error) {
+func (m *ShipmentMutation) OldTrackingCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotal is only allowed on UpdateOne operations")
+		return v, errors.New("OldTrackingCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotal requires an ID field in the mutation")
+		return v, errors.New("OldTrackingCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotal: %w", err)
+		return v, fmt.Errorf("querying old value for OldTrackingCode: %w", err)
+	}
+	return oldValue.TrackingCode, nil
+}
+
+// ResetTrackingCode resets all changes to the "tracking_code" field.
+func (m *ShipmentMutation) ResetTrackingCode() {
+	m.tracking_code = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ShipmentMutation) SetStatus(s shipment.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ShipmentMutation) Status() (r shipment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShipmentMutation) OldStatus(ctx context.Context) (v shipment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ShipmentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (m *ShipmentMutation) SetShippedAt(t time.Time) {
+	m.shipped_at = &t
+}
+
+// ShippedAt returns the value of the "shipped_at" field in the mutation.
+func (m *ShipmentMutation) ShippedAt() (r time.Time, exists bool) {
+	v := m.shipped_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShippedAt returns the old "shipped_at" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShipmentMutation) OldShippedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShippedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShippedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShippedAt: %w", err)
+	}
+	return oldValue.ShippedAt, nil
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (m *ShipmentMutation) ClearShippedAt() {
+	m.shipped_at = nil
+	m.clearedFields[shipment.FieldShippedAt] = struct{}{}
+}
+
+// ShippedAtCleared returns if the "shipped_at" field was cleared in this mutation.
+func (m *ShipmentMutation) ShippedAtCleared() bool {
+	_, ok := m.clearedFields[shipment.FieldShippedAt]
+	return ok
+}
+
+// ResetShippedAt resets all changes to the "shipped_at" field.
+func (m *ShipmentMutation) ResetShippedAt() {
+	m.shipped_at = nil
+	delete(m.clearedFields, shipment.FieldShippedAt)
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *ShipmentMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
+}
+
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *ShipmentMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeliveredAt returns the old "delivered_at" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShipmentMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
+	}
+	return oldValue.DeliveredAt, nil
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *ShipmentMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[shipment.FieldDeliveredAt] = struct{}{}
+}
+
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *ShipmentMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[shipment.FieldDeliveredAt]
+	return ok
+}
+
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *ShipmentMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, shipment.FieldDeliveredAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ShipmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ShipmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShipmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ShipmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ShipmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ShipmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Shipment entity.
+// If the Shipment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ShipmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ShipmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (m *ShipmentMutation) ClearOrder() {
+	m.cleared_order = true
+	m.clearedFields[shipment.FieldOrderID] = struct{}{}
+}
+
+// OrderCleared reports if the "order" edge to the Order entity was cleared.
+func (m *ShipmentMutation) OrderCleared() bool {
+	return m.OrderIDCleared() || m.cleared_order
+}
+
+// OrderIDs returns the "order" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrderID instead. It exists only for internal usage by the builders.
+func (m *ShipmentMutation) OrderIDs() (ids []string) {
+	if id := m._order; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Total, nil
+	return
 }
 
-// AddTotal adds f to the "total" field.
-func (m *OrderMutation) AddTotal(f float64) {
-	if m.addtotal != nil {
-		*m.addtotal += f
-	} else {
-		m.addtotal = &f
-	}
+// ResetOrder resets all changes to the "order" edge.
+func (m *ShipmentMutation) ResetOrder() {
+	m._order = nil
+	m.cleared_order = false
 }
 
-// AddedTotal returns the value that was added to the "total" field in this mutation.
-func (m *OrderMutation) AddedTotal() (r float64, exists bool) {
-	v := m.addtotal
-	if v == nil {
-		return
+// AddEventIDs adds the "events" edge to the TrackingEvent entity by ids.
+func (m *ShipmentMutation) AddEventIDs(ids ...string) {
+	if m.events == nil {
+		m.events = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetTotal resets all changes to the "total" field.
-func (m *OrderMutation) ResetTotal() {
-	m.total = nil
-	m.addtotal = nil
+// ClearEvents clears the "events" edge to the TrackingEvent entity.
+func (m *ShipmentMutation) ClearEvents() {
+	m.clearedevents = true
 }
 
-// SetShipping sets the "shipping" field.
-func (m *OrderMutation) SetShipping(f float64) {
-	m.shipping = &f
-	m.addshipping = nil
+// EventsCleared reports if the "events" edge to the TrackingEvent entity was cleared.
+func (m *ShipmentMutation) EventsCleared() bool {
+	return m.clearedevents
 }
 
-// Shipping returns the value of the "shipping" field in the mutation.
-func (m *OrderMutation) Shipping() (r float64, exists bool) {
-	v := m.shipping
-	if v == nil {
-		return
+// RemoveEventIDs removes the "events" edge to the TrackingEvent entity by IDs.
+func (m *ShipmentMutation) RemoveEventIDs(ids ...string) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldShipping returns the old "shipping" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldShipping(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldShipping is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldShipping requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldShipping: %w", err)
+// RemovedEvents returns the removed IDs of the "events" edge to the TrackingEvent entity.
+func (m *ShipmentMutation) RemovedEventsIDs() (ids []string) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
 	}
-	return oldValue.Shipping, nil
+	return
 }
 
-// AddShipping adds f to the "shipping" field.
-func (m *OrderMutation) AddShipping(f float64) {
-	if m.addshipping != nil {
-		*m.addshipping += f
-	} else {
-		m.addshipping = &f
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *ShipmentMutation) EventsIDs() (ids []string) {
+	for id := range m.events {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedShipping returns the value that was added to the "shipping" field in this mutation.
-func (m *OrderMutation) AddedShipping() (r float64, exists bool) {
-	v := m.addshipping
-	if v == nil {
-		return
+// ResetEvents resets all changes to the "events" edge.
+func (m *ShipmentMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// Where appends a list predicates to the ShipmentMutation builder.
+func (m *ShipmentMutation) Where(ps ...predicate.Shipment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ShipmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ShipmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Shipment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetShipping resets all changes to the "shipping" field.
-func (m *OrderMutation) ResetShipping() {
-	m.shipping = nil
-	m.addshipping = nil
+// Op returns the operation name.
+func (m *ShipmentMutation) Op() Op {
+	return m.op
 }
 
-// SetDiscount sets the "discount" field.
-func (m *OrderMutation) SetDiscount(f float64) {
-	m.discount = &f
-	m.adddiscount = nil
+// SetOp allows setting the mutation operation.
+func (m *ShipmentMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Discount returns the value of the "discount" field in the mutation.
-func (m *OrderMutation) Discount() (r float64, exists bool) {
-	v := m.discount
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (Shipment).
+func (m *ShipmentMutation) Type() string {
+	return m.typ
 }
 
-// OldDiscount returns the old "discount" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldDiscount(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDiscount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ShipmentMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m._order != nil {
+		fields = append(fields, shipment.FieldOrderID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDiscount requires an ID field in the mutation")
+	if m.carrier != nil {
+		fields = append(fields, shipment.FieldCarrier)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDiscount: %w", err)
+	if m.tracking_code != nil {
+		fields = append(fields, shipment.FieldTrackingCode)
 	}
-	return oldValue.Discount, nil
+	if m.status != nil {
+		fields = append(fields, shipment.FieldStatus)
+	}
+	if m.shipped_at != nil {
+		fields = append(fields, shipment.FieldShippedAt)
+	}
+	if m.delivered_at != nil {
+		fields = append(fields, shipment.FieldDeliveredAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, shipment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, shipment.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// AddDiscount adds f to the "discount" field.
-func (m *OrderMutation) AddDiscount(f float64) {
-	if m.adddiscount != nil {
-		*m.adddiscount += f
-	} else {
-		m.adddiscount = &f
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ShipmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case shipment.FieldOrderID:
+		return m.OrderID()
+	case shipment.FieldCarrier:
+		return m.Carrier()
+	case shipment.FieldTrackingCode:
+		return m.TrackingCode()
+	case shipment.FieldStatus:
+		return m.Status()
+	case shipment.FieldShippedAt:
+		return m.ShippedAt()
+	case shipment.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case shipment.FieldCreatedAt:
+		return m.CreatedAt()
+	case shipment.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
+	return nil, false
 }
 
-// AddedDiscount returns the value that was added to the "discount" field in this mutation.
-func (m *OrderMutation) AddedDiscount() (r float64, exists bool) {
-	v := m.adddiscount
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ShipmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case shipment.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case shipment.FieldCarrier:
+		return m.OldCarrier(ctx)
+	case shipment.FieldTrackingCode:
+		return m.OldTrackingCode(ctx)
+	case shipment.FieldStatus:
+		return m.OldStatus(ctx)
+	case shipment.FieldShippedAt:
+		return m.OldShippedAt(ctx)
+	case shipment.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case shipment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case shipment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown Shipment field %s", name)
 }
 
-// ResetDiscount resets all changes to the "discount" field.
-func (m *OrderMutation) ResetDiscount() {
-	m.discount = nil
-	m.adddiscount = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShipmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case shipment.FieldOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderID(v)
+		return nil
+	case shipment.FieldCarrier:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCarrier(v)
+		return nil
+	case shipment.FieldTrackingCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrackingCode(v)
+		return nil
+	case shipment.FieldStatus:
+		v, ok := value.(shipment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case shipment.FieldShippedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShippedAt(v)
+		return nil
+	case shipment.FieldDeliveredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeliveredAt(v)
+		return nil
+	case shipment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case shipment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Shipment field %s", name)
 }
 
-// SetDeliveryType sets the "delivery_type" field.
-func (m *OrderMutation) SetDeliveryType(ot order.DeliveryType) {
-	m.delivery_type = &ot
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ShipmentMutation) AddedFields() []string {
+	return nil
 }
 
-// DeliveryType returns the value of the "delivery_type" field in the mutation.
-func (m *OrderMutation) DeliveryType() (r order.DeliveryType, exists bool) {
-	v := m.delivery_type
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ShipmentMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ShipmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown Shipment numeric field %s", name)
 }
 
-// OldDeliveryType returns the old "delivery_type" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldDeliveryType(ctx context.Context) (v order.DeliveryType, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeliveryType is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ShipmentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(shipment.FieldOrderID) {
+		fields = append(fields, shipment.FieldOrderID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeliveryType requires an ID field in the mutation")
+	if m.FieldCleared(shipment.FieldShippedAt) {
+		fields = append(fields, shipment.FieldShippedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeliveryType: %w", err)
+	if m.FieldCleared(shipment.FieldDeliveredAt) {
+		fields = append(fields, shipment.FieldDeliveredAt)
 	}
-	return oldValue.DeliveryType, nil
+	return fields
 }
 
-// ResetDeliveryType resets all changes to the "delivery_type" field.
-func (m *OrderMutation) ResetDeliveryType() {
-	m.delivery_type = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ShipmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetStatus sets the "status" field.
-func (m *OrderMutation) SetStatus(o order.Status) {
-	m.status = &o
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ShipmentMutation) ClearField(name string) error {
+	switch name {
+	case shipment.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case shipment.FieldShippedAt:
+		m.ClearShippedAt()
+		return nil
+	case shipment.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Shipment nullable field %s", name)
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *OrderMutation) Status() (r order.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ShipmentMutation) ResetField(name string) error {
+	switch name {
+	case shipment.FieldOrderID:
+		m.ResetOrderID()
+		return nil
+	case shipment.FieldCarrier:
+		m.ResetCarrier()
+		return nil
+	case shipment.FieldTrackingCode:
+		m.ResetTrackingCode()
+		return nil
+	case shipment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case shipment.FieldShippedAt:
+		m.ResetShippedAt()
+		return nil
+	case shipment.FieldDeliveredAt:
+		m.ResetDeliveredAt()
+		return nil
+	case shipment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case shipment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Shipment field %s", name)
 }
 
-// OldStatus returns the old "status" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldStatus(ctx context.Context) (v order.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ShipmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m._order != nil {
+		edges = append(edges, shipment.EdgeOrder)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.events != nil {
+		edges = append(edges, shipment.EdgeEvents)
 	}
-	return oldValue.Status, nil
+	return edges
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *OrderMutation) ResetStatus() {
-	m.status = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ShipmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case shipment.EdgeOrder:
+		if id := m._order; id != nil {
+			return []ent.Value{*id}
+		}
+	case shipment.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetAddressID sets the "address_id" field.
-func (m *OrderMutation) SetAddressID(s string) {
-	m.address = &s
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ShipmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedevents != nil {
+		edges = append(edges, shipment.EdgeEvents)
+	}
+	return edges
 }
 
-// AddressID returns the value of the "address_id" field in the mutation.
-func (m *OrderMutation) AddressID() (r string, exists bool) {
-	v := m.address
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ShipmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case shipment.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldAddressID returns the old "address_id" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldAddressID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAddressID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAddressID requires an ID field in the mutation")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ShipmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleared_order {
+		edges = append(edges, shipment.EdgeOrder)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAddressID: %w", err)
+	if m.clearedevents {
+		edges = append(edges, shipment.EdgeEvents)
 	}
-	return oldValue.AddressID, nil
-}
-
-// ClearAddressID clears the value of the "address_id" field.
-func (m *OrderMutation) ClearAddressID() {
-	m.address = nil
-	m.clearedFields[order.FieldAddressID] = struct{}{}
-}
-
-// AddressIDCleared returns if the "address_id" field was cleared in this mutation.
-func (m *OrderMutation) AddressIDCleared() bool {
-	_, ok := m.clearedFields[order.FieldAddressID]
-	return ok
-}
-
-// ResetAddressID resets all changes to the "address_id" field.
-func (m *OrderMutation) ResetAddressID() {
-	m.address = nil
-	delete(m.clearedFields, order.FieldAddressID)
+	return edges
 }
 
-// SetPaymentMethod sets the "payment_method" field.
-func (m *OrderMutation) SetPaymentMethod(s string) {
-	m.payment_method = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ShipmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case shipment.EdgeOrder:
+		return m.cleared_order
+	case shipment.EdgeEvents:
+		return m.clearedevents
+	}
+	return false
 }
 
-// PaymentMethod returns the value of the "payment_method" field in the mutation.
-func (m *OrderMutation) PaymentMethod() (r string, exists bool) {
-	v := m.payment_method
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ShipmentMutation) ClearEdge(name string) error {
+	switch name {
+	case shipment.EdgeOrder:
+		m.ClearOrder()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Shipment unique edge %s", name)
 }
 
-// OldPaymentMethod returns the old "payment_method" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldPaymentMethod(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentMethod is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentMethod requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentMethod: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ShipmentMutation) ResetEdge(name string) error {
+	switch name {
+	case shipment.EdgeOrder:
+		m.ResetOrder()
+		return nil
+	case shipment.EdgeEvents:
+		m.ResetEvents()
+		return nil
 	}
-	return oldValue.PaymentMethod, nil
+	return fmt.Errorf("unknown Shipment edge %s", name)
 }
 
-// ResetPaymentMethod resets all changes to the "payment_method" field.
-func (m *OrderMutation) ResetPaymentMethod() {
-	m.payment_method = nil
+// SlugRedirectMutation represents an operation that mutates the SlugRedirect nodes in the graph.
+type SlugRedirectMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	entity        *slugredirect.Entity
+	slug          *string
+	target_id     *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*SlugRedirect, error)
+	predicates    []predicate.SlugRedirect
 }
 
-// SetPaymentStatus sets the "payment_status" field.
-func (m *OrderMutation) SetPaymentStatus(s string) {
-	m.payment_status = &s
-}
+var _ ent.Mutation = (*SlugRedirectMutation)(nil)
 
-// PaymentStatus returns the value of the "payment_status" field in the mutation.
-func (m *OrderMutation) PaymentStatus() (r string, exists bool) {
-	v := m.payment_status
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// slugredirectOption allows management of the mutation configuration using functional options.
+type slugredirectOption func(*SlugRedirectMutation)
 
-// OldPaymentStatus returns the old "payment_status" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldPaymentStatus(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentStatus requires an ID field in the mutation")
+// newSlugRedirectMutation creates new mutation for the SlugRedirect entity.
+func newSlugRedirectMutation(c config, op Op, opts ...slugredirectOption) *SlugRedirectMutation {
+	m := &SlugRedirectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSlugRedirect,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentStatus: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.PaymentStatus, nil
+	return m
 }
 
-// ResetPaymentStatus resets all changes to the "payment_status" field.
-func (m *OrderMutation) ResetPaymentStatus() {
-	m.payment_status = nil
+// withSlugRedirectID sets the ID field of the mutation.
+func withSlugRedirectID(id string) slugredirectOption {
+	return func(m *SlugRedirectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SlugRedirect
+		)
+		m.oldValue = func(ctx context.Context) (*SlugRedirect, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SlugRedirect.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// SetCouponCode sets the "coupon_code" field.
-func (m *OrderMutation) SetCouponCode(s string) {
-	m.coupon_code = &s
+// withSlugRedirect sets the old SlugRedirect of the mutation.
+func withSlugRedirect(node *SlugRedirect) slugredirectOption {
+	return func(m *SlugRedirectMutation) {
+		m.oldValue = func(context.Context) (*SlugRedirect, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// CouponCode returns the value of the "coupon_code" field in the mutation.
-func (m *OrderMutation) CouponCode() (r string, exists bool) {
-	v := m.coupon_code
-	if v == nil {
-		return
-	}
-	return *v, true
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SlugRedirectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// OldCouponCode returns the old "coupon_code" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldCouponCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCouponCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCouponCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCouponCode: %w", err)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SlugRedirectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.CouponCode, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearCouponCode clears the value of the "coupon_code" field.
-func (m *OrderMutation) ClearCouponCode() {
-	m.coupon_code = nil
-	m.clearedFields[order.FieldCouponCode] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SlugRedirect entities.
+func (m *SlugRedirectMutation) SetID(id string) {
+	m.id = &id
 }
 
-// CouponCodeCleared returns if the "coupon_code" field was cleared in this mutation.
-func (m *OrderMutation) CouponCodeCleared() bool {
-	_, ok := m.clearedFields[order.FieldCouponCode]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SlugRedirectMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetCouponCode resets all changes to the "coupon_code" field.
-func (m *OrderMutation) ResetCouponCode() {
-	m.coupon_code = nil
-	delete(m.clearedFields, order.FieldCouponCode)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SlugRedirectMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SlugRedirect.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *OrderMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEntity sets the "entity" field.
+func (m *SlugRedirectMutation) SetEntity(s slugredirect.Entity) {
+	m.entity = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrderMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Entity returns the value of the "entity" field in the mutation.
+func (m *SlugRedirectMutation) Entity() (r slugredirect.Entity, exists bool) {
+	v := m.entity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldEntity returns the old "entity" field's value of the SlugRedirect entity.
+// If the SlugRedirect object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SlugRedirectMutation) OldEntity(ctx context.Context) (v slugredirect.Entity, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEntity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEntity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEntity: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Entity, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrderMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEntity resets all changes to the "entity" field.
+func (m *SlugRedirectMutation) ResetEntity() {
+	m.entity = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OrderMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetSlug sets the "slug" field.
+func (m *SlugRedirectMutation) SetSlug(s string) {
+	m.slug = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrderMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Slug returns the value of the "slug" field in the mutation.
+func (m *SlugRedirectMutation) Slug() (r string, exists bool) {
+	v := m.slug
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Order entity.
-// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// OldSlug returns the old "slug" field's value of the SlugRedirect entity.
+// If the SlugRedirect object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SlugRedirectMutation) OldSlug(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldSlug requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrderMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.Slug, nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *OrderMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[order.FieldUserID] = struct{}{}
+// ResetSlug resets all changes to the "slug" field.
+func (m *SlugRedirectMutation) ResetSlug() {
+	m.slug = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *OrderMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// SetTargetID sets the "target_id" field.
+func (m *SlugRedirectMutation) SetTargetID(s string) {
+	m.target_id = &s
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *OrderMutation) UserIDs() (ids []string) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *SlugRedirectMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *OrderMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// ClearAddress clears the "address" edge to the Address entity.
-func (m *OrderMutation) ClearAddress() {
-	m.clearedaddress = true
-	m.clearedFields[order.FieldAddressID] = struct{}{}
-}
-
-// AddressCleared reports if the "address" edge to the Address entity was cleared.
-func (m *OrderMutation) AddressCleared() bool {
-	return m.AddressIDCleared() || m.clearedaddress
+	return *v, true
 }
 
-// AddressIDs returns the "address" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AddressID instead. It exists only for internal usage by the builders.
-func (m *OrderMutation) AddressIDs() (ids []string) {
-	if id := m.address; id != nil {
-		ids = append(ids, *id)
+// OldTargetID returns the old "target_id" field's value of the SlugRedirect entity.
+// If the SlugRedirect object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlugRedirectMutation) OldTargetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetAddress resets all changes to the "address" edge.
-func (m *OrderMutation) ResetAddress() {
-	m.address = nil
-	m.clearedaddress = false
-}
-
-// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by ids.
-func (m *OrderMutation) AddOrderItemIDs(ids ...string) {
-	if m.order_items == nil {
-		m.order_items = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.order_items[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
+	return oldValue.TargetID, nil
 }
 
-// ClearOrderItems clears the "order_items" edge to the OrderItem entity.
-func (m *OrderMutation) ClearOrderItems() {
-	m.clearedorder_items = true
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *SlugRedirectMutation) ResetTargetID() {
+	m.target_id = nil
 }
 
-// OrderItemsCleared reports if the "order_items" edge to the OrderItem entity was cleared.
-func (m *OrderMutation) OrderItemsCleared() bool {
-	return m.clearedorder_items
+// SetCreatedAt sets the "created_at" field.
+func (m *SlugRedirectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveOrderItemIDs removes the "order_items" edge to the OrderItem entity by IDs.
-func (m *OrderMutation) RemoveOrderItemIDs(ids ...string) {
-	if m.removedorder_items == nil {
-		m.removedorder_items = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.order_items, ids[i])
-		m.removedorder_items[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SlugRedirectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedOrderItems returns the removed IDs of the "order_items" edge to the OrderItem entity.
-func (m *OrderMutation) RemovedOrderItemsIDs() (ids []string) {
-	for id := range m.removedorder_items {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the SlugRedirect entity.
+// If the SlugRedirect object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SlugRedirectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// OrderItemsIDs returns the "order_items" edge IDs in the mutation.
-func (m *OrderMutation) OrderItemsIDs() (ids []string) {
-	for id := range m.order_items {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetOrderItems resets all changes to the "order_items" edge.
-func (m *OrderMutation) ResetOrderItems() {
-	m.order_items = nil
-	m.clearedorder_items = false
-	m.removedorder_items = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SlugRedirectMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the OrderMutation builder.
-func (m *OrderMutation) Where(ps ...predicate.Order) {
+// Where appends a list predicates to the SlugRedirectMutation builder.
+func (m *SlugRedirectMutation) Where(ps ...predicate.SlugRedirect) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SlugRedirectMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Order, len(ps))
+func (m *SlugRedirectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SlugRedirect, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6599,63 +21261,36 @@ func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrderMutation) Op() Op {
+func (m *SlugRedirectMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrderMutation) SetOp(op Op) {
+func (m *SlugRedirectMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Order).
-func (m *OrderMutation) Type() string {
+// Type returns the node type of this mutation (SlugRedirect).
+func (m *SlugRedirectMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrderMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.user != nil {
-		fields = append(fields, order.FieldUserID)
-	}
-	if m.date != nil {
-		fields = append(fields, order.FieldDate)
-	}
-	if m.total != nil {
-		fields = append(fields, order.FieldTotal)
-	}
-	if m.shipping != nil {
-		fields = append(fields, order.FieldShipping)
-	}
-	if m.discount != nil {
-		fields = append(fields, order.FieldDiscount)
-	}
-	if m.delivery_type != nil {
-		fields = append(fields, order.FieldDeliveryType)
-	}
-	if m.status != nil {
-		fields = append(fields, order.FieldStatus)
-	}
-	if m.address != nil {
-		fields = append(fields, order.FieldAddressID)
-	}
-	if m.payment_method != nil {
-		fields = append(fields, order.FieldPaymentMethod)
+func (m *SlugRedirectMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.entity != nil {
+		fields = append(fields, slugredirect.FieldEntity)
 	}
-	if m.payment_status != nil {
-		fields = append(fields, order.FieldPaymentStatus)
+	if m.slug != nil {
+		fields = append(fields, slugredirect.FieldSlug)
 	}
-	if m.coupon_code != nil {
-		fields = append(fields, order.FieldCouponCode)
+	if m.target_id != nil {
+		fields = append(fields, slugredirect.FieldTargetID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, order.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, order.FieldUpdatedAt)
+		fields = append(fields, slugredirect.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6663,34 +21298,16 @@ func (m *OrderMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+func (m *SlugRedirectMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case order.FieldUserID:
-		return m.UserID()
-	case order.FieldDate:
-		return m.Date()
-	case order.FieldTotal:
-		return m.Total()
-	case order.FieldShipping:
-		return m.Shipping()
-	case order.FieldDiscount:
-		return m.Discount()
-	case order.FieldDeliveryType:
-		return m.DeliveryType()
-	case order.FieldStatus:
-		return m.Status()
-	case order.FieldAddressID:
-		return m.AddressID()
-	case order.FieldPaymentMethod:
-		return m.PaymentMethod()
-	case order.FieldPaymentStatus:
-		return m.PaymentStatus()
-	case order.FieldCouponCode:
-		return m.CouponCode()
-	case order.FieldCreatedAt:
+	case slugredirect.FieldEntity:
+		return m.Entity()
+	case slugredirect.FieldSlug:
+		return m.Slug()
+	case slugredirect.FieldTargetID:
+		return m.TargetID()
+	case slugredirect.FieldCreatedAt:
 		return m.CreatedAt()
-	case order.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6698,441 +21315,196 @@ func (m *OrderMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SlugRedirectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case order.FieldUserID:
-		return m.OldUserID(ctx)
-	case order.FieldDate:
-		return m.OldDate(ctx)
-	case order.FieldTotal:
-		return m.OldTotal(ctx)
-	case order.FieldShipping:
-		return m.OldShipping(ctx)
-	case order.FieldDiscount:
-		return m.OldDiscount(ctx)
-	case order.FieldDeliveryType:
-		return m.OldDeliveryType(ctx)
-	case order.FieldStatus:
-		return m.OldStatus(ctx)
-	case order.FieldAddressID:
-		return m.OldAddressID(ctx)
-	case order.FieldPaymentMethod:
-		return m.OldPaymentMethod(ctx)
-	case order.FieldPaymentStatus:
-		return m.OldPaymentStatus(ctx)
-	case order.FieldCouponCode:
-		return m.OldCouponCode(ctx)
-	case order.FieldCreatedAt:
+	case slugredirect.FieldEntity:
+		return m.OldEntity(ctx)
+	case slugredirect.FieldSlug:
+		return m.OldSlug(ctx)
+	case slugredirect.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case slugredirect.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case order.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Order field %s", name)
+	return nil, fmt.Errorf("unknown SlugRedirect field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) SetField(name string, value ent.Value) error {
+func (m *SlugRedirectMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldUserID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case order.FieldDate:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDate(v)
-		return nil
-	case order.FieldTotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotal(v)
-		return nil
-	case order.FieldShipping:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetShipping(v)
-		return nil
-	case order.FieldDiscount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDiscount(v)
-		return nil
-	case order.FieldDeliveryType:
-		v, ok := value.(order.DeliveryType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeliveryType(v)
-		return nil
-	case order.FieldStatus:
-		v, ok := value.(order.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case order.FieldAddressID:
-		v, ok := value.(string)
+	case slugredirect.FieldEntity:
+		v, ok := value.(slugredirect.Entity)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAddressID(v)
-		return nil
-	case order.FieldPaymentMethod:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPaymentMethod(v)
+		m.SetEntity(v)
 		return nil
-	case order.FieldPaymentStatus:
+	case slugredirect.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentStatus(v)
+		m.SetSlug(v)
 		return nil
-	case order.FieldCouponCode:
+	case slugredirect.FieldTargetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCouponCode(v)
+		m.SetTargetID(v)
 		return nil
-	case order.FieldCreatedAt:
+	case slugredirect.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case order.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown SlugRedirect field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderMutation) AddedFields() []string {
-	var fields []string
-	if m.addtotal != nil {
-		fields = append(fields, order.FieldTotal)
-	}
-	if m.addshipping != nil {
-		fields = append(fields, order.FieldShipping)
-	}
-	if m.adddiscount != nil {
-		fields = append(fields, order.FieldDiscount)
-	}
-	return fields
+func (m *SlugRedirectMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case order.FieldTotal:
-		return m.AddedTotal()
-	case order.FieldShipping:
-		return m.AddedShipping()
-	case order.FieldDiscount:
-		return m.AddedDiscount()
-	}
+func (m *SlugRedirectMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderMutation) AddField(name string, value ent.Value) error {
+func (m *SlugRedirectMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case order.FieldTotal:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotal(v)
-		return nil
-	case order.FieldShipping:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddShipping(v)
-		return nil
-	case order.FieldDiscount:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddDiscount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Order numeric field %s", name)
+	return fmt.Errorf("unknown SlugRedirect numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(order.FieldUserID) {
-		fields = append(fields, order.FieldUserID)
-	}
-	if m.FieldCleared(order.FieldAddressID) {
-		fields = append(fields, order.FieldAddressID)
-	}
-	if m.FieldCleared(order.FieldCouponCode) {
-		fields = append(fields, order.FieldCouponCode)
-	}
-	return fields
+func (m *SlugRedirectMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderMutation) FieldCleared(name string) bool {
+func (m *SlugRedirectMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderMutation) ClearField(name string) error {
-	switch name {
-	case order.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case order.FieldAddressID:
-		m.ClearAddressID()
-		return nil
-	case order.FieldCouponCode:
-		m.ClearCouponCode()
-		return nil
-	}
-	return fmt.Errorf("unknown Order nullable field %s", name)
+func (m *SlugRedirectMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SlugRedirect nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderMutation) ResetField(name string) error {
+func (m *SlugRedirectMutation) ResetField(name string) error {
 	switch name {
-	case order.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case order.FieldDate:
-		m.ResetDate()
-		return nil
-	case order.FieldTotal:
-		m.ResetTotal()
-		return nil
-	case order.FieldShipping:
-		m.ResetShipping()
-		return nil
-	case order.FieldDiscount:
-		m.ResetDiscount()
-		return nil
-	case order.FieldDeliveryType:
-		m.ResetDeliveryType()
-		return nil
-	case order.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case order.FieldAddressID:
-		m.ResetAddressID()
-		return nil
-	case order.FieldPaymentMethod:
-		m.ResetPaymentMethod()
+	case slugredirect.FieldEntity:
+		m.ResetEntity()
 		return nil
-	case order.FieldPaymentStatus:
-		m.ResetPaymentStatus()
+	case slugredirect.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case order.FieldCouponCode:
-		m.ResetCouponCode()
+	case slugredirect.FieldTargetID:
+		m.ResetTargetID()
 		return nil
-	case order.FieldCreatedAt:
+	case slugredirect.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case order.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Order field %s", name)
+	return fmt.Errorf("unknown SlugRedirect field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, order.EdgeUser)
-	}
-	if m.address != nil {
-		edges = append(edges, order.EdgeAddress)
-	}
-	if m.order_items != nil {
-		edges = append(edges, order.EdgeOrderItems)
-	}
+func (m *SlugRedirectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case order.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case order.EdgeAddress:
-		if id := m.address; id != nil {
-			return []ent.Value{*id}
-		}
-	case order.EdgeOrderItems:
-		ids := make([]ent.Value, 0, len(m.order_items))
-		for id := range m.order_items {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SlugRedirectMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedorder_items != nil {
-		edges = append(edges, order.EdgeOrderItems)
-	}
+func (m *SlugRedirectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case order.EdgeOrderItems:
-		ids := make([]ent.Value, 0, len(m.removedorder_items))
-		for id := range m.removedorder_items {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *SlugRedirectMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, order.EdgeUser)
-	}
-	if m.clearedaddress {
-		edges = append(edges, order.EdgeAddress)
-	}
-	if m.clearedorder_items {
-		edges = append(edges, order.EdgeOrderItems)
-	}
+func (m *SlugRedirectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *OrderMutation) EdgeCleared(name string) bool {
-	switch name {
-	case order.EdgeUser:
-		return m.cleareduser
-	case order.EdgeAddress:
-		return m.clearedaddress
-	case order.EdgeOrderItems:
-		return m.clearedorder_items
-	}
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SlugRedirectMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderMutation) ClearEdge(name string) error {
-	switch name {
-	case order.EdgeUser:
-		m.ClearUser()
-		return nil
-	case order.EdgeAddress:
-		m.ClearAddress()
-		return nil
-	}
-	return fmt.Errorf("unknown Order unique edge %s", name)
+func (m *SlugRedirectMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SlugRedirect unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderMutation) ResetEdge(name string) error {
-	switch name {
-	case order.EdgeUser:
-		m.ResetUser()
-		return nil
-	case order.EdgeAddress:
-		m.ResetAddress()
-		return nil
-	case order.EdgeOrderItems:
-		m.ResetOrderItems()
-		return nil
-	}
-	return fmt.Errorf("unknown Order edge %s", name)
+func (m *SlugRedirectMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SlugRedirect edge %s", name)
 }
 
-// OrderItemMutation represents an operation that mutates the OrderItem nodes in the graph.
-type OrderItemMutation struct {
+// TrackingEventMutation represents an operation that mutates the TrackingEvent nodes in the graph.
+type TrackingEventMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *string
-	name           *string
-	price          *float64
-	addprice       *float64
-	image          *string
-	quantity       *int
-	addquantity    *int
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	_order         *string
-	cleared_order  bool
-	product        *string
-	clearedproduct bool
-	done           bool
-	oldValue       func(context.Context) (*OrderItem, error)
-	predicates     []predicate.OrderItem
+	op              Op
+	typ             string
+	id              *string
+	status          *trackingevent.Status
+	description     *string
+	location        *string
+	occurred_at     *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	shipment        *string
+	clearedshipment bool
+	done            bool
+	oldValue        func(context.Context) (*TrackingEvent, error)
+	predicates      []predicate.TrackingEvent
 }
 
-var _ ent.Mutation = (*OrderItemMutation)(nil)
+var _ ent.Mutation = (*TrackingEventMutation)(nil)
 
-// orderitemOption allows management of the mutation configuration using functional options.
-type orderitemOption func(*OrderItemMutation)
+// trackingeventOption allows management of the mutation configuration using functional options.
+type trackingeventOption func(*TrackingEventMutation)
 
-// newOrderItemMutation creates new mutation for the OrderItem entity.
-func newOrderItemMutation(c config, op Op, opts ...orderitemOption) *OrderItemMutation {
-	m := &OrderItemMutation{
+// newTrackingEventMutation creates new mutation for the TrackingEvent entity.
+func newTrackingEventMutation(c config, op Op, opts ...trackingeventOption) *TrackingEventMutation {
+	m := &TrackingEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeOrderItem,
+		typ:           TypeTrackingEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7141,20 +21513,20 @@ func newOrderItemMutation(c config, op Op, opts ...orderitemOption) *OrderItemMu
 	return m
 }
 
-// withOrderItemID sets the ID field of the mutation.
-func withOrderItemID(id string) orderitemOption {
-	return func(m *OrderItemMutation) {
+// withTrackingEventID sets the ID field of the mutation.
+func withTrackingEventID(id string) trackingeventOption {
+	return func(m *TrackingEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *OrderItem
+			value *TrackingEvent
 		)
-		m.oldValue = func(ctx context.Context) (*OrderItem, error) {
+		m.oldValue = func(ctx context.Context) (*TrackingEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().OrderItem.Get(ctx, id)
+					value, err = m.Client().TrackingEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7163,10 +21535,10 @@ func withOrderItemID(id string) orderitemOption {
 	}
 }
 
-// withOrderItem sets the old OrderItem of the mutation.
-func withOrderItem(node *OrderItem) orderitemOption {
-	return func(m *OrderItemMutation) {
-		m.oldValue = func(context.Context) (*OrderItem, error) {
+// withTrackingEvent sets the old TrackingEvent of the mutation.
+func withTrackingEvent(node *TrackingEvent) trackingeventOption {
+	return func(m *TrackingEventMutation) {
+		m.oldValue = func(context.Context) (*TrackingEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7175,7 +21547,7 @@ func withOrderItem(node *OrderItem) orderitemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrderItemMutation) Client() *Client {
+func (m TrackingEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7183,7 +21555,7 @@ func (m OrderItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m OrderItemMutation) Tx() (*Tx, error) {
+func (m TrackingEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7193,14 +21565,14 @@ func (m OrderItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OrderItem entities.
-func (m *OrderItemMutation) SetID(id string) {
+// operation is only accepted on creation of TrackingEvent entities.
+func (m *TrackingEventMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *OrderItemMutation) ID() (id string, exists bool) {
+func (m *TrackingEventMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7211,7 +21583,7 @@ func (m *OrderItemMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *OrderItemMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *TrackingEventMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7220,429 +21592,290 @@ func (m *OrderItemMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OrderItem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().TrackingEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
-
-// SetOrderID sets the "order_id" field.
-func (m *OrderItemMutation) SetOrderID(s string) {
-	m._order = &s
-}
-
-// OrderID returns the value of the "order_id" field in the mutation.
-func (m *OrderItemMutation) OrderID() (r string, exists bool) {
-	v := m._order
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldOrderID returns the old "order_id" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldOrderID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
-	}
-	return oldValue.OrderID, nil
-}
-
-// ClearOrderID clears the value of the "order_id" field.
-func (m *OrderItemMutation) ClearOrderID() {
-	m._order = nil
-	m.clearedFields[orderitem.FieldOrderID] = struct{}{}
-}
-
-// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
-func (m *OrderItemMutation) OrderIDCleared() bool {
-	_, ok := m.clearedFields[orderitem.FieldOrderID]
-	return ok
-}
-
-// ResetOrderID resets all changes to the "order_id" field.
-func (m *OrderItemMutation) ResetOrderID() {
-	m._order = nil
-	delete(m.clearedFields, orderitem.FieldOrderID)
-}
-
-// SetProductID sets the "product_id" field.
-func (m *OrderItemMutation) SetProductID(s string) {
-	m.product = &s
-}
-
-// ProductID returns the value of the "product_id" field in the mutation.
-func (m *OrderItemMutation) ProductID() (r string, exists bool) {
-	v := m.product
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldProductID returns the old "product_id" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldProductID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
-	}
-	return oldValue.ProductID, nil
-}
-
-// ClearProductID clears the value of the "product_id" field.
-func (m *OrderItemMutation) ClearProductID() {
-	m.product = nil
-	m.clearedFields[orderitem.FieldProductID] = struct{}{}
-}
-
-// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
-func (m *OrderItemMutation) ProductIDCleared() bool {
-	_, ok := m.clearedFields[orderitem.FieldProductID]
-	return ok
-}
-
-// ResetProductID resets all changes to the "product_id" field.
-func (m *OrderItemMutation) ResetProductID() {
-	m.product = nil
-	delete(m.clearedFields, orderitem.FieldProductID)
-}
-
-// SetName sets the "name" field.
-func (m *OrderItemMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *OrderItemMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *OrderItemMutation) ResetName() {
-	m.name = nil
-}
-
-// SetPrice sets the "price" field.
-func (m *OrderItemMutation) SetPrice(f float64) {
-	m.price = &f
-	m.addprice = nil
-}
-
-// Price returns the value of the "price" field in the mutation.
-func (m *OrderItemMutation) Price() (r float64, exists bool) {
-	v := m.price
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPrice returns the old "price" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldPrice(ctx context.Context) (v float64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
-	}
-	return oldValue.Price, nil
-}
-
-// AddPrice adds f to the "price" field.
-func (m *OrderItemMutation) AddPrice(f float64) {
-	if m.addprice != nil {
-		*m.addprice += f
-	} else {
-		m.addprice = &f
-	}
-}
-
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *OrderItemMutation) AddedPrice() (r float64, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPrice resets all changes to the "price" field.
-func (m *OrderItemMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
-}
-
-// SetImage sets the "image" field.
-func (m *OrderItemMutation) SetImage(s string) {
-	m.image = &s
+
+// SetShipmentID sets the "shipment_id" field.
+func (m *TrackingEventMutation) SetShipmentID(s string) {
+	m.shipment = &s
 }
 
-// Image returns the value of the "image" field in the mutation.
-func (m *OrderItemMutation) Image() (r string, exists bool) {
-	v := m.image
+// ShipmentID returns the value of the "shipment_id" field in the mutation.
+func (m *TrackingEventMutation) ShipmentID() (r string, exists bool) {
+	v := m.shipment
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImage returns the old "image" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// OldShipmentID returns the old "shipment_id" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldImage(ctx context.Context) (v string, err error) {
+func (m *TrackingEventMutation) OldShipmentID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldShipmentID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImage requires an ID field in the mutation")
+		return v, errors.New("OldShipmentID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldShipmentID: %w", err)
 	}
-	return oldValue.Image, nil
+	return oldValue.ShipmentID, nil
 }
 
-// ResetImage resets all changes to the "image" field.
-func (m *OrderItemMutation) ResetImage() {
-	m.image = nil
+// ClearShipmentID clears the value of the "shipment_id" field.
+func (m *TrackingEventMutation) ClearShipmentID() {
+	m.shipment = nil
+	m.clearedFields[trackingevent.FieldShipmentID] = struct{}{}
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *OrderItemMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// ShipmentIDCleared returns if the "shipment_id" field was cleared in this mutation.
+func (m *TrackingEventMutation) ShipmentIDCleared() bool {
+	_, ok := m.clearedFields[trackingevent.FieldShipmentID]
+	return ok
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *OrderItemMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
+// ResetShipmentID resets all changes to the "shipment_id" field.
+func (m *TrackingEventMutation) ResetShipmentID() {
+	m.shipment = nil
+	delete(m.clearedFields, trackingevent.FieldShipmentID)
+}
+
+// SetStatus sets the "status" field.
+func (m *TrackingEventMutation) SetStatus(t trackingevent.Status) {
+	m.status = &t
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *TrackingEventMutation) Status() (r trackingevent.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldQuantity(ctx context.Context) (v int, err error) {
+func (m *TrackingEventMutation) OldStatus(ctx context.Context) (v trackingevent.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Status, nil
 }
 
-// AddQuantity adds i to the "quantity" field.
-func (m *OrderItemMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
+// ResetStatus resets all changes to the "status" field.
+func (m *TrackingEventMutation) ResetStatus() {
+	m.status = nil
 }
 
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *OrderItemMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
+// SetDescription sets the "description" field.
+func (m *TrackingEventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *TrackingEventMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *OrderItemMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
+// OldDescription returns the old "description" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingEventMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *OrderItemMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetDescription resets all changes to the "description" field.
+func (m *TrackingEventMutation) ResetDescription() {
+	m.description = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrderItemMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetLocation sets the "location" field.
+func (m *TrackingEventMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *TrackingEventMutation) Location() (r string, exists bool) {
+	v := m.location
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// OldLocation returns the old "location" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TrackingEventMutation) OldLocation(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLocation requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Location, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrderItemMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearLocation clears the value of the "location" field.
+func (m *TrackingEventMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[trackingevent.FieldLocation] = struct{}{}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OrderItemMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *TrackingEventMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[trackingevent.FieldLocation]
+	return ok
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrderItemMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetLocation resets all changes to the "location" field.
+func (m *TrackingEventMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, trackingevent.FieldLocation)
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (m *TrackingEventMutation) SetOccurredAt(t time.Time) {
+	m.occurred_at = &t
+}
+
+// OccurredAt returns the value of the "occurred_at" field in the mutation.
+func (m *TrackingEventMutation) OccurredAt() (r time.Time, exists bool) {
+	v := m.occurred_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the OrderItem entity.
-// If the OrderItem object wasn't provided to the builder, the object is fetched from the database.
+// OldOccurredAt returns the old "occurred_at" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrderItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TrackingEventMutation) OldOccurredAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOccurredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOccurredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOccurredAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.OccurredAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrderItemMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetOccurredAt resets all changes to the "occurred_at" field.
+func (m *TrackingEventMutation) ResetOccurredAt() {
+	m.occurred_at = nil
 }
 
-// ClearOrder clears the "order" edge to the Order entity.
-func (m *OrderItemMutation) ClearOrder() {
-	m.cleared_order = true
-	m.clearedFields[orderitem.FieldOrderID] = struct{}{}
+// SetCreatedAt sets the "created_at" field.
+func (m *TrackingEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// OrderCleared reports if the "order" edge to the Order entity was cleared.
-func (m *OrderItemMutation) OrderCleared() bool {
-	return m.OrderIDCleared() || m.cleared_order
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TrackingEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// OrderIDs returns the "order" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrderID instead. It exists only for internal usage by the builders.
-func (m *OrderItemMutation) OrderIDs() (ids []string) {
-	if id := m._order; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the TrackingEvent entity.
+// If the TrackingEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrackingEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetOrder resets all changes to the "order" edge.
-func (m *OrderItemMutation) ResetOrder() {
-	m._order = nil
-	m.cleared_order = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TrackingEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearProduct clears the "product" edge to the Product entity.
-func (m *OrderItemMutation) ClearProduct() {
-	m.clearedproduct = true
-	m.clearedFields[orderitem.FieldProductID] = struct{}{}
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (m *TrackingEventMutation) ClearShipment() {
+	m.clearedshipment = true
+	m.clearedFields[trackingevent.FieldShipmentID] = struct{}{}
 }
 
-// ProductCleared reports if the "product" edge to the Product entity was cleared.
-func (m *OrderItemMutation) ProductCleared() bool {
-	return m.ProductIDCleared() || m.clearedproduct
+// ShipmentCleared reports if the "shipment" edge to the Shipment entity was cleared.
+func (m *TrackingEventMutation) ShipmentCleared() bool {
+	return m.ShipmentIDCleared() || m.clearedshipment
 }
 
-// ProductIDs returns the "product" edge IDs in the mutation.
+// ShipmentIDs returns the "shipment" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProductID instead. It exists only for internal usage by the builders.
-func (m *OrderItemMutation) ProductIDs() (ids []string) {
-	if id := m.product; id != nil {
+// ShipmentID instead. It exists only for internal usage by the builders.
+func (m *TrackingEventMutation) ShipmentIDs() (ids []string) {
+	if id := m.shipment; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetProduct resets all changes to the "product" edge.
-func (m *OrderItemMutation) ResetProduct() {
-	m.product = nil
-	m.clearedproduct = false
+// ResetShipment resets all changes to the "shipment" edge.
+func (m *TrackingEventMutation) ResetShipment() {
+	m.shipment = nil
+	m.clearedshipment = false
 }
 
-// Where appends a list predicates to the OrderItemMutation builder.
-func (m *OrderItemMutation) Where(ps ...predicate.OrderItem) {
+// Where appends a list predicates to the TrackingEventMutation builder.
+func (m *TrackingEventMutation) Where(ps ...predicate.TrackingEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrderItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TrackingEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrderItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OrderItem, len(ps))
+func (m *TrackingEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TrackingEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7650,48 +21883,42 @@ func (m *OrderItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrderItemMutation) Op() Op {
+func (m *TrackingEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrderItemMutation) SetOp(op Op) {
+func (m *TrackingEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OrderItem).
-func (m *OrderItemMutation) Type() string {
+// Type returns the node type of this mutation (TrackingEvent).
+func (m *TrackingEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrderItemMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m._order != nil {
-		fields = append(fields, orderitem.FieldOrderID)
-	}
-	if m.product != nil {
-		fields = append(fields, orderitem.FieldProductID)
+func (m *TrackingEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.shipment != nil {
+		fields = append(fields, trackingevent.FieldShipmentID)
 	}
-	if m.name != nil {
-		fields = append(fields, orderitem.FieldName)
+	if m.status != nil {
+		fields = append(fields, trackingevent.FieldStatus)
 	}
-	if m.price != nil {
-		fields = append(fields, orderitem.FieldPrice)
+	if m.description != nil {
+		fields = append(fields, trackingevent.FieldDescription)
 	}
-	if m.image != nil {
-		fields = append(fields, orderitem.FieldImage)
+	if m.location != nil {
+		fields = append(fields, trackingevent.FieldLocation)
 	}
-	if m.quantity != nil {
-		fields = append(fields, orderitem.FieldQuantity)
+	if m.occurred_at != nil {
+		fields = append(fields, trackingevent.FieldOccurredAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, orderitem.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, orderitem.FieldUpdatedAt)
+		fields = append(fields, trackingevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -7699,24 +21926,20 @@ func (m *OrderItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrderItemMutation) Field(name string) (ent.Value, bool) {
+func (m *TrackingEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case orderitem.FieldOrderID:
-		return m.OrderID()
-	case orderitem.FieldProductID:
-		return m.ProductID()
-	case orderitem.FieldName:
-		return m.Name()
-	case orderitem.FieldPrice:
-		return m.Price()
-	case orderitem.FieldImage:
-		return m.Image()
-	case orderitem.FieldQuantity:
-		return m.Quantity()
-	case orderitem.FieldCreatedAt:
+	case trackingevent.FieldShipmentID:
+		return m.ShipmentID()
+	case trackingevent.FieldStatus:
+		return m.Status()
+	case trackingevent.FieldDescription:
+		return m.Description()
+	case trackingevent.FieldLocation:
+		return m.Location()
+	case trackingevent.FieldOccurredAt:
+		return m.OccurredAt()
+	case trackingevent.FieldCreatedAt:
 		return m.CreatedAt()
-	case orderitem.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7724,230 +21947,172 @@ func (m *OrderItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrderItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TrackingEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case orderitem.FieldOrderID:
-		return m.OldOrderID(ctx)
-	case orderitem.FieldProductID:
-		return m.OldProductID(ctx)
-	case orderitem.FieldName:
-		return m.OldName(ctx)
-	case orderitem.FieldPrice:
-		return m.OldPrice(ctx)
-	case orderitem.FieldImage:
-		return m.OldImage(ctx)
-	case orderitem.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case orderitem.FieldCreatedAt:
+	case trackingevent.FieldShipmentID:
+		return m.OldShipmentID(ctx)
+	case trackingevent.FieldStatus:
+		return m.OldStatus(ctx)
+	case trackingevent.FieldDescription:
+		return m.OldDescription(ctx)
+	case trackingevent.FieldLocation:
+		return m.OldLocation(ctx)
+	case trackingevent.FieldOccurredAt:
+		return m.OldOccurredAt(ctx)
+	case trackingevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case orderitem.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown OrderItem field %s", name)
+	return nil, fmt.Errorf("unknown TrackingEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderItemMutation) SetField(name string, value ent.Value) error {
+func (m *TrackingEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case orderitem.FieldOrderID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOrderID(v)
-		return nil
-	case orderitem.FieldProductID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProductID(v)
-		return nil
-	case orderitem.FieldName:
+	case trackingevent.FieldShipmentID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
-		return nil
-	case orderitem.FieldPrice:
-		v, ok := value.(float64)
+		m.SetShipmentID(v)
+		return nil
+	case trackingevent.FieldStatus:
+		v, ok := value.(trackingevent.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetStatus(v)
 		return nil
-	case orderitem.FieldImage:
+	case trackingevent.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImage(v)
+		m.SetDescription(v)
 		return nil
-	case orderitem.FieldQuantity:
-		v, ok := value.(int)
+	case trackingevent.FieldLocation:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetLocation(v)
 		return nil
-	case orderitem.FieldCreatedAt:
+	case trackingevent.FieldOccurredAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetOccurredAt(v)
 		return nil
-	case orderitem.FieldUpdatedAt:
+	case trackingevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OrderItem field %s", name)
+	return fmt.Errorf("unknown TrackingEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrderItemMutation) AddedFields() []string {
-	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, orderitem.FieldPrice)
-	}
-	if m.addquantity != nil {
-		fields = append(fields, orderitem.FieldQuantity)
-	}
-	return fields
+func (m *TrackingEventMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrderItemMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case orderitem.FieldPrice:
-		return m.AddedPrice()
-	case orderitem.FieldQuantity:
-		return m.AddedQuantity()
-	}
+func (m *TrackingEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrderItemMutation) AddField(name string, value ent.Value) error {
+func (m *TrackingEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case orderitem.FieldPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrice(v)
-		return nil
-	case orderitem.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantity(v)
-		return nil
 	}
-	return fmt.Errorf("unknown OrderItem numeric field %s", name)
+	return fmt.Errorf("unknown TrackingEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrderItemMutation) ClearedFields() []string {
+func (m *TrackingEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(orderitem.FieldOrderID) {
-		fields = append(fields, orderitem.FieldOrderID)
+	if m.FieldCleared(trackingevent.FieldShipmentID) {
+		fields = append(fields, trackingevent.FieldShipmentID)
 	}
-	if m.FieldCleared(orderitem.FieldProductID) {
-		fields = append(fields, orderitem.FieldProductID)
+	if m.FieldCleared(trackingevent.FieldLocation) {
+		fields = append(fields, trackingevent.FieldLocation)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrderItemMutation) FieldCleared(name string) bool {
+func (m *TrackingEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrderItemMutation) ClearField(name string) error {
+func (m *TrackingEventMutation) ClearField(name string) error {
 	switch name {
-	case orderitem.FieldOrderID:
-		m.ClearOrderID()
+	case trackingevent.FieldShipmentID:
+		m.ClearShipmentID()
 		return nil
-	case orderitem.FieldProductID:
-		m.ClearProductID()
+	case trackingevent.FieldLocation:
+		m.ClearLocation()
 		return nil
 	}
-	return fmt.Errorf("unknown OrderItem nullable field %s", name)
+	return fmt.Errorf("unknown TrackingEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrderItemMutation) ResetField(name string) error {
+func (m *TrackingEventMutation) ResetField(name string) error {
 	switch name {
-	case orderitem.FieldOrderID:
-		m.ResetOrderID()
+	case trackingevent.FieldShipmentID:
+		m.ResetShipmentID()
 		return nil
-	case orderitem.FieldProductID:
-		m.ResetProductID()
-		return nil
-	case orderitem.FieldName:
-		m.ResetName()
+	case trackingevent.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case orderitem.FieldPrice:
-		m.ResetPrice()
+	case trackingevent.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case orderitem.FieldImage:
-		m.ResetImage()
+	case trackingevent.FieldLocation:
+		m.ResetLocation()
 		return nil
-	case orderitem.FieldQuantity:
-		m.ResetQuantity()
+	case trackingevent.FieldOccurredAt:
+		m.ResetOccurredAt()
 		return nil
-	case orderitem.FieldCreatedAt:
+	case trackingevent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case orderitem.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown OrderItem field %s", name)
+	return fmt.Errorf("unknown TrackingEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrderItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m._order != nil {
-		edges = append(edges, orderitem.EdgeOrder)
-	}
-	if m.product != nil {
-		edges = append(edges, orderitem.EdgeProduct)
+func (m *TrackingEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.shipment != nil {
+		edges = append(edges, trackingevent.EdgeShipment)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrderItemMutation) AddedIDs(name string) []ent.Value {
+func (m *TrackingEventMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case orderitem.EdgeOrder:
-		if id := m._order; id != nil {
-			return []ent.Value{*id}
-		}
-	case orderitem.EdgeProduct:
-		if id := m.product; id != nil {
+	case trackingevent.EdgeShipment:
+		if id := m.shipment; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -7955,122 +22120,115 @@ func (m *OrderItemMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrderItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *TrackingEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrderItemMutation) RemovedIDs(name string) []ent.Value {
+func (m *TrackingEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrderItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.cleared_order {
-		edges = append(edges, orderitem.EdgeOrder)
-	}
-	if m.clearedproduct {
-		edges = append(edges, orderitem.EdgeProduct)
+func (m *TrackingEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedshipment {
+		edges = append(edges, trackingevent.EdgeShipment)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrderItemMutation) EdgeCleared(name string) bool {
+func (m *TrackingEventMutation) EdgeCleared(name string) bool {
 	switch name {
-	case orderitem.EdgeOrder:
-		return m.cleared_order
-	case orderitem.EdgeProduct:
-		return m.clearedproduct
+	case trackingevent.EdgeShipment:
+		return m.clearedshipment
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrderItemMutation) ClearEdge(name string) error {
+func (m *TrackingEventMutation) ClearEdge(name string) error {
 	switch name {
-	case orderitem.EdgeOrder:
-		m.ClearOrder()
-		return nil
-	case orderitem.EdgeProduct:
-		m.ClearProduct()
+	case trackingevent.EdgeShipment:
+		m.ClearShipment()
 		return nil
 	}
-	return fmt.Errorf("unknown OrderItem unique edge %s", name)
+	return fmt.Errorf("unknown TrackingEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrderItemMutation) ResetEdge(name string) error {
+func (m *TrackingEventMutation) ResetEdge(name string) error {
 	switch name {
-	case orderitem.EdgeOrder:
-		m.ResetOrder()
-		return nil
-	case orderitem.EdgeProduct:
-		m.ResetProduct()
+	case trackingevent.EdgeShipment:
+		m.ResetShipment()
 		return nil
 	}
-	return fmt.Errorf("unknown OrderItem edge %s", name)
+	return fmt.Errorf("unknown TrackingEvent edge %s", name)
 }
 
-// ProductMutation represents an operation that mutates the Product nodes in the graph.
-type ProductMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	name               *string
-	slug               *string
-	description        *string
-	price              *float64
-	addprice           *float64
-	sale_price         *float64
-	addsale_price      *float64
-	on_sale            *bool
-	stock              *int
-	addstock           *int
-	sku                *string
-	images             *[]string
-	appendimages       []string
-	rating             *float64
-	addrating          *float64
-	review_count       *int
-	addreview_count    *int
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	avaliations        map[string]struct{}
-	removedavaliations map[string]struct{}
-	clearedavaliations bool
-	category           *string
-	clearedcategory    bool
-	order_items        map[string]struct{}
-	removedorder_items map[string]struct{}
-	clearedorder_items bool
-	cart_items         map[string]struct{}
-	removedcart_items  map[string]struct{}
-	clearedcart_items  bool
-	done               bool
-	oldValue           func(context.Context) (*Product, error)
-	predicates         []predicate.Product
+	op                    Op
+	typ                   string
+	id                    *string
+	name                  *string
+	email                 *string
+	password              *string
+	email_verified_at     *time.Time
+	phone                 *string
+	profile_image         *string
+	role                  *user.Role
+	permissions           *[]string
+	appendpermissions     []string
+	totp_secret           *string
+	totp_enabled_at       *time.Time
+	totp_last_step        *int64
+	addtotp_last_step     *int64
+	recovery_codes        *[]string
+	appendrecovery_codes  []string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	addresses             map[string]struct{}
+	removedaddresses      map[string]struct{}
+	clearedaddresses      bool
+	orders                map[string]struct{}
+	removedorders         map[string]struct{}
+	clearedorders         bool
+	avaliations           map[string]struct{}
+	removedavaliations    map[string]struct{}
+	clearedavaliations    bool
+	cart                  *string
+	clearedcart           bool
+	sessions              map[string]struct{}
+	removedsessions       map[string]struct{}
+	clearedsessions       bool
+	account_tokens        map[string]struct{}
+	removedaccount_tokens map[string]struct{}
+	clearedaccount_tokens bool
+	done                  bool
+	oldValue              func(context.Context) (*User, error)
+	predicates            []predicate.User
 }
 
-var _ ent.Mutation = (*ProductMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// productOption allows management of the mutation configuration using functional options.
-type productOption func(*ProductMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newProductMutation creates new mutation for the Product entity.
-func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation {
-	m := &ProductMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProduct,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8079,20 +22237,20 @@ func newProductMutation(c config, op Op, opts ...productOption) *ProductMutation
 	return m
 }
 
-// withProductID sets the ID field of the mutation.
-func withProductID(id string) productOption {
-	return func(m *ProductMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id string) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Product
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Product, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Product.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8101,10 +22259,10 @@ func withProductID(id string) productOption {
 	}
 }
 
-// withProduct sets the old Product of the mutation.
-func withProduct(node *Product) productOption {
-	return func(m *ProductMutation) {
-		m.oldValue = func(context.Context) (*Product, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8113,7 +22271,7 @@ func withProduct(node *Product) productOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProductMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8121,7 +22279,7 @@ func (m ProductMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProductMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8131,14 +22289,14 @@ func (m ProductMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Product entities.
-func (m *ProductMutation) SetID(id string) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProductMutation) ID() (id string, exists bool) {
+func (m *UserMutation) ID() (id string, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8149,7 +22307,7 @@ func (m *ProductMutation) ID() (id string, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProductMutation) IDs(ctx context.Context) ([]string, error) {
+func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8158,19 +22316,19 @@ func (m *ProductMutation) IDs(ctx context.Context) ([]string, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Product.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ProductMutation) SetName(s string) {
+func (m *UserMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ProductMutation) Name() (r string, exists bool) {
+func (m *UserMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -8178,10 +22336,10 @@ func (m *ProductMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -8196,555 +22354,556 @@ func (m *ProductMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ProductMutation) ResetName() {
+func (m *UserMutation) ResetName() {
 	m.name = nil
 }
 
-// SetSlug sets the "slug" field.
-func (m *ProductMutation) SetSlug(s string) {
-	m.slug = &s
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
 }
 
-// Slug returns the value of the "slug" field in the mutation.
-func (m *ProductMutation) Slug() (r string, exists bool) {
-	v := m.slug
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSlug returns the old "slug" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSlug(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSlug requires an ID field in the mutation")
+		return v, errors.New("OldEmail requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
 	}
-	return oldValue.Slug, nil
+	return oldValue.Email, nil
 }
 
-// ResetSlug resets all changes to the "slug" field.
-func (m *ProductMutation) ResetSlug() {
-	m.slug = nil
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *ProductMutation) SetDescription(s string) {
-	m.description = &s
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *ProductMutation) Description() (r string, exists bool) {
-	v := m.description
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Password, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *ProductMutation) ResetDescription() {
-	m.description = nil
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *ProductMutation) SetPrice(f float64) {
-	m.price = &f
-	m.addprice = nil
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *ProductMutation) Price() (r float64, exists bool) {
-	v := m.price
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldPrice(ctx context.Context) (v float64, err error) {
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
 	}
-	return oldValue.Price, nil
+	return oldValue.EmailVerifiedAt, nil
 }
 
-// AddPrice adds f to the "price" field.
-func (m *ProductMutation) AddPrice(f float64) {
-	if m.addprice != nil {
-		*m.addprice += f
-	} else {
-		m.addprice = &f
-	}
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
 }
 
-// AddedPrice returns the value that was added to the "price" field in this mutation.
-func (m *ProductMutation) AddedPrice() (r float64, exists bool) {
-	v := m.addprice
-	if v == nil {
-		return
-	}
-	return *v, true
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *ProductMutation) ResetPrice() {
-	m.price = nil
-	m.addprice = nil
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
 }
 
-// SetSalePrice sets the "sale_price" field.
-func (m *ProductMutation) SetSalePrice(f float64) {
-	m.sale_price = &f
-	m.addsale_price = nil
+// SetPhone sets the "phone" field.
+func (m *UserMutation) SetPhone(s string) {
+	m.phone = &s
 }
 
-// SalePrice returns the value of the "sale_price" field in the mutation.
-func (m *ProductMutation) SalePrice() (r float64, exists bool) {
-	v := m.sale_price
+// Phone returns the value of the "phone" field in the mutation.
+func (m *UserMutation) Phone() (r string, exists bool) {
+	v := m.phone
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSalePrice returns the old "sale_price" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldPhone returns the old "phone" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSalePrice(ctx context.Context) (v float64, err error) {
+func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSalePrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSalePrice requires an ID field in the mutation")
+		return v, errors.New("OldPhone requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSalePrice: %w", err)
-	}
-	return oldValue.SalePrice, nil
-}
-
-// AddSalePrice adds f to the "sale_price" field.
-func (m *ProductMutation) AddSalePrice(f float64) {
-	if m.addsale_price != nil {
-		*m.addsale_price += f
-	} else {
-		m.addsale_price = &f
-	}
-}
-
-// AddedSalePrice returns the value that was added to the "sale_price" field in this mutation.
-func (m *ProductMutation) AddedSalePrice() (r float64, exists bool) {
-	v := m.addsale_price
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
 	}
-	return *v, true
+	return oldValue.Phone, nil
 }
 
-// ClearSalePrice clears the value of the "sale_price" field.
-func (m *ProductMutation) ClearSalePrice() {
-	m.sale_price = nil
-	m.addsale_price = nil
-	m.clearedFields[product.FieldSalePrice] = struct{}{}
+// ClearPhone clears the value of the "phone" field.
+func (m *UserMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[user.FieldPhone] = struct{}{}
 }
 
-// SalePriceCleared returns if the "sale_price" field was cleared in this mutation.
-func (m *ProductMutation) SalePriceCleared() bool {
-	_, ok := m.clearedFields[product.FieldSalePrice]
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *UserMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[user.FieldPhone]
 	return ok
 }
 
-// ResetSalePrice resets all changes to the "sale_price" field.
-func (m *ProductMutation) ResetSalePrice() {
-	m.sale_price = nil
-	m.addsale_price = nil
-	delete(m.clearedFields, product.FieldSalePrice)
+// ResetPhone resets all changes to the "phone" field.
+func (m *UserMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, user.FieldPhone)
 }
 
-// SetOnSale sets the "on_sale" field.
-func (m *ProductMutation) SetOnSale(b bool) {
-	m.on_sale = &b
+// SetProfileImage sets the "profile_image" field.
+func (m *UserMutation) SetProfileImage(s string) {
+	m.profile_image = &s
 }
 
-// OnSale returns the value of the "on_sale" field in the mutation.
-func (m *ProductMutation) OnSale() (r bool, exists bool) {
-	v := m.on_sale
+// ProfileImage returns the value of the "profile_image" field in the mutation.
+func (m *UserMutation) ProfileImage() (r string, exists bool) {
+	v := m.profile_image
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOnSale returns the old "on_sale" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldProfileImage returns the old "profile_image" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldOnSale(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldProfileImage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOnSale is only allowed on UpdateOne operations")
+		return v, errors.New("OldProfileImage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOnSale requires an ID field in the mutation")
+		return v, errors.New("OldProfileImage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOnSale: %w", err)
+		return v, fmt.Errorf("querying old value for OldProfileImage: %w", err)
 	}
-	return oldValue.OnSale, nil
+	return oldValue.ProfileImage, nil
 }
 
-// ResetOnSale resets all changes to the "on_sale" field.
-func (m *ProductMutation) ResetOnSale() {
-	m.on_sale = nil
+// ClearProfileImage clears the value of the "profile_image" field.
+func (m *UserMutation) ClearProfileImage() {
+	m.profile_image = nil
+	m.clearedFields[user.FieldProfileImage] = struct{}{}
 }
 
-// SetStock sets the "stock" field.
-func (m *ProductMutation) SetStock(i int) {
-	m.stock = &i
-	m.addstock = nil
+// ProfileImageCleared returns if the "profile_image" field was cleared in this mutation.
+func (m *UserMutation) ProfileImageCleared() bool {
+	_, ok := m.clearedFields[user.FieldProfileImage]
+	return ok
 }
 
-// Stock returns the value of the "stock" field in the mutation.
-func (m *ProductMutation) Stock() (r int, exists bool) {
-	v := m.stock
+// ResetProfileImage resets all changes to the "profile_image" field.
+func (m *UserMutation) ResetProfileImage() {
+	m.profile_image = nil
+	delete(m.clearedFields, user.FieldProfileImage)
+}
+
+// SetRole sets the "role" field.
+func (m *UserMutation) SetRole(u user.Role) {
+	m.role = &u
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *UserMutation) Role() (r user.Role, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStock returns the old "stock" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldStock(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldRole(ctx context.Context) (v user.Role, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStock is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStock requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStock: %w", err)
-	}
-	return oldValue.Stock, nil
-}
-
-// AddStock adds i to the "stock" field.
-func (m *ProductMutation) AddStock(i int) {
-	if m.addstock != nil {
-		*m.addstock += i
-	} else {
-		m.addstock = &i
-	}
-}
-
-// AddedStock returns the value that was added to the "stock" field in this mutation.
-func (m *ProductMutation) AddedStock() (r int, exists bool) {
-	v := m.addstock
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return *v, true
+	return oldValue.Role, nil
 }
 
-// ResetStock resets all changes to the "stock" field.
-func (m *ProductMutation) ResetStock() {
-	m.stock = nil
-	m.addstock = nil
+// ResetRole resets all changes to the "role" field.
+func (m *UserMutation) ResetRole() {
+	m.role = nil
 }
 
-// SetSku sets the "sku" field.
-func (m *ProductMutation) SetSku(s string) {
-	m.sku = &s
+// SetPermissions sets the "permissions" field.
+func (m *UserMutation) SetPermissions(s []string) {
+	m.permissions = &s
+	m.appendpermissions = nil
 }
 
-// Sku returns the value of the "sku" field in the mutation.
-func (m *ProductMutation) Sku() (r string, exists bool) {
-	v := m.sku
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *UserMutation) Permissions() (r []string, exists bool) {
+	v := m.permissions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSku returns the old "sku" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldPermissions returns the old "permissions" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldSku(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldPermissions(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSku is only allowed on UpdateOne operations")
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSku requires an ID field in the mutation")
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSku: %w", err)
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
 	}
-	return oldValue.Sku, nil
+	return oldValue.Permissions, nil
 }
 
-// ResetSku resets all changes to the "sku" field.
-func (m *ProductMutation) ResetSku() {
-	m.sku = nil
+// AppendPermissions adds s to the "permissions" field.
+func (m *UserMutation) AppendPermissions(s []string) {
+	m.appendpermissions = append(m.appendpermissions, s...)
 }
 
-// SetCategoryID sets the "category_id" field.
-func (m *ProductMutation) SetCategoryID(s string) {
-	m.category = &s
+// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
+func (m *UserMutation) AppendedPermissions() ([]string, bool) {
+	if len(m.appendpermissions) == 0 {
+		return nil, false
+	}
+	return m.appendpermissions, true
 }
 
-// CategoryID returns the value of the "category_id" field in the mutation.
-func (m *ProductMutation) CategoryID() (r string, exists bool) {
-	v := m.category
+// ClearPermissions clears the value of the "permissions" field.
+func (m *UserMutation) ClearPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+	m.clearedFields[user.FieldPermissions] = struct{}{}
+}
+
+// PermissionsCleared returns if the "permissions" field was cleared in this mutation.
+func (m *UserMutation) PermissionsCleared() bool {
+	_, ok := m.clearedFields[user.FieldPermissions]
+	return ok
+}
+
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *UserMutation) ResetPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+	delete(m.clearedFields, user.FieldPermissions)
+}
+
+// SetTotpSecret sets the "totp_secret" field.
+func (m *UserMutation) SetTotpSecret(s string) {
+	m.totp_secret = &s
+}
+
+// TotpSecret returns the value of the "totp_secret" field in the mutation.
+func (m *UserMutation) TotpSecret() (r string, exists bool) {
+	v := m.totp_secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategoryID returns the old "category_id" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpSecret returns the old "totp_secret" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldCategoryID(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldTotpSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+		return v, errors.New("OldTotpSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpSecret: %w", err)
 	}
-	return oldValue.CategoryID, nil
-}
-
-// ClearCategoryID clears the value of the "category_id" field.
-func (m *ProductMutation) ClearCategoryID() {
-	m.category = nil
-	m.clearedFields[product.FieldCategoryID] = struct{}{}
+	return oldValue.TotpSecret, nil
 }
 
-// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
-func (m *ProductMutation) CategoryIDCleared() bool {
-	_, ok := m.clearedFields[product.FieldCategoryID]
-	return ok
+// ClearTotpSecret clears the value of the "totp_secret" field.
+func (m *UserMutation) ClearTotpSecret() {
+	m.totp_secret = nil
+	m.clearedFields[user.FieldTotpSecret] = struct{}{}
 }
 
-// ResetCategoryID resets all changes to the "category_id" field.
-func (m *ProductMutation) ResetCategoryID() {
-	m.category = nil
-	delete(m.clearedFields, product.FieldCategoryID)
+// TotpSecretCleared returns if the "totp_secret" field was cleared in this mutation.
+func (m *UserMutation) TotpSecretCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpSecret]
+	return ok
 }
 
-// SetImages sets the "images" field.
-func (m *ProductMutation) SetImages(s []string) {
-	m.images = &s
-	m.appendimages = nil
+// ResetTotpSecret resets all changes to the "totp_secret" field.
+func (m *UserMutation) ResetTotpSecret() {
+	m.totp_secret = nil
+	delete(m.clearedFields, user.FieldTotpSecret)
 }
 
-// Images returns the value of the "images" field in the mutation.
-func (m *ProductMutation) Images() (r []string, exists bool) {
-	v := m.images
+// SetTotpEnabledAt sets the "totp_enabled_at" field.
+func (m *UserMutation) SetTotpEnabledAt(t time.Time) {
+	m.totp_enabled_at = &t
+}
+
+// TotpEnabledAt returns the value of the "totp_enabled_at" field in the mutation.
+func (m *UserMutation) TotpEnabledAt() (r time.Time, exists bool) {
+	v := m.totp_enabled_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldImages returns the old "images" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpEnabledAt returns the old "totp_enabled_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldImages(ctx context.Context) (v []string, err error) {
+func (m *UserMutation) OldTotpEnabledAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImages is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpEnabledAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImages requires an ID field in the mutation")
+		return v, errors.New("OldTotpEnabledAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImages: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpEnabledAt: %w", err)
 	}
-	return oldValue.Images, nil
+	return oldValue.TotpEnabledAt, nil
 }
 
-// AppendImages adds s to the "images" field.
-func (m *ProductMutation) AppendImages(s []string) {
-	m.appendimages = append(m.appendimages, s...)
+// ClearTotpEnabledAt clears the value of the "totp_enabled_at" field.
+func (m *UserMutation) ClearTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	m.clearedFields[user.FieldTotpEnabledAt] = struct{}{}
 }
 
-// AppendedImages returns the list of values that were appended to the "images" field in this mutation.
-func (m *ProductMutation) AppendedImages() ([]string, bool) {
-	if len(m.appendimages) == 0 {
-		return nil, false
-	}
-	return m.appendimages, true
+// TotpEnabledAtCleared returns if the "totp_enabled_at" field was cleared in this mutation.
+func (m *UserMutation) TotpEnabledAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldTotpEnabledAt]
+	return ok
 }
 
-// ResetImages resets all changes to the "images" field.
-func (m *ProductMutation) ResetImages() {
-	m.images = nil
-	m.appendimages = nil
+// ResetTotpEnabledAt resets all changes to the "totp_enabled_at" field.
+func (m *UserMutation) ResetTotpEnabledAt() {
+	m.totp_enabled_at = nil
+	delete(m.clearedFields, user.FieldTotpEnabledAt)
 }
 
-// SetRating sets the "rating" field.
-func (m *ProductMutation) SetRating(f float64) {
-	m.rating = &f
-	m.addrating = nil
+// SetTotpLastStep sets the "totp_last_step" field.
+func (m *UserMutation) SetTotpLastStep(i int64) {
+	m.totp_last_step = &i
+	m.addtotp_last_step = nil
 }
 
-// Rating returns the value of the "rating" field in the mutation.
-func (m *ProductMutation) Rating() (r float64, exists bool) {
-	v := m.rating
+// TotpLastStep returns the value of the "totp_last_step" field in the mutation.
+func (m *UserMutation) TotpLastStep() (r int64, exists bool) {
+	v := m.totp_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRating returns the old "rating" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldTotpLastStep returns the old "totp_last_step" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldRating(ctx context.Context) (v float64, err error) {
+func (m *UserMutation) OldTotpLastStep(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRating is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotpLastStep is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRating requires an ID field in the mutation")
+		return v, errors.New("OldTotpLastStep requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRating: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotpLastStep: %w", err)
 	}
-	return oldValue.Rating, nil
+	return oldValue.TotpLastStep, nil
 }
 
-// AddRating adds f to the "rating" field.
-func (m *ProductMutation) AddRating(f float64) {
-	if m.addrating != nil {
-		*m.addrating += f
+// AddTotpLastStep adds i to the "totp_last_step" field.
+func (m *UserMutation) AddTotpLastStep(i int64) {
+	if m.addtotp_last_step != nil {
+		*m.addtotp_last_step += i
 	} else {
-		m.addrating = &f
+		m.addtotp_last_step = &i
 	}
 }
 
-// AddedRating returns the value that was added to the "rating" field in this mutation.
-func (m *ProductMutation) AddedRating() (r float64, exists bool) {
-	v := m.addrating
+// AddedTotpLastStep returns the value that was added to the "totp_last_step" field in this mutation.
+func (m *UserMutation) AddedTotpLastStep() (r int64, exists bool) {
+	v := m.addtotp_last_step
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRating resets all changes to the "rating" field.
-func (m *ProductMutation) ResetRating() {
-	m.rating = nil
-	m.addrating = nil
+// ResetTotpLastStep resets all changes to the "totp_last_step" field.
+func (m *UserMutation) ResetTotpLastStep() {
+	m.totp_last_step = nil
+	m.addtotp_last_step = nil
 }
 
-// SetReviewCount sets the "review_count" field.
-func (m *ProductMutation) SetReviewCount(i int) {
-	m.review_count = &i
-	m.addreview_count = nil
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (m *UserMutation) SetRecoveryCodes(s []string) {
+	m.recovery_codes = &s
+	m.appendrecovery_codes = nil
 }
 
-// ReviewCount returns the value of the "review_count" field in the mutation.
-func (m *ProductMutation) ReviewCount() (r int, exists bool) {
-	v := m.review_count
+// RecoveryCodes returns the value of the "recovery_codes" field in the mutation.
+func (m *UserMutation) RecoveryCodes() (r []string, exists bool) {
+	v := m.recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReviewCount returns the old "review_count" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldRecoveryCodes returns the old "recovery_codes" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldReviewCount(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldRecoveryCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReviewCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldRecoveryCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReviewCount requires an ID field in the mutation")
+		return v, errors.New("OldRecoveryCodes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReviewCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldRecoveryCodes: %w", err)
 	}
-	return oldValue.ReviewCount, nil
+	return oldValue.RecoveryCodes, nil
 }
 
-// AddReviewCount adds i to the "review_count" field.
-func (m *ProductMutation) AddReviewCount(i int) {
-	if m.addreview_count != nil {
-		*m.addreview_count += i
-	} else {
-		m.addreview_count = &i
-	}
+// AppendRecoveryCodes adds s to the "recovery_codes" field.
+func (m *UserMutation) AppendRecoveryCodes(s []string) {
+	m.appendrecovery_codes = append(m.appendrecovery_codes, s...)
 }
 
-// AddedReviewCount returns the value that was added to the "review_count" field in this mutation.
-func (m *ProductMutation) AddedReviewCount() (r int, exists bool) {
-	v := m.addreview_count
-	if v == nil {
-		return
+// AppendedRecoveryCodes returns the list of values that were appended to the "recovery_codes" field in this mutation.
+func (m *UserMutation) AppendedRecoveryCodes() ([]string, bool) {
+	if len(m.appendrecovery_codes) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendrecovery_codes, true
 }
 
-// ResetReviewCount resets all changes to the "review_count" field.
-func (m *ProductMutation) ResetReviewCount() {
-	m.review_count = nil
-	m.addreview_count = nil
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (m *UserMutation) ClearRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	m.clearedFields[user.FieldRecoveryCodes] = struct{}{}
+}
+
+// RecoveryCodesCleared returns if the "recovery_codes" field was cleared in this mutation.
+func (m *UserMutation) RecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[user.FieldRecoveryCodes]
+	return ok
+}
+
+// ResetRecoveryCodes resets all changes to the "recovery_codes" field.
+func (m *UserMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	delete(m.clearedFields, user.FieldRecoveryCodes)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProductMutation) SetCreatedAt(t time.Time) {
+func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProductMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -8752,10 +22911,10 @@ func (m *ProductMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -8770,17 +22929,17 @@ func (m *ProductMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProductMutation) ResetCreatedAt() {
+func (m *UserMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProductMutation) SetUpdatedAt(t time.Time) {
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProductMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -8788,10 +22947,10 @@ func (m *ProductMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Product entity.
-// If the Product object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProductMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -8806,12 +22965,120 @@ func (m *ProductMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProductMutation) ResetUpdatedAt() {
+func (m *UserMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// AddAddressIDs adds the "addresses" edge to the Address entity by ids.
+func (m *UserMutation) AddAddressIDs(ids ...string) {
+	if m.addresses == nil {
+		m.addresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.addresses[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAddresses clears the "addresses" edge to the Address entity.
+func (m *UserMutation) ClearAddresses() {
+	m.clearedaddresses = true
+}
+
+// AddressesCleared reports if the "addresses" edge to the Address entity was cleared.
+func (m *UserMutation) AddressesCleared() bool {
+	return m.clearedaddresses
+}
+
+// RemoveAddressIDs removes the "addresses" edge to the Address entity by IDs.
+func (m *UserMutation) RemoveAddressIDs(ids ...string) {
+	if m.removedaddresses == nil {
+		m.removedaddresses = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.addresses, ids[i])
+		m.removedaddresses[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAddresses returns the removed IDs of the "addresses" edge to the Address entity.
+func (m *UserMutation) RemovedAddressesIDs() (ids []string) {
+	for id := range m.removedaddresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AddressesIDs returns the "addresses" edge IDs in the mutation.
+func (m *UserMutation) AddressesIDs() (ids []string) {
+	for id := range m.addresses {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAddresses resets all changes to the "addresses" edge.
+func (m *UserMutation) ResetAddresses() {
+	m.addresses = nil
+	m.clearedaddresses = false
+	m.removedaddresses = nil
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by ids.
+func (m *UserMutation) AddOrderIDs(ids ...string) {
+	if m.orders == nil {
+		m.orders = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.orders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrders clears the "orders" edge to the Order entity.
+func (m *UserMutation) ClearOrders() {
+	m.clearedorders = true
+}
+
+// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
+func (m *UserMutation) OrdersCleared() bool {
+	return m.clearedorders
+}
+
+// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
+func (m *UserMutation) RemoveOrderIDs(ids ...string) {
+	if m.removedorders == nil {
+		m.removedorders = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.orders, ids[i])
+		m.removedorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
+func (m *UserMutation) RemovedOrdersIDs() (ids []string) {
+	for id := range m.removedorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrdersIDs returns the "orders" edge IDs in the mutation.
+func (m *UserMutation) OrdersIDs() (ids []string) {
+	for id := range m.orders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrders resets all changes to the "orders" edge.
+func (m *UserMutation) ResetOrders() {
+	m.orders = nil
+	m.clearedorders = false
+	m.removedorders = nil
+}
+
 // AddAvaliationIDs adds the "avaliations" edge to the Avaliation entity by ids.
-func (m *ProductMutation) AddAvaliationIDs(ids ...string) {
+func (m *UserMutation) AddAvaliationIDs(ids ...string) {
 	if m.avaliations == nil {
 		m.avaliations = make(map[string]struct{})
 	}
@@ -8821,17 +23088,17 @@ func (m *ProductMutation) AddAvaliationIDs(ids ...string) {
 }
 
 // ClearAvaliations clears the "avaliations" edge to the Avaliation entity.
-func (m *ProductMutation) ClearAvaliations() {
+func (m *UserMutation) ClearAvaliations() {
 	m.clearedavaliations = true
 }
 
 // AvaliationsCleared reports if the "avaliations" edge to the Avaliation entity was cleared.
-func (m *ProductMutation) AvaliationsCleared() bool {
+func (m *UserMutation) AvaliationsCleared() bool {
 	return m.clearedavaliations
 }
 
 // RemoveAvaliationIDs removes the "avaliations" edge to the Avaliation entity by IDs.
-func (m *ProductMutation) RemoveAvaliationIDs(ids ...string) {
+func (m *UserMutation) RemoveAvaliationIDs(ids ...string) {
 	if m.removedavaliations == nil {
 		m.removedavaliations = make(map[string]struct{})
 	}
@@ -8842,7 +23109,7 @@ func (m *ProductMutation) RemoveAvaliationIDs(ids ...string) {
 }
 
 // RemovedAvaliations returns the removed IDs of the "avaliations" edge to the Avaliation entity.
-func (m *ProductMutation) RemovedAvaliationsIDs() (ids []string) {
+func (m *UserMutation) RemovedAvaliationsIDs() (ids []string) {
 	for id := range m.removedavaliations {
 		ids = append(ids, id)
 	}
@@ -8850,7 +23117,7 @@ func (m *ProductMutation) RemovedAvaliationsIDs() (ids []string) {
 }
 
 // AvaliationsIDs returns the "avaliations" edge IDs in the mutation.
-func (m *ProductMutation) AvaliationsIDs() (ids []string) {
+func (m *UserMutation) AvaliationsIDs() (ids []string) {
 	for id := range m.avaliations {
 		ids = append(ids, id)
 	}
@@ -8858,156 +23125,168 @@ func (m *ProductMutation) AvaliationsIDs() (ids []string) {
 }
 
 // ResetAvaliations resets all changes to the "avaliations" edge.
-func (m *ProductMutation) ResetAvaliations() {
+func (m *UserMutation) ResetAvaliations() {
 	m.avaliations = nil
 	m.clearedavaliations = false
 	m.removedavaliations = nil
 }
 
-// ClearCategory clears the "category" edge to the Category entity.
-func (m *ProductMutation) ClearCategory() {
-	m.clearedcategory = true
-	m.clearedFields[product.FieldCategoryID] = struct{}{}
+// SetCartID sets the "cart" edge to the Cart entity by id.
+func (m *UserMutation) SetCartID(id string) {
+	m.cart = &id
+}
+
+// ClearCart clears the "cart" edge to the Cart entity.
+func (m *UserMutation) ClearCart() {
+	m.clearedcart = true
+}
+
+// CartCleared reports if the "cart" edge to the Cart entity was cleared.
+func (m *UserMutation) CartCleared() bool {
+	return m.clearedcart
 }
 
-// CategoryCleared reports if the "category" edge to the Category entity was cleared.
-func (m *ProductMutation) CategoryCleared() bool {
-	return m.CategoryIDCleared() || m.clearedcategory
+// CartID returns the "cart" edge ID in the mutation.
+func (m *UserMutation) CartID() (id string, exists bool) {
+	if m.cart != nil {
+		return *m.cart, true
+	}
+	return
 }
 
-// CategoryIDs returns the "category" edge IDs in the mutation.
+// CartIDs returns the "cart" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CategoryID instead. It exists only for internal usage by the builders.
-func (m *ProductMutation) CategoryIDs() (ids []string) {
-	if id := m.category; id != nil {
+// CartID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) CartIDs() (ids []string) {
+	if id := m.cart; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCategory resets all changes to the "category" edge.
-func (m *ProductMutation) ResetCategory() {
-	m.category = nil
-	m.clearedcategory = false
+// ResetCart resets all changes to the "cart" edge.
+func (m *UserMutation) ResetCart() {
+	m.cart = nil
+	m.clearedcart = false
 }
 
-// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by ids.
-func (m *ProductMutation) AddOrderItemIDs(ids ...string) {
-	if m.order_items == nil {
-		m.order_items = make(map[string]struct{})
+// AddSessionIDs adds the "sessions" edge to the Session entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...string) {
+	if m.sessions == nil {
+		m.sessions = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.order_items[ids[i]] = struct{}{}
+		m.sessions[ids[i]] = struct{}{}
 	}
 }
 
-// ClearOrderItems clears the "order_items" edge to the OrderItem entity.
-func (m *ProductMutation) ClearOrderItems() {
-	m.clearedorder_items = true
+// ClearSessions clears the "sessions" edge to the Session entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
 }
 
-// OrderItemsCleared reports if the "order_items" edge to the OrderItem entity was cleared.
-func (m *ProductMutation) OrderItemsCleared() bool {
-	return m.clearedorder_items
+// SessionsCleared reports if the "sessions" edge to the Session entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
 }
 
-// RemoveOrderItemIDs removes the "order_items" edge to the OrderItem entity by IDs.
-func (m *ProductMutation) RemoveOrderItemIDs(ids ...string) {
-	if m.removedorder_items == nil {
-		m.removedorder_items = make(map[string]struct{})
+// RemoveSessionIDs removes the "sessions" edge to the Session entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...string) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.order_items, ids[i])
-		m.removedorder_items[ids[i]] = struct{}{}
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedOrderItems returns the removed IDs of the "order_items" edge to the OrderItem entity.
-func (m *ProductMutation) RemovedOrderItemsIDs() (ids []string) {
-	for id := range m.removedorder_items {
+// RemovedSessions returns the removed IDs of the "sessions" edge to the Session entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []string) {
+	for id := range m.removedsessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// OrderItemsIDs returns the "order_items" edge IDs in the mutation.
-func (m *ProductMutation) OrderItemsIDs() (ids []string) {
-	for id := range m.order_items {
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []string) {
+	for id := range m.sessions {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetOrderItems resets all changes to the "order_items" edge.
-func (m *ProductMutation) ResetOrderItems() {
-	m.order_items = nil
-	m.clearedorder_items = false
-	m.removedorder_items = nil
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
 }
 
-// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by ids.
-func (m *ProductMutation) AddCartItemIDs(ids ...string) {
-	if m.cart_items == nil {
-		m.cart_items = make(map[string]struct{})
+// AddAccountTokenIDs adds the "account_tokens" edge to the AccountToken entity by ids.
+func (m *UserMutation) AddAccountTokenIDs(ids ...string) {
+	if m.account_tokens == nil {
+		m.account_tokens = make(map[string]struct{})
 	}
 	for i := range ids {
-		m.cart_items[ids[i]] = struct{}{}
+		m.account_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// ClearCartItems clears the "cart_items" edge to the CartItem entity.
-func (m *ProductMutation) ClearCartItems() {
-	m.clearedcart_items = true
+// ClearAccountTokens clears the "account_tokens" edge to the AccountToken entity.
+func (m *UserMutation) ClearAccountTokens() {
+	m.clearedaccount_tokens = true
 }
 
-// CartItemsCleared reports if the "cart_items" edge to the CartItem entity was cleared.
-func (m *ProductMutation) CartItemsCleared() bool {
-	return m.clearedcart_items
+// AccountTokensCleared reports if the "account_tokens" edge to the AccountToken entity was cleared.
+func (m *UserMutation) AccountTokensCleared() bool {
+	return m.clearedaccount_tokens
 }
 
-// RemoveCartItemIDs removes the "cart_items" edge to the CartItem entity by IDs.
-func (m *ProductMutation) RemoveCartItemIDs(ids ...string) {
-	if m.removedcart_items == nil {
-		m.removedcart_items = make(map[string]struct{})
+// RemoveAccountTokenIDs removes the "account_tokens" edge to the AccountToken entity by IDs.
+func (m *UserMutation) RemoveAccountTokenIDs(ids ...string) {
+	if m.removedaccount_tokens == nil {
+		m.removedaccount_tokens = make(map[string]struct{})
 	}
 	for i := range ids {
-		delete(m.cart_items, ids[i])
-		m.removedcart_items[ids[i]] = struct{}{}
+		delete(m.account_tokens, ids[i])
+		m.removedaccount_tokens[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedCartItems returns the removed IDs of the "cart_items" edge to the CartItem entity.
-func (m *ProductMutation) RemovedCartItemsIDs() (ids []string) {
-	for id := range m.removedcart_items {
+// RemovedAccountTokens returns the removed IDs of the "account_tokens" edge to the AccountToken entity.
+func (m *UserMutation) RemovedAccountTokensIDs() (ids []string) {
+	for id := range m.removedaccount_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// CartItemsIDs returns the "cart_items" edge IDs in the mutation.
-func (m *ProductMutation) CartItemsIDs() (ids []string) {
-	for id := range m.cart_items {
+// AccountTokensIDs returns the "account_tokens" edge IDs in the mutation.
+func (m *UserMutation) AccountTokensIDs() (ids []string) {
+	for id := range m.account_tokens {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetCartItems resets all changes to the "cart_items" edge.
-func (m *ProductMutation) ResetCartItems() {
-	m.cart_items = nil
-	m.clearedcart_items = false
-	m.removedcart_items = nil
+// ResetAccountTokens resets all changes to the "account_tokens" edge.
+func (m *UserMutation) ResetAccountTokens() {
+	m.account_tokens = nil
+	m.clearedaccount_tokens = false
+	m.removedaccount_tokens = nil
 }
 
-// Where appends a list predicates to the ProductMutation builder.
-func (m *ProductMutation) Where(ps ...predicate.Product) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProductMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Product, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9015,66 +23294,66 @@ func (m *ProductMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProductMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProductMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Product).
-func (m *ProductMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProductMutation) Fields() []string {
+func (m *UserMutation) Fields() []string {
 	fields := make([]string, 0, 14)
 	if m.name != nil {
-		fields = append(fields, product.FieldName)
+		fields = append(fields, user.FieldName)
 	}
-	if m.slug != nil {
-		fields = append(fields, product.FieldSlug)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.description != nil {
-		fields = append(fields, product.FieldDescription)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
-	if m.price != nil {
-		fields = append(fields, product.FieldPrice)
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
 	}
-	if m.sale_price != nil {
-		fields = append(fields, product.FieldSalePrice)
+	if m.phone != nil {
+		fields = append(fields, user.FieldPhone)
 	}
-	if m.on_sale != nil {
-		fields = append(fields, product.FieldOnSale)
+	if m.profile_image != nil {
+		fields = append(fields, user.FieldProfileImage)
 	}
-	if m.stock != nil {
-		fields = append(fields, product.FieldStock)
+	if m.role != nil {
+		fields = append(fields, user.FieldRole)
 	}
-	if m.sku != nil {
-		fields = append(fields, product.FieldSku)
+	if m.permissions != nil {
+		fields = append(fields, user.FieldPermissions)
 	}
-	if m.category != nil {
-		fields = append(fields, product.FieldCategoryID)
+	if m.totp_secret != nil {
+		fields = append(fields, user.FieldTotpSecret)
 	}
-	if m.images != nil {
-		fields = append(fields, product.FieldImages)
+	if m.totp_enabled_at != nil {
+		fields = append(fields, user.FieldTotpEnabledAt)
 	}
-	if m.rating != nil {
-		fields = append(fields, product.FieldRating)
+	if m.totp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
 	}
-	if m.review_count != nil {
-		fields = append(fields, product.FieldReviewCount)
+	if m.recovery_codes != nil {
+		fields = append(fields, user.FieldRecoveryCodes)
 	}
 	if m.created_at != nil {
-		fields = append(fields, product.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, product.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9082,35 +23361,35 @@ func (m *ProductMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProductMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case product.FieldName:
+	case user.FieldName:
 		return m.Name()
-	case product.FieldSlug:
-		return m.Slug()
-	case product.FieldDescription:
-		return m.Description()
-	case product.FieldPrice:
-		return m.Price()
-	case product.FieldSalePrice:
-		return m.SalePrice()
-	case product.FieldOnSale:
-		return m.OnSale()
-	case product.FieldStock:
-		return m.Stock()
-	case product.FieldSku:
-		return m.Sku()
-	case product.FieldCategoryID:
-		return m.CategoryID()
-	case product.FieldImages:
-		return m.Images()
-	case product.FieldRating:
-		return m.Rating()
-	case product.FieldReviewCount:
-		return m.ReviewCount()
-	case product.FieldCreatedAt:
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
+	case user.FieldPhone:
+		return m.Phone()
+	case user.FieldProfileImage:
+		return m.ProfileImage()
+	case user.FieldRole:
+		return m.Role()
+	case user.FieldPermissions:
+		return m.Permissions()
+	case user.FieldTotpSecret:
+		return m.TotpSecret()
+	case user.FieldTotpEnabledAt:
+		return m.TotpEnabledAt()
+	case user.FieldTotpLastStep:
+		return m.TotpLastStep()
+	case user.FieldRecoveryCodes:
+		return m.RecoveryCodes()
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case product.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -9119,137 +23398,137 @@ func (m *ProductMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProductMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case product.FieldName:
+	case user.FieldName:
 		return m.OldName(ctx)
-	case product.FieldSlug:
-		return m.OldSlug(ctx)
-	case product.FieldDescription:
-		return m.OldDescription(ctx)
-	case product.FieldPrice:
-		return m.OldPrice(ctx)
-	case product.FieldSalePrice:
-		return m.OldSalePrice(ctx)
-	case product.FieldOnSale:
-		return m.OldOnSale(ctx)
-	case product.FieldStock:
-		return m.OldStock(ctx)
-	case product.FieldSku:
-		return m.OldSku(ctx)
-	case product.FieldCategoryID:
-		return m.OldCategoryID(ctx)
-	case product.FieldImages:
-		return m.OldImages(ctx)
-	case product.FieldRating:
-		return m.OldRating(ctx)
-	case product.FieldReviewCount:
-		return m.OldReviewCount(ctx)
-	case product.FieldCreatedAt:
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
+	case user.FieldPhone:
+		return m.OldPhone(ctx)
+	case user.FieldProfileImage:
+		return m.OldProfileImage(ctx)
+	case user.FieldRole:
+		return m.OldRole(ctx)
+	case user.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case user.FieldTotpSecret:
+		return m.OldTotpSecret(ctx)
+	case user.FieldTotpEnabledAt:
+		return m.OldTotpEnabledAt(ctx)
+	case user.FieldTotpLastStep:
+		return m.OldTotpLastStep(ctx)
+	case user.FieldRecoveryCodes:
+		return m.OldRecoveryCodes(ctx)
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case product.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Product field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProductMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case product.FieldName:
+	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case product.FieldSlug:
+	case user.FieldEmail:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSlug(v)
+		m.SetEmail(v)
 		return nil
-	case product.FieldDescription:
+	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetPassword(v)
 		return nil
-	case product.FieldPrice:
-		v, ok := value.(float64)
+	case user.FieldEmailVerifiedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetEmailVerifiedAt(v)
 		return nil
-	case product.FieldSalePrice:
-		v, ok := value.(float64)
+	case user.FieldPhone:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSalePrice(v)
+		m.SetPhone(v)
 		return nil
-	case product.FieldOnSale:
-		v, ok := value.(bool)
+	case user.FieldProfileImage:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOnSale(v)
+		m.SetProfileImage(v)
 		return nil
-	case product.FieldStock:
-		v, ok := value.(int)
+	case user.FieldRole:
+		v, ok := value.(user.Role)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStock(v)
+		m.SetRole(v)
 		return nil
-	case product.FieldSku:
-		v, ok := value.(string)
+	case user.FieldPermissions:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSku(v)
+		m.SetPermissions(v)
 		return nil
-	case product.FieldCategoryID:
+	case user.FieldTotpSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategoryID(v)
+		m.SetTotpSecret(v)
 		return nil
-	case product.FieldImages:
-		v, ok := value.([]string)
+	case user.FieldTotpEnabledAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetImages(v)
+		m.SetTotpEnabledAt(v)
 		return nil
-	case product.FieldRating:
-		v, ok := value.(float64)
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRating(v)
+		m.SetTotpLastStep(v)
 		return nil
-	case product.FieldReviewCount:
-		v, ok := value.(int)
+	case user.FieldRecoveryCodes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReviewCount(v)
+		m.SetRecoveryCodes(v)
 		return nil
-	case product.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case product.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9257,27 +23536,15 @@ func (m *ProductMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Product field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProductMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addprice != nil {
-		fields = append(fields, product.FieldPrice)
-	}
-	if m.addsale_price != nil {
-		fields = append(fields, product.FieldSalePrice)
-	}
-	if m.addstock != nil {
-		fields = append(fields, product.FieldStock)
-	}
-	if m.addrating != nil {
-		fields = append(fields, product.FieldRating)
-	}
-	if m.addreview_count != nil {
-		fields = append(fields, product.FieldReviewCount)
+	if m.addtotp_last_step != nil {
+		fields = append(fields, user.FieldTotpLastStep)
 	}
 	return fields
 }
@@ -9285,18 +23552,10 @@ func (m *ProductMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case product.FieldPrice:
-		return m.AddedPrice()
-	case product.FieldSalePrice:
-		return m.AddedSalePrice()
-	case product.FieldStock:
-		return m.AddedStock()
-	case product.FieldRating:
-		return m.AddedRating()
-	case product.FieldReviewCount:
-		return m.AddedReviewCount()
+	case user.FieldTotpLastStep:
+		return m.AddedTotpLastStep()
 	}
 	return nil, false
 }
@@ -9304,172 +23563,192 @@ func (m *ProductMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProductMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case product.FieldPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPrice(v)
-		return nil
-	case product.FieldSalePrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSalePrice(v)
-		return nil
-	case product.FieldStock:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddStock(v)
-		return nil
-	case product.FieldRating:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRating(v)
-		return nil
-	case product.FieldReviewCount:
-		v, ok := value.(int)
+	case user.FieldTotpLastStep:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddReviewCount(v)
+		m.AddTotpLastStep(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Product numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProductMutation) ClearedFields() []string {
+func (m *UserMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(product.FieldSalePrice) {
-		fields = append(fields, product.FieldSalePrice)
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
 	}
-	if m.FieldCleared(product.FieldCategoryID) {
-		fields = append(fields, product.FieldCategoryID)
+	if m.FieldCleared(user.FieldPhone) {
+		fields = append(fields, user.FieldPhone)
+	}
+	if m.FieldCleared(user.FieldProfileImage) {
+		fields = append(fields, user.FieldProfileImage)
+	}
+	if m.FieldCleared(user.FieldPermissions) {
+		fields = append(fields, user.FieldPermissions)
+	}
+	if m.FieldCleared(user.FieldTotpSecret) {
+		fields = append(fields, user.FieldTotpSecret)
+	}
+	if m.FieldCleared(user.FieldTotpEnabledAt) {
+		fields = append(fields, user.FieldTotpEnabledAt)
+	}
+	if m.FieldCleared(user.FieldRecoveryCodes) {
+		fields = append(fields, user.FieldRecoveryCodes)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProductMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProductMutation) ClearField(name string) error {
+func (m *UserMutation) ClearField(name string) error {
 	switch name {
-	case product.FieldSalePrice:
-		m.ClearSalePrice()
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
 		return nil
-	case product.FieldCategoryID:
-		m.ClearCategoryID()
+	case user.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case user.FieldProfileImage:
+		m.ClearProfileImage()
+		return nil
+	case user.FieldPermissions:
+		m.ClearPermissions()
+		return nil
+	case user.FieldTotpSecret:
+		m.ClearTotpSecret()
+		return nil
+	case user.FieldTotpEnabledAt:
+		m.ClearTotpEnabledAt()
+		return nil
+	case user.FieldRecoveryCodes:
+		m.ClearRecoveryCodes()
 		return nil
 	}
-	return fmt.Errorf("unknown Product nullable field %s", name)
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProductMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case product.FieldName:
+	case user.FieldName:
 		m.ResetName()
 		return nil
-	case product.FieldSlug:
-		m.ResetSlug()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case product.FieldDescription:
-		m.ResetDescription()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case product.FieldPrice:
-		m.ResetPrice()
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
 		return nil
-	case product.FieldSalePrice:
-		m.ResetSalePrice()
+	case user.FieldPhone:
+		m.ResetPhone()
 		return nil
-	case product.FieldOnSale:
-		m.ResetOnSale()
+	case user.FieldProfileImage:
+		m.ResetProfileImage()
 		return nil
-	case product.FieldStock:
-		m.ResetStock()
+	case user.FieldRole:
+		m.ResetRole()
 		return nil
-	case product.FieldSku:
-		m.ResetSku()
+	case user.FieldPermissions:
+		m.ResetPermissions()
 		return nil
-	case product.FieldCategoryID:
-		m.ResetCategoryID()
+	case user.FieldTotpSecret:
+		m.ResetTotpSecret()
 		return nil
-	case product.FieldImages:
-		m.ResetImages()
+	case user.FieldTotpEnabledAt:
+		m.ResetTotpEnabledAt()
 		return nil
-	case product.FieldRating:
-		m.ResetRating()
+	case user.FieldTotpLastStep:
+		m.ResetTotpLastStep()
 		return nil
-	case product.FieldReviewCount:
-		m.ResetReviewCount()
+	case user.FieldRecoveryCodes:
+		m.ResetRecoveryCodes()
 		return nil
-	case product.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case product.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Product field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProductMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.addresses != nil {
+		edges = append(edges, user.EdgeAddresses)
+	}
+	if m.orders != nil {
+		edges = append(edges, user.EdgeOrders)
+	}
 	if m.avaliations != nil {
-		edges = append(edges, product.EdgeAvaliations)
+		edges = append(edges, user.EdgeAvaliations)
 	}
-	if m.category != nil {
-		edges = append(edges, product.EdgeCategory)
+	if m.cart != nil {
+		edges = append(edges, user.EdgeCart)
 	}
-	if m.order_items != nil {
-		edges = append(edges, product.EdgeOrderItems)
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
 	}
-	if m.cart_items != nil {
-		edges = append(edges, product.EdgeCartItems)
+	if m.account_tokens != nil {
+		edges = append(edges, user.EdgeAccountTokens)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProductMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case product.EdgeAvaliations:
+	case user.EdgeAddresses:
+		ids := make([]ent.Value, 0, len(m.addresses))
+		for id := range m.addresses {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.orders))
+		for id := range m.orders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAvaliations:
 		ids := make([]ent.Value, 0, len(m.avaliations))
 		for id := range m.avaliations {
 			ids = append(ids, id)
 		}
 		return ids
-	case product.EdgeCategory:
-		if id := m.category; id != nil {
+	case user.EdgeCart:
+		if id := m.cart; id != nil {
 			return []ent.Value{*id}
 		}
-	case product.EdgeOrderItems:
-		ids := make([]ent.Value, 0, len(m.order_items))
-		for id := range m.order_items {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
 			ids = append(ids, id)
 		}
 		return ids
-	case product.EdgeCartItems:
-		ids := make([]ent.Value, 0, len(m.cart_items))
-		for id := range m.cart_items {
+	case user.EdgeAccountTokens:
+		ids := make([]ent.Value, 0, len(m.account_tokens))
+		for id := range m.account_tokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9478,39 +23757,57 @@ func (m *ProductMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProductMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedaddresses != nil {
+		edges = append(edges, user.EdgeAddresses)
+	}
+	if m.removedorders != nil {
+		edges = append(edges, user.EdgeOrders)
+	}
 	if m.removedavaliations != nil {
-		edges = append(edges, product.EdgeAvaliations)
+		edges = append(edges, user.EdgeAvaliations)
 	}
-	if m.removedorder_items != nil {
-		edges = append(edges, product.EdgeOrderItems)
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
 	}
-	if m.removedcart_items != nil {
-		edges = append(edges, product.EdgeCartItems)
+	if m.removedaccount_tokens != nil {
+		edges = append(edges, user.EdgeAccountTokens)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case product.EdgeAvaliations:
+	case user.EdgeAddresses:
+		ids := make([]ent.Value, 0, len(m.removedaddresses))
+		for id := range m.removedaddresses {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.removedorders))
+		for id := range m.removedorders {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeAvaliations:
 		ids := make([]ent.Value, 0, len(m.removedavaliations))
 		for id := range m.removedavaliations {
 			ids = append(ids, id)
 		}
 		return ids
-	case product.EdgeOrderItems:
-		ids := make([]ent.Value, 0, len(m.removedorder_items))
-		for id := range m.removedorder_items {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
 			ids = append(ids, id)
 		}
 		return ids
-	case product.EdgeCartItems:
-		ids := make([]ent.Value, 0, len(m.removedcart_items))
-		for id := range m.removedcart_items {
+	case user.EdgeAccountTokens:
+		ids := make([]ent.Value, 0, len(m.removedaccount_tokens))
+		for id := range m.removedaccount_tokens {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9519,111 +23816,115 @@ func (m *ProductMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProductMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedaddresses {
+		edges = append(edges, user.EdgeAddresses)
+	}
+	if m.clearedorders {
+		edges = append(edges, user.EdgeOrders)
+	}
 	if m.clearedavaliations {
-		edges = append(edges, product.EdgeAvaliations)
+		edges = append(edges, user.EdgeAvaliations)
 	}
-	if m.clearedcategory {
-		edges = append(edges, product.EdgeCategory)
+	if m.clearedcart {
+		edges = append(edges, user.EdgeCart)
 	}
-	if m.clearedorder_items {
-		edges = append(edges, product.EdgeOrderItems)
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
 	}
-	if m.clearedcart_items {
-		edges = append(edges, product.EdgeCartItems)
+	if m.clearedaccount_tokens {
+		edges = append(edges, user.EdgeAccountTokens)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProductMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case product.EdgeAvaliations:
+	case user.EdgeAddresses:
+		return m.clearedaddresses
+	case user.EdgeOrders:
+		return m.clearedorders
+	case user.EdgeAvaliations:
 		return m.clearedavaliations
-	case product.EdgeCategory:
-		return m.clearedcategory
-	case product.EdgeOrderItems:
-		return m.clearedorder_items
-	case product.EdgeCartItems:
-		return m.clearedcart_items
+	case user.EdgeCart:
+		return m.clearedcart
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeAccountTokens:
+		return m.clearedaccount_tokens
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProductMutation) ClearEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case product.EdgeCategory:
-		m.ClearCategory()
+	case user.EdgeCart:
+		m.ClearCart()
 		return nil
 	}
-	return fmt.Errorf("unknown Product unique edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProductMutation) ResetEdge(name string) error {
+func (m *UserMutation) ResetEdge(name string) error {
 	switch name {
-	case product.EdgeAvaliations:
+	case user.EdgeAddresses:
+		m.ResetAddresses()
+		return nil
+	case user.EdgeOrders:
+		m.ResetOrders()
+		return nil
+	case user.EdgeAvaliations:
 		m.ResetAvaliations()
 		return nil
-	case product.EdgeCategory:
-		m.ResetCategory()
+	case user.EdgeCart:
+		m.ResetCart()
 		return nil
-	case product.EdgeOrderItems:
-		m.ResetOrderItems()
+	case user.EdgeSessions:
+		m.ResetSessions()
 		return nil
-	case product.EdgeCartItems:
-		m.ResetCartItems()
+	case user.EdgeAccountTokens:
+		m.ResetAccountTokens()
 		return nil
 	}
-	return fmt.Errorf("unknown Product edge %s", name)
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookEventMutation represents an operation that mutates the WebhookEvent nodes in the graph.
+type WebhookEventMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	name               *string
-	email              *string
-	password           *string
-	phone              *string
-	profile_image      *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	addresses          map[string]struct{}
-	removedaddresses   map[string]struct{}
-	clearedaddresses   bool
-	orders             map[string]struct{}
-	removedorders      map[string]struct{}
-	clearedorders      bool
-	avaliations        map[string]struct{}
-	removedavaliations map[string]struct{}
-	clearedavaliations bool
-	cart               *string
-	clearedcart        bool
-	done               bool
-	oldValue           func(context.Context) (*User, error)
-	predicates         []predicate.User
+	op             Op
+	typ            string
+	id             *string
+	provider       *string
+	event_id       *string
+	event_type     *string
+	transaction_id *string
+	payload        *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*WebhookEvent, error)
+	predicates     []predicate.WebhookEvent
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*WebhookEventMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// webhookeventOption allows management of the mutation configuration using functional options.
+type webhookeventOption func(*WebhookEventMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newWebhookEventMutation creates new mutation for the WebhookEvent entity.
+func newWebhookEventMutation(c config, op Op, opts ...webhookeventOption) *WebhookEventMutation {
+	m := &WebhookEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9632,20 +23933,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id string) userOption {
-	return func(m *UserMutation) {
+// withWebhookEventID sets the ID field of the mutation.
+func withWebhookEventID(id string) webhookeventOption {
+	return func(m *WebhookEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookEvent
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -9654,10 +23955,10 @@ func withUserID(id string) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookEvent sets the old WebhookEvent of the mutation.
+func withWebhookEvent(node *WebhookEvent) webhookeventOption {
+	return func(m *WebhookEventMutation) {
+		m.oldValue = func(context.Context) (*WebhookEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9666,7 +23967,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9674,7 +23975,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9684,527 +23985,277 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id string) {
+// operation is only accepted on creation of WebhookEvent entities.
+func (m *WebhookEventMutation) SetID(id string) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id string, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
-}
-
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]string, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []string{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
-}
-
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
-}
-
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
-	}
-	return oldValue.Name, nil
-}
-
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
-}
-
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
-}
-
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
-	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
-}
-
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
-	}
-	return oldValue.Password, nil
-}
-
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-}
-
-// SetPhone sets the "phone" field.
-func (m *UserMutation) SetPhone(s string) {
-	m.phone = &s
-}
-
-// Phone returns the value of the "phone" field in the mutation.
-func (m *UserMutation) Phone() (r string, exists bool) {
-	v := m.phone
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldPhone returns the old "phone" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPhone(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhone requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
-	}
-	return oldValue.Phone, nil
-}
-
-// ClearPhone clears the value of the "phone" field.
-func (m *UserMutation) ClearPhone() {
-	m.phone = nil
-	m.clearedFields[user.FieldPhone] = struct{}{}
-}
-
-// PhoneCleared returns if the "phone" field was cleared in this mutation.
-func (m *UserMutation) PhoneCleared() bool {
-	_, ok := m.clearedFields[user.FieldPhone]
-	return ok
+// if it was provided to the builder or after it was returned from the database.
+func (m *WebhookEventMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetPhone resets all changes to the "phone" field.
-func (m *UserMutation) ResetPhone() {
-	m.phone = nil
-	delete(m.clearedFields, user.FieldPhone)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WebhookEventMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WebhookEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetProfileImage sets the "profile_image" field.
-func (m *UserMutation) SetProfileImage(s string) {
-	m.profile_image = &s
+// SetProvider sets the "provider" field.
+func (m *WebhookEventMutation) SetProvider(s string) {
+	m.provider = &s
 }
 
-// ProfileImage returns the value of the "profile_image" field in the mutation.
-func (m *UserMutation) ProfileImage() (r string, exists bool) {
-	v := m.profile_image
+// Provider returns the value of the "provider" field in the mutation.
+func (m *WebhookEventMutation) Provider() (r string, exists bool) {
+	v := m.provider
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfileImage returns the old "profile_image" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldProvider returns the old "provider" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProfileImage(ctx context.Context) (v string, err error) {
+func (m *WebhookEventMutation) OldProvider(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfileImage is only allowed on UpdateOne operations")
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfileImage requires an ID field in the mutation")
+		return v, errors.New("OldProvider requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfileImage: %w", err)
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
 	}
-	return oldValue.ProfileImage, nil
-}
-
-// ClearProfileImage clears the value of the "profile_image" field.
-func (m *UserMutation) ClearProfileImage() {
-	m.profile_image = nil
-	m.clearedFields[user.FieldProfileImage] = struct{}{}
-}
-
-// ProfileImageCleared returns if the "profile_image" field was cleared in this mutation.
-func (m *UserMutation) ProfileImageCleared() bool {
-	_, ok := m.clearedFields[user.FieldProfileImage]
-	return ok
+	return oldValue.Provider, nil
 }
 
-// ResetProfileImage resets all changes to the "profile_image" field.
-func (m *UserMutation) ResetProfileImage() {
-	m.profile_image = nil
-	delete(m.clearedFields, user.FieldProfileImage)
+// ResetProvider resets all changes to the "provider" field.
+func (m *WebhookEventMutation) ResetProvider() {
+	m.provider = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetEventID sets the "event_id" field.
+func (m *WebhookEventMutation) SetEventID(s string) {
+	m.event_id = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEventMutation) OldEventID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.EventID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookEventMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetEventType sets the "event_type" field.
+func (m *WebhookEventMutation) SetEventType(s string) {
+	m.event_type = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEventMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddAddressIDs adds the "addresses" edge to the Address entity by ids.
-func (m *UserMutation) AddAddressIDs(ids ...string) {
-	if m.addresses == nil {
-		m.addresses = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.addresses[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
+	return oldValue.EventType, nil
 }
 
-// ClearAddresses clears the "addresses" edge to the Address entity.
-func (m *UserMutation) ClearAddresses() {
-	m.clearedaddresses = true
-}
-
-// AddressesCleared reports if the "addresses" edge to the Address entity was cleared.
-func (m *UserMutation) AddressesCleared() bool {
-	return m.clearedaddresses
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookEventMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// RemoveAddressIDs removes the "addresses" edge to the Address entity by IDs.
-func (m *UserMutation) RemoveAddressIDs(ids ...string) {
-	if m.removedaddresses == nil {
-		m.removedaddresses = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.addresses, ids[i])
-		m.removedaddresses[ids[i]] = struct{}{}
-	}
+// SetTransactionID sets the "transaction_id" field.
+func (m *WebhookEventMutation) SetTransactionID(s string) {
+	m.transaction_id = &s
 }
 
-// RemovedAddresses returns the removed IDs of the "addresses" edge to the Address entity.
-func (m *UserMutation) RemovedAddressesIDs() (ids []string) {
-	for id := range m.removedaddresses {
-		ids = append(ids, id)
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *WebhookEventMutation) TransactionID() (r string, exists bool) {
+	v := m.transaction_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AddressesIDs returns the "addresses" edge IDs in the mutation.
-func (m *UserMutation) AddressesIDs() (ids []string) {
-	for id := range m.addresses {
-		ids = append(ids, id)
+// OldTransactionID returns the old "transaction_id" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventMutation) OldTransactionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetAddresses resets all changes to the "addresses" edge.
-func (m *UserMutation) ResetAddresses() {
-	m.addresses = nil
-	m.clearedaddresses = false
-	m.removedaddresses = nil
-}
-
-// AddOrderIDs adds the "orders" edge to the Order entity by ids.
-func (m *UserMutation) AddOrderIDs(ids ...string) {
-	if m.orders == nil {
-		m.orders = make(map[string]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.orders[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
 	}
+	return oldValue.TransactionID, nil
 }
 
-// ClearOrders clears the "orders" edge to the Order entity.
-func (m *UserMutation) ClearOrders() {
-	m.clearedorders = true
-}
-
-// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
-func (m *UserMutation) OrdersCleared() bool {
-	return m.clearedorders
-}
-
-// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
-func (m *UserMutation) RemoveOrderIDs(ids ...string) {
-	if m.removedorders == nil {
-		m.removedorders = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.orders, ids[i])
-		m.removedorders[ids[i]] = struct{}{}
-	}
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (m *WebhookEventMutation) ClearTransactionID() {
+	m.transaction_id = nil
+	m.clearedFields[webhookevent.FieldTransactionID] = struct{}{}
 }
 
-// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
-func (m *UserMutation) RemovedOrdersIDs() (ids []string) {
-	for id := range m.removedorders {
-		ids = append(ids, id)
-	}
-	return
+// TransactionIDCleared returns if the "transaction_id" field was cleared in this mutation.
+func (m *WebhookEventMutation) TransactionIDCleared() bool {
+	_, ok := m.clearedFields[webhookevent.FieldTransactionID]
+	return ok
 }
 
-// OrdersIDs returns the "orders" edge IDs in the mutation.
-func (m *UserMutation) OrdersIDs() (ids []string) {
-	for id := range m.orders {
-		ids = append(ids, id)
-	}
-	return
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *WebhookEventMutation) ResetTransactionID() {
+	m.transaction_id = nil
+	delete(m.clearedFields, webhookevent.FieldTransactionID)
 }
 
-// ResetOrders resets all changes to the "orders" edge.
-func (m *UserMutation) ResetOrders() {
-	m.orders = nil
-	m.clearedorders = false
-	m.removedorders = nil
+// SetPayload sets the "payload" field.
+func (m *WebhookEventMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// AddAvaliationIDs adds the "avaliations" edge to the Avaliation entity by ids.
-func (m *UserMutation) AddAvaliationIDs(ids ...string) {
-	if m.avaliations == nil {
-		m.avaliations = make(map[string]struct{})
-	}
-	for i := range ids {
-		m.avaliations[ids[i]] = struct{}{}
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookEventMutation) Payload() (r string, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAvaliations clears the "avaliations" edge to the Avaliation entity.
-func (m *UserMutation) ClearAvaliations() {
-	m.clearedavaliations = true
-}
-
-// AvaliationsCleared reports if the "avaliations" edge to the Avaliation entity was cleared.
-func (m *UserMutation) AvaliationsCleared() bool {
-	return m.clearedavaliations
-}
-
-// RemoveAvaliationIDs removes the "avaliations" edge to the Avaliation entity by IDs.
-func (m *UserMutation) RemoveAvaliationIDs(ids ...string) {
-	if m.removedavaliations == nil {
-		m.removedavaliations = make(map[string]struct{})
-	}
-	for i := range ids {
-		delete(m.avaliations, ids[i])
-		m.removedavaliations[ids[i]] = struct{}{}
+// OldPayload returns the old "payload" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
-}
-
-// RemovedAvaliations returns the removed IDs of the "avaliations" edge to the Avaliation entity.
-func (m *UserMutation) RemovedAvaliationsIDs() (ids []string) {
-	for id := range m.removedavaliations {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
-	return
-}
-
-// AvaliationsIDs returns the "avaliations" edge IDs in the mutation.
-func (m *UserMutation) AvaliationsIDs() (ids []string) {
-	for id := range m.avaliations {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return
-}
-
-// ResetAvaliations resets all changes to the "avaliations" edge.
-func (m *UserMutation) ResetAvaliations() {
-	m.avaliations = nil
-	m.clearedavaliations = false
-	m.removedavaliations = nil
-}
-
-// SetCartID sets the "cart" edge to the Cart entity by id.
-func (m *UserMutation) SetCartID(id string) {
-	m.cart = &id
+	return oldValue.Payload, nil
 }
 
-// ClearCart clears the "cart" edge to the Cart entity.
-func (m *UserMutation) ClearCart() {
-	m.clearedcart = true
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookEventMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// CartCleared reports if the "cart" edge to the Cart entity was cleared.
-func (m *UserMutation) CartCleared() bool {
-	return m.clearedcart
+// SetCreatedAt sets the "created_at" field.
+func (m *WebhookEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CartID returns the "cart" edge ID in the mutation.
-func (m *UserMutation) CartID() (id string, exists bool) {
-	if m.cart != nil {
-		return *m.cart, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WebhookEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// CartIDs returns the "cart" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CartID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) CartIDs() (ids []string) {
-	if id := m.cart; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the WebhookEvent entity.
+// If the WebhookEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCart resets all changes to the "cart" edge.
-func (m *UserMutation) ResetCart() {
-	m.cart = nil
-	m.clearedcart = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WebhookEventMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookEventMutation builder.
+func (m *WebhookEventMutation) Where(ps ...predicate.WebhookEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10212,45 +24263,42 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *WebhookEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (WebhookEvent).
+func (m *WebhookEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+func (m *WebhookEventMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.provider != nil {
+		fields = append(fields, webhookevent.FieldProvider)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.event_id != nil {
+		fields = append(fields, webhookevent.FieldEventID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.event_type != nil {
+		fields = append(fields, webhookevent.FieldEventType)
 	}
-	if m.phone != nil {
-		fields = append(fields, user.FieldPhone)
+	if m.transaction_id != nil {
+		fields = append(fields, webhookevent.FieldTransactionID)
 	}
-	if m.profile_image != nil {
-		fields = append(fields, user.FieldProfileImage)
+	if m.payload != nil {
+		fields = append(fields, webhookevent.FieldPayload)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, webhookevent.FieldCreatedAt)
 	}
 	return fields
 }
@@ -10258,22 +24306,20 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldName:
-		return m.Name()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldPhone:
-		return m.Phone()
-	case user.FieldProfileImage:
-		return m.ProfileImage()
-	case user.FieldCreatedAt:
+	case webhookevent.FieldProvider:
+		return m.Provider()
+	case webhookevent.FieldEventID:
+		return m.EventID()
+	case webhookevent.FieldEventType:
+		return m.EventType()
+	case webhookevent.FieldTransactionID:
+		return m.TransactionID()
+	case webhookevent.FieldPayload:
+		return m.Payload()
+	case webhookevent.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -10281,319 +24327,195 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldPhone:
-		return m.OldPhone(ctx)
-	case user.FieldProfileImage:
-		return m.OldProfileImage(ctx)
-	case user.FieldCreatedAt:
+	case webhookevent.FieldProvider:
+		return m.OldProvider(ctx)
+	case webhookevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case webhookevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookevent.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case webhookevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookevent.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldName:
+	case webhookevent.FieldProvider:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetProvider(v)
 		return nil
-	case user.FieldEmail:
+	case webhookevent.FieldEventID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetEventID(v)
 		return nil
-	case user.FieldPassword:
+	case webhookevent.FieldEventType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetEventType(v)
 		return nil
-	case user.FieldPhone:
+	case webhookevent.FieldTransactionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhone(v)
+		m.SetTransactionID(v)
 		return nil
-	case user.FieldProfileImage:
+	case webhookevent.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfileImage(v)
+		m.SetPayload(v)
 		return nil
-	case user.FieldCreatedAt:
+	case webhookevent.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookEventMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookEventMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(user.FieldPhone) {
-		fields = append(fields, user.FieldPhone)
-	}
-	if m.FieldCleared(user.FieldProfileImage) {
-		fields = append(fields, user.FieldProfileImage)
+	if m.FieldCleared(webhookevent.FieldTransactionID) {
+		fields = append(fields, webhookevent.FieldTransactionID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
+func (m *WebhookEventMutation) ClearField(name string) error {
 	switch name {
-	case user.FieldPhone:
-		m.ClearPhone()
-		return nil
-	case user.FieldProfileImage:
-		m.ClearProfileImage()
+	case webhookevent.FieldTransactionID:
+		m.ClearTransactionID()
 		return nil
 	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+	return fmt.Errorf("unknown WebhookEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookEventMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldName:
-		m.ResetName()
+	case webhookevent.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhookevent.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webhookevent.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case user.FieldPhone:
-		m.ResetPhone()
+	case webhookevent.FieldTransactionID:
+		m.ResetTransactionID()
 		return nil
-	case user.FieldProfileImage:
-		m.ResetProfileImage()
+	case webhookevent.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case user.FieldCreatedAt:
+	case webhookevent.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.addresses != nil {
-		edges = append(edges, user.EdgeAddresses)
-	}
-	if m.orders != nil {
-		edges = append(edges, user.EdgeOrders)
-	}
-	if m.avaliations != nil {
-		edges = append(edges, user.EdgeAvaliations)
-	}
-	if m.cart != nil {
-		edges = append(edges, user.EdgeCart)
-	}
+func (m *WebhookEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeAddresses:
-		ids := make([]ent.Value, 0, len(m.addresses))
-		for id := range m.addresses {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeOrders:
-		ids := make([]ent.Value, 0, len(m.orders))
-		for id := range m.orders {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAvaliations:
-		ids := make([]ent.Value, 0, len(m.avaliations))
-		for id := range m.avaliations {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCart:
-		if id := m.cart; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *WebhookEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedaddresses != nil {
-		edges = append(edges, user.EdgeAddresses)
-	}
-	if m.removedorders != nil {
-		edges = append(edges, user.EdgeOrders)
-	}
-	if m.removedavaliations != nil {
-		edges = append(edges, user.EdgeAvaliations)
-	}
+func (m *WebhookEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeAddresses:
-		ids := make([]ent.Value, 0, len(m.removedaddresses))
-		for id := range m.removedaddresses {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeOrders:
-		ids := make([]ent.Value, 0, len(m.removedorders))
-		for id := range m.removedorders {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeAvaliations:
-		ids := make([]ent.Value, 0, len(m.removedavaliations))
-		for id := range m.removedavaliations {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WebhookEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedaddresses {
-		edges = append(edges, user.EdgeAddresses)
-	}
-	if m.clearedorders {
-		edges = append(edges, user.EdgeOrders)
-	}
-	if m.clearedavaliations {
-		edges = append(edges, user.EdgeAvaliations)
-	}
-	if m.clearedcart {
-		edges = append(edges, user.EdgeCart)
-	}
+func (m *WebhookEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeAddresses:
-		return m.clearedaddresses
-	case user.EdgeOrders:
-		return m.clearedorders
-	case user.EdgeAvaliations:
-		return m.clearedavaliations
-	case user.EdgeCart:
-		return m.clearedcart
-	}
+func (m *WebhookEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	case user.EdgeCart:
-		m.ClearCart()
-		return nil
-	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *WebhookEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeAddresses:
-		m.ResetAddresses()
-		return nil
-	case user.EdgeOrders:
-		m.ResetOrders()
-		return nil
-	case user.EdgeAvaliations:
-		m.ResetAvaliations()
-		return nil
-	case user.EdgeCart:
-		m.ResetCart()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *WebhookEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown WebhookEvent edge %s", name)
 }
@@ -11,7 +11,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/shipment"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Order is the model entity for the Order schema.
@@ -21,14 +23,22 @@ type Order struct {
 	ID string `json:"id,omitempty"`
 	// UserID holds the value of the "user_id" field.
 	UserID string `json:"user_id,omitempty"`
+	// GuestEmail holds the value of the "guest_email" field.
+	GuestEmail string `json:"guest_email,omitempty"`
+	// GuestName holds the value of the "guest_name" field.
+	GuestName string `json:"guest_name,omitempty"`
 	// Date holds the value of the "date" field.
 	Date time.Time `json:"date,omitempty"`
 	// Total holds the value of the "total" field.
-	Total float64 `json:"total,omitempty"`
+	Total money.Amount `json:"total,omitempty"`
 	// Shipping holds the value of the "shipping" field.
-	Shipping float64 `json:"shipping,omitempty"`
+	Shipping money.Amount `json:"shipping,omitempty"`
+	// ShippingService holds the value of the "shipping_service" field.
+	ShippingService string `json:"shipping_service,omitempty"`
 	// Discount holds the value of the "discount" field.
-	Discount float64 `json:"discount,omitempty"`
+	Discount money.Amount `json:"discount,omitempty"`
+	// RefundedTotal holds the value of the "refunded_total" field.
+	RefundedTotal money.Amount `json:"refunded_total,omitempty"`
 	// DeliveryType holds the value of the "delivery_type" field.
 	DeliveryType order.DeliveryType `json:"delivery_type,omitempty"`
 	// Status holds the value of the "status" field.
@@ -59,9 +69,17 @@ type OrderEdges struct {
 	Address *Address `json:"address,omitempty"`
 	// OrderItems holds the value of the order_items edge.
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// StatusEvents holds the value of the status_events edge.
+	StatusEvents []*OrderStatusEvent `json:"status_events,omitempty"`
+	// Shipment holds the value of the shipment edge.
+	Shipment *Shipment `json:"shipment,omitempty"`
+	// Payments holds the value of the payments edge.
+	Payments []*Payment `json:"payments,omitempty"`
+	// Returns holds the value of the returns edge.
+	Returns []*ReturnRequest `json:"returns,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -95,14 +113,52 @@ func (e OrderEdges) OrderItemsOrErr() ([]*OrderItem, error) {
 	return nil, &NotLoadedError{edge: "order_items"}
 }
 
+// StatusEventsOrErr returns the StatusEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) StatusEventsOrErr() ([]*OrderStatusEvent, error) {
+	if e.loadedTypes[3] {
+		return e.StatusEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "status_events"}
+}
+
+// ShipmentOrErr returns the Shipment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) ShipmentOrErr() (*Shipment, error) {
+	if e.Shipment != nil {
+		return e.Shipment, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: shipment.Label}
+	}
+	return nil, &NotLoadedError{edge: "shipment"}
+}
+
+// PaymentsOrErr returns the Payments value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) PaymentsOrErr() ([]*Payment, error) {
+	if e.loadedTypes[5] {
+		return e.Payments, nil
+	}
+	return nil, &NotLoadedError{edge: "payments"}
+}
+
+// ReturnsOrErr returns the Returns value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrderEdges) ReturnsOrErr() ([]*ReturnRequest, error) {
+	if e.loadedTypes[6] {
+		return e.Returns, nil
+	}
+	return nil, &NotLoadedError{edge: "returns"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Order) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case order.FieldTotal, order.FieldShipping, order.FieldDiscount:
-			values[i] = new(sql.NullFloat64)
-		case order.FieldID, order.FieldUserID, order.FieldDeliveryType, order.FieldStatus, order.FieldAddressID, order.FieldPaymentMethod, order.FieldPaymentStatus, order.FieldCouponCode:
+		case order.FieldTotal, order.FieldShipping, order.FieldDiscount, order.FieldRefundedTotal:
+			values[i] = new(money.Amount)
+		case order.FieldID, order.FieldUserID, order.FieldGuestEmail, order.FieldGuestName, order.FieldShippingService, order.FieldDeliveryType, order.FieldStatus, order.FieldAddressID, order.FieldPaymentMethod, order.FieldPaymentStatus, order.FieldCouponCode:
 			values[i] = new(sql.NullString)
 		case order.FieldDate, order.FieldCreatedAt, order.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -133,6 +189,18 @@ func (o *Order) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				o.UserID = value.String
 			}
+		case order.FieldGuestEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_email", values[i])
+			} else if value.Valid {
+				o.GuestEmail = value.String
+			}
+		case order.FieldGuestName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field guest_name", values[i])
+			} else if value.Valid {
+				o.GuestName = value.String
+			}
 		case order.FieldDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field date", values[i])
@@ -140,22 +208,34 @@ func (o *Order) assignValues(columns []string, values []any) error {
 				o.Date = value.Time
 			}
 		case order.FieldTotal:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field total", values[i])
-			} else if value.Valid {
-				o.Total = value.Float64
+			} else if value != nil {
+				o.Total = *value
 			}
 		case order.FieldShipping:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field shipping", values[i])
+			} else if value != nil {
+				o.Shipping = *value
+			}
+		case order.FieldShippingService:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipping_service", values[i])
 			} else if value.Valid {
-				o.Shipping = value.Float64
+				o.ShippingService = value.String
 			}
 		case order.FieldDiscount:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field discount", values[i])
-			} else if value.Valid {
-				o.Discount = value.Float64
+			} else if value != nil {
+				o.Discount = *value
+			}
+		case order.FieldRefundedTotal:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_total", values[i])
+			} else if value != nil {
+				o.RefundedTotal = *value
 			}
 		case order.FieldDeliveryType:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -233,6 +313,26 @@ func (o *Order) QueryOrderItems() *OrderItemQuery {
 	return NewOrderClient(o.config).QueryOrderItems(o)
 }
 
+// QueryStatusEvents queries the "status_events" edge of the Order entity.
+func (o *Order) QueryStatusEvents() *OrderStatusEventQuery {
+	return NewOrderClient(o.config).QueryStatusEvents(o)
+}
+
+// QueryShipment queries the "shipment" edge of the Order entity.
+func (o *Order) QueryShipment() *ShipmentQuery {
+	return NewOrderClient(o.config).QueryShipment(o)
+}
+
+// QueryPayments queries the "payments" edge of the Order entity.
+func (o *Order) QueryPayments() *PaymentQuery {
+	return NewOrderClient(o.config).QueryPayments(o)
+}
+
+// QueryReturns queries the "returns" edge of the Order entity.
+func (o *Order) QueryReturns() *ReturnRequestQuery {
+	return NewOrderClient(o.config).QueryReturns(o)
+}
+
 // Update returns a builder for updating this Order.
 // Note that you need to call Order.Unwrap() before calling this method if this Order
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -259,6 +359,12 @@ func (o *Order) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(o.UserID)
 	builder.WriteString(", ")
+	builder.WriteString("guest_email=")
+	builder.WriteString(o.GuestEmail)
+	builder.WriteString(", ")
+	builder.WriteString("guest_name=")
+	builder.WriteString(o.GuestName)
+	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(o.Date.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -268,9 +374,15 @@ func (o *Order) String() string {
 	builder.WriteString("shipping=")
 	builder.WriteString(fmt.Sprintf("%v", o.Shipping))
 	builder.WriteString(", ")
+	builder.WriteString("shipping_service=")
+	builder.WriteString(o.ShippingService)
+	builder.WriteString(", ")
 	builder.WriteString("discount=")
 	builder.WriteString(fmt.Sprintf("%v", o.Discount))
 	builder.WriteString(", ")
+	builder.WriteString("refunded_total=")
+	builder.WriteString(fmt.Sprintf("%v", o.RefundedTotal))
+	builder.WriteString(", ")
 	builder.WriteString("delivery_type=")
 	builder.WriteString(fmt.Sprintf("%v", o.DeliveryType))
 	builder.WriteString(", ")
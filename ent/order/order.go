@@ -8,6 +8,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 const (
@@ -17,14 +18,22 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldGuestEmail holds the string denoting the guest_email field in the database.
+	FieldGuestEmail = "guest_email"
+	// FieldGuestName holds the string denoting the guest_name field in the database.
+	FieldGuestName = "guest_name"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
 	// FieldTotal holds the string denoting the total field in the database.
 	FieldTotal = "total"
 	// FieldShipping holds the string denoting the shipping field in the database.
 	FieldShipping = "shipping"
+	// FieldShippingService holds the string denoting the shipping_service field in the database.
+	FieldShippingService = "shipping_service"
 	// FieldDiscount holds the string denoting the discount field in the database.
 	FieldDiscount = "discount"
+	// FieldRefundedTotal holds the string denoting the refunded_total field in the database.
+	FieldRefundedTotal = "refunded_total"
 	// FieldDeliveryType holds the string denoting the delivery_type field in the database.
 	FieldDeliveryType = "delivery_type"
 	// FieldStatus holds the string denoting the status field in the database.
@@ -47,6 +56,14 @@ const (
 	EdgeAddress = "address"
 	// EdgeOrderItems holds the string denoting the order_items edge name in mutations.
 	EdgeOrderItems = "order_items"
+	// EdgeStatusEvents holds the string denoting the status_events edge name in mutations.
+	EdgeStatusEvents = "status_events"
+	// EdgeShipment holds the string denoting the shipment edge name in mutations.
+	EdgeShipment = "shipment"
+	// EdgePayments holds the string denoting the payments edge name in mutations.
+	EdgePayments = "payments"
+	// EdgeReturns holds the string denoting the returns edge name in mutations.
+	EdgeReturns = "returns"
 	// Table holds the table name of the order in the database.
 	Table = "orders"
 	// UserTable is the table that holds the user relation/edge.
@@ -70,16 +87,48 @@ const (
 	OrderItemsInverseTable = "order_items"
 	// OrderItemsColumn is the table column denoting the order_items relation/edge.
 	OrderItemsColumn = "order_id"
+	// StatusEventsTable is the table that holds the status_events relation/edge.
+	StatusEventsTable = "order_status_events"
+	// StatusEventsInverseTable is the table name for the OrderStatusEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "orderstatusevent" package.
+	StatusEventsInverseTable = "order_status_events"
+	// StatusEventsColumn is the table column denoting the status_events relation/edge.
+	StatusEventsColumn = "order_id"
+	// ShipmentTable is the table that holds the shipment relation/edge.
+	ShipmentTable = "shipments"
+	// ShipmentInverseTable is the table name for the Shipment entity.
+	// It exists in this package in order to avoid circular dependency with the "shipment" package.
+	ShipmentInverseTable = "shipments"
+	// ShipmentColumn is the table column denoting the shipment relation/edge.
+	ShipmentColumn = "order_id"
+	// PaymentsTable is the table that holds the payments relation/edge.
+	PaymentsTable = "payments"
+	// PaymentsInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentsInverseTable = "payments"
+	// PaymentsColumn is the table column denoting the payments relation/edge.
+	PaymentsColumn = "order_id"
+	// ReturnsTable is the table that holds the returns relation/edge.
+	ReturnsTable = "return_requests"
+	// ReturnsInverseTable is the table name for the ReturnRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "returnrequest" package.
+	ReturnsInverseTable = "return_requests"
+	// ReturnsColumn is the table column denoting the returns relation/edge.
+	ReturnsColumn = "order_id"
 )
 
 // Columns holds all SQL columns for order fields.
 var Columns = []string{
 	FieldID,
 	FieldUserID,
+	FieldGuestEmail,
+	FieldGuestName,
 	FieldDate,
 	FieldTotal,
 	FieldShipping,
+	FieldShippingService,
 	FieldDiscount,
+	FieldRefundedTotal,
 	FieldDeliveryType,
 	FieldStatus,
 	FieldAddressID,
@@ -104,15 +153,17 @@ var (
 	// DefaultDate holds the default value on creation for the "date" field.
 	DefaultDate func() time.Time
 	// TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	TotalValidator func(float64) error
+	TotalValidator func(int64) error
 	// DefaultShipping holds the default value on creation for the "shipping" field.
-	DefaultShipping float64
+	DefaultShipping money.Amount
 	// DefaultDiscount holds the default value on creation for the "discount" field.
-	DefaultDiscount float64
+	DefaultDiscount money.Amount
+	// DefaultRefundedTotal holds the default value on creation for the "refunded_total" field.
+	DefaultRefundedTotal money.Amount
 	// PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
 	PaymentMethodValidator func(string) error
-	// PaymentStatusValidator is a validator for the "payment_status" field. It is called by the builders before save.
-	PaymentStatusValidator func(string) error
+	// DefaultPaymentStatus holds the default value on creation for the "payment_status" field.
+	DefaultPaymentStatus string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -183,6 +234,16 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByGuestEmail orders the results by the guest_email field.
+func ByGuestEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestEmail, opts...).ToFunc()
+}
+
+// ByGuestName orders the results by the guest_name field.
+func ByGuestName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGuestName, opts...).ToFunc()
+}
+
 // ByDate orders the results by the date field.
 func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
@@ -198,11 +259,21 @@ func ByShipping(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldShipping, opts...).ToFunc()
 }
 
+// ByShippingService orders the results by the shipping_service field.
+func ByShippingService(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShippingService, opts...).ToFunc()
+}
+
 // ByDiscount orders the results by the discount field.
 func ByDiscount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDiscount, opts...).ToFunc()
 }
 
+// ByRefundedTotal orders the results by the refunded_total field.
+func ByRefundedTotal(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedTotal, opts...).ToFunc()
+}
+
 // ByDeliveryType orders the results by the delivery_type field.
 func ByDeliveryType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeliveryType, opts...).ToFunc()
@@ -270,6 +341,55 @@ func ByOrderItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newOrderItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByStatusEventsCount orders the results by status_events count.
+func ByStatusEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStatusEventsStep(), opts...)
+	}
+}
+
+// ByStatusEvents orders the results by status_events terms.
+func ByStatusEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStatusEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByShipmentField orders the results by shipment field.
+func ByShipmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShipmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPaymentsCount orders the results by payments count.
+func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPaymentsStep(), opts...)
+	}
+}
+
+// ByPayments orders the results by payments terms.
+func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByReturnsCount orders the results by returns count.
+func ByReturnsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newReturnsStep(), opts...)
+	}
+}
+
+// ByReturns orders the results by returns terms.
+func ByReturns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -291,3 +411,31 @@ func newOrderItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
 	)
 }
+func newStatusEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StatusEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+	)
+}
+func newShipmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShipmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, ShipmentTable, ShipmentColumn),
+	)
+}
+func newPaymentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+	)
+}
+func newReturnsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ReturnsTable, ReturnsColumn),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -70,26 +71,46 @@ func UserID(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldUserID, v))
 }
 
+// GuestEmail applies equality check predicate on the "guest_email" field. It's identical to GuestEmailEQ.
+func GuestEmail(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGuestEmail, v))
+}
+
+// GuestName applies equality check predicate on the "guest_name" field. It's identical to GuestNameEQ.
+func GuestName(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGuestName, v))
+}
+
 // Date applies equality check predicate on the "date" field. It's identical to DateEQ.
 func Date(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldDate, v))
 }
 
 // Total applies equality check predicate on the "total" field. It's identical to TotalEQ.
-func Total(v float64) predicate.Order {
+func Total(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldTotal, v))
 }
 
 // Shipping applies equality check predicate on the "shipping" field. It's identical to ShippingEQ.
-func Shipping(v float64) predicate.Order {
+func Shipping(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldShipping, v))
 }
 
+// ShippingService applies equality check predicate on the "shipping_service" field. It's identical to ShippingServiceEQ.
+func ShippingService(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingService, v))
+}
+
 // Discount applies equality check predicate on the "discount" field. It's identical to DiscountEQ.
-func Discount(v float64) predicate.Order {
+func Discount(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldDiscount, v))
 }
 
+// RefundedTotal applies equality check predicate on the "refunded_total" field. It's identical to RefundedTotalEQ.
+func RefundedTotal(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRefundedTotal, v))
+}
+
 // AddressID applies equality check predicate on the "address_id" field. It's identical to AddressIDEQ.
 func AddressID(v string) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldAddressID, v))
@@ -195,6 +216,156 @@ func UserIDContainsFold(v string) predicate.Order {
 	return predicate.Order(sql.FieldContainsFold(FieldUserID, v))
 }
 
+// GuestEmailEQ applies the EQ predicate on the "guest_email" field.
+func GuestEmailEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGuestEmail, v))
+}
+
+// GuestEmailNEQ applies the NEQ predicate on the "guest_email" field.
+func GuestEmailNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGuestEmail, v))
+}
+
+// GuestEmailIn applies the In predicate on the "guest_email" field.
+func GuestEmailIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldGuestEmail, vs...))
+}
+
+// GuestEmailNotIn applies the NotIn predicate on the "guest_email" field.
+func GuestEmailNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldGuestEmail, vs...))
+}
+
+// GuestEmailGT applies the GT predicate on the "guest_email" field.
+func GuestEmailGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldGuestEmail, v))
+}
+
+// GuestEmailGTE applies the GTE predicate on the "guest_email" field.
+func GuestEmailGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldGuestEmail, v))
+}
+
+// GuestEmailLT applies the LT predicate on the "guest_email" field.
+func GuestEmailLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldGuestEmail, v))
+}
+
+// GuestEmailLTE applies the LTE predicate on the "guest_email" field.
+func GuestEmailLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldGuestEmail, v))
+}
+
+// GuestEmailContains applies the Contains predicate on the "guest_email" field.
+func GuestEmailContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldGuestEmail, v))
+}
+
+// GuestEmailHasPrefix applies the HasPrefix predicate on the "guest_email" field.
+func GuestEmailHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldGuestEmail, v))
+}
+
+// GuestEmailHasSuffix applies the HasSuffix predicate on the "guest_email" field.
+func GuestEmailHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldGuestEmail, v))
+}
+
+// GuestEmailIsNil applies the IsNil predicate on the "guest_email" field.
+func GuestEmailIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldGuestEmail))
+}
+
+// GuestEmailNotNil applies the NotNil predicate on the "guest_email" field.
+func GuestEmailNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldGuestEmail))
+}
+
+// GuestEmailEqualFold applies the EqualFold predicate on the "guest_email" field.
+func GuestEmailEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldGuestEmail, v))
+}
+
+// GuestEmailContainsFold applies the ContainsFold predicate on the "guest_email" field.
+func GuestEmailContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldGuestEmail, v))
+}
+
+// GuestNameEQ applies the EQ predicate on the "guest_name" field.
+func GuestNameEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldGuestName, v))
+}
+
+// GuestNameNEQ applies the NEQ predicate on the "guest_name" field.
+func GuestNameNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldGuestName, v))
+}
+
+// GuestNameIn applies the In predicate on the "guest_name" field.
+func GuestNameIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldGuestName, vs...))
+}
+
+// GuestNameNotIn applies the NotIn predicate on the "guest_name" field.
+func GuestNameNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldGuestName, vs...))
+}
+
+// GuestNameGT applies the GT predicate on the "guest_name" field.
+func GuestNameGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldGuestName, v))
+}
+
+// GuestNameGTE applies the GTE predicate on the "guest_name" field.
+func GuestNameGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldGuestName, v))
+}
+
+// GuestNameLT applies the LT predicate on the "guest_name" field.
+func GuestNameLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldGuestName, v))
+}
+
+// GuestNameLTE applies the LTE predicate on the "guest_name" field.
+func GuestNameLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldGuestName, v))
+}
+
+// GuestNameContains applies the Contains predicate on the "guest_name" field.
+func GuestNameContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldGuestName, v))
+}
+
+// GuestNameHasPrefix applies the HasPrefix predicate on the "guest_name" field.
+func GuestNameHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldGuestName, v))
+}
+
+// GuestNameHasSuffix applies the HasSuffix predicate on the "guest_name" field.
+func GuestNameHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldGuestName, v))
+}
+
+// GuestNameIsNil applies the IsNil predicate on the "guest_name" field.
+func GuestNameIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldGuestName))
+}
+
+// GuestNameNotNil applies the NotNil predicate on the "guest_name" field.
+func GuestNameNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldGuestName))
+}
+
+// GuestNameEqualFold applies the EqualFold predicate on the "guest_name" field.
+func GuestNameEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldGuestName, v))
+}
+
+// GuestNameContainsFold applies the ContainsFold predicate on the "guest_name" field.
+func GuestNameContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldGuestName, v))
+}
+
 // DateEQ applies the EQ predicate on the "date" field.
 func DateEQ(v time.Time) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldDate, v))
@@ -236,125 +407,240 @@ func DateLTE(v time.Time) predicate.Order {
 }
 
 // TotalEQ applies the EQ predicate on the "total" field.
-func TotalEQ(v float64) predicate.Order {
+func TotalEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldTotal, v))
 }
 
 // TotalNEQ applies the NEQ predicate on the "total" field.
-func TotalNEQ(v float64) predicate.Order {
+func TotalNEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldTotal, v))
 }
 
 // TotalIn applies the In predicate on the "total" field.
-func TotalIn(vs ...float64) predicate.Order {
+func TotalIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldIn(FieldTotal, vs...))
 }
 
 // TotalNotIn applies the NotIn predicate on the "total" field.
-func TotalNotIn(vs ...float64) predicate.Order {
+func TotalNotIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldTotal, vs...))
 }
 
 // TotalGT applies the GT predicate on the "total" field.
-func TotalGT(v float64) predicate.Order {
+func TotalGT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGT(FieldTotal, v))
 }
 
 // TotalGTE applies the GTE predicate on the "total" field.
-func TotalGTE(v float64) predicate.Order {
+func TotalGTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGTE(FieldTotal, v))
 }
 
 // TotalLT applies the LT predicate on the "total" field.
-func TotalLT(v float64) predicate.Order {
+func TotalLT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLT(FieldTotal, v))
 }
 
 // TotalLTE applies the LTE predicate on the "total" field.
-func TotalLTE(v float64) predicate.Order {
+func TotalLTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldTotal, v))
 }
 
 // ShippingEQ applies the EQ predicate on the "shipping" field.
-func ShippingEQ(v float64) predicate.Order {
+func ShippingEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldShipping, v))
 }
 
 // ShippingNEQ applies the NEQ predicate on the "shipping" field.
-func ShippingNEQ(v float64) predicate.Order {
+func ShippingNEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldShipping, v))
 }
 
 // ShippingIn applies the In predicate on the "shipping" field.
-func ShippingIn(vs ...float64) predicate.Order {
+func ShippingIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldIn(FieldShipping, vs...))
 }
 
 // ShippingNotIn applies the NotIn predicate on the "shipping" field.
-func ShippingNotIn(vs ...float64) predicate.Order {
+func ShippingNotIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldShipping, vs...))
 }
 
 // ShippingGT applies the GT predicate on the "shipping" field.
-func ShippingGT(v float64) predicate.Order {
+func ShippingGT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGT(FieldShipping, v))
 }
 
 // ShippingGTE applies the GTE predicate on the "shipping" field.
-func ShippingGTE(v float64) predicate.Order {
+func ShippingGTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGTE(FieldShipping, v))
 }
 
 // ShippingLT applies the LT predicate on the "shipping" field.
-func ShippingLT(v float64) predicate.Order {
+func ShippingLT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLT(FieldShipping, v))
 }
 
 // ShippingLTE applies the LTE predicate on the "shipping" field.
-func ShippingLTE(v float64) predicate.Order {
+func ShippingLTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldShipping, v))
 }
 
+// ShippingServiceEQ applies the EQ predicate on the "shipping_service" field.
+func ShippingServiceEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldShippingService, v))
+}
+
+// ShippingServiceNEQ applies the NEQ predicate on the "shipping_service" field.
+func ShippingServiceNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldShippingService, v))
+}
+
+// ShippingServiceIn applies the In predicate on the "shipping_service" field.
+func ShippingServiceIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldShippingService, vs...))
+}
+
+// ShippingServiceNotIn applies the NotIn predicate on the "shipping_service" field.
+func ShippingServiceNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldShippingService, vs...))
+}
+
+// ShippingServiceGT applies the GT predicate on the "shipping_service" field.
+func ShippingServiceGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldShippingService, v))
+}
+
+// ShippingServiceGTE applies the GTE predicate on the "shipping_service" field.
+func ShippingServiceGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldShippingService, v))
+}
+
+// ShippingServiceLT applies the LT predicate on the "shipping_service" field.
+func ShippingServiceLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldShippingService, v))
+}
+
+// ShippingServiceLTE applies the LTE predicate on the "shipping_service" field.
+func ShippingServiceLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldShippingService, v))
+}
+
+// ShippingServiceContains applies the Contains predicate on the "shipping_service" field.
+func ShippingServiceContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldShippingService, v))
+}
+
+// ShippingServiceHasPrefix applies the HasPrefix predicate on the "shipping_service" field.
+func ShippingServiceHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldShippingService, v))
+}
+
+// ShippingServiceHasSuffix applies the HasSuffix predicate on the "shipping_service" field.
+func ShippingServiceHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldShippingService, v))
+}
+
+// ShippingServiceIsNil applies the IsNil predicate on the "shipping_service" field.
+func ShippingServiceIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldShippingService))
+}
+
+// ShippingServiceNotNil applies the NotNil predicate on the "shipping_service" field.
+func ShippingServiceNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldShippingService))
+}
+
+// ShippingServiceEqualFold applies the EqualFold predicate on the "shipping_service" field.
+func ShippingServiceEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldShippingService, v))
+}
+
+// ShippingServiceContainsFold applies the ContainsFold predicate on the "shipping_service" field.
+func ShippingServiceContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldShippingService, v))
+}
+
 // DiscountEQ applies the EQ predicate on the "discount" field.
-func DiscountEQ(v float64) predicate.Order {
+func DiscountEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldDiscount, v))
 }
 
 // DiscountNEQ applies the NEQ predicate on the "discount" field.
-func DiscountNEQ(v float64) predicate.Order {
+func DiscountNEQ(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNEQ(FieldDiscount, v))
 }
 
 // DiscountIn applies the In predicate on the "discount" field.
-func DiscountIn(vs ...float64) predicate.Order {
+func DiscountIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldIn(FieldDiscount, vs...))
 }
 
 // DiscountNotIn applies the NotIn predicate on the "discount" field.
-func DiscountNotIn(vs ...float64) predicate.Order {
+func DiscountNotIn(vs ...money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldNotIn(FieldDiscount, vs...))
 }
 
 // DiscountGT applies the GT predicate on the "discount" field.
-func DiscountGT(v float64) predicate.Order {
+func DiscountGT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGT(FieldDiscount, v))
 }
 
 // DiscountGTE applies the GTE predicate on the "discount" field.
-func DiscountGTE(v float64) predicate.Order {
+func DiscountGTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldGTE(FieldDiscount, v))
 }
 
 // DiscountLT applies the LT predicate on the "discount" field.
-func DiscountLT(v float64) predicate.Order {
+func DiscountLT(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLT(FieldDiscount, v))
 }
 
 // DiscountLTE applies the LTE predicate on the "discount" field.
-func DiscountLTE(v float64) predicate.Order {
+func DiscountLTE(v money.Amount) predicate.Order {
 	return predicate.Order(sql.FieldLTE(FieldDiscount, v))
 }
 
+// RefundedTotalEQ applies the EQ predicate on the "refunded_total" field.
+func RefundedTotalEQ(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRefundedTotal, v))
+}
+
+// RefundedTotalNEQ applies the NEQ predicate on the "refunded_total" field.
+func RefundedTotalNEQ(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRefundedTotal, v))
+}
+
+// RefundedTotalIn applies the In predicate on the "refunded_total" field.
+func RefundedTotalIn(vs ...money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRefundedTotal, vs...))
+}
+
+// RefundedTotalNotIn applies the NotIn predicate on the "refunded_total" field.
+func RefundedTotalNotIn(vs ...money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRefundedTotal, vs...))
+}
+
+// RefundedTotalGT applies the GT predicate on the "refunded_total" field.
+func RefundedTotalGT(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRefundedTotal, v))
+}
+
+// RefundedTotalGTE applies the GTE predicate on the "refunded_total" field.
+func RefundedTotalGTE(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRefundedTotal, v))
+}
+
+// RefundedTotalLT applies the LT predicate on the "refunded_total" field.
+func RefundedTotalLT(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRefundedTotal, v))
+}
+
+// RefundedTotalLTE applies the LTE predicate on the "refunded_total" field.
+func RefundedTotalLTE(v money.Amount) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRefundedTotal, v))
+}
+
 // DeliveryTypeEQ applies the EQ predicate on the "delivery_type" field.
 func DeliveryTypeEQ(v DeliveryType) predicate.Order {
 	return predicate.Order(sql.FieldEQ(FieldDeliveryType, v))
@@ -824,6 +1110,98 @@ func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.Order {
 	})
 }
 
+// HasStatusEvents applies the HasEdge predicate on the "status_events" edge.
+func HasStatusEvents() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StatusEventsTable, StatusEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStatusEventsWith applies the HasEdge predicate on the "status_events" edge with a given conditions (other predicates).
+func HasStatusEventsWith(preds ...predicate.OrderStatusEvent) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStatusEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShipment applies the HasEdge predicate on the "shipment" edge.
+func HasShipment() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, ShipmentTable, ShipmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShipmentWith applies the HasEdge predicate on the "shipment" edge with a given conditions (other predicates).
+func HasShipmentWith(preds ...predicate.Shipment) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newShipmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPayments applies the HasEdge predicate on the "payments" edge.
+func HasPayments() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentsWith applies the HasEdge predicate on the "payments" edge with a given conditions (other predicates).
+func HasPaymentsWith(preds ...predicate.Payment) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newPaymentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasReturns applies the HasEdge predicate on the "returns" edge.
+func HasReturns() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ReturnsTable, ReturnsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnsWith applies the HasEdge predicate on the "returns" edge with a given conditions (other predicates).
+func HasReturnsWith(preds ...predicate.ReturnRequest) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newReturnsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Order) predicate.Order {
 	return predicate.Order(sql.AndPredicates(predicates...))
@@ -13,7 +13,12 @@ import (
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/ent/shipment"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderCreate is the builder for creating a Order entity.
@@ -37,6 +42,34 @@ func (oc *OrderCreate) SetNillableUserID(s *string) *OrderCreate {
 	return oc
 }
 
+// SetGuestEmail sets the "guest_email" field.
+func (oc *OrderCreate) SetGuestEmail(s string) *OrderCreate {
+	oc.mutation.SetGuestEmail(s)
+	return oc
+}
+
+// SetNillableGuestEmail sets the "guest_email" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableGuestEmail(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetGuestEmail(*s)
+	}
+	return oc
+}
+
+// SetGuestName sets the "guest_name" field.
+func (oc *OrderCreate) SetGuestName(s string) *OrderCreate {
+	oc.mutation.SetGuestName(s)
+	return oc
+}
+
+// SetNillableGuestName sets the "guest_name" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableGuestName(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetGuestName(*s)
+	}
+	return oc
+}
+
 // SetDate sets the "date" field.
 func (oc *OrderCreate) SetDate(t time.Time) *OrderCreate {
 	oc.mutation.SetDate(t)
@@ -52,35 +85,63 @@ func (oc *OrderCreate) SetNillableDate(t *time.Time) *OrderCreate {
 }
 
 // SetTotal sets the "total" field.
-func (oc *OrderCreate) SetTotal(f float64) *OrderCreate {
-	oc.mutation.SetTotal(f)
+func (oc *OrderCreate) SetTotal(m money.Amount) *OrderCreate {
+	oc.mutation.SetTotal(m)
 	return oc
 }
 
 // SetShipping sets the "shipping" field.
-func (oc *OrderCreate) SetShipping(f float64) *OrderCreate {
-	oc.mutation.SetShipping(f)
+func (oc *OrderCreate) SetShipping(m money.Amount) *OrderCreate {
+	oc.mutation.SetShipping(m)
 	return oc
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (oc *OrderCreate) SetNillableShipping(f *float64) *OrderCreate {
-	if f != nil {
-		oc.SetShipping(*f)
+func (oc *OrderCreate) SetNillableShipping(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetShipping(*m)
+	}
+	return oc
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (oc *OrderCreate) SetShippingService(s string) *OrderCreate {
+	oc.mutation.SetShippingService(s)
+	return oc
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableShippingService(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetShippingService(*s)
 	}
 	return oc
 }
 
 // SetDiscount sets the "discount" field.
-func (oc *OrderCreate) SetDiscount(f float64) *OrderCreate {
-	oc.mutation.SetDiscount(f)
+func (oc *OrderCreate) SetDiscount(m money.Amount) *OrderCreate {
+	oc.mutation.SetDiscount(m)
 	return oc
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (oc *OrderCreate) SetNillableDiscount(f *float64) *OrderCreate {
-	if f != nil {
-		oc.SetDiscount(*f)
+func (oc *OrderCreate) SetNillableDiscount(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetDiscount(*m)
+	}
+	return oc
+}
+
+// SetRefundedTotal sets the "refunded_total" field.
+func (oc *OrderCreate) SetRefundedTotal(m money.Amount) *OrderCreate {
+	oc.mutation.SetRefundedTotal(m)
+	return oc
+}
+
+// SetNillableRefundedTotal sets the "refunded_total" field if the given value is not nil.
+func (oc *OrderCreate) SetNillableRefundedTotal(m *money.Amount) *OrderCreate {
+	if m != nil {
+		oc.SetRefundedTotal(*m)
 	}
 	return oc
 }
@@ -123,6 +184,14 @@ func (oc *OrderCreate) SetPaymentStatus(s string) *OrderCreate {
 	return oc
 }
 
+// SetNillablePaymentStatus sets the "payment_status" field if the given value is not nil.
+func (oc *OrderCreate) SetNillablePaymentStatus(s *string) *OrderCreate {
+	if s != nil {
+		oc.SetPaymentStatus(*s)
+	}
+	return oc
+}
+
 // SetCouponCode sets the "coupon_code" field.
 func (oc *OrderCreate) SetCouponCode(s string) *OrderCreate {
 	oc.mutation.SetCouponCode(s)
@@ -196,6 +265,70 @@ func (oc *OrderCreate) AddOrderItems(o ...*OrderItem) *OrderCreate {
 	return oc.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (oc *OrderCreate) AddStatusEventIDs(ids ...string) *OrderCreate {
+	oc.mutation.AddStatusEventIDs(ids...)
+	return oc
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (oc *OrderCreate) AddStatusEvents(o ...*OrderStatusEvent) *OrderCreate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return oc.AddStatusEventIDs(ids...)
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (oc *OrderCreate) SetShipmentID(id string) *OrderCreate {
+	oc.mutation.SetShipmentID(id)
+	return oc
+}
+
+// SetNillableShipmentID sets the "shipment" edge to the Shipment entity by ID if the given value is not nil.
+func (oc *OrderCreate) SetNillableShipmentID(id *string) *OrderCreate {
+	if id != nil {
+		oc = oc.SetShipmentID(*id)
+	}
+	return oc
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (oc *OrderCreate) SetShipment(s *Shipment) *OrderCreate {
+	return oc.SetShipmentID(s.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (oc *OrderCreate) AddPaymentIDs(ids ...string) *OrderCreate {
+	oc.mutation.AddPaymentIDs(ids...)
+	return oc
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (oc *OrderCreate) AddPayments(p ...*Payment) *OrderCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return oc.AddPaymentIDs(ids...)
+}
+
+// AddReturnIDs adds the "returns" edge to the ReturnRequest entity by IDs.
+func (oc *OrderCreate) AddReturnIDs(ids ...string) *OrderCreate {
+	oc.mutation.AddReturnIDs(ids...)
+	return oc
+}
+
+// AddReturns adds the "returns" edges to the ReturnRequest entity.
+func (oc *OrderCreate) AddReturns(r ...*ReturnRequest) *OrderCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return oc.AddReturnIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (oc *OrderCreate) Mutation() *OrderMutation {
 	return oc.mutation
@@ -243,6 +376,14 @@ func (oc *OrderCreate) defaults() {
 		v := order.DefaultDiscount
 		oc.mutation.SetDiscount(v)
 	}
+	if _, ok := oc.mutation.RefundedTotal(); !ok {
+		v := order.DefaultRefundedTotal
+		oc.mutation.SetRefundedTotal(v)
+	}
+	if _, ok := oc.mutation.PaymentStatus(); !ok {
+		v := order.DefaultPaymentStatus
+		oc.mutation.SetPaymentStatus(v)
+	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		v := order.DefaultCreatedAt()
 		oc.mutation.SetCreatedAt(v)
@@ -262,7 +403,7 @@ func (oc *OrderCreate) check() error {
 		return &ValidationError{Name: "total", err: errors.New(`ent: missing required field "Order.total"`)}
 	}
 	if v, ok := oc.mutation.Total(); ok {
-		if err := order.TotalValidator(v); err != nil {
+		if err := order.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
 		}
 	}
@@ -272,6 +413,9 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.Discount(); !ok {
 		return &ValidationError{Name: "discount", err: errors.New(`ent: missing required field "Order.discount"`)}
 	}
+	if _, ok := oc.mutation.RefundedTotal(); !ok {
+		return &ValidationError{Name: "refunded_total", err: errors.New(`ent: missing required field "Order.refunded_total"`)}
+	}
 	if _, ok := oc.mutation.DeliveryType(); !ok {
 		return &ValidationError{Name: "delivery_type", err: errors.New(`ent: missing required field "Order.delivery_type"`)}
 	}
@@ -299,11 +443,6 @@ func (oc *OrderCreate) check() error {
 	if _, ok := oc.mutation.PaymentStatus(); !ok {
 		return &ValidationError{Name: "payment_status", err: errors.New(`ent: missing required field "Order.payment_status"`)}
 	}
-	if v, ok := oc.mutation.PaymentStatus(); ok {
-		if err := order.PaymentStatusValidator(v); err != nil {
-			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
-		}
-	}
 	if _, ok := oc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Order.created_at"`)}
 	}
@@ -345,22 +484,38 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := oc.mutation.GuestEmail(); ok {
+		_spec.SetField(order.FieldGuestEmail, field.TypeString, value)
+		_node.GuestEmail = value
+	}
+	if value, ok := oc.mutation.GuestName(); ok {
+		_spec.SetField(order.FieldGuestName, field.TypeString, value)
+		_node.GuestName = value
+	}
 	if value, ok := oc.mutation.Date(); ok {
 		_spec.SetField(order.FieldDate, field.TypeTime, value)
 		_node.Date = value
 	}
 	if value, ok := oc.mutation.Total(); ok {
-		_spec.SetField(order.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
 		_node.Total = value
 	}
 	if value, ok := oc.mutation.Shipping(); ok {
-		_spec.SetField(order.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(order.FieldShipping, field.TypeInt64, value)
 		_node.Shipping = value
 	}
+	if value, ok := oc.mutation.ShippingService(); ok {
+		_spec.SetField(order.FieldShippingService, field.TypeString, value)
+		_node.ShippingService = value
+	}
 	if value, ok := oc.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 		_node.Discount = value
 	}
+	if value, ok := oc.mutation.RefundedTotal(); ok {
+		_spec.SetField(order.FieldRefundedTotal, field.TypeInt64, value)
+		_node.RefundedTotal = value
+	}
 	if value, ok := oc.mutation.DeliveryType(); ok {
 		_spec.SetField(order.FieldDeliveryType, field.TypeEnum, value)
 		_node.DeliveryType = value
@@ -439,6 +594,70 @@ func (oc *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oc.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   order.ShipmentTable,
+			Columns: []string{order.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := oc.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
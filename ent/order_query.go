@@ -15,20 +15,28 @@ import (
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/ent/shipment"
 	"github.com/vtrod/veecomm-api/ent/user"
 )
 
 // OrderQuery is the builder for querying Order entities.
 type OrderQuery struct {
 	config
-	ctx            *QueryContext
-	order          []order.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Order
-	withUser       *UserQuery
-	withAddress    *AddressQuery
-	withOrderItems *OrderItemQuery
+	ctx              *QueryContext
+	order            []order.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Order
+	withUser         *UserQuery
+	withAddress      *AddressQuery
+	withOrderItems   *OrderItemQuery
+	withStatusEvents *OrderStatusEventQuery
+	withShipment     *ShipmentQuery
+	withPayments     *PaymentQuery
+	withReturns      *ReturnRequestQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +139,94 @@ func (oq *OrderQuery) QueryOrderItems() *OrderItemQuery {
 	return query
 }
 
+// QueryStatusEvents chains the current query on the "status_events" edge.
+func (oq *OrderQuery) QueryStatusEvents() *OrderStatusEventQuery {
+	query := (&OrderStatusEventClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(orderstatusevent.Table, orderstatusevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.StatusEventsTable, order.StatusEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShipment chains the current query on the "shipment" edge.
+func (oq *OrderQuery) QueryShipment() *ShipmentQuery {
+	query := (&ShipmentClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(shipment.Table, shipment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, order.ShipmentTable, order.ShipmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPayments chains the current query on the "payments" edge.
+func (oq *OrderQuery) QueryPayments() *PaymentQuery {
+	query := (&PaymentClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(payment.Table, payment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.PaymentsTable, order.PaymentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryReturns chains the current query on the "returns" edge.
+func (oq *OrderQuery) QueryReturns() *ReturnRequestQuery {
+	query := (&ReturnRequestClient{config: oq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, selector),
+			sqlgraph.To(returnrequest.Table, returnrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, order.ReturnsTable, order.ReturnsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Order entity from the query.
 // Returns a *NotFoundError when no Order was found.
 func (oq *OrderQuery) First(ctx context.Context) (*Order, error) {
@@ -318,14 +414,18 @@ func (oq *OrderQuery) Clone() *OrderQuery {
 		return nil
 	}
 	return &OrderQuery{
-		config:         oq.config,
-		ctx:            oq.ctx.Clone(),
-		order:          append([]order.OrderOption{}, oq.order...),
-		inters:         append([]Interceptor{}, oq.inters...),
-		predicates:     append([]predicate.Order{}, oq.predicates...),
-		withUser:       oq.withUser.Clone(),
-		withAddress:    oq.withAddress.Clone(),
-		withOrderItems: oq.withOrderItems.Clone(),
+		config:           oq.config,
+		ctx:              oq.ctx.Clone(),
+		order:            append([]order.OrderOption{}, oq.order...),
+		inters:           append([]Interceptor{}, oq.inters...),
+		predicates:       append([]predicate.Order{}, oq.predicates...),
+		withUser:         oq.withUser.Clone(),
+		withAddress:      oq.withAddress.Clone(),
+		withOrderItems:   oq.withOrderItems.Clone(),
+		withStatusEvents: oq.withStatusEvents.Clone(),
+		withShipment:     oq.withShipment.Clone(),
+		withPayments:     oq.withPayments.Clone(),
+		withReturns:      oq.withReturns.Clone(),
 		// clone intermediate query.
 		sql:  oq.sql.Clone(),
 		path: oq.path,
@@ -365,6 +465,50 @@ func (oq *OrderQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *OrderQuery
 	return oq
 }
 
+// WithStatusEvents tells the query-builder to eager-load the nodes that are connected to
+// the "status_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithStatusEvents(opts ...func(*OrderStatusEventQuery)) *OrderQuery {
+	query := (&OrderStatusEventClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withStatusEvents = query
+	return oq
+}
+
+// WithShipment tells the query-builder to eager-load the nodes that are connected to
+// the "shipment" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithShipment(opts ...func(*ShipmentQuery)) *OrderQuery {
+	query := (&ShipmentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withShipment = query
+	return oq
+}
+
+// WithPayments tells the query-builder to eager-load the nodes that are connected to
+// the "payments" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithPayments(opts ...func(*PaymentQuery)) *OrderQuery {
+	query := (&PaymentClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withPayments = query
+	return oq
+}
+
+// WithReturns tells the query-builder to eager-load the nodes that are connected to
+// the "returns" edge. The optional arguments are used to configure the query builder of the edge.
+func (oq *OrderQuery) WithReturns(opts ...func(*ReturnRequestQuery)) *OrderQuery {
+	query := (&ReturnRequestClient{config: oq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oq.withReturns = query
+	return oq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +587,14 @@ func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 	var (
 		nodes       = []*Order{}
 		_spec       = oq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [7]bool{
 			oq.withUser != nil,
 			oq.withAddress != nil,
 			oq.withOrderItems != nil,
+			oq.withStatusEvents != nil,
+			oq.withShipment != nil,
+			oq.withPayments != nil,
+			oq.withReturns != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -486,6 +634,33 @@ func (oq *OrderQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Order,
 			return nil, err
 		}
 	}
+	if query := oq.withStatusEvents; query != nil {
+		if err := oq.loadStatusEvents(ctx, query, nodes,
+			func(n *Order) { n.Edges.StatusEvents = []*OrderStatusEvent{} },
+			func(n *Order, e *OrderStatusEvent) { n.Edges.StatusEvents = append(n.Edges.StatusEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withShipment; query != nil {
+		if err := oq.loadShipment(ctx, query, nodes, nil,
+			func(n *Order, e *Shipment) { n.Edges.Shipment = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withPayments; query != nil {
+		if err := oq.loadPayments(ctx, query, nodes,
+			func(n *Order) { n.Edges.Payments = []*Payment{} },
+			func(n *Order, e *Payment) { n.Edges.Payments = append(n.Edges.Payments, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := oq.withReturns; query != nil {
+		if err := oq.loadReturns(ctx, query, nodes,
+			func(n *Order) { n.Edges.Returns = []*ReturnRequest{} },
+			func(n *Order, e *ReturnRequest) { n.Edges.Returns = append(n.Edges.Returns, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -577,6 +752,123 @@ func (oq *OrderQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery,
 	}
 	return nil
 }
+func (oq *OrderQuery) loadStatusEvents(ctx context.Context, query *OrderStatusEventQuery, nodes []*Order, init func(*Order), assign func(*Order, *OrderStatusEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderstatusevent.FieldOrderID)
+	}
+	query.Where(predicate.OrderStatusEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.StatusEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrderQuery) loadShipment(ctx context.Context, query *ShipmentQuery, nodes []*Order, init func(*Order), assign func(*Order, *Shipment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(shipment.FieldOrderID)
+	}
+	query.Where(predicate.Shipment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.ShipmentColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrderQuery) loadPayments(ctx context.Context, query *PaymentQuery, nodes []*Order, init func(*Order), assign func(*Order, *Payment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(payment.FieldOrderID)
+	}
+	query.Where(predicate.Payment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.PaymentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (oq *OrderQuery) loadReturns(ctx context.Context, query *ReturnRequestQuery, nodes []*Order, init func(*Order), assign func(*Order, *ReturnRequest)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Order)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(returnrequest.FieldOrderID)
+	}
+	query.Where(predicate.ReturnRequest(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(order.ReturnsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OrderID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "order_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (oq *OrderQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oq.querySpec()
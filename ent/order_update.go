@@ -14,8 +14,13 @@ import (
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/ent/shipment"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderUpdate is the builder for updating Order entities.
@@ -51,6 +56,46 @@ func (ou *OrderUpdate) ClearUserID() *OrderUpdate {
 	return ou
 }
 
+// SetGuestEmail sets the "guest_email" field.
+func (ou *OrderUpdate) SetGuestEmail(s string) *OrderUpdate {
+	ou.mutation.SetGuestEmail(s)
+	return ou
+}
+
+// SetNillableGuestEmail sets the "guest_email" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableGuestEmail(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetGuestEmail(*s)
+	}
+	return ou
+}
+
+// ClearGuestEmail clears the value of the "guest_email" field.
+func (ou *OrderUpdate) ClearGuestEmail() *OrderUpdate {
+	ou.mutation.ClearGuestEmail()
+	return ou
+}
+
+// SetGuestName sets the "guest_name" field.
+func (ou *OrderUpdate) SetGuestName(s string) *OrderUpdate {
+	ou.mutation.SetGuestName(s)
+	return ou
+}
+
+// SetNillableGuestName sets the "guest_name" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableGuestName(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetGuestName(*s)
+	}
+	return ou
+}
+
+// ClearGuestName clears the value of the "guest_name" field.
+func (ou *OrderUpdate) ClearGuestName() *OrderUpdate {
+	ou.mutation.ClearGuestName()
+	return ou
+}
+
 // SetDate sets the "date" field.
 func (ou *OrderUpdate) SetDate(t time.Time) *OrderUpdate {
 	ou.mutation.SetDate(t)
@@ -66,65 +111,106 @@ func (ou *OrderUpdate) SetNillableDate(t *time.Time) *OrderUpdate {
 }
 
 // SetTotal sets the "total" field.
-func (ou *OrderUpdate) SetTotal(f float64) *OrderUpdate {
+func (ou *OrderUpdate) SetTotal(m money.Amount) *OrderUpdate {
 	ou.mutation.ResetTotal()
-	ou.mutation.SetTotal(f)
+	ou.mutation.SetTotal(m)
 	return ou
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableTotal(f *float64) *OrderUpdate {
-	if f != nil {
-		ou.SetTotal(*f)
+func (ou *OrderUpdate) SetNillableTotal(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetTotal(*m)
 	}
 	return ou
 }
 
-// AddTotal adds f to the "total" field.
-func (ou *OrderUpdate) AddTotal(f float64) *OrderUpdate {
-	ou.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (ou *OrderUpdate) AddTotal(m money.Amount) *OrderUpdate {
+	ou.mutation.AddTotal(m)
 	return ou
 }
 
 // SetShipping sets the "shipping" field.
-func (ou *OrderUpdate) SetShipping(f float64) *OrderUpdate {
+func (ou *OrderUpdate) SetShipping(m money.Amount) *OrderUpdate {
 	ou.mutation.ResetShipping()
-	ou.mutation.SetShipping(f)
+	ou.mutation.SetShipping(m)
 	return ou
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableShipping(f *float64) *OrderUpdate {
-	if f != nil {
-		ou.SetShipping(*f)
+func (ou *OrderUpdate) SetNillableShipping(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetShipping(*m)
 	}
 	return ou
 }
 
-// AddShipping adds f to the "shipping" field.
-func (ou *OrderUpdate) AddShipping(f float64) *OrderUpdate {
-	ou.mutation.AddShipping(f)
+// AddShipping adds m to the "shipping" field.
+func (ou *OrderUpdate) AddShipping(m money.Amount) *OrderUpdate {
+	ou.mutation.AddShipping(m)
+	return ou
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (ou *OrderUpdate) SetShippingService(s string) *OrderUpdate {
+	ou.mutation.SetShippingService(s)
+	return ou
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShippingService(s *string) *OrderUpdate {
+	if s != nil {
+		ou.SetShippingService(*s)
+	}
+	return ou
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (ou *OrderUpdate) ClearShippingService() *OrderUpdate {
+	ou.mutation.ClearShippingService()
 	return ou
 }
 
 // SetDiscount sets the "discount" field.
-func (ou *OrderUpdate) SetDiscount(f float64) *OrderUpdate {
+func (ou *OrderUpdate) SetDiscount(m money.Amount) *OrderUpdate {
 	ou.mutation.ResetDiscount()
-	ou.mutation.SetDiscount(f)
+	ou.mutation.SetDiscount(m)
 	return ou
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (ou *OrderUpdate) SetNillableDiscount(f *float64) *OrderUpdate {
-	if f != nil {
-		ou.SetDiscount(*f)
+func (ou *OrderUpdate) SetNillableDiscount(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetDiscount(*m)
 	}
 	return ou
 }
 
-// AddDiscount adds f to the "discount" field.
-func (ou *OrderUpdate) AddDiscount(f float64) *OrderUpdate {
-	ou.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (ou *OrderUpdate) AddDiscount(m money.Amount) *OrderUpdate {
+	ou.mutation.AddDiscount(m)
+	return ou
+}
+
+// SetRefundedTotal sets the "refunded_total" field.
+func (ou *OrderUpdate) SetRefundedTotal(m money.Amount) *OrderUpdate {
+	ou.mutation.ResetRefundedTotal()
+	ou.mutation.SetRefundedTotal(m)
+	return ou
+}
+
+// SetNillableRefundedTotal sets the "refunded_total" field if the given value is not nil.
+func (ou *OrderUpdate) SetNillableRefundedTotal(m *money.Amount) *OrderUpdate {
+	if m != nil {
+		ou.SetRefundedTotal(*m)
+	}
+	return ou
+}
+
+// AddRefundedTotal adds m to the "refunded_total" field.
+func (ou *OrderUpdate) AddRefundedTotal(m money.Amount) *OrderUpdate {
+	ou.mutation.AddRefundedTotal(m)
 	return ou
 }
 
@@ -269,6 +355,70 @@ func (ou *OrderUpdate) AddOrderItems(o ...*OrderItem) *OrderUpdate {
 	return ou.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (ou *OrderUpdate) AddStatusEventIDs(ids ...string) *OrderUpdate {
+	ou.mutation.AddStatusEventIDs(ids...)
+	return ou
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (ou *OrderUpdate) AddStatusEvents(o ...*OrderStatusEvent) *OrderUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.AddStatusEventIDs(ids...)
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (ou *OrderUpdate) SetShipmentID(id string) *OrderUpdate {
+	ou.mutation.SetShipmentID(id)
+	return ou
+}
+
+// SetNillableShipmentID sets the "shipment" edge to the Shipment entity by ID if the given value is not nil.
+func (ou *OrderUpdate) SetNillableShipmentID(id *string) *OrderUpdate {
+	if id != nil {
+		ou = ou.SetShipmentID(*id)
+	}
+	return ou
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (ou *OrderUpdate) SetShipment(s *Shipment) *OrderUpdate {
+	return ou.SetShipmentID(s.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (ou *OrderUpdate) AddPaymentIDs(ids ...string) *OrderUpdate {
+	ou.mutation.AddPaymentIDs(ids...)
+	return ou
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (ou *OrderUpdate) AddPayments(p ...*Payment) *OrderUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.AddPaymentIDs(ids...)
+}
+
+// AddReturnIDs adds the "returns" edge to the ReturnRequest entity by IDs.
+func (ou *OrderUpdate) AddReturnIDs(ids ...string) *OrderUpdate {
+	ou.mutation.AddReturnIDs(ids...)
+	return ou
+}
+
+// AddReturns adds the "returns" edges to the ReturnRequest entity.
+func (ou *OrderUpdate) AddReturns(r ...*ReturnRequest) *OrderUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ou.AddReturnIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ou *OrderUpdate) Mutation() *OrderMutation {
 	return ou.mutation
@@ -307,6 +457,75 @@ func (ou *OrderUpdate) RemoveOrderItems(o ...*OrderItem) *OrderUpdate {
 	return ou.RemoveOrderItemIDs(ids...)
 }
 
+// ClearStatusEvents clears all "status_events" edges to the OrderStatusEvent entity.
+func (ou *OrderUpdate) ClearStatusEvents() *OrderUpdate {
+	ou.mutation.ClearStatusEvents()
+	return ou
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to OrderStatusEvent entities by IDs.
+func (ou *OrderUpdate) RemoveStatusEventIDs(ids ...string) *OrderUpdate {
+	ou.mutation.RemoveStatusEventIDs(ids...)
+	return ou
+}
+
+// RemoveStatusEvents removes "status_events" edges to OrderStatusEvent entities.
+func (ou *OrderUpdate) RemoveStatusEvents(o ...*OrderStatusEvent) *OrderUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ou.RemoveStatusEventIDs(ids...)
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (ou *OrderUpdate) ClearShipment() *OrderUpdate {
+	ou.mutation.ClearShipment()
+	return ou
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (ou *OrderUpdate) ClearPayments() *OrderUpdate {
+	ou.mutation.ClearPayments()
+	return ou
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (ou *OrderUpdate) RemovePaymentIDs(ids ...string) *OrderUpdate {
+	ou.mutation.RemovePaymentIDs(ids...)
+	return ou
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (ou *OrderUpdate) RemovePayments(p ...*Payment) *OrderUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ou.RemovePaymentIDs(ids...)
+}
+
+// ClearReturns clears all "returns" edges to the ReturnRequest entity.
+func (ou *OrderUpdate) ClearReturns() *OrderUpdate {
+	ou.mutation.ClearReturns()
+	return ou
+}
+
+// RemoveReturnIDs removes the "returns" edge to ReturnRequest entities by IDs.
+func (ou *OrderUpdate) RemoveReturnIDs(ids ...string) *OrderUpdate {
+	ou.mutation.RemoveReturnIDs(ids...)
+	return ou
+}
+
+// RemoveReturns removes "returns" edges to ReturnRequest entities.
+func (ou *OrderUpdate) RemoveReturns(r ...*ReturnRequest) *OrderUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ou.RemoveReturnIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (ou *OrderUpdate) Save(ctx context.Context) (int, error) {
 	ou.defaults()
@@ -346,7 +565,7 @@ func (ou *OrderUpdate) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (ou *OrderUpdate) check() error {
 	if v, ok := ou.mutation.Total(); ok {
-		if err := order.TotalValidator(v); err != nil {
+		if err := order.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
 		}
 	}
@@ -365,11 +584,6 @@ func (ou *OrderUpdate) check() error {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Order.payment_method": %w`, err)}
 		}
 	}
-	if v, ok := ou.mutation.PaymentStatus(); ok {
-		if err := order.PaymentStatusValidator(v); err != nil {
-			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
-		}
-	}
 	return nil
 }
 
@@ -385,26 +599,50 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := ou.mutation.GuestEmail(); ok {
+		_spec.SetField(order.FieldGuestEmail, field.TypeString, value)
+	}
+	if ou.mutation.GuestEmailCleared() {
+		_spec.ClearField(order.FieldGuestEmail, field.TypeString)
+	}
+	if value, ok := ou.mutation.GuestName(); ok {
+		_spec.SetField(order.FieldGuestName, field.TypeString, value)
+	}
+	if ou.mutation.GuestNameCleared() {
+		_spec.ClearField(order.FieldGuestName, field.TypeString)
+	}
 	if value, ok := ou.mutation.Date(); ok {
 		_spec.SetField(order.FieldDate, field.TypeTime, value)
 	}
 	if value, ok := ou.mutation.Total(); ok {
-		_spec.SetField(order.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.AddedTotal(); ok {
-		_spec.AddField(order.FieldTotal, field.TypeFloat64, value)
+		_spec.AddField(order.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.Shipping(); ok {
-		_spec.SetField(order.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(order.FieldShipping, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.AddedShipping(); ok {
-		_spec.AddField(order.FieldShipping, field.TypeFloat64, value)
+		_spec.AddField(order.FieldShipping, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.ShippingService(); ok {
+		_spec.SetField(order.FieldShippingService, field.TypeString, value)
+	}
+	if ou.mutation.ShippingServiceCleared() {
+		_spec.ClearField(order.FieldShippingService, field.TypeString)
 	}
 	if value, ok := ou.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.AddedDiscount(); ok {
-		_spec.AddField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.RefundedTotal(); ok {
+		_spec.SetField(order.FieldRefundedTotal, field.TypeInt64, value)
+	}
+	if value, ok := ou.mutation.AddedRefundedTotal(); ok {
+		_spec.AddField(order.FieldRefundedTotal, field.TypeInt64, value)
 	}
 	if value, ok := ou.mutation.DeliveryType(); ok {
 		_spec.SetField(order.FieldDeliveryType, field.TypeEnum, value)
@@ -533,6 +771,170 @@ func (ou *OrderUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ou.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !ou.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   order.ShipmentTable,
+			Columns: []string{order.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   order.ShipmentTable,
+			Columns: []string{order.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !ou.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ou.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !ou.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ou.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, ou.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{order.Label}
@@ -573,6 +975,46 @@ func (ouo *OrderUpdateOne) ClearUserID() *OrderUpdateOne {
 	return ouo
 }
 
+// SetGuestEmail sets the "guest_email" field.
+func (ouo *OrderUpdateOne) SetGuestEmail(s string) *OrderUpdateOne {
+	ouo.mutation.SetGuestEmail(s)
+	return ouo
+}
+
+// SetNillableGuestEmail sets the "guest_email" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableGuestEmail(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetGuestEmail(*s)
+	}
+	return ouo
+}
+
+// ClearGuestEmail clears the value of the "guest_email" field.
+func (ouo *OrderUpdateOne) ClearGuestEmail() *OrderUpdateOne {
+	ouo.mutation.ClearGuestEmail()
+	return ouo
+}
+
+// SetGuestName sets the "guest_name" field.
+func (ouo *OrderUpdateOne) SetGuestName(s string) *OrderUpdateOne {
+	ouo.mutation.SetGuestName(s)
+	return ouo
+}
+
+// SetNillableGuestName sets the "guest_name" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableGuestName(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetGuestName(*s)
+	}
+	return ouo
+}
+
+// ClearGuestName clears the value of the "guest_name" field.
+func (ouo *OrderUpdateOne) ClearGuestName() *OrderUpdateOne {
+	ouo.mutation.ClearGuestName()
+	return ouo
+}
+
 // SetDate sets the "date" field.
 func (ouo *OrderUpdateOne) SetDate(t time.Time) *OrderUpdateOne {
 	ouo.mutation.SetDate(t)
@@ -588,65 +1030,106 @@ func (ouo *OrderUpdateOne) SetNillableDate(t *time.Time) *OrderUpdateOne {
 }
 
 // SetTotal sets the "total" field.
-func (ouo *OrderUpdateOne) SetTotal(f float64) *OrderUpdateOne {
+func (ouo *OrderUpdateOne) SetTotal(m money.Amount) *OrderUpdateOne {
 	ouo.mutation.ResetTotal()
-	ouo.mutation.SetTotal(f)
+	ouo.mutation.SetTotal(m)
 	return ouo
 }
 
 // SetNillableTotal sets the "total" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableTotal(f *float64) *OrderUpdateOne {
-	if f != nil {
-		ouo.SetTotal(*f)
+func (ouo *OrderUpdateOne) SetNillableTotal(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetTotal(*m)
 	}
 	return ouo
 }
 
-// AddTotal adds f to the "total" field.
-func (ouo *OrderUpdateOne) AddTotal(f float64) *OrderUpdateOne {
-	ouo.mutation.AddTotal(f)
+// AddTotal adds m to the "total" field.
+func (ouo *OrderUpdateOne) AddTotal(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddTotal(m)
 	return ouo
 }
 
 // SetShipping sets the "shipping" field.
-func (ouo *OrderUpdateOne) SetShipping(f float64) *OrderUpdateOne {
+func (ouo *OrderUpdateOne) SetShipping(m money.Amount) *OrderUpdateOne {
 	ouo.mutation.ResetShipping()
-	ouo.mutation.SetShipping(f)
+	ouo.mutation.SetShipping(m)
 	return ouo
 }
 
 // SetNillableShipping sets the "shipping" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableShipping(f *float64) *OrderUpdateOne {
-	if f != nil {
-		ouo.SetShipping(*f)
+func (ouo *OrderUpdateOne) SetNillableShipping(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetShipping(*m)
 	}
 	return ouo
 }
 
-// AddShipping adds f to the "shipping" field.
-func (ouo *OrderUpdateOne) AddShipping(f float64) *OrderUpdateOne {
-	ouo.mutation.AddShipping(f)
+// AddShipping adds m to the "shipping" field.
+func (ouo *OrderUpdateOne) AddShipping(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddShipping(m)
+	return ouo
+}
+
+// SetShippingService sets the "shipping_service" field.
+func (ouo *OrderUpdateOne) SetShippingService(s string) *OrderUpdateOne {
+	ouo.mutation.SetShippingService(s)
+	return ouo
+}
+
+// SetNillableShippingService sets the "shipping_service" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShippingService(s *string) *OrderUpdateOne {
+	if s != nil {
+		ouo.SetShippingService(*s)
+	}
+	return ouo
+}
+
+// ClearShippingService clears the value of the "shipping_service" field.
+func (ouo *OrderUpdateOne) ClearShippingService() *OrderUpdateOne {
+	ouo.mutation.ClearShippingService()
 	return ouo
 }
 
 // SetDiscount sets the "discount" field.
-func (ouo *OrderUpdateOne) SetDiscount(f float64) *OrderUpdateOne {
+func (ouo *OrderUpdateOne) SetDiscount(m money.Amount) *OrderUpdateOne {
 	ouo.mutation.ResetDiscount()
-	ouo.mutation.SetDiscount(f)
+	ouo.mutation.SetDiscount(m)
 	return ouo
 }
 
 // SetNillableDiscount sets the "discount" field if the given value is not nil.
-func (ouo *OrderUpdateOne) SetNillableDiscount(f *float64) *OrderUpdateOne {
-	if f != nil {
-		ouo.SetDiscount(*f)
+func (ouo *OrderUpdateOne) SetNillableDiscount(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetDiscount(*m)
 	}
 	return ouo
 }
 
-// AddDiscount adds f to the "discount" field.
-func (ouo *OrderUpdateOne) AddDiscount(f float64) *OrderUpdateOne {
-	ouo.mutation.AddDiscount(f)
+// AddDiscount adds m to the "discount" field.
+func (ouo *OrderUpdateOne) AddDiscount(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddDiscount(m)
+	return ouo
+}
+
+// SetRefundedTotal sets the "refunded_total" field.
+func (ouo *OrderUpdateOne) SetRefundedTotal(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.ResetRefundedTotal()
+	ouo.mutation.SetRefundedTotal(m)
+	return ouo
+}
+
+// SetNillableRefundedTotal sets the "refunded_total" field if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableRefundedTotal(m *money.Amount) *OrderUpdateOne {
+	if m != nil {
+		ouo.SetRefundedTotal(*m)
+	}
+	return ouo
+}
+
+// AddRefundedTotal adds m to the "refunded_total" field.
+func (ouo *OrderUpdateOne) AddRefundedTotal(m money.Amount) *OrderUpdateOne {
+	ouo.mutation.AddRefundedTotal(m)
 	return ouo
 }
 
@@ -791,6 +1274,70 @@ func (ouo *OrderUpdateOne) AddOrderItems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.AddOrderItemIDs(ids...)
 }
 
+// AddStatusEventIDs adds the "status_events" edge to the OrderStatusEvent entity by IDs.
+func (ouo *OrderUpdateOne) AddStatusEventIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.AddStatusEventIDs(ids...)
+	return ouo
+}
+
+// AddStatusEvents adds the "status_events" edges to the OrderStatusEvent entity.
+func (ouo *OrderUpdateOne) AddStatusEvents(o ...*OrderStatusEvent) *OrderUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.AddStatusEventIDs(ids...)
+}
+
+// SetShipmentID sets the "shipment" edge to the Shipment entity by ID.
+func (ouo *OrderUpdateOne) SetShipmentID(id string) *OrderUpdateOne {
+	ouo.mutation.SetShipmentID(id)
+	return ouo
+}
+
+// SetNillableShipmentID sets the "shipment" edge to the Shipment entity by ID if the given value is not nil.
+func (ouo *OrderUpdateOne) SetNillableShipmentID(id *string) *OrderUpdateOne {
+	if id != nil {
+		ouo = ouo.SetShipmentID(*id)
+	}
+	return ouo
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (ouo *OrderUpdateOne) SetShipment(s *Shipment) *OrderUpdateOne {
+	return ouo.SetShipmentID(s.ID)
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
+func (ouo *OrderUpdateOne) AddPaymentIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.AddPaymentIDs(ids...)
+	return ouo
+}
+
+// AddPayments adds the "payments" edges to the Payment entity.
+func (ouo *OrderUpdateOne) AddPayments(p ...*Payment) *OrderUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ouo.AddPaymentIDs(ids...)
+}
+
+// AddReturnIDs adds the "returns" edge to the ReturnRequest entity by IDs.
+func (ouo *OrderUpdateOne) AddReturnIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.AddReturnIDs(ids...)
+	return ouo
+}
+
+// AddReturns adds the "returns" edges to the ReturnRequest entity.
+func (ouo *OrderUpdateOne) AddReturns(r ...*ReturnRequest) *OrderUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ouo.AddReturnIDs(ids...)
+}
+
 // Mutation returns the OrderMutation object of the builder.
 func (ouo *OrderUpdateOne) Mutation() *OrderMutation {
 	return ouo.mutation
@@ -829,6 +1376,75 @@ func (ouo *OrderUpdateOne) RemoveOrderItems(o ...*OrderItem) *OrderUpdateOne {
 	return ouo.RemoveOrderItemIDs(ids...)
 }
 
+// ClearStatusEvents clears all "status_events" edges to the OrderStatusEvent entity.
+func (ouo *OrderUpdateOne) ClearStatusEvents() *OrderUpdateOne {
+	ouo.mutation.ClearStatusEvents()
+	return ouo
+}
+
+// RemoveStatusEventIDs removes the "status_events" edge to OrderStatusEvent entities by IDs.
+func (ouo *OrderUpdateOne) RemoveStatusEventIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.RemoveStatusEventIDs(ids...)
+	return ouo
+}
+
+// RemoveStatusEvents removes "status_events" edges to OrderStatusEvent entities.
+func (ouo *OrderUpdateOne) RemoveStatusEvents(o ...*OrderStatusEvent) *OrderUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return ouo.RemoveStatusEventIDs(ids...)
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (ouo *OrderUpdateOne) ClearShipment() *OrderUpdateOne {
+	ouo.mutation.ClearShipment()
+	return ouo
+}
+
+// ClearPayments clears all "payments" edges to the Payment entity.
+func (ouo *OrderUpdateOne) ClearPayments() *OrderUpdateOne {
+	ouo.mutation.ClearPayments()
+	return ouo
+}
+
+// RemovePaymentIDs removes the "payments" edge to Payment entities by IDs.
+func (ouo *OrderUpdateOne) RemovePaymentIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.RemovePaymentIDs(ids...)
+	return ouo
+}
+
+// RemovePayments removes "payments" edges to Payment entities.
+func (ouo *OrderUpdateOne) RemovePayments(p ...*Payment) *OrderUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return ouo.RemovePaymentIDs(ids...)
+}
+
+// ClearReturns clears all "returns" edges to the ReturnRequest entity.
+func (ouo *OrderUpdateOne) ClearReturns() *OrderUpdateOne {
+	ouo.mutation.ClearReturns()
+	return ouo
+}
+
+// RemoveReturnIDs removes the "returns" edge to ReturnRequest entities by IDs.
+func (ouo *OrderUpdateOne) RemoveReturnIDs(ids ...string) *OrderUpdateOne {
+	ouo.mutation.RemoveReturnIDs(ids...)
+	return ouo
+}
+
+// RemoveReturns removes "returns" edges to ReturnRequest entities.
+func (ouo *OrderUpdateOne) RemoveReturns(r ...*ReturnRequest) *OrderUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return ouo.RemoveReturnIDs(ids...)
+}
+
 // Where appends a list predicates to the OrderUpdate builder.
 func (ouo *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
 	ouo.mutation.Where(ps...)
@@ -881,7 +1497,7 @@ func (ouo *OrderUpdateOne) defaults() {
 // check runs all checks and user-defined validators on the builder.
 func (ouo *OrderUpdateOne) check() error {
 	if v, ok := ouo.mutation.Total(); ok {
-		if err := order.TotalValidator(v); err != nil {
+		if err := order.TotalValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "total", err: fmt.Errorf(`ent: validator failed for field "Order.total": %w`, err)}
 		}
 	}
@@ -900,11 +1516,6 @@ func (ouo *OrderUpdateOne) check() error {
 			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Order.payment_method": %w`, err)}
 		}
 	}
-	if v, ok := ouo.mutation.PaymentStatus(); ok {
-		if err := order.PaymentStatusValidator(v); err != nil {
-			return &ValidationError{Name: "payment_status", err: fmt.Errorf(`ent: validator failed for field "Order.payment_status": %w`, err)}
-		}
-	}
 	return nil
 }
 
@@ -937,26 +1548,50 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 			}
 		}
 	}
+	if value, ok := ouo.mutation.GuestEmail(); ok {
+		_spec.SetField(order.FieldGuestEmail, field.TypeString, value)
+	}
+	if ouo.mutation.GuestEmailCleared() {
+		_spec.ClearField(order.FieldGuestEmail, field.TypeString)
+	}
+	if value, ok := ouo.mutation.GuestName(); ok {
+		_spec.SetField(order.FieldGuestName, field.TypeString, value)
+	}
+	if ouo.mutation.GuestNameCleared() {
+		_spec.ClearField(order.FieldGuestName, field.TypeString)
+	}
 	if value, ok := ouo.mutation.Date(); ok {
 		_spec.SetField(order.FieldDate, field.TypeTime, value)
 	}
 	if value, ok := ouo.mutation.Total(); ok {
-		_spec.SetField(order.FieldTotal, field.TypeFloat64, value)
+		_spec.SetField(order.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.AddedTotal(); ok {
-		_spec.AddField(order.FieldTotal, field.TypeFloat64, value)
+		_spec.AddField(order.FieldTotal, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.Shipping(); ok {
-		_spec.SetField(order.FieldShipping, field.TypeFloat64, value)
+		_spec.SetField(order.FieldShipping, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.AddedShipping(); ok {
-		_spec.AddField(order.FieldShipping, field.TypeFloat64, value)
+		_spec.AddField(order.FieldShipping, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.ShippingService(); ok {
+		_spec.SetField(order.FieldShippingService, field.TypeString, value)
+	}
+	if ouo.mutation.ShippingServiceCleared() {
+		_spec.ClearField(order.FieldShippingService, field.TypeString)
 	}
 	if value, ok := ouo.mutation.Discount(); ok {
-		_spec.SetField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.SetField(order.FieldDiscount, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.AddedDiscount(); ok {
-		_spec.AddField(order.FieldDiscount, field.TypeFloat64, value)
+		_spec.AddField(order.FieldDiscount, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.RefundedTotal(); ok {
+		_spec.SetField(order.FieldRefundedTotal, field.TypeInt64, value)
+	}
+	if value, ok := ouo.mutation.AddedRefundedTotal(); ok {
+		_spec.AddField(order.FieldRefundedTotal, field.TypeInt64, value)
 	}
 	if value, ok := ouo.mutation.DeliveryType(); ok {
 		_spec.SetField(order.FieldDeliveryType, field.TypeEnum, value)
@@ -1085,6 +1720,170 @@ func (ouo *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if ouo.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedStatusEventsIDs(); len(nodes) > 0 && !ouo.mutation.StatusEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.StatusEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.StatusEventsTable,
+			Columns: []string{order.StatusEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   order.ShipmentTable,
+			Columns: []string{order.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   order.ShipmentTable,
+			Columns: []string{order.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedPaymentsIDs(); len(nodes) > 0 && !ouo.mutation.PaymentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.PaymentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.PaymentsTable,
+			Columns: []string{order.PaymentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if ouo.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.RemovedReturnsIDs(); len(nodes) > 0 && !ouo.mutation.ReturnsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := ouo.mutation.ReturnsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   order.ReturnsTable,
+			Columns: []string{order.ReturnsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Order{config: ouo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
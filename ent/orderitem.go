@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -12,6 +13,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderItem is the model entity for the OrderItem schema.
@@ -23,10 +26,16 @@ type OrderItem struct {
 	OrderID string `json:"order_id,omitempty"`
 	// ProductID holds the value of the "product_id" field.
 	ProductID string `json:"product_id,omitempty"`
+	// VariantID holds the value of the "variant_id" field.
+	VariantID string `json:"variant_id,omitempty"`
+	// Sku holds the value of the "sku" field.
+	Sku string `json:"sku,omitempty"`
+	// VariantOptions holds the value of the "variant_options" field.
+	VariantOptions map[string]string `json:"variant_options,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
 	// Price holds the value of the "price" field.
-	Price float64 `json:"price,omitempty"`
+	Price money.Amount `json:"price,omitempty"`
 	// Image holds the value of the "image" field.
 	Image string `json:"image,omitempty"`
 	// Quantity holds the value of the "quantity" field.
@@ -47,9 +56,11 @@ type OrderItemEdges struct {
 	Order *Order `json:"order,omitempty"`
 	// Product holds the value of the product edge.
 	Product *Product `json:"product,omitempty"`
+	// Variant holds the value of the variant edge.
+	Variant *ProductVariant `json:"variant,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // OrderOrErr returns the Order value or an error if the edge
@@ -74,16 +85,29 @@ func (e OrderItemEdges) ProductOrErr() (*Product, error) {
 	return nil, &NotLoadedError{edge: "product"}
 }
 
+// VariantOrErr returns the Variant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderItemEdges) VariantOrErr() (*ProductVariant, error) {
+	if e.Variant != nil {
+		return e.Variant, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: productvariant.Label}
+	}
+	return nil, &NotLoadedError{edge: "variant"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*OrderItem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case orderitem.FieldVariantOptions:
+			values[i] = new([]byte)
 		case orderitem.FieldPrice:
-			values[i] = new(sql.NullFloat64)
+			values[i] = new(money.Amount)
 		case orderitem.FieldQuantity:
 			values[i] = new(sql.NullInt64)
-		case orderitem.FieldID, orderitem.FieldOrderID, orderitem.FieldProductID, orderitem.FieldName, orderitem.FieldImage:
+		case orderitem.FieldID, orderitem.FieldOrderID, orderitem.FieldProductID, orderitem.FieldVariantID, orderitem.FieldSku, orderitem.FieldName, orderitem.FieldImage:
 			values[i] = new(sql.NullString)
 		case orderitem.FieldCreatedAt, orderitem.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -120,6 +144,26 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				oi.ProductID = value.String
 			}
+		case orderitem.FieldVariantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				oi.VariantID = value.String
+			}
+		case orderitem.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				oi.Sku = value.String
+			}
+		case orderitem.FieldVariantOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &oi.VariantOptions); err != nil {
+					return fmt.Errorf("unmarshal field variant_options: %w", err)
+				}
+			}
 		case orderitem.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -127,10 +171,10 @@ func (oi *OrderItem) assignValues(columns []string, values []any) error {
 				oi.Name = value.String
 			}
 		case orderitem.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
-			} else if value.Valid {
-				oi.Price = value.Float64
+			} else if value != nil {
+				oi.Price = *value
 			}
 		case orderitem.FieldImage:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -179,6 +223,11 @@ func (oi *OrderItem) QueryProduct() *ProductQuery {
 	return NewOrderItemClient(oi.config).QueryProduct(oi)
 }
 
+// QueryVariant queries the "variant" edge of the OrderItem entity.
+func (oi *OrderItem) QueryVariant() *ProductVariantQuery {
+	return NewOrderItemClient(oi.config).QueryVariant(oi)
+}
+
 // Update returns a builder for updating this OrderItem.
 // Note that you need to call OrderItem.Unwrap() before calling this method if this OrderItem
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -208,6 +257,15 @@ func (oi *OrderItem) String() string {
 	builder.WriteString("product_id=")
 	builder.WriteString(oi.ProductID)
 	builder.WriteString(", ")
+	builder.WriteString("variant_id=")
+	builder.WriteString(oi.VariantID)
+	builder.WriteString(", ")
+	builder.WriteString("sku=")
+	builder.WriteString(oi.Sku)
+	builder.WriteString(", ")
+	builder.WriteString("variant_options=")
+	builder.WriteString(fmt.Sprintf("%v", oi.VariantOptions))
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(oi.Name)
 	builder.WriteString(", ")
@@ -13,6 +13,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderItemCreate is the builder for creating a OrderItem entity.
@@ -50,6 +52,40 @@ func (oic *OrderItemCreate) SetNillableProductID(s *string) *OrderItemCreate {
 	return oic
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oic *OrderItemCreate) SetVariantID(s string) *OrderItemCreate {
+	oic.mutation.SetVariantID(s)
+	return oic
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableVariantID(s *string) *OrderItemCreate {
+	if s != nil {
+		oic.SetVariantID(*s)
+	}
+	return oic
+}
+
+// SetSku sets the "sku" field.
+func (oic *OrderItemCreate) SetSku(s string) *OrderItemCreate {
+	oic.mutation.SetSku(s)
+	return oic
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (oic *OrderItemCreate) SetNillableSku(s *string) *OrderItemCreate {
+	if s != nil {
+		oic.SetSku(*s)
+	}
+	return oic
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (oic *OrderItemCreate) SetVariantOptions(m map[string]string) *OrderItemCreate {
+	oic.mutation.SetVariantOptions(m)
+	return oic
+}
+
 // SetName sets the "name" field.
 func (oic *OrderItemCreate) SetName(s string) *OrderItemCreate {
 	oic.mutation.SetName(s)
@@ -57,8 +93,8 @@ func (oic *OrderItemCreate) SetName(s string) *OrderItemCreate {
 }
 
 // SetPrice sets the "price" field.
-func (oic *OrderItemCreate) SetPrice(f float64) *OrderItemCreate {
-	oic.mutation.SetPrice(f)
+func (oic *OrderItemCreate) SetPrice(m money.Amount) *OrderItemCreate {
+	oic.mutation.SetPrice(m)
 	return oic
 }
 
@@ -118,6 +154,11 @@ func (oic *OrderItemCreate) SetProduct(p *Product) *OrderItemCreate {
 	return oic.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (oic *OrderItemCreate) SetVariant(p *ProductVariant) *OrderItemCreate {
+	return oic.SetVariantID(p.ID)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oic *OrderItemCreate) Mutation() *OrderItemMutation {
 	return oic.mutation
@@ -177,7 +218,7 @@ func (oic *OrderItemCreate) check() error {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "OrderItem.price"`)}
 	}
 	if v, ok := oic.mutation.Price(); ok {
-		if err := orderitem.PriceValidator(v); err != nil {
+		if err := orderitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.price": %w`, err)}
 		}
 	}
@@ -238,12 +279,20 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = id
 	}
+	if value, ok := oic.mutation.Sku(); ok {
+		_spec.SetField(orderitem.FieldSku, field.TypeString, value)
+		_node.Sku = value
+	}
+	if value, ok := oic.mutation.VariantOptions(); ok {
+		_spec.SetField(orderitem.FieldVariantOptions, field.TypeJSON, value)
+		_node.VariantOptions = value
+	}
 	if value, ok := oic.mutation.Name(); ok {
 		_spec.SetField(orderitem.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
 	if value, ok := oic.mutation.Price(); ok {
-		_spec.SetField(orderitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
 	if value, ok := oic.mutation.Image(); ok {
@@ -296,6 +345,23 @@ func (oic *OrderItemCreate) createSpec() (*OrderItem, *sqlgraph.CreateSpec) {
 		_node.ProductID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := oic.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VariantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
 )
 
 // OrderItemQuery is the builder for querying OrderItem entities.
@@ -26,6 +27,7 @@ type OrderItemQuery struct {
 	predicates  []predicate.OrderItem
 	withOrder   *OrderQuery
 	withProduct *ProductQuery
+	withVariant *ProductVariantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -106,6 +108,28 @@ func (oiq *OrderItemQuery) QueryProduct() *ProductQuery {
 	return query
 }
 
+// QueryVariant chains the current query on the "variant" edge.
+func (oiq *OrderItemQuery) QueryVariant() *ProductVariantQuery {
+	query := (&ProductVariantClient{config: oiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderitem.Table, orderitem.FieldID, selector),
+			sqlgraph.To(productvariant.Table, productvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderitem.VariantTable, orderitem.VariantColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first OrderItem entity from the query.
 // Returns a *NotFoundError when no OrderItem was found.
 func (oiq *OrderItemQuery) First(ctx context.Context) (*OrderItem, error) {
@@ -300,6 +324,7 @@ func (oiq *OrderItemQuery) Clone() *OrderItemQuery {
 		predicates:  append([]predicate.OrderItem{}, oiq.predicates...),
 		withOrder:   oiq.withOrder.Clone(),
 		withProduct: oiq.withProduct.Clone(),
+		withVariant: oiq.withVariant.Clone(),
 		// clone intermediate query.
 		sql:  oiq.sql.Clone(),
 		path: oiq.path,
@@ -328,6 +353,17 @@ func (oiq *OrderItemQuery) WithProduct(opts ...func(*ProductQuery)) *OrderItemQu
 	return oiq
 }
 
+// WithVariant tells the query-builder to eager-load the nodes that are connected to
+// the "variant" edge. The optional arguments are used to configure the query builder of the edge.
+func (oiq *OrderItemQuery) WithVariant(opts ...func(*ProductVariantQuery)) *OrderItemQuery {
+	query := (&ProductVariantClient{config: oiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oiq.withVariant = query
+	return oiq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -406,9 +442,10 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 	var (
 		nodes       = []*OrderItem{}
 		_spec       = oiq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			oiq.withOrder != nil,
 			oiq.withProduct != nil,
+			oiq.withVariant != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -441,6 +478,12 @@ func (oiq *OrderItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*O
 			return nil, err
 		}
 	}
+	if query := oiq.withVariant; query != nil {
+		if err := oiq.loadVariant(ctx, query, nodes, nil,
+			func(n *OrderItem, e *ProductVariant) { n.Edges.Variant = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -502,6 +545,35 @@ func (oiq *OrderItemQuery) loadProduct(ctx context.Context, query *ProductQuery,
 	}
 	return nil
 }
+func (oiq *OrderItemQuery) loadVariant(ctx context.Context, query *ProductVariantQuery, nodes []*OrderItem, init func(*OrderItem), assign func(*OrderItem, *ProductVariant)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*OrderItem)
+	for i := range nodes {
+		fk := nodes[i].VariantID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(productvariant.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "variant_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (oiq *OrderItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := oiq.querySpec()
@@ -534,6 +606,9 @@ func (oiq *OrderItemQuery) querySpec() *sqlgraph.QuerySpec {
 		if oiq.withProduct != nil {
 			_spec.Node.AddColumnOnce(orderitem.FieldProductID)
 		}
+		if oiq.withVariant != nil {
+			_spec.Node.AddColumnOnce(orderitem.FieldVariantID)
+		}
 	}
 	if ps := oiq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -15,6 +15,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderItemUpdate is the builder for updating OrderItem entities.
@@ -70,6 +72,58 @@ func (oiu *OrderItemUpdate) ClearProductID() *OrderItemUpdate {
 	return oiu
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oiu *OrderItemUpdate) SetVariantID(s string) *OrderItemUpdate {
+	oiu.mutation.SetVariantID(s)
+	return oiu
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableVariantID(s *string) *OrderItemUpdate {
+	if s != nil {
+		oiu.SetVariantID(*s)
+	}
+	return oiu
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (oiu *OrderItemUpdate) ClearVariantID() *OrderItemUpdate {
+	oiu.mutation.ClearVariantID()
+	return oiu
+}
+
+// SetSku sets the "sku" field.
+func (oiu *OrderItemUpdate) SetSku(s string) *OrderItemUpdate {
+	oiu.mutation.SetSku(s)
+	return oiu
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (oiu *OrderItemUpdate) SetNillableSku(s *string) *OrderItemUpdate {
+	if s != nil {
+		oiu.SetSku(*s)
+	}
+	return oiu
+}
+
+// ClearSku clears the value of the "sku" field.
+func (oiu *OrderItemUpdate) ClearSku() *OrderItemUpdate {
+	oiu.mutation.ClearSku()
+	return oiu
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (oiu *OrderItemUpdate) SetVariantOptions(m map[string]string) *OrderItemUpdate {
+	oiu.mutation.SetVariantOptions(m)
+	return oiu
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (oiu *OrderItemUpdate) ClearVariantOptions() *OrderItemUpdate {
+	oiu.mutation.ClearVariantOptions()
+	return oiu
+}
+
 // SetName sets the "name" field.
 func (oiu *OrderItemUpdate) SetName(s string) *OrderItemUpdate {
 	oiu.mutation.SetName(s)
@@ -85,23 +139,23 @@ func (oiu *OrderItemUpdate) SetNillableName(s *string) *OrderItemUpdate {
 }
 
 // SetPrice sets the "price" field.
-func (oiu *OrderItemUpdate) SetPrice(f float64) *OrderItemUpdate {
+func (oiu *OrderItemUpdate) SetPrice(m money.Amount) *OrderItemUpdate {
 	oiu.mutation.ResetPrice()
-	oiu.mutation.SetPrice(f)
+	oiu.mutation.SetPrice(m)
 	return oiu
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (oiu *OrderItemUpdate) SetNillablePrice(f *float64) *OrderItemUpdate {
-	if f != nil {
-		oiu.SetPrice(*f)
+func (oiu *OrderItemUpdate) SetNillablePrice(m *money.Amount) *OrderItemUpdate {
+	if m != nil {
+		oiu.SetPrice(*m)
 	}
 	return oiu
 }
 
-// AddPrice adds f to the "price" field.
-func (oiu *OrderItemUpdate) AddPrice(f float64) *OrderItemUpdate {
-	oiu.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (oiu *OrderItemUpdate) AddPrice(m money.Amount) *OrderItemUpdate {
+	oiu.mutation.AddPrice(m)
 	return oiu
 }
 
@@ -170,6 +224,11 @@ func (oiu *OrderItemUpdate) SetProduct(p *Product) *OrderItemUpdate {
 	return oiu.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (oiu *OrderItemUpdate) SetVariant(p *ProductVariant) *OrderItemUpdate {
+	return oiu.SetVariantID(p.ID)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oiu *OrderItemUpdate) Mutation() *OrderItemMutation {
 	return oiu.mutation
@@ -187,6 +246,12 @@ func (oiu *OrderItemUpdate) ClearProduct() *OrderItemUpdate {
 	return oiu
 }
 
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (oiu *OrderItemUpdate) ClearVariant() *OrderItemUpdate {
+	oiu.mutation.ClearVariant()
+	return oiu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (oiu *OrderItemUpdate) Save(ctx context.Context) (int, error) {
 	oiu.defaults()
@@ -231,7 +296,7 @@ func (oiu *OrderItemUpdate) check() error {
 		}
 	}
 	if v, ok := oiu.mutation.Price(); ok {
-		if err := orderitem.PriceValidator(v); err != nil {
+		if err := orderitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.price": %w`, err)}
 		}
 	}
@@ -260,14 +325,26 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := oiu.mutation.Sku(); ok {
+		_spec.SetField(orderitem.FieldSku, field.TypeString, value)
+	}
+	if oiu.mutation.SkuCleared() {
+		_spec.ClearField(orderitem.FieldSku, field.TypeString)
+	}
+	if value, ok := oiu.mutation.VariantOptions(); ok {
+		_spec.SetField(orderitem.FieldVariantOptions, field.TypeJSON, value)
+	}
+	if oiu.mutation.VariantOptionsCleared() {
+		_spec.ClearField(orderitem.FieldVariantOptions, field.TypeJSON)
+	}
 	if value, ok := oiu.mutation.Name(); ok {
 		_spec.SetField(orderitem.FieldName, field.TypeString, value)
 	}
 	if value, ok := oiu.mutation.Price(); ok {
-		_spec.SetField(orderitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiu.mutation.AddedPrice(); ok {
-		_spec.AddField(orderitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiu.mutation.Image(); ok {
 		_spec.SetField(orderitem.FieldImage, field.TypeString, value)
@@ -342,6 +419,35 @@ func (oiu *OrderItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if oiu.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiu.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, oiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{orderitem.Label}
@@ -402,6 +508,58 @@ func (oiuo *OrderItemUpdateOne) ClearProductID() *OrderItemUpdateOne {
 	return oiuo
 }
 
+// SetVariantID sets the "variant_id" field.
+func (oiuo *OrderItemUpdateOne) SetVariantID(s string) *OrderItemUpdateOne {
+	oiuo.mutation.SetVariantID(s)
+	return oiuo
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableVariantID(s *string) *OrderItemUpdateOne {
+	if s != nil {
+		oiuo.SetVariantID(*s)
+	}
+	return oiuo
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (oiuo *OrderItemUpdateOne) ClearVariantID() *OrderItemUpdateOne {
+	oiuo.mutation.ClearVariantID()
+	return oiuo
+}
+
+// SetSku sets the "sku" field.
+func (oiuo *OrderItemUpdateOne) SetSku(s string) *OrderItemUpdateOne {
+	oiuo.mutation.SetSku(s)
+	return oiuo
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (oiuo *OrderItemUpdateOne) SetNillableSku(s *string) *OrderItemUpdateOne {
+	if s != nil {
+		oiuo.SetSku(*s)
+	}
+	return oiuo
+}
+
+// ClearSku clears the value of the "sku" field.
+func (oiuo *OrderItemUpdateOne) ClearSku() *OrderItemUpdateOne {
+	oiuo.mutation.ClearSku()
+	return oiuo
+}
+
+// SetVariantOptions sets the "variant_options" field.
+func (oiuo *OrderItemUpdateOne) SetVariantOptions(m map[string]string) *OrderItemUpdateOne {
+	oiuo.mutation.SetVariantOptions(m)
+	return oiuo
+}
+
+// ClearVariantOptions clears the value of the "variant_options" field.
+func (oiuo *OrderItemUpdateOne) ClearVariantOptions() *OrderItemUpdateOne {
+	oiuo.mutation.ClearVariantOptions()
+	return oiuo
+}
+
 // SetName sets the "name" field.
 func (oiuo *OrderItemUpdateOne) SetName(s string) *OrderItemUpdateOne {
 	oiuo.mutation.SetName(s)
@@ -417,23 +575,23 @@ func (oiuo *OrderItemUpdateOne) SetNillableName(s *string) *OrderItemUpdateOne {
 }
 
 // SetPrice sets the "price" field.
-func (oiuo *OrderItemUpdateOne) SetPrice(f float64) *OrderItemUpdateOne {
+func (oiuo *OrderItemUpdateOne) SetPrice(m money.Amount) *OrderItemUpdateOne {
 	oiuo.mutation.ResetPrice()
-	oiuo.mutation.SetPrice(f)
+	oiuo.mutation.SetPrice(m)
 	return oiuo
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (oiuo *OrderItemUpdateOne) SetNillablePrice(f *float64) *OrderItemUpdateOne {
-	if f != nil {
-		oiuo.SetPrice(*f)
+func (oiuo *OrderItemUpdateOne) SetNillablePrice(m *money.Amount) *OrderItemUpdateOne {
+	if m != nil {
+		oiuo.SetPrice(*m)
 	}
 	return oiuo
 }
 
-// AddPrice adds f to the "price" field.
-func (oiuo *OrderItemUpdateOne) AddPrice(f float64) *OrderItemUpdateOne {
-	oiuo.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (oiuo *OrderItemUpdateOne) AddPrice(m money.Amount) *OrderItemUpdateOne {
+	oiuo.mutation.AddPrice(m)
 	return oiuo
 }
 
@@ -502,6 +660,11 @@ func (oiuo *OrderItemUpdateOne) SetProduct(p *Product) *OrderItemUpdateOne {
 	return oiuo.SetProductID(p.ID)
 }
 
+// SetVariant sets the "variant" edge to the ProductVariant entity.
+func (oiuo *OrderItemUpdateOne) SetVariant(p *ProductVariant) *OrderItemUpdateOne {
+	return oiuo.SetVariantID(p.ID)
+}
+
 // Mutation returns the OrderItemMutation object of the builder.
 func (oiuo *OrderItemUpdateOne) Mutation() *OrderItemMutation {
 	return oiuo.mutation
@@ -519,6 +682,12 @@ func (oiuo *OrderItemUpdateOne) ClearProduct() *OrderItemUpdateOne {
 	return oiuo
 }
 
+// ClearVariant clears the "variant" edge to the ProductVariant entity.
+func (oiuo *OrderItemUpdateOne) ClearVariant() *OrderItemUpdateOne {
+	oiuo.mutation.ClearVariant()
+	return oiuo
+}
+
 // Where appends a list predicates to the OrderItemUpdate builder.
 func (oiuo *OrderItemUpdateOne) Where(ps ...predicate.OrderItem) *OrderItemUpdateOne {
 	oiuo.mutation.Where(ps...)
@@ -576,7 +745,7 @@ func (oiuo *OrderItemUpdateOne) check() error {
 		}
 	}
 	if v, ok := oiuo.mutation.Price(); ok {
-		if err := orderitem.PriceValidator(v); err != nil {
+		if err := orderitem.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "OrderItem.price": %w`, err)}
 		}
 	}
@@ -622,14 +791,26 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 			}
 		}
 	}
+	if value, ok := oiuo.mutation.Sku(); ok {
+		_spec.SetField(orderitem.FieldSku, field.TypeString, value)
+	}
+	if oiuo.mutation.SkuCleared() {
+		_spec.ClearField(orderitem.FieldSku, field.TypeString)
+	}
+	if value, ok := oiuo.mutation.VariantOptions(); ok {
+		_spec.SetField(orderitem.FieldVariantOptions, field.TypeJSON, value)
+	}
+	if oiuo.mutation.VariantOptionsCleared() {
+		_spec.ClearField(orderitem.FieldVariantOptions, field.TypeJSON)
+	}
 	if value, ok := oiuo.mutation.Name(); ok {
 		_spec.SetField(orderitem.FieldName, field.TypeString, value)
 	}
 	if value, ok := oiuo.mutation.Price(); ok {
-		_spec.SetField(orderitem.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(orderitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiuo.mutation.AddedPrice(); ok {
-		_spec.AddField(orderitem.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(orderitem.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := oiuo.mutation.Image(); ok {
 		_spec.SetField(orderitem.FieldImage, field.TypeString, value)
@@ -704,6 +885,35 @@ func (oiuo *OrderItemUpdateOne) sqlSave(ctx context.Context) (_node *OrderItem,
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if oiuo.mutation.VariantCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oiuo.mutation.VariantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderitem.VariantTable,
+			Columns: []string{orderitem.VariantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &OrderItem{config: oiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,191 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+)
+
+// OrderStatusEvent is the model entity for the OrderStatusEvent schema.
+type OrderStatusEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// FromStatus holds the value of the "from_status" field.
+	FromStatus *orderstatusevent.FromStatus `json:"from_status,omitempty"`
+	// ToStatus holds the value of the "to_status" field.
+	ToStatus orderstatusevent.ToStatus `json:"to_status,omitempty"`
+	// ChangedBy holds the value of the "changed_by" field.
+	ChangedBy string `json:"changed_by,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderStatusEventQuery when eager-loading is set.
+	Edges        OrderStatusEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderStatusEventEdges holds the relations/edges for other nodes in the graph.
+type OrderStatusEventEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderStatusEventEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrderStatusEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case orderstatusevent.FieldID, orderstatusevent.FieldOrderID, orderstatusevent.FieldFromStatus, orderstatusevent.FieldToStatus, orderstatusevent.FieldChangedBy, orderstatusevent.FieldNote:
+			values[i] = new(sql.NullString)
+		case orderstatusevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrderStatusEvent fields.
+func (ose *OrderStatusEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case orderstatusevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ose.ID = value.String
+			}
+		case orderstatusevent.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				ose.OrderID = value.String
+			}
+		case orderstatusevent.FieldFromStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field from_status", values[i])
+			} else if value.Valid {
+				ose.FromStatus = new(orderstatusevent.FromStatus)
+				*ose.FromStatus = orderstatusevent.FromStatus(value.String)
+			}
+		case orderstatusevent.FieldToStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field to_status", values[i])
+			} else if value.Valid {
+				ose.ToStatus = orderstatusevent.ToStatus(value.String)
+			}
+		case orderstatusevent.FieldChangedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value.Valid {
+				ose.ChangedBy = value.String
+			}
+		case orderstatusevent.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				ose.Note = value.String
+			}
+		case orderstatusevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ose.CreatedAt = value.Time
+			}
+		default:
+			ose.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrderStatusEvent.
+// This includes values selected through modifiers, order, etc.
+func (ose *OrderStatusEvent) Value(name string) (ent.Value, error) {
+	return ose.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the OrderStatusEvent entity.
+func (ose *OrderStatusEvent) QueryOrder() *OrderQuery {
+	return NewOrderStatusEventClient(ose.config).QueryOrder(ose)
+}
+
+// Update returns a builder for updating this OrderStatusEvent.
+// Note that you need to call OrderStatusEvent.Unwrap() before calling this method if this OrderStatusEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ose *OrderStatusEvent) Update() *OrderStatusEventUpdateOne {
+	return NewOrderStatusEventClient(ose.config).UpdateOne(ose)
+}
+
+// Unwrap unwraps the OrderStatusEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ose *OrderStatusEvent) Unwrap() *OrderStatusEvent {
+	_tx, ok := ose.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrderStatusEvent is not a transactional entity")
+	}
+	ose.config.driver = _tx.drv
+	return ose
+}
+
+// String implements the fmt.Stringer.
+func (ose *OrderStatusEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrderStatusEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ose.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(ose.OrderID)
+	builder.WriteString(", ")
+	if v := ose.FromStatus; v != nil {
+		builder.WriteString("from_status=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("to_status=")
+	builder.WriteString(fmt.Sprintf("%v", ose.ToStatus))
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(ose.ChangedBy)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(ose.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ose.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrderStatusEvents is a parsable slice of OrderStatusEvent.
+type OrderStatusEvents []*OrderStatusEvent
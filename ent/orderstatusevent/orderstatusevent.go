@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package orderstatusevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the orderstatusevent type in the database.
+	Label = "order_status_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrderID holds the string denoting the order_id field in the database.
+	FieldOrderID = "order_id"
+	// FieldFromStatus holds the string denoting the from_status field in the database.
+	FieldFromStatus = "from_status"
+	// FieldToStatus holds the string denoting the to_status field in the database.
+	FieldToStatus = "to_status"
+	// FieldChangedBy holds the string denoting the changed_by field in the database.
+	FieldChangedBy = "changed_by"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOrder holds the string denoting the order edge name in mutations.
+	EdgeOrder = "order"
+	// Table holds the table name of the orderstatusevent in the database.
+	Table = "order_status_events"
+	// OrderTable is the table that holds the order relation/edge.
+	OrderTable = "order_status_events"
+	// OrderInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrderInverseTable = "orders"
+	// OrderColumn is the table column denoting the order relation/edge.
+	OrderColumn = "order_id"
+)
+
+// Columns holds all SQL columns for orderstatusevent fields.
+var Columns = []string{
+	FieldID,
+	FieldOrderID,
+	FieldFromStatus,
+	FieldToStatus,
+	FieldChangedBy,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// FromStatus defines the type for the "from_status" enum field.
+type FromStatus string
+
+// FromStatus values.
+const (
+	FromStatusPending    FromStatus = "pending"
+	FromStatusProcessing FromStatus = "processing"
+	FromStatusShipped    FromStatus = "shipped"
+	FromStatusDelivered  FromStatus = "delivered"
+	FromStatusCancelled  FromStatus = "cancelled"
+)
+
+func (fs FromStatus) String() string {
+	return string(fs)
+}
+
+// FromStatusValidator is a validator for the "from_status" field enum values. It is called by the builders before save.
+func FromStatusValidator(fs FromStatus) error {
+	switch fs {
+	case FromStatusPending, FromStatusProcessing, FromStatusShipped, FromStatusDelivered, FromStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("orderstatusevent: invalid enum value for from_status field: %q", fs)
+	}
+}
+
+// ToStatus defines the type for the "to_status" enum field.
+type ToStatus string
+
+// ToStatus values.
+const (
+	ToStatusPending    ToStatus = "pending"
+	ToStatusProcessing ToStatus = "processing"
+	ToStatusShipped    ToStatus = "shipped"
+	ToStatusDelivered  ToStatus = "delivered"
+	ToStatusCancelled  ToStatus = "cancelled"
+)
+
+func (ts ToStatus) String() string {
+	return string(ts)
+}
+
+// ToStatusValidator is a validator for the "to_status" field enum values. It is called by the builders before save.
+func ToStatusValidator(ts ToStatus) error {
+	switch ts {
+	case ToStatusPending, ToStatusProcessing, ToStatusShipped, ToStatusDelivered, ToStatusCancelled:
+		return nil
+	default:
+		return fmt.Errorf("orderstatusevent: invalid enum value for to_status field: %q", ts)
+	}
+}
+
+// OrderOption defines the ordering options for the OrderStatusEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrderID orders the results by the order_id field.
+func ByOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderID, opts...).ToFunc()
+}
+
+// ByFromStatus orders the results by the from_status field.
+func ByFromStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFromStatus, opts...).ToFunc()
+}
+
+// ByToStatus orders the results by the to_status field.
+func ByToStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldToStatus, opts...).ToFunc()
+}
+
+// ByChangedBy orders the results by the changed_by field.
+func ByChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedBy, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOrderField orders the results by order field.
+func ByOrderField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrderStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrderStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrderInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+	)
+}
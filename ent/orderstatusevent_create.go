@@ -0,0 +1,327 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+)
+
+// OrderStatusEventCreate is the builder for creating a OrderStatusEvent entity.
+type OrderStatusEventCreate struct {
+	config
+	mutation *OrderStatusEventMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (osec *OrderStatusEventCreate) SetOrderID(s string) *OrderStatusEventCreate {
+	osec.mutation.SetOrderID(s)
+	return osec
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (osec *OrderStatusEventCreate) SetNillableOrderID(s *string) *OrderStatusEventCreate {
+	if s != nil {
+		osec.SetOrderID(*s)
+	}
+	return osec
+}
+
+// SetFromStatus sets the "from_status" field.
+func (osec *OrderStatusEventCreate) SetFromStatus(os orderstatusevent.FromStatus) *OrderStatusEventCreate {
+	osec.mutation.SetFromStatus(os)
+	return osec
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (osec *OrderStatusEventCreate) SetNillableFromStatus(os *orderstatusevent.FromStatus) *OrderStatusEventCreate {
+	if os != nil {
+		osec.SetFromStatus(*os)
+	}
+	return osec
+}
+
+// SetToStatus sets the "to_status" field.
+func (osec *OrderStatusEventCreate) SetToStatus(os orderstatusevent.ToStatus) *OrderStatusEventCreate {
+	osec.mutation.SetToStatus(os)
+	return osec
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (osec *OrderStatusEventCreate) SetChangedBy(s string) *OrderStatusEventCreate {
+	osec.mutation.SetChangedBy(s)
+	return osec
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (osec *OrderStatusEventCreate) SetNillableChangedBy(s *string) *OrderStatusEventCreate {
+	if s != nil {
+		osec.SetChangedBy(*s)
+	}
+	return osec
+}
+
+// SetNote sets the "note" field.
+func (osec *OrderStatusEventCreate) SetNote(s string) *OrderStatusEventCreate {
+	osec.mutation.SetNote(s)
+	return osec
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (osec *OrderStatusEventCreate) SetNillableNote(s *string) *OrderStatusEventCreate {
+	if s != nil {
+		osec.SetNote(*s)
+	}
+	return osec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (osec *OrderStatusEventCreate) SetCreatedAt(t time.Time) *OrderStatusEventCreate {
+	osec.mutation.SetCreatedAt(t)
+	return osec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (osec *OrderStatusEventCreate) SetNillableCreatedAt(t *time.Time) *OrderStatusEventCreate {
+	if t != nil {
+		osec.SetCreatedAt(*t)
+	}
+	return osec
+}
+
+// SetID sets the "id" field.
+func (osec *OrderStatusEventCreate) SetID(s string) *OrderStatusEventCreate {
+	osec.mutation.SetID(s)
+	return osec
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (osec *OrderStatusEventCreate) SetOrder(o *Order) *OrderStatusEventCreate {
+	return osec.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusEventMutation object of the builder.
+func (osec *OrderStatusEventCreate) Mutation() *OrderStatusEventMutation {
+	return osec.mutation
+}
+
+// Save creates the OrderStatusEvent in the database.
+func (osec *OrderStatusEventCreate) Save(ctx context.Context) (*OrderStatusEvent, error) {
+	osec.defaults()
+	return withHooks(ctx, osec.sqlSave, osec.mutation, osec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (osec *OrderStatusEventCreate) SaveX(ctx context.Context) *OrderStatusEvent {
+	v, err := osec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (osec *OrderStatusEventCreate) Exec(ctx context.Context) error {
+	_, err := osec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osec *OrderStatusEventCreate) ExecX(ctx context.Context) {
+	if err := osec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (osec *OrderStatusEventCreate) defaults() {
+	if _, ok := osec.mutation.CreatedAt(); !ok {
+		v := orderstatusevent.DefaultCreatedAt()
+		osec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (osec *OrderStatusEventCreate) check() error {
+	if v, ok := osec.mutation.FromStatus(); ok {
+		if err := orderstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if _, ok := osec.mutation.ToStatus(); !ok {
+		return &ValidationError{Name: "to_status", err: errors.New(`ent: missing required field "OrderStatusEvent.to_status"`)}
+	}
+	if v, ok := osec.mutation.ToStatus(); ok {
+		if err := orderstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.to_status": %w`, err)}
+		}
+	}
+	if _, ok := osec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrderStatusEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (osec *OrderStatusEventCreate) sqlSave(ctx context.Context) (*OrderStatusEvent, error) {
+	if err := osec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := osec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, osec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected OrderStatusEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	osec.mutation.id = &_node.ID
+	osec.mutation.done = true
+	return _node, nil
+}
+
+func (osec *OrderStatusEventCreate) createSpec() (*OrderStatusEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrderStatusEvent{config: osec.config}
+		_spec = sqlgraph.NewCreateSpec(orderstatusevent.Table, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString))
+	)
+	if id, ok := osec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := osec.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldFromStatus, field.TypeEnum, value)
+		_node.FromStatus = &value
+	}
+	if value, ok := osec.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldToStatus, field.TypeEnum, value)
+		_node.ToStatus = value
+	}
+	if value, ok := osec.mutation.ChangedBy(); ok {
+		_spec.SetField(orderstatusevent.FieldChangedBy, field.TypeString, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := osec.mutation.Note(); ok {
+		_spec.SetField(orderstatusevent.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := osec.mutation.CreatedAt(); ok {
+		_spec.SetField(orderstatusevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := osec.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderStatusEventCreateBulk is the builder for creating many OrderStatusEvent entities in bulk.
+type OrderStatusEventCreateBulk struct {
+	config
+	err      error
+	builders []*OrderStatusEventCreate
+}
+
+// Save creates the OrderStatusEvent entities in the database.
+func (osecb *OrderStatusEventCreateBulk) Save(ctx context.Context) ([]*OrderStatusEvent, error) {
+	if osecb.err != nil {
+		return nil, osecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(osecb.builders))
+	nodes := make([]*OrderStatusEvent, len(osecb.builders))
+	mutators := make([]Mutator, len(osecb.builders))
+	for i := range osecb.builders {
+		func(i int, root context.Context) {
+			builder := osecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderStatusEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, osecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, osecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, osecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (osecb *OrderStatusEventCreateBulk) SaveX(ctx context.Context) []*OrderStatusEvent {
+	v, err := osecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (osecb *OrderStatusEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := osecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osecb *OrderStatusEventCreateBulk) ExecX(ctx context.Context) {
+	if err := osecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// OrderStatusEventDelete is the builder for deleting a OrderStatusEvent entity.
+type OrderStatusEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusEventMutation
+}
+
+// Where appends a list predicates to the OrderStatusEventDelete builder.
+func (osed *OrderStatusEventDelete) Where(ps ...predicate.OrderStatusEvent) *OrderStatusEventDelete {
+	osed.mutation.Where(ps...)
+	return osed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (osed *OrderStatusEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, osed.sqlExec, osed.mutation, osed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osed *OrderStatusEventDelete) ExecX(ctx context.Context) int {
+	n, err := osed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (osed *OrderStatusEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(orderstatusevent.Table, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString))
+	if ps := osed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, osed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	osed.mutation.done = true
+	return affected, err
+}
+
+// OrderStatusEventDeleteOne is the builder for deleting a single OrderStatusEvent entity.
+type OrderStatusEventDeleteOne struct {
+	osed *OrderStatusEventDelete
+}
+
+// Where appends a list predicates to the OrderStatusEventDelete builder.
+func (osedo *OrderStatusEventDeleteOne) Where(ps ...predicate.OrderStatusEvent) *OrderStatusEventDeleteOne {
+	osedo.osed.mutation.Where(ps...)
+	return osedo
+}
+
+// Exec executes the deletion query.
+func (osedo *OrderStatusEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := osedo.osed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{orderstatusevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (osedo *OrderStatusEventDeleteOne) ExecX(ctx context.Context) {
+	if err := osedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
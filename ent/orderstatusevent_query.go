@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// OrderStatusEventQuery is the builder for querying OrderStatusEvent entities.
+type OrderStatusEventQuery struct {
+	config
+	ctx        *QueryContext
+	order      []orderstatusevent.OrderOption
+	inters     []Interceptor
+	predicates []predicate.OrderStatusEvent
+	withOrder  *OrderQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the OrderStatusEventQuery builder.
+func (oseq *OrderStatusEventQuery) Where(ps ...predicate.OrderStatusEvent) *OrderStatusEventQuery {
+	oseq.predicates = append(oseq.predicates, ps...)
+	return oseq
+}
+
+// Limit the number of records to be returned by this query.
+func (oseq *OrderStatusEventQuery) Limit(limit int) *OrderStatusEventQuery {
+	oseq.ctx.Limit = &limit
+	return oseq
+}
+
+// Offset to start from.
+func (oseq *OrderStatusEventQuery) Offset(offset int) *OrderStatusEventQuery {
+	oseq.ctx.Offset = &offset
+	return oseq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (oseq *OrderStatusEventQuery) Unique(unique bool) *OrderStatusEventQuery {
+	oseq.ctx.Unique = &unique
+	return oseq
+}
+
+// Order specifies how the records should be ordered.
+func (oseq *OrderStatusEventQuery) Order(o ...orderstatusevent.OrderOption) *OrderStatusEventQuery {
+	oseq.order = append(oseq.order, o...)
+	return oseq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (oseq *OrderStatusEventQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: oseq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := oseq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := oseq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(orderstatusevent.Table, orderstatusevent.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, orderstatusevent.OrderTable, orderstatusevent.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(oseq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first OrderStatusEvent entity from the query.
+// Returns a *NotFoundError when no OrderStatusEvent was found.
+func (oseq *OrderStatusEventQuery) First(ctx context.Context) (*OrderStatusEvent, error) {
+	nodes, err := oseq.Limit(1).All(setContextOp(ctx, oseq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{orderstatusevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) FirstX(ctx context.Context) *OrderStatusEvent {
+	node, err := oseq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first OrderStatusEvent ID from the query.
+// Returns a *NotFoundError when no OrderStatusEvent ID was found.
+func (oseq *OrderStatusEventQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = oseq.Limit(1).IDs(setContextOp(ctx, oseq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{orderstatusevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) FirstIDX(ctx context.Context) string {
+	id, err := oseq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single OrderStatusEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one OrderStatusEvent entity is found.
+// Returns a *NotFoundError when no OrderStatusEvent entities are found.
+func (oseq *OrderStatusEventQuery) Only(ctx context.Context) (*OrderStatusEvent, error) {
+	nodes, err := oseq.Limit(2).All(setContextOp(ctx, oseq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{orderstatusevent.Label}
+	default:
+		return nil, &NotSingularError{orderstatusevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) OnlyX(ctx context.Context) *OrderStatusEvent {
+	node, err := oseq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only OrderStatusEvent ID in the query.
+// Returns a *NotSingularError when more than one OrderStatusEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (oseq *OrderStatusEventQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = oseq.Limit(2).IDs(setContextOp(ctx, oseq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{orderstatusevent.Label}
+	default:
+		err = &NotSingularError{orderstatusevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) OnlyIDX(ctx context.Context) string {
+	id, err := oseq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of OrderStatusEvents.
+func (oseq *OrderStatusEventQuery) All(ctx context.Context) ([]*OrderStatusEvent, error) {
+	ctx = setContextOp(ctx, oseq.ctx, ent.OpQueryAll)
+	if err := oseq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*OrderStatusEvent, *OrderStatusEventQuery]()
+	return withInterceptors[[]*OrderStatusEvent](ctx, oseq, qr, oseq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) AllX(ctx context.Context) []*OrderStatusEvent {
+	nodes, err := oseq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of OrderStatusEvent IDs.
+func (oseq *OrderStatusEventQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if oseq.ctx.Unique == nil && oseq.path != nil {
+		oseq.Unique(true)
+	}
+	ctx = setContextOp(ctx, oseq.ctx, ent.OpQueryIDs)
+	if err = oseq.Select(orderstatusevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) IDsX(ctx context.Context) []string {
+	ids, err := oseq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (oseq *OrderStatusEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, oseq.ctx, ent.OpQueryCount)
+	if err := oseq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, oseq, querierCount[*OrderStatusEventQuery](), oseq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) CountX(ctx context.Context) int {
+	count, err := oseq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (oseq *OrderStatusEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, oseq.ctx, ent.OpQueryExist)
+	switch _, err := oseq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (oseq *OrderStatusEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := oseq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the OrderStatusEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (oseq *OrderStatusEventQuery) Clone() *OrderStatusEventQuery {
+	if oseq == nil {
+		return nil
+	}
+	return &OrderStatusEventQuery{
+		config:     oseq.config,
+		ctx:        oseq.ctx.Clone(),
+		order:      append([]orderstatusevent.OrderOption{}, oseq.order...),
+		inters:     append([]Interceptor{}, oseq.inters...),
+		predicates: append([]predicate.OrderStatusEvent{}, oseq.predicates...),
+		withOrder:  oseq.withOrder.Clone(),
+		// clone intermediate query.
+		sql:  oseq.sql.Clone(),
+		path: oseq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (oseq *OrderStatusEventQuery) WithOrder(opts ...func(*OrderQuery)) *OrderStatusEventQuery {
+	query := (&OrderClient{config: oseq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	oseq.withOrder = query
+	return oseq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID string `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.OrderStatusEvent.Query().
+//		GroupBy(orderstatusevent.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (oseq *OrderStatusEventQuery) GroupBy(field string, fields ...string) *OrderStatusEventGroupBy {
+	oseq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &OrderStatusEventGroupBy{build: oseq}
+	grbuild.flds = &oseq.ctx.Fields
+	grbuild.label = orderstatusevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID string `json:"order_id,omitempty"`
+//	}
+//
+//	client.OrderStatusEvent.Query().
+//		Select(orderstatusevent.FieldOrderID).
+//		Scan(ctx, &v)
+func (oseq *OrderStatusEventQuery) Select(fields ...string) *OrderStatusEventSelect {
+	oseq.ctx.Fields = append(oseq.ctx.Fields, fields...)
+	sbuild := &OrderStatusEventSelect{OrderStatusEventQuery: oseq}
+	sbuild.label = orderstatusevent.Label
+	sbuild.flds, sbuild.scan = &oseq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a OrderStatusEventSelect configured with the given aggregations.
+func (oseq *OrderStatusEventQuery) Aggregate(fns ...AggregateFunc) *OrderStatusEventSelect {
+	return oseq.Select().Aggregate(fns...)
+}
+
+func (oseq *OrderStatusEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range oseq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, oseq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range oseq.ctx.Fields {
+		if !orderstatusevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if oseq.path != nil {
+		prev, err := oseq.path(ctx)
+		if err != nil {
+			return err
+		}
+		oseq.sql = prev
+	}
+	return nil
+}
+
+func (oseq *OrderStatusEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*OrderStatusEvent, error) {
+	var (
+		nodes       = []*OrderStatusEvent{}
+		_spec       = oseq.querySpec()
+		loadedTypes = [1]bool{
+			oseq.withOrder != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*OrderStatusEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &OrderStatusEvent{config: oseq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, oseq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := oseq.withOrder; query != nil {
+		if err := oseq.loadOrder(ctx, query, nodes, nil,
+			func(n *OrderStatusEvent, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (oseq *OrderStatusEventQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*OrderStatusEvent, init func(*OrderStatusEvent), assign func(*OrderStatusEvent, *Order)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*OrderStatusEvent)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (oseq *OrderStatusEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := oseq.querySpec()
+	_spec.Node.Columns = oseq.ctx.Fields
+	if len(oseq.ctx.Fields) > 0 {
+		_spec.Unique = oseq.ctx.Unique != nil && *oseq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, oseq.driver, _spec)
+}
+
+func (oseq *OrderStatusEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(orderstatusevent.Table, orderstatusevent.Columns, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString))
+	_spec.From = oseq.sql
+	if unique := oseq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if oseq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := oseq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatusevent.FieldID)
+		for i := range fields {
+			if fields[i] != orderstatusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if oseq.withOrder != nil {
+			_spec.Node.AddColumnOnce(orderstatusevent.FieldOrderID)
+		}
+	}
+	if ps := oseq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := oseq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := oseq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := oseq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (oseq *OrderStatusEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(oseq.driver.Dialect())
+	t1 := builder.Table(orderstatusevent.Table)
+	columns := oseq.ctx.Fields
+	if len(columns) == 0 {
+		columns = orderstatusevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if oseq.sql != nil {
+		selector = oseq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if oseq.ctx.Unique != nil && *oseq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range oseq.predicates {
+		p(selector)
+	}
+	for _, p := range oseq.order {
+		p(selector)
+	}
+	if offset := oseq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := oseq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// OrderStatusEventGroupBy is the group-by builder for OrderStatusEvent entities.
+type OrderStatusEventGroupBy struct {
+	selector
+	build *OrderStatusEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (osegb *OrderStatusEventGroupBy) Aggregate(fns ...AggregateFunc) *OrderStatusEventGroupBy {
+	osegb.fns = append(osegb.fns, fns...)
+	return osegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (osegb *OrderStatusEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, osegb.build.ctx, ent.OpQueryGroupBy)
+	if err := osegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusEventQuery, *OrderStatusEventGroupBy](ctx, osegb.build, osegb, osegb.build.inters, v)
+}
+
+func (osegb *OrderStatusEventGroupBy) sqlScan(ctx context.Context, root *OrderStatusEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(osegb.fns))
+	for _, fn := range osegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*osegb.flds)+len(osegb.fns))
+		for _, f := range *osegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*osegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := osegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// OrderStatusEventSelect is the builder for selecting fields of OrderStatusEvent entities.
+type OrderStatusEventSelect struct {
+	*OrderStatusEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (oses *OrderStatusEventSelect) Aggregate(fns ...AggregateFunc) *OrderStatusEventSelect {
+	oses.fns = append(oses.fns, fns...)
+	return oses
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (oses *OrderStatusEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, oses.ctx, ent.OpQuerySelect)
+	if err := oses.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*OrderStatusEventQuery, *OrderStatusEventSelect](ctx, oses.OrderStatusEventQuery, oses, oses.inters, v)
+}
+
+func (oses *OrderStatusEventSelect) sqlScan(ctx context.Context, root *OrderStatusEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(oses.fns))
+	for _, fn := range oses.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*oses.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := oses.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
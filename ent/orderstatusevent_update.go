@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// OrderStatusEventUpdate is the builder for updating OrderStatusEvent entities.
+type OrderStatusEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderStatusEventMutation
+}
+
+// Where appends a list predicates to the OrderStatusEventUpdate builder.
+func (oseu *OrderStatusEventUpdate) Where(ps ...predicate.OrderStatusEvent) *OrderStatusEventUpdate {
+	oseu.mutation.Where(ps...)
+	return oseu
+}
+
+// SetOrderID sets the "order_id" field.
+func (oseu *OrderStatusEventUpdate) SetOrderID(s string) *OrderStatusEventUpdate {
+	oseu.mutation.SetOrderID(s)
+	return oseu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oseu *OrderStatusEventUpdate) SetNillableOrderID(s *string) *OrderStatusEventUpdate {
+	if s != nil {
+		oseu.SetOrderID(*s)
+	}
+	return oseu
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (oseu *OrderStatusEventUpdate) ClearOrderID() *OrderStatusEventUpdate {
+	oseu.mutation.ClearOrderID()
+	return oseu
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oseu *OrderStatusEventUpdate) SetFromStatus(os orderstatusevent.FromStatus) *OrderStatusEventUpdate {
+	oseu.mutation.SetFromStatus(os)
+	return oseu
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oseu *OrderStatusEventUpdate) SetNillableFromStatus(os *orderstatusevent.FromStatus) *OrderStatusEventUpdate {
+	if os != nil {
+		oseu.SetFromStatus(*os)
+	}
+	return oseu
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oseu *OrderStatusEventUpdate) ClearFromStatus() *OrderStatusEventUpdate {
+	oseu.mutation.ClearFromStatus()
+	return oseu
+}
+
+// SetToStatus sets the "to_status" field.
+func (oseu *OrderStatusEventUpdate) SetToStatus(os orderstatusevent.ToStatus) *OrderStatusEventUpdate {
+	oseu.mutation.SetToStatus(os)
+	return oseu
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oseu *OrderStatusEventUpdate) SetNillableToStatus(os *orderstatusevent.ToStatus) *OrderStatusEventUpdate {
+	if os != nil {
+		oseu.SetToStatus(*os)
+	}
+	return oseu
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (oseu *OrderStatusEventUpdate) SetChangedBy(s string) *OrderStatusEventUpdate {
+	oseu.mutation.SetChangedBy(s)
+	return oseu
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (oseu *OrderStatusEventUpdate) SetNillableChangedBy(s *string) *OrderStatusEventUpdate {
+	if s != nil {
+		oseu.SetChangedBy(*s)
+	}
+	return oseu
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (oseu *OrderStatusEventUpdate) ClearChangedBy() *OrderStatusEventUpdate {
+	oseu.mutation.ClearChangedBy()
+	return oseu
+}
+
+// SetNote sets the "note" field.
+func (oseu *OrderStatusEventUpdate) SetNote(s string) *OrderStatusEventUpdate {
+	oseu.mutation.SetNote(s)
+	return oseu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (oseu *OrderStatusEventUpdate) SetNillableNote(s *string) *OrderStatusEventUpdate {
+	if s != nil {
+		oseu.SetNote(*s)
+	}
+	return oseu
+}
+
+// ClearNote clears the value of the "note" field.
+func (oseu *OrderStatusEventUpdate) ClearNote() *OrderStatusEventUpdate {
+	oseu.mutation.ClearNote()
+	return oseu
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oseu *OrderStatusEventUpdate) SetOrder(o *Order) *OrderStatusEventUpdate {
+	return oseu.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusEventMutation object of the builder.
+func (oseu *OrderStatusEventUpdate) Mutation() *OrderStatusEventMutation {
+	return oseu.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oseu *OrderStatusEventUpdate) ClearOrder() *OrderStatusEventUpdate {
+	oseu.mutation.ClearOrder()
+	return oseu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oseu *OrderStatusEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oseu.sqlSave, oseu.mutation, oseu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oseu *OrderStatusEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oseu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oseu *OrderStatusEventUpdate) Exec(ctx context.Context) error {
+	_, err := oseu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oseu *OrderStatusEventUpdate) ExecX(ctx context.Context) {
+	if err := oseu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oseu *OrderStatusEventUpdate) check() error {
+	if v, ok := oseu.mutation.FromStatus(); ok {
+		if err := orderstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := oseu.mutation.ToStatus(); ok {
+		if err := orderstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.to_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oseu *OrderStatusEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := oseu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatusevent.Table, orderstatusevent.Columns, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString))
+	if ps := oseu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oseu.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldFromStatus, field.TypeEnum, value)
+	}
+	if oseu.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatusevent.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := oseu.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := oseu.mutation.ChangedBy(); ok {
+		_spec.SetField(orderstatusevent.FieldChangedBy, field.TypeString, value)
+	}
+	if oseu.mutation.ChangedByCleared() {
+		_spec.ClearField(orderstatusevent.FieldChangedBy, field.TypeString)
+	}
+	if value, ok := oseu.mutation.Note(); ok {
+		_spec.SetField(orderstatusevent.FieldNote, field.TypeString, value)
+	}
+	if oseu.mutation.NoteCleared() {
+		_spec.ClearField(orderstatusevent.FieldNote, field.TypeString)
+	}
+	if oseu.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oseu.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oseu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oseu.mutation.done = true
+	return n, nil
+}
+
+// OrderStatusEventUpdateOne is the builder for updating a single OrderStatusEvent entity.
+type OrderStatusEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderStatusEventMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (oseuo *OrderStatusEventUpdateOne) SetOrderID(s string) *OrderStatusEventUpdateOne {
+	oseuo.mutation.SetOrderID(s)
+	return oseuo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (oseuo *OrderStatusEventUpdateOne) SetNillableOrderID(s *string) *OrderStatusEventUpdateOne {
+	if s != nil {
+		oseuo.SetOrderID(*s)
+	}
+	return oseuo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (oseuo *OrderStatusEventUpdateOne) ClearOrderID() *OrderStatusEventUpdateOne {
+	oseuo.mutation.ClearOrderID()
+	return oseuo
+}
+
+// SetFromStatus sets the "from_status" field.
+func (oseuo *OrderStatusEventUpdateOne) SetFromStatus(os orderstatusevent.FromStatus) *OrderStatusEventUpdateOne {
+	oseuo.mutation.SetFromStatus(os)
+	return oseuo
+}
+
+// SetNillableFromStatus sets the "from_status" field if the given value is not nil.
+func (oseuo *OrderStatusEventUpdateOne) SetNillableFromStatus(os *orderstatusevent.FromStatus) *OrderStatusEventUpdateOne {
+	if os != nil {
+		oseuo.SetFromStatus(*os)
+	}
+	return oseuo
+}
+
+// ClearFromStatus clears the value of the "from_status" field.
+func (oseuo *OrderStatusEventUpdateOne) ClearFromStatus() *OrderStatusEventUpdateOne {
+	oseuo.mutation.ClearFromStatus()
+	return oseuo
+}
+
+// SetToStatus sets the "to_status" field.
+func (oseuo *OrderStatusEventUpdateOne) SetToStatus(os orderstatusevent.ToStatus) *OrderStatusEventUpdateOne {
+	oseuo.mutation.SetToStatus(os)
+	return oseuo
+}
+
+// SetNillableToStatus sets the "to_status" field if the given value is not nil.
+func (oseuo *OrderStatusEventUpdateOne) SetNillableToStatus(os *orderstatusevent.ToStatus) *OrderStatusEventUpdateOne {
+	if os != nil {
+		oseuo.SetToStatus(*os)
+	}
+	return oseuo
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (oseuo *OrderStatusEventUpdateOne) SetChangedBy(s string) *OrderStatusEventUpdateOne {
+	oseuo.mutation.SetChangedBy(s)
+	return oseuo
+}
+
+// SetNillableChangedBy sets the "changed_by" field if the given value is not nil.
+func (oseuo *OrderStatusEventUpdateOne) SetNillableChangedBy(s *string) *OrderStatusEventUpdateOne {
+	if s != nil {
+		oseuo.SetChangedBy(*s)
+	}
+	return oseuo
+}
+
+// ClearChangedBy clears the value of the "changed_by" field.
+func (oseuo *OrderStatusEventUpdateOne) ClearChangedBy() *OrderStatusEventUpdateOne {
+	oseuo.mutation.ClearChangedBy()
+	return oseuo
+}
+
+// SetNote sets the "note" field.
+func (oseuo *OrderStatusEventUpdateOne) SetNote(s string) *OrderStatusEventUpdateOne {
+	oseuo.mutation.SetNote(s)
+	return oseuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (oseuo *OrderStatusEventUpdateOne) SetNillableNote(s *string) *OrderStatusEventUpdateOne {
+	if s != nil {
+		oseuo.SetNote(*s)
+	}
+	return oseuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (oseuo *OrderStatusEventUpdateOne) ClearNote() *OrderStatusEventUpdateOne {
+	oseuo.mutation.ClearNote()
+	return oseuo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (oseuo *OrderStatusEventUpdateOne) SetOrder(o *Order) *OrderStatusEventUpdateOne {
+	return oseuo.SetOrderID(o.ID)
+}
+
+// Mutation returns the OrderStatusEventMutation object of the builder.
+func (oseuo *OrderStatusEventUpdateOne) Mutation() *OrderStatusEventMutation {
+	return oseuo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (oseuo *OrderStatusEventUpdateOne) ClearOrder() *OrderStatusEventUpdateOne {
+	oseuo.mutation.ClearOrder()
+	return oseuo
+}
+
+// Where appends a list predicates to the OrderStatusEventUpdate builder.
+func (oseuo *OrderStatusEventUpdateOne) Where(ps ...predicate.OrderStatusEvent) *OrderStatusEventUpdateOne {
+	oseuo.mutation.Where(ps...)
+	return oseuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oseuo *OrderStatusEventUpdateOne) Select(field string, fields ...string) *OrderStatusEventUpdateOne {
+	oseuo.fields = append([]string{field}, fields...)
+	return oseuo
+}
+
+// Save executes the query and returns the updated OrderStatusEvent entity.
+func (oseuo *OrderStatusEventUpdateOne) Save(ctx context.Context) (*OrderStatusEvent, error) {
+	return withHooks(ctx, oseuo.sqlSave, oseuo.mutation, oseuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oseuo *OrderStatusEventUpdateOne) SaveX(ctx context.Context) *OrderStatusEvent {
+	node, err := oseuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oseuo *OrderStatusEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oseuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oseuo *OrderStatusEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oseuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oseuo *OrderStatusEventUpdateOne) check() error {
+	if v, ok := oseuo.mutation.FromStatus(); ok {
+		if err := orderstatusevent.FromStatusValidator(v); err != nil {
+			return &ValidationError{Name: "from_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.from_status": %w`, err)}
+		}
+	}
+	if v, ok := oseuo.mutation.ToStatus(); ok {
+		if err := orderstatusevent.ToStatusValidator(v); err != nil {
+			return &ValidationError{Name: "to_status", err: fmt.Errorf(`ent: validator failed for field "OrderStatusEvent.to_status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (oseuo *OrderStatusEventUpdateOne) sqlSave(ctx context.Context) (_node *OrderStatusEvent, err error) {
+	if err := oseuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(orderstatusevent.Table, orderstatusevent.Columns, sqlgraph.NewFieldSpec(orderstatusevent.FieldID, field.TypeString))
+	id, ok := oseuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrderStatusEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oseuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, orderstatusevent.FieldID)
+		for _, f := range fields {
+			if !orderstatusevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != orderstatusevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oseuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oseuo.mutation.FromStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldFromStatus, field.TypeEnum, value)
+	}
+	if oseuo.mutation.FromStatusCleared() {
+		_spec.ClearField(orderstatusevent.FieldFromStatus, field.TypeEnum)
+	}
+	if value, ok := oseuo.mutation.ToStatus(); ok {
+		_spec.SetField(orderstatusevent.FieldToStatus, field.TypeEnum, value)
+	}
+	if value, ok := oseuo.mutation.ChangedBy(); ok {
+		_spec.SetField(orderstatusevent.FieldChangedBy, field.TypeString, value)
+	}
+	if oseuo.mutation.ChangedByCleared() {
+		_spec.ClearField(orderstatusevent.FieldChangedBy, field.TypeString)
+	}
+	if value, ok := oseuo.mutation.Note(); ok {
+		_spec.SetField(orderstatusevent.FieldNote, field.TypeString, value)
+	}
+	if oseuo.mutation.NoteCleared() {
+		_spec.ClearField(orderstatusevent.FieldNote, field.TypeString)
+	}
+	if oseuo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := oseuo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   orderstatusevent.OrderTable,
+			Columns: []string{orderstatusevent.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrderStatusEvent{config: oseuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oseuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{orderstatusevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oseuo.mutation.done = true
+	return _node, nil
+}
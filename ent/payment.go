@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// Payment is the model entity for the Payment schema.
+type Payment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Method holds the value of the "method" field.
+	Method string `json:"method,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status payment.Status `json:"status,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount money.Amount `json:"amount,omitempty"`
+	// CapturedAmount holds the value of the "captured_amount" field.
+	CapturedAmount money.Amount `json:"captured_amount,omitempty"`
+	// RefundedAmount holds the value of the "refunded_amount" field.
+	RefundedAmount money.Amount `json:"refunded_amount,omitempty"`
+	// FailureReason holds the value of the "failure_reason" field.
+	FailureReason string `json:"failure_reason,omitempty"`
+	// PixPayload holds the value of the "pix_payload" field.
+	PixPayload string `json:"pix_payload,omitempty"`
+	// BoletoBarcode holds the value of the "boleto_barcode" field.
+	BoletoBarcode string `json:"boleto_barcode,omitempty"`
+	// BoletoDigitableLine holds the value of the "boleto_digitable_line" field.
+	BoletoDigitableLine string `json:"boleto_digitable_line,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaymentQuery when eager-loading is set.
+	Edges        PaymentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaymentEdges holds the relations/edges for other nodes in the graph.
+type PaymentEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaymentEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Payment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case payment.FieldAmount, payment.FieldCapturedAmount, payment.FieldRefundedAmount:
+			values[i] = new(money.Amount)
+		case payment.FieldID, payment.FieldOrderID, payment.FieldProvider, payment.FieldMethod, payment.FieldTransactionID, payment.FieldStatus, payment.FieldFailureReason, payment.FieldPixPayload, payment.FieldBoletoBarcode, payment.FieldBoletoDigitableLine:
+			values[i] = new(sql.NullString)
+		case payment.FieldExpiresAt, payment.FieldCreatedAt, payment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Payment fields.
+func (pa *Payment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case payment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pa.ID = value.String
+			}
+		case payment.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				pa.OrderID = value.String
+			}
+		case payment.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				pa.Provider = value.String
+			}
+		case payment.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				pa.Method = value.String
+			}
+		case payment.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				pa.TransactionID = value.String
+			}
+		case payment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				pa.Status = payment.Status(value.String)
+			}
+		case payment.FieldAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value != nil {
+				pa.Amount = *value
+			}
+		case payment.FieldCapturedAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field captured_amount", values[i])
+			} else if value != nil {
+				pa.CapturedAmount = *value
+			}
+		case payment.FieldRefundedAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_amount", values[i])
+			} else if value != nil {
+				pa.RefundedAmount = *value
+			}
+		case payment.FieldFailureReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field failure_reason", values[i])
+			} else if value.Valid {
+				pa.FailureReason = value.String
+			}
+		case payment.FieldPixPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pix_payload", values[i])
+			} else if value.Valid {
+				pa.PixPayload = value.String
+			}
+		case payment.FieldBoletoBarcode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field boleto_barcode", values[i])
+			} else if value.Valid {
+				pa.BoletoBarcode = value.String
+			}
+		case payment.FieldBoletoDigitableLine:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field boleto_digitable_line", values[i])
+			} else if value.Valid {
+				pa.BoletoDigitableLine = value.String
+			}
+		case payment.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				pa.ExpiresAt = new(time.Time)
+				*pa.ExpiresAt = value.Time
+			}
+		case payment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case payment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Payment.
+// This includes values selected through modifiers, order, etc.
+func (pa *Payment) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the Payment entity.
+func (pa *Payment) QueryOrder() *OrderQuery {
+	return NewPaymentClient(pa.config).QueryOrder(pa)
+}
+
+// Update returns a builder for updating this Payment.
+// Note that you need to call Payment.Unwrap() before calling this method if this Payment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *Payment) Update() *PaymentUpdateOne {
+	return NewPaymentClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the Payment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *Payment) Unwrap() *Payment {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Payment is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *Payment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Payment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(pa.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(pa.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(pa.Method)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(pa.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Status))
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("captured_amount=")
+	builder.WriteString(fmt.Sprintf("%v", pa.CapturedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("refunded_amount=")
+	builder.WriteString(fmt.Sprintf("%v", pa.RefundedAmount))
+	builder.WriteString(", ")
+	builder.WriteString("failure_reason=")
+	builder.WriteString(pa.FailureReason)
+	builder.WriteString(", ")
+	builder.WriteString("pix_payload=")
+	builder.WriteString(pa.PixPayload)
+	builder.WriteString(", ")
+	builder.WriteString("boleto_barcode=")
+	builder.WriteString(pa.BoletoBarcode)
+	builder.WriteString(", ")
+	builder.WriteString("boleto_digitable_line=")
+	builder.WriteString(pa.BoletoDigitableLine)
+	builder.WriteString(", ")
+	if v := pa.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Payments is a parsable slice of Payment.
+type Payments []*Payment
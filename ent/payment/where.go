@@ -0,0 +1,1025 @@
+// Code generated by ent, DO NOT EDIT.
+
+package payment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldID, id))
+}
+
+// OrderID applies equality check predicate on the "order_id" field. It's identical to OrderIDEQ.
+func OrderID(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldOrderID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldProvider, v))
+}
+
+// Method applies equality check predicate on the "method" field. It's identical to MethodEQ.
+func Method(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldMethod, v))
+}
+
+// TransactionID applies equality check predicate on the "transaction_id" field. It's identical to TransactionIDEQ.
+func TransactionID(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldAmount, v))
+}
+
+// CapturedAmount applies equality check predicate on the "captured_amount" field. It's identical to CapturedAmountEQ.
+func CapturedAmount(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCapturedAmount, v))
+}
+
+// RefundedAmount applies equality check predicate on the "refunded_amount" field. It's identical to RefundedAmountEQ.
+func RefundedAmount(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// FailureReason applies equality check predicate on the "failure_reason" field. It's identical to FailureReasonEQ.
+func FailureReason(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// PixPayload applies equality check predicate on the "pix_payload" field. It's identical to PixPayloadEQ.
+func PixPayload(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldPixPayload, v))
+}
+
+// BoletoBarcode applies equality check predicate on the "boleto_barcode" field. It's identical to BoletoBarcodeEQ.
+func BoletoBarcode(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldBoletoBarcode, v))
+}
+
+// BoletoDigitableLine applies equality check predicate on the "boleto_digitable_line" field. It's identical to BoletoDigitableLineEQ.
+func BoletoDigitableLine(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldBoletoDigitableLine, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrderIDEQ applies the EQ predicate on the "order_id" field.
+func OrderIDEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldOrderID, v))
+}
+
+// OrderIDNEQ applies the NEQ predicate on the "order_id" field.
+func OrderIDNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldOrderID, v))
+}
+
+// OrderIDIn applies the In predicate on the "order_id" field.
+func OrderIDIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldOrderID, vs...))
+}
+
+// OrderIDNotIn applies the NotIn predicate on the "order_id" field.
+func OrderIDNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldOrderID, vs...))
+}
+
+// OrderIDGT applies the GT predicate on the "order_id" field.
+func OrderIDGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldOrderID, v))
+}
+
+// OrderIDGTE applies the GTE predicate on the "order_id" field.
+func OrderIDGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldOrderID, v))
+}
+
+// OrderIDLT applies the LT predicate on the "order_id" field.
+func OrderIDLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldOrderID, v))
+}
+
+// OrderIDLTE applies the LTE predicate on the "order_id" field.
+func OrderIDLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldOrderID, v))
+}
+
+// OrderIDContains applies the Contains predicate on the "order_id" field.
+func OrderIDContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldOrderID, v))
+}
+
+// OrderIDHasPrefix applies the HasPrefix predicate on the "order_id" field.
+func OrderIDHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldOrderID, v))
+}
+
+// OrderIDHasSuffix applies the HasSuffix predicate on the "order_id" field.
+func OrderIDHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldOrderID, v))
+}
+
+// OrderIDIsNil applies the IsNil predicate on the "order_id" field.
+func OrderIDIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldOrderID))
+}
+
+// OrderIDNotNil applies the NotNil predicate on the "order_id" field.
+func OrderIDNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldOrderID))
+}
+
+// OrderIDEqualFold applies the EqualFold predicate on the "order_id" field.
+func OrderIDEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldOrderID, v))
+}
+
+// OrderIDContainsFold applies the ContainsFold predicate on the "order_id" field.
+func OrderIDContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldOrderID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// MethodEQ applies the EQ predicate on the "method" field.
+func MethodEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldMethod, v))
+}
+
+// MethodNEQ applies the NEQ predicate on the "method" field.
+func MethodNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldMethod, v))
+}
+
+// MethodIn applies the In predicate on the "method" field.
+func MethodIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldMethod, vs...))
+}
+
+// MethodNotIn applies the NotIn predicate on the "method" field.
+func MethodNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldMethod, vs...))
+}
+
+// MethodGT applies the GT predicate on the "method" field.
+func MethodGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldMethod, v))
+}
+
+// MethodGTE applies the GTE predicate on the "method" field.
+func MethodGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldMethod, v))
+}
+
+// MethodLT applies the LT predicate on the "method" field.
+func MethodLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldMethod, v))
+}
+
+// MethodLTE applies the LTE predicate on the "method" field.
+func MethodLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldMethod, v))
+}
+
+// MethodContains applies the Contains predicate on the "method" field.
+func MethodContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldMethod, v))
+}
+
+// MethodHasPrefix applies the HasPrefix predicate on the "method" field.
+func MethodHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldMethod, v))
+}
+
+// MethodHasSuffix applies the HasSuffix predicate on the "method" field.
+func MethodHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldMethod, v))
+}
+
+// MethodEqualFold applies the EqualFold predicate on the "method" field.
+func MethodEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldMethod, v))
+}
+
+// MethodContainsFold applies the ContainsFold predicate on the "method" field.
+func MethodContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldMethod, v))
+}
+
+// TransactionIDEQ applies the EQ predicate on the "transaction_id" field.
+func TransactionIDEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldTransactionID, v))
+}
+
+// TransactionIDNEQ applies the NEQ predicate on the "transaction_id" field.
+func TransactionIDNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldTransactionID, v))
+}
+
+// TransactionIDIn applies the In predicate on the "transaction_id" field.
+func TransactionIDIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDNotIn applies the NotIn predicate on the "transaction_id" field.
+func TransactionIDNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldTransactionID, vs...))
+}
+
+// TransactionIDGT applies the GT predicate on the "transaction_id" field.
+func TransactionIDGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldTransactionID, v))
+}
+
+// TransactionIDGTE applies the GTE predicate on the "transaction_id" field.
+func TransactionIDGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldTransactionID, v))
+}
+
+// TransactionIDLT applies the LT predicate on the "transaction_id" field.
+func TransactionIDLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldTransactionID, v))
+}
+
+// TransactionIDLTE applies the LTE predicate on the "transaction_id" field.
+func TransactionIDLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldTransactionID, v))
+}
+
+// TransactionIDContains applies the Contains predicate on the "transaction_id" field.
+func TransactionIDContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldTransactionID, v))
+}
+
+// TransactionIDHasPrefix applies the HasPrefix predicate on the "transaction_id" field.
+func TransactionIDHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldTransactionID, v))
+}
+
+// TransactionIDHasSuffix applies the HasSuffix predicate on the "transaction_id" field.
+func TransactionIDHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldTransactionID, v))
+}
+
+// TransactionIDIsNil applies the IsNil predicate on the "transaction_id" field.
+func TransactionIDIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldTransactionID))
+}
+
+// TransactionIDNotNil applies the NotNil predicate on the "transaction_id" field.
+func TransactionIDNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldTransactionID))
+}
+
+// TransactionIDEqualFold applies the EqualFold predicate on the "transaction_id" field.
+func TransactionIDEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldTransactionID, v))
+}
+
+// TransactionIDContainsFold applies the ContainsFold predicate on the "transaction_id" field.
+func TransactionIDContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldTransactionID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldAmount, v))
+}
+
+// CapturedAmountEQ applies the EQ predicate on the "captured_amount" field.
+func CapturedAmountEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCapturedAmount, v))
+}
+
+// CapturedAmountNEQ applies the NEQ predicate on the "captured_amount" field.
+func CapturedAmountNEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldCapturedAmount, v))
+}
+
+// CapturedAmountIn applies the In predicate on the "captured_amount" field.
+func CapturedAmountIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldCapturedAmount, vs...))
+}
+
+// CapturedAmountNotIn applies the NotIn predicate on the "captured_amount" field.
+func CapturedAmountNotIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldCapturedAmount, vs...))
+}
+
+// CapturedAmountGT applies the GT predicate on the "captured_amount" field.
+func CapturedAmountGT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldCapturedAmount, v))
+}
+
+// CapturedAmountGTE applies the GTE predicate on the "captured_amount" field.
+func CapturedAmountGTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldCapturedAmount, v))
+}
+
+// CapturedAmountLT applies the LT predicate on the "captured_amount" field.
+func CapturedAmountLT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldCapturedAmount, v))
+}
+
+// CapturedAmountLTE applies the LTE predicate on the "captured_amount" field.
+func CapturedAmountLTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldCapturedAmount, v))
+}
+
+// RefundedAmountEQ applies the EQ predicate on the "refunded_amount" field.
+func RefundedAmountEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountNEQ applies the NEQ predicate on the "refunded_amount" field.
+func RefundedAmountNEQ(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldRefundedAmount, v))
+}
+
+// RefundedAmountIn applies the In predicate on the "refunded_amount" field.
+func RefundedAmountIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountNotIn applies the NotIn predicate on the "refunded_amount" field.
+func RefundedAmountNotIn(vs ...money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldRefundedAmount, vs...))
+}
+
+// RefundedAmountGT applies the GT predicate on the "refunded_amount" field.
+func RefundedAmountGT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountGTE applies the GTE predicate on the "refunded_amount" field.
+func RefundedAmountGTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLT applies the LT predicate on the "refunded_amount" field.
+func RefundedAmountLT(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldRefundedAmount, v))
+}
+
+// RefundedAmountLTE applies the LTE predicate on the "refunded_amount" field.
+func RefundedAmountLTE(v money.Amount) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldRefundedAmount, v))
+}
+
+// FailureReasonEQ applies the EQ predicate on the "failure_reason" field.
+func FailureReasonEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldFailureReason, v))
+}
+
+// FailureReasonNEQ applies the NEQ predicate on the "failure_reason" field.
+func FailureReasonNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldFailureReason, v))
+}
+
+// FailureReasonIn applies the In predicate on the "failure_reason" field.
+func FailureReasonIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonNotIn applies the NotIn predicate on the "failure_reason" field.
+func FailureReasonNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldFailureReason, vs...))
+}
+
+// FailureReasonGT applies the GT predicate on the "failure_reason" field.
+func FailureReasonGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldFailureReason, v))
+}
+
+// FailureReasonGTE applies the GTE predicate on the "failure_reason" field.
+func FailureReasonGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldFailureReason, v))
+}
+
+// FailureReasonLT applies the LT predicate on the "failure_reason" field.
+func FailureReasonLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldFailureReason, v))
+}
+
+// FailureReasonLTE applies the LTE predicate on the "failure_reason" field.
+func FailureReasonLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldFailureReason, v))
+}
+
+// FailureReasonContains applies the Contains predicate on the "failure_reason" field.
+func FailureReasonContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldFailureReason, v))
+}
+
+// FailureReasonHasPrefix applies the HasPrefix predicate on the "failure_reason" field.
+func FailureReasonHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldFailureReason, v))
+}
+
+// FailureReasonHasSuffix applies the HasSuffix predicate on the "failure_reason" field.
+func FailureReasonHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldFailureReason, v))
+}
+
+// FailureReasonIsNil applies the IsNil predicate on the "failure_reason" field.
+func FailureReasonIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldFailureReason))
+}
+
+// FailureReasonNotNil applies the NotNil predicate on the "failure_reason" field.
+func FailureReasonNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldFailureReason))
+}
+
+// FailureReasonEqualFold applies the EqualFold predicate on the "failure_reason" field.
+func FailureReasonEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldFailureReason, v))
+}
+
+// FailureReasonContainsFold applies the ContainsFold predicate on the "failure_reason" field.
+func FailureReasonContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldFailureReason, v))
+}
+
+// PixPayloadEQ applies the EQ predicate on the "pix_payload" field.
+func PixPayloadEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldPixPayload, v))
+}
+
+// PixPayloadNEQ applies the NEQ predicate on the "pix_payload" field.
+func PixPayloadNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldPixPayload, v))
+}
+
+// PixPayloadIn applies the In predicate on the "pix_payload" field.
+func PixPayloadIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldPixPayload, vs...))
+}
+
+// PixPayloadNotIn applies the NotIn predicate on the "pix_payload" field.
+func PixPayloadNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldPixPayload, vs...))
+}
+
+// PixPayloadGT applies the GT predicate on the "pix_payload" field.
+func PixPayloadGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldPixPayload, v))
+}
+
+// PixPayloadGTE applies the GTE predicate on the "pix_payload" field.
+func PixPayloadGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldPixPayload, v))
+}
+
+// PixPayloadLT applies the LT predicate on the "pix_payload" field.
+func PixPayloadLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldPixPayload, v))
+}
+
+// PixPayloadLTE applies the LTE predicate on the "pix_payload" field.
+func PixPayloadLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldPixPayload, v))
+}
+
+// PixPayloadContains applies the Contains predicate on the "pix_payload" field.
+func PixPayloadContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldPixPayload, v))
+}
+
+// PixPayloadHasPrefix applies the HasPrefix predicate on the "pix_payload" field.
+func PixPayloadHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldPixPayload, v))
+}
+
+// PixPayloadHasSuffix applies the HasSuffix predicate on the "pix_payload" field.
+func PixPayloadHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldPixPayload, v))
+}
+
+// PixPayloadIsNil applies the IsNil predicate on the "pix_payload" field.
+func PixPayloadIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldPixPayload))
+}
+
+// PixPayloadNotNil applies the NotNil predicate on the "pix_payload" field.
+func PixPayloadNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldPixPayload))
+}
+
+// PixPayloadEqualFold applies the EqualFold predicate on the "pix_payload" field.
+func PixPayloadEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldPixPayload, v))
+}
+
+// PixPayloadContainsFold applies the ContainsFold predicate on the "pix_payload" field.
+func PixPayloadContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldPixPayload, v))
+}
+
+// BoletoBarcodeEQ applies the EQ predicate on the "boleto_barcode" field.
+func BoletoBarcodeEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeNEQ applies the NEQ predicate on the "boleto_barcode" field.
+func BoletoBarcodeNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeIn applies the In predicate on the "boleto_barcode" field.
+func BoletoBarcodeIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldBoletoBarcode, vs...))
+}
+
+// BoletoBarcodeNotIn applies the NotIn predicate on the "boleto_barcode" field.
+func BoletoBarcodeNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldBoletoBarcode, vs...))
+}
+
+// BoletoBarcodeGT applies the GT predicate on the "boleto_barcode" field.
+func BoletoBarcodeGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeGTE applies the GTE predicate on the "boleto_barcode" field.
+func BoletoBarcodeGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeLT applies the LT predicate on the "boleto_barcode" field.
+func BoletoBarcodeLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeLTE applies the LTE predicate on the "boleto_barcode" field.
+func BoletoBarcodeLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeContains applies the Contains predicate on the "boleto_barcode" field.
+func BoletoBarcodeContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeHasPrefix applies the HasPrefix predicate on the "boleto_barcode" field.
+func BoletoBarcodeHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeHasSuffix applies the HasSuffix predicate on the "boleto_barcode" field.
+func BoletoBarcodeHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeIsNil applies the IsNil predicate on the "boleto_barcode" field.
+func BoletoBarcodeIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldBoletoBarcode))
+}
+
+// BoletoBarcodeNotNil applies the NotNil predicate on the "boleto_barcode" field.
+func BoletoBarcodeNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldBoletoBarcode))
+}
+
+// BoletoBarcodeEqualFold applies the EqualFold predicate on the "boleto_barcode" field.
+func BoletoBarcodeEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldBoletoBarcode, v))
+}
+
+// BoletoBarcodeContainsFold applies the ContainsFold predicate on the "boleto_barcode" field.
+func BoletoBarcodeContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldBoletoBarcode, v))
+}
+
+// BoletoDigitableLineEQ applies the EQ predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineNEQ applies the NEQ predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineIn applies the In predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldBoletoDigitableLine, vs...))
+}
+
+// BoletoDigitableLineNotIn applies the NotIn predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldBoletoDigitableLine, vs...))
+}
+
+// BoletoDigitableLineGT applies the GT predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineGTE applies the GTE predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineLT applies the LT predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineLTE applies the LTE predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineContains applies the Contains predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineHasPrefix applies the HasPrefix predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineHasSuffix applies the HasSuffix predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineIsNil applies the IsNil predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldBoletoDigitableLine))
+}
+
+// BoletoDigitableLineNotNil applies the NotNil predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldBoletoDigitableLine))
+}
+
+// BoletoDigitableLineEqualFold applies the EqualFold predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldBoletoDigitableLine, v))
+}
+
+// BoletoDigitableLineContainsFold applies the ContainsFold predicate on the "boleto_digitable_line" field.
+func BoletoDigitableLineContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldBoletoDigitableLine, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrder applies the HasEdge predicate on the "order" edge.
+func HasOrder() predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrderTable, OrderColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderWith applies the HasEdge predicate on the "order" edge with a given conditions (other predicates).
+func HasOrderWith(preds ...predicate.Order) predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := newOrderStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Payment) predicate.Payment {
+	return predicate.Payment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Payment) predicate.Payment {
+	return predicate.Payment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Payment) predicate.Payment {
+	return predicate.Payment(sql.NotPredicates(p))
+}
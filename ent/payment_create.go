@@ -0,0 +1,518 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// PaymentCreate is the builder for creating a Payment entity.
+type PaymentCreate struct {
+	config
+	mutation *PaymentMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (pc *PaymentCreate) SetOrderID(s string) *PaymentCreate {
+	pc.mutation.SetOrderID(s)
+	return pc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableOrderID(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetOrderID(*s)
+	}
+	return pc
+}
+
+// SetProvider sets the "provider" field.
+func (pc *PaymentCreate) SetProvider(s string) *PaymentCreate {
+	pc.mutation.SetProvider(s)
+	return pc
+}
+
+// SetMethod sets the "method" field.
+func (pc *PaymentCreate) SetMethod(s string) *PaymentCreate {
+	pc.mutation.SetMethod(s)
+	return pc
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (pc *PaymentCreate) SetTransactionID(s string) *PaymentCreate {
+	pc.mutation.SetTransactionID(s)
+	return pc
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableTransactionID(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetTransactionID(*s)
+	}
+	return pc
+}
+
+// SetStatus sets the "status" field.
+func (pc *PaymentCreate) SetStatus(pa payment.Status) *PaymentCreate {
+	pc.mutation.SetStatus(pa)
+	return pc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableStatus(pa *payment.Status) *PaymentCreate {
+	if pa != nil {
+		pc.SetStatus(*pa)
+	}
+	return pc
+}
+
+// SetAmount sets the "amount" field.
+func (pc *PaymentCreate) SetAmount(m money.Amount) *PaymentCreate {
+	pc.mutation.SetAmount(m)
+	return pc
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (pc *PaymentCreate) SetCapturedAmount(m money.Amount) *PaymentCreate {
+	pc.mutation.SetCapturedAmount(m)
+	return pc
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableCapturedAmount(m *money.Amount) *PaymentCreate {
+	if m != nil {
+		pc.SetCapturedAmount(*m)
+	}
+	return pc
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (pc *PaymentCreate) SetRefundedAmount(m money.Amount) *PaymentCreate {
+	pc.mutation.SetRefundedAmount(m)
+	return pc
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableRefundedAmount(m *money.Amount) *PaymentCreate {
+	if m != nil {
+		pc.SetRefundedAmount(*m)
+	}
+	return pc
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pc *PaymentCreate) SetFailureReason(s string) *PaymentCreate {
+	pc.mutation.SetFailureReason(s)
+	return pc
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableFailureReason(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetFailureReason(*s)
+	}
+	return pc
+}
+
+// SetPixPayload sets the "pix_payload" field.
+func (pc *PaymentCreate) SetPixPayload(s string) *PaymentCreate {
+	pc.mutation.SetPixPayload(s)
+	return pc
+}
+
+// SetNillablePixPayload sets the "pix_payload" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillablePixPayload(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetPixPayload(*s)
+	}
+	return pc
+}
+
+// SetBoletoBarcode sets the "boleto_barcode" field.
+func (pc *PaymentCreate) SetBoletoBarcode(s string) *PaymentCreate {
+	pc.mutation.SetBoletoBarcode(s)
+	return pc
+}
+
+// SetNillableBoletoBarcode sets the "boleto_barcode" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableBoletoBarcode(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetBoletoBarcode(*s)
+	}
+	return pc
+}
+
+// SetBoletoDigitableLine sets the "boleto_digitable_line" field.
+func (pc *PaymentCreate) SetBoletoDigitableLine(s string) *PaymentCreate {
+	pc.mutation.SetBoletoDigitableLine(s)
+	return pc
+}
+
+// SetNillableBoletoDigitableLine sets the "boleto_digitable_line" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableBoletoDigitableLine(s *string) *PaymentCreate {
+	if s != nil {
+		pc.SetBoletoDigitableLine(*s)
+	}
+	return pc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pc *PaymentCreate) SetExpiresAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetExpiresAt(t)
+	return pc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableExpiresAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetExpiresAt(*t)
+	}
+	return pc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pc *PaymentCreate) SetCreatedAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetCreatedAt(t)
+	return pc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableCreatedAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetCreatedAt(*t)
+	}
+	return pc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pc *PaymentCreate) SetUpdatedAt(t time.Time) *PaymentCreate {
+	pc.mutation.SetUpdatedAt(t)
+	return pc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pc *PaymentCreate) SetNillableUpdatedAt(t *time.Time) *PaymentCreate {
+	if t != nil {
+		pc.SetUpdatedAt(*t)
+	}
+	return pc
+}
+
+// SetID sets the "id" field.
+func (pc *PaymentCreate) SetID(s string) *PaymentCreate {
+	pc.mutation.SetID(s)
+	return pc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (pc *PaymentCreate) SetOrder(o *Order) *PaymentCreate {
+	return pc.SetOrderID(o.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pc *PaymentCreate) Mutation() *PaymentMutation {
+	return pc.mutation
+}
+
+// Save creates the Payment in the database.
+func (pc *PaymentCreate) Save(ctx context.Context) (*Payment, error) {
+	pc.defaults()
+	return withHooks(ctx, pc.sqlSave, pc.mutation, pc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pc *PaymentCreate) SaveX(ctx context.Context) *Payment {
+	v, err := pc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pc *PaymentCreate) Exec(ctx context.Context) error {
+	_, err := pc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pc *PaymentCreate) ExecX(ctx context.Context) {
+	if err := pc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pc *PaymentCreate) defaults() {
+	if _, ok := pc.mutation.Status(); !ok {
+		v := payment.DefaultStatus
+		pc.mutation.SetStatus(v)
+	}
+	if _, ok := pc.mutation.CapturedAmount(); !ok {
+		v := payment.DefaultCapturedAmount
+		pc.mutation.SetCapturedAmount(v)
+	}
+	if _, ok := pc.mutation.RefundedAmount(); !ok {
+		v := payment.DefaultRefundedAmount
+		pc.mutation.SetRefundedAmount(v)
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		v := payment.DefaultCreatedAt()
+		pc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		v := payment.DefaultUpdatedAt()
+		pc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pc *PaymentCreate) check() error {
+	if _, ok := pc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Payment.provider"`)}
+	}
+	if v, ok := pc.mutation.Provider(); ok {
+		if err := payment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payment.provider": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "Payment.method"`)}
+	}
+	if v, ok := pc.mutation.Method(); ok {
+		if err := payment.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Payment.method": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Payment.status"`)}
+	}
+	if v, ok := pc.mutation.Status(); ok {
+		if err := payment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Payment.amount"`)}
+	}
+	if v, ok := pc.mutation.Amount(); ok {
+		if err := payment.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payment.amount": %w`, err)}
+		}
+	}
+	if _, ok := pc.mutation.CapturedAmount(); !ok {
+		return &ValidationError{Name: "captured_amount", err: errors.New(`ent: missing required field "Payment.captured_amount"`)}
+	}
+	if _, ok := pc.mutation.RefundedAmount(); !ok {
+		return &ValidationError{Name: "refunded_amount", err: errors.New(`ent: missing required field "Payment.refunded_amount"`)}
+	}
+	if _, ok := pc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payment.created_at"`)}
+	}
+	if _, ok := pc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Payment.updated_at"`)}
+	}
+	return nil
+}
+
+func (pc *PaymentCreate) sqlSave(ctx context.Context) (*Payment, error) {
+	if err := pc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Payment.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pc.mutation.id = &_node.ID
+	pc.mutation.done = true
+	return _node, nil
+}
+
+func (pc *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Payment{config: pc.config}
+		_spec = sqlgraph.NewCreateSpec(payment.Table, sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString))
+	)
+	if id, ok := pc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pc.mutation.Provider(); ok {
+		_spec.SetField(payment.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := pc.mutation.Method(); ok {
+		_spec.SetField(payment.FieldMethod, field.TypeString, value)
+		_node.Method = value
+	}
+	if value, ok := pc.mutation.TransactionID(); ok {
+		_spec.SetField(payment.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := pc.mutation.Status(); ok {
+		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := pc.mutation.Amount(); ok {
+		_spec.SetField(payment.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := pc.mutation.CapturedAmount(); ok {
+		_spec.SetField(payment.FieldCapturedAmount, field.TypeInt64, value)
+		_node.CapturedAmount = value
+	}
+	if value, ok := pc.mutation.RefundedAmount(); ok {
+		_spec.SetField(payment.FieldRefundedAmount, field.TypeInt64, value)
+		_node.RefundedAmount = value
+	}
+	if value, ok := pc.mutation.FailureReason(); ok {
+		_spec.SetField(payment.FieldFailureReason, field.TypeString, value)
+		_node.FailureReason = value
+	}
+	if value, ok := pc.mutation.PixPayload(); ok {
+		_spec.SetField(payment.FieldPixPayload, field.TypeString, value)
+		_node.PixPayload = value
+	}
+	if value, ok := pc.mutation.BoletoBarcode(); ok {
+		_spec.SetField(payment.FieldBoletoBarcode, field.TypeString, value)
+		_node.BoletoBarcode = value
+	}
+	if value, ok := pc.mutation.BoletoDigitableLine(); ok {
+		_spec.SetField(payment.FieldBoletoDigitableLine, field.TypeString, value)
+		_node.BoletoDigitableLine = value
+	}
+	if value, ok := pc.mutation.ExpiresAt(); ok {
+		_spec.SetField(payment.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := pc.mutation.CreatedAt(); ok {
+		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pc.mutation.UpdatedAt(); ok {
+		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.OrderTable,
+			Columns: []string{payment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaymentCreateBulk is the builder for creating many Payment entities in bulk.
+type PaymentCreateBulk struct {
+	config
+	err      error
+	builders []*PaymentCreate
+}
+
+// Save creates the Payment entities in the database.
+func (pcb *PaymentCreateBulk) Save(ctx context.Context) ([]*Payment, error) {
+	if pcb.err != nil {
+		return nil, pcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pcb.builders))
+	nodes := make([]*Payment, len(pcb.builders))
+	mutators := make([]Mutator, len(pcb.builders))
+	for i := range pcb.builders {
+		func(i int, root context.Context) {
+			builder := pcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaymentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pcb *PaymentCreateBulk) SaveX(ctx context.Context) []*Payment {
+	v, err := pcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pcb *PaymentCreateBulk) Exec(ctx context.Context) error {
+	_, err := pcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pcb *PaymentCreateBulk) ExecX(ctx context.Context) {
+	if err := pcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1000 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/payment"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// PaymentUpdate is the builder for updating Payment entities.
+type PaymentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// Where appends a list predicates to the PaymentUpdate builder.
+func (pu *PaymentUpdate) Where(ps ...predicate.Payment) *PaymentUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetOrderID sets the "order_id" field.
+func (pu *PaymentUpdate) SetOrderID(s string) *PaymentUpdate {
+	pu.mutation.SetOrderID(s)
+	return pu
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableOrderID(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetOrderID(*s)
+	}
+	return pu
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (pu *PaymentUpdate) ClearOrderID() *PaymentUpdate {
+	pu.mutation.ClearOrderID()
+	return pu
+}
+
+// SetProvider sets the "provider" field.
+func (pu *PaymentUpdate) SetProvider(s string) *PaymentUpdate {
+	pu.mutation.SetProvider(s)
+	return pu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableProvider(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetProvider(*s)
+	}
+	return pu
+}
+
+// SetMethod sets the "method" field.
+func (pu *PaymentUpdate) SetMethod(s string) *PaymentUpdate {
+	pu.mutation.SetMethod(s)
+	return pu
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableMethod(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetMethod(*s)
+	}
+	return pu
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (pu *PaymentUpdate) SetTransactionID(s string) *PaymentUpdate {
+	pu.mutation.SetTransactionID(s)
+	return pu
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableTransactionID(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetTransactionID(*s)
+	}
+	return pu
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (pu *PaymentUpdate) ClearTransactionID() *PaymentUpdate {
+	pu.mutation.ClearTransactionID()
+	return pu
+}
+
+// SetStatus sets the "status" field.
+func (pu *PaymentUpdate) SetStatus(pa payment.Status) *PaymentUpdate {
+	pu.mutation.SetStatus(pa)
+	return pu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableStatus(pa *payment.Status) *PaymentUpdate {
+	if pa != nil {
+		pu.SetStatus(*pa)
+	}
+	return pu
+}
+
+// SetAmount sets the "amount" field.
+func (pu *PaymentUpdate) SetAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.ResetAmount()
+	pu.mutation.SetAmount(m)
+	return pu
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableAmount(m *money.Amount) *PaymentUpdate {
+	if m != nil {
+		pu.SetAmount(*m)
+	}
+	return pu
+}
+
+// AddAmount adds m to the "amount" field.
+func (pu *PaymentUpdate) AddAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.AddAmount(m)
+	return pu
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (pu *PaymentUpdate) SetCapturedAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.ResetCapturedAmount()
+	pu.mutation.SetCapturedAmount(m)
+	return pu
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableCapturedAmount(m *money.Amount) *PaymentUpdate {
+	if m != nil {
+		pu.SetCapturedAmount(*m)
+	}
+	return pu
+}
+
+// AddCapturedAmount adds m to the "captured_amount" field.
+func (pu *PaymentUpdate) AddCapturedAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.AddCapturedAmount(m)
+	return pu
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (pu *PaymentUpdate) SetRefundedAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.ResetRefundedAmount()
+	pu.mutation.SetRefundedAmount(m)
+	return pu
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableRefundedAmount(m *money.Amount) *PaymentUpdate {
+	if m != nil {
+		pu.SetRefundedAmount(*m)
+	}
+	return pu
+}
+
+// AddRefundedAmount adds m to the "refunded_amount" field.
+func (pu *PaymentUpdate) AddRefundedAmount(m money.Amount) *PaymentUpdate {
+	pu.mutation.AddRefundedAmount(m)
+	return pu
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (pu *PaymentUpdate) SetFailureReason(s string) *PaymentUpdate {
+	pu.mutation.SetFailureReason(s)
+	return pu
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableFailureReason(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetFailureReason(*s)
+	}
+	return pu
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (pu *PaymentUpdate) ClearFailureReason() *PaymentUpdate {
+	pu.mutation.ClearFailureReason()
+	return pu
+}
+
+// SetPixPayload sets the "pix_payload" field.
+func (pu *PaymentUpdate) SetPixPayload(s string) *PaymentUpdate {
+	pu.mutation.SetPixPayload(s)
+	return pu
+}
+
+// SetNillablePixPayload sets the "pix_payload" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillablePixPayload(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetPixPayload(*s)
+	}
+	return pu
+}
+
+// ClearPixPayload clears the value of the "pix_payload" field.
+func (pu *PaymentUpdate) ClearPixPayload() *PaymentUpdate {
+	pu.mutation.ClearPixPayload()
+	return pu
+}
+
+// SetBoletoBarcode sets the "boleto_barcode" field.
+func (pu *PaymentUpdate) SetBoletoBarcode(s string) *PaymentUpdate {
+	pu.mutation.SetBoletoBarcode(s)
+	return pu
+}
+
+// SetNillableBoletoBarcode sets the "boleto_barcode" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableBoletoBarcode(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetBoletoBarcode(*s)
+	}
+	return pu
+}
+
+// ClearBoletoBarcode clears the value of the "boleto_barcode" field.
+func (pu *PaymentUpdate) ClearBoletoBarcode() *PaymentUpdate {
+	pu.mutation.ClearBoletoBarcode()
+	return pu
+}
+
+// SetBoletoDigitableLine sets the "boleto_digitable_line" field.
+func (pu *PaymentUpdate) SetBoletoDigitableLine(s string) *PaymentUpdate {
+	pu.mutation.SetBoletoDigitableLine(s)
+	return pu
+}
+
+// SetNillableBoletoDigitableLine sets the "boleto_digitable_line" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableBoletoDigitableLine(s *string) *PaymentUpdate {
+	if s != nil {
+		pu.SetBoletoDigitableLine(*s)
+	}
+	return pu
+}
+
+// ClearBoletoDigitableLine clears the value of the "boleto_digitable_line" field.
+func (pu *PaymentUpdate) ClearBoletoDigitableLine() *PaymentUpdate {
+	pu.mutation.ClearBoletoDigitableLine()
+	return pu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (pu *PaymentUpdate) SetExpiresAt(t time.Time) *PaymentUpdate {
+	pu.mutation.SetExpiresAt(t)
+	return pu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableExpiresAt(t *time.Time) *PaymentUpdate {
+	if t != nil {
+		pu.SetExpiresAt(*t)
+	}
+	return pu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (pu *PaymentUpdate) ClearExpiresAt() *PaymentUpdate {
+	pu.mutation.ClearExpiresAt()
+	return pu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pu *PaymentUpdate) SetCreatedAt(t time.Time) *PaymentUpdate {
+	pu.mutation.SetCreatedAt(t)
+	return pu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pu *PaymentUpdate) SetNillableCreatedAt(t *time.Time) *PaymentUpdate {
+	if t != nil {
+		pu.SetCreatedAt(*t)
+	}
+	return pu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pu *PaymentUpdate) SetUpdatedAt(t time.Time) *PaymentUpdate {
+	pu.mutation.SetUpdatedAt(t)
+	return pu
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (pu *PaymentUpdate) SetOrder(o *Order) *PaymentUpdate {
+	return pu.SetOrderID(o.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (pu *PaymentUpdate) Mutation() *PaymentMutation {
+	return pu.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (pu *PaymentUpdate) ClearOrder() *PaymentUpdate {
+	pu.mutation.ClearOrder()
+	return pu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PaymentUpdate) Save(ctx context.Context) (int, error) {
+	pu.defaults()
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PaymentUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PaymentUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PaymentUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pu *PaymentUpdate) defaults() {
+	if _, ok := pu.mutation.UpdatedAt(); !ok {
+		v := payment.UpdateDefaultUpdatedAt()
+		pu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PaymentUpdate) check() error {
+	if v, ok := pu.mutation.Provider(); ok {
+		if err := payment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payment.provider": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Method(); ok {
+		if err := payment.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Payment.method": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Status(); ok {
+		if err := payment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.Amount(); ok {
+		if err := payment.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payment.amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PaymentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payment.Table, payment.Columns, sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Provider(); ok {
+		_spec.SetField(payment.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Method(); ok {
+		_spec.SetField(payment.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.TransactionID(); ok {
+		_spec.SetField(payment.FieldTransactionID, field.TypeString, value)
+	}
+	if pu.mutation.TransactionIDCleared() {
+		_spec.ClearField(payment.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := pu.mutation.Status(); ok {
+		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := pu.mutation.Amount(); ok {
+		_spec.SetField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedAmount(); ok {
+		_spec.AddField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.CapturedAmount(); ok {
+		_spec.SetField(payment.FieldCapturedAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(payment.FieldCapturedAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.RefundedAmount(); ok {
+		_spec.SetField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := pu.mutation.FailureReason(); ok {
+		_spec.SetField(payment.FieldFailureReason, field.TypeString, value)
+	}
+	if pu.mutation.FailureReasonCleared() {
+		_spec.ClearField(payment.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := pu.mutation.PixPayload(); ok {
+		_spec.SetField(payment.FieldPixPayload, field.TypeString, value)
+	}
+	if pu.mutation.PixPayloadCleared() {
+		_spec.ClearField(payment.FieldPixPayload, field.TypeString)
+	}
+	if value, ok := pu.mutation.BoletoBarcode(); ok {
+		_spec.SetField(payment.FieldBoletoBarcode, field.TypeString, value)
+	}
+	if pu.mutation.BoletoBarcodeCleared() {
+		_spec.ClearField(payment.FieldBoletoBarcode, field.TypeString)
+	}
+	if value, ok := pu.mutation.BoletoDigitableLine(); ok {
+		_spec.SetField(payment.FieldBoletoDigitableLine, field.TypeString, value)
+	}
+	if pu.mutation.BoletoDigitableLineCleared() {
+		_spec.ClearField(payment.FieldBoletoDigitableLine, field.TypeString)
+	}
+	if value, ok := pu.mutation.ExpiresAt(); ok {
+		_spec.SetField(payment.FieldExpiresAt, field.TypeTime, value)
+	}
+	if pu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(payment.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := pu.mutation.CreatedAt(); ok {
+		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pu.mutation.UpdatedAt(); ok {
+		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pu.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.OrderTable,
+			Columns: []string{payment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.OrderTable,
+			Columns: []string{payment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PaymentUpdateOne is the builder for updating a single Payment entity.
+type PaymentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaymentMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (puo *PaymentUpdateOne) SetOrderID(s string) *PaymentUpdateOne {
+	puo.mutation.SetOrderID(s)
+	return puo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableOrderID(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetOrderID(*s)
+	}
+	return puo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (puo *PaymentUpdateOne) ClearOrderID() *PaymentUpdateOne {
+	puo.mutation.ClearOrderID()
+	return puo
+}
+
+// SetProvider sets the "provider" field.
+func (puo *PaymentUpdateOne) SetProvider(s string) *PaymentUpdateOne {
+	puo.mutation.SetProvider(s)
+	return puo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableProvider(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetProvider(*s)
+	}
+	return puo
+}
+
+// SetMethod sets the "method" field.
+func (puo *PaymentUpdateOne) SetMethod(s string) *PaymentUpdateOne {
+	puo.mutation.SetMethod(s)
+	return puo
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableMethod(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetMethod(*s)
+	}
+	return puo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (puo *PaymentUpdateOne) SetTransactionID(s string) *PaymentUpdateOne {
+	puo.mutation.SetTransactionID(s)
+	return puo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableTransactionID(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetTransactionID(*s)
+	}
+	return puo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (puo *PaymentUpdateOne) ClearTransactionID() *PaymentUpdateOne {
+	puo.mutation.ClearTransactionID()
+	return puo
+}
+
+// SetStatus sets the "status" field.
+func (puo *PaymentUpdateOne) SetStatus(pa payment.Status) *PaymentUpdateOne {
+	puo.mutation.SetStatus(pa)
+	return puo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableStatus(pa *payment.Status) *PaymentUpdateOne {
+	if pa != nil {
+		puo.SetStatus(*pa)
+	}
+	return puo
+}
+
+// SetAmount sets the "amount" field.
+func (puo *PaymentUpdateOne) SetAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.ResetAmount()
+	puo.mutation.SetAmount(m)
+	return puo
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableAmount(m *money.Amount) *PaymentUpdateOne {
+	if m != nil {
+		puo.SetAmount(*m)
+	}
+	return puo
+}
+
+// AddAmount adds m to the "amount" field.
+func (puo *PaymentUpdateOne) AddAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.AddAmount(m)
+	return puo
+}
+
+// SetCapturedAmount sets the "captured_amount" field.
+func (puo *PaymentUpdateOne) SetCapturedAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.ResetCapturedAmount()
+	puo.mutation.SetCapturedAmount(m)
+	return puo
+}
+
+// SetNillableCapturedAmount sets the "captured_amount" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableCapturedAmount(m *money.Amount) *PaymentUpdateOne {
+	if m != nil {
+		puo.SetCapturedAmount(*m)
+	}
+	return puo
+}
+
+// AddCapturedAmount adds m to the "captured_amount" field.
+func (puo *PaymentUpdateOne) AddCapturedAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.AddCapturedAmount(m)
+	return puo
+}
+
+// SetRefundedAmount sets the "refunded_amount" field.
+func (puo *PaymentUpdateOne) SetRefundedAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.ResetRefundedAmount()
+	puo.mutation.SetRefundedAmount(m)
+	return puo
+}
+
+// SetNillableRefundedAmount sets the "refunded_amount" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableRefundedAmount(m *money.Amount) *PaymentUpdateOne {
+	if m != nil {
+		puo.SetRefundedAmount(*m)
+	}
+	return puo
+}
+
+// AddRefundedAmount adds m to the "refunded_amount" field.
+func (puo *PaymentUpdateOne) AddRefundedAmount(m money.Amount) *PaymentUpdateOne {
+	puo.mutation.AddRefundedAmount(m)
+	return puo
+}
+
+// SetFailureReason sets the "failure_reason" field.
+func (puo *PaymentUpdateOne) SetFailureReason(s string) *PaymentUpdateOne {
+	puo.mutation.SetFailureReason(s)
+	return puo
+}
+
+// SetNillableFailureReason sets the "failure_reason" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableFailureReason(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetFailureReason(*s)
+	}
+	return puo
+}
+
+// ClearFailureReason clears the value of the "failure_reason" field.
+func (puo *PaymentUpdateOne) ClearFailureReason() *PaymentUpdateOne {
+	puo.mutation.ClearFailureReason()
+	return puo
+}
+
+// SetPixPayload sets the "pix_payload" field.
+func (puo *PaymentUpdateOne) SetPixPayload(s string) *PaymentUpdateOne {
+	puo.mutation.SetPixPayload(s)
+	return puo
+}
+
+// SetNillablePixPayload sets the "pix_payload" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillablePixPayload(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetPixPayload(*s)
+	}
+	return puo
+}
+
+// ClearPixPayload clears the value of the "pix_payload" field.
+func (puo *PaymentUpdateOne) ClearPixPayload() *PaymentUpdateOne {
+	puo.mutation.ClearPixPayload()
+	return puo
+}
+
+// SetBoletoBarcode sets the "boleto_barcode" field.
+func (puo *PaymentUpdateOne) SetBoletoBarcode(s string) *PaymentUpdateOne {
+	puo.mutation.SetBoletoBarcode(s)
+	return puo
+}
+
+// SetNillableBoletoBarcode sets the "boleto_barcode" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableBoletoBarcode(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetBoletoBarcode(*s)
+	}
+	return puo
+}
+
+// ClearBoletoBarcode clears the value of the "boleto_barcode" field.
+func (puo *PaymentUpdateOne) ClearBoletoBarcode() *PaymentUpdateOne {
+	puo.mutation.ClearBoletoBarcode()
+	return puo
+}
+
+// SetBoletoDigitableLine sets the "boleto_digitable_line" field.
+func (puo *PaymentUpdateOne) SetBoletoDigitableLine(s string) *PaymentUpdateOne {
+	puo.mutation.SetBoletoDigitableLine(s)
+	return puo
+}
+
+// SetNillableBoletoDigitableLine sets the "boleto_digitable_line" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableBoletoDigitableLine(s *string) *PaymentUpdateOne {
+	if s != nil {
+		puo.SetBoletoDigitableLine(*s)
+	}
+	return puo
+}
+
+// ClearBoletoDigitableLine clears the value of the "boleto_digitable_line" field.
+func (puo *PaymentUpdateOne) ClearBoletoDigitableLine() *PaymentUpdateOne {
+	puo.mutation.ClearBoletoDigitableLine()
+	return puo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (puo *PaymentUpdateOne) SetExpiresAt(t time.Time) *PaymentUpdateOne {
+	puo.mutation.SetExpiresAt(t)
+	return puo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableExpiresAt(t *time.Time) *PaymentUpdateOne {
+	if t != nil {
+		puo.SetExpiresAt(*t)
+	}
+	return puo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (puo *PaymentUpdateOne) ClearExpiresAt() *PaymentUpdateOne {
+	puo.mutation.ClearExpiresAt()
+	return puo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (puo *PaymentUpdateOne) SetCreatedAt(t time.Time) *PaymentUpdateOne {
+	puo.mutation.SetCreatedAt(t)
+	return puo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (puo *PaymentUpdateOne) SetNillableCreatedAt(t *time.Time) *PaymentUpdateOne {
+	if t != nil {
+		puo.SetCreatedAt(*t)
+	}
+	return puo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (puo *PaymentUpdateOne) SetUpdatedAt(t time.Time) *PaymentUpdateOne {
+	puo.mutation.SetUpdatedAt(t)
+	return puo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (puo *PaymentUpdateOne) SetOrder(o *Order) *PaymentUpdateOne {
+	return puo.SetOrderID(o.ID)
+}
+
+// Mutation returns the PaymentMutation object of the builder.
+func (puo *PaymentUpdateOne) Mutation() *PaymentMutation {
+	return puo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (puo *PaymentUpdateOne) ClearOrder() *PaymentUpdateOne {
+	puo.mutation.ClearOrder()
+	return puo
+}
+
+// Where appends a list predicates to the PaymentUpdate builder.
+func (puo *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PaymentUpdateOne) Select(field string, fields ...string) *PaymentUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Payment entity.
+func (puo *PaymentUpdateOne) Save(ctx context.Context) (*Payment, error) {
+	puo.defaults()
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PaymentUpdateOne) SaveX(ctx context.Context) *Payment {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PaymentUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PaymentUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (puo *PaymentUpdateOne) defaults() {
+	if _, ok := puo.mutation.UpdatedAt(); !ok {
+		v := payment.UpdateDefaultUpdatedAt()
+		puo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PaymentUpdateOne) check() error {
+	if v, ok := puo.mutation.Provider(); ok {
+		if err := payment.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Payment.provider": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Method(); ok {
+		if err := payment.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "Payment.method": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Status(); ok {
+		if err := payment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.Amount(); ok {
+		if err := payment.AmountValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "Payment.amount": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(payment.Table, payment.Columns, sqlgraph.NewFieldSpec(payment.FieldID, field.TypeString))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Payment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, payment.FieldID)
+		for _, f := range fields {
+			if !payment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != payment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Provider(); ok {
+		_spec.SetField(payment.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Method(); ok {
+		_spec.SetField(payment.FieldMethod, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.TransactionID(); ok {
+		_spec.SetField(payment.FieldTransactionID, field.TypeString, value)
+	}
+	if puo.mutation.TransactionIDCleared() {
+		_spec.ClearField(payment.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := puo.mutation.Status(); ok {
+		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := puo.mutation.Amount(); ok {
+		_spec.SetField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedAmount(); ok {
+		_spec.AddField(payment.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.CapturedAmount(); ok {
+		_spec.SetField(payment.FieldCapturedAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedCapturedAmount(); ok {
+		_spec.AddField(payment.FieldCapturedAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.RefundedAmount(); ok {
+		_spec.SetField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.AddedRefundedAmount(); ok {
+		_spec.AddField(payment.FieldRefundedAmount, field.TypeInt64, value)
+	}
+	if value, ok := puo.mutation.FailureReason(); ok {
+		_spec.SetField(payment.FieldFailureReason, field.TypeString, value)
+	}
+	if puo.mutation.FailureReasonCleared() {
+		_spec.ClearField(payment.FieldFailureReason, field.TypeString)
+	}
+	if value, ok := puo.mutation.PixPayload(); ok {
+		_spec.SetField(payment.FieldPixPayload, field.TypeString, value)
+	}
+	if puo.mutation.PixPayloadCleared() {
+		_spec.ClearField(payment.FieldPixPayload, field.TypeString)
+	}
+	if value, ok := puo.mutation.BoletoBarcode(); ok {
+		_spec.SetField(payment.FieldBoletoBarcode, field.TypeString, value)
+	}
+	if puo.mutation.BoletoBarcodeCleared() {
+		_spec.ClearField(payment.FieldBoletoBarcode, field.TypeString)
+	}
+	if value, ok := puo.mutation.BoletoDigitableLine(); ok {
+		_spec.SetField(payment.FieldBoletoDigitableLine, field.TypeString, value)
+	}
+	if puo.mutation.BoletoDigitableLineCleared() {
+		_spec.ClearField(payment.FieldBoletoDigitableLine, field.TypeString)
+	}
+	if value, ok := puo.mutation.ExpiresAt(); ok {
+		_spec.SetField(payment.FieldExpiresAt, field.TypeTime, value)
+	}
+	if puo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(payment.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := puo.mutation.CreatedAt(); ok {
+		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := puo.mutation.UpdatedAt(); ok {
+		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if puo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.OrderTable,
+			Columns: []string{payment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   payment.OrderTable,
+			Columns: []string{payment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Payment{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{payment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
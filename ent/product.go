@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Product is the model entity for the Product schema.
@@ -26,15 +27,17 @@ type Product struct {
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// Price holds the value of the "price" field.
-	Price float64 `json:"price,omitempty"`
+	Price money.Amount `json:"price,omitempty"`
 	// SalePrice holds the value of the "sale_price" field.
-	SalePrice float64 `json:"sale_price,omitempty"`
+	SalePrice money.Amount `json:"sale_price,omitempty"`
 	// OnSale holds the value of the "on_sale" field.
 	OnSale bool `json:"on_sale,omitempty"`
 	// Stock holds the value of the "stock" field.
 	Stock int `json:"stock,omitempty"`
 	// Sku holds the value of the "sku" field.
 	Sku string `json:"sku,omitempty"`
+	// Weight holds the value of the "weight" field.
+	Weight int `json:"weight,omitempty"`
 	// CategoryID holds the value of the "category_id" field.
 	CategoryID string `json:"category_id,omitempty"`
 	// Images holds the value of the "images" field.
@@ -63,9 +66,13 @@ type ProductEdges struct {
 	OrderItems []*OrderItem `json:"order_items,omitempty"`
 	// CartItems holds the value of the cart_items edge.
 	CartItems []*CartItem `json:"cart_items,omitempty"`
+	// Options holds the value of the options edge.
+	Options []*ProductOption `json:"options,omitempty"`
+	// Variants holds the value of the variants edge.
+	Variants []*ProductVariant `json:"variants,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [6]bool
 }
 
 // AvaliationsOrErr returns the Avaliations value or an error if the edge
@@ -106,6 +113,24 @@ func (e ProductEdges) CartItemsOrErr() ([]*CartItem, error) {
 	return nil, &NotLoadedError{edge: "cart_items"}
 }
 
+// OptionsOrErr returns the Options value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) OptionsOrErr() ([]*ProductOption, error) {
+	if e.loadedTypes[4] {
+		return e.Options, nil
+	}
+	return nil, &NotLoadedError{edge: "options"}
+}
+
+// VariantsOrErr returns the Variants value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductEdges) VariantsOrErr() ([]*ProductVariant, error) {
+	if e.loadedTypes[5] {
+		return e.Variants, nil
+	}
+	return nil, &NotLoadedError{edge: "variants"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Product) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -113,11 +138,13 @@ func (*Product) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case product.FieldImages:
 			values[i] = new([]byte)
+		case product.FieldPrice, product.FieldSalePrice:
+			values[i] = new(money.Amount)
 		case product.FieldOnSale:
 			values[i] = new(sql.NullBool)
-		case product.FieldPrice, product.FieldSalePrice, product.FieldRating:
+		case product.FieldRating:
 			values[i] = new(sql.NullFloat64)
-		case product.FieldStock, product.FieldReviewCount:
+		case product.FieldStock, product.FieldWeight, product.FieldReviewCount:
 			values[i] = new(sql.NullInt64)
 		case product.FieldID, product.FieldName, product.FieldSlug, product.FieldDescription, product.FieldSku, product.FieldCategoryID:
 			values[i] = new(sql.NullString)
@@ -163,16 +190,16 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 				pr.Description = value.String
 			}
 		case product.FieldPrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field price", values[i])
-			} else if value.Valid {
-				pr.Price = value.Float64
+			} else if value != nil {
+				pr.Price = *value
 			}
 		case product.FieldSalePrice:
-			if value, ok := values[i].(*sql.NullFloat64); !ok {
+			if value, ok := values[i].(*money.Amount); !ok {
 				return fmt.Errorf("unexpected type %T for field sale_price", values[i])
-			} else if value.Valid {
-				pr.SalePrice = value.Float64
+			} else if value != nil {
+				pr.SalePrice = *value
 			}
 		case product.FieldOnSale:
 			if value, ok := values[i].(*sql.NullBool); !ok {
@@ -192,6 +219,12 @@ func (pr *Product) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.Sku = value.String
 			}
+		case product.FieldWeight:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field weight", values[i])
+			} else if value.Valid {
+				pr.Weight = int(value.Int64)
+			}
 		case product.FieldCategoryID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field category_id", values[i])
@@ -263,6 +296,16 @@ func (pr *Product) QueryCartItems() *CartItemQuery {
 	return NewProductClient(pr.config).QueryCartItems(pr)
 }
 
+// QueryOptions queries the "options" edge of the Product entity.
+func (pr *Product) QueryOptions() *ProductOptionQuery {
+	return NewProductClient(pr.config).QueryOptions(pr)
+}
+
+// QueryVariants queries the "variants" edge of the Product entity.
+func (pr *Product) QueryVariants() *ProductVariantQuery {
+	return NewProductClient(pr.config).QueryVariants(pr)
+}
+
 // Update returns a builder for updating this Product.
 // Note that you need to call Product.Unwrap() before calling this method if this Product
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -310,6 +353,9 @@ func (pr *Product) String() string {
 	builder.WriteString("sku=")
 	builder.WriteString(pr.Sku)
 	builder.WriteString(", ")
+	builder.WriteString("weight=")
+	builder.WriteString(fmt.Sprintf("%v", pr.Weight))
+	builder.WriteString(", ")
 	builder.WriteString("category_id=")
 	builder.WriteString(pr.CategoryID)
 	builder.WriteString(", ")
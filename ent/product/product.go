@@ -30,6 +30,8 @@ const (
 	FieldStock = "stock"
 	// FieldSku holds the string denoting the sku field in the database.
 	FieldSku = "sku"
+	// FieldWeight holds the string denoting the weight field in the database.
+	FieldWeight = "weight"
 	// FieldCategoryID holds the string denoting the category_id field in the database.
 	FieldCategoryID = "category_id"
 	// FieldImages holds the string denoting the images field in the database.
@@ -50,6 +52,10 @@ const (
 	EdgeOrderItems = "order_items"
 	// EdgeCartItems holds the string denoting the cart_items edge name in mutations.
 	EdgeCartItems = "cart_items"
+	// EdgeOptions holds the string denoting the options edge name in mutations.
+	EdgeOptions = "options"
+	// EdgeVariants holds the string denoting the variants edge name in mutations.
+	EdgeVariants = "variants"
 	// Table holds the table name of the product in the database.
 	Table = "products"
 	// AvaliationsTable is the table that holds the avaliations relation/edge.
@@ -80,6 +86,20 @@ const (
 	CartItemsInverseTable = "cart_items"
 	// CartItemsColumn is the table column denoting the cart_items relation/edge.
 	CartItemsColumn = "product_id"
+	// OptionsTable is the table that holds the options relation/edge.
+	OptionsTable = "product_options"
+	// OptionsInverseTable is the table name for the ProductOption entity.
+	// It exists in this package in order to avoid circular dependency with the "productoption" package.
+	OptionsInverseTable = "product_options"
+	// OptionsColumn is the table column denoting the options relation/edge.
+	OptionsColumn = "product_id"
+	// VariantsTable is the table that holds the variants relation/edge.
+	VariantsTable = "product_variants"
+	// VariantsInverseTable is the table name for the ProductVariant entity.
+	// It exists in this package in order to avoid circular dependency with the "productvariant" package.
+	VariantsInverseTable = "product_variants"
+	// VariantsColumn is the table column denoting the variants relation/edge.
+	VariantsColumn = "product_id"
 )
 
 // Columns holds all SQL columns for product fields.
@@ -93,6 +113,7 @@ var Columns = []string{
 	FieldOnSale,
 	FieldStock,
 	FieldSku,
+	FieldWeight,
 	FieldCategoryID,
 	FieldImages,
 	FieldRating,
@@ -119,13 +140,19 @@ var (
 	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
 	DescriptionValidator func(string) error
 	// PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	PriceValidator func(float64) error
+	PriceValidator func(int64) error
 	// DefaultOnSale holds the default value on creation for the "on_sale" field.
 	DefaultOnSale bool
 	// DefaultStock holds the default value on creation for the "stock" field.
 	DefaultStock int
+	// StockValidator is a validator for the "stock" field. It is called by the builders before save.
+	StockValidator func(int) error
 	// SkuValidator is a validator for the "sku" field. It is called by the builders before save.
 	SkuValidator func(string) error
+	// DefaultWeight holds the default value on creation for the "weight" field.
+	DefaultWeight int
+	// WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	WeightValidator func(int) error
 	// DefaultImages holds the default value on creation for the "images" field.
 	DefaultImages []string
 	// DefaultRating holds the default value on creation for the "rating" field.
@@ -188,6 +215,11 @@ func BySku(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSku, opts...).ToFunc()
 }
 
+// ByWeight orders the results by the weight field.
+func ByWeight(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWeight, opts...).ToFunc()
+}
+
 // ByCategoryID orders the results by the category_id field.
 func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
@@ -261,6 +293,34 @@ func ByCartItems(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newCartItemsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByOptionsCount orders the results by options count.
+func ByOptionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOptionsStep(), opts...)
+	}
+}
+
+// ByOptions orders the results by options terms.
+func ByOptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVariantsCount orders the results by variants count.
+func ByVariantsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVariantsStep(), opts...)
+	}
+}
+
+// ByVariants orders the results by variants terms.
+func ByVariants(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVariantsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAvaliationsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -289,3 +349,17 @@ func newCartItemsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, CartItemsTable, CartItemsColumn),
 	)
 }
+func newOptionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OptionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
+	)
+}
+func newVariantsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VariantsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+	)
+}
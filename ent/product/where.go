@@ -8,6 +8,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ID filters vertices based on their ID field.
@@ -81,12 +82,12 @@ func Description(v string) predicate.Product {
 }
 
 // Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
-func Price(v float64) predicate.Product {
+func Price(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldPrice, v))
 }
 
 // SalePrice applies equality check predicate on the "sale_price" field. It's identical to SalePriceEQ.
-func SalePrice(v float64) predicate.Product {
+func SalePrice(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldSalePrice, v))
 }
 
@@ -105,6 +106,11 @@ func Sku(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldSku, v))
 }
 
+// Weight applies equality check predicate on the "weight" field. It's identical to WeightEQ.
+func Weight(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWeight, v))
+}
+
 // CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
 func CategoryID(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
@@ -326,82 +332,82 @@ func DescriptionContainsFold(v string) predicate.Product {
 }
 
 // PriceEQ applies the EQ predicate on the "price" field.
-func PriceEQ(v float64) predicate.Product {
+func PriceEQ(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldPrice, v))
 }
 
 // PriceNEQ applies the NEQ predicate on the "price" field.
-func PriceNEQ(v float64) predicate.Product {
+func PriceNEQ(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldNEQ(FieldPrice, v))
 }
 
 // PriceIn applies the In predicate on the "price" field.
-func PriceIn(vs ...float64) predicate.Product {
+func PriceIn(vs ...money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldIn(FieldPrice, vs...))
 }
 
 // PriceNotIn applies the NotIn predicate on the "price" field.
-func PriceNotIn(vs ...float64) predicate.Product {
+func PriceNotIn(vs ...money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldNotIn(FieldPrice, vs...))
 }
 
 // PriceGT applies the GT predicate on the "price" field.
-func PriceGT(v float64) predicate.Product {
+func PriceGT(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldGT(FieldPrice, v))
 }
 
 // PriceGTE applies the GTE predicate on the "price" field.
-func PriceGTE(v float64) predicate.Product {
+func PriceGTE(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldGTE(FieldPrice, v))
 }
 
 // PriceLT applies the LT predicate on the "price" field.
-func PriceLT(v float64) predicate.Product {
+func PriceLT(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldLT(FieldPrice, v))
 }
 
 // PriceLTE applies the LTE predicate on the "price" field.
-func PriceLTE(v float64) predicate.Product {
+func PriceLTE(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldLTE(FieldPrice, v))
 }
 
 // SalePriceEQ applies the EQ predicate on the "sale_price" field.
-func SalePriceEQ(v float64) predicate.Product {
+func SalePriceEQ(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldSalePrice, v))
 }
 
 // SalePriceNEQ applies the NEQ predicate on the "sale_price" field.
-func SalePriceNEQ(v float64) predicate.Product {
+func SalePriceNEQ(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldNEQ(FieldSalePrice, v))
 }
 
 // SalePriceIn applies the In predicate on the "sale_price" field.
-func SalePriceIn(vs ...float64) predicate.Product {
+func SalePriceIn(vs ...money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldIn(FieldSalePrice, vs...))
 }
 
 // SalePriceNotIn applies the NotIn predicate on the "sale_price" field.
-func SalePriceNotIn(vs ...float64) predicate.Product {
+func SalePriceNotIn(vs ...money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldNotIn(FieldSalePrice, vs...))
 }
 
 // SalePriceGT applies the GT predicate on the "sale_price" field.
-func SalePriceGT(v float64) predicate.Product {
+func SalePriceGT(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldGT(FieldSalePrice, v))
 }
 
 // SalePriceGTE applies the GTE predicate on the "sale_price" field.
-func SalePriceGTE(v float64) predicate.Product {
+func SalePriceGTE(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldGTE(FieldSalePrice, v))
 }
 
 // SalePriceLT applies the LT predicate on the "sale_price" field.
-func SalePriceLT(v float64) predicate.Product {
+func SalePriceLT(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldLT(FieldSalePrice, v))
 }
 
 // SalePriceLTE applies the LTE predicate on the "sale_price" field.
-func SalePriceLTE(v float64) predicate.Product {
+func SalePriceLTE(v money.Amount) predicate.Product {
 	return predicate.Product(sql.FieldLTE(FieldSalePrice, v))
 }
 
@@ -530,6 +536,46 @@ func SkuContainsFold(v string) predicate.Product {
 	return predicate.Product(sql.FieldContainsFold(FieldSku, v))
 }
 
+// WeightEQ applies the EQ predicate on the "weight" field.
+func WeightEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldEQ(FieldWeight, v))
+}
+
+// WeightNEQ applies the NEQ predicate on the "weight" field.
+func WeightNEQ(v int) predicate.Product {
+	return predicate.Product(sql.FieldNEQ(FieldWeight, v))
+}
+
+// WeightIn applies the In predicate on the "weight" field.
+func WeightIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldIn(FieldWeight, vs...))
+}
+
+// WeightNotIn applies the NotIn predicate on the "weight" field.
+func WeightNotIn(vs ...int) predicate.Product {
+	return predicate.Product(sql.FieldNotIn(FieldWeight, vs...))
+}
+
+// WeightGT applies the GT predicate on the "weight" field.
+func WeightGT(v int) predicate.Product {
+	return predicate.Product(sql.FieldGT(FieldWeight, v))
+}
+
+// WeightGTE applies the GTE predicate on the "weight" field.
+func WeightGTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldGTE(FieldWeight, v))
+}
+
+// WeightLT applies the LT predicate on the "weight" field.
+func WeightLT(v int) predicate.Product {
+	return predicate.Product(sql.FieldLT(FieldWeight, v))
+}
+
+// WeightLTE applies the LTE predicate on the "weight" field.
+func WeightLTE(v int) predicate.Product {
+	return predicate.Product(sql.FieldLTE(FieldWeight, v))
+}
+
 // CategoryIDEQ applies the EQ predicate on the "category_id" field.
 func CategoryIDEQ(v string) predicate.Product {
 	return predicate.Product(sql.FieldEQ(FieldCategoryID, v))
@@ -857,6 +903,52 @@ func HasCartItemsWith(preds ...predicate.CartItem) predicate.Product {
 	})
 }
 
+// HasOptions applies the HasEdge predicate on the "options" edge.
+func HasOptions() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OptionsTable, OptionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOptionsWith applies the HasEdge predicate on the "options" edge with a given conditions (other predicates).
+func HasOptionsWith(preds ...predicate.ProductOption) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newOptionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVariants applies the HasEdge predicate on the "variants" edge.
+func HasVariants() predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VariantsTable, VariantsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVariantsWith applies the HasEdge predicate on the "variants" edge with a given conditions (other predicates).
+func HasVariantsWith(preds ...predicate.ProductVariant) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		step := newVariantsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Product) predicate.Product {
 	return predicate.Product(sql.AndPredicates(predicates...))
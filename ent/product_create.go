@@ -15,6 +15,9 @@ import (
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ProductCreate is the builder for creating a Product entity.
@@ -43,21 +46,21 @@ func (pc *ProductCreate) SetDescription(s string) *ProductCreate {
 }
 
 // SetPrice sets the "price" field.
-func (pc *ProductCreate) SetPrice(f float64) *ProductCreate {
-	pc.mutation.SetPrice(f)
+func (pc *ProductCreate) SetPrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetPrice(m)
 	return pc
 }
 
 // SetSalePrice sets the "sale_price" field.
-func (pc *ProductCreate) SetSalePrice(f float64) *ProductCreate {
-	pc.mutation.SetSalePrice(f)
+func (pc *ProductCreate) SetSalePrice(m money.Amount) *ProductCreate {
+	pc.mutation.SetSalePrice(m)
 	return pc
 }
 
 // SetNillableSalePrice sets the "sale_price" field if the given value is not nil.
-func (pc *ProductCreate) SetNillableSalePrice(f *float64) *ProductCreate {
-	if f != nil {
-		pc.SetSalePrice(*f)
+func (pc *ProductCreate) SetNillableSalePrice(m *money.Amount) *ProductCreate {
+	if m != nil {
+		pc.SetSalePrice(*m)
 	}
 	return pc
 }
@@ -96,6 +99,20 @@ func (pc *ProductCreate) SetSku(s string) *ProductCreate {
 	return pc
 }
 
+// SetWeight sets the "weight" field.
+func (pc *ProductCreate) SetWeight(i int) *ProductCreate {
+	pc.mutation.SetWeight(i)
+	return pc
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (pc *ProductCreate) SetNillableWeight(i *int) *ProductCreate {
+	if i != nil {
+		pc.SetWeight(*i)
+	}
+	return pc
+}
+
 // SetCategoryID sets the "category_id" field.
 func (pc *ProductCreate) SetCategoryID(s string) *ProductCreate {
 	pc.mutation.SetCategoryID(s)
@@ -228,6 +245,36 @@ func (pc *ProductCreate) AddCartItems(c ...*CartItem) *ProductCreate {
 	return pc.AddCartItemIDs(ids...)
 }
 
+// AddOptionIDs adds the "options" edge to the ProductOption entity by IDs.
+func (pc *ProductCreate) AddOptionIDs(ids ...string) *ProductCreate {
+	pc.mutation.AddOptionIDs(ids...)
+	return pc
+}
+
+// AddOptions adds the "options" edges to the ProductOption entity.
+func (pc *ProductCreate) AddOptions(p ...*ProductOption) *ProductCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddOptionIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (pc *ProductCreate) AddVariantIDs(ids ...string) *ProductCreate {
+	pc.mutation.AddVariantIDs(ids...)
+	return pc
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (pc *ProductCreate) AddVariants(p ...*ProductVariant) *ProductCreate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pc.AddVariantIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pc *ProductCreate) Mutation() *ProductMutation {
 	return pc.mutation
@@ -271,6 +318,10 @@ func (pc *ProductCreate) defaults() {
 		v := product.DefaultStock
 		pc.mutation.SetStock(v)
 	}
+	if _, ok := pc.mutation.Weight(); !ok {
+		v := product.DefaultWeight
+		pc.mutation.SetWeight(v)
+	}
 	if _, ok := pc.mutation.Images(); !ok {
 		v := product.DefaultImages
 		pc.mutation.SetImages(v)
@@ -323,7 +374,7 @@ func (pc *ProductCreate) check() error {
 		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Product.price"`)}
 	}
 	if v, ok := pc.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
@@ -333,6 +384,11 @@ func (pc *ProductCreate) check() error {
 	if _, ok := pc.mutation.Stock(); !ok {
 		return &ValidationError{Name: "stock", err: errors.New(`ent: missing required field "Product.stock"`)}
 	}
+	if v, ok := pc.mutation.Stock(); ok {
+		if err := product.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Sku(); !ok {
 		return &ValidationError{Name: "sku", err: errors.New(`ent: missing required field "Product.sku"`)}
 	}
@@ -341,6 +397,14 @@ func (pc *ProductCreate) check() error {
 			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
 		}
 	}
+	if _, ok := pc.mutation.Weight(); !ok {
+		return &ValidationError{Name: "weight", err: errors.New(`ent: missing required field "Product.weight"`)}
+	}
+	if v, ok := pc.mutation.Weight(); ok {
+		if err := product.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Product.weight": %w`, err)}
+		}
+	}
 	if _, ok := pc.mutation.Images(); !ok {
 		return &ValidationError{Name: "images", err: errors.New(`ent: missing required field "Product.images"`)}
 	}
@@ -404,11 +468,11 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_node.Description = value
 	}
 	if value, ok := pc.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 		_node.Price = value
 	}
 	if value, ok := pc.mutation.SalePrice(); ok {
-		_spec.SetField(product.FieldSalePrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldSalePrice, field.TypeInt64, value)
 		_node.SalePrice = value
 	}
 	if value, ok := pc.mutation.OnSale(); ok {
@@ -423,6 +487,10 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		_spec.SetField(product.FieldSku, field.TypeString, value)
 		_node.Sku = value
 	}
+	if value, ok := pc.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeInt, value)
+		_node.Weight = value
+	}
 	if value, ok := pc.mutation.Images(); ok {
 		_spec.SetField(product.FieldImages, field.TypeJSON, value)
 		_node.Images = value
@@ -508,6 +576,38 @@ func (pc *ProductCreate) createSpec() (*Product, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := pc.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pc.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
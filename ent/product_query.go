@@ -18,6 +18,8 @@ import (
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
 )
 
 // ProductQuery is the builder for querying Product entities.
@@ -31,6 +33,8 @@ type ProductQuery struct {
 	withCategory    *CategoryQuery
 	withOrderItems  *OrderItemQuery
 	withCartItems   *CartItemQuery
+	withOptions     *ProductOptionQuery
+	withVariants    *ProductVariantQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -155,6 +159,50 @@ func (pq *ProductQuery) QueryCartItems() *CartItemQuery {
 	return query
 }
 
+// QueryOptions chains the current query on the "options" edge.
+func (pq *ProductQuery) QueryOptions() *ProductOptionQuery {
+	query := (&ProductOptionClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(productoption.Table, productoption.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.OptionsTable, product.OptionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVariants chains the current query on the "variants" edge.
+func (pq *ProductQuery) QueryVariants() *ProductVariantQuery {
+	query := (&ProductVariantClient{config: pq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(product.Table, product.FieldID, selector),
+			sqlgraph.To(productvariant.Table, productvariant.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, product.VariantsTable, product.VariantsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Product entity from the query.
 // Returns a *NotFoundError when no Product was found.
 func (pq *ProductQuery) First(ctx context.Context) (*Product, error) {
@@ -351,6 +399,8 @@ func (pq *ProductQuery) Clone() *ProductQuery {
 		withCategory:    pq.withCategory.Clone(),
 		withOrderItems:  pq.withOrderItems.Clone(),
 		withCartItems:   pq.withCartItems.Clone(),
+		withOptions:     pq.withOptions.Clone(),
+		withVariants:    pq.withVariants.Clone(),
 		// clone intermediate query.
 		sql:  pq.sql.Clone(),
 		path: pq.path,
@@ -401,6 +451,28 @@ func (pq *ProductQuery) WithCartItems(opts ...func(*CartItemQuery)) *ProductQuer
 	return pq
 }
 
+// WithOptions tells the query-builder to eager-load the nodes that are connected to
+// the "options" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithOptions(opts ...func(*ProductOptionQuery)) *ProductQuery {
+	query := (&ProductOptionClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withOptions = query
+	return pq
+}
+
+// WithVariants tells the query-builder to eager-load the nodes that are connected to
+// the "variants" edge. The optional arguments are used to configure the query builder of the edge.
+func (pq *ProductQuery) WithVariants(opts ...func(*ProductVariantQuery)) *ProductQuery {
+	query := (&ProductVariantClient{config: pq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pq.withVariants = query
+	return pq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -479,11 +551,13 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 	var (
 		nodes       = []*Product{}
 		_spec       = pq.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [6]bool{
 			pq.withAvaliations != nil,
 			pq.withCategory != nil,
 			pq.withOrderItems != nil,
 			pq.withCartItems != nil,
+			pq.withOptions != nil,
+			pq.withVariants != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -531,6 +605,20 @@ func (pq *ProductQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Prod
 			return nil, err
 		}
 	}
+	if query := pq.withOptions; query != nil {
+		if err := pq.loadOptions(ctx, query, nodes,
+			func(n *Product) { n.Edges.Options = []*ProductOption{} },
+			func(n *Product, e *ProductOption) { n.Edges.Options = append(n.Edges.Options, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pq.withVariants; query != nil {
+		if err := pq.loadVariants(ctx, query, nodes,
+			func(n *Product) { n.Edges.Variants = []*ProductVariant{} },
+			func(n *Product, e *ProductVariant) { n.Edges.Variants = append(n.Edges.Variants, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -653,6 +741,66 @@ func (pq *ProductQuery) loadCartItems(ctx context.Context, query *CartItemQuery,
 	}
 	return nil
 }
+func (pq *ProductQuery) loadOptions(ctx context.Context, query *ProductOptionQuery, nodes []*Product, init func(*Product), assign func(*Product, *ProductOption)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(productoption.FieldProductID)
+	}
+	query.Where(predicate.ProductOption(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.OptionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pq *ProductQuery) loadVariants(ctx context.Context, query *ProductVariantQuery, nodes []*Product, init func(*Product), assign func(*Product, *ProductVariant)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Product)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(productvariant.FieldProductID)
+	}
+	query.Where(predicate.ProductVariant(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(product.VariantsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProductID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "product_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (pq *ProductQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := pq.querySpec()
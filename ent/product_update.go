@@ -18,6 +18,9 @@ import (
 	"github.com/vtrod/veecomm-api/ent/orderitem"
 	"github.com/vtrod/veecomm-api/ent/predicate"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // ProductUpdate is the builder for updating Product entities.
@@ -76,44 +79,44 @@ func (pu *ProductUpdate) SetNillableDescription(s *string) *ProductUpdate {
 }
 
 // SetPrice sets the "price" field.
-func (pu *ProductUpdate) SetPrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetPrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetPrice()
-	pu.mutation.SetPrice(f)
+	pu.mutation.SetPrice(m)
 	return pu
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillablePrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetPrice(*f)
+func (pu *ProductUpdate) SetNillablePrice(m *money.Amount) *ProductUpdate {
+	if m != nil {
+		pu.SetPrice(*m)
 	}
 	return pu
 }
 
-// AddPrice adds f to the "price" field.
-func (pu *ProductUpdate) AddPrice(f float64) *ProductUpdate {
-	pu.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (pu *ProductUpdate) AddPrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddPrice(m)
 	return pu
 }
 
 // SetSalePrice sets the "sale_price" field.
-func (pu *ProductUpdate) SetSalePrice(f float64) *ProductUpdate {
+func (pu *ProductUpdate) SetSalePrice(m money.Amount) *ProductUpdate {
 	pu.mutation.ResetSalePrice()
-	pu.mutation.SetSalePrice(f)
+	pu.mutation.SetSalePrice(m)
 	return pu
 }
 
 // SetNillableSalePrice sets the "sale_price" field if the given value is not nil.
-func (pu *ProductUpdate) SetNillableSalePrice(f *float64) *ProductUpdate {
-	if f != nil {
-		pu.SetSalePrice(*f)
+func (pu *ProductUpdate) SetNillableSalePrice(m *money.Amount) *ProductUpdate {
+	if m != nil {
+		pu.SetSalePrice(*m)
 	}
 	return pu
 }
 
-// AddSalePrice adds f to the "sale_price" field.
-func (pu *ProductUpdate) AddSalePrice(f float64) *ProductUpdate {
-	pu.mutation.AddSalePrice(f)
+// AddSalePrice adds m to the "sale_price" field.
+func (pu *ProductUpdate) AddSalePrice(m money.Amount) *ProductUpdate {
+	pu.mutation.AddSalePrice(m)
 	return pu
 }
 
@@ -172,6 +175,27 @@ func (pu *ProductUpdate) SetNillableSku(s *string) *ProductUpdate {
 	return pu
 }
 
+// SetWeight sets the "weight" field.
+func (pu *ProductUpdate) SetWeight(i int) *ProductUpdate {
+	pu.mutation.ResetWeight()
+	pu.mutation.SetWeight(i)
+	return pu
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (pu *ProductUpdate) SetNillableWeight(i *int) *ProductUpdate {
+	if i != nil {
+		pu.SetWeight(*i)
+	}
+	return pu
+}
+
+// AddWeight adds i to the "weight" field.
+func (pu *ProductUpdate) AddWeight(i int) *ProductUpdate {
+	pu.mutation.AddWeight(i)
+	return pu
+}
+
 // SetCategoryID sets the "category_id" field.
 func (pu *ProductUpdate) SetCategoryID(s string) *ProductUpdate {
 	pu.mutation.SetCategoryID(s)
@@ -316,6 +340,36 @@ func (pu *ProductUpdate) AddCartItems(c ...*CartItem) *ProductUpdate {
 	return pu.AddCartItemIDs(ids...)
 }
 
+// AddOptionIDs adds the "options" edge to the ProductOption entity by IDs.
+func (pu *ProductUpdate) AddOptionIDs(ids ...string) *ProductUpdate {
+	pu.mutation.AddOptionIDs(ids...)
+	return pu
+}
+
+// AddOptions adds the "options" edges to the ProductOption entity.
+func (pu *ProductUpdate) AddOptions(p ...*ProductOption) *ProductUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddOptionIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (pu *ProductUpdate) AddVariantIDs(ids ...string) *ProductUpdate {
+	pu.mutation.AddVariantIDs(ids...)
+	return pu
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (pu *ProductUpdate) AddVariants(p ...*ProductVariant) *ProductUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddVariantIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (pu *ProductUpdate) Mutation() *ProductMutation {
 	return pu.mutation
@@ -390,6 +444,48 @@ func (pu *ProductUpdate) RemoveCartItems(c ...*CartItem) *ProductUpdate {
 	return pu.RemoveCartItemIDs(ids...)
 }
 
+// ClearOptions clears all "options" edges to the ProductOption entity.
+func (pu *ProductUpdate) ClearOptions() *ProductUpdate {
+	pu.mutation.ClearOptions()
+	return pu
+}
+
+// RemoveOptionIDs removes the "options" edge to ProductOption entities by IDs.
+func (pu *ProductUpdate) RemoveOptionIDs(ids ...string) *ProductUpdate {
+	pu.mutation.RemoveOptionIDs(ids...)
+	return pu
+}
+
+// RemoveOptions removes "options" edges to ProductOption entities.
+func (pu *ProductUpdate) RemoveOptions(p ...*ProductOption) *ProductUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveOptionIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the ProductVariant entity.
+func (pu *ProductUpdate) ClearVariants() *ProductUpdate {
+	pu.mutation.ClearVariants()
+	return pu
+}
+
+// RemoveVariantIDs removes the "variants" edge to ProductVariant entities by IDs.
+func (pu *ProductUpdate) RemoveVariantIDs(ids ...string) *ProductUpdate {
+	pu.mutation.RemoveVariantIDs(ids...)
+	return pu
+}
+
+// RemoveVariants removes "variants" edges to ProductVariant entities.
+func (pu *ProductUpdate) RemoveVariants(p ...*ProductVariant) *ProductUpdate {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveVariantIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (pu *ProductUpdate) Save(ctx context.Context) (int, error) {
 	pu.defaults()
@@ -444,15 +540,25 @@ func (pu *ProductUpdate) check() error {
 		}
 	}
 	if v, ok := pu.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Stock(); ok {
+		if err := product.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
+		}
+	}
 	if v, ok := pu.mutation.Sku(); ok {
 		if err := product.SkuValidator(v); err != nil {
 			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
 		}
 	}
+	if v, ok := pu.mutation.Weight(); ok {
+		if err := product.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Product.weight": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -478,19 +584,19 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
 	if value, ok := pu.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := pu.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := pu.mutation.SalePrice(); ok {
-		_spec.SetField(product.FieldSalePrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldSalePrice, field.TypeInt64, value)
 	}
 	if value, ok := pu.mutation.AddedSalePrice(); ok {
-		_spec.AddField(product.FieldSalePrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldSalePrice, field.TypeInt64, value)
 	}
 	if pu.mutation.SalePriceCleared() {
-		_spec.ClearField(product.FieldSalePrice, field.TypeFloat64)
+		_spec.ClearField(product.FieldSalePrice, field.TypeInt64)
 	}
 	if value, ok := pu.mutation.OnSale(); ok {
 		_spec.SetField(product.FieldOnSale, field.TypeBool, value)
@@ -504,6 +610,12 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := pu.mutation.Sku(); ok {
 		_spec.SetField(product.FieldSku, field.TypeString, value)
 	}
+	if value, ok := pu.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedWeight(); ok {
+		_spec.AddField(product.FieldWeight, field.TypeInt, value)
+	}
 	if value, ok := pu.mutation.Images(); ok {
 		_spec.SetField(product.FieldImages, field.TypeJSON, value)
 	}
@@ -694,6 +806,96 @@ func (pu *ProductUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if pu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !pu.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !pu.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{product.Label}
@@ -757,44 +959,44 @@ func (puo *ProductUpdateOne) SetNillableDescription(s *string) *ProductUpdateOne
 }
 
 // SetPrice sets the "price" field.
-func (puo *ProductUpdateOne) SetPrice(f float64) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetPrice(m money.Amount) *ProductUpdateOne {
 	puo.mutation.ResetPrice()
-	puo.mutation.SetPrice(f)
+	puo.mutation.SetPrice(m)
 	return puo
 }
 
 // SetNillablePrice sets the "price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillablePrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetPrice(*f)
+func (puo *ProductUpdateOne) SetNillablePrice(m *money.Amount) *ProductUpdateOne {
+	if m != nil {
+		puo.SetPrice(*m)
 	}
 	return puo
 }
 
-// AddPrice adds f to the "price" field.
-func (puo *ProductUpdateOne) AddPrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddPrice(f)
+// AddPrice adds m to the "price" field.
+func (puo *ProductUpdateOne) AddPrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddPrice(m)
 	return puo
 }
 
 // SetSalePrice sets the "sale_price" field.
-func (puo *ProductUpdateOne) SetSalePrice(f float64) *ProductUpdateOne {
+func (puo *ProductUpdateOne) SetSalePrice(m money.Amount) *ProductUpdateOne {
 	puo.mutation.ResetSalePrice()
-	puo.mutation.SetSalePrice(f)
+	puo.mutation.SetSalePrice(m)
 	return puo
 }
 
 // SetNillableSalePrice sets the "sale_price" field if the given value is not nil.
-func (puo *ProductUpdateOne) SetNillableSalePrice(f *float64) *ProductUpdateOne {
-	if f != nil {
-		puo.SetSalePrice(*f)
+func (puo *ProductUpdateOne) SetNillableSalePrice(m *money.Amount) *ProductUpdateOne {
+	if m != nil {
+		puo.SetSalePrice(*m)
 	}
 	return puo
 }
 
-// AddSalePrice adds f to the "sale_price" field.
-func (puo *ProductUpdateOne) AddSalePrice(f float64) *ProductUpdateOne {
-	puo.mutation.AddSalePrice(f)
+// AddSalePrice adds m to the "sale_price" field.
+func (puo *ProductUpdateOne) AddSalePrice(m money.Amount) *ProductUpdateOne {
+	puo.mutation.AddSalePrice(m)
 	return puo
 }
 
@@ -853,6 +1055,27 @@ func (puo *ProductUpdateOne) SetNillableSku(s *string) *ProductUpdateOne {
 	return puo
 }
 
+// SetWeight sets the "weight" field.
+func (puo *ProductUpdateOne) SetWeight(i int) *ProductUpdateOne {
+	puo.mutation.ResetWeight()
+	puo.mutation.SetWeight(i)
+	return puo
+}
+
+// SetNillableWeight sets the "weight" field if the given value is not nil.
+func (puo *ProductUpdateOne) SetNillableWeight(i *int) *ProductUpdateOne {
+	if i != nil {
+		puo.SetWeight(*i)
+	}
+	return puo
+}
+
+// AddWeight adds i to the "weight" field.
+func (puo *ProductUpdateOne) AddWeight(i int) *ProductUpdateOne {
+	puo.mutation.AddWeight(i)
+	return puo
+}
+
 // SetCategoryID sets the "category_id" field.
 func (puo *ProductUpdateOne) SetCategoryID(s string) *ProductUpdateOne {
 	puo.mutation.SetCategoryID(s)
@@ -997,6 +1220,36 @@ func (puo *ProductUpdateOne) AddCartItems(c ...*CartItem) *ProductUpdateOne {
 	return puo.AddCartItemIDs(ids...)
 }
 
+// AddOptionIDs adds the "options" edge to the ProductOption entity by IDs.
+func (puo *ProductUpdateOne) AddOptionIDs(ids ...string) *ProductUpdateOne {
+	puo.mutation.AddOptionIDs(ids...)
+	return puo
+}
+
+// AddOptions adds the "options" edges to the ProductOption entity.
+func (puo *ProductUpdateOne) AddOptions(p ...*ProductOption) *ProductUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddOptionIDs(ids...)
+}
+
+// AddVariantIDs adds the "variants" edge to the ProductVariant entity by IDs.
+func (puo *ProductUpdateOne) AddVariantIDs(ids ...string) *ProductUpdateOne {
+	puo.mutation.AddVariantIDs(ids...)
+	return puo
+}
+
+// AddVariants adds the "variants" edges to the ProductVariant entity.
+func (puo *ProductUpdateOne) AddVariants(p ...*ProductVariant) *ProductUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddVariantIDs(ids...)
+}
+
 // Mutation returns the ProductMutation object of the builder.
 func (puo *ProductUpdateOne) Mutation() *ProductMutation {
 	return puo.mutation
@@ -1071,6 +1324,48 @@ func (puo *ProductUpdateOne) RemoveCartItems(c ...*CartItem) *ProductUpdateOne {
 	return puo.RemoveCartItemIDs(ids...)
 }
 
+// ClearOptions clears all "options" edges to the ProductOption entity.
+func (puo *ProductUpdateOne) ClearOptions() *ProductUpdateOne {
+	puo.mutation.ClearOptions()
+	return puo
+}
+
+// RemoveOptionIDs removes the "options" edge to ProductOption entities by IDs.
+func (puo *ProductUpdateOne) RemoveOptionIDs(ids ...string) *ProductUpdateOne {
+	puo.mutation.RemoveOptionIDs(ids...)
+	return puo
+}
+
+// RemoveOptions removes "options" edges to ProductOption entities.
+func (puo *ProductUpdateOne) RemoveOptions(p ...*ProductOption) *ProductUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveOptionIDs(ids...)
+}
+
+// ClearVariants clears all "variants" edges to the ProductVariant entity.
+func (puo *ProductUpdateOne) ClearVariants() *ProductUpdateOne {
+	puo.mutation.ClearVariants()
+	return puo
+}
+
+// RemoveVariantIDs removes the "variants" edge to ProductVariant entities by IDs.
+func (puo *ProductUpdateOne) RemoveVariantIDs(ids ...string) *ProductUpdateOne {
+	puo.mutation.RemoveVariantIDs(ids...)
+	return puo
+}
+
+// RemoveVariants removes "variants" edges to ProductVariant entities.
+func (puo *ProductUpdateOne) RemoveVariants(p ...*ProductVariant) *ProductUpdateOne {
+	ids := make([]string, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveVariantIDs(ids...)
+}
+
 // Where appends a list predicates to the ProductUpdate builder.
 func (puo *ProductUpdateOne) Where(ps ...predicate.Product) *ProductUpdateOne {
 	puo.mutation.Where(ps...)
@@ -1138,15 +1433,25 @@ func (puo *ProductUpdateOne) check() error {
 		}
 	}
 	if v, ok := puo.mutation.Price(); ok {
-		if err := product.PriceValidator(v); err != nil {
+		if err := product.PriceValidator(int64(v)); err != nil {
 			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "Product.price": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Stock(); ok {
+		if err := product.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "Product.stock": %w`, err)}
+		}
+	}
 	if v, ok := puo.mutation.Sku(); ok {
 		if err := product.SkuValidator(v); err != nil {
 			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "Product.sku": %w`, err)}
 		}
 	}
+	if v, ok := puo.mutation.Weight(); ok {
+		if err := product.WeightValidator(v); err != nil {
+			return &ValidationError{Name: "weight", err: fmt.Errorf(`ent: validator failed for field "Product.weight": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1189,19 +1494,19 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		_spec.SetField(product.FieldDescription, field.TypeString, value)
 	}
 	if value, ok := puo.mutation.Price(); ok {
-		_spec.SetField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := puo.mutation.AddedPrice(); ok {
-		_spec.AddField(product.FieldPrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldPrice, field.TypeInt64, value)
 	}
 	if value, ok := puo.mutation.SalePrice(); ok {
-		_spec.SetField(product.FieldSalePrice, field.TypeFloat64, value)
+		_spec.SetField(product.FieldSalePrice, field.TypeInt64, value)
 	}
 	if value, ok := puo.mutation.AddedSalePrice(); ok {
-		_spec.AddField(product.FieldSalePrice, field.TypeFloat64, value)
+		_spec.AddField(product.FieldSalePrice, field.TypeInt64, value)
 	}
 	if puo.mutation.SalePriceCleared() {
-		_spec.ClearField(product.FieldSalePrice, field.TypeFloat64)
+		_spec.ClearField(product.FieldSalePrice, field.TypeInt64)
 	}
 	if value, ok := puo.mutation.OnSale(); ok {
 		_spec.SetField(product.FieldOnSale, field.TypeBool, value)
@@ -1215,6 +1520,12 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 	if value, ok := puo.mutation.Sku(); ok {
 		_spec.SetField(product.FieldSku, field.TypeString, value)
 	}
+	if value, ok := puo.mutation.Weight(); ok {
+		_spec.SetField(product.FieldWeight, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedWeight(); ok {
+		_spec.AddField(product.FieldWeight, field.TypeInt, value)
+	}
 	if value, ok := puo.mutation.Images(); ok {
 		_spec.SetField(product.FieldImages, field.TypeJSON, value)
 	}
@@ -1405,6 +1716,96 @@ func (puo *ProductUpdateOne) sqlSave(ctx context.Context) (_node *Product, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if puo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedOptionsIDs(); len(nodes) > 0 && !puo.mutation.OptionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OptionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.OptionsTable,
+			Columns: []string{product.OptionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedVariantsIDs(); len(nodes) > 0 && !puo.mutation.VariantsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.VariantsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   product.VariantsTable,
+			Columns: []string{product.VariantsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Product{config: puo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+)
+
+// ProductOption is the model entity for the ProductOption schema.
+type ProductOption struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID string `json:"product_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Values holds the value of the "values" field.
+	Values []string `json:"values,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductOptionQuery when eager-loading is set.
+	Edges        ProductOptionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductOptionEdges holds the relations/edges for other nodes in the graph.
+type ProductOptionEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductOptionEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductOption) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productoption.FieldValues:
+			values[i] = new([]byte)
+		case productoption.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case productoption.FieldID, productoption.FieldProductID, productoption.FieldName:
+			values[i] = new(sql.NullString)
+		case productoption.FieldCreatedAt, productoption.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductOption fields.
+func (po *ProductOption) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productoption.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				po.ID = value.String
+			}
+		case productoption.FieldProductID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				po.ProductID = value.String
+			}
+		case productoption.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				po.Name = value.String
+			}
+		case productoption.FieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &po.Values); err != nil {
+					return fmt.Errorf("unmarshal field values: %w", err)
+				}
+			}
+		case productoption.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				po.Position = int(value.Int64)
+			}
+		case productoption.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				po.CreatedAt = value.Time
+			}
+		case productoption.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				po.UpdatedAt = value.Time
+			}
+		default:
+			po.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProductOption.
+// This includes values selected through modifiers, order, etc.
+func (po *ProductOption) Value(name string) (ent.Value, error) {
+	return po.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the ProductOption entity.
+func (po *ProductOption) QueryProduct() *ProductQuery {
+	return NewProductOptionClient(po.config).QueryProduct(po)
+}
+
+// Update returns a builder for updating this ProductOption.
+// Note that you need to call ProductOption.Unwrap() before calling this method if this ProductOption
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (po *ProductOption) Update() *ProductOptionUpdateOne {
+	return NewProductOptionClient(po.config).UpdateOne(po)
+}
+
+// Unwrap unwraps the ProductOption entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (po *ProductOption) Unwrap() *ProductOption {
+	_tx, ok := po.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductOption is not a transactional entity")
+	}
+	po.config.driver = _tx.drv
+	return po
+}
+
+// String implements the fmt.Stringer.
+func (po *ProductOption) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductOption(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", po.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(po.ProductID)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(po.Name)
+	builder.WriteString(", ")
+	builder.WriteString("values=")
+	builder.WriteString(fmt.Sprintf("%v", po.Values))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", po.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(po.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(po.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductOptions is a parsable slice of ProductOption.
+type ProductOptions []*ProductOption
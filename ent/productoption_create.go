@@ -0,0 +1,335 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+)
+
+// ProductOptionCreate is the builder for creating a ProductOption entity.
+type ProductOptionCreate struct {
+	config
+	mutation *ProductOptionMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (poc *ProductOptionCreate) SetProductID(s string) *ProductOptionCreate {
+	poc.mutation.SetProductID(s)
+	return poc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (poc *ProductOptionCreate) SetNillableProductID(s *string) *ProductOptionCreate {
+	if s != nil {
+		poc.SetProductID(*s)
+	}
+	return poc
+}
+
+// SetName sets the "name" field.
+func (poc *ProductOptionCreate) SetName(s string) *ProductOptionCreate {
+	poc.mutation.SetName(s)
+	return poc
+}
+
+// SetValues sets the "values" field.
+func (poc *ProductOptionCreate) SetValues(s []string) *ProductOptionCreate {
+	poc.mutation.SetValues(s)
+	return poc
+}
+
+// SetPosition sets the "position" field.
+func (poc *ProductOptionCreate) SetPosition(i int) *ProductOptionCreate {
+	poc.mutation.SetPosition(i)
+	return poc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (poc *ProductOptionCreate) SetNillablePosition(i *int) *ProductOptionCreate {
+	if i != nil {
+		poc.SetPosition(*i)
+	}
+	return poc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (poc *ProductOptionCreate) SetCreatedAt(t time.Time) *ProductOptionCreate {
+	poc.mutation.SetCreatedAt(t)
+	return poc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (poc *ProductOptionCreate) SetNillableCreatedAt(t *time.Time) *ProductOptionCreate {
+	if t != nil {
+		poc.SetCreatedAt(*t)
+	}
+	return poc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (poc *ProductOptionCreate) SetUpdatedAt(t time.Time) *ProductOptionCreate {
+	poc.mutation.SetUpdatedAt(t)
+	return poc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (poc *ProductOptionCreate) SetNillableUpdatedAt(t *time.Time) *ProductOptionCreate {
+	if t != nil {
+		poc.SetUpdatedAt(*t)
+	}
+	return poc
+}
+
+// SetID sets the "id" field.
+func (poc *ProductOptionCreate) SetID(s string) *ProductOptionCreate {
+	poc.mutation.SetID(s)
+	return poc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (poc *ProductOptionCreate) SetProduct(p *Product) *ProductOptionCreate {
+	return poc.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductOptionMutation object of the builder.
+func (poc *ProductOptionCreate) Mutation() *ProductOptionMutation {
+	return poc.mutation
+}
+
+// Save creates the ProductOption in the database.
+func (poc *ProductOptionCreate) Save(ctx context.Context) (*ProductOption, error) {
+	poc.defaults()
+	return withHooks(ctx, poc.sqlSave, poc.mutation, poc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (poc *ProductOptionCreate) SaveX(ctx context.Context) *ProductOption {
+	v, err := poc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (poc *ProductOptionCreate) Exec(ctx context.Context) error {
+	_, err := poc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (poc *ProductOptionCreate) ExecX(ctx context.Context) {
+	if err := poc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (poc *ProductOptionCreate) defaults() {
+	if _, ok := poc.mutation.Values(); !ok {
+		v := productoption.DefaultValues
+		poc.mutation.SetValues(v)
+	}
+	if _, ok := poc.mutation.Position(); !ok {
+		v := productoption.DefaultPosition
+		poc.mutation.SetPosition(v)
+	}
+	if _, ok := poc.mutation.CreatedAt(); !ok {
+		v := productoption.DefaultCreatedAt()
+		poc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := poc.mutation.UpdatedAt(); !ok {
+		v := productoption.DefaultUpdatedAt()
+		poc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (poc *ProductOptionCreate) check() error {
+	if _, ok := poc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProductOption.name"`)}
+	}
+	if v, ok := poc.mutation.Name(); ok {
+		if err := productoption.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductOption.name": %w`, err)}
+		}
+	}
+	if _, ok := poc.mutation.Values(); !ok {
+		return &ValidationError{Name: "values", err: errors.New(`ent: missing required field "ProductOption.values"`)}
+	}
+	if _, ok := poc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProductOption.position"`)}
+	}
+	if _, ok := poc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductOption.created_at"`)}
+	}
+	if _, ok := poc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductOption.updated_at"`)}
+	}
+	return nil
+}
+
+func (poc *ProductOptionCreate) sqlSave(ctx context.Context) (*ProductOption, error) {
+	if err := poc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := poc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, poc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ProductOption.ID type: %T", _spec.ID.Value)
+		}
+	}
+	poc.mutation.id = &_node.ID
+	poc.mutation.done = true
+	return _node, nil
+}
+
+func (poc *ProductOptionCreate) createSpec() (*ProductOption, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductOption{config: poc.config}
+		_spec = sqlgraph.NewCreateSpec(productoption.Table, sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString))
+	)
+	if id, ok := poc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := poc.mutation.Name(); ok {
+		_spec.SetField(productoption.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := poc.mutation.Values(); ok {
+		_spec.SetField(productoption.FieldValues, field.TypeJSON, value)
+		_node.Values = value
+	}
+	if value, ok := poc.mutation.Position(); ok {
+		_spec.SetField(productoption.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := poc.mutation.CreatedAt(); ok {
+		_spec.SetField(productoption.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := poc.mutation.UpdatedAt(); ok {
+		_spec.SetField(productoption.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := poc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productoption.ProductTable,
+			Columns: []string{productoption.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductOptionCreateBulk is the builder for creating many ProductOption entities in bulk.
+type ProductOptionCreateBulk struct {
+	config
+	err      error
+	builders []*ProductOptionCreate
+}
+
+// Save creates the ProductOption entities in the database.
+func (pocb *ProductOptionCreateBulk) Save(ctx context.Context) ([]*ProductOption, error) {
+	if pocb.err != nil {
+		return nil, pocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pocb.builders))
+	nodes := make([]*ProductOption, len(pocb.builders))
+	mutators := make([]Mutator, len(pocb.builders))
+	for i := range pocb.builders {
+		func(i int, root context.Context) {
+			builder := pocb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductOptionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pocb *ProductOptionCreateBulk) SaveX(ctx context.Context) []*ProductOption {
+	v, err := pocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pocb *ProductOptionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pocb *ProductOptionCreateBulk) ExecX(ctx context.Context) {
+	if err := pocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
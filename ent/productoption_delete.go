@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+)
+
+// ProductOptionDelete is the builder for deleting a ProductOption entity.
+type ProductOptionDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductOptionMutation
+}
+
+// Where appends a list predicates to the ProductOptionDelete builder.
+func (pod *ProductOptionDelete) Where(ps ...predicate.ProductOption) *ProductOptionDelete {
+	pod.mutation.Where(ps...)
+	return pod
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pod *ProductOptionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pod.sqlExec, pod.mutation, pod.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pod *ProductOptionDelete) ExecX(ctx context.Context) int {
+	n, err := pod.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pod *ProductOptionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(productoption.Table, sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString))
+	if ps := pod.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pod.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pod.mutation.done = true
+	return affected, err
+}
+
+// ProductOptionDeleteOne is the builder for deleting a single ProductOption entity.
+type ProductOptionDeleteOne struct {
+	pod *ProductOptionDelete
+}
+
+// Where appends a list predicates to the ProductOptionDelete builder.
+func (podo *ProductOptionDeleteOne) Where(ps ...predicate.ProductOption) *ProductOptionDeleteOne {
+	podo.pod.mutation.Where(ps...)
+	return podo
+}
+
+// Exec executes the deletion query.
+func (podo *ProductOptionDeleteOne) Exec(ctx context.Context) error {
+	n, err := podo.pod.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productoption.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (podo *ProductOptionDeleteOne) ExecX(ctx context.Context) {
+	if err := podo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+)
+
+// ProductOptionQuery is the builder for querying ProductOption entities.
+type ProductOptionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []productoption.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ProductOption
+	withProduct *ProductQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductOptionQuery builder.
+func (poq *ProductOptionQuery) Where(ps ...predicate.ProductOption) *ProductOptionQuery {
+	poq.predicates = append(poq.predicates, ps...)
+	return poq
+}
+
+// Limit the number of records to be returned by this query.
+func (poq *ProductOptionQuery) Limit(limit int) *ProductOptionQuery {
+	poq.ctx.Limit = &limit
+	return poq
+}
+
+// Offset to start from.
+func (poq *ProductOptionQuery) Offset(offset int) *ProductOptionQuery {
+	poq.ctx.Offset = &offset
+	return poq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (poq *ProductOptionQuery) Unique(unique bool) *ProductOptionQuery {
+	poq.ctx.Unique = &unique
+	return poq
+}
+
+// Order specifies how the records should be ordered.
+func (poq *ProductOptionQuery) Order(o ...productoption.OrderOption) *ProductOptionQuery {
+	poq.order = append(poq.order, o...)
+	return poq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (poq *ProductOptionQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: poq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := poq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := poq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productoption.Table, productoption.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productoption.ProductTable, productoption.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(poq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductOption entity from the query.
+// Returns a *NotFoundError when no ProductOption was found.
+func (poq *ProductOptionQuery) First(ctx context.Context) (*ProductOption, error) {
+	nodes, err := poq.Limit(1).All(setContextOp(ctx, poq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productoption.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (poq *ProductOptionQuery) FirstX(ctx context.Context) *ProductOption {
+	node, err := poq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductOption ID from the query.
+// Returns a *NotFoundError when no ProductOption ID was found.
+func (poq *ProductOptionQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = poq.Limit(1).IDs(setContextOp(ctx, poq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productoption.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (poq *ProductOptionQuery) FirstIDX(ctx context.Context) string {
+	id, err := poq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductOption entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductOption entity is found.
+// Returns a *NotFoundError when no ProductOption entities are found.
+func (poq *ProductOptionQuery) Only(ctx context.Context) (*ProductOption, error) {
+	nodes, err := poq.Limit(2).All(setContextOp(ctx, poq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productoption.Label}
+	default:
+		return nil, &NotSingularError{productoption.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (poq *ProductOptionQuery) OnlyX(ctx context.Context) *ProductOption {
+	node, err := poq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductOption ID in the query.
+// Returns a *NotSingularError when more than one ProductOption ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (poq *ProductOptionQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = poq.Limit(2).IDs(setContextOp(ctx, poq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productoption.Label}
+	default:
+		err = &NotSingularError{productoption.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (poq *ProductOptionQuery) OnlyIDX(ctx context.Context) string {
+	id, err := poq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductOptions.
+func (poq *ProductOptionQuery) All(ctx context.Context) ([]*ProductOption, error) {
+	ctx = setContextOp(ctx, poq.ctx, ent.OpQueryAll)
+	if err := poq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductOption, *ProductOptionQuery]()
+	return withInterceptors[[]*ProductOption](ctx, poq, qr, poq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (poq *ProductOptionQuery) AllX(ctx context.Context) []*ProductOption {
+	nodes, err := poq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductOption IDs.
+func (poq *ProductOptionQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if poq.ctx.Unique == nil && poq.path != nil {
+		poq.Unique(true)
+	}
+	ctx = setContextOp(ctx, poq.ctx, ent.OpQueryIDs)
+	if err = poq.Select(productoption.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (poq *ProductOptionQuery) IDsX(ctx context.Context) []string {
+	ids, err := poq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (poq *ProductOptionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, poq.ctx, ent.OpQueryCount)
+	if err := poq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, poq, querierCount[*ProductOptionQuery](), poq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (poq *ProductOptionQuery) CountX(ctx context.Context) int {
+	count, err := poq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (poq *ProductOptionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, poq.ctx, ent.OpQueryExist)
+	switch _, err := poq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (poq *ProductOptionQuery) ExistX(ctx context.Context) bool {
+	exist, err := poq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductOptionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (poq *ProductOptionQuery) Clone() *ProductOptionQuery {
+	if poq == nil {
+		return nil
+	}
+	return &ProductOptionQuery{
+		config:      poq.config,
+		ctx:         poq.ctx.Clone(),
+		order:       append([]productoption.OrderOption{}, poq.order...),
+		inters:      append([]Interceptor{}, poq.inters...),
+		predicates:  append([]predicate.ProductOption{}, poq.predicates...),
+		withProduct: poq.withProduct.Clone(),
+		// clone intermediate query.
+		sql:  poq.sql.Clone(),
+		path: poq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (poq *ProductOptionQuery) WithProduct(opts ...func(*ProductQuery)) *ProductOptionQuery {
+	query := (&ProductClient{config: poq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	poq.withProduct = query
+	return poq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID string `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductOption.Query().
+//		GroupBy(productoption.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (poq *ProductOptionQuery) GroupBy(field string, fields ...string) *ProductOptionGroupBy {
+	poq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductOptionGroupBy{build: poq}
+	grbuild.flds = &poq.ctx.Fields
+	grbuild.label = productoption.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID string `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductOption.Query().
+//		Select(productoption.FieldProductID).
+//		Scan(ctx, &v)
+func (poq *ProductOptionQuery) Select(fields ...string) *ProductOptionSelect {
+	poq.ctx.Fields = append(poq.ctx.Fields, fields...)
+	sbuild := &ProductOptionSelect{ProductOptionQuery: poq}
+	sbuild.label = productoption.Label
+	sbuild.flds, sbuild.scan = &poq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductOptionSelect configured with the given aggregations.
+func (poq *ProductOptionQuery) Aggregate(fns ...AggregateFunc) *ProductOptionSelect {
+	return poq.Select().Aggregate(fns...)
+}
+
+func (poq *ProductOptionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range poq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, poq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range poq.ctx.Fields {
+		if !productoption.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if poq.path != nil {
+		prev, err := poq.path(ctx)
+		if err != nil {
+			return err
+		}
+		poq.sql = prev
+	}
+	return nil
+}
+
+func (poq *ProductOptionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductOption, error) {
+	var (
+		nodes       = []*ProductOption{}
+		_spec       = poq.querySpec()
+		loadedTypes = [1]bool{
+			poq.withProduct != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductOption).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductOption{config: poq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, poq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := poq.withProduct; query != nil {
+		if err := poq.loadProduct(ctx, query, nodes, nil,
+			func(n *ProductOption, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (poq *ProductOptionQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*ProductOption, init func(*ProductOption), assign func(*ProductOption, *Product)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProductOption)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (poq *ProductOptionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := poq.querySpec()
+	_spec.Node.Columns = poq.ctx.Fields
+	if len(poq.ctx.Fields) > 0 {
+		_spec.Unique = poq.ctx.Unique != nil && *poq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, poq.driver, _spec)
+}
+
+func (poq *ProductOptionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productoption.Table, productoption.Columns, sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString))
+	_spec.From = poq.sql
+	if unique := poq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if poq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := poq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productoption.FieldID)
+		for i := range fields {
+			if fields[i] != productoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if poq.withProduct != nil {
+			_spec.Node.AddColumnOnce(productoption.FieldProductID)
+		}
+	}
+	if ps := poq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := poq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := poq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := poq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (poq *ProductOptionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(poq.driver.Dialect())
+	t1 := builder.Table(productoption.Table)
+	columns := poq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productoption.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if poq.sql != nil {
+		selector = poq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if poq.ctx.Unique != nil && *poq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range poq.predicates {
+		p(selector)
+	}
+	for _, p := range poq.order {
+		p(selector)
+	}
+	if offset := poq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := poq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProductOptionGroupBy is the group-by builder for ProductOption entities.
+type ProductOptionGroupBy struct {
+	selector
+	build *ProductOptionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pogb *ProductOptionGroupBy) Aggregate(fns ...AggregateFunc) *ProductOptionGroupBy {
+	pogb.fns = append(pogb.fns, fns...)
+	return pogb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pogb *ProductOptionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pogb.build.ctx, ent.OpQueryGroupBy)
+	if err := pogb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductOptionQuery, *ProductOptionGroupBy](ctx, pogb.build, pogb, pogb.build.inters, v)
+}
+
+func (pogb *ProductOptionGroupBy) sqlScan(ctx context.Context, root *ProductOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pogb.fns))
+	for _, fn := range pogb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pogb.flds)+len(pogb.fns))
+		for _, f := range *pogb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pogb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pogb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductOptionSelect is the builder for selecting fields of ProductOption entities.
+type ProductOptionSelect struct {
+	*ProductOptionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pos *ProductOptionSelect) Aggregate(fns ...AggregateFunc) *ProductOptionSelect {
+	pos.fns = append(pos.fns, fns...)
+	return pos
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pos *ProductOptionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pos.ctx, ent.OpQuerySelect)
+	if err := pos.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductOptionQuery, *ProductOptionSelect](ctx, pos.ProductOptionQuery, pos, pos.inters, v)
+}
+
+func (pos *ProductOptionSelect) sqlScan(ctx context.Context, root *ProductOptionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pos.fns))
+	for _, fn := range pos.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pos.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pos.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
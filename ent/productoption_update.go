@@ -0,0 +1,522 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+)
+
+// ProductOptionUpdate is the builder for updating ProductOption entities.
+type ProductOptionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductOptionMutation
+}
+
+// Where appends a list predicates to the ProductOptionUpdate builder.
+func (pou *ProductOptionUpdate) Where(ps ...predicate.ProductOption) *ProductOptionUpdate {
+	pou.mutation.Where(ps...)
+	return pou
+}
+
+// SetProductID sets the "product_id" field.
+func (pou *ProductOptionUpdate) SetProductID(s string) *ProductOptionUpdate {
+	pou.mutation.SetProductID(s)
+	return pou
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pou *ProductOptionUpdate) SetNillableProductID(s *string) *ProductOptionUpdate {
+	if s != nil {
+		pou.SetProductID(*s)
+	}
+	return pou
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (pou *ProductOptionUpdate) ClearProductID() *ProductOptionUpdate {
+	pou.mutation.ClearProductID()
+	return pou
+}
+
+// SetName sets the "name" field.
+func (pou *ProductOptionUpdate) SetName(s string) *ProductOptionUpdate {
+	pou.mutation.SetName(s)
+	return pou
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pou *ProductOptionUpdate) SetNillableName(s *string) *ProductOptionUpdate {
+	if s != nil {
+		pou.SetName(*s)
+	}
+	return pou
+}
+
+// SetValues sets the "values" field.
+func (pou *ProductOptionUpdate) SetValues(s []string) *ProductOptionUpdate {
+	pou.mutation.SetValues(s)
+	return pou
+}
+
+// AppendValues appends s to the "values" field.
+func (pou *ProductOptionUpdate) AppendValues(s []string) *ProductOptionUpdate {
+	pou.mutation.AppendValues(s)
+	return pou
+}
+
+// SetPosition sets the "position" field.
+func (pou *ProductOptionUpdate) SetPosition(i int) *ProductOptionUpdate {
+	pou.mutation.ResetPosition()
+	pou.mutation.SetPosition(i)
+	return pou
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pou *ProductOptionUpdate) SetNillablePosition(i *int) *ProductOptionUpdate {
+	if i != nil {
+		pou.SetPosition(*i)
+	}
+	return pou
+}
+
+// AddPosition adds i to the "position" field.
+func (pou *ProductOptionUpdate) AddPosition(i int) *ProductOptionUpdate {
+	pou.mutation.AddPosition(i)
+	return pou
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pou *ProductOptionUpdate) SetCreatedAt(t time.Time) *ProductOptionUpdate {
+	pou.mutation.SetCreatedAt(t)
+	return pou
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pou *ProductOptionUpdate) SetNillableCreatedAt(t *time.Time) *ProductOptionUpdate {
+	if t != nil {
+		pou.SetCreatedAt(*t)
+	}
+	return pou
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pou *ProductOptionUpdate) SetUpdatedAt(t time.Time) *ProductOptionUpdate {
+	pou.mutation.SetUpdatedAt(t)
+	return pou
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pou *ProductOptionUpdate) SetProduct(p *Product) *ProductOptionUpdate {
+	return pou.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductOptionMutation object of the builder.
+func (pou *ProductOptionUpdate) Mutation() *ProductOptionMutation {
+	return pou.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pou *ProductOptionUpdate) ClearProduct() *ProductOptionUpdate {
+	pou.mutation.ClearProduct()
+	return pou
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pou *ProductOptionUpdate) Save(ctx context.Context) (int, error) {
+	pou.defaults()
+	return withHooks(ctx, pou.sqlSave, pou.mutation, pou.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pou *ProductOptionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pou.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pou *ProductOptionUpdate) Exec(ctx context.Context) error {
+	_, err := pou.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pou *ProductOptionUpdate) ExecX(ctx context.Context) {
+	if err := pou.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pou *ProductOptionUpdate) defaults() {
+	if _, ok := pou.mutation.UpdatedAt(); !ok {
+		v := productoption.UpdateDefaultUpdatedAt()
+		pou.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pou *ProductOptionUpdate) check() error {
+	if v, ok := pou.mutation.Name(); ok {
+		if err := productoption.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductOption.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pou *ProductOptionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pou.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productoption.Table, productoption.Columns, sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString))
+	if ps := pou.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pou.mutation.Name(); ok {
+		_spec.SetField(productoption.FieldName, field.TypeString, value)
+	}
+	if value, ok := pou.mutation.Values(); ok {
+		_spec.SetField(productoption.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := pou.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productoption.FieldValues, value)
+		})
+	}
+	if value, ok := pou.mutation.Position(); ok {
+		_spec.SetField(productoption.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pou.mutation.AddedPosition(); ok {
+		_spec.AddField(productoption.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pou.mutation.CreatedAt(); ok {
+		_spec.SetField(productoption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pou.mutation.UpdatedAt(); ok {
+		_spec.SetField(productoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pou.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productoption.ProductTable,
+			Columns: []string{productoption.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pou.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productoption.ProductTable,
+			Columns: []string{productoption.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pou.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pou.mutation.done = true
+	return n, nil
+}
+
+// ProductOptionUpdateOne is the builder for updating a single ProductOption entity.
+type ProductOptionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductOptionMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (pouo *ProductOptionUpdateOne) SetProductID(s string) *ProductOptionUpdateOne {
+	pouo.mutation.SetProductID(s)
+	return pouo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pouo *ProductOptionUpdateOne) SetNillableProductID(s *string) *ProductOptionUpdateOne {
+	if s != nil {
+		pouo.SetProductID(*s)
+	}
+	return pouo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (pouo *ProductOptionUpdateOne) ClearProductID() *ProductOptionUpdateOne {
+	pouo.mutation.ClearProductID()
+	return pouo
+}
+
+// SetName sets the "name" field.
+func (pouo *ProductOptionUpdateOne) SetName(s string) *ProductOptionUpdateOne {
+	pouo.mutation.SetName(s)
+	return pouo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pouo *ProductOptionUpdateOne) SetNillableName(s *string) *ProductOptionUpdateOne {
+	if s != nil {
+		pouo.SetName(*s)
+	}
+	return pouo
+}
+
+// SetValues sets the "values" field.
+func (pouo *ProductOptionUpdateOne) SetValues(s []string) *ProductOptionUpdateOne {
+	pouo.mutation.SetValues(s)
+	return pouo
+}
+
+// AppendValues appends s to the "values" field.
+func (pouo *ProductOptionUpdateOne) AppendValues(s []string) *ProductOptionUpdateOne {
+	pouo.mutation.AppendValues(s)
+	return pouo
+}
+
+// SetPosition sets the "position" field.
+func (pouo *ProductOptionUpdateOne) SetPosition(i int) *ProductOptionUpdateOne {
+	pouo.mutation.ResetPosition()
+	pouo.mutation.SetPosition(i)
+	return pouo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pouo *ProductOptionUpdateOne) SetNillablePosition(i *int) *ProductOptionUpdateOne {
+	if i != nil {
+		pouo.SetPosition(*i)
+	}
+	return pouo
+}
+
+// AddPosition adds i to the "position" field.
+func (pouo *ProductOptionUpdateOne) AddPosition(i int) *ProductOptionUpdateOne {
+	pouo.mutation.AddPosition(i)
+	return pouo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pouo *ProductOptionUpdateOne) SetCreatedAt(t time.Time) *ProductOptionUpdateOne {
+	pouo.mutation.SetCreatedAt(t)
+	return pouo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pouo *ProductOptionUpdateOne) SetNillableCreatedAt(t *time.Time) *ProductOptionUpdateOne {
+	if t != nil {
+		pouo.SetCreatedAt(*t)
+	}
+	return pouo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pouo *ProductOptionUpdateOne) SetUpdatedAt(t time.Time) *ProductOptionUpdateOne {
+	pouo.mutation.SetUpdatedAt(t)
+	return pouo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pouo *ProductOptionUpdateOne) SetProduct(p *Product) *ProductOptionUpdateOne {
+	return pouo.SetProductID(p.ID)
+}
+
+// Mutation returns the ProductOptionMutation object of the builder.
+func (pouo *ProductOptionUpdateOne) Mutation() *ProductOptionMutation {
+	return pouo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pouo *ProductOptionUpdateOne) ClearProduct() *ProductOptionUpdateOne {
+	pouo.mutation.ClearProduct()
+	return pouo
+}
+
+// Where appends a list predicates to the ProductOptionUpdate builder.
+func (pouo *ProductOptionUpdateOne) Where(ps ...predicate.ProductOption) *ProductOptionUpdateOne {
+	pouo.mutation.Where(ps...)
+	return pouo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pouo *ProductOptionUpdateOne) Select(field string, fields ...string) *ProductOptionUpdateOne {
+	pouo.fields = append([]string{field}, fields...)
+	return pouo
+}
+
+// Save executes the query and returns the updated ProductOption entity.
+func (pouo *ProductOptionUpdateOne) Save(ctx context.Context) (*ProductOption, error) {
+	pouo.defaults()
+	return withHooks(ctx, pouo.sqlSave, pouo.mutation, pouo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pouo *ProductOptionUpdateOne) SaveX(ctx context.Context) *ProductOption {
+	node, err := pouo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pouo *ProductOptionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pouo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pouo *ProductOptionUpdateOne) ExecX(ctx context.Context) {
+	if err := pouo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pouo *ProductOptionUpdateOne) defaults() {
+	if _, ok := pouo.mutation.UpdatedAt(); !ok {
+		v := productoption.UpdateDefaultUpdatedAt()
+		pouo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pouo *ProductOptionUpdateOne) check() error {
+	if v, ok := pouo.mutation.Name(); ok {
+		if err := productoption.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ProductOption.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pouo *ProductOptionUpdateOne) sqlSave(ctx context.Context) (_node *ProductOption, err error) {
+	if err := pouo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productoption.Table, productoption.Columns, sqlgraph.NewFieldSpec(productoption.FieldID, field.TypeString))
+	id, ok := pouo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductOption.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pouo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productoption.FieldID)
+		for _, f := range fields {
+			if !productoption.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productoption.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pouo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pouo.mutation.Name(); ok {
+		_spec.SetField(productoption.FieldName, field.TypeString, value)
+	}
+	if value, ok := pouo.mutation.Values(); ok {
+		_spec.SetField(productoption.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := pouo.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productoption.FieldValues, value)
+		})
+	}
+	if value, ok := pouo.mutation.Position(); ok {
+		_spec.SetField(productoption.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pouo.mutation.AddedPosition(); ok {
+		_spec.AddField(productoption.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pouo.mutation.CreatedAt(); ok {
+		_spec.SetField(productoption.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pouo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productoption.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pouo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productoption.ProductTable,
+			Columns: []string{productoption.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pouo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productoption.ProductTable,
+			Columns: []string{productoption.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductOption{config: pouo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pouo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productoption.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pouo.mutation.done = true
+	return _node, nil
+}
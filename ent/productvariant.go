@@ -0,0 +1,279 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ProductVariant is the model entity for the ProductVariant schema.
+type ProductVariant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID string `json:"product_id,omitempty"`
+	// Sku holds the value of the "sku" field.
+	Sku string `json:"sku,omitempty"`
+	// Options holds the value of the "options" field.
+	Options map[string]string `json:"options,omitempty"`
+	// Combination holds the value of the "combination" field.
+	Combination string `json:"combination,omitempty"`
+	// Price holds the value of the "price" field.
+	Price *money.Amount `json:"price,omitempty"`
+	// Stock holds the value of the "stock" field.
+	Stock int `json:"stock,omitempty"`
+	// Images holds the value of the "images" field.
+	Images []string `json:"images,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ProductVariantQuery when eager-loading is set.
+	Edges        ProductVariantEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ProductVariantEdges holds the relations/edges for other nodes in the graph.
+type ProductVariantEdges struct {
+	// Product holds the value of the product edge.
+	Product *Product `json:"product,omitempty"`
+	// CartItems holds the value of the cart_items edge.
+	CartItems []*CartItem `json:"cart_items,omitempty"`
+	// OrderItems holds the value of the order_items edge.
+	OrderItems []*OrderItem `json:"order_items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// ProductOrErr returns the Product value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ProductVariantEdges) ProductOrErr() (*Product, error) {
+	if e.Product != nil {
+		return e.Product, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: product.Label}
+	}
+	return nil, &NotLoadedError{edge: "product"}
+}
+
+// CartItemsOrErr returns the CartItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductVariantEdges) CartItemsOrErr() ([]*CartItem, error) {
+	if e.loadedTypes[1] {
+		return e.CartItems, nil
+	}
+	return nil, &NotLoadedError{edge: "cart_items"}
+}
+
+// OrderItemsOrErr returns the OrderItems value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProductVariantEdges) OrderItemsOrErr() ([]*OrderItem, error) {
+	if e.loadedTypes[2] {
+		return e.OrderItems, nil
+	}
+	return nil, &NotLoadedError{edge: "order_items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProductVariant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case productvariant.FieldPrice:
+			values[i] = &sql.NullScanner{S: new(money.Amount)}
+		case productvariant.FieldOptions, productvariant.FieldImages:
+			values[i] = new([]byte)
+		case productvariant.FieldStock, productvariant.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case productvariant.FieldID, productvariant.FieldProductID, productvariant.FieldSku, productvariant.FieldCombination:
+			values[i] = new(sql.NullString)
+		case productvariant.FieldCreatedAt, productvariant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProductVariant fields.
+func (pv *ProductVariant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case productvariant.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				pv.ID = value.String
+			}
+		case productvariant.FieldProductID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				pv.ProductID = value.String
+			}
+		case productvariant.FieldSku:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sku", values[i])
+			} else if value.Valid {
+				pv.Sku = value.String
+			}
+		case productvariant.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case productvariant.FieldCombination:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field combination", values[i])
+			} else if value.Valid {
+				pv.Combination = value.String
+			}
+		case productvariant.FieldPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				pv.Price = new(money.Amount)
+				*pv.Price = *value.S.(*money.Amount)
+			}
+		case productvariant.FieldStock:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stock", values[i])
+			} else if value.Valid {
+				pv.Stock = int(value.Int64)
+			}
+		case productvariant.FieldImages:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field images", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pv.Images); err != nil {
+					return fmt.Errorf("unmarshal field images: %w", err)
+				}
+			}
+		case productvariant.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				pv.Position = int(value.Int64)
+			}
+		case productvariant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pv.CreatedAt = value.Time
+			}
+		case productvariant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pv.UpdatedAt = value.Time
+			}
+		default:
+			pv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProductVariant.
+// This includes values selected through modifiers, order, etc.
+func (pv *ProductVariant) Value(name string) (ent.Value, error) {
+	return pv.selectValues.Get(name)
+}
+
+// QueryProduct queries the "product" edge of the ProductVariant entity.
+func (pv *ProductVariant) QueryProduct() *ProductQuery {
+	return NewProductVariantClient(pv.config).QueryProduct(pv)
+}
+
+// QueryCartItems queries the "cart_items" edge of the ProductVariant entity.
+func (pv *ProductVariant) QueryCartItems() *CartItemQuery {
+	return NewProductVariantClient(pv.config).QueryCartItems(pv)
+}
+
+// QueryOrderItems queries the "order_items" edge of the ProductVariant entity.
+func (pv *ProductVariant) QueryOrderItems() *OrderItemQuery {
+	return NewProductVariantClient(pv.config).QueryOrderItems(pv)
+}
+
+// Update returns a builder for updating this ProductVariant.
+// Note that you need to call ProductVariant.Unwrap() before calling this method if this ProductVariant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pv *ProductVariant) Update() *ProductVariantUpdateOne {
+	return NewProductVariantClient(pv.config).UpdateOne(pv)
+}
+
+// Unwrap unwraps the ProductVariant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pv *ProductVariant) Unwrap() *ProductVariant {
+	_tx, ok := pv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProductVariant is not a transactional entity")
+	}
+	pv.config.driver = _tx.drv
+	return pv
+}
+
+// String implements the fmt.Stringer.
+func (pv *ProductVariant) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProductVariant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pv.ID))
+	builder.WriteString("product_id=")
+	builder.WriteString(pv.ProductID)
+	builder.WriteString(", ")
+	builder.WriteString("sku=")
+	builder.WriteString(pv.Sku)
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Options))
+	builder.WriteString(", ")
+	builder.WriteString("combination=")
+	builder.WriteString(pv.Combination)
+	builder.WriteString(", ")
+	if v := pv.Price; v != nil {
+		builder.WriteString("price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("stock=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Stock))
+	builder.WriteString(", ")
+	builder.WriteString("images=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Images))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", pv.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pv.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pv.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProductVariants is a parsable slice of ProductVariant.
+type ProductVariants []*ProductVariant
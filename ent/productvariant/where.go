@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package productvariant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldID, id))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldProductID, v))
+}
+
+// Sku applies equality check predicate on the "sku" field. It's identical to SkuEQ.
+func Sku(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// Combination applies equality check predicate on the "combination" field. It's identical to CombinationEQ.
+func Combination(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCombination, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldPrice, v))
+}
+
+// Stock applies equality check predicate on the "stock" field. It's identical to StockEQ.
+func Stock(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldStock, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldProductID, v))
+}
+
+// ProductIDContains applies the Contains predicate on the "product_id" field.
+func ProductIDContains(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContains(FieldProductID, v))
+}
+
+// ProductIDHasPrefix applies the HasPrefix predicate on the "product_id" field.
+func ProductIDHasPrefix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasPrefix(FieldProductID, v))
+}
+
+// ProductIDHasSuffix applies the HasSuffix predicate on the "product_id" field.
+func ProductIDHasSuffix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasSuffix(FieldProductID, v))
+}
+
+// ProductIDIsNil applies the IsNil predicate on the "product_id" field.
+func ProductIDIsNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIsNull(FieldProductID))
+}
+
+// ProductIDNotNil applies the NotNil predicate on the "product_id" field.
+func ProductIDNotNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotNull(FieldProductID))
+}
+
+// ProductIDEqualFold applies the EqualFold predicate on the "product_id" field.
+func ProductIDEqualFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldProductID, v))
+}
+
+// ProductIDContainsFold applies the ContainsFold predicate on the "product_id" field.
+func ProductIDContainsFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldProductID, v))
+}
+
+// SkuEQ applies the EQ predicate on the "sku" field.
+func SkuEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldSku, v))
+}
+
+// SkuNEQ applies the NEQ predicate on the "sku" field.
+func SkuNEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldSku, v))
+}
+
+// SkuIn applies the In predicate on the "sku" field.
+func SkuIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldSku, vs...))
+}
+
+// SkuNotIn applies the NotIn predicate on the "sku" field.
+func SkuNotIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldSku, vs...))
+}
+
+// SkuGT applies the GT predicate on the "sku" field.
+func SkuGT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldSku, v))
+}
+
+// SkuGTE applies the GTE predicate on the "sku" field.
+func SkuGTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldSku, v))
+}
+
+// SkuLT applies the LT predicate on the "sku" field.
+func SkuLT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldSku, v))
+}
+
+// SkuLTE applies the LTE predicate on the "sku" field.
+func SkuLTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldSku, v))
+}
+
+// SkuContains applies the Contains predicate on the "sku" field.
+func SkuContains(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContains(FieldSku, v))
+}
+
+// SkuHasPrefix applies the HasPrefix predicate on the "sku" field.
+func SkuHasPrefix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasPrefix(FieldSku, v))
+}
+
+// SkuHasSuffix applies the HasSuffix predicate on the "sku" field.
+func SkuHasSuffix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasSuffix(FieldSku, v))
+}
+
+// SkuEqualFold applies the EqualFold predicate on the "sku" field.
+func SkuEqualFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldSku, v))
+}
+
+// SkuContainsFold applies the ContainsFold predicate on the "sku" field.
+func SkuContainsFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldSku, v))
+}
+
+// CombinationEQ applies the EQ predicate on the "combination" field.
+func CombinationEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCombination, v))
+}
+
+// CombinationNEQ applies the NEQ predicate on the "combination" field.
+func CombinationNEQ(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldCombination, v))
+}
+
+// CombinationIn applies the In predicate on the "combination" field.
+func CombinationIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldCombination, vs...))
+}
+
+// CombinationNotIn applies the NotIn predicate on the "combination" field.
+func CombinationNotIn(vs ...string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldCombination, vs...))
+}
+
+// CombinationGT applies the GT predicate on the "combination" field.
+func CombinationGT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldCombination, v))
+}
+
+// CombinationGTE applies the GTE predicate on the "combination" field.
+func CombinationGTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldCombination, v))
+}
+
+// CombinationLT applies the LT predicate on the "combination" field.
+func CombinationLT(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldCombination, v))
+}
+
+// CombinationLTE applies the LTE predicate on the "combination" field.
+func CombinationLTE(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldCombination, v))
+}
+
+// CombinationContains applies the Contains predicate on the "combination" field.
+func CombinationContains(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContains(FieldCombination, v))
+}
+
+// CombinationHasPrefix applies the HasPrefix predicate on the "combination" field.
+func CombinationHasPrefix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasPrefix(FieldCombination, v))
+}
+
+// CombinationHasSuffix applies the HasSuffix predicate on the "combination" field.
+func CombinationHasSuffix(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldHasSuffix(FieldCombination, v))
+}
+
+// CombinationEqualFold applies the EqualFold predicate on the "combination" field.
+func CombinationEqualFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEqualFold(FieldCombination, v))
+}
+
+// CombinationContainsFold applies the ContainsFold predicate on the "combination" field.
+func CombinationContainsFold(v string) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldContainsFold(FieldCombination, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v money.Amount) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldPrice, v))
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIsNull(FieldPrice))
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotNull(FieldPrice))
+}
+
+// StockEQ applies the EQ predicate on the "stock" field.
+func StockEQ(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldStock, v))
+}
+
+// StockNEQ applies the NEQ predicate on the "stock" field.
+func StockNEQ(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldStock, v))
+}
+
+// StockIn applies the In predicate on the "stock" field.
+func StockIn(vs ...int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldStock, vs...))
+}
+
+// StockNotIn applies the NotIn predicate on the "stock" field.
+func StockNotIn(vs ...int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldStock, vs...))
+}
+
+// StockGT applies the GT predicate on the "stock" field.
+func StockGT(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldStock, v))
+}
+
+// StockGTE applies the GTE predicate on the "stock" field.
+func StockGTE(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldStock, v))
+}
+
+// StockLT applies the LT predicate on the "stock" field.
+func StockLT(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldStock, v))
+}
+
+// StockLTE applies the LTE predicate on the "stock" field.
+func StockLTE(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldStock, v))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasProduct applies the HasEdge predicate on the "product" edge.
+func HasProduct() predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ProductTable, ProductColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasProductWith applies the HasEdge predicate on the "product" edge with a given conditions (other predicates).
+func HasProductWith(preds ...predicate.Product) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := newProductStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCartItems applies the HasEdge predicate on the "cart_items" edge.
+func HasCartItems() predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CartItemsTable, CartItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCartItemsWith applies the HasEdge predicate on the "cart_items" edge with a given conditions (other predicates).
+func HasCartItemsWith(preds ...predicate.CartItem) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := newCartItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOrderItems applies the HasEdge predicate on the "order_items" edge.
+func HasOrderItems() predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrderItemsTable, OrderItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrderItemsWith applies the HasEdge predicate on the "order_items" edge with a given conditions (other predicates).
+func HasOrderItemsWith(preds ...predicate.OrderItem) predicate.ProductVariant {
+	return predicate.ProductVariant(func(s *sql.Selector) {
+		step := newOrderItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProductVariant) predicate.ProductVariant {
+	return predicate.ProductVariant(sql.NotPredicates(p))
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ProductVariantCreate is the builder for creating a ProductVariant entity.
+type ProductVariantCreate struct {
+	config
+	mutation *ProductVariantMutation
+	hooks    []Hook
+}
+
+// SetProductID sets the "product_id" field.
+func (pvc *ProductVariantCreate) SetProductID(s string) *ProductVariantCreate {
+	pvc.mutation.SetProductID(s)
+	return pvc
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableProductID(s *string) *ProductVariantCreate {
+	if s != nil {
+		pvc.SetProductID(*s)
+	}
+	return pvc
+}
+
+// SetSku sets the "sku" field.
+func (pvc *ProductVariantCreate) SetSku(s string) *ProductVariantCreate {
+	pvc.mutation.SetSku(s)
+	return pvc
+}
+
+// SetOptions sets the "options" field.
+func (pvc *ProductVariantCreate) SetOptions(m map[string]string) *ProductVariantCreate {
+	pvc.mutation.SetOptions(m)
+	return pvc
+}
+
+// SetCombination sets the "combination" field.
+func (pvc *ProductVariantCreate) SetCombination(s string) *ProductVariantCreate {
+	pvc.mutation.SetCombination(s)
+	return pvc
+}
+
+// SetPrice sets the "price" field.
+func (pvc *ProductVariantCreate) SetPrice(m money.Amount) *ProductVariantCreate {
+	pvc.mutation.SetPrice(m)
+	return pvc
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillablePrice(m *money.Amount) *ProductVariantCreate {
+	if m != nil {
+		pvc.SetPrice(*m)
+	}
+	return pvc
+}
+
+// SetStock sets the "stock" field.
+func (pvc *ProductVariantCreate) SetStock(i int) *ProductVariantCreate {
+	pvc.mutation.SetStock(i)
+	return pvc
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableStock(i *int) *ProductVariantCreate {
+	if i != nil {
+		pvc.SetStock(*i)
+	}
+	return pvc
+}
+
+// SetImages sets the "images" field.
+func (pvc *ProductVariantCreate) SetImages(s []string) *ProductVariantCreate {
+	pvc.mutation.SetImages(s)
+	return pvc
+}
+
+// SetPosition sets the "position" field.
+func (pvc *ProductVariantCreate) SetPosition(i int) *ProductVariantCreate {
+	pvc.mutation.SetPosition(i)
+	return pvc
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillablePosition(i *int) *ProductVariantCreate {
+	if i != nil {
+		pvc.SetPosition(*i)
+	}
+	return pvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvc *ProductVariantCreate) SetCreatedAt(t time.Time) *ProductVariantCreate {
+	pvc.mutation.SetCreatedAt(t)
+	return pvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableCreatedAt(t *time.Time) *ProductVariantCreate {
+	if t != nil {
+		pvc.SetCreatedAt(*t)
+	}
+	return pvc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvc *ProductVariantCreate) SetUpdatedAt(t time.Time) *ProductVariantCreate {
+	pvc.mutation.SetUpdatedAt(t)
+	return pvc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pvc *ProductVariantCreate) SetNillableUpdatedAt(t *time.Time) *ProductVariantCreate {
+	if t != nil {
+		pvc.SetUpdatedAt(*t)
+	}
+	return pvc
+}
+
+// SetID sets the "id" field.
+func (pvc *ProductVariantCreate) SetID(s string) *ProductVariantCreate {
+	pvc.mutation.SetID(s)
+	return pvc
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pvc *ProductVariantCreate) SetProduct(p *Product) *ProductVariantCreate {
+	return pvc.SetProductID(p.ID)
+}
+
+// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
+func (pvc *ProductVariantCreate) AddCartItemIDs(ids ...string) *ProductVariantCreate {
+	pvc.mutation.AddCartItemIDs(ids...)
+	return pvc
+}
+
+// AddCartItems adds the "cart_items" edges to the CartItem entity.
+func (pvc *ProductVariantCreate) AddCartItems(c ...*CartItem) *ProductVariantCreate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pvc.AddCartItemIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (pvc *ProductVariantCreate) AddOrderItemIDs(ids ...string) *ProductVariantCreate {
+	pvc.mutation.AddOrderItemIDs(ids...)
+	return pvc
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (pvc *ProductVariantCreate) AddOrderItems(o ...*OrderItem) *ProductVariantCreate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pvc.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvc *ProductVariantCreate) Mutation() *ProductVariantMutation {
+	return pvc.mutation
+}
+
+// Save creates the ProductVariant in the database.
+func (pvc *ProductVariantCreate) Save(ctx context.Context) (*ProductVariant, error) {
+	pvc.defaults()
+	return withHooks(ctx, pvc.sqlSave, pvc.mutation, pvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pvc *ProductVariantCreate) SaveX(ctx context.Context) *ProductVariant {
+	v, err := pvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvc *ProductVariantCreate) Exec(ctx context.Context) error {
+	_, err := pvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvc *ProductVariantCreate) ExecX(ctx context.Context) {
+	if err := pvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvc *ProductVariantCreate) defaults() {
+	if _, ok := pvc.mutation.Options(); !ok {
+		v := productvariant.DefaultOptions
+		pvc.mutation.SetOptions(v)
+	}
+	if _, ok := pvc.mutation.Stock(); !ok {
+		v := productvariant.DefaultStock
+		pvc.mutation.SetStock(v)
+	}
+	if _, ok := pvc.mutation.Images(); !ok {
+		v := productvariant.DefaultImages
+		pvc.mutation.SetImages(v)
+	}
+	if _, ok := pvc.mutation.Position(); !ok {
+		v := productvariant.DefaultPosition
+		pvc.mutation.SetPosition(v)
+	}
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		v := productvariant.DefaultCreatedAt()
+		pvc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		v := productvariant.DefaultUpdatedAt()
+		pvc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvc *ProductVariantCreate) check() error {
+	if _, ok := pvc.mutation.Sku(); !ok {
+		return &ValidationError{Name: "sku", err: errors.New(`ent: missing required field "ProductVariant.sku"`)}
+	}
+	if v, ok := pvc.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Options(); !ok {
+		return &ValidationError{Name: "options", err: errors.New(`ent: missing required field "ProductVariant.options"`)}
+	}
+	if _, ok := pvc.mutation.Combination(); !ok {
+		return &ValidationError{Name: "combination", err: errors.New(`ent: missing required field "ProductVariant.combination"`)}
+	}
+	if v, ok := pvc.mutation.Combination(); ok {
+		if err := productvariant.CombinationValidator(v); err != nil {
+			return &ValidationError{Name: "combination", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.combination": %w`, err)}
+		}
+	}
+	if v, ok := pvc.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Stock(); !ok {
+		return &ValidationError{Name: "stock", err: errors.New(`ent: missing required field "ProductVariant.stock"`)}
+	}
+	if v, ok := pvc.mutation.Stock(); ok {
+		if err := productvariant.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.stock": %w`, err)}
+		}
+	}
+	if _, ok := pvc.mutation.Images(); !ok {
+		return &ValidationError{Name: "images", err: errors.New(`ent: missing required field "ProductVariant.images"`)}
+	}
+	if _, ok := pvc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "ProductVariant.position"`)}
+	}
+	if _, ok := pvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProductVariant.created_at"`)}
+	}
+	if _, ok := pvc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProductVariant.updated_at"`)}
+	}
+	return nil
+}
+
+func (pvc *ProductVariantCreate) sqlSave(ctx context.Context) (*ProductVariant, error) {
+	if err := pvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ProductVariant.ID type: %T", _spec.ID.Value)
+		}
+	}
+	pvc.mutation.id = &_node.ID
+	pvc.mutation.done = true
+	return _node, nil
+}
+
+func (pvc *ProductVariantCreate) createSpec() (*ProductVariant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProductVariant{config: pvc.config}
+		_spec = sqlgraph.NewCreateSpec(productvariant.Table, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString))
+	)
+	if id, ok := pvc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := pvc.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+		_node.Sku = value
+	}
+	if value, ok := pvc.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := pvc.mutation.Combination(); ok {
+		_spec.SetField(productvariant.FieldCombination, field.TypeString, value)
+		_node.Combination = value
+	}
+	if value, ok := pvc.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+		_node.Price = &value
+	}
+	if value, ok := pvc.mutation.Stock(); ok {
+		_spec.SetField(productvariant.FieldStock, field.TypeInt, value)
+		_node.Stock = value
+	}
+	if value, ok := pvc.mutation.Images(); ok {
+		_spec.SetField(productvariant.FieldImages, field.TypeJSON, value)
+		_node.Images = value
+	}
+	if value, ok := pvc.mutation.Position(); ok {
+		_spec.SetField(productvariant.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := pvc.mutation.CreatedAt(); ok {
+		_spec.SetField(productvariant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pvc.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := pvc.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.ProductTable,
+			Columns: []string{productvariant.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ProductID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pvc.mutation.CartItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pvc.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ProductVariantCreateBulk is the builder for creating many ProductVariant entities in bulk.
+type ProductVariantCreateBulk struct {
+	config
+	err      error
+	builders []*ProductVariantCreate
+}
+
+// Save creates the ProductVariant entities in the database.
+func (pvcb *ProductVariantCreateBulk) Save(ctx context.Context) ([]*ProductVariant, error) {
+	if pvcb.err != nil {
+		return nil, pvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pvcb.builders))
+	nodes := make([]*ProductVariant, len(pvcb.builders))
+	mutators := make([]Mutator, len(pvcb.builders))
+	for i := range pvcb.builders {
+		func(i int, root context.Context) {
+			builder := pvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProductVariantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvcb *ProductVariantCreateBulk) SaveX(ctx context.Context) []*ProductVariant {
+	v, err := pvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pvcb *ProductVariantCreateBulk) Exec(ctx context.Context) error {
+	_, err := pvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvcb *ProductVariantCreateBulk) ExecX(ctx context.Context) {
+	if err := pvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+)
+
+// ProductVariantDelete is the builder for deleting a ProductVariant entity.
+type ProductVariantDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProductVariantMutation
+}
+
+// Where appends a list predicates to the ProductVariantDelete builder.
+func (pvd *ProductVariantDelete) Where(ps ...predicate.ProductVariant) *ProductVariantDelete {
+	pvd.mutation.Where(ps...)
+	return pvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pvd *ProductVariantDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pvd.sqlExec, pvd.mutation, pvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvd *ProductVariantDelete) ExecX(ctx context.Context) int {
+	n, err := pvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pvd *ProductVariantDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(productvariant.Table, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString))
+	if ps := pvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pvd.mutation.done = true
+	return affected, err
+}
+
+// ProductVariantDeleteOne is the builder for deleting a single ProductVariant entity.
+type ProductVariantDeleteOne struct {
+	pvd *ProductVariantDelete
+}
+
+// Where appends a list predicates to the ProductVariantDelete builder.
+func (pvdo *ProductVariantDeleteOne) Where(ps ...predicate.ProductVariant) *ProductVariantDeleteOne {
+	pvdo.pvd.mutation.Where(ps...)
+	return pvdo
+}
+
+// Exec executes the deletion query.
+func (pvdo *ProductVariantDeleteOne) Exec(ctx context.Context) error {
+	n, err := pvdo.pvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{productvariant.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvdo *ProductVariantDeleteOne) ExecX(ctx context.Context) {
+	if err := pvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
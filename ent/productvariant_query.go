@@ -0,0 +1,755 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+)
+
+// ProductVariantQuery is the builder for querying ProductVariant entities.
+type ProductVariantQuery struct {
+	config
+	ctx            *QueryContext
+	order          []productvariant.OrderOption
+	inters         []Interceptor
+	predicates     []predicate.ProductVariant
+	withProduct    *ProductQuery
+	withCartItems  *CartItemQuery
+	withOrderItems *OrderItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProductVariantQuery builder.
+func (pvq *ProductVariantQuery) Where(ps ...predicate.ProductVariant) *ProductVariantQuery {
+	pvq.predicates = append(pvq.predicates, ps...)
+	return pvq
+}
+
+// Limit the number of records to be returned by this query.
+func (pvq *ProductVariantQuery) Limit(limit int) *ProductVariantQuery {
+	pvq.ctx.Limit = &limit
+	return pvq
+}
+
+// Offset to start from.
+func (pvq *ProductVariantQuery) Offset(offset int) *ProductVariantQuery {
+	pvq.ctx.Offset = &offset
+	return pvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pvq *ProductVariantQuery) Unique(unique bool) *ProductVariantQuery {
+	pvq.ctx.Unique = &unique
+	return pvq
+}
+
+// Order specifies how the records should be ordered.
+func (pvq *ProductVariantQuery) Order(o ...productvariant.OrderOption) *ProductVariantQuery {
+	pvq.order = append(pvq.order, o...)
+	return pvq
+}
+
+// QueryProduct chains the current query on the "product" edge.
+func (pvq *ProductVariantQuery) QueryProduct() *ProductQuery {
+	query := (&ProductClient{config: pvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productvariant.Table, productvariant.FieldID, selector),
+			sqlgraph.To(product.Table, product.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, productvariant.ProductTable, productvariant.ProductColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCartItems chains the current query on the "cart_items" edge.
+func (pvq *ProductVariantQuery) QueryCartItems() *CartItemQuery {
+	query := (&CartItemClient{config: pvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productvariant.Table, productvariant.FieldID, selector),
+			sqlgraph.To(cartitem.Table, cartitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productvariant.CartItemsTable, productvariant.CartItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOrderItems chains the current query on the "order_items" edge.
+func (pvq *ProductVariantQuery) QueryOrderItems() *OrderItemQuery {
+	query := (&OrderItemClient{config: pvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(productvariant.Table, productvariant.FieldID, selector),
+			sqlgraph.To(orderitem.Table, orderitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, productvariant.OrderItemsTable, productvariant.OrderItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ProductVariant entity from the query.
+// Returns a *NotFoundError when no ProductVariant was found.
+func (pvq *ProductVariantQuery) First(ctx context.Context) (*ProductVariant, error) {
+	nodes, err := pvq.Limit(1).All(setContextOp(ctx, pvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{productvariant.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pvq *ProductVariantQuery) FirstX(ctx context.Context) *ProductVariant {
+	node, err := pvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProductVariant ID from the query.
+// Returns a *NotFoundError when no ProductVariant ID was found.
+func (pvq *ProductVariantQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pvq.Limit(1).IDs(setContextOp(ctx, pvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{productvariant.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pvq *ProductVariantQuery) FirstIDX(ctx context.Context) string {
+	id, err := pvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProductVariant entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProductVariant entity is found.
+// Returns a *NotFoundError when no ProductVariant entities are found.
+func (pvq *ProductVariantQuery) Only(ctx context.Context) (*ProductVariant, error) {
+	nodes, err := pvq.Limit(2).All(setContextOp(ctx, pvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{productvariant.Label}
+	default:
+		return nil, &NotSingularError{productvariant.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pvq *ProductVariantQuery) OnlyX(ctx context.Context) *ProductVariant {
+	node, err := pvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProductVariant ID in the query.
+// Returns a *NotSingularError when more than one ProductVariant ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pvq *ProductVariantQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = pvq.Limit(2).IDs(setContextOp(ctx, pvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{productvariant.Label}
+	default:
+		err = &NotSingularError{productvariant.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pvq *ProductVariantQuery) OnlyIDX(ctx context.Context) string {
+	id, err := pvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProductVariants.
+func (pvq *ProductVariantQuery) All(ctx context.Context) ([]*ProductVariant, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryAll)
+	if err := pvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProductVariant, *ProductVariantQuery]()
+	return withInterceptors[[]*ProductVariant](ctx, pvq, qr, pvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pvq *ProductVariantQuery) AllX(ctx context.Context) []*ProductVariant {
+	nodes, err := pvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProductVariant IDs.
+func (pvq *ProductVariantQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if pvq.ctx.Unique == nil && pvq.path != nil {
+		pvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryIDs)
+	if err = pvq.Select(productvariant.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pvq *ProductVariantQuery) IDsX(ctx context.Context) []string {
+	ids, err := pvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pvq *ProductVariantQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryCount)
+	if err := pvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pvq, querierCount[*ProductVariantQuery](), pvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pvq *ProductVariantQuery) CountX(ctx context.Context) int {
+	count, err := pvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pvq *ProductVariantQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pvq.ctx, ent.OpQueryExist)
+	switch _, err := pvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pvq *ProductVariantQuery) ExistX(ctx context.Context) bool {
+	exist, err := pvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProductVariantQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pvq *ProductVariantQuery) Clone() *ProductVariantQuery {
+	if pvq == nil {
+		return nil
+	}
+	return &ProductVariantQuery{
+		config:         pvq.config,
+		ctx:            pvq.ctx.Clone(),
+		order:          append([]productvariant.OrderOption{}, pvq.order...),
+		inters:         append([]Interceptor{}, pvq.inters...),
+		predicates:     append([]predicate.ProductVariant{}, pvq.predicates...),
+		withProduct:    pvq.withProduct.Clone(),
+		withCartItems:  pvq.withCartItems.Clone(),
+		withOrderItems: pvq.withOrderItems.Clone(),
+		// clone intermediate query.
+		sql:  pvq.sql.Clone(),
+		path: pvq.path,
+	}
+}
+
+// WithProduct tells the query-builder to eager-load the nodes that are connected to
+// the "product" edge. The optional arguments are used to configure the query builder of the edge.
+func (pvq *ProductVariantQuery) WithProduct(opts ...func(*ProductQuery)) *ProductVariantQuery {
+	query := (&ProductClient{config: pvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pvq.withProduct = query
+	return pvq
+}
+
+// WithCartItems tells the query-builder to eager-load the nodes that are connected to
+// the "cart_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (pvq *ProductVariantQuery) WithCartItems(opts ...func(*CartItemQuery)) *ProductVariantQuery {
+	query := (&CartItemClient{config: pvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pvq.withCartItems = query
+	return pvq
+}
+
+// WithOrderItems tells the query-builder to eager-load the nodes that are connected to
+// the "order_items" edge. The optional arguments are used to configure the query builder of the edge.
+func (pvq *ProductVariantQuery) WithOrderItems(opts ...func(*OrderItemQuery)) *ProductVariantQuery {
+	query := (&OrderItemClient{config: pvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pvq.withOrderItems = query
+	return pvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID string `json:"product_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProductVariant.Query().
+//		GroupBy(productvariant.FieldProductID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pvq *ProductVariantQuery) GroupBy(field string, fields ...string) *ProductVariantGroupBy {
+	pvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProductVariantGroupBy{build: pvq}
+	grbuild.flds = &pvq.ctx.Fields
+	grbuild.label = productvariant.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ProductID string `json:"product_id,omitempty"`
+//	}
+//
+//	client.ProductVariant.Query().
+//		Select(productvariant.FieldProductID).
+//		Scan(ctx, &v)
+func (pvq *ProductVariantQuery) Select(fields ...string) *ProductVariantSelect {
+	pvq.ctx.Fields = append(pvq.ctx.Fields, fields...)
+	sbuild := &ProductVariantSelect{ProductVariantQuery: pvq}
+	sbuild.label = productvariant.Label
+	sbuild.flds, sbuild.scan = &pvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProductVariantSelect configured with the given aggregations.
+func (pvq *ProductVariantQuery) Aggregate(fns ...AggregateFunc) *ProductVariantSelect {
+	return pvq.Select().Aggregate(fns...)
+}
+
+func (pvq *ProductVariantQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pvq.ctx.Fields {
+		if !productvariant.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pvq.path != nil {
+		prev, err := pvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pvq.sql = prev
+	}
+	return nil
+}
+
+func (pvq *ProductVariantQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProductVariant, error) {
+	var (
+		nodes       = []*ProductVariant{}
+		_spec       = pvq.querySpec()
+		loadedTypes = [3]bool{
+			pvq.withProduct != nil,
+			pvq.withCartItems != nil,
+			pvq.withOrderItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProductVariant).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProductVariant{config: pvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pvq.withProduct; query != nil {
+		if err := pvq.loadProduct(ctx, query, nodes, nil,
+			func(n *ProductVariant, e *Product) { n.Edges.Product = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pvq.withCartItems; query != nil {
+		if err := pvq.loadCartItems(ctx, query, nodes,
+			func(n *ProductVariant) { n.Edges.CartItems = []*CartItem{} },
+			func(n *ProductVariant, e *CartItem) { n.Edges.CartItems = append(n.Edges.CartItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pvq.withOrderItems; query != nil {
+		if err := pvq.loadOrderItems(ctx, query, nodes,
+			func(n *ProductVariant) { n.Edges.OrderItems = []*OrderItem{} },
+			func(n *ProductVariant, e *OrderItem) { n.Edges.OrderItems = append(n.Edges.OrderItems, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pvq *ProductVariantQuery) loadProduct(ctx context.Context, query *ProductQuery, nodes []*ProductVariant, init func(*ProductVariant), assign func(*ProductVariant, *Product)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ProductVariant)
+	for i := range nodes {
+		fk := nodes[i].ProductID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(product.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "product_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pvq *ProductVariantQuery) loadCartItems(ctx context.Context, query *CartItemQuery, nodes []*ProductVariant, init func(*ProductVariant), assign func(*ProductVariant, *CartItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProductVariant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(cartitem.FieldVariantID)
+	}
+	query.Where(predicate.CartItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(productvariant.CartItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VariantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "variant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (pvq *ProductVariantQuery) loadOrderItems(ctx context.Context, query *OrderItemQuery, nodes []*ProductVariant, init func(*ProductVariant), assign func(*ProductVariant, *OrderItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ProductVariant)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(orderitem.FieldVariantID)
+	}
+	query.Where(predicate.OrderItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(productvariant.OrderItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VariantID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "variant_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pvq *ProductVariantQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pvq.querySpec()
+	_spec.Node.Columns = pvq.ctx.Fields
+	if len(pvq.ctx.Fields) > 0 {
+		_spec.Unique = pvq.ctx.Unique != nil && *pvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pvq.driver, _spec)
+}
+
+func (pvq *ProductVariantQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(productvariant.Table, productvariant.Columns, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString))
+	_spec.From = pvq.sql
+	if unique := pvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productvariant.FieldID)
+		for i := range fields {
+			if fields[i] != productvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pvq.withProduct != nil {
+			_spec.Node.AddColumnOnce(productvariant.FieldProductID)
+		}
+	}
+	if ps := pvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pvq *ProductVariantQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pvq.driver.Dialect())
+	t1 := builder.Table(productvariant.Table)
+	columns := pvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = productvariant.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pvq.sql != nil {
+		selector = pvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pvq.ctx.Unique != nil && *pvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range pvq.predicates {
+		p(selector)
+	}
+	for _, p := range pvq.order {
+		p(selector)
+	}
+	if offset := pvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProductVariantGroupBy is the group-by builder for ProductVariant entities.
+type ProductVariantGroupBy struct {
+	selector
+	build *ProductVariantQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pvgb *ProductVariantGroupBy) Aggregate(fns ...AggregateFunc) *ProductVariantGroupBy {
+	pvgb.fns = append(pvgb.fns, fns...)
+	return pvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pvgb *ProductVariantGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductVariantQuery, *ProductVariantGroupBy](ctx, pvgb.build, pvgb, pvgb.build.inters, v)
+}
+
+func (pvgb *ProductVariantGroupBy) sqlScan(ctx context.Context, root *ProductVariantQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pvgb.fns))
+	for _, fn := range pvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pvgb.flds)+len(pvgb.fns))
+		for _, f := range *pvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProductVariantSelect is the builder for selecting fields of ProductVariant entities.
+type ProductVariantSelect struct {
+	*ProductVariantQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pvs *ProductVariantSelect) Aggregate(fns ...AggregateFunc) *ProductVariantSelect {
+	pvs.fns = append(pvs.fns, fns...)
+	return pvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pvs *ProductVariantSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pvs.ctx, ent.OpQuerySelect)
+	if err := pvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProductVariantQuery, *ProductVariantSelect](ctx, pvs.ProductVariantQuery, pvs, pvs.inters, v)
+}
+
+func (pvs *ProductVariantSelect) sqlScan(ctx context.Context, root *ProductVariantQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pvs.fns))
+	for _, fn := range pvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
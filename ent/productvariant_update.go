@@ -0,0 +1,1057 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/cartitem"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ProductVariantUpdate is the builder for updating ProductVariant entities.
+type ProductVariantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProductVariantMutation
+}
+
+// Where appends a list predicates to the ProductVariantUpdate builder.
+func (pvu *ProductVariantUpdate) Where(ps ...predicate.ProductVariant) *ProductVariantUpdate {
+	pvu.mutation.Where(ps...)
+	return pvu
+}
+
+// SetProductID sets the "product_id" field.
+func (pvu *ProductVariantUpdate) SetProductID(s string) *ProductVariantUpdate {
+	pvu.mutation.SetProductID(s)
+	return pvu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableProductID(s *string) *ProductVariantUpdate {
+	if s != nil {
+		pvu.SetProductID(*s)
+	}
+	return pvu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (pvu *ProductVariantUpdate) ClearProductID() *ProductVariantUpdate {
+	pvu.mutation.ClearProductID()
+	return pvu
+}
+
+// SetSku sets the "sku" field.
+func (pvu *ProductVariantUpdate) SetSku(s string) *ProductVariantUpdate {
+	pvu.mutation.SetSku(s)
+	return pvu
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableSku(s *string) *ProductVariantUpdate {
+	if s != nil {
+		pvu.SetSku(*s)
+	}
+	return pvu
+}
+
+// SetOptions sets the "options" field.
+func (pvu *ProductVariantUpdate) SetOptions(m map[string]string) *ProductVariantUpdate {
+	pvu.mutation.SetOptions(m)
+	return pvu
+}
+
+// SetCombination sets the "combination" field.
+func (pvu *ProductVariantUpdate) SetCombination(s string) *ProductVariantUpdate {
+	pvu.mutation.SetCombination(s)
+	return pvu
+}
+
+// SetNillableCombination sets the "combination" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableCombination(s *string) *ProductVariantUpdate {
+	if s != nil {
+		pvu.SetCombination(*s)
+	}
+	return pvu
+}
+
+// SetPrice sets the "price" field.
+func (pvu *ProductVariantUpdate) SetPrice(m money.Amount) *ProductVariantUpdate {
+	pvu.mutation.ResetPrice()
+	pvu.mutation.SetPrice(m)
+	return pvu
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillablePrice(m *money.Amount) *ProductVariantUpdate {
+	if m != nil {
+		pvu.SetPrice(*m)
+	}
+	return pvu
+}
+
+// AddPrice adds m to the "price" field.
+func (pvu *ProductVariantUpdate) AddPrice(m money.Amount) *ProductVariantUpdate {
+	pvu.mutation.AddPrice(m)
+	return pvu
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pvu *ProductVariantUpdate) ClearPrice() *ProductVariantUpdate {
+	pvu.mutation.ClearPrice()
+	return pvu
+}
+
+// SetStock sets the "stock" field.
+func (pvu *ProductVariantUpdate) SetStock(i int) *ProductVariantUpdate {
+	pvu.mutation.ResetStock()
+	pvu.mutation.SetStock(i)
+	return pvu
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableStock(i *int) *ProductVariantUpdate {
+	if i != nil {
+		pvu.SetStock(*i)
+	}
+	return pvu
+}
+
+// AddStock adds i to the "stock" field.
+func (pvu *ProductVariantUpdate) AddStock(i int) *ProductVariantUpdate {
+	pvu.mutation.AddStock(i)
+	return pvu
+}
+
+// SetImages sets the "images" field.
+func (pvu *ProductVariantUpdate) SetImages(s []string) *ProductVariantUpdate {
+	pvu.mutation.SetImages(s)
+	return pvu
+}
+
+// AppendImages appends s to the "images" field.
+func (pvu *ProductVariantUpdate) AppendImages(s []string) *ProductVariantUpdate {
+	pvu.mutation.AppendImages(s)
+	return pvu
+}
+
+// SetPosition sets the "position" field.
+func (pvu *ProductVariantUpdate) SetPosition(i int) *ProductVariantUpdate {
+	pvu.mutation.ResetPosition()
+	pvu.mutation.SetPosition(i)
+	return pvu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillablePosition(i *int) *ProductVariantUpdate {
+	if i != nil {
+		pvu.SetPosition(*i)
+	}
+	return pvu
+}
+
+// AddPosition adds i to the "position" field.
+func (pvu *ProductVariantUpdate) AddPosition(i int) *ProductVariantUpdate {
+	pvu.mutation.AddPosition(i)
+	return pvu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvu *ProductVariantUpdate) SetCreatedAt(t time.Time) *ProductVariantUpdate {
+	pvu.mutation.SetCreatedAt(t)
+	return pvu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvu *ProductVariantUpdate) SetNillableCreatedAt(t *time.Time) *ProductVariantUpdate {
+	if t != nil {
+		pvu.SetCreatedAt(*t)
+	}
+	return pvu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvu *ProductVariantUpdate) SetUpdatedAt(t time.Time) *ProductVariantUpdate {
+	pvu.mutation.SetUpdatedAt(t)
+	return pvu
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pvu *ProductVariantUpdate) SetProduct(p *Product) *ProductVariantUpdate {
+	return pvu.SetProductID(p.ID)
+}
+
+// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
+func (pvu *ProductVariantUpdate) AddCartItemIDs(ids ...string) *ProductVariantUpdate {
+	pvu.mutation.AddCartItemIDs(ids...)
+	return pvu
+}
+
+// AddCartItems adds the "cart_items" edges to the CartItem entity.
+func (pvu *ProductVariantUpdate) AddCartItems(c ...*CartItem) *ProductVariantUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pvu.AddCartItemIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (pvu *ProductVariantUpdate) AddOrderItemIDs(ids ...string) *ProductVariantUpdate {
+	pvu.mutation.AddOrderItemIDs(ids...)
+	return pvu
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (pvu *ProductVariantUpdate) AddOrderItems(o ...*OrderItem) *ProductVariantUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pvu.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvu *ProductVariantUpdate) Mutation() *ProductVariantMutation {
+	return pvu.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pvu *ProductVariantUpdate) ClearProduct() *ProductVariantUpdate {
+	pvu.mutation.ClearProduct()
+	return pvu
+}
+
+// ClearCartItems clears all "cart_items" edges to the CartItem entity.
+func (pvu *ProductVariantUpdate) ClearCartItems() *ProductVariantUpdate {
+	pvu.mutation.ClearCartItems()
+	return pvu
+}
+
+// RemoveCartItemIDs removes the "cart_items" edge to CartItem entities by IDs.
+func (pvu *ProductVariantUpdate) RemoveCartItemIDs(ids ...string) *ProductVariantUpdate {
+	pvu.mutation.RemoveCartItemIDs(ids...)
+	return pvu
+}
+
+// RemoveCartItems removes "cart_items" edges to CartItem entities.
+func (pvu *ProductVariantUpdate) RemoveCartItems(c ...*CartItem) *ProductVariantUpdate {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pvu.RemoveCartItemIDs(ids...)
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (pvu *ProductVariantUpdate) ClearOrderItems() *ProductVariantUpdate {
+	pvu.mutation.ClearOrderItems()
+	return pvu
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (pvu *ProductVariantUpdate) RemoveOrderItemIDs(ids ...string) *ProductVariantUpdate {
+	pvu.mutation.RemoveOrderItemIDs(ids...)
+	return pvu
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (pvu *ProductVariantUpdate) RemoveOrderItems(o ...*OrderItem) *ProductVariantUpdate {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pvu.RemoveOrderItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pvu *ProductVariantUpdate) Save(ctx context.Context) (int, error) {
+	pvu.defaults()
+	return withHooks(ctx, pvu.sqlSave, pvu.mutation, pvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvu *ProductVariantUpdate) SaveX(ctx context.Context) int {
+	affected, err := pvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pvu *ProductVariantUpdate) Exec(ctx context.Context) error {
+	_, err := pvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvu *ProductVariantUpdate) ExecX(ctx context.Context) {
+	if err := pvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvu *ProductVariantUpdate) defaults() {
+	if _, ok := pvu.mutation.UpdatedAt(); !ok {
+		v := productvariant.UpdateDefaultUpdatedAt()
+		pvu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvu *ProductVariantUpdate) check() error {
+	if v, ok := pvu.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Combination(); ok {
+		if err := productvariant.CombinationValidator(v); err != nil {
+			return &ValidationError{Name: "combination", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.combination": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := pvu.mutation.Stock(); ok {
+		if err := productvariant.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.stock": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pvu *ProductVariantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productvariant.Table, productvariant.Columns, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString))
+	if ps := pvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvu.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pvu.mutation.Combination(); ok {
+		_spec.SetField(productvariant.FieldCombination, field.TypeString, value)
+	}
+	if value, ok := pvu.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := pvu.mutation.AddedPrice(); ok {
+		_spec.AddField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if pvu.mutation.PriceCleared() {
+		_spec.ClearField(productvariant.FieldPrice, field.TypeInt64)
+	}
+	if value, ok := pvu.mutation.Stock(); ok {
+		_spec.SetField(productvariant.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := pvu.mutation.AddedStock(); ok {
+		_spec.AddField(productvariant.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := pvu.mutation.Images(); ok {
+		_spec.SetField(productvariant.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := pvu.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productvariant.FieldImages, value)
+		})
+	}
+	if value, ok := pvu.mutation.Position(); ok {
+		_spec.SetField(productvariant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pvu.mutation.AddedPosition(); ok {
+		_spec.AddField(productvariant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pvu.mutation.CreatedAt(); ok {
+		_spec.SetField(productvariant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pvu.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pvu.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.ProductTable,
+			Columns: []string{productvariant.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.ProductTable,
+			Columns: []string{productvariant.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pvu.mutation.CartItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.RemovedCartItemsIDs(); len(nodes) > 0 && !pvu.mutation.CartItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.CartItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pvu.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !pvu.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvu.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pvu.mutation.done = true
+	return n, nil
+}
+
+// ProductVariantUpdateOne is the builder for updating a single ProductVariant entity.
+type ProductVariantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProductVariantMutation
+}
+
+// SetProductID sets the "product_id" field.
+func (pvuo *ProductVariantUpdateOne) SetProductID(s string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetProductID(s)
+	return pvuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableProductID(s *string) *ProductVariantUpdateOne {
+	if s != nil {
+		pvuo.SetProductID(*s)
+	}
+	return pvuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (pvuo *ProductVariantUpdateOne) ClearProductID() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearProductID()
+	return pvuo
+}
+
+// SetSku sets the "sku" field.
+func (pvuo *ProductVariantUpdateOne) SetSku(s string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetSku(s)
+	return pvuo
+}
+
+// SetNillableSku sets the "sku" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableSku(s *string) *ProductVariantUpdateOne {
+	if s != nil {
+		pvuo.SetSku(*s)
+	}
+	return pvuo
+}
+
+// SetOptions sets the "options" field.
+func (pvuo *ProductVariantUpdateOne) SetOptions(m map[string]string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetOptions(m)
+	return pvuo
+}
+
+// SetCombination sets the "combination" field.
+func (pvuo *ProductVariantUpdateOne) SetCombination(s string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetCombination(s)
+	return pvuo
+}
+
+// SetNillableCombination sets the "combination" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableCombination(s *string) *ProductVariantUpdateOne {
+	if s != nil {
+		pvuo.SetCombination(*s)
+	}
+	return pvuo
+}
+
+// SetPrice sets the "price" field.
+func (pvuo *ProductVariantUpdateOne) SetPrice(m money.Amount) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetPrice()
+	pvuo.mutation.SetPrice(m)
+	return pvuo
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillablePrice(m *money.Amount) *ProductVariantUpdateOne {
+	if m != nil {
+		pvuo.SetPrice(*m)
+	}
+	return pvuo
+}
+
+// AddPrice adds m to the "price" field.
+func (pvuo *ProductVariantUpdateOne) AddPrice(m money.Amount) *ProductVariantUpdateOne {
+	pvuo.mutation.AddPrice(m)
+	return pvuo
+}
+
+// ClearPrice clears the value of the "price" field.
+func (pvuo *ProductVariantUpdateOne) ClearPrice() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearPrice()
+	return pvuo
+}
+
+// SetStock sets the "stock" field.
+func (pvuo *ProductVariantUpdateOne) SetStock(i int) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetStock()
+	pvuo.mutation.SetStock(i)
+	return pvuo
+}
+
+// SetNillableStock sets the "stock" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableStock(i *int) *ProductVariantUpdateOne {
+	if i != nil {
+		pvuo.SetStock(*i)
+	}
+	return pvuo
+}
+
+// AddStock adds i to the "stock" field.
+func (pvuo *ProductVariantUpdateOne) AddStock(i int) *ProductVariantUpdateOne {
+	pvuo.mutation.AddStock(i)
+	return pvuo
+}
+
+// SetImages sets the "images" field.
+func (pvuo *ProductVariantUpdateOne) SetImages(s []string) *ProductVariantUpdateOne {
+	pvuo.mutation.SetImages(s)
+	return pvuo
+}
+
+// AppendImages appends s to the "images" field.
+func (pvuo *ProductVariantUpdateOne) AppendImages(s []string) *ProductVariantUpdateOne {
+	pvuo.mutation.AppendImages(s)
+	return pvuo
+}
+
+// SetPosition sets the "position" field.
+func (pvuo *ProductVariantUpdateOne) SetPosition(i int) *ProductVariantUpdateOne {
+	pvuo.mutation.ResetPosition()
+	pvuo.mutation.SetPosition(i)
+	return pvuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillablePosition(i *int) *ProductVariantUpdateOne {
+	if i != nil {
+		pvuo.SetPosition(*i)
+	}
+	return pvuo
+}
+
+// AddPosition adds i to the "position" field.
+func (pvuo *ProductVariantUpdateOne) AddPosition(i int) *ProductVariantUpdateOne {
+	pvuo.mutation.AddPosition(i)
+	return pvuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pvuo *ProductVariantUpdateOne) SetCreatedAt(t time.Time) *ProductVariantUpdateOne {
+	pvuo.mutation.SetCreatedAt(t)
+	return pvuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pvuo *ProductVariantUpdateOne) SetNillableCreatedAt(t *time.Time) *ProductVariantUpdateOne {
+	if t != nil {
+		pvuo.SetCreatedAt(*t)
+	}
+	return pvuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pvuo *ProductVariantUpdateOne) SetUpdatedAt(t time.Time) *ProductVariantUpdateOne {
+	pvuo.mutation.SetUpdatedAt(t)
+	return pvuo
+}
+
+// SetProduct sets the "product" edge to the Product entity.
+func (pvuo *ProductVariantUpdateOne) SetProduct(p *Product) *ProductVariantUpdateOne {
+	return pvuo.SetProductID(p.ID)
+}
+
+// AddCartItemIDs adds the "cart_items" edge to the CartItem entity by IDs.
+func (pvuo *ProductVariantUpdateOne) AddCartItemIDs(ids ...string) *ProductVariantUpdateOne {
+	pvuo.mutation.AddCartItemIDs(ids...)
+	return pvuo
+}
+
+// AddCartItems adds the "cart_items" edges to the CartItem entity.
+func (pvuo *ProductVariantUpdateOne) AddCartItems(c ...*CartItem) *ProductVariantUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pvuo.AddCartItemIDs(ids...)
+}
+
+// AddOrderItemIDs adds the "order_items" edge to the OrderItem entity by IDs.
+func (pvuo *ProductVariantUpdateOne) AddOrderItemIDs(ids ...string) *ProductVariantUpdateOne {
+	pvuo.mutation.AddOrderItemIDs(ids...)
+	return pvuo
+}
+
+// AddOrderItems adds the "order_items" edges to the OrderItem entity.
+func (pvuo *ProductVariantUpdateOne) AddOrderItems(o ...*OrderItem) *ProductVariantUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pvuo.AddOrderItemIDs(ids...)
+}
+
+// Mutation returns the ProductVariantMutation object of the builder.
+func (pvuo *ProductVariantUpdateOne) Mutation() *ProductVariantMutation {
+	return pvuo.mutation
+}
+
+// ClearProduct clears the "product" edge to the Product entity.
+func (pvuo *ProductVariantUpdateOne) ClearProduct() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearProduct()
+	return pvuo
+}
+
+// ClearCartItems clears all "cart_items" edges to the CartItem entity.
+func (pvuo *ProductVariantUpdateOne) ClearCartItems() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearCartItems()
+	return pvuo
+}
+
+// RemoveCartItemIDs removes the "cart_items" edge to CartItem entities by IDs.
+func (pvuo *ProductVariantUpdateOne) RemoveCartItemIDs(ids ...string) *ProductVariantUpdateOne {
+	pvuo.mutation.RemoveCartItemIDs(ids...)
+	return pvuo
+}
+
+// RemoveCartItems removes "cart_items" edges to CartItem entities.
+func (pvuo *ProductVariantUpdateOne) RemoveCartItems(c ...*CartItem) *ProductVariantUpdateOne {
+	ids := make([]string, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pvuo.RemoveCartItemIDs(ids...)
+}
+
+// ClearOrderItems clears all "order_items" edges to the OrderItem entity.
+func (pvuo *ProductVariantUpdateOne) ClearOrderItems() *ProductVariantUpdateOne {
+	pvuo.mutation.ClearOrderItems()
+	return pvuo
+}
+
+// RemoveOrderItemIDs removes the "order_items" edge to OrderItem entities by IDs.
+func (pvuo *ProductVariantUpdateOne) RemoveOrderItemIDs(ids ...string) *ProductVariantUpdateOne {
+	pvuo.mutation.RemoveOrderItemIDs(ids...)
+	return pvuo
+}
+
+// RemoveOrderItems removes "order_items" edges to OrderItem entities.
+func (pvuo *ProductVariantUpdateOne) RemoveOrderItems(o ...*OrderItem) *ProductVariantUpdateOne {
+	ids := make([]string, len(o))
+	for i := range o {
+		ids[i] = o[i].ID
+	}
+	return pvuo.RemoveOrderItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ProductVariantUpdate builder.
+func (pvuo *ProductVariantUpdateOne) Where(ps ...predicate.ProductVariant) *ProductVariantUpdateOne {
+	pvuo.mutation.Where(ps...)
+	return pvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pvuo *ProductVariantUpdateOne) Select(field string, fields ...string) *ProductVariantUpdateOne {
+	pvuo.fields = append([]string{field}, fields...)
+	return pvuo
+}
+
+// Save executes the query and returns the updated ProductVariant entity.
+func (pvuo *ProductVariantUpdateOne) Save(ctx context.Context) (*ProductVariant, error) {
+	pvuo.defaults()
+	return withHooks(ctx, pvuo.sqlSave, pvuo.mutation, pvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pvuo *ProductVariantUpdateOne) SaveX(ctx context.Context) *ProductVariant {
+	node, err := pvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pvuo *ProductVariantUpdateOne) Exec(ctx context.Context) error {
+	_, err := pvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pvuo *ProductVariantUpdateOne) ExecX(ctx context.Context) {
+	if err := pvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pvuo *ProductVariantUpdateOne) defaults() {
+	if _, ok := pvuo.mutation.UpdatedAt(); !ok {
+		v := productvariant.UpdateDefaultUpdatedAt()
+		pvuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pvuo *ProductVariantUpdateOne) check() error {
+	if v, ok := pvuo.mutation.Sku(); ok {
+		if err := productvariant.SkuValidator(v); err != nil {
+			return &ValidationError{Name: "sku", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.sku": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Combination(); ok {
+		if err := productvariant.CombinationValidator(v); err != nil {
+			return &ValidationError{Name: "combination", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.combination": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Price(); ok {
+		if err := productvariant.PriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "price", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.price": %w`, err)}
+		}
+	}
+	if v, ok := pvuo.mutation.Stock(); ok {
+		if err := productvariant.StockValidator(v); err != nil {
+			return &ValidationError{Name: "stock", err: fmt.Errorf(`ent: validator failed for field "ProductVariant.stock": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pvuo *ProductVariantUpdateOne) sqlSave(ctx context.Context) (_node *ProductVariant, err error) {
+	if err := pvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(productvariant.Table, productvariant.Columns, sqlgraph.NewFieldSpec(productvariant.FieldID, field.TypeString))
+	id, ok := pvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProductVariant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, productvariant.FieldID)
+		for _, f := range fields {
+			if !productvariant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != productvariant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pvuo.mutation.Sku(); ok {
+		_spec.SetField(productvariant.FieldSku, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Options(); ok {
+		_spec.SetField(productvariant.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := pvuo.mutation.Combination(); ok {
+		_spec.SetField(productvariant.FieldCombination, field.TypeString, value)
+	}
+	if value, ok := pvuo.mutation.Price(); ok {
+		_spec.SetField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if value, ok := pvuo.mutation.AddedPrice(); ok {
+		_spec.AddField(productvariant.FieldPrice, field.TypeInt64, value)
+	}
+	if pvuo.mutation.PriceCleared() {
+		_spec.ClearField(productvariant.FieldPrice, field.TypeInt64)
+	}
+	if value, ok := pvuo.mutation.Stock(); ok {
+		_spec.SetField(productvariant.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := pvuo.mutation.AddedStock(); ok {
+		_spec.AddField(productvariant.FieldStock, field.TypeInt, value)
+	}
+	if value, ok := pvuo.mutation.Images(); ok {
+		_spec.SetField(productvariant.FieldImages, field.TypeJSON, value)
+	}
+	if value, ok := pvuo.mutation.AppendedImages(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, productvariant.FieldImages, value)
+		})
+	}
+	if value, ok := pvuo.mutation.Position(); ok {
+		_spec.SetField(productvariant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pvuo.mutation.AddedPosition(); ok {
+		_spec.AddField(productvariant.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := pvuo.mutation.CreatedAt(); ok {
+		_spec.SetField(productvariant.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := pvuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(productvariant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if pvuo.mutation.ProductCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.ProductTable,
+			Columns: []string{productvariant.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.ProductIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   productvariant.ProductTable,
+			Columns: []string{productvariant.ProductColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(product.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pvuo.mutation.CartItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.RemovedCartItemsIDs(); len(nodes) > 0 && !pvuo.mutation.CartItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.CartItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.CartItemsTable,
+			Columns: []string{productvariant.CartItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cartitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pvuo.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.RemovedOrderItemsIDs(); len(nodes) > 0 && !pvuo.mutation.OrderItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pvuo.mutation.OrderItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   productvariant.OrderItemsTable,
+			Columns: []string{productvariant.OrderItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(orderitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ProductVariant{config: pvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{productvariant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pvuo.mutation.done = true
+	return _node, nil
+}
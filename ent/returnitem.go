@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnItem is the model entity for the ReturnItem schema.
+type ReturnItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ReturnID holds the value of the "return_id" field.
+	ReturnID string `json:"return_id,omitempty"`
+	// OrderItemID holds the value of the "order_item_id" field.
+	OrderItemID string `json:"order_item_id,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID string `json:"product_id,omitempty"`
+	// VariantID holds the value of the "variant_id" field.
+	VariantID string `json:"variant_id,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
+	// UnitPrice holds the value of the "unit_price" field.
+	UnitPrice money.Amount `json:"unit_price,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReturnItemQuery when eager-loading is set.
+	Edges        ReturnItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReturnItemEdges holds the relations/edges for other nodes in the graph.
+type ReturnItemEdges struct {
+	// Return holds the value of the return edge.
+	Return *ReturnRequest `json:"return,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ReturnOrErr returns the Return value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReturnItemEdges) ReturnOrErr() (*ReturnRequest, error) {
+	if e.Return != nil {
+		return e.Return, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: returnrequest.Label}
+	}
+	return nil, &NotLoadedError{edge: "return"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReturnItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case returnitem.FieldUnitPrice:
+			values[i] = new(money.Amount)
+		case returnitem.FieldQuantity:
+			values[i] = new(sql.NullInt64)
+		case returnitem.FieldID, returnitem.FieldReturnID, returnitem.FieldOrderItemID, returnitem.FieldProductID, returnitem.FieldVariantID:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReturnItem fields.
+func (ri *ReturnItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case returnitem.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ri.ID = value.String
+			}
+		case returnitem.FieldReturnID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field return_id", values[i])
+			} else if value.Valid {
+				ri.ReturnID = value.String
+			}
+		case returnitem.FieldOrderItemID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_item_id", values[i])
+			} else if value.Valid {
+				ri.OrderItemID = value.String
+			}
+		case returnitem.FieldProductID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				ri.ProductID = value.String
+			}
+		case returnitem.FieldVariantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant_id", values[i])
+			} else if value.Valid {
+				ri.VariantID = value.String
+			}
+		case returnitem.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				ri.Quantity = int(value.Int64)
+			}
+		case returnitem.FieldUnitPrice:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_price", values[i])
+			} else if value != nil {
+				ri.UnitPrice = *value
+			}
+		default:
+			ri.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReturnItem.
+// This includes values selected through modifiers, order, etc.
+func (ri *ReturnItem) Value(name string) (ent.Value, error) {
+	return ri.selectValues.Get(name)
+}
+
+// QueryReturn queries the "return" edge of the ReturnItem entity.
+func (ri *ReturnItem) QueryReturn() *ReturnRequestQuery {
+	return NewReturnItemClient(ri.config).QueryReturn(ri)
+}
+
+// Update returns a builder for updating this ReturnItem.
+// Note that you need to call ReturnItem.Unwrap() before calling this method if this ReturnItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ri *ReturnItem) Update() *ReturnItemUpdateOne {
+	return NewReturnItemClient(ri.config).UpdateOne(ri)
+}
+
+// Unwrap unwraps the ReturnItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ri *ReturnItem) Unwrap() *ReturnItem {
+	_tx, ok := ri.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReturnItem is not a transactional entity")
+	}
+	ri.config.driver = _tx.drv
+	return ri
+}
+
+// String implements the fmt.Stringer.
+func (ri *ReturnItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReturnItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ri.ID))
+	builder.WriteString("return_id=")
+	builder.WriteString(ri.ReturnID)
+	builder.WriteString(", ")
+	builder.WriteString("order_item_id=")
+	builder.WriteString(ri.OrderItemID)
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(ri.ProductID)
+	builder.WriteString(", ")
+	builder.WriteString("variant_id=")
+	builder.WriteString(ri.VariantID)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", ri.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("unit_price=")
+	builder.WriteString(fmt.Sprintf("%v", ri.UnitPrice))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReturnItems is a parsable slice of ReturnItem.
+type ReturnItems []*ReturnItem
@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package returnitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the returnitem type in the database.
+	Label = "return_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldReturnID holds the string denoting the return_id field in the database.
+	FieldReturnID = "return_id"
+	// FieldOrderItemID holds the string denoting the order_item_id field in the database.
+	FieldOrderItemID = "order_item_id"
+	// FieldProductID holds the string denoting the product_id field in the database.
+	FieldProductID = "product_id"
+	// FieldVariantID holds the string denoting the variant_id field in the database.
+	FieldVariantID = "variant_id"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldUnitPrice holds the string denoting the unit_price field in the database.
+	FieldUnitPrice = "unit_price"
+	// EdgeReturn holds the string denoting the return edge name in mutations.
+	EdgeReturn = "return"
+	// Table holds the table name of the returnitem in the database.
+	Table = "return_items"
+	// ReturnTable is the table that holds the return relation/edge.
+	ReturnTable = "return_items"
+	// ReturnInverseTable is the table name for the ReturnRequest entity.
+	// It exists in this package in order to avoid circular dependency with the "returnrequest" package.
+	ReturnInverseTable = "return_requests"
+	// ReturnColumn is the table column denoting the return relation/edge.
+	ReturnColumn = "return_id"
+)
+
+// Columns holds all SQL columns for returnitem fields.
+var Columns = []string{
+	FieldID,
+	FieldReturnID,
+	FieldOrderItemID,
+	FieldProductID,
+	FieldVariantID,
+	FieldQuantity,
+	FieldUnitPrice,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// OrderItemIDValidator is a validator for the "order_item_id" field. It is called by the builders before save.
+	OrderItemIDValidator func(string) error
+	// QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	QuantityValidator func(int) error
+	// UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
+	UnitPriceValidator func(int64) error
+)
+
+// OrderOption defines the ordering options for the ReturnItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByReturnID orders the results by the return_id field.
+func ByReturnID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnID, opts...).ToFunc()
+}
+
+// ByOrderItemID orders the results by the order_item_id field.
+func ByOrderItemID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderItemID, opts...).ToFunc()
+}
+
+// ByProductID orders the results by the product_id field.
+func ByProductID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductID, opts...).ToFunc()
+}
+
+// ByVariantID orders the results by the variant_id field.
+func ByVariantID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariantID, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByUnitPrice orders the results by the unit_price field.
+func ByUnitPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnitPrice, opts...).ToFunc()
+}
+
+// ByReturnField orders the results by return field.
+func ByReturnField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newReturnStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newReturnStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ReturnInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ReturnTable, ReturnColumn),
+	)
+}
@@ -0,0 +1,503 @@
+// Code generated by ent, DO NOT EDIT.
+
+package returnitem
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContainsFold(FieldID, id))
+}
+
+// ReturnID applies equality check predicate on the "return_id" field. It's identical to ReturnIDEQ.
+func ReturnID(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldReturnID, v))
+}
+
+// OrderItemID applies equality check predicate on the "order_item_id" field. It's identical to OrderItemIDEQ.
+func OrderItemID(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldOrderItemID, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// VariantID applies equality check predicate on the "variant_id" field. It's identical to VariantIDEQ.
+func VariantID(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// UnitPrice applies equality check predicate on the "unit_price" field. It's identical to UnitPriceEQ.
+func UnitPrice(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// ReturnIDEQ applies the EQ predicate on the "return_id" field.
+func ReturnIDEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldReturnID, v))
+}
+
+// ReturnIDNEQ applies the NEQ predicate on the "return_id" field.
+func ReturnIDNEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldReturnID, v))
+}
+
+// ReturnIDIn applies the In predicate on the "return_id" field.
+func ReturnIDIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldReturnID, vs...))
+}
+
+// ReturnIDNotIn applies the NotIn predicate on the "return_id" field.
+func ReturnIDNotIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldReturnID, vs...))
+}
+
+// ReturnIDGT applies the GT predicate on the "return_id" field.
+func ReturnIDGT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldReturnID, v))
+}
+
+// ReturnIDGTE applies the GTE predicate on the "return_id" field.
+func ReturnIDGTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldReturnID, v))
+}
+
+// ReturnIDLT applies the LT predicate on the "return_id" field.
+func ReturnIDLT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldReturnID, v))
+}
+
+// ReturnIDLTE applies the LTE predicate on the "return_id" field.
+func ReturnIDLTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldReturnID, v))
+}
+
+// ReturnIDContains applies the Contains predicate on the "return_id" field.
+func ReturnIDContains(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContains(FieldReturnID, v))
+}
+
+// ReturnIDHasPrefix applies the HasPrefix predicate on the "return_id" field.
+func ReturnIDHasPrefix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasPrefix(FieldReturnID, v))
+}
+
+// ReturnIDHasSuffix applies the HasSuffix predicate on the "return_id" field.
+func ReturnIDHasSuffix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasSuffix(FieldReturnID, v))
+}
+
+// ReturnIDIsNil applies the IsNil predicate on the "return_id" field.
+func ReturnIDIsNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIsNull(FieldReturnID))
+}
+
+// ReturnIDNotNil applies the NotNil predicate on the "return_id" field.
+func ReturnIDNotNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotNull(FieldReturnID))
+}
+
+// ReturnIDEqualFold applies the EqualFold predicate on the "return_id" field.
+func ReturnIDEqualFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEqualFold(FieldReturnID, v))
+}
+
+// ReturnIDContainsFold applies the ContainsFold predicate on the "return_id" field.
+func ReturnIDContainsFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContainsFold(FieldReturnID, v))
+}
+
+// OrderItemIDEQ applies the EQ predicate on the "order_item_id" field.
+func OrderItemIDEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldOrderItemID, v))
+}
+
+// OrderItemIDNEQ applies the NEQ predicate on the "order_item_id" field.
+func OrderItemIDNEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldOrderItemID, v))
+}
+
+// OrderItemIDIn applies the In predicate on the "order_item_id" field.
+func OrderItemIDIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldOrderItemID, vs...))
+}
+
+// OrderItemIDNotIn applies the NotIn predicate on the "order_item_id" field.
+func OrderItemIDNotIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldOrderItemID, vs...))
+}
+
+// OrderItemIDGT applies the GT predicate on the "order_item_id" field.
+func OrderItemIDGT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldOrderItemID, v))
+}
+
+// OrderItemIDGTE applies the GTE predicate on the "order_item_id" field.
+func OrderItemIDGTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldOrderItemID, v))
+}
+
+// OrderItemIDLT applies the LT predicate on the "order_item_id" field.
+func OrderItemIDLT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldOrderItemID, v))
+}
+
+// OrderItemIDLTE applies the LTE predicate on the "order_item_id" field.
+func OrderItemIDLTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldOrderItemID, v))
+}
+
+// OrderItemIDContains applies the Contains predicate on the "order_item_id" field.
+func OrderItemIDContains(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContains(FieldOrderItemID, v))
+}
+
+// OrderItemIDHasPrefix applies the HasPrefix predicate on the "order_item_id" field.
+func OrderItemIDHasPrefix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasPrefix(FieldOrderItemID, v))
+}
+
+// OrderItemIDHasSuffix applies the HasSuffix predicate on the "order_item_id" field.
+func OrderItemIDHasSuffix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasSuffix(FieldOrderItemID, v))
+}
+
+// OrderItemIDEqualFold applies the EqualFold predicate on the "order_item_id" field.
+func OrderItemIDEqualFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEqualFold(FieldOrderItemID, v))
+}
+
+// OrderItemIDContainsFold applies the ContainsFold predicate on the "order_item_id" field.
+func OrderItemIDContainsFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContainsFold(FieldOrderItemID, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldProductID, v))
+}
+
+// ProductIDContains applies the Contains predicate on the "product_id" field.
+func ProductIDContains(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContains(FieldProductID, v))
+}
+
+// ProductIDHasPrefix applies the HasPrefix predicate on the "product_id" field.
+func ProductIDHasPrefix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasPrefix(FieldProductID, v))
+}
+
+// ProductIDHasSuffix applies the HasSuffix predicate on the "product_id" field.
+func ProductIDHasSuffix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasSuffix(FieldProductID, v))
+}
+
+// ProductIDIsNil applies the IsNil predicate on the "product_id" field.
+func ProductIDIsNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIsNull(FieldProductID))
+}
+
+// ProductIDNotNil applies the NotNil predicate on the "product_id" field.
+func ProductIDNotNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotNull(FieldProductID))
+}
+
+// ProductIDEqualFold applies the EqualFold predicate on the "product_id" field.
+func ProductIDEqualFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEqualFold(FieldProductID, v))
+}
+
+// ProductIDContainsFold applies the ContainsFold predicate on the "product_id" field.
+func ProductIDContainsFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContainsFold(FieldProductID, v))
+}
+
+// VariantIDEQ applies the EQ predicate on the "variant_id" field.
+func VariantIDEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldVariantID, v))
+}
+
+// VariantIDNEQ applies the NEQ predicate on the "variant_id" field.
+func VariantIDNEQ(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldVariantID, v))
+}
+
+// VariantIDIn applies the In predicate on the "variant_id" field.
+func VariantIDIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldVariantID, vs...))
+}
+
+// VariantIDNotIn applies the NotIn predicate on the "variant_id" field.
+func VariantIDNotIn(vs ...string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldVariantID, vs...))
+}
+
+// VariantIDGT applies the GT predicate on the "variant_id" field.
+func VariantIDGT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldVariantID, v))
+}
+
+// VariantIDGTE applies the GTE predicate on the "variant_id" field.
+func VariantIDGTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldVariantID, v))
+}
+
+// VariantIDLT applies the LT predicate on the "variant_id" field.
+func VariantIDLT(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldVariantID, v))
+}
+
+// VariantIDLTE applies the LTE predicate on the "variant_id" field.
+func VariantIDLTE(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldVariantID, v))
+}
+
+// VariantIDContains applies the Contains predicate on the "variant_id" field.
+func VariantIDContains(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContains(FieldVariantID, v))
+}
+
+// VariantIDHasPrefix applies the HasPrefix predicate on the "variant_id" field.
+func VariantIDHasPrefix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasPrefix(FieldVariantID, v))
+}
+
+// VariantIDHasSuffix applies the HasSuffix predicate on the "variant_id" field.
+func VariantIDHasSuffix(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldHasSuffix(FieldVariantID, v))
+}
+
+// VariantIDIsNil applies the IsNil predicate on the "variant_id" field.
+func VariantIDIsNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIsNull(FieldVariantID))
+}
+
+// VariantIDNotNil applies the NotNil predicate on the "variant_id" field.
+func VariantIDNotNil() predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotNull(FieldVariantID))
+}
+
+// VariantIDEqualFold applies the EqualFold predicate on the "variant_id" field.
+func VariantIDEqualFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEqualFold(FieldVariantID, v))
+}
+
+// VariantIDContainsFold applies the ContainsFold predicate on the "variant_id" field.
+func VariantIDContainsFold(v string) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldContainsFold(FieldVariantID, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldQuantity, v))
+}
+
+// UnitPriceEQ applies the EQ predicate on the "unit_price" field.
+func UnitPriceEQ(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceNEQ applies the NEQ predicate on the "unit_price" field.
+func UnitPriceNEQ(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNEQ(FieldUnitPrice, v))
+}
+
+// UnitPriceIn applies the In predicate on the "unit_price" field.
+func UnitPriceIn(vs ...money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceNotIn applies the NotIn predicate on the "unit_price" field.
+func UnitPriceNotIn(vs ...money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldNotIn(FieldUnitPrice, vs...))
+}
+
+// UnitPriceGT applies the GT predicate on the "unit_price" field.
+func UnitPriceGT(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGT(FieldUnitPrice, v))
+}
+
+// UnitPriceGTE applies the GTE predicate on the "unit_price" field.
+func UnitPriceGTE(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldGTE(FieldUnitPrice, v))
+}
+
+// UnitPriceLT applies the LT predicate on the "unit_price" field.
+func UnitPriceLT(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLT(FieldUnitPrice, v))
+}
+
+// UnitPriceLTE applies the LTE predicate on the "unit_price" field.
+func UnitPriceLTE(v money.Amount) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.FieldLTE(FieldUnitPrice, v))
+}
+
+// HasReturn applies the HasEdge predicate on the "return" edge.
+func HasReturn() predicate.ReturnItem {
+	return predicate.ReturnItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ReturnTable, ReturnColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasReturnWith applies the HasEdge predicate on the "return" edge with a given conditions (other predicates).
+func HasReturnWith(preds ...predicate.ReturnRequest) predicate.ReturnItem {
+	return predicate.ReturnItem(func(s *sql.Selector) {
+		step := newReturnStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ReturnItem) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ReturnItem) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ReturnItem) predicate.ReturnItem {
+	return predicate.ReturnItem(sql.NotPredicates(p))
+}
@@ -0,0 +1,309 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnItemCreate is the builder for creating a ReturnItem entity.
+type ReturnItemCreate struct {
+	config
+	mutation *ReturnItemMutation
+	hooks    []Hook
+}
+
+// SetReturnID sets the "return_id" field.
+func (ric *ReturnItemCreate) SetReturnID(s string) *ReturnItemCreate {
+	ric.mutation.SetReturnID(s)
+	return ric
+}
+
+// SetNillableReturnID sets the "return_id" field if the given value is not nil.
+func (ric *ReturnItemCreate) SetNillableReturnID(s *string) *ReturnItemCreate {
+	if s != nil {
+		ric.SetReturnID(*s)
+	}
+	return ric
+}
+
+// SetOrderItemID sets the "order_item_id" field.
+func (ric *ReturnItemCreate) SetOrderItemID(s string) *ReturnItemCreate {
+	ric.mutation.SetOrderItemID(s)
+	return ric
+}
+
+// SetProductID sets the "product_id" field.
+func (ric *ReturnItemCreate) SetProductID(s string) *ReturnItemCreate {
+	ric.mutation.SetProductID(s)
+	return ric
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (ric *ReturnItemCreate) SetNillableProductID(s *string) *ReturnItemCreate {
+	if s != nil {
+		ric.SetProductID(*s)
+	}
+	return ric
+}
+
+// SetVariantID sets the "variant_id" field.
+func (ric *ReturnItemCreate) SetVariantID(s string) *ReturnItemCreate {
+	ric.mutation.SetVariantID(s)
+	return ric
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (ric *ReturnItemCreate) SetNillableVariantID(s *string) *ReturnItemCreate {
+	if s != nil {
+		ric.SetVariantID(*s)
+	}
+	return ric
+}
+
+// SetQuantity sets the "quantity" field.
+func (ric *ReturnItemCreate) SetQuantity(i int) *ReturnItemCreate {
+	ric.mutation.SetQuantity(i)
+	return ric
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (ric *ReturnItemCreate) SetUnitPrice(m money.Amount) *ReturnItemCreate {
+	ric.mutation.SetUnitPrice(m)
+	return ric
+}
+
+// SetID sets the "id" field.
+func (ric *ReturnItemCreate) SetID(s string) *ReturnItemCreate {
+	ric.mutation.SetID(s)
+	return ric
+}
+
+// SetReturn sets the "return" edge to the ReturnRequest entity.
+func (ric *ReturnItemCreate) SetReturn(r *ReturnRequest) *ReturnItemCreate {
+	return ric.SetReturnID(r.ID)
+}
+
+// Mutation returns the ReturnItemMutation object of the builder.
+func (ric *ReturnItemCreate) Mutation() *ReturnItemMutation {
+	return ric.mutation
+}
+
+// Save creates the ReturnItem in the database.
+func (ric *ReturnItemCreate) Save(ctx context.Context) (*ReturnItem, error) {
+	return withHooks(ctx, ric.sqlSave, ric.mutation, ric.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ric *ReturnItemCreate) SaveX(ctx context.Context) *ReturnItem {
+	v, err := ric.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ric *ReturnItemCreate) Exec(ctx context.Context) error {
+	_, err := ric.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ric *ReturnItemCreate) ExecX(ctx context.Context) {
+	if err := ric.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ric *ReturnItemCreate) check() error {
+	if _, ok := ric.mutation.OrderItemID(); !ok {
+		return &ValidationError{Name: "order_item_id", err: errors.New(`ent: missing required field "ReturnItem.order_item_id"`)}
+	}
+	if v, ok := ric.mutation.OrderItemID(); ok {
+		if err := returnitem.OrderItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_item_id", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.order_item_id": %w`, err)}
+		}
+	}
+	if _, ok := ric.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "ReturnItem.quantity"`)}
+	}
+	if v, ok := ric.mutation.Quantity(); ok {
+		if err := returnitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.quantity": %w`, err)}
+		}
+	}
+	if _, ok := ric.mutation.UnitPrice(); !ok {
+		return &ValidationError{Name: "unit_price", err: errors.New(`ent: missing required field "ReturnItem.unit_price"`)}
+	}
+	if v, ok := ric.mutation.UnitPrice(); ok {
+		if err := returnitem.UnitPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.unit_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ric *ReturnItemCreate) sqlSave(ctx context.Context) (*ReturnItem, error) {
+	if err := ric.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ric.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ric.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReturnItem.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ric.mutation.id = &_node.ID
+	ric.mutation.done = true
+	return _node, nil
+}
+
+func (ric *ReturnItemCreate) createSpec() (*ReturnItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReturnItem{config: ric.config}
+		_spec = sqlgraph.NewCreateSpec(returnitem.Table, sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString))
+	)
+	if id, ok := ric.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ric.mutation.OrderItemID(); ok {
+		_spec.SetField(returnitem.FieldOrderItemID, field.TypeString, value)
+		_node.OrderItemID = value
+	}
+	if value, ok := ric.mutation.ProductID(); ok {
+		_spec.SetField(returnitem.FieldProductID, field.TypeString, value)
+		_node.ProductID = value
+	}
+	if value, ok := ric.mutation.VariantID(); ok {
+		_spec.SetField(returnitem.FieldVariantID, field.TypeString, value)
+		_node.VariantID = value
+	}
+	if value, ok := ric.mutation.Quantity(); ok {
+		_spec.SetField(returnitem.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
+	if value, ok := ric.mutation.UnitPrice(); ok {
+		_spec.SetField(returnitem.FieldUnitPrice, field.TypeInt64, value)
+		_node.UnitPrice = value
+	}
+	if nodes := ric.mutation.ReturnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnitem.ReturnTable,
+			Columns: []string{returnitem.ReturnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ReturnID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReturnItemCreateBulk is the builder for creating many ReturnItem entities in bulk.
+type ReturnItemCreateBulk struct {
+	config
+	err      error
+	builders []*ReturnItemCreate
+}
+
+// Save creates the ReturnItem entities in the database.
+func (ricb *ReturnItemCreateBulk) Save(ctx context.Context) ([]*ReturnItem, error) {
+	if ricb.err != nil {
+		return nil, ricb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ricb.builders))
+	nodes := make([]*ReturnItem, len(ricb.builders))
+	mutators := make([]Mutator, len(ricb.builders))
+	for i := range ricb.builders {
+		func(i int, root context.Context) {
+			builder := ricb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReturnItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ricb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ricb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ricb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ricb *ReturnItemCreateBulk) SaveX(ctx context.Context) []*ReturnItem {
+	v, err := ricb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ricb *ReturnItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := ricb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ricb *ReturnItemCreateBulk) ExecX(ctx context.Context) {
+	if err := ricb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
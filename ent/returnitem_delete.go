@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+)
+
+// ReturnItemDelete is the builder for deleting a ReturnItem entity.
+type ReturnItemDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReturnItemMutation
+}
+
+// Where appends a list predicates to the ReturnItemDelete builder.
+func (rid *ReturnItemDelete) Where(ps ...predicate.ReturnItem) *ReturnItemDelete {
+	rid.mutation.Where(ps...)
+	return rid
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rid *ReturnItemDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rid.sqlExec, rid.mutation, rid.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rid *ReturnItemDelete) ExecX(ctx context.Context) int {
+	n, err := rid.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rid *ReturnItemDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(returnitem.Table, sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString))
+	if ps := rid.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rid.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rid.mutation.done = true
+	return affected, err
+}
+
+// ReturnItemDeleteOne is the builder for deleting a single ReturnItem entity.
+type ReturnItemDeleteOne struct {
+	rid *ReturnItemDelete
+}
+
+// Where appends a list predicates to the ReturnItemDelete builder.
+func (rido *ReturnItemDeleteOne) Where(ps ...predicate.ReturnItem) *ReturnItemDeleteOne {
+	rido.rid.mutation.Where(ps...)
+	return rido
+}
+
+// Exec executes the deletion query.
+func (rido *ReturnItemDeleteOne) Exec(ctx context.Context) error {
+	n, err := rido.rid.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{returnitem.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rido *ReturnItemDeleteOne) ExecX(ctx context.Context) {
+	if err := rido.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+)
+
+// ReturnItemQuery is the builder for querying ReturnItem entities.
+type ReturnItemQuery struct {
+	config
+	ctx        *QueryContext
+	order      []returnitem.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReturnItem
+	withReturn *ReturnRequestQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReturnItemQuery builder.
+func (riq *ReturnItemQuery) Where(ps ...predicate.ReturnItem) *ReturnItemQuery {
+	riq.predicates = append(riq.predicates, ps...)
+	return riq
+}
+
+// Limit the number of records to be returned by this query.
+func (riq *ReturnItemQuery) Limit(limit int) *ReturnItemQuery {
+	riq.ctx.Limit = &limit
+	return riq
+}
+
+// Offset to start from.
+func (riq *ReturnItemQuery) Offset(offset int) *ReturnItemQuery {
+	riq.ctx.Offset = &offset
+	return riq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (riq *ReturnItemQuery) Unique(unique bool) *ReturnItemQuery {
+	riq.ctx.Unique = &unique
+	return riq
+}
+
+// Order specifies how the records should be ordered.
+func (riq *ReturnItemQuery) Order(o ...returnitem.OrderOption) *ReturnItemQuery {
+	riq.order = append(riq.order, o...)
+	return riq
+}
+
+// QueryReturn chains the current query on the "return" edge.
+func (riq *ReturnItemQuery) QueryReturn() *ReturnRequestQuery {
+	query := (&ReturnRequestClient{config: riq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := riq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := riq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(returnitem.Table, returnitem.FieldID, selector),
+			sqlgraph.To(returnrequest.Table, returnrequest.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, returnitem.ReturnTable, returnitem.ReturnColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(riq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReturnItem entity from the query.
+// Returns a *NotFoundError when no ReturnItem was found.
+func (riq *ReturnItemQuery) First(ctx context.Context) (*ReturnItem, error) {
+	nodes, err := riq.Limit(1).All(setContextOp(ctx, riq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{returnitem.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (riq *ReturnItemQuery) FirstX(ctx context.Context) *ReturnItem {
+	node, err := riq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReturnItem ID from the query.
+// Returns a *NotFoundError when no ReturnItem ID was found.
+func (riq *ReturnItemQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = riq.Limit(1).IDs(setContextOp(ctx, riq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{returnitem.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (riq *ReturnItemQuery) FirstIDX(ctx context.Context) string {
+	id, err := riq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReturnItem entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReturnItem entity is found.
+// Returns a *NotFoundError when no ReturnItem entities are found.
+func (riq *ReturnItemQuery) Only(ctx context.Context) (*ReturnItem, error) {
+	nodes, err := riq.Limit(2).All(setContextOp(ctx, riq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{returnitem.Label}
+	default:
+		return nil, &NotSingularError{returnitem.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (riq *ReturnItemQuery) OnlyX(ctx context.Context) *ReturnItem {
+	node, err := riq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReturnItem ID in the query.
+// Returns a *NotSingularError when more than one ReturnItem ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (riq *ReturnItemQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = riq.Limit(2).IDs(setContextOp(ctx, riq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{returnitem.Label}
+	default:
+		err = &NotSingularError{returnitem.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (riq *ReturnItemQuery) OnlyIDX(ctx context.Context) string {
+	id, err := riq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReturnItems.
+func (riq *ReturnItemQuery) All(ctx context.Context) ([]*ReturnItem, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryAll)
+	if err := riq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReturnItem, *ReturnItemQuery]()
+	return withInterceptors[[]*ReturnItem](ctx, riq, qr, riq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (riq *ReturnItemQuery) AllX(ctx context.Context) []*ReturnItem {
+	nodes, err := riq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReturnItem IDs.
+func (riq *ReturnItemQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if riq.ctx.Unique == nil && riq.path != nil {
+		riq.Unique(true)
+	}
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryIDs)
+	if err = riq.Select(returnitem.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (riq *ReturnItemQuery) IDsX(ctx context.Context) []string {
+	ids, err := riq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (riq *ReturnItemQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryCount)
+	if err := riq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, riq, querierCount[*ReturnItemQuery](), riq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (riq *ReturnItemQuery) CountX(ctx context.Context) int {
+	count, err := riq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (riq *ReturnItemQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, riq.ctx, ent.OpQueryExist)
+	switch _, err := riq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (riq *ReturnItemQuery) ExistX(ctx context.Context) bool {
+	exist, err := riq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReturnItemQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (riq *ReturnItemQuery) Clone() *ReturnItemQuery {
+	if riq == nil {
+		return nil
+	}
+	return &ReturnItemQuery{
+		config:     riq.config,
+		ctx:        riq.ctx.Clone(),
+		order:      append([]returnitem.OrderOption{}, riq.order...),
+		inters:     append([]Interceptor{}, riq.inters...),
+		predicates: append([]predicate.ReturnItem{}, riq.predicates...),
+		withReturn: riq.withReturn.Clone(),
+		// clone intermediate query.
+		sql:  riq.sql.Clone(),
+		path: riq.path,
+	}
+}
+
+// WithReturn tells the query-builder to eager-load the nodes that are connected to
+// the "return" edge. The optional arguments are used to configure the query builder of the edge.
+func (riq *ReturnItemQuery) WithReturn(opts ...func(*ReturnRequestQuery)) *ReturnItemQuery {
+	query := (&ReturnRequestClient{config: riq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	riq.withReturn = query
+	return riq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ReturnID string `json:"return_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReturnItem.Query().
+//		GroupBy(returnitem.FieldReturnID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (riq *ReturnItemQuery) GroupBy(field string, fields ...string) *ReturnItemGroupBy {
+	riq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReturnItemGroupBy{build: riq}
+	grbuild.flds = &riq.ctx.Fields
+	grbuild.label = returnitem.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ReturnID string `json:"return_id,omitempty"`
+//	}
+//
+//	client.ReturnItem.Query().
+//		Select(returnitem.FieldReturnID).
+//		Scan(ctx, &v)
+func (riq *ReturnItemQuery) Select(fields ...string) *ReturnItemSelect {
+	riq.ctx.Fields = append(riq.ctx.Fields, fields...)
+	sbuild := &ReturnItemSelect{ReturnItemQuery: riq}
+	sbuild.label = returnitem.Label
+	sbuild.flds, sbuild.scan = &riq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReturnItemSelect configured with the given aggregations.
+func (riq *ReturnItemQuery) Aggregate(fns ...AggregateFunc) *ReturnItemSelect {
+	return riq.Select().Aggregate(fns...)
+}
+
+func (riq *ReturnItemQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range riq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, riq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range riq.ctx.Fields {
+		if !returnitem.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if riq.path != nil {
+		prev, err := riq.path(ctx)
+		if err != nil {
+			return err
+		}
+		riq.sql = prev
+	}
+	return nil
+}
+
+func (riq *ReturnItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReturnItem, error) {
+	var (
+		nodes       = []*ReturnItem{}
+		_spec       = riq.querySpec()
+		loadedTypes = [1]bool{
+			riq.withReturn != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReturnItem).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReturnItem{config: riq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, riq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := riq.withReturn; query != nil {
+		if err := riq.loadReturn(ctx, query, nodes, nil,
+			func(n *ReturnItem, e *ReturnRequest) { n.Edges.Return = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (riq *ReturnItemQuery) loadReturn(ctx context.Context, query *ReturnRequestQuery, nodes []*ReturnItem, init func(*ReturnItem), assign func(*ReturnItem, *ReturnRequest)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ReturnItem)
+	for i := range nodes {
+		fk := nodes[i].ReturnID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(returnrequest.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "return_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (riq *ReturnItemQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := riq.querySpec()
+	_spec.Node.Columns = riq.ctx.Fields
+	if len(riq.ctx.Fields) > 0 {
+		_spec.Unique = riq.ctx.Unique != nil && *riq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, riq.driver, _spec)
+}
+
+func (riq *ReturnItemQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(returnitem.Table, returnitem.Columns, sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString))
+	_spec.From = riq.sql
+	if unique := riq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if riq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := riq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, returnitem.FieldID)
+		for i := range fields {
+			if fields[i] != returnitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if riq.withReturn != nil {
+			_spec.Node.AddColumnOnce(returnitem.FieldReturnID)
+		}
+	}
+	if ps := riq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := riq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := riq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := riq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (riq *ReturnItemQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(riq.driver.Dialect())
+	t1 := builder.Table(returnitem.Table)
+	columns := riq.ctx.Fields
+	if len(columns) == 0 {
+		columns = returnitem.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if riq.sql != nil {
+		selector = riq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if riq.ctx.Unique != nil && *riq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range riq.predicates {
+		p(selector)
+	}
+	for _, p := range riq.order {
+		p(selector)
+	}
+	if offset := riq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := riq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReturnItemGroupBy is the group-by builder for ReturnItem entities.
+type ReturnItemGroupBy struct {
+	selector
+	build *ReturnItemQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rigb *ReturnItemGroupBy) Aggregate(fns ...AggregateFunc) *ReturnItemGroupBy {
+	rigb.fns = append(rigb.fns, fns...)
+	return rigb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rigb *ReturnItemGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rigb.build.ctx, ent.OpQueryGroupBy)
+	if err := rigb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReturnItemQuery, *ReturnItemGroupBy](ctx, rigb.build, rigb, rigb.build.inters, v)
+}
+
+func (rigb *ReturnItemGroupBy) sqlScan(ctx context.Context, root *ReturnItemQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rigb.fns))
+	for _, fn := range rigb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rigb.flds)+len(rigb.fns))
+		for _, f := range *rigb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rigb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rigb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReturnItemSelect is the builder for selecting fields of ReturnItem entities.
+type ReturnItemSelect struct {
+	*ReturnItemQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ris *ReturnItemSelect) Aggregate(fns ...AggregateFunc) *ReturnItemSelect {
+	ris.fns = append(ris.fns, fns...)
+	return ris
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ris *ReturnItemSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ris.ctx, ent.OpQuerySelect)
+	if err := ris.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReturnItemQuery, *ReturnItemSelect](ctx, ris.ReturnItemQuery, ris, ris.inters, v)
+}
+
+func (ris *ReturnItemSelect) sqlScan(ctx context.Context, root *ReturnItemQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ris.fns))
+	for _, fn := range ris.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ris.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ris.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
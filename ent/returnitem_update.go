@@ -0,0 +1,589 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnItemUpdate is the builder for updating ReturnItem entities.
+type ReturnItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReturnItemMutation
+}
+
+// Where appends a list predicates to the ReturnItemUpdate builder.
+func (riu *ReturnItemUpdate) Where(ps ...predicate.ReturnItem) *ReturnItemUpdate {
+	riu.mutation.Where(ps...)
+	return riu
+}
+
+// SetReturnID sets the "return_id" field.
+func (riu *ReturnItemUpdate) SetReturnID(s string) *ReturnItemUpdate {
+	riu.mutation.SetReturnID(s)
+	return riu
+}
+
+// SetNillableReturnID sets the "return_id" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableReturnID(s *string) *ReturnItemUpdate {
+	if s != nil {
+		riu.SetReturnID(*s)
+	}
+	return riu
+}
+
+// ClearReturnID clears the value of the "return_id" field.
+func (riu *ReturnItemUpdate) ClearReturnID() *ReturnItemUpdate {
+	riu.mutation.ClearReturnID()
+	return riu
+}
+
+// SetOrderItemID sets the "order_item_id" field.
+func (riu *ReturnItemUpdate) SetOrderItemID(s string) *ReturnItemUpdate {
+	riu.mutation.SetOrderItemID(s)
+	return riu
+}
+
+// SetNillableOrderItemID sets the "order_item_id" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableOrderItemID(s *string) *ReturnItemUpdate {
+	if s != nil {
+		riu.SetOrderItemID(*s)
+	}
+	return riu
+}
+
+// SetProductID sets the "product_id" field.
+func (riu *ReturnItemUpdate) SetProductID(s string) *ReturnItemUpdate {
+	riu.mutation.SetProductID(s)
+	return riu
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableProductID(s *string) *ReturnItemUpdate {
+	if s != nil {
+		riu.SetProductID(*s)
+	}
+	return riu
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (riu *ReturnItemUpdate) ClearProductID() *ReturnItemUpdate {
+	riu.mutation.ClearProductID()
+	return riu
+}
+
+// SetVariantID sets the "variant_id" field.
+func (riu *ReturnItemUpdate) SetVariantID(s string) *ReturnItemUpdate {
+	riu.mutation.SetVariantID(s)
+	return riu
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableVariantID(s *string) *ReturnItemUpdate {
+	if s != nil {
+		riu.SetVariantID(*s)
+	}
+	return riu
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (riu *ReturnItemUpdate) ClearVariantID() *ReturnItemUpdate {
+	riu.mutation.ClearVariantID()
+	return riu
+}
+
+// SetQuantity sets the "quantity" field.
+func (riu *ReturnItemUpdate) SetQuantity(i int) *ReturnItemUpdate {
+	riu.mutation.ResetQuantity()
+	riu.mutation.SetQuantity(i)
+	return riu
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableQuantity(i *int) *ReturnItemUpdate {
+	if i != nil {
+		riu.SetQuantity(*i)
+	}
+	return riu
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (riu *ReturnItemUpdate) AddQuantity(i int) *ReturnItemUpdate {
+	riu.mutation.AddQuantity(i)
+	return riu
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (riu *ReturnItemUpdate) SetUnitPrice(m money.Amount) *ReturnItemUpdate {
+	riu.mutation.ResetUnitPrice()
+	riu.mutation.SetUnitPrice(m)
+	return riu
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (riu *ReturnItemUpdate) SetNillableUnitPrice(m *money.Amount) *ReturnItemUpdate {
+	if m != nil {
+		riu.SetUnitPrice(*m)
+	}
+	return riu
+}
+
+// AddUnitPrice adds m to the "unit_price" field.
+func (riu *ReturnItemUpdate) AddUnitPrice(m money.Amount) *ReturnItemUpdate {
+	riu.mutation.AddUnitPrice(m)
+	return riu
+}
+
+// SetReturn sets the "return" edge to the ReturnRequest entity.
+func (riu *ReturnItemUpdate) SetReturn(r *ReturnRequest) *ReturnItemUpdate {
+	return riu.SetReturnID(r.ID)
+}
+
+// Mutation returns the ReturnItemMutation object of the builder.
+func (riu *ReturnItemUpdate) Mutation() *ReturnItemMutation {
+	return riu.mutation
+}
+
+// ClearReturn clears the "return" edge to the ReturnRequest entity.
+func (riu *ReturnItemUpdate) ClearReturn() *ReturnItemUpdate {
+	riu.mutation.ClearReturn()
+	return riu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (riu *ReturnItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, riu.sqlSave, riu.mutation, riu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (riu *ReturnItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := riu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (riu *ReturnItemUpdate) Exec(ctx context.Context) error {
+	_, err := riu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (riu *ReturnItemUpdate) ExecX(ctx context.Context) {
+	if err := riu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (riu *ReturnItemUpdate) check() error {
+	if v, ok := riu.mutation.OrderItemID(); ok {
+		if err := returnitem.OrderItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_item_id", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.order_item_id": %w`, err)}
+		}
+	}
+	if v, ok := riu.mutation.Quantity(); ok {
+		if err := returnitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.quantity": %w`, err)}
+		}
+	}
+	if v, ok := riu.mutation.UnitPrice(); ok {
+		if err := returnitem.UnitPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.unit_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (riu *ReturnItemUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := riu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(returnitem.Table, returnitem.Columns, sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString))
+	if ps := riu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := riu.mutation.OrderItemID(); ok {
+		_spec.SetField(returnitem.FieldOrderItemID, field.TypeString, value)
+	}
+	if value, ok := riu.mutation.ProductID(); ok {
+		_spec.SetField(returnitem.FieldProductID, field.TypeString, value)
+	}
+	if riu.mutation.ProductIDCleared() {
+		_spec.ClearField(returnitem.FieldProductID, field.TypeString)
+	}
+	if value, ok := riu.mutation.VariantID(); ok {
+		_spec.SetField(returnitem.FieldVariantID, field.TypeString, value)
+	}
+	if riu.mutation.VariantIDCleared() {
+		_spec.ClearField(returnitem.FieldVariantID, field.TypeString)
+	}
+	if value, ok := riu.mutation.Quantity(); ok {
+		_spec.SetField(returnitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := riu.mutation.AddedQuantity(); ok {
+		_spec.AddField(returnitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := riu.mutation.UnitPrice(); ok {
+		_spec.SetField(returnitem.FieldUnitPrice, field.TypeInt64, value)
+	}
+	if value, ok := riu.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(returnitem.FieldUnitPrice, field.TypeInt64, value)
+	}
+	if riu.mutation.ReturnCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnitem.ReturnTable,
+			Columns: []string{returnitem.ReturnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riu.mutation.ReturnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnitem.ReturnTable,
+			Columns: []string{returnitem.ReturnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, riu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{returnitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	riu.mutation.done = true
+	return n, nil
+}
+
+// ReturnItemUpdateOne is the builder for updating a single ReturnItem entity.
+type ReturnItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReturnItemMutation
+}
+
+// SetReturnID sets the "return_id" field.
+func (riuo *ReturnItemUpdateOne) SetReturnID(s string) *ReturnItemUpdateOne {
+	riuo.mutation.SetReturnID(s)
+	return riuo
+}
+
+// SetNillableReturnID sets the "return_id" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableReturnID(s *string) *ReturnItemUpdateOne {
+	if s != nil {
+		riuo.SetReturnID(*s)
+	}
+	return riuo
+}
+
+// ClearReturnID clears the value of the "return_id" field.
+func (riuo *ReturnItemUpdateOne) ClearReturnID() *ReturnItemUpdateOne {
+	riuo.mutation.ClearReturnID()
+	return riuo
+}
+
+// SetOrderItemID sets the "order_item_id" field.
+func (riuo *ReturnItemUpdateOne) SetOrderItemID(s string) *ReturnItemUpdateOne {
+	riuo.mutation.SetOrderItemID(s)
+	return riuo
+}
+
+// SetNillableOrderItemID sets the "order_item_id" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableOrderItemID(s *string) *ReturnItemUpdateOne {
+	if s != nil {
+		riuo.SetOrderItemID(*s)
+	}
+	return riuo
+}
+
+// SetProductID sets the "product_id" field.
+func (riuo *ReturnItemUpdateOne) SetProductID(s string) *ReturnItemUpdateOne {
+	riuo.mutation.SetProductID(s)
+	return riuo
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableProductID(s *string) *ReturnItemUpdateOne {
+	if s != nil {
+		riuo.SetProductID(*s)
+	}
+	return riuo
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (riuo *ReturnItemUpdateOne) ClearProductID() *ReturnItemUpdateOne {
+	riuo.mutation.ClearProductID()
+	return riuo
+}
+
+// SetVariantID sets the "variant_id" field.
+func (riuo *ReturnItemUpdateOne) SetVariantID(s string) *ReturnItemUpdateOne {
+	riuo.mutation.SetVariantID(s)
+	return riuo
+}
+
+// SetNillableVariantID sets the "variant_id" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableVariantID(s *string) *ReturnItemUpdateOne {
+	if s != nil {
+		riuo.SetVariantID(*s)
+	}
+	return riuo
+}
+
+// ClearVariantID clears the value of the "variant_id" field.
+func (riuo *ReturnItemUpdateOne) ClearVariantID() *ReturnItemUpdateOne {
+	riuo.mutation.ClearVariantID()
+	return riuo
+}
+
+// SetQuantity sets the "quantity" field.
+func (riuo *ReturnItemUpdateOne) SetQuantity(i int) *ReturnItemUpdateOne {
+	riuo.mutation.ResetQuantity()
+	riuo.mutation.SetQuantity(i)
+	return riuo
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableQuantity(i *int) *ReturnItemUpdateOne {
+	if i != nil {
+		riuo.SetQuantity(*i)
+	}
+	return riuo
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (riuo *ReturnItemUpdateOne) AddQuantity(i int) *ReturnItemUpdateOne {
+	riuo.mutation.AddQuantity(i)
+	return riuo
+}
+
+// SetUnitPrice sets the "unit_price" field.
+func (riuo *ReturnItemUpdateOne) SetUnitPrice(m money.Amount) *ReturnItemUpdateOne {
+	riuo.mutation.ResetUnitPrice()
+	riuo.mutation.SetUnitPrice(m)
+	return riuo
+}
+
+// SetNillableUnitPrice sets the "unit_price" field if the given value is not nil.
+func (riuo *ReturnItemUpdateOne) SetNillableUnitPrice(m *money.Amount) *ReturnItemUpdateOne {
+	if m != nil {
+		riuo.SetUnitPrice(*m)
+	}
+	return riuo
+}
+
+// AddUnitPrice adds m to the "unit_price" field.
+func (riuo *ReturnItemUpdateOne) AddUnitPrice(m money.Amount) *ReturnItemUpdateOne {
+	riuo.mutation.AddUnitPrice(m)
+	return riuo
+}
+
+// SetReturn sets the "return" edge to the ReturnRequest entity.
+func (riuo *ReturnItemUpdateOne) SetReturn(r *ReturnRequest) *ReturnItemUpdateOne {
+	return riuo.SetReturnID(r.ID)
+}
+
+// Mutation returns the ReturnItemMutation object of the builder.
+func (riuo *ReturnItemUpdateOne) Mutation() *ReturnItemMutation {
+	return riuo.mutation
+}
+
+// ClearReturn clears the "return" edge to the ReturnRequest entity.
+func (riuo *ReturnItemUpdateOne) ClearReturn() *ReturnItemUpdateOne {
+	riuo.mutation.ClearReturn()
+	return riuo
+}
+
+// Where appends a list predicates to the ReturnItemUpdate builder.
+func (riuo *ReturnItemUpdateOne) Where(ps ...predicate.ReturnItem) *ReturnItemUpdateOne {
+	riuo.mutation.Where(ps...)
+	return riuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (riuo *ReturnItemUpdateOne) Select(field string, fields ...string) *ReturnItemUpdateOne {
+	riuo.fields = append([]string{field}, fields...)
+	return riuo
+}
+
+// Save executes the query and returns the updated ReturnItem entity.
+func (riuo *ReturnItemUpdateOne) Save(ctx context.Context) (*ReturnItem, error) {
+	return withHooks(ctx, riuo.sqlSave, riuo.mutation, riuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (riuo *ReturnItemUpdateOne) SaveX(ctx context.Context) *ReturnItem {
+	node, err := riuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (riuo *ReturnItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := riuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (riuo *ReturnItemUpdateOne) ExecX(ctx context.Context) {
+	if err := riuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (riuo *ReturnItemUpdateOne) check() error {
+	if v, ok := riuo.mutation.OrderItemID(); ok {
+		if err := returnitem.OrderItemIDValidator(v); err != nil {
+			return &ValidationError{Name: "order_item_id", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.order_item_id": %w`, err)}
+		}
+	}
+	if v, ok := riuo.mutation.Quantity(); ok {
+		if err := returnitem.QuantityValidator(v); err != nil {
+			return &ValidationError{Name: "quantity", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.quantity": %w`, err)}
+		}
+	}
+	if v, ok := riuo.mutation.UnitPrice(); ok {
+		if err := returnitem.UnitPriceValidator(int64(v)); err != nil {
+			return &ValidationError{Name: "unit_price", err: fmt.Errorf(`ent: validator failed for field "ReturnItem.unit_price": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (riuo *ReturnItemUpdateOne) sqlSave(ctx context.Context) (_node *ReturnItem, err error) {
+	if err := riuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(returnitem.Table, returnitem.Columns, sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString))
+	id, ok := riuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReturnItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := riuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, returnitem.FieldID)
+		for _, f := range fields {
+			if !returnitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != returnitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := riuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := riuo.mutation.OrderItemID(); ok {
+		_spec.SetField(returnitem.FieldOrderItemID, field.TypeString, value)
+	}
+	if value, ok := riuo.mutation.ProductID(); ok {
+		_spec.SetField(returnitem.FieldProductID, field.TypeString, value)
+	}
+	if riuo.mutation.ProductIDCleared() {
+		_spec.ClearField(returnitem.FieldProductID, field.TypeString)
+	}
+	if value, ok := riuo.mutation.VariantID(); ok {
+		_spec.SetField(returnitem.FieldVariantID, field.TypeString, value)
+	}
+	if riuo.mutation.VariantIDCleared() {
+		_spec.ClearField(returnitem.FieldVariantID, field.TypeString)
+	}
+	if value, ok := riuo.mutation.Quantity(); ok {
+		_spec.SetField(returnitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := riuo.mutation.AddedQuantity(); ok {
+		_spec.AddField(returnitem.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := riuo.mutation.UnitPrice(); ok {
+		_spec.SetField(returnitem.FieldUnitPrice, field.TypeInt64, value)
+	}
+	if value, ok := riuo.mutation.AddedUnitPrice(); ok {
+		_spec.AddField(returnitem.FieldUnitPrice, field.TypeInt64, value)
+	}
+	if riuo.mutation.ReturnCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnitem.ReturnTable,
+			Columns: []string{returnitem.ReturnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := riuo.mutation.ReturnIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnitem.ReturnTable,
+			Columns: []string{returnitem.ReturnColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReturnItem{config: riuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, riuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{returnitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	riuo.mutation.done = true
+	return _node, nil
+}
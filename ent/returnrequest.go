@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnRequest is the model entity for the ReturnRequest schema.
+type ReturnRequest struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID string `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status returnrequest.Status `json:"status,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// RefundAmount holds the value of the "refund_amount" field.
+	RefundAmount money.Amount `json:"refund_amount,omitempty"`
+	// ReviewedBy holds the value of the "reviewed_by" field.
+	ReviewedBy string `json:"reviewed_by,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ReturnRequestQuery when eager-loading is set.
+	Edges        ReturnRequestEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ReturnRequestEdges holds the relations/edges for other nodes in the graph.
+type ReturnRequestEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*ReturnItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ReturnRequestEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e ReturnRequestEdges) ItemsOrErr() ([]*ReturnItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ReturnRequest) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case returnrequest.FieldRefundAmount:
+			values[i] = new(money.Amount)
+		case returnrequest.FieldID, returnrequest.FieldOrderID, returnrequest.FieldUserID, returnrequest.FieldStatus, returnrequest.FieldReason, returnrequest.FieldReviewedBy, returnrequest.FieldReviewNote:
+			values[i] = new(sql.NullString)
+		case returnrequest.FieldReviewedAt, returnrequest.FieldCreatedAt, returnrequest.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ReturnRequest fields.
+func (rr *ReturnRequest) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case returnrequest.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				rr.ID = value.String
+			}
+		case returnrequest.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				rr.OrderID = value.String
+			}
+		case returnrequest.FieldUserID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rr.UserID = value.String
+			}
+		case returnrequest.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rr.Status = returnrequest.Status(value.String)
+			}
+		case returnrequest.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				rr.Reason = value.String
+			}
+		case returnrequest.FieldRefundAmount:
+			if value, ok := values[i].(*money.Amount); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_amount", values[i])
+			} else if value != nil {
+				rr.RefundAmount = *value
+			}
+		case returnrequest.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				rr.ReviewedBy = value.String
+			}
+		case returnrequest.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				rr.ReviewNote = value.String
+			}
+		case returnrequest.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				rr.ReviewedAt = new(time.Time)
+				*rr.ReviewedAt = value.Time
+			}
+		case returnrequest.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rr.CreatedAt = value.Time
+			}
+		case returnrequest.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rr.UpdatedAt = value.Time
+			}
+		default:
+			rr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ReturnRequest.
+// This includes values selected through modifiers, order, etc.
+func (rr *ReturnRequest) Value(name string) (ent.Value, error) {
+	return rr.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the ReturnRequest entity.
+func (rr *ReturnRequest) QueryOrder() *OrderQuery {
+	return NewReturnRequestClient(rr.config).QueryOrder(rr)
+}
+
+// QueryItems queries the "items" edge of the ReturnRequest entity.
+func (rr *ReturnRequest) QueryItems() *ReturnItemQuery {
+	return NewReturnRequestClient(rr.config).QueryItems(rr)
+}
+
+// Update returns a builder for updating this ReturnRequest.
+// Note that you need to call ReturnRequest.Unwrap() before calling this method if this ReturnRequest
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rr *ReturnRequest) Update() *ReturnRequestUpdateOne {
+	return NewReturnRequestClient(rr.config).UpdateOne(rr)
+}
+
+// Unwrap unwraps the ReturnRequest entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rr *ReturnRequest) Unwrap() *ReturnRequest {
+	_tx, ok := rr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ReturnRequest is not a transactional entity")
+	}
+	rr.config.driver = _tx.drv
+	return rr
+}
+
+// String implements the fmt.Stringer.
+func (rr *ReturnRequest) String() string {
+	var builder strings.Builder
+	builder.WriteString("ReturnRequest(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rr.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(rr.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(rr.UserID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rr.Status))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(rr.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("refund_amount=")
+	builder.WriteString(fmt.Sprintf("%v", rr.RefundAmount))
+	builder.WriteString(", ")
+	builder.WriteString("reviewed_by=")
+	builder.WriteString(rr.ReviewedBy)
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(rr.ReviewNote)
+	builder.WriteString(", ")
+	if v := rr.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(rr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ReturnRequests is a parsable slice of ReturnRequest.
+type ReturnRequests []*ReturnRequest
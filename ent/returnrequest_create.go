@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnRequestCreate is the builder for creating a ReturnRequest entity.
+type ReturnRequestCreate struct {
+	config
+	mutation *ReturnRequestMutation
+	hooks    []Hook
+}
+
+// SetOrderID sets the "order_id" field.
+func (rrc *ReturnRequestCreate) SetOrderID(s string) *ReturnRequestCreate {
+	rrc.mutation.SetOrderID(s)
+	return rrc
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableOrderID(s *string) *ReturnRequestCreate {
+	if s != nil {
+		rrc.SetOrderID(*s)
+	}
+	return rrc
+}
+
+// SetUserID sets the "user_id" field.
+func (rrc *ReturnRequestCreate) SetUserID(s string) *ReturnRequestCreate {
+	rrc.mutation.SetUserID(s)
+	return rrc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableUserID(s *string) *ReturnRequestCreate {
+	if s != nil {
+		rrc.SetUserID(*s)
+	}
+	return rrc
+}
+
+// SetStatus sets the "status" field.
+func (rrc *ReturnRequestCreate) SetStatus(r returnrequest.Status) *ReturnRequestCreate {
+	rrc.mutation.SetStatus(r)
+	return rrc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableStatus(r *returnrequest.Status) *ReturnRequestCreate {
+	if r != nil {
+		rrc.SetStatus(*r)
+	}
+	return rrc
+}
+
+// SetReason sets the "reason" field.
+func (rrc *ReturnRequestCreate) SetReason(s string) *ReturnRequestCreate {
+	rrc.mutation.SetReason(s)
+	return rrc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableReason(s *string) *ReturnRequestCreate {
+	if s != nil {
+		rrc.SetReason(*s)
+	}
+	return rrc
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (rrc *ReturnRequestCreate) SetRefundAmount(m money.Amount) *ReturnRequestCreate {
+	rrc.mutation.SetRefundAmount(m)
+	return rrc
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableRefundAmount(m *money.Amount) *ReturnRequestCreate {
+	if m != nil {
+		rrc.SetRefundAmount(*m)
+	}
+	return rrc
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rrc *ReturnRequestCreate) SetReviewedBy(s string) *ReturnRequestCreate {
+	rrc.mutation.SetReviewedBy(s)
+	return rrc
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableReviewedBy(s *string) *ReturnRequestCreate {
+	if s != nil {
+		rrc.SetReviewedBy(*s)
+	}
+	return rrc
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rrc *ReturnRequestCreate) SetReviewNote(s string) *ReturnRequestCreate {
+	rrc.mutation.SetReviewNote(s)
+	return rrc
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableReviewNote(s *string) *ReturnRequestCreate {
+	if s != nil {
+		rrc.SetReviewNote(*s)
+	}
+	return rrc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rrc *ReturnRequestCreate) SetReviewedAt(t time.Time) *ReturnRequestCreate {
+	rrc.mutation.SetReviewedAt(t)
+	return rrc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableReviewedAt(t *time.Time) *ReturnRequestCreate {
+	if t != nil {
+		rrc.SetReviewedAt(*t)
+	}
+	return rrc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rrc *ReturnRequestCreate) SetCreatedAt(t time.Time) *ReturnRequestCreate {
+	rrc.mutation.SetCreatedAt(t)
+	return rrc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableCreatedAt(t *time.Time) *ReturnRequestCreate {
+	if t != nil {
+		rrc.SetCreatedAt(*t)
+	}
+	return rrc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rrc *ReturnRequestCreate) SetUpdatedAt(t time.Time) *ReturnRequestCreate {
+	rrc.mutation.SetUpdatedAt(t)
+	return rrc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rrc *ReturnRequestCreate) SetNillableUpdatedAt(t *time.Time) *ReturnRequestCreate {
+	if t != nil {
+		rrc.SetUpdatedAt(*t)
+	}
+	return rrc
+}
+
+// SetID sets the "id" field.
+func (rrc *ReturnRequestCreate) SetID(s string) *ReturnRequestCreate {
+	rrc.mutation.SetID(s)
+	return rrc
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (rrc *ReturnRequestCreate) SetOrder(o *Order) *ReturnRequestCreate {
+	return rrc.SetOrderID(o.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReturnItem entity by IDs.
+func (rrc *ReturnRequestCreate) AddItemIDs(ids ...string) *ReturnRequestCreate {
+	rrc.mutation.AddItemIDs(ids...)
+	return rrc
+}
+
+// AddItems adds the "items" edges to the ReturnItem entity.
+func (rrc *ReturnRequestCreate) AddItems(r ...*ReturnItem) *ReturnRequestCreate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rrc.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReturnRequestMutation object of the builder.
+func (rrc *ReturnRequestCreate) Mutation() *ReturnRequestMutation {
+	return rrc.mutation
+}
+
+// Save creates the ReturnRequest in the database.
+func (rrc *ReturnRequestCreate) Save(ctx context.Context) (*ReturnRequest, error) {
+	rrc.defaults()
+	return withHooks(ctx, rrc.sqlSave, rrc.mutation, rrc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rrc *ReturnRequestCreate) SaveX(ctx context.Context) *ReturnRequest {
+	v, err := rrc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrc *ReturnRequestCreate) Exec(ctx context.Context) error {
+	_, err := rrc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrc *ReturnRequestCreate) ExecX(ctx context.Context) {
+	if err := rrc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rrc *ReturnRequestCreate) defaults() {
+	if _, ok := rrc.mutation.Status(); !ok {
+		v := returnrequest.DefaultStatus
+		rrc.mutation.SetStatus(v)
+	}
+	if _, ok := rrc.mutation.RefundAmount(); !ok {
+		v := returnrequest.DefaultRefundAmount
+		rrc.mutation.SetRefundAmount(v)
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		v := returnrequest.DefaultCreatedAt()
+		rrc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		v := returnrequest.DefaultUpdatedAt()
+		rrc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rrc *ReturnRequestCreate) check() error {
+	if _, ok := rrc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ReturnRequest.status"`)}
+	}
+	if v, ok := rrc.mutation.Status(); ok {
+		if err := returnrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReturnRequest.status": %w`, err)}
+		}
+	}
+	if _, ok := rrc.mutation.RefundAmount(); !ok {
+		return &ValidationError{Name: "refund_amount", err: errors.New(`ent: missing required field "ReturnRequest.refund_amount"`)}
+	}
+	if _, ok := rrc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ReturnRequest.created_at"`)}
+	}
+	if _, ok := rrc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ReturnRequest.updated_at"`)}
+	}
+	return nil
+}
+
+func (rrc *ReturnRequestCreate) sqlSave(ctx context.Context) (*ReturnRequest, error) {
+	if err := rrc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rrc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rrc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected ReturnRequest.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rrc.mutation.id = &_node.ID
+	rrc.mutation.done = true
+	return _node, nil
+}
+
+func (rrc *ReturnRequestCreate) createSpec() (*ReturnRequest, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ReturnRequest{config: rrc.config}
+		_spec = sqlgraph.NewCreateSpec(returnrequest.Table, sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString))
+	)
+	if id, ok := rrc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rrc.mutation.UserID(); ok {
+		_spec.SetField(returnrequest.FieldUserID, field.TypeString, value)
+		_node.UserID = value
+	}
+	if value, ok := rrc.mutation.Status(); ok {
+		_spec.SetField(returnrequest.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := rrc.mutation.Reason(); ok {
+		_spec.SetField(returnrequest.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := rrc.mutation.RefundAmount(); ok {
+		_spec.SetField(returnrequest.FieldRefundAmount, field.TypeInt64, value)
+		_node.RefundAmount = value
+	}
+	if value, ok := rrc.mutation.ReviewedBy(); ok {
+		_spec.SetField(returnrequest.FieldReviewedBy, field.TypeString, value)
+		_node.ReviewedBy = value
+	}
+	if value, ok := rrc.mutation.ReviewNote(); ok {
+		_spec.SetField(returnrequest.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := rrc.mutation.ReviewedAt(); ok {
+		_spec.SetField(returnrequest.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := rrc.mutation.CreatedAt(); ok {
+		_spec.SetField(returnrequest.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rrc.mutation.UpdatedAt(); ok {
+		_spec.SetField(returnrequest.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := rrc.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnrequest.OrderTable,
+			Columns: []string{returnrequest.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrderID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := rrc.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ReturnRequestCreateBulk is the builder for creating many ReturnRequest entities in bulk.
+type ReturnRequestCreateBulk struct {
+	config
+	err      error
+	builders []*ReturnRequestCreate
+}
+
+// Save creates the ReturnRequest entities in the database.
+func (rrcb *ReturnRequestCreateBulk) Save(ctx context.Context) ([]*ReturnRequest, error) {
+	if rrcb.err != nil {
+		return nil, rrcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rrcb.builders))
+	nodes := make([]*ReturnRequest, len(rrcb.builders))
+	mutators := make([]Mutator, len(rrcb.builders))
+	for i := range rrcb.builders {
+		func(i int, root context.Context) {
+			builder := rrcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReturnRequestMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rrcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rrcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rrcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rrcb *ReturnRequestCreateBulk) SaveX(ctx context.Context) []*ReturnRequest {
+	v, err := rrcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rrcb *ReturnRequestCreateBulk) Exec(ctx context.Context) error {
+	_, err := rrcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrcb *ReturnRequestCreateBulk) ExecX(ctx context.Context) {
+	if err := rrcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
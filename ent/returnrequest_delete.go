@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+)
+
+// ReturnRequestDelete is the builder for deleting a ReturnRequest entity.
+type ReturnRequestDelete struct {
+	config
+	hooks    []Hook
+	mutation *ReturnRequestMutation
+}
+
+// Where appends a list predicates to the ReturnRequestDelete builder.
+func (rrd *ReturnRequestDelete) Where(ps ...predicate.ReturnRequest) *ReturnRequestDelete {
+	rrd.mutation.Where(ps...)
+	return rrd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rrd *ReturnRequestDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rrd.sqlExec, rrd.mutation, rrd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrd *ReturnRequestDelete) ExecX(ctx context.Context) int {
+	n, err := rrd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rrd *ReturnRequestDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(returnrequest.Table, sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString))
+	if ps := rrd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rrd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rrd.mutation.done = true
+	return affected, err
+}
+
+// ReturnRequestDeleteOne is the builder for deleting a single ReturnRequest entity.
+type ReturnRequestDeleteOne struct {
+	rrd *ReturnRequestDelete
+}
+
+// Where appends a list predicates to the ReturnRequestDelete builder.
+func (rrdo *ReturnRequestDeleteOne) Where(ps ...predicate.ReturnRequest) *ReturnRequestDeleteOne {
+	rrdo.rrd.mutation.Where(ps...)
+	return rrdo
+}
+
+// Exec executes the deletion query.
+func (rrdo *ReturnRequestDeleteOne) Exec(ctx context.Context) error {
+	n, err := rrdo.rrd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{returnrequest.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rrdo *ReturnRequestDeleteOne) ExecX(ctx context.Context) {
+	if err := rrdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,681 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+)
+
+// ReturnRequestQuery is the builder for querying ReturnRequest entities.
+type ReturnRequestQuery struct {
+	config
+	ctx        *QueryContext
+	order      []returnrequest.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ReturnRequest
+	withOrder  *OrderQuery
+	withItems  *ReturnItemQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ReturnRequestQuery builder.
+func (rrq *ReturnRequestQuery) Where(ps ...predicate.ReturnRequest) *ReturnRequestQuery {
+	rrq.predicates = append(rrq.predicates, ps...)
+	return rrq
+}
+
+// Limit the number of records to be returned by this query.
+func (rrq *ReturnRequestQuery) Limit(limit int) *ReturnRequestQuery {
+	rrq.ctx.Limit = &limit
+	return rrq
+}
+
+// Offset to start from.
+func (rrq *ReturnRequestQuery) Offset(offset int) *ReturnRequestQuery {
+	rrq.ctx.Offset = &offset
+	return rrq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rrq *ReturnRequestQuery) Unique(unique bool) *ReturnRequestQuery {
+	rrq.ctx.Unique = &unique
+	return rrq
+}
+
+// Order specifies how the records should be ordered.
+func (rrq *ReturnRequestQuery) Order(o ...returnrequest.OrderOption) *ReturnRequestQuery {
+	rrq.order = append(rrq.order, o...)
+	return rrq
+}
+
+// QueryOrder chains the current query on the "order" edge.
+func (rrq *ReturnRequestQuery) QueryOrder() *OrderQuery {
+	query := (&OrderClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(returnrequest.Table, returnrequest.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, returnrequest.OrderTable, returnrequest.OrderColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryItems chains the current query on the "items" edge.
+func (rrq *ReturnRequestQuery) QueryItems() *ReturnItemQuery {
+	query := (&ReturnItemClient{config: rrq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := rrq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := rrq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(returnrequest.Table, returnrequest.FieldID, selector),
+			sqlgraph.To(returnitem.Table, returnitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, returnrequest.ItemsTable, returnrequest.ItemsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(rrq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ReturnRequest entity from the query.
+// Returns a *NotFoundError when no ReturnRequest was found.
+func (rrq *ReturnRequestQuery) First(ctx context.Context) (*ReturnRequest, error) {
+	nodes, err := rrq.Limit(1).All(setContextOp(ctx, rrq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{returnrequest.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) FirstX(ctx context.Context) *ReturnRequest {
+	node, err := rrq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ReturnRequest ID from the query.
+// Returns a *NotFoundError when no ReturnRequest ID was found.
+func (rrq *ReturnRequestQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rrq.Limit(1).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{returnrequest.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) FirstIDX(ctx context.Context) string {
+	id, err := rrq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ReturnRequest entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ReturnRequest entity is found.
+// Returns a *NotFoundError when no ReturnRequest entities are found.
+func (rrq *ReturnRequestQuery) Only(ctx context.Context) (*ReturnRequest, error) {
+	nodes, err := rrq.Limit(2).All(setContextOp(ctx, rrq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{returnrequest.Label}
+	default:
+		return nil, &NotSingularError{returnrequest.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) OnlyX(ctx context.Context) *ReturnRequest {
+	node, err := rrq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ReturnRequest ID in the query.
+// Returns a *NotSingularError when more than one ReturnRequest ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rrq *ReturnRequestQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = rrq.Limit(2).IDs(setContextOp(ctx, rrq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{returnrequest.Label}
+	default:
+		err = &NotSingularError{returnrequest.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) OnlyIDX(ctx context.Context) string {
+	id, err := rrq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ReturnRequests.
+func (rrq *ReturnRequestQuery) All(ctx context.Context) ([]*ReturnRequest, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryAll)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ReturnRequest, *ReturnRequestQuery]()
+	return withInterceptors[[]*ReturnRequest](ctx, rrq, qr, rrq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) AllX(ctx context.Context) []*ReturnRequest {
+	nodes, err := rrq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ReturnRequest IDs.
+func (rrq *ReturnRequestQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if rrq.ctx.Unique == nil && rrq.path != nil {
+		rrq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryIDs)
+	if err = rrq.Select(returnrequest.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) IDsX(ctx context.Context) []string {
+	ids, err := rrq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rrq *ReturnRequestQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryCount)
+	if err := rrq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rrq, querierCount[*ReturnRequestQuery](), rrq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) CountX(ctx context.Context) int {
+	count, err := rrq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rrq *ReturnRequestQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rrq.ctx, ent.OpQueryExist)
+	switch _, err := rrq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rrq *ReturnRequestQuery) ExistX(ctx context.Context) bool {
+	exist, err := rrq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ReturnRequestQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rrq *ReturnRequestQuery) Clone() *ReturnRequestQuery {
+	if rrq == nil {
+		return nil
+	}
+	return &ReturnRequestQuery{
+		config:     rrq.config,
+		ctx:        rrq.ctx.Clone(),
+		order:      append([]returnrequest.OrderOption{}, rrq.order...),
+		inters:     append([]Interceptor{}, rrq.inters...),
+		predicates: append([]predicate.ReturnRequest{}, rrq.predicates...),
+		withOrder:  rrq.withOrder.Clone(),
+		withItems:  rrq.withItems.Clone(),
+		// clone intermediate query.
+		sql:  rrq.sql.Clone(),
+		path: rrq.path,
+	}
+}
+
+// WithOrder tells the query-builder to eager-load the nodes that are connected to
+// the "order" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ReturnRequestQuery) WithOrder(opts ...func(*OrderQuery)) *ReturnRequestQuery {
+	query := (&OrderClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withOrder = query
+	return rrq
+}
+
+// WithItems tells the query-builder to eager-load the nodes that are connected to
+// the "items" edge. The optional arguments are used to configure the query builder of the edge.
+func (rrq *ReturnRequestQuery) WithItems(opts ...func(*ReturnItemQuery)) *ReturnRequestQuery {
+	query := (&ReturnItemClient{config: rrq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	rrq.withItems = query
+	return rrq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID string `json:"order_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ReturnRequest.Query().
+//		GroupBy(returnrequest.FieldOrderID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rrq *ReturnRequestQuery) GroupBy(field string, fields ...string) *ReturnRequestGroupBy {
+	rrq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ReturnRequestGroupBy{build: rrq}
+	grbuild.flds = &rrq.ctx.Fields
+	grbuild.label = returnrequest.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		OrderID string `json:"order_id,omitempty"`
+//	}
+//
+//	client.ReturnRequest.Query().
+//		Select(returnrequest.FieldOrderID).
+//		Scan(ctx, &v)
+func (rrq *ReturnRequestQuery) Select(fields ...string) *ReturnRequestSelect {
+	rrq.ctx.Fields = append(rrq.ctx.Fields, fields...)
+	sbuild := &ReturnRequestSelect{ReturnRequestQuery: rrq}
+	sbuild.label = returnrequest.Label
+	sbuild.flds, sbuild.scan = &rrq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ReturnRequestSelect configured with the given aggregations.
+func (rrq *ReturnRequestQuery) Aggregate(fns ...AggregateFunc) *ReturnRequestSelect {
+	return rrq.Select().Aggregate(fns...)
+}
+
+func (rrq *ReturnRequestQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rrq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rrq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rrq.ctx.Fields {
+		if !returnrequest.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rrq.path != nil {
+		prev, err := rrq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rrq.sql = prev
+	}
+	return nil
+}
+
+func (rrq *ReturnRequestQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ReturnRequest, error) {
+	var (
+		nodes       = []*ReturnRequest{}
+		_spec       = rrq.querySpec()
+		loadedTypes = [2]bool{
+			rrq.withOrder != nil,
+			rrq.withItems != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ReturnRequest).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ReturnRequest{config: rrq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rrq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := rrq.withOrder; query != nil {
+		if err := rrq.loadOrder(ctx, query, nodes, nil,
+			func(n *ReturnRequest, e *Order) { n.Edges.Order = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := rrq.withItems; query != nil {
+		if err := rrq.loadItems(ctx, query, nodes,
+			func(n *ReturnRequest) { n.Edges.Items = []*ReturnItem{} },
+			func(n *ReturnRequest, e *ReturnItem) { n.Edges.Items = append(n.Edges.Items, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (rrq *ReturnRequestQuery) loadOrder(ctx context.Context, query *OrderQuery, nodes []*ReturnRequest, init func(*ReturnRequest), assign func(*ReturnRequest, *Order)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*ReturnRequest)
+	for i := range nodes {
+		fk := nodes[i].OrderID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(order.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "order_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (rrq *ReturnRequestQuery) loadItems(ctx context.Context, query *ReturnItemQuery, nodes []*ReturnRequest, init func(*ReturnRequest), assign func(*ReturnRequest, *ReturnItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*ReturnRequest)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(returnitem.FieldReturnID)
+	}
+	query.Where(predicate.ReturnItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(returnrequest.ItemsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ReturnID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "return_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (rrq *ReturnRequestQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rrq.querySpec()
+	_spec.Node.Columns = rrq.ctx.Fields
+	if len(rrq.ctx.Fields) > 0 {
+		_spec.Unique = rrq.ctx.Unique != nil && *rrq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rrq.driver, _spec)
+}
+
+func (rrq *ReturnRequestQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(returnrequest.Table, returnrequest.Columns, sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString))
+	_spec.From = rrq.sql
+	if unique := rrq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rrq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rrq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, returnrequest.FieldID)
+		for i := range fields {
+			if fields[i] != returnrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if rrq.withOrder != nil {
+			_spec.Node.AddColumnOnce(returnrequest.FieldOrderID)
+		}
+	}
+	if ps := rrq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rrq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rrq *ReturnRequestQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rrq.driver.Dialect())
+	t1 := builder.Table(returnrequest.Table)
+	columns := rrq.ctx.Fields
+	if len(columns) == 0 {
+		columns = returnrequest.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rrq.sql != nil {
+		selector = rrq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rrq.ctx.Unique != nil && *rrq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rrq.predicates {
+		p(selector)
+	}
+	for _, p := range rrq.order {
+		p(selector)
+	}
+	if offset := rrq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rrq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ReturnRequestGroupBy is the group-by builder for ReturnRequest entities.
+type ReturnRequestGroupBy struct {
+	selector
+	build *ReturnRequestQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rrgb *ReturnRequestGroupBy) Aggregate(fns ...AggregateFunc) *ReturnRequestGroupBy {
+	rrgb.fns = append(rrgb.fns, fns...)
+	return rrgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrgb *ReturnRequestGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rrgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReturnRequestQuery, *ReturnRequestGroupBy](ctx, rrgb.build, rrgb, rrgb.build.inters, v)
+}
+
+func (rrgb *ReturnRequestGroupBy) sqlScan(ctx context.Context, root *ReturnRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rrgb.fns))
+	for _, fn := range rrgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rrgb.flds)+len(rrgb.fns))
+		for _, f := range *rrgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rrgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ReturnRequestSelect is the builder for selecting fields of ReturnRequest entities.
+type ReturnRequestSelect struct {
+	*ReturnRequestQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rrs *ReturnRequestSelect) Aggregate(fns ...AggregateFunc) *ReturnRequestSelect {
+	rrs.fns = append(rrs.fns, fns...)
+	return rrs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rrs *ReturnRequestSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rrs.ctx, ent.OpQuerySelect)
+	if err := rrs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ReturnRequestQuery, *ReturnRequestSelect](ctx, rrs.ReturnRequestQuery, rrs, rrs.inters, v)
+}
+
+func (rrs *ReturnRequestSelect) sqlScan(ctx context.Context, root *ReturnRequestQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rrs.fns))
+	for _, fn := range rrs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rrs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rrs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
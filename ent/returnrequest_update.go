@@ -0,0 +1,905 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnRequestUpdate is the builder for updating ReturnRequest entities.
+type ReturnRequestUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReturnRequestMutation
+}
+
+// Where appends a list predicates to the ReturnRequestUpdate builder.
+func (rru *ReturnRequestUpdate) Where(ps ...predicate.ReturnRequest) *ReturnRequestUpdate {
+	rru.mutation.Where(ps...)
+	return rru
+}
+
+// SetOrderID sets the "order_id" field.
+func (rru *ReturnRequestUpdate) SetOrderID(s string) *ReturnRequestUpdate {
+	rru.mutation.SetOrderID(s)
+	return rru
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableOrderID(s *string) *ReturnRequestUpdate {
+	if s != nil {
+		rru.SetOrderID(*s)
+	}
+	return rru
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (rru *ReturnRequestUpdate) ClearOrderID() *ReturnRequestUpdate {
+	rru.mutation.ClearOrderID()
+	return rru
+}
+
+// SetUserID sets the "user_id" field.
+func (rru *ReturnRequestUpdate) SetUserID(s string) *ReturnRequestUpdate {
+	rru.mutation.SetUserID(s)
+	return rru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableUserID(s *string) *ReturnRequestUpdate {
+	if s != nil {
+		rru.SetUserID(*s)
+	}
+	return rru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (rru *ReturnRequestUpdate) ClearUserID() *ReturnRequestUpdate {
+	rru.mutation.ClearUserID()
+	return rru
+}
+
+// SetStatus sets the "status" field.
+func (rru *ReturnRequestUpdate) SetStatus(r returnrequest.Status) *ReturnRequestUpdate {
+	rru.mutation.SetStatus(r)
+	return rru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableStatus(r *returnrequest.Status) *ReturnRequestUpdate {
+	if r != nil {
+		rru.SetStatus(*r)
+	}
+	return rru
+}
+
+// SetReason sets the "reason" field.
+func (rru *ReturnRequestUpdate) SetReason(s string) *ReturnRequestUpdate {
+	rru.mutation.SetReason(s)
+	return rru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableReason(s *string) *ReturnRequestUpdate {
+	if s != nil {
+		rru.SetReason(*s)
+	}
+	return rru
+}
+
+// ClearReason clears the value of the "reason" field.
+func (rru *ReturnRequestUpdate) ClearReason() *ReturnRequestUpdate {
+	rru.mutation.ClearReason()
+	return rru
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (rru *ReturnRequestUpdate) SetRefundAmount(m money.Amount) *ReturnRequestUpdate {
+	rru.mutation.ResetRefundAmount()
+	rru.mutation.SetRefundAmount(m)
+	return rru
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableRefundAmount(m *money.Amount) *ReturnRequestUpdate {
+	if m != nil {
+		rru.SetRefundAmount(*m)
+	}
+	return rru
+}
+
+// AddRefundAmount adds m to the "refund_amount" field.
+func (rru *ReturnRequestUpdate) AddRefundAmount(m money.Amount) *ReturnRequestUpdate {
+	rru.mutation.AddRefundAmount(m)
+	return rru
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rru *ReturnRequestUpdate) SetReviewedBy(s string) *ReturnRequestUpdate {
+	rru.mutation.SetReviewedBy(s)
+	return rru
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableReviewedBy(s *string) *ReturnRequestUpdate {
+	if s != nil {
+		rru.SetReviewedBy(*s)
+	}
+	return rru
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rru *ReturnRequestUpdate) ClearReviewedBy() *ReturnRequestUpdate {
+	rru.mutation.ClearReviewedBy()
+	return rru
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rru *ReturnRequestUpdate) SetReviewNote(s string) *ReturnRequestUpdate {
+	rru.mutation.SetReviewNote(s)
+	return rru
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableReviewNote(s *string) *ReturnRequestUpdate {
+	if s != nil {
+		rru.SetReviewNote(*s)
+	}
+	return rru
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (rru *ReturnRequestUpdate) ClearReviewNote() *ReturnRequestUpdate {
+	rru.mutation.ClearReviewNote()
+	return rru
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rru *ReturnRequestUpdate) SetReviewedAt(t time.Time) *ReturnRequestUpdate {
+	rru.mutation.SetReviewedAt(t)
+	return rru
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableReviewedAt(t *time.Time) *ReturnRequestUpdate {
+	if t != nil {
+		rru.SetReviewedAt(*t)
+	}
+	return rru
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (rru *ReturnRequestUpdate) ClearReviewedAt() *ReturnRequestUpdate {
+	rru.mutation.ClearReviewedAt()
+	return rru
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rru *ReturnRequestUpdate) SetCreatedAt(t time.Time) *ReturnRequestUpdate {
+	rru.mutation.SetCreatedAt(t)
+	return rru
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rru *ReturnRequestUpdate) SetNillableCreatedAt(t *time.Time) *ReturnRequestUpdate {
+	if t != nil {
+		rru.SetCreatedAt(*t)
+	}
+	return rru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rru *ReturnRequestUpdate) SetUpdatedAt(t time.Time) *ReturnRequestUpdate {
+	rru.mutation.SetUpdatedAt(t)
+	return rru
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (rru *ReturnRequestUpdate) SetOrder(o *Order) *ReturnRequestUpdate {
+	return rru.SetOrderID(o.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReturnItem entity by IDs.
+func (rru *ReturnRequestUpdate) AddItemIDs(ids ...string) *ReturnRequestUpdate {
+	rru.mutation.AddItemIDs(ids...)
+	return rru
+}
+
+// AddItems adds the "items" edges to the ReturnItem entity.
+func (rru *ReturnRequestUpdate) AddItems(r ...*ReturnItem) *ReturnRequestUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReturnRequestMutation object of the builder.
+func (rru *ReturnRequestUpdate) Mutation() *ReturnRequestMutation {
+	return rru.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (rru *ReturnRequestUpdate) ClearOrder() *ReturnRequestUpdate {
+	rru.mutation.ClearOrder()
+	return rru
+}
+
+// ClearItems clears all "items" edges to the ReturnItem entity.
+func (rru *ReturnRequestUpdate) ClearItems() *ReturnRequestUpdate {
+	rru.mutation.ClearItems()
+	return rru
+}
+
+// RemoveItemIDs removes the "items" edge to ReturnItem entities by IDs.
+func (rru *ReturnRequestUpdate) RemoveItemIDs(ids ...string) *ReturnRequestUpdate {
+	rru.mutation.RemoveItemIDs(ids...)
+	return rru
+}
+
+// RemoveItems removes "items" edges to ReturnItem entities.
+func (rru *ReturnRequestUpdate) RemoveItems(r ...*ReturnItem) *ReturnRequestUpdate {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rru.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rru *ReturnRequestUpdate) Save(ctx context.Context) (int, error) {
+	rru.defaults()
+	return withHooks(ctx, rru.sqlSave, rru.mutation, rru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rru *ReturnRequestUpdate) SaveX(ctx context.Context) int {
+	affected, err := rru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rru *ReturnRequestUpdate) Exec(ctx context.Context) error {
+	_, err := rru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rru *ReturnRequestUpdate) ExecX(ctx context.Context) {
+	if err := rru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rru *ReturnRequestUpdate) defaults() {
+	if _, ok := rru.mutation.UpdatedAt(); !ok {
+		v := returnrequest.UpdateDefaultUpdatedAt()
+		rru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rru *ReturnRequestUpdate) check() error {
+	if v, ok := rru.mutation.Status(); ok {
+		if err := returnrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReturnRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rru *ReturnRequestUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := rru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(returnrequest.Table, returnrequest.Columns, sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString))
+	if ps := rru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rru.mutation.UserID(); ok {
+		_spec.SetField(returnrequest.FieldUserID, field.TypeString, value)
+	}
+	if rru.mutation.UserIDCleared() {
+		_spec.ClearField(returnrequest.FieldUserID, field.TypeString)
+	}
+	if value, ok := rru.mutation.Status(); ok {
+		_spec.SetField(returnrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rru.mutation.Reason(); ok {
+		_spec.SetField(returnrequest.FieldReason, field.TypeString, value)
+	}
+	if rru.mutation.ReasonCleared() {
+		_spec.ClearField(returnrequest.FieldReason, field.TypeString)
+	}
+	if value, ok := rru.mutation.RefundAmount(); ok {
+		_spec.SetField(returnrequest.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(returnrequest.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := rru.mutation.ReviewedBy(); ok {
+		_spec.SetField(returnrequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if rru.mutation.ReviewedByCleared() {
+		_spec.ClearField(returnrequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := rru.mutation.ReviewNote(); ok {
+		_spec.SetField(returnrequest.FieldReviewNote, field.TypeString, value)
+	}
+	if rru.mutation.ReviewNoteCleared() {
+		_spec.ClearField(returnrequest.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := rru.mutation.ReviewedAt(); ok {
+		_spec.SetField(returnrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if rru.mutation.ReviewedAtCleared() {
+		_spec.ClearField(returnrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := rru.mutation.CreatedAt(); ok {
+		_spec.SetField(returnrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rru.mutation.UpdatedAt(); ok {
+		_spec.SetField(returnrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rru.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnrequest.OrderTable,
+			Columns: []string{returnrequest.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnrequest.OrderTable,
+			Columns: []string{returnrequest.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rru.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.RemovedItemsIDs(); len(nodes) > 0 && !rru.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rru.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{returnrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rru.mutation.done = true
+	return n, nil
+}
+
+// ReturnRequestUpdateOne is the builder for updating a single ReturnRequest entity.
+type ReturnRequestUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReturnRequestMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (rruo *ReturnRequestUpdateOne) SetOrderID(s string) *ReturnRequestUpdateOne {
+	rruo.mutation.SetOrderID(s)
+	return rruo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableOrderID(s *string) *ReturnRequestUpdateOne {
+	if s != nil {
+		rruo.SetOrderID(*s)
+	}
+	return rruo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (rruo *ReturnRequestUpdateOne) ClearOrderID() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearOrderID()
+	return rruo
+}
+
+// SetUserID sets the "user_id" field.
+func (rruo *ReturnRequestUpdateOne) SetUserID(s string) *ReturnRequestUpdateOne {
+	rruo.mutation.SetUserID(s)
+	return rruo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableUserID(s *string) *ReturnRequestUpdateOne {
+	if s != nil {
+		rruo.SetUserID(*s)
+	}
+	return rruo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (rruo *ReturnRequestUpdateOne) ClearUserID() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearUserID()
+	return rruo
+}
+
+// SetStatus sets the "status" field.
+func (rruo *ReturnRequestUpdateOne) SetStatus(r returnrequest.Status) *ReturnRequestUpdateOne {
+	rruo.mutation.SetStatus(r)
+	return rruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableStatus(r *returnrequest.Status) *ReturnRequestUpdateOne {
+	if r != nil {
+		rruo.SetStatus(*r)
+	}
+	return rruo
+}
+
+// SetReason sets the "reason" field.
+func (rruo *ReturnRequestUpdateOne) SetReason(s string) *ReturnRequestUpdateOne {
+	rruo.mutation.SetReason(s)
+	return rruo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableReason(s *string) *ReturnRequestUpdateOne {
+	if s != nil {
+		rruo.SetReason(*s)
+	}
+	return rruo
+}
+
+// ClearReason clears the value of the "reason" field.
+func (rruo *ReturnRequestUpdateOne) ClearReason() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearReason()
+	return rruo
+}
+
+// SetRefundAmount sets the "refund_amount" field.
+func (rruo *ReturnRequestUpdateOne) SetRefundAmount(m money.Amount) *ReturnRequestUpdateOne {
+	rruo.mutation.ResetRefundAmount()
+	rruo.mutation.SetRefundAmount(m)
+	return rruo
+}
+
+// SetNillableRefundAmount sets the "refund_amount" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableRefundAmount(m *money.Amount) *ReturnRequestUpdateOne {
+	if m != nil {
+		rruo.SetRefundAmount(*m)
+	}
+	return rruo
+}
+
+// AddRefundAmount adds m to the "refund_amount" field.
+func (rruo *ReturnRequestUpdateOne) AddRefundAmount(m money.Amount) *ReturnRequestUpdateOne {
+	rruo.mutation.AddRefundAmount(m)
+	return rruo
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (rruo *ReturnRequestUpdateOne) SetReviewedBy(s string) *ReturnRequestUpdateOne {
+	rruo.mutation.SetReviewedBy(s)
+	return rruo
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableReviewedBy(s *string) *ReturnRequestUpdateOne {
+	if s != nil {
+		rruo.SetReviewedBy(*s)
+	}
+	return rruo
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (rruo *ReturnRequestUpdateOne) ClearReviewedBy() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearReviewedBy()
+	return rruo
+}
+
+// SetReviewNote sets the "review_note" field.
+func (rruo *ReturnRequestUpdateOne) SetReviewNote(s string) *ReturnRequestUpdateOne {
+	rruo.mutation.SetReviewNote(s)
+	return rruo
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableReviewNote(s *string) *ReturnRequestUpdateOne {
+	if s != nil {
+		rruo.SetReviewNote(*s)
+	}
+	return rruo
+}
+
+// ClearReviewNote clears the value of the "review_note" field.
+func (rruo *ReturnRequestUpdateOne) ClearReviewNote() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearReviewNote()
+	return rruo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (rruo *ReturnRequestUpdateOne) SetReviewedAt(t time.Time) *ReturnRequestUpdateOne {
+	rruo.mutation.SetReviewedAt(t)
+	return rruo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableReviewedAt(t *time.Time) *ReturnRequestUpdateOne {
+	if t != nil {
+		rruo.SetReviewedAt(*t)
+	}
+	return rruo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (rruo *ReturnRequestUpdateOne) ClearReviewedAt() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearReviewedAt()
+	return rruo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rruo *ReturnRequestUpdateOne) SetCreatedAt(t time.Time) *ReturnRequestUpdateOne {
+	rruo.mutation.SetCreatedAt(t)
+	return rruo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rruo *ReturnRequestUpdateOne) SetNillableCreatedAt(t *time.Time) *ReturnRequestUpdateOne {
+	if t != nil {
+		rruo.SetCreatedAt(*t)
+	}
+	return rruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rruo *ReturnRequestUpdateOne) SetUpdatedAt(t time.Time) *ReturnRequestUpdateOne {
+	rruo.mutation.SetUpdatedAt(t)
+	return rruo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (rruo *ReturnRequestUpdateOne) SetOrder(o *Order) *ReturnRequestUpdateOne {
+	return rruo.SetOrderID(o.ID)
+}
+
+// AddItemIDs adds the "items" edge to the ReturnItem entity by IDs.
+func (rruo *ReturnRequestUpdateOne) AddItemIDs(ids ...string) *ReturnRequestUpdateOne {
+	rruo.mutation.AddItemIDs(ids...)
+	return rruo
+}
+
+// AddItems adds the "items" edges to the ReturnItem entity.
+func (rruo *ReturnRequestUpdateOne) AddItems(r ...*ReturnItem) *ReturnRequestUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.AddItemIDs(ids...)
+}
+
+// Mutation returns the ReturnRequestMutation object of the builder.
+func (rruo *ReturnRequestUpdateOne) Mutation() *ReturnRequestMutation {
+	return rruo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (rruo *ReturnRequestUpdateOne) ClearOrder() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearOrder()
+	return rruo
+}
+
+// ClearItems clears all "items" edges to the ReturnItem entity.
+func (rruo *ReturnRequestUpdateOne) ClearItems() *ReturnRequestUpdateOne {
+	rruo.mutation.ClearItems()
+	return rruo
+}
+
+// RemoveItemIDs removes the "items" edge to ReturnItem entities by IDs.
+func (rruo *ReturnRequestUpdateOne) RemoveItemIDs(ids ...string) *ReturnRequestUpdateOne {
+	rruo.mutation.RemoveItemIDs(ids...)
+	return rruo
+}
+
+// RemoveItems removes "items" edges to ReturnItem entities.
+func (rruo *ReturnRequestUpdateOne) RemoveItems(r ...*ReturnItem) *ReturnRequestUpdateOne {
+	ids := make([]string, len(r))
+	for i := range r {
+		ids[i] = r[i].ID
+	}
+	return rruo.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the ReturnRequestUpdate builder.
+func (rruo *ReturnRequestUpdateOne) Where(ps ...predicate.ReturnRequest) *ReturnRequestUpdateOne {
+	rruo.mutation.Where(ps...)
+	return rruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rruo *ReturnRequestUpdateOne) Select(field string, fields ...string) *ReturnRequestUpdateOne {
+	rruo.fields = append([]string{field}, fields...)
+	return rruo
+}
+
+// Save executes the query and returns the updated ReturnRequest entity.
+func (rruo *ReturnRequestUpdateOne) Save(ctx context.Context) (*ReturnRequest, error) {
+	rruo.defaults()
+	return withHooks(ctx, rruo.sqlSave, rruo.mutation, rruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rruo *ReturnRequestUpdateOne) SaveX(ctx context.Context) *ReturnRequest {
+	node, err := rruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rruo *ReturnRequestUpdateOne) Exec(ctx context.Context) error {
+	_, err := rruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rruo *ReturnRequestUpdateOne) ExecX(ctx context.Context) {
+	if err := rruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rruo *ReturnRequestUpdateOne) defaults() {
+	if _, ok := rruo.mutation.UpdatedAt(); !ok {
+		v := returnrequest.UpdateDefaultUpdatedAt()
+		rruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rruo *ReturnRequestUpdateOne) check() error {
+	if v, ok := rruo.mutation.Status(); ok {
+		if err := returnrequest.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ReturnRequest.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rruo *ReturnRequestUpdateOne) sqlSave(ctx context.Context) (_node *ReturnRequest, err error) {
+	if err := rruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(returnrequest.Table, returnrequest.Columns, sqlgraph.NewFieldSpec(returnrequest.FieldID, field.TypeString))
+	id, ok := rruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ReturnRequest.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, returnrequest.FieldID)
+		for _, f := range fields {
+			if !returnrequest.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != returnrequest.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rruo.mutation.UserID(); ok {
+		_spec.SetField(returnrequest.FieldUserID, field.TypeString, value)
+	}
+	if rruo.mutation.UserIDCleared() {
+		_spec.ClearField(returnrequest.FieldUserID, field.TypeString)
+	}
+	if value, ok := rruo.mutation.Status(); ok {
+		_spec.SetField(returnrequest.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := rruo.mutation.Reason(); ok {
+		_spec.SetField(returnrequest.FieldReason, field.TypeString, value)
+	}
+	if rruo.mutation.ReasonCleared() {
+		_spec.ClearField(returnrequest.FieldReason, field.TypeString)
+	}
+	if value, ok := rruo.mutation.RefundAmount(); ok {
+		_spec.SetField(returnrequest.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.AddedRefundAmount(); ok {
+		_spec.AddField(returnrequest.FieldRefundAmount, field.TypeInt64, value)
+	}
+	if value, ok := rruo.mutation.ReviewedBy(); ok {
+		_spec.SetField(returnrequest.FieldReviewedBy, field.TypeString, value)
+	}
+	if rruo.mutation.ReviewedByCleared() {
+		_spec.ClearField(returnrequest.FieldReviewedBy, field.TypeString)
+	}
+	if value, ok := rruo.mutation.ReviewNote(); ok {
+		_spec.SetField(returnrequest.FieldReviewNote, field.TypeString, value)
+	}
+	if rruo.mutation.ReviewNoteCleared() {
+		_spec.ClearField(returnrequest.FieldReviewNote, field.TypeString)
+	}
+	if value, ok := rruo.mutation.ReviewedAt(); ok {
+		_spec.SetField(returnrequest.FieldReviewedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(returnrequest.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := rruo.mutation.CreatedAt(); ok {
+		_spec.SetField(returnrequest.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := rruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(returnrequest.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if rruo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnrequest.OrderTable,
+			Columns: []string{returnrequest.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   returnrequest.OrderTable,
+			Columns: []string{returnrequest.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if rruo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.RemovedItemsIDs(); len(nodes) > 0 && !rruo.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := rruo.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   returnrequest.ItemsTable,
+			Columns: []string{returnrequest.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(returnitem.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ReturnRequest{config: rruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{returnrequest.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rruo.mutation.done = true
+	return _node, nil
+}
@@ -5,23 +5,43 @@ package ent
 import (
 	"time"
 
+	"github.com/vtrod/veecomm-api/ent/accounttoken"
 	"github.com/vtrod/veecomm-api/ent/address"
 	"github.com/vtrod/veecomm-api/ent/avaliation"
 	"github.com/vtrod/veecomm-api/ent/cart"
 	"github.com/vtrod/veecomm-api/ent/cartitem"
 	"github.com/vtrod/veecomm-api/ent/category"
 	"github.com/vtrod/veecomm-api/ent/coupon"
+	"github.com/vtrod/veecomm-api/ent/loginattempt"
 	"github.com/vtrod/veecomm-api/ent/order"
 	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/orderstatusevent"
+	"github.com/vtrod/veecomm-api/ent/payment"
 	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productoption"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+	"github.com/vtrod/veecomm-api/ent/returnitem"
+	"github.com/vtrod/veecomm-api/ent/returnrequest"
 	"github.com/vtrod/veecomm-api/ent/schema"
+	"github.com/vtrod/veecomm-api/ent/session"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
 	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	accounttokenFields := schema.AccountToken{}.Fields()
+	_ = accounttokenFields
+	// accounttokenDescCreatedAt is the schema descriptor for created_at field.
+	accounttokenDescCreatedAt := accounttokenFields[7].Descriptor()
+	// accounttoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accounttoken.DefaultCreatedAt = accounttokenDescCreatedAt.Default.(func() time.Time)
 	addressFields := schema.Address{}.Fields()
 	_ = addressFields
 	// addressDescCep is the schema descriptor for cep field.
@@ -95,25 +115,25 @@ func init() {
 	// cartDescSubtotal is the schema descriptor for subtotal field.
 	cartDescSubtotal := cartFields[2].Descriptor()
 	// cart.DefaultSubtotal holds the default value on creation for the subtotal field.
-	cart.DefaultSubtotal = cartDescSubtotal.Default.(float64)
+	cart.DefaultSubtotal = money.Amount(cartDescSubtotal.Default.(int64))
 	// cartDescDiscount is the schema descriptor for discount field.
-	cartDescDiscount := cartFields[4].Descriptor()
+	cartDescDiscount := cartFields[6].Descriptor()
 	// cart.DefaultDiscount holds the default value on creation for the discount field.
-	cart.DefaultDiscount = cartDescDiscount.Default.(float64)
+	cart.DefaultDiscount = money.Amount(cartDescDiscount.Default.(int64))
 	// cartDescTotal is the schema descriptor for total field.
-	cartDescTotal := cartFields[5].Descriptor()
+	cartDescTotal := cartFields[7].Descriptor()
 	// cart.DefaultTotal holds the default value on creation for the total field.
-	cart.DefaultTotal = cartDescTotal.Default.(float64)
+	cart.DefaultTotal = money.Amount(cartDescTotal.Default.(int64))
 	// cartDescAppliedCoupon is the schema descriptor for applied_coupon field.
-	cartDescAppliedCoupon := cartFields[6].Descriptor()
+	cartDescAppliedCoupon := cartFields[8].Descriptor()
 	// cart.DefaultAppliedCoupon holds the default value on creation for the applied_coupon field.
 	cart.DefaultAppliedCoupon = cartDescAppliedCoupon.Default.(bool)
 	// cartDescCreatedAt is the schema descriptor for created_at field.
-	cartDescCreatedAt := cartFields[8].Descriptor()
+	cartDescCreatedAt := cartFields[11].Descriptor()
 	// cart.DefaultCreatedAt holds the default value on creation for the created_at field.
 	cart.DefaultCreatedAt = cartDescCreatedAt.Default.(func() time.Time)
 	// cartDescUpdatedAt is the schema descriptor for updated_at field.
-	cartDescUpdatedAt := cartFields[9].Descriptor()
+	cartDescUpdatedAt := cartFields[12].Descriptor()
 	// cart.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	cart.DefaultUpdatedAt = cartDescUpdatedAt.Default.(func() time.Time)
 	// cart.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -121,27 +141,27 @@ func init() {
 	cartitemFields := schema.CartItem{}.Fields()
 	_ = cartitemFields
 	// cartitemDescName is the schema descriptor for name field.
-	cartitemDescName := cartitemFields[3].Descriptor()
+	cartitemDescName := cartitemFields[5].Descriptor()
 	// cartitem.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	cartitem.NameValidator = cartitemDescName.Validators[0].(func(string) error)
 	// cartitemDescPrice is the schema descriptor for price field.
-	cartitemDescPrice := cartitemFields[4].Descriptor()
+	cartitemDescPrice := cartitemFields[6].Descriptor()
 	// cartitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	cartitem.PriceValidator = cartitemDescPrice.Validators[0].(func(float64) error)
+	cartitem.PriceValidator = cartitemDescPrice.Validators[0].(func(int64) error)
 	// cartitemDescImage is the schema descriptor for image field.
-	cartitemDescImage := cartitemFields[5].Descriptor()
+	cartitemDescImage := cartitemFields[7].Descriptor()
 	// cartitem.ImageValidator is a validator for the "image" field. It is called by the builders before save.
 	cartitem.ImageValidator = cartitemDescImage.Validators[0].(func(string) error)
 	// cartitemDescQuantity is the schema descriptor for quantity field.
-	cartitemDescQuantity := cartitemFields[6].Descriptor()
+	cartitemDescQuantity := cartitemFields[8].Descriptor()
 	// cartitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	cartitem.QuantityValidator = cartitemDescQuantity.Validators[0].(func(int) error)
 	// cartitemDescCreatedAt is the schema descriptor for created_at field.
-	cartitemDescCreatedAt := cartitemFields[7].Descriptor()
+	cartitemDescCreatedAt := cartitemFields[12].Descriptor()
 	// cartitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	cartitem.DefaultCreatedAt = cartitemDescCreatedAt.Default.(func() time.Time)
 	// cartitemDescUpdatedAt is the schema descriptor for updated_at field.
-	cartitemDescUpdatedAt := cartitemFields[8].Descriptor()
+	cartitemDescUpdatedAt := cartitemFields[13].Descriptor()
 	// cartitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	cartitem.DefaultUpdatedAt = cartitemDescUpdatedAt.Default.(func() time.Time)
 	// cartitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -157,11 +177,11 @@ func init() {
 	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
 	// categoryDescCreatedAt is the schema descriptor for created_at field.
-	categoryDescCreatedAt := categoryFields[4].Descriptor()
+	categoryDescCreatedAt := categoryFields[5].Descriptor()
 	// category.DefaultCreatedAt holds the default value on creation for the created_at field.
 	category.DefaultCreatedAt = categoryDescCreatedAt.Default.(func() time.Time)
 	// categoryDescUpdatedAt is the schema descriptor for updated_at field.
-	categoryDescUpdatedAt := categoryFields[5].Descriptor()
+	categoryDescUpdatedAt := categoryFields[6].Descriptor()
 	// category.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	category.DefaultUpdatedAt = categoryDescUpdatedAt.Default.(func() time.Time)
 	// category.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -172,18 +192,22 @@ func init() {
 	couponDescCode := couponFields[1].Descriptor()
 	// coupon.CodeValidator is a validator for the "code" field. It is called by the builders before save.
 	coupon.CodeValidator = couponDescCode.Validators[0].(func(string) error)
-	// couponDescValue is the schema descriptor for value field.
-	couponDescValue := couponFields[3].Descriptor()
-	// coupon.ValueValidator is a validator for the "value" field. It is called by the builders before save.
-	coupon.ValueValidator = couponDescValue.Validators[0].(func(float64) error)
-	// couponDescUsedCount is the schema descriptor for used_count field.
-	couponDescUsedCount := couponFields[6].Descriptor()
-	// coupon.DefaultUsedCount holds the default value on creation for the used_count field.
-	coupon.DefaultUsedCount = couponDescUsedCount.Default.(int)
-	// couponDescActive is the schema descriptor for active field.
-	couponDescActive := couponFields[8].Descriptor()
-	// coupon.DefaultActive holds the default value on creation for the active field.
-	coupon.DefaultActive = couponDescActive.Default.(bool)
+	// couponDescDiscountValue is the schema descriptor for discount_value field.
+	couponDescDiscountValue := couponFields[3].Descriptor()
+	// coupon.DiscountValueValidator is a validator for the "discount_value" field. It is called by the builders before save.
+	coupon.DiscountValueValidator = couponDescDiscountValue.Validators[0].(func(int64) error)
+	// couponDescMinPurchase is the schema descriptor for min_purchase field.
+	couponDescMinPurchase := couponFields[4].Descriptor()
+	// coupon.DefaultMinPurchase holds the default value on creation for the min_purchase field.
+	coupon.DefaultMinPurchase = money.Amount(couponDescMinPurchase.Default.(int64))
+	// couponDescIsActive is the schema descriptor for is_active field.
+	couponDescIsActive := couponFields[6].Descriptor()
+	// coupon.DefaultIsActive holds the default value on creation for the is_active field.
+	coupon.DefaultIsActive = couponDescIsActive.Default.(bool)
+	// couponDescTimesUsed is the schema descriptor for times_used field.
+	couponDescTimesUsed := couponFields[8].Descriptor()
+	// coupon.DefaultTimesUsed holds the default value on creation for the times_used field.
+	coupon.DefaultTimesUsed = couponDescTimesUsed.Default.(int)
 	// couponDescCreatedAt is the schema descriptor for created_at field.
 	couponDescCreatedAt := couponFields[9].Descriptor()
 	// coupon.DefaultCreatedAt holds the default value on creation for the created_at field.
@@ -194,38 +218,52 @@ func init() {
 	coupon.DefaultUpdatedAt = couponDescUpdatedAt.Default.(func() time.Time)
 	// coupon.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	coupon.UpdateDefaultUpdatedAt = couponDescUpdatedAt.UpdateDefault.(func() time.Time)
+	loginattemptFields := schema.LoginAttempt{}.Fields()
+	_ = loginattemptFields
+	// loginattemptDescCausedLockout is the schema descriptor for caused_lockout field.
+	loginattemptDescCausedLockout := loginattemptFields[6].Descriptor()
+	// loginattempt.DefaultCausedLockout holds the default value on creation for the caused_lockout field.
+	loginattempt.DefaultCausedLockout = loginattemptDescCausedLockout.Default.(bool)
+	// loginattemptDescCreatedAt is the schema descriptor for created_at field.
+	loginattemptDescCreatedAt := loginattemptFields[7].Descriptor()
+	// loginattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	loginattempt.DefaultCreatedAt = loginattemptDescCreatedAt.Default.(func() time.Time)
 	orderFields := schema.Order{}.Fields()
 	_ = orderFields
 	// orderDescDate is the schema descriptor for date field.
-	orderDescDate := orderFields[2].Descriptor()
+	orderDescDate := orderFields[4].Descriptor()
 	// order.DefaultDate holds the default value on creation for the date field.
 	order.DefaultDate = orderDescDate.Default.(func() time.Time)
 	// orderDescTotal is the schema descriptor for total field.
-	orderDescTotal := orderFields[3].Descriptor()
+	orderDescTotal := orderFields[5].Descriptor()
 	// order.TotalValidator is a validator for the "total" field. It is called by the builders before save.
-	order.TotalValidator = orderDescTotal.Validators[0].(func(float64) error)
+	order.TotalValidator = orderDescTotal.Validators[0].(func(int64) error)
 	// orderDescShipping is the schema descriptor for shipping field.
-	orderDescShipping := orderFields[4].Descriptor()
+	orderDescShipping := orderFields[6].Descriptor()
 	// order.DefaultShipping holds the default value on creation for the shipping field.
-	order.DefaultShipping = orderDescShipping.Default.(float64)
+	order.DefaultShipping = money.Amount(orderDescShipping.Default.(int64))
 	// orderDescDiscount is the schema descriptor for discount field.
-	orderDescDiscount := orderFields[5].Descriptor()
+	orderDescDiscount := orderFields[8].Descriptor()
 	// order.DefaultDiscount holds the default value on creation for the discount field.
-	order.DefaultDiscount = orderDescDiscount.Default.(float64)
+	order.DefaultDiscount = money.Amount(orderDescDiscount.Default.(int64))
+	// orderDescRefundedTotal is the schema descriptor for refunded_total field.
+	orderDescRefundedTotal := orderFields[9].Descriptor()
+	// order.DefaultRefundedTotal holds the default value on creation for the refunded_total field.
+	order.DefaultRefundedTotal = money.Amount(orderDescRefundedTotal.Default.(int64))
 	// orderDescPaymentMethod is the schema descriptor for payment_method field.
-	orderDescPaymentMethod := orderFields[9].Descriptor()
+	orderDescPaymentMethod := orderFields[13].Descriptor()
 	// order.PaymentMethodValidator is a validator for the "payment_method" field. It is called by the builders before save.
 	order.PaymentMethodValidator = orderDescPaymentMethod.Validators[0].(func(string) error)
 	// orderDescPaymentStatus is the schema descriptor for payment_status field.
-	orderDescPaymentStatus := orderFields[10].Descriptor()
-	// order.PaymentStatusValidator is a validator for the "payment_status" field. It is called by the builders before save.
-	order.PaymentStatusValidator = orderDescPaymentStatus.Validators[0].(func(string) error)
+	orderDescPaymentStatus := orderFields[14].Descriptor()
+	// order.DefaultPaymentStatus holds the default value on creation for the payment_status field.
+	order.DefaultPaymentStatus = orderDescPaymentStatus.Default.(string)
 	// orderDescCreatedAt is the schema descriptor for created_at field.
-	orderDescCreatedAt := orderFields[12].Descriptor()
+	orderDescCreatedAt := orderFields[16].Descriptor()
 	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
 	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
 	// orderDescUpdatedAt is the schema descriptor for updated_at field.
-	orderDescUpdatedAt := orderFields[13].Descriptor()
+	orderDescUpdatedAt := orderFields[17].Descriptor()
 	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
 	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -233,31 +271,69 @@ func init() {
 	orderitemFields := schema.OrderItem{}.Fields()
 	_ = orderitemFields
 	// orderitemDescName is the schema descriptor for name field.
-	orderitemDescName := orderitemFields[3].Descriptor()
+	orderitemDescName := orderitemFields[6].Descriptor()
 	// orderitem.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	orderitem.NameValidator = orderitemDescName.Validators[0].(func(string) error)
 	// orderitemDescPrice is the schema descriptor for price field.
-	orderitemDescPrice := orderitemFields[4].Descriptor()
+	orderitemDescPrice := orderitemFields[7].Descriptor()
 	// orderitem.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	orderitem.PriceValidator = orderitemDescPrice.Validators[0].(func(float64) error)
+	orderitem.PriceValidator = orderitemDescPrice.Validators[0].(func(int64) error)
 	// orderitemDescImage is the schema descriptor for image field.
-	orderitemDescImage := orderitemFields[5].Descriptor()
+	orderitemDescImage := orderitemFields[8].Descriptor()
 	// orderitem.ImageValidator is a validator for the "image" field. It is called by the builders before save.
 	orderitem.ImageValidator = orderitemDescImage.Validators[0].(func(string) error)
 	// orderitemDescQuantity is the schema descriptor for quantity field.
-	orderitemDescQuantity := orderitemFields[6].Descriptor()
+	orderitemDescQuantity := orderitemFields[9].Descriptor()
 	// orderitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
 	orderitem.QuantityValidator = orderitemDescQuantity.Validators[0].(func(int) error)
 	// orderitemDescCreatedAt is the schema descriptor for created_at field.
-	orderitemDescCreatedAt := orderitemFields[7].Descriptor()
+	orderitemDescCreatedAt := orderitemFields[10].Descriptor()
 	// orderitem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	orderitem.DefaultCreatedAt = orderitemDescCreatedAt.Default.(func() time.Time)
 	// orderitemDescUpdatedAt is the schema descriptor for updated_at field.
-	orderitemDescUpdatedAt := orderitemFields[8].Descriptor()
+	orderitemDescUpdatedAt := orderitemFields[11].Descriptor()
 	// orderitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	orderitem.DefaultUpdatedAt = orderitemDescUpdatedAt.Default.(func() time.Time)
 	// orderitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	orderitem.UpdateDefaultUpdatedAt = orderitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	orderstatuseventFields := schema.OrderStatusEvent{}.Fields()
+	_ = orderstatuseventFields
+	// orderstatuseventDescCreatedAt is the schema descriptor for created_at field.
+	orderstatuseventDescCreatedAt := orderstatuseventFields[6].Descriptor()
+	// orderstatusevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	orderstatusevent.DefaultCreatedAt = orderstatuseventDescCreatedAt.Default.(func() time.Time)
+	paymentFields := schema.Payment{}.Fields()
+	_ = paymentFields
+	// paymentDescProvider is the schema descriptor for provider field.
+	paymentDescProvider := paymentFields[2].Descriptor()
+	// payment.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	payment.ProviderValidator = paymentDescProvider.Validators[0].(func(string) error)
+	// paymentDescMethod is the schema descriptor for method field.
+	paymentDescMethod := paymentFields[3].Descriptor()
+	// payment.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	payment.MethodValidator = paymentDescMethod.Validators[0].(func(string) error)
+	// paymentDescAmount is the schema descriptor for amount field.
+	paymentDescAmount := paymentFields[6].Descriptor()
+	// payment.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	payment.AmountValidator = paymentDescAmount.Validators[0].(func(int64) error)
+	// paymentDescCapturedAmount is the schema descriptor for captured_amount field.
+	paymentDescCapturedAmount := paymentFields[7].Descriptor()
+	// payment.DefaultCapturedAmount holds the default value on creation for the captured_amount field.
+	payment.DefaultCapturedAmount = money.Amount(paymentDescCapturedAmount.Default.(int64))
+	// paymentDescRefundedAmount is the schema descriptor for refunded_amount field.
+	paymentDescRefundedAmount := paymentFields[8].Descriptor()
+	// payment.DefaultRefundedAmount holds the default value on creation for the refunded_amount field.
+	payment.DefaultRefundedAmount = money.Amount(paymentDescRefundedAmount.Default.(int64))
+	// paymentDescCreatedAt is the schema descriptor for created_at field.
+	paymentDescCreatedAt := paymentFields[14].Descriptor()
+	// payment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
+	// paymentDescUpdatedAt is the schema descriptor for updated_at field.
+	paymentDescUpdatedAt := paymentFields[15].Descriptor()
+	// payment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	payment.DefaultUpdatedAt = paymentDescUpdatedAt.Default.(func() time.Time)
+	// payment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	payment.UpdateDefaultUpdatedAt = paymentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	productFields := schema.Product{}.Fields()
 	_ = productFields
 	// productDescName is the schema descriptor for name field.
@@ -275,7 +351,7 @@ func init() {
 	// productDescPrice is the schema descriptor for price field.
 	productDescPrice := productFields[4].Descriptor()
 	// product.PriceValidator is a validator for the "price" field. It is called by the builders before save.
-	product.PriceValidator = productDescPrice.Validators[0].(func(float64) error)
+	product.PriceValidator = productDescPrice.Validators[0].(func(int64) error)
 	// productDescOnSale is the schema descriptor for on_sale field.
 	productDescOnSale := productFields[6].Descriptor()
 	// product.DefaultOnSale holds the default value on creation for the on_sale field.
@@ -284,32 +360,198 @@ func init() {
 	productDescStock := productFields[7].Descriptor()
 	// product.DefaultStock holds the default value on creation for the stock field.
 	product.DefaultStock = productDescStock.Default.(int)
+	// product.StockValidator is a validator for the "stock" field. It is called by the builders before save.
+	product.StockValidator = productDescStock.Validators[0].(func(int) error)
 	// productDescSku is the schema descriptor for sku field.
 	productDescSku := productFields[8].Descriptor()
 	// product.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
 	product.SkuValidator = productDescSku.Validators[0].(func(string) error)
+	// productDescWeight is the schema descriptor for weight field.
+	productDescWeight := productFields[9].Descriptor()
+	// product.DefaultWeight holds the default value on creation for the weight field.
+	product.DefaultWeight = productDescWeight.Default.(int)
+	// product.WeightValidator is a validator for the "weight" field. It is called by the builders before save.
+	product.WeightValidator = productDescWeight.Validators[0].(func(int) error)
 	// productDescImages is the schema descriptor for images field.
-	productDescImages := productFields[10].Descriptor()
+	productDescImages := productFields[11].Descriptor()
 	// product.DefaultImages holds the default value on creation for the images field.
 	product.DefaultImages = productDescImages.Default.([]string)
 	// productDescRating is the schema descriptor for rating field.
-	productDescRating := productFields[11].Descriptor()
+	productDescRating := productFields[12].Descriptor()
 	// product.DefaultRating holds the default value on creation for the rating field.
 	product.DefaultRating = productDescRating.Default.(float64)
 	// productDescReviewCount is the schema descriptor for review_count field.
-	productDescReviewCount := productFields[12].Descriptor()
+	productDescReviewCount := productFields[13].Descriptor()
 	// product.DefaultReviewCount holds the default value on creation for the review_count field.
 	product.DefaultReviewCount = productDescReviewCount.Default.(int)
 	// productDescCreatedAt is the schema descriptor for created_at field.
-	productDescCreatedAt := productFields[13].Descriptor()
+	productDescCreatedAt := productFields[14].Descriptor()
 	// product.DefaultCreatedAt holds the default value on creation for the created_at field.
 	product.DefaultCreatedAt = productDescCreatedAt.Default.(func() time.Time)
 	// productDescUpdatedAt is the schema descriptor for updated_at field.
-	productDescUpdatedAt := productFields[14].Descriptor()
+	productDescUpdatedAt := productFields[15].Descriptor()
 	// product.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	product.DefaultUpdatedAt = productDescUpdatedAt.Default.(func() time.Time)
 	// product.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	product.UpdateDefaultUpdatedAt = productDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productoptionFields := schema.ProductOption{}.Fields()
+	_ = productoptionFields
+	// productoptionDescName is the schema descriptor for name field.
+	productoptionDescName := productoptionFields[2].Descriptor()
+	// productoption.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	productoption.NameValidator = productoptionDescName.Validators[0].(func(string) error)
+	// productoptionDescValues is the schema descriptor for values field.
+	productoptionDescValues := productoptionFields[3].Descriptor()
+	// productoption.DefaultValues holds the default value on creation for the values field.
+	productoption.DefaultValues = productoptionDescValues.Default.([]string)
+	// productoptionDescPosition is the schema descriptor for position field.
+	productoptionDescPosition := productoptionFields[4].Descriptor()
+	// productoption.DefaultPosition holds the default value on creation for the position field.
+	productoption.DefaultPosition = productoptionDescPosition.Default.(int)
+	// productoptionDescCreatedAt is the schema descriptor for created_at field.
+	productoptionDescCreatedAt := productoptionFields[5].Descriptor()
+	// productoption.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productoption.DefaultCreatedAt = productoptionDescCreatedAt.Default.(func() time.Time)
+	// productoptionDescUpdatedAt is the schema descriptor for updated_at field.
+	productoptionDescUpdatedAt := productoptionFields[6].Descriptor()
+	// productoption.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productoption.DefaultUpdatedAt = productoptionDescUpdatedAt.Default.(func() time.Time)
+	// productoption.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productoption.UpdateDefaultUpdatedAt = productoptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	productvariantFields := schema.ProductVariant{}.Fields()
+	_ = productvariantFields
+	// productvariantDescSku is the schema descriptor for sku field.
+	productvariantDescSku := productvariantFields[2].Descriptor()
+	// productvariant.SkuValidator is a validator for the "sku" field. It is called by the builders before save.
+	productvariant.SkuValidator = productvariantDescSku.Validators[0].(func(string) error)
+	// productvariantDescOptions is the schema descriptor for options field.
+	productvariantDescOptions := productvariantFields[3].Descriptor()
+	// productvariant.DefaultOptions holds the default value on creation for the options field.
+	productvariant.DefaultOptions = productvariantDescOptions.Default.(map[string]string)
+	// productvariantDescCombination is the schema descriptor for combination field.
+	productvariantDescCombination := productvariantFields[4].Descriptor()
+	// productvariant.CombinationValidator is a validator for the "combination" field. It is called by the builders before save.
+	productvariant.CombinationValidator = productvariantDescCombination.Validators[0].(func(string) error)
+	// productvariantDescPrice is the schema descriptor for price field.
+	productvariantDescPrice := productvariantFields[5].Descriptor()
+	// productvariant.PriceValidator is a validator for the "price" field. It is called by the builders before save.
+	productvariant.PriceValidator = productvariantDescPrice.Validators[0].(func(int64) error)
+	// productvariantDescStock is the schema descriptor for stock field.
+	productvariantDescStock := productvariantFields[6].Descriptor()
+	// productvariant.DefaultStock holds the default value on creation for the stock field.
+	productvariant.DefaultStock = productvariantDescStock.Default.(int)
+	// productvariant.StockValidator is a validator for the "stock" field. It is called by the builders before save.
+	productvariant.StockValidator = productvariantDescStock.Validators[0].(func(int) error)
+	// productvariantDescImages is the schema descriptor for images field.
+	productvariantDescImages := productvariantFields[7].Descriptor()
+	// productvariant.DefaultImages holds the default value on creation for the images field.
+	productvariant.DefaultImages = productvariantDescImages.Default.([]string)
+	// productvariantDescPosition is the schema descriptor for position field.
+	productvariantDescPosition := productvariantFields[8].Descriptor()
+	// productvariant.DefaultPosition holds the default value on creation for the position field.
+	productvariant.DefaultPosition = productvariantDescPosition.Default.(int)
+	// productvariantDescCreatedAt is the schema descriptor for created_at field.
+	productvariantDescCreatedAt := productvariantFields[9].Descriptor()
+	// productvariant.DefaultCreatedAt holds the default value on creation for the created_at field.
+	productvariant.DefaultCreatedAt = productvariantDescCreatedAt.Default.(func() time.Time)
+	// productvariantDescUpdatedAt is the schema descriptor for updated_at field.
+	productvariantDescUpdatedAt := productvariantFields[10].Descriptor()
+	// productvariant.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	productvariant.DefaultUpdatedAt = productvariantDescUpdatedAt.Default.(func() time.Time)
+	// productvariant.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	productvariant.UpdateDefaultUpdatedAt = productvariantDescUpdatedAt.UpdateDefault.(func() time.Time)
+	returnitemFields := schema.ReturnItem{}.Fields()
+	_ = returnitemFields
+	// returnitemDescOrderItemID is the schema descriptor for order_item_id field.
+	returnitemDescOrderItemID := returnitemFields[2].Descriptor()
+	// returnitem.OrderItemIDValidator is a validator for the "order_item_id" field. It is called by the builders before save.
+	returnitem.OrderItemIDValidator = returnitemDescOrderItemID.Validators[0].(func(string) error)
+	// returnitemDescQuantity is the schema descriptor for quantity field.
+	returnitemDescQuantity := returnitemFields[5].Descriptor()
+	// returnitem.QuantityValidator is a validator for the "quantity" field. It is called by the builders before save.
+	returnitem.QuantityValidator = returnitemDescQuantity.Validators[0].(func(int) error)
+	// returnitemDescUnitPrice is the schema descriptor for unit_price field.
+	returnitemDescUnitPrice := returnitemFields[6].Descriptor()
+	// returnitem.UnitPriceValidator is a validator for the "unit_price" field. It is called by the builders before save.
+	returnitem.UnitPriceValidator = returnitemDescUnitPrice.Validators[0].(func(int64) error)
+	returnrequestFields := schema.ReturnRequest{}.Fields()
+	_ = returnrequestFields
+	// returnrequestDescRefundAmount is the schema descriptor for refund_amount field.
+	returnrequestDescRefundAmount := returnrequestFields[5].Descriptor()
+	// returnrequest.DefaultRefundAmount holds the default value on creation for the refund_amount field.
+	returnrequest.DefaultRefundAmount = money.Amount(returnrequestDescRefundAmount.Default.(int64))
+	// returnrequestDescCreatedAt is the schema descriptor for created_at field.
+	returnrequestDescCreatedAt := returnrequestFields[9].Descriptor()
+	// returnrequest.DefaultCreatedAt holds the default value on creation for the created_at field.
+	returnrequest.DefaultCreatedAt = returnrequestDescCreatedAt.Default.(func() time.Time)
+	// returnrequestDescUpdatedAt is the schema descriptor for updated_at field.
+	returnrequestDescUpdatedAt := returnrequestFields[10].Descriptor()
+	// returnrequest.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	returnrequest.DefaultUpdatedAt = returnrequestDescUpdatedAt.Default.(func() time.Time)
+	// returnrequest.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	returnrequest.UpdateDefaultUpdatedAt = returnrequestDescUpdatedAt.UpdateDefault.(func() time.Time)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescFamilyID is the schema descriptor for family_id field.
+	sessionDescFamilyID := sessionFields[2].Descriptor()
+	// session.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	session.FamilyIDValidator = sessionDescFamilyID.Validators[0].(func(string) error)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[8].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[11].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	shipmentFields := schema.Shipment{}.Fields()
+	_ = shipmentFields
+	// shipmentDescCarrier is the schema descriptor for carrier field.
+	shipmentDescCarrier := shipmentFields[2].Descriptor()
+	// shipment.CarrierValidator is a validator for the "carrier" field. It is called by the builders before save.
+	shipment.CarrierValidator = shipmentDescCarrier.Validators[0].(func(string) error)
+	// shipmentDescTrackingCode is the schema descriptor for tracking_code field.
+	shipmentDescTrackingCode := shipmentFields[3].Descriptor()
+	// shipment.TrackingCodeValidator is a validator for the "tracking_code" field. It is called by the builders before save.
+	shipment.TrackingCodeValidator = shipmentDescTrackingCode.Validators[0].(func(string) error)
+	// shipmentDescCreatedAt is the schema descriptor for created_at field.
+	shipmentDescCreatedAt := shipmentFields[7].Descriptor()
+	// shipment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	shipment.DefaultCreatedAt = shipmentDescCreatedAt.Default.(func() time.Time)
+	// shipmentDescUpdatedAt is the schema descriptor for updated_at field.
+	shipmentDescUpdatedAt := shipmentFields[8].Descriptor()
+	// shipment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	shipment.DefaultUpdatedAt = shipmentDescUpdatedAt.Default.(func() time.Time)
+	// shipment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	shipment.UpdateDefaultUpdatedAt = shipmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	slugredirectFields := schema.SlugRedirect{}.Fields()
+	_ = slugredirectFields
+	// slugredirectDescSlug is the schema descriptor for slug field.
+	slugredirectDescSlug := slugredirectFields[2].Descriptor()
+	// slugredirect.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	slugredirect.SlugValidator = slugredirectDescSlug.Validators[0].(func(string) error)
+	// slugredirectDescTargetID is the schema descriptor for target_id field.
+	slugredirectDescTargetID := slugredirectFields[3].Descriptor()
+	// slugredirect.TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	slugredirect.TargetIDValidator = slugredirectDescTargetID.Validators[0].(func(string) error)
+	// slugredirectDescCreatedAt is the schema descriptor for created_at field.
+	slugredirectDescCreatedAt := slugredirectFields[4].Descriptor()
+	// slugredirect.DefaultCreatedAt holds the default value on creation for the created_at field.
+	slugredirect.DefaultCreatedAt = slugredirectDescCreatedAt.Default.(func() time.Time)
+	trackingeventFields := schema.TrackingEvent{}.Fields()
+	_ = trackingeventFields
+	// trackingeventDescDescription is the schema descriptor for description field.
+	trackingeventDescDescription := trackingeventFields[3].Descriptor()
+	// trackingevent.DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	trackingevent.DescriptionValidator = trackingeventDescDescription.Validators[0].(func(string) error)
+	// trackingeventDescOccurredAt is the schema descriptor for occurred_at field.
+	trackingeventDescOccurredAt := trackingeventFields[5].Descriptor()
+	// trackingevent.DefaultOccurredAt holds the default value on creation for the occurred_at field.
+	trackingevent.DefaultOccurredAt = trackingeventDescOccurredAt.Default.(func() time.Time)
+	// trackingeventDescCreatedAt is the schema descriptor for created_at field.
+	trackingeventDescCreatedAt := trackingeventFields[6].Descriptor()
+	// trackingevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trackingevent.DefaultCreatedAt = trackingeventDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -324,14 +566,36 @@ func init() {
 	userDescPassword := userFields[3].Descriptor()
 	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
 	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescTotpLastStep is the schema descriptor for totp_last_step field.
+	userDescTotpLastStep := userFields[11].Descriptor()
+	// user.DefaultTotpLastStep holds the default value on creation for the totp_last_step field.
+	user.DefaultTotpLastStep = userDescTotpLastStep.Default.(int64)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[13].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[14].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	webhookeventFields := schema.WebhookEvent{}.Fields()
+	_ = webhookeventFields
+	// webhookeventDescProvider is the schema descriptor for provider field.
+	webhookeventDescProvider := webhookeventFields[1].Descriptor()
+	// webhookevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	webhookevent.ProviderValidator = webhookeventDescProvider.Validators[0].(func(string) error)
+	// webhookeventDescEventID is the schema descriptor for event_id field.
+	webhookeventDescEventID := webhookeventFields[2].Descriptor()
+	// webhookevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookevent.EventIDValidator = webhookeventDescEventID.Validators[0].(func(string) error)
+	// webhookeventDescEventType is the schema descriptor for event_type field.
+	webhookeventDescEventType := webhookeventFields[3].Descriptor()
+	// webhookevent.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookevent.EventTypeValidator = webhookeventDescEventType.Validators[0].(func(string) error)
+	// webhookeventDescCreatedAt is the schema descriptor for created_at field.
+	webhookeventDescCreatedAt := webhookeventFields[6].Descriptor()
+	// webhookevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookevent.DefaultCreatedAt = webhookeventDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// AccountToken define o schema da entidade Token de Conta, usado na redefinição
+// de senha e na verificação de email. Cada token vale uma única vez.
+type AccountToken struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Token de Conta
+func (AccountToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("user_id").
+			Optional(),
+		field.Enum("purpose").
+			Values("password_reset", "email_verification").
+			Immutable(),
+		// Hash SHA-256 do token enviado por email; o token em si nunca é armazenado
+		field.String("token_hash").
+			Unique().
+			Sensitive().
+			Immutable(),
+		// Email para o qual o token foi enviado
+		field.String("email").
+			Immutable(),
+		field.Time("expires_at").
+			Immutable(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (AccountToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("account_tokens").
+			Field("user_id").
+			Unique(),
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Cart define o schema da entidade Carrinho
@@ -21,18 +23,30 @@ func (Cart) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			Optional(),
-		field.Float("subtotal").
+		field.Int64("subtotal").
+			GoType(money.Amount(0)).
 			Default(0),
-		field.Float("shipping").
+		field.Int64("shipping").
+			GoType(money.Amount(0)).
 			Optional(),
-		field.Float("discount").
+		field.String("shipping_service").
+			Optional(),
+		field.String("shipping_cep").
+			Optional(),
+		field.Int64("discount").
+			GoType(money.Amount(0)).
 			Default(0),
-		field.Float("total").
+		field.Int64("total").
+			GoType(money.Amount(0)).
 			Default(0),
 		field.Bool("applied_coupon").
 			Default(false),
 		field.String("coupon_code").
 			Optional(),
+		// Carrinhos de visitantes (sem user_id) expiram por inatividade
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -50,4 +64,11 @@ func (Cart) Edges() []ent.Edge {
 			Unique(),
 		edge.To("cart_items", CartItem.Type),
 	}
-} 
\ No newline at end of file
+} 
+
+// Indexes acelera a limpeza dos carrinhos de visitantes expirados
+func (Cart) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // CartItem define o schema da entidade Item do Carrinho
@@ -23,14 +24,33 @@ func (CartItem) Fields() []ent.Field {
 			Optional(),
 		field.String("product_id").
 			Optional(),
+		field.String("variant_id").
+			Optional(),
+		// Valores das opções da variante escolhida, para exibição
+		field.JSON("variant_options", map[string]string{}).
+			Optional(),
 		field.String("name").
 			NotEmpty(),
-		field.Float("price").
+		field.Int64("price").
+			GoType(money.Amount(0)).
 			Positive(),
 		field.String("image").
 			NotEmpty(),
 		field.Int("quantity").
 			Positive(),
+		// Preço e quantidade confirmados pelo cliente, enquanto diferirem dos atuais
+		field.Int64("previous_price").
+			GoType(money.Amount(0)).
+			Optional().
+			Nillable(),
+		field.Int("previous_quantity").
+			Optional().
+			Nillable(),
+		// Itens sem estoque ou de produtos excluídos não entram no total do carrinho
+		field.Enum("unavailable_reason").
+			Values("out_of_stock", "removed").
+			Optional().
+			Nillable(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -50,5 +70,9 @@ func (CartItem) Edges() []ent.Edge {
 			Ref("cart_items").
 			Field("product_id").
 			Unique(),
+		edge.From("variant", ProductVariant.Type).
+			Ref("cart_items").
+			Field("variant_id").
+			Unique(),
 	}
 } 
\ No newline at end of file
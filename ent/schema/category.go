@@ -26,6 +26,9 @@ func (Category) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("image").
 			Optional(),
+		// Categoria pai; categorias sem pai ficam na raiz da árvore
+		field.String("parent_id").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -38,5 +41,9 @@ func (Category) Fields() []ent.Field {
 func (Category) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("products", Product.Type),
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
 	}
 } 
\ No newline at end of file
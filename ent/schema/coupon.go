@@ -4,6 +4,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Coupon define o schema da entidade Cupom
@@ -23,9 +24,11 @@ func (Coupon) Fields() []ent.Field {
 			Unique(),
 		field.Enum("discount_type").
 			Values("percentage", "fixed"),
-		field.Float("discount_value").
+		field.Int64("discount_value").
+			GoType(money.Amount(0)).
 			Positive(),
-		field.Float("min_purchase").
+		field.Int64("min_purchase").
+			GoType(money.Amount(0)).
 			Default(0),
 		field.Time("expires_at").
 			Optional(),
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// LoginAttempt define o schema da entidade Tentativa de Login, o registro de
+// auditoria das tentativas malsucedidas
+type LoginAttempt struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Tentativa de Login
+func (LoginAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		// Email informado, mesmo que não pertença a nenhuma conta
+		field.String("email").
+			Immutable(),
+		field.String("user_id").
+			Optional().
+			Immutable(),
+		field.String("ip").
+			Optional().
+			Immutable(),
+		field.String("user_agent").
+			Optional().
+			Immutable(),
+		field.Enum("reason").
+			Values("invalid_credentials", "invalid_two_factor", "throttled", "locked").
+			Immutable(),
+		// Indica se esta falha bloqueou a conta
+		field.Bool("caused_lockout").
+			Default(false).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes acelera a consulta da auditoria por conta e por IP
+func (LoginAttempt) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("email", "created_at"),
+		index.Fields("ip", "created_at"),
+	}
+}
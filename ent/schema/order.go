@@ -4,7 +4,9 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Order define o schema da entidade Pedido
@@ -21,13 +23,27 @@ func (Order) Fields() []ent.Field {
 			Immutable(),
 		field.String("user_id").
 			Optional(),
+		// Comprador sem cadastro; o pedido pode ser reivindicado depois pela conta com o mesmo email
+		field.String("guest_email").
+			Optional(),
+		field.String("guest_name").
+			Optional(),
 		field.Time("date").
 			Default(time.Now),
-		field.Float("total").
+		field.Int64("total").
+			GoType(money.Amount(0)).
 			Positive(),
-		field.Float("shipping").
+		field.Int64("shipping").
+			GoType(money.Amount(0)).
 			Default(0),
-		field.Float("discount").
+		field.String("shipping_service").
+			Optional(),
+		field.Int64("discount").
+			GoType(money.Amount(0)).
+			Default(0),
+		// Soma dos valores devolvidos ao cliente em devoluções aprovadas
+		field.Int64("refunded_total").
+			GoType(money.Amount(0)).
 			Default(0),
 		field.Enum("delivery_type").
 			Values("pickup", "delivery"),
@@ -44,7 +60,7 @@ func (Order) Fields() []ent.Field {
 		field.String("payment_method").
 			NotEmpty(),
 		field.String("payment_status").
-			NotEmpty(),
+			Default("pending"),
 		field.String("coupon_code").
 			Optional(),
 		field.Time("created_at").
@@ -67,5 +83,17 @@ func (Order) Edges() []ent.Edge {
 			Field("address_id").
 			Unique(),
 		edge.To("order_items", OrderItem.Type),
+		edge.To("status_events", OrderStatusEvent.Type),
+		edge.To("shipment", Shipment.Type).
+			Unique(),
+		edge.To("payments", Payment.Type),
+		edge.To("returns", ReturnRequest.Type),
 	}
-} 
\ No newline at end of file
+}
+
+// Indexes acelera a busca dos pedidos de visitantes a reivindicar
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("guest_email"),
+	}
+}
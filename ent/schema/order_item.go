@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // OrderItem define o schema da entidade Item do Pedido
@@ -23,9 +24,17 @@ func (OrderItem) Fields() []ent.Field {
 			Optional(),
 		field.String("product_id").
 			Optional(),
+		field.String("variant_id").
+			Optional(),
+		// SKU e valores das opções da variante no momento da compra
+		field.String("sku").
+			Optional(),
+		field.JSON("variant_options", map[string]string{}).
+			Optional(),
 		field.String("name").
 			NotEmpty(),
-		field.Float("price").
+		field.Int64("price").
+			GoType(money.Amount(0)).
 			Positive(),
 		field.String("image").
 			NotEmpty(),
@@ -50,5 +59,9 @@ func (OrderItem) Edges() []ent.Edge {
 			Ref("order_items").
 			Field("product_id").
 			Unique(),
+		edge.From("variant", ProductVariant.Type).
+			Ref("order_items").
+			Field("variant_id").
+			Unique(),
 	}
 } 
\ No newline at end of file
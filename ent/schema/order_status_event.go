@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// OrderStatusEvent define o schema da entidade Histórico de Status do Pedido
+type OrderStatusEvent struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Histórico de Status do Pedido
+func (OrderStatusEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("order_id").
+			Optional(),
+		field.Enum("from_status").
+			Values(
+				"pending", 
+				"processing", 
+				"shipped", 
+				"delivered", 
+				"cancelled",
+			).
+			Optional().
+			Nillable(),
+		field.Enum("to_status").
+			Values(
+				"pending", 
+				"processing", 
+				"shipped", 
+				"delivered", 
+				"cancelled",
+			),
+		field.String("changed_by").
+			Optional(),
+		field.Text("note").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (OrderStatusEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("status_events").
+			Field("order_id").
+			Unique(),
+	}
+}
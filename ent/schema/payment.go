@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// Payment define o schema da entidade Pagamento
+type Payment struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Pagamento
+func (Payment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("order_id").
+			Optional(),
+		field.String("provider").
+			NotEmpty(),
+		field.String("method").
+			NotEmpty(),
+		field.String("transaction_id").
+			Optional(),
+		field.Enum("status").
+			Values(
+				"pending",
+				"authorized",
+				"paid",
+				"declined",
+				"refunded",
+				"partially_refunded",
+				"voided",
+				"expired",
+				// Autorização de um pedido que não foi salvo e cujo cancelamento
+				// falhou no gateway; precisa ser cancelada manualmente
+				"void_failed",
+				// Pagamento notificado como pago que precisa de conferência manual,
+				// como um valor menor que o devido ou um pagamento já encerrado
+				"review_required",
+			).
+			Default("pending"),
+		field.Int64("amount").
+			GoType(money.Amount(0)).
+			Positive(),
+		field.Int64("captured_amount").
+			GoType(money.Amount(0)).
+			Default(0),
+		field.Int64("refunded_amount").
+			GoType(money.Amount(0)).
+			Default(0),
+		field.String("failure_reason").
+			Optional(),
+		// Instruções de pagamento de Pix e boleto
+		field.Text("pix_payload").
+			Optional(),
+		field.String("boleto_barcode").
+			Optional(),
+		field.String("boleto_digitable_line").
+			Optional(),
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (Payment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("payments").
+			Field("order_id").
+			Unique(),
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"time"
+	"github.com/vtrod/veecomm-api/money"
 )
 
 // Product define o schema da entidade Produto
@@ -26,16 +27,24 @@ func (Product) Fields() []ent.Field {
 			NotEmpty(),
 		field.Text("description").
 			NotEmpty(),
-		field.Float("price").
+		field.Int64("price").
+			GoType(money.Amount(0)).
 			Positive(),
-		field.Float("sale_price").
+		field.Int64("sale_price").
+			GoType(money.Amount(0)).
 			Optional(),
 		field.Bool("on_sale").
 			Default(false),
+		// Em produtos com variantes, é a soma dos estoques das variantes
 		field.Int("stock").
-			Default(0),
+			Default(0).
+			NonNegative(),
 		field.String("sku").
 			NotEmpty(),
+		// Peso em gramas, usado no cálculo do frete
+		field.Int("weight").
+			Default(0).
+			NonNegative(),
 		field.String("category_id").
 			Optional(),
 		field.JSON("images", []string{}).
@@ -62,5 +71,7 @@ func (Product) Edges() []ent.Edge {
 			Unique(),
 		edge.To("order_items", OrderItem.Type),
 		edge.To("cart_items", CartItem.Type),
+		edge.To("options", ProductOption.Type),
+		edge.To("variants", ProductVariant.Type),
 	}
 } 
\ No newline at end of file
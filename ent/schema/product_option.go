@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// ProductOption define o schema da entidade Opção do Produto (um eixo de variação,
+// como tamanho ou cor, com os valores possíveis na ordem de exibição)
+type ProductOption struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Opção do Produto
+func (ProductOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("product_id").
+			Optional(),
+		field.String("name").
+			NotEmpty(),
+		field.JSON("values", []string{}).
+			Default([]string{}),
+		field.Int("position").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (ProductOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("options").
+			Field("product_id").
+			Unique(),
+	}
+}
+
+// Indexes impede duas opções com o mesmo nome no mesmo produto
+func (ProductOption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ProductVariant define o schema da entidade Variante do Produto (uma combinação de
+// valores das opções, com SKU, estoque e, opcionalmente, preço e imagens próprios)
+type ProductVariant struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Variante do Produto
+func (ProductVariant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("product_id").
+			Optional(),
+		field.String("sku").
+			Unique().
+			NotEmpty(),
+		// Valor escolhido em cada opção do produto (nome da opção -> valor)
+		field.JSON("options", map[string]string{}).
+			Default(map[string]string{}),
+		// Chave normalizada da combinação, usada para impedir variantes repetidas
+		field.String("combination").
+			NotEmpty(),
+		// Substitui o preço do produto quando definido
+		field.Int64("price").
+			GoType(money.Amount(0)).
+			Optional().
+			Nillable().
+			Positive(),
+		field.Int("stock").
+			Default(0).
+			NonNegative(),
+		field.JSON("images", []string{}).
+			Default([]string{}),
+		field.Int("position").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (ProductVariant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("product", Product.Type).
+			Ref("variants").
+			Field("product_id").
+			Unique(),
+		edge.To("cart_items", CartItem.Type),
+		edge.To("order_items", OrderItem.Type),
+	}
+}
+
+// Indexes impede duas variantes com a mesma combinação no mesmo produto
+func (ProductVariant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("product_id", "combination").
+			Unique(),
+	}
+}
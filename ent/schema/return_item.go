@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnItem define o schema da entidade Item da Devolução
+type ReturnItem struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Item da Devolução
+func (ReturnItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("return_id").
+			Optional(),
+		field.String("order_item_id").
+			NotEmpty(),
+		field.String("product_id").
+			Optional(),
+		field.String("variant_id").
+			Optional(),
+		field.Int("quantity").
+			Positive(),
+		field.Int64("unit_price").
+			GoType(money.Amount(0)).
+			Positive(),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (ReturnItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("return", ReturnRequest.Type).
+			Ref("items").
+			Field("return_id").
+			Unique(),
+	}
+}
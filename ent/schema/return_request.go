@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// ReturnRequest define o schema da entidade Solicitação de Devolução
+type ReturnRequest struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Solicitação de Devolução
+func (ReturnRequest) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("order_id").
+			Optional(),
+		field.String("user_id").
+			Optional(),
+		field.Enum("status").
+			Values(
+				"requested",
+				"approved",
+				"rejected",
+			).
+			Default("requested"),
+		field.Text("reason").
+			Optional(),
+		field.Int64("refund_amount").
+			GoType(money.Amount(0)).
+			Default(0),
+		field.String("reviewed_by").
+			Optional(),
+		field.Text("review_note").
+			Optional(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (ReturnRequest) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("returns").
+			Field("order_id").
+			Unique(),
+		edge.To("items", ReturnItem.Type),
+	}
+}
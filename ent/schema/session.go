@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// Session define o schema da entidade Sessão. Cada refresh token emitido gera uma
+// sessão; as sessões criadas por rotação compartilham a mesma família (family_id).
+type Session struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Sessão
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("user_id").
+			Optional(),
+		field.String("family_id").
+			NotEmpty().
+			Immutable(),
+		// Hash SHA-256 do refresh token; o token em si nunca é armazenado
+		field.String("token_hash").
+			Unique().
+			Sensitive().
+			Immutable(),
+		field.String("device").
+			Optional(),
+		field.String("ip").
+			Optional(),
+		field.String("user_agent").
+			Optional(),
+		field.Time("expires_at"),
+		field.Time("last_used_at").
+			Default(time.Now),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		// Sessão que substituiu esta na rotação do refresh token
+		field.String("replaced_by").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (Session) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("sessions").
+			Field("user_id").
+			Unique(),
+	}
+}
+
+// Indexes acelera a revogação de famílias e a listagem de sessões do usuário
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id"),
+	}
+}
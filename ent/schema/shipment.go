@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// Shipment define o schema da entidade Remessa
+type Shipment struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Remessa
+func (Shipment) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("order_id").
+			Optional(),
+		field.String("carrier").
+			NotEmpty(),
+		field.String("tracking_code").
+			NotEmpty(),
+		field.Enum("status").
+			Values(
+				"created",
+				"posted",
+				"in_transit",
+				"out_for_delivery",
+				"delivered",
+				"failed_attempt",
+				"returned",
+			).
+			Default("created"),
+		field.Time("shipped_at").
+			Optional().
+			Nillable(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (Shipment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("order", Order.Type).
+			Ref("shipment").
+			Field("order_id").
+			Unique(),
+		edge.To("events", TrackingEvent.Type),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// SlugRedirect define o schema da entidade Redirecionamento de Slug, o histórico dos
+// slugs antigos de produtos e categorias que foram renomeados
+type SlugRedirect struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Redirecionamento de Slug
+func (SlugRedirect) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.Enum("entity").
+			Values("product", "category").
+			Immutable(),
+		// Slug antigo, que deixou de ser usado
+		field.String("slug").
+			NotEmpty().
+			Immutable(),
+		// Produto ou categoria que usava o slug; o slug atual é lido dele
+		field.String("target_id").
+			NotEmpty().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes garante que cada slug antigo aponte para um único destino
+func (SlugRedirect) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity", "slug").
+			Unique(),
+		index.Fields("target_id"),
+	}
+}
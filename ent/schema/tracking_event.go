@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"time"
+)
+
+// TrackingEvent define o schema da entidade Evento de Rastreamento
+type TrackingEvent struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Evento de Rastreamento
+func (TrackingEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("shipment_id").
+			Optional(),
+		field.Enum("status").
+			Values(
+				"posted",
+				"in_transit",
+				"out_for_delivery",
+				"delivered",
+				"failed_attempt",
+				"returned",
+			),
+		field.String("description").
+			NotEmpty(),
+		field.String("location").
+			Optional(),
+		field.Time("occurred_at").
+			Default(time.Now),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges define as relações desta entidade com outras entidades
+func (TrackingEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("shipment", Shipment.Type).
+			Ref("events").
+			Field("shipment_id").
+			Unique(),
+	}
+}
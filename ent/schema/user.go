@@ -27,10 +27,34 @@ func (User) Fields() []ent.Field {
 		field.String("password").
 			Sensitive().
 			NotEmpty(),
+		field.Time("email_verified_at").
+			Optional().
+			Nillable(),
 		field.String("phone").
 			Optional(),
 		field.String("profile_image").
 			Optional(),
+		field.Enum("role").
+			Values("customer", "admin").
+			Default("customer"),
+		// Permissões concedidas individualmente, além das do papel
+		field.Strings("permissions").
+			Optional(),
+		// Autenticação em dois fatores (TOTP). O segredo fica pendente até a
+		// confirmação do primeiro código, quando totp_enabled_at é preenchido.
+		field.String("totp_secret").
+			Optional().
+			Sensitive(),
+		field.Time("totp_enabled_at").
+			Optional().
+			Nillable(),
+		// Último passo de tempo aceito, para impedir reuso de códigos
+		field.Int64("totp_last_step").
+			Default(0),
+		// Hashes dos códigos de recuperação ainda não usados
+		field.Strings("recovery_codes").
+			Optional().
+			Sensitive(),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -46,5 +70,7 @@ func (User) Edges() []ent.Edge {
 		edge.To("orders", Order.Type),
 		edge.To("avaliations", Avaliation.Type),
 		edge.To("cart", Cart.Type).Unique(),
+		edge.To("sessions", Session.Type),
+		edge.To("account_tokens", AccountToken.Type),
 	}
 } 
\ No newline at end of file
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"time"
+)
+
+// WebhookEvent define o schema da entidade Evento de Webhook recebido de um provedor de pagamento
+type WebhookEvent struct {
+	ent.Schema
+}
+
+// Fields define os campos da entidade Evento de Webhook
+func (WebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").
+			Unique().
+			StorageKey("id").
+			Immutable(),
+		field.String("provider").
+			NotEmpty().
+			Immutable(),
+		field.String("event_id").
+			NotEmpty().
+			Immutable(),
+		field.String("event_type").
+			NotEmpty(),
+		field.String("transaction_id").
+			Optional(),
+		field.Text("payload"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes garante que cada evento de um provedor seja processado uma única vez
+func (WebhookEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("provider", "event_id").
+			Unique(),
+	}
+}
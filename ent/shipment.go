@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+)
+
+// Shipment is the model entity for the Shipment schema.
+type Shipment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// OrderID holds the value of the "order_id" field.
+	OrderID string `json:"order_id,omitempty"`
+	// Carrier holds the value of the "carrier" field.
+	Carrier string `json:"carrier,omitempty"`
+	// TrackingCode holds the value of the "tracking_code" field.
+	TrackingCode string `json:"tracking_code,omitempty"`
+	// Status holds the value of the "status" field.
+	Status shipment.Status `json:"status,omitempty"`
+	// ShippedAt holds the value of the "shipped_at" field.
+	ShippedAt *time.Time `json:"shipped_at,omitempty"`
+	// DeliveredAt holds the value of the "delivered_at" field.
+	DeliveredAt *time.Time `json:"delivered_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ShipmentQuery when eager-loading is set.
+	Edges        ShipmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ShipmentEdges holds the relations/edges for other nodes in the graph.
+type ShipmentEdges struct {
+	// Order holds the value of the order edge.
+	Order *Order `json:"order,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*TrackingEvent `json:"events,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrderOrErr returns the Order value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ShipmentEdges) OrderOrErr() (*Order, error) {
+	if e.Order != nil {
+		return e.Order, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: order.Label}
+	}
+	return nil, &NotLoadedError{edge: "order"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e ShipmentEdges) EventsOrErr() ([]*TrackingEvent, error) {
+	if e.loadedTypes[1] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Shipment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case shipment.FieldID, shipment.FieldOrderID, shipment.FieldCarrier, shipment.FieldTrackingCode, shipment.FieldStatus:
+			values[i] = new(sql.NullString)
+		case shipment.FieldShippedAt, shipment.FieldDeliveredAt, shipment.FieldCreatedAt, shipment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Shipment fields.
+func (s *Shipment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case shipment.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				s.ID = value.String
+			}
+		case shipment.FieldOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_id", values[i])
+			} else if value.Valid {
+				s.OrderID = value.String
+			}
+		case shipment.FieldCarrier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field carrier", values[i])
+			} else if value.Valid {
+				s.Carrier = value.String
+			}
+		case shipment.FieldTrackingCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tracking_code", values[i])
+			} else if value.Valid {
+				s.TrackingCode = value.String
+			}
+		case shipment.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				s.Status = shipment.Status(value.String)
+			}
+		case shipment.FieldShippedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field shipped_at", values[i])
+			} else if value.Valid {
+				s.ShippedAt = new(time.Time)
+				*s.ShippedAt = value.Time
+			}
+		case shipment.FieldDeliveredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delivered_at", values[i])
+			} else if value.Valid {
+				s.DeliveredAt = new(time.Time)
+				*s.DeliveredAt = value.Time
+			}
+		case shipment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case shipment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Shipment.
+// This includes values selected through modifiers, order, etc.
+func (s *Shipment) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryOrder queries the "order" edge of the Shipment entity.
+func (s *Shipment) QueryOrder() *OrderQuery {
+	return NewShipmentClient(s.config).QueryOrder(s)
+}
+
+// QueryEvents queries the "events" edge of the Shipment entity.
+func (s *Shipment) QueryEvents() *TrackingEventQuery {
+	return NewShipmentClient(s.config).QueryEvents(s)
+}
+
+// Update returns a builder for updating this Shipment.
+// Note that you need to call Shipment.Unwrap() before calling this method if this Shipment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Shipment) Update() *ShipmentUpdateOne {
+	return NewShipmentClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Shipment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Shipment) Unwrap() *Shipment {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Shipment is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Shipment) String() string {
+	var builder strings.Builder
+	builder.WriteString("Shipment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("order_id=")
+	builder.WriteString(s.OrderID)
+	builder.WriteString(", ")
+	builder.WriteString("carrier=")
+	builder.WriteString(s.Carrier)
+	builder.WriteString(", ")
+	builder.WriteString("tracking_code=")
+	builder.WriteString(s.TrackingCode)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", s.Status))
+	builder.WriteString(", ")
+	if v := s.ShippedAt; v != nil {
+		builder.WriteString("shipped_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := s.DeliveredAt; v != nil {
+		builder.WriteString("delivered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Shipments is a parsable slice of Shipment.
+type Shipments []*Shipment
@@ -0,0 +1,782 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// ShipmentUpdate is the builder for updating Shipment entities.
+type ShipmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ShipmentMutation
+}
+
+// Where appends a list predicates to the ShipmentUpdate builder.
+func (su *ShipmentUpdate) Where(ps ...predicate.Shipment) *ShipmentUpdate {
+	su.mutation.Where(ps...)
+	return su
+}
+
+// SetOrderID sets the "order_id" field.
+func (su *ShipmentUpdate) SetOrderID(s string) *ShipmentUpdate {
+	su.mutation.SetOrderID(s)
+	return su
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableOrderID(s *string) *ShipmentUpdate {
+	if s != nil {
+		su.SetOrderID(*s)
+	}
+	return su
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (su *ShipmentUpdate) ClearOrderID() *ShipmentUpdate {
+	su.mutation.ClearOrderID()
+	return su
+}
+
+// SetCarrier sets the "carrier" field.
+func (su *ShipmentUpdate) SetCarrier(s string) *ShipmentUpdate {
+	su.mutation.SetCarrier(s)
+	return su
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableCarrier(s *string) *ShipmentUpdate {
+	if s != nil {
+		su.SetCarrier(*s)
+	}
+	return su
+}
+
+// SetTrackingCode sets the "tracking_code" field.
+func (su *ShipmentUpdate) SetTrackingCode(s string) *ShipmentUpdate {
+	su.mutation.SetTrackingCode(s)
+	return su
+}
+
+// SetNillableTrackingCode sets the "tracking_code" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableTrackingCode(s *string) *ShipmentUpdate {
+	if s != nil {
+		su.SetTrackingCode(*s)
+	}
+	return su
+}
+
+// SetStatus sets the "status" field.
+func (su *ShipmentUpdate) SetStatus(s shipment.Status) *ShipmentUpdate {
+	su.mutation.SetStatus(s)
+	return su
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableStatus(s *shipment.Status) *ShipmentUpdate {
+	if s != nil {
+		su.SetStatus(*s)
+	}
+	return su
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (su *ShipmentUpdate) SetShippedAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetShippedAt(t)
+	return su
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableShippedAt(t *time.Time) *ShipmentUpdate {
+	if t != nil {
+		su.SetShippedAt(*t)
+	}
+	return su
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (su *ShipmentUpdate) ClearShippedAt() *ShipmentUpdate {
+	su.mutation.ClearShippedAt()
+	return su
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (su *ShipmentUpdate) SetDeliveredAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetDeliveredAt(t)
+	return su
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableDeliveredAt(t *time.Time) *ShipmentUpdate {
+	if t != nil {
+		su.SetDeliveredAt(*t)
+	}
+	return su
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (su *ShipmentUpdate) ClearDeliveredAt() *ShipmentUpdate {
+	su.mutation.ClearDeliveredAt()
+	return su
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (su *ShipmentUpdate) SetCreatedAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetCreatedAt(t)
+	return su
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (su *ShipmentUpdate) SetNillableCreatedAt(t *time.Time) *ShipmentUpdate {
+	if t != nil {
+		su.SetCreatedAt(*t)
+	}
+	return su
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (su *ShipmentUpdate) SetUpdatedAt(t time.Time) *ShipmentUpdate {
+	su.mutation.SetUpdatedAt(t)
+	return su
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (su *ShipmentUpdate) SetOrder(o *Order) *ShipmentUpdate {
+	return su.SetOrderID(o.ID)
+}
+
+// AddEventIDs adds the "events" edge to the TrackingEvent entity by IDs.
+func (su *ShipmentUpdate) AddEventIDs(ids ...string) *ShipmentUpdate {
+	su.mutation.AddEventIDs(ids...)
+	return su
+}
+
+// AddEvents adds the "events" edges to the TrackingEvent entity.
+func (su *ShipmentUpdate) AddEvents(t ...*TrackingEvent) *ShipmentUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.AddEventIDs(ids...)
+}
+
+// Mutation returns the ShipmentMutation object of the builder.
+func (su *ShipmentUpdate) Mutation() *ShipmentMutation {
+	return su.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (su *ShipmentUpdate) ClearOrder() *ShipmentUpdate {
+	su.mutation.ClearOrder()
+	return su
+}
+
+// ClearEvents clears all "events" edges to the TrackingEvent entity.
+func (su *ShipmentUpdate) ClearEvents() *ShipmentUpdate {
+	su.mutation.ClearEvents()
+	return su
+}
+
+// RemoveEventIDs removes the "events" edge to TrackingEvent entities by IDs.
+func (su *ShipmentUpdate) RemoveEventIDs(ids ...string) *ShipmentUpdate {
+	su.mutation.RemoveEventIDs(ids...)
+	return su
+}
+
+// RemoveEvents removes "events" edges to TrackingEvent entities.
+func (su *ShipmentUpdate) RemoveEvents(t ...*TrackingEvent) *ShipmentUpdate {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return su.RemoveEventIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (su *ShipmentUpdate) Save(ctx context.Context) (int, error) {
+	su.defaults()
+	return withHooks(ctx, su.sqlSave, su.mutation, su.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (su *ShipmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := su.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (su *ShipmentUpdate) Exec(ctx context.Context) error {
+	_, err := su.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (su *ShipmentUpdate) ExecX(ctx context.Context) {
+	if err := su.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (su *ShipmentUpdate) defaults() {
+	if _, ok := su.mutation.UpdatedAt(); !ok {
+		v := shipment.UpdateDefaultUpdatedAt()
+		su.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (su *ShipmentUpdate) check() error {
+	if v, ok := su.mutation.Carrier(); ok {
+		if err := shipment.CarrierValidator(v); err != nil {
+			return &ValidationError{Name: "carrier", err: fmt.Errorf(`ent: validator failed for field "Shipment.carrier": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.TrackingCode(); ok {
+		if err := shipment.TrackingCodeValidator(v); err != nil {
+			return &ValidationError{Name: "tracking_code", err: fmt.Errorf(`ent: validator failed for field "Shipment.tracking_code": %w`, err)}
+		}
+	}
+	if v, ok := su.mutation.Status(); ok {
+		if err := shipment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Shipment.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (su *ShipmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := su.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipment.Table, shipment.Columns, sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString))
+	if ps := su.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := su.mutation.Carrier(); ok {
+		_spec.SetField(shipment.FieldCarrier, field.TypeString, value)
+	}
+	if value, ok := su.mutation.TrackingCode(); ok {
+		_spec.SetField(shipment.FieldTrackingCode, field.TypeString, value)
+	}
+	if value, ok := su.mutation.Status(); ok {
+		_spec.SetField(shipment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := su.mutation.ShippedAt(); ok {
+		_spec.SetField(shipment.FieldShippedAt, field.TypeTime, value)
+	}
+	if su.mutation.ShippedAtCleared() {
+		_spec.ClearField(shipment.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.DeliveredAt(); ok {
+		_spec.SetField(shipment.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if su.mutation.DeliveredAtCleared() {
+		_spec.ClearField(shipment.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := su.mutation.CreatedAt(); ok {
+		_spec.SetField(shipment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := su.mutation.UpdatedAt(); ok {
+		_spec.SetField(shipment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if su.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if su.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedEventsIDs(); len(nodes) > 0 && !su.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	su.mutation.done = true
+	return n, nil
+}
+
+// ShipmentUpdateOne is the builder for updating a single Shipment entity.
+type ShipmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ShipmentMutation
+}
+
+// SetOrderID sets the "order_id" field.
+func (suo *ShipmentUpdateOne) SetOrderID(s string) *ShipmentUpdateOne {
+	suo.mutation.SetOrderID(s)
+	return suo
+}
+
+// SetNillableOrderID sets the "order_id" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableOrderID(s *string) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetOrderID(*s)
+	}
+	return suo
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (suo *ShipmentUpdateOne) ClearOrderID() *ShipmentUpdateOne {
+	suo.mutation.ClearOrderID()
+	return suo
+}
+
+// SetCarrier sets the "carrier" field.
+func (suo *ShipmentUpdateOne) SetCarrier(s string) *ShipmentUpdateOne {
+	suo.mutation.SetCarrier(s)
+	return suo
+}
+
+// SetNillableCarrier sets the "carrier" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableCarrier(s *string) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetCarrier(*s)
+	}
+	return suo
+}
+
+// SetTrackingCode sets the "tracking_code" field.
+func (suo *ShipmentUpdateOne) SetTrackingCode(s string) *ShipmentUpdateOne {
+	suo.mutation.SetTrackingCode(s)
+	return suo
+}
+
+// SetNillableTrackingCode sets the "tracking_code" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableTrackingCode(s *string) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetTrackingCode(*s)
+	}
+	return suo
+}
+
+// SetStatus sets the "status" field.
+func (suo *ShipmentUpdateOne) SetStatus(s shipment.Status) *ShipmentUpdateOne {
+	suo.mutation.SetStatus(s)
+	return suo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableStatus(s *shipment.Status) *ShipmentUpdateOne {
+	if s != nil {
+		suo.SetStatus(*s)
+	}
+	return suo
+}
+
+// SetShippedAt sets the "shipped_at" field.
+func (suo *ShipmentUpdateOne) SetShippedAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetShippedAt(t)
+	return suo
+}
+
+// SetNillableShippedAt sets the "shipped_at" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableShippedAt(t *time.Time) *ShipmentUpdateOne {
+	if t != nil {
+		suo.SetShippedAt(*t)
+	}
+	return suo
+}
+
+// ClearShippedAt clears the value of the "shipped_at" field.
+func (suo *ShipmentUpdateOne) ClearShippedAt() *ShipmentUpdateOne {
+	suo.mutation.ClearShippedAt()
+	return suo
+}
+
+// SetDeliveredAt sets the "delivered_at" field.
+func (suo *ShipmentUpdateOne) SetDeliveredAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetDeliveredAt(t)
+	return suo
+}
+
+// SetNillableDeliveredAt sets the "delivered_at" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableDeliveredAt(t *time.Time) *ShipmentUpdateOne {
+	if t != nil {
+		suo.SetDeliveredAt(*t)
+	}
+	return suo
+}
+
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (suo *ShipmentUpdateOne) ClearDeliveredAt() *ShipmentUpdateOne {
+	suo.mutation.ClearDeliveredAt()
+	return suo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (suo *ShipmentUpdateOne) SetCreatedAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetCreatedAt(t)
+	return suo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (suo *ShipmentUpdateOne) SetNillableCreatedAt(t *time.Time) *ShipmentUpdateOne {
+	if t != nil {
+		suo.SetCreatedAt(*t)
+	}
+	return suo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (suo *ShipmentUpdateOne) SetUpdatedAt(t time.Time) *ShipmentUpdateOne {
+	suo.mutation.SetUpdatedAt(t)
+	return suo
+}
+
+// SetOrder sets the "order" edge to the Order entity.
+func (suo *ShipmentUpdateOne) SetOrder(o *Order) *ShipmentUpdateOne {
+	return suo.SetOrderID(o.ID)
+}
+
+// AddEventIDs adds the "events" edge to the TrackingEvent entity by IDs.
+func (suo *ShipmentUpdateOne) AddEventIDs(ids ...string) *ShipmentUpdateOne {
+	suo.mutation.AddEventIDs(ids...)
+	return suo
+}
+
+// AddEvents adds the "events" edges to the TrackingEvent entity.
+func (suo *ShipmentUpdateOne) AddEvents(t ...*TrackingEvent) *ShipmentUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.AddEventIDs(ids...)
+}
+
+// Mutation returns the ShipmentMutation object of the builder.
+func (suo *ShipmentUpdateOne) Mutation() *ShipmentMutation {
+	return suo.mutation
+}
+
+// ClearOrder clears the "order" edge to the Order entity.
+func (suo *ShipmentUpdateOne) ClearOrder() *ShipmentUpdateOne {
+	suo.mutation.ClearOrder()
+	return suo
+}
+
+// ClearEvents clears all "events" edges to the TrackingEvent entity.
+func (suo *ShipmentUpdateOne) ClearEvents() *ShipmentUpdateOne {
+	suo.mutation.ClearEvents()
+	return suo
+}
+
+// RemoveEventIDs removes the "events" edge to TrackingEvent entities by IDs.
+func (suo *ShipmentUpdateOne) RemoveEventIDs(ids ...string) *ShipmentUpdateOne {
+	suo.mutation.RemoveEventIDs(ids...)
+	return suo
+}
+
+// RemoveEvents removes "events" edges to TrackingEvent entities.
+func (suo *ShipmentUpdateOne) RemoveEvents(t ...*TrackingEvent) *ShipmentUpdateOne {
+	ids := make([]string, len(t))
+	for i := range t {
+		ids[i] = t[i].ID
+	}
+	return suo.RemoveEventIDs(ids...)
+}
+
+// Where appends a list predicates to the ShipmentUpdate builder.
+func (suo *ShipmentUpdateOne) Where(ps ...predicate.Shipment) *ShipmentUpdateOne {
+	suo.mutation.Where(ps...)
+	return suo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (suo *ShipmentUpdateOne) Select(field string, fields ...string) *ShipmentUpdateOne {
+	suo.fields = append([]string{field}, fields...)
+	return suo
+}
+
+// Save executes the query and returns the updated Shipment entity.
+func (suo *ShipmentUpdateOne) Save(ctx context.Context) (*Shipment, error) {
+	suo.defaults()
+	return withHooks(ctx, suo.sqlSave, suo.mutation, suo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (suo *ShipmentUpdateOne) SaveX(ctx context.Context) *Shipment {
+	node, err := suo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (suo *ShipmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := suo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (suo *ShipmentUpdateOne) ExecX(ctx context.Context) {
+	if err := suo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (suo *ShipmentUpdateOne) defaults() {
+	if _, ok := suo.mutation.UpdatedAt(); !ok {
+		v := shipment.UpdateDefaultUpdatedAt()
+		suo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (suo *ShipmentUpdateOne) check() error {
+	if v, ok := suo.mutation.Carrier(); ok {
+		if err := shipment.CarrierValidator(v); err != nil {
+			return &ValidationError{Name: "carrier", err: fmt.Errorf(`ent: validator failed for field "Shipment.carrier": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.TrackingCode(); ok {
+		if err := shipment.TrackingCodeValidator(v); err != nil {
+			return &ValidationError{Name: "tracking_code", err: fmt.Errorf(`ent: validator failed for field "Shipment.tracking_code": %w`, err)}
+		}
+	}
+	if v, ok := suo.mutation.Status(); ok {
+		if err := shipment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Shipment.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (suo *ShipmentUpdateOne) sqlSave(ctx context.Context) (_node *Shipment, err error) {
+	if err := suo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(shipment.Table, shipment.Columns, sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString))
+	id, ok := suo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Shipment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := suo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, shipment.FieldID)
+		for _, f := range fields {
+			if !shipment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != shipment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := suo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := suo.mutation.Carrier(); ok {
+		_spec.SetField(shipment.FieldCarrier, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.TrackingCode(); ok {
+		_spec.SetField(shipment.FieldTrackingCode, field.TypeString, value)
+	}
+	if value, ok := suo.mutation.Status(); ok {
+		_spec.SetField(shipment.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := suo.mutation.ShippedAt(); ok {
+		_spec.SetField(shipment.FieldShippedAt, field.TypeTime, value)
+	}
+	if suo.mutation.ShippedAtCleared() {
+		_spec.ClearField(shipment.FieldShippedAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.DeliveredAt(); ok {
+		_spec.SetField(shipment.FieldDeliveredAt, field.TypeTime, value)
+	}
+	if suo.mutation.DeliveredAtCleared() {
+		_spec.ClearField(shipment.FieldDeliveredAt, field.TypeTime)
+	}
+	if value, ok := suo.mutation.CreatedAt(); ok {
+		_spec.SetField(shipment.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := suo.mutation.UpdatedAt(); ok {
+		_spec.SetField(shipment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if suo.mutation.OrderCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.OrderIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   shipment.OrderTable,
+			Columns: []string{shipment.OrderColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if suo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedEventsIDs(); len(nodes) > 0 && !suo.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   shipment.EventsTable,
+			Columns: []string{shipment.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Shipment{config: suo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, suo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{shipment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	suo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+)
+
+// SlugRedirect is the model entity for the SlugRedirect schema.
+type SlugRedirect struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Entity holds the value of the "entity" field.
+	Entity slugredirect.Entity `json:"entity,omitempty"`
+	// Slug holds the value of the "slug" field.
+	Slug string `json:"slug,omitempty"`
+	// TargetID holds the value of the "target_id" field.
+	TargetID string `json:"target_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SlugRedirect) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case slugredirect.FieldID, slugredirect.FieldEntity, slugredirect.FieldSlug, slugredirect.FieldTargetID:
+			values[i] = new(sql.NullString)
+		case slugredirect.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SlugRedirect fields.
+func (sr *SlugRedirect) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case slugredirect.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sr.ID = value.String
+			}
+		case slugredirect.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				sr.Entity = slugredirect.Entity(value.String)
+			}
+		case slugredirect.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				sr.Slug = value.String
+			}
+		case slugredirect.FieldTargetID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field target_id", values[i])
+			} else if value.Valid {
+				sr.TargetID = value.String
+			}
+		case slugredirect.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sr.CreatedAt = value.Time
+			}
+		default:
+			sr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SlugRedirect.
+// This includes values selected through modifiers, order, etc.
+func (sr *SlugRedirect) Value(name string) (ent.Value, error) {
+	return sr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SlugRedirect.
+// Note that you need to call SlugRedirect.Unwrap() before calling this method if this SlugRedirect
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sr *SlugRedirect) Update() *SlugRedirectUpdateOne {
+	return NewSlugRedirectClient(sr.config).UpdateOne(sr)
+}
+
+// Unwrap unwraps the SlugRedirect entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sr *SlugRedirect) Unwrap() *SlugRedirect {
+	_tx, ok := sr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SlugRedirect is not a transactional entity")
+	}
+	sr.config.driver = _tx.drv
+	return sr
+}
+
+// String implements the fmt.Stringer.
+func (sr *SlugRedirect) String() string {
+	var builder strings.Builder
+	builder.WriteString("SlugRedirect(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sr.ID))
+	builder.WriteString("entity=")
+	builder.WriteString(fmt.Sprintf("%v", sr.Entity))
+	builder.WriteString(", ")
+	builder.WriteString("slug=")
+	builder.WriteString(sr.Slug)
+	builder.WriteString(", ")
+	builder.WriteString("target_id=")
+	builder.WriteString(sr.TargetID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sr.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SlugRedirects is a parsable slice of SlugRedirect.
+type SlugRedirects []*SlugRedirect
@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slugredirect
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the slugredirect type in the database.
+	Label = "slug_redirect"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEntity holds the string denoting the entity field in the database.
+	FieldEntity = "entity"
+	// FieldSlug holds the string denoting the slug field in the database.
+	FieldSlug = "slug"
+	// FieldTargetID holds the string denoting the target_id field in the database.
+	FieldTargetID = "target_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the slugredirect in the database.
+	Table = "slug_redirects"
+)
+
+// Columns holds all SQL columns for slugredirect fields.
+var Columns = []string{
+	FieldID,
+	FieldEntity,
+	FieldSlug,
+	FieldTargetID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	SlugValidator func(string) error
+	// TargetIDValidator is a validator for the "target_id" field. It is called by the builders before save.
+	TargetIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Entity defines the type for the "entity" enum field.
+type Entity string
+
+// Entity values.
+const (
+	EntityProduct  Entity = "product"
+	EntityCategory Entity = "category"
+)
+
+func (e Entity) String() string {
+	return string(e)
+}
+
+// EntityValidator is a validator for the "entity" field enum values. It is called by the builders before save.
+func EntityValidator(e Entity) error {
+	switch e {
+	case EntityProduct, EntityCategory:
+		return nil
+	default:
+		return fmt.Errorf("slugredirect: invalid enum value for entity field: %q", e)
+	}
+}
+
+// OrderOption defines the ordering options for the SlugRedirect queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEntity orders the results by the entity field.
+func ByEntity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntity, opts...).ToFunc()
+}
+
+// BySlug orders the results by the slug field.
+func BySlug(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSlug, opts...).ToFunc()
+}
+
+// ByTargetID orders the results by the target_id field.
+func ByTargetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTargetID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,285 @@
+// Code generated by ent, DO NOT EDIT.
+
+package slugredirect
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldContainsFold(FieldID, id))
+}
+
+// Slug applies equality check predicate on the "slug" field. It's identical to SlugEQ.
+func Slug(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldSlug, v))
+}
+
+// TargetID applies equality check predicate on the "target_id" field. It's identical to TargetIDEQ.
+func TargetID(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldTargetID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EntityEQ applies the EQ predicate on the "entity" field.
+func EntityEQ(v Entity) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldEntity, v))
+}
+
+// EntityNEQ applies the NEQ predicate on the "entity" field.
+func EntityNEQ(v Entity) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNEQ(FieldEntity, v))
+}
+
+// EntityIn applies the In predicate on the "entity" field.
+func EntityIn(vs ...Entity) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldIn(FieldEntity, vs...))
+}
+
+// EntityNotIn applies the NotIn predicate on the "entity" field.
+func EntityNotIn(vs ...Entity) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNotIn(FieldEntity, vs...))
+}
+
+// SlugEQ applies the EQ predicate on the "slug" field.
+func SlugEQ(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldSlug, v))
+}
+
+// SlugNEQ applies the NEQ predicate on the "slug" field.
+func SlugNEQ(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNEQ(FieldSlug, v))
+}
+
+// SlugIn applies the In predicate on the "slug" field.
+func SlugIn(vs ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldIn(FieldSlug, vs...))
+}
+
+// SlugNotIn applies the NotIn predicate on the "slug" field.
+func SlugNotIn(vs ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNotIn(FieldSlug, vs...))
+}
+
+// SlugGT applies the GT predicate on the "slug" field.
+func SlugGT(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGT(FieldSlug, v))
+}
+
+// SlugGTE applies the GTE predicate on the "slug" field.
+func SlugGTE(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGTE(FieldSlug, v))
+}
+
+// SlugLT applies the LT predicate on the "slug" field.
+func SlugLT(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLT(FieldSlug, v))
+}
+
+// SlugLTE applies the LTE predicate on the "slug" field.
+func SlugLTE(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLTE(FieldSlug, v))
+}
+
+// SlugContains applies the Contains predicate on the "slug" field.
+func SlugContains(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldContains(FieldSlug, v))
+}
+
+// SlugHasPrefix applies the HasPrefix predicate on the "slug" field.
+func SlugHasPrefix(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldHasPrefix(FieldSlug, v))
+}
+
+// SlugHasSuffix applies the HasSuffix predicate on the "slug" field.
+func SlugHasSuffix(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldHasSuffix(FieldSlug, v))
+}
+
+// SlugEqualFold applies the EqualFold predicate on the "slug" field.
+func SlugEqualFold(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEqualFold(FieldSlug, v))
+}
+
+// SlugContainsFold applies the ContainsFold predicate on the "slug" field.
+func SlugContainsFold(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldContainsFold(FieldSlug, v))
+}
+
+// TargetIDEQ applies the EQ predicate on the "target_id" field.
+func TargetIDEQ(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldTargetID, v))
+}
+
+// TargetIDNEQ applies the NEQ predicate on the "target_id" field.
+func TargetIDNEQ(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNEQ(FieldTargetID, v))
+}
+
+// TargetIDIn applies the In predicate on the "target_id" field.
+func TargetIDIn(vs ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldIn(FieldTargetID, vs...))
+}
+
+// TargetIDNotIn applies the NotIn predicate on the "target_id" field.
+func TargetIDNotIn(vs ...string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNotIn(FieldTargetID, vs...))
+}
+
+// TargetIDGT applies the GT predicate on the "target_id" field.
+func TargetIDGT(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGT(FieldTargetID, v))
+}
+
+// TargetIDGTE applies the GTE predicate on the "target_id" field.
+func TargetIDGTE(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGTE(FieldTargetID, v))
+}
+
+// TargetIDLT applies the LT predicate on the "target_id" field.
+func TargetIDLT(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLT(FieldTargetID, v))
+}
+
+// TargetIDLTE applies the LTE predicate on the "target_id" field.
+func TargetIDLTE(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLTE(FieldTargetID, v))
+}
+
+// TargetIDContains applies the Contains predicate on the "target_id" field.
+func TargetIDContains(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldContains(FieldTargetID, v))
+}
+
+// TargetIDHasPrefix applies the HasPrefix predicate on the "target_id" field.
+func TargetIDHasPrefix(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldHasPrefix(FieldTargetID, v))
+}
+
+// TargetIDHasSuffix applies the HasSuffix predicate on the "target_id" field.
+func TargetIDHasSuffix(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldHasSuffix(FieldTargetID, v))
+}
+
+// TargetIDEqualFold applies the EqualFold predicate on the "target_id" field.
+func TargetIDEqualFold(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEqualFold(FieldTargetID, v))
+}
+
+// TargetIDContainsFold applies the ContainsFold predicate on the "target_id" field.
+func TargetIDContainsFold(v string) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldContainsFold(FieldTargetID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SlugRedirect) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SlugRedirect) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SlugRedirect) predicate.SlugRedirect {
+	return predicate.SlugRedirect(sql.NotPredicates(p))
+}
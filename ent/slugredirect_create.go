@@ -0,0 +1,267 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+)
+
+// SlugRedirectCreate is the builder for creating a SlugRedirect entity.
+type SlugRedirectCreate struct {
+	config
+	mutation *SlugRedirectMutation
+	hooks    []Hook
+}
+
+// SetEntity sets the "entity" field.
+func (src *SlugRedirectCreate) SetEntity(s slugredirect.Entity) *SlugRedirectCreate {
+	src.mutation.SetEntity(s)
+	return src
+}
+
+// SetSlug sets the "slug" field.
+func (src *SlugRedirectCreate) SetSlug(s string) *SlugRedirectCreate {
+	src.mutation.SetSlug(s)
+	return src
+}
+
+// SetTargetID sets the "target_id" field.
+func (src *SlugRedirectCreate) SetTargetID(s string) *SlugRedirectCreate {
+	src.mutation.SetTargetID(s)
+	return src
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (src *SlugRedirectCreate) SetCreatedAt(t time.Time) *SlugRedirectCreate {
+	src.mutation.SetCreatedAt(t)
+	return src
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (src *SlugRedirectCreate) SetNillableCreatedAt(t *time.Time) *SlugRedirectCreate {
+	if t != nil {
+		src.SetCreatedAt(*t)
+	}
+	return src
+}
+
+// SetID sets the "id" field.
+func (src *SlugRedirectCreate) SetID(s string) *SlugRedirectCreate {
+	src.mutation.SetID(s)
+	return src
+}
+
+// Mutation returns the SlugRedirectMutation object of the builder.
+func (src *SlugRedirectCreate) Mutation() *SlugRedirectMutation {
+	return src.mutation
+}
+
+// Save creates the SlugRedirect in the database.
+func (src *SlugRedirectCreate) Save(ctx context.Context) (*SlugRedirect, error) {
+	src.defaults()
+	return withHooks(ctx, src.sqlSave, src.mutation, src.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (src *SlugRedirectCreate) SaveX(ctx context.Context) *SlugRedirect {
+	v, err := src.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (src *SlugRedirectCreate) Exec(ctx context.Context) error {
+	_, err := src.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (src *SlugRedirectCreate) ExecX(ctx context.Context) {
+	if err := src.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (src *SlugRedirectCreate) defaults() {
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		v := slugredirect.DefaultCreatedAt()
+		src.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (src *SlugRedirectCreate) check() error {
+	if _, ok := src.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "SlugRedirect.entity"`)}
+	}
+	if v, ok := src.mutation.Entity(); ok {
+		if err := slugredirect.EntityValidator(v); err != nil {
+			return &ValidationError{Name: "entity", err: fmt.Errorf(`ent: validator failed for field "SlugRedirect.entity": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.Slug(); !ok {
+		return &ValidationError{Name: "slug", err: errors.New(`ent: missing required field "SlugRedirect.slug"`)}
+	}
+	if v, ok := src.mutation.Slug(); ok {
+		if err := slugredirect.SlugValidator(v); err != nil {
+			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "SlugRedirect.slug": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.TargetID(); !ok {
+		return &ValidationError{Name: "target_id", err: errors.New(`ent: missing required field "SlugRedirect.target_id"`)}
+	}
+	if v, ok := src.mutation.TargetID(); ok {
+		if err := slugredirect.TargetIDValidator(v); err != nil {
+			return &ValidationError{Name: "target_id", err: fmt.Errorf(`ent: validator failed for field "SlugRedirect.target_id": %w`, err)}
+		}
+	}
+	if _, ok := src.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SlugRedirect.created_at"`)}
+	}
+	return nil
+}
+
+func (src *SlugRedirectCreate) sqlSave(ctx context.Context) (*SlugRedirect, error) {
+	if err := src.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := src.createSpec()
+	if err := sqlgraph.CreateNode(ctx, src.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SlugRedirect.ID type: %T", _spec.ID.Value)
+		}
+	}
+	src.mutation.id = &_node.ID
+	src.mutation.done = true
+	return _node, nil
+}
+
+func (src *SlugRedirectCreate) createSpec() (*SlugRedirect, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SlugRedirect{config: src.config}
+		_spec = sqlgraph.NewCreateSpec(slugredirect.Table, sqlgraph.NewFieldSpec(slugredirect.FieldID, field.TypeString))
+	)
+	if id, ok := src.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := src.mutation.Entity(); ok {
+		_spec.SetField(slugredirect.FieldEntity, field.TypeEnum, value)
+		_node.Entity = value
+	}
+	if value, ok := src.mutation.Slug(); ok {
+		_spec.SetField(slugredirect.FieldSlug, field.TypeString, value)
+		_node.Slug = value
+	}
+	if value, ok := src.mutation.TargetID(); ok {
+		_spec.SetField(slugredirect.FieldTargetID, field.TypeString, value)
+		_node.TargetID = value
+	}
+	if value, ok := src.mutation.CreatedAt(); ok {
+		_spec.SetField(slugredirect.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SlugRedirectCreateBulk is the builder for creating many SlugRedirect entities in bulk.
+type SlugRedirectCreateBulk struct {
+	config
+	err      error
+	builders []*SlugRedirectCreate
+}
+
+// Save creates the SlugRedirect entities in the database.
+func (srcb *SlugRedirectCreateBulk) Save(ctx context.Context) ([]*SlugRedirect, error) {
+	if srcb.err != nil {
+		return nil, srcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(srcb.builders))
+	nodes := make([]*SlugRedirect, len(srcb.builders))
+	mutators := make([]Mutator, len(srcb.builders))
+	for i := range srcb.builders {
+		func(i int, root context.Context) {
+			builder := srcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SlugRedirectMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, srcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, srcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, srcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (srcb *SlugRedirectCreateBulk) SaveX(ctx context.Context) []*SlugRedirect {
+	v, err := srcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (srcb *SlugRedirectCreateBulk) Exec(ctx context.Context) error {
+	_, err := srcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srcb *SlugRedirectCreateBulk) ExecX(ctx context.Context) {
+	if err := srcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+)
+
+// SlugRedirectDelete is the builder for deleting a SlugRedirect entity.
+type SlugRedirectDelete struct {
+	config
+	hooks    []Hook
+	mutation *SlugRedirectMutation
+}
+
+// Where appends a list predicates to the SlugRedirectDelete builder.
+func (srd *SlugRedirectDelete) Where(ps ...predicate.SlugRedirect) *SlugRedirectDelete {
+	srd.mutation.Where(ps...)
+	return srd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (srd *SlugRedirectDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, srd.sqlExec, srd.mutation, srd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srd *SlugRedirectDelete) ExecX(ctx context.Context) int {
+	n, err := srd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (srd *SlugRedirectDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(slugredirect.Table, sqlgraph.NewFieldSpec(slugredirect.FieldID, field.TypeString))
+	if ps := srd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, srd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	srd.mutation.done = true
+	return affected, err
+}
+
+// SlugRedirectDeleteOne is the builder for deleting a single SlugRedirect entity.
+type SlugRedirectDeleteOne struct {
+	srd *SlugRedirectDelete
+}
+
+// Where appends a list predicates to the SlugRedirectDelete builder.
+func (srdo *SlugRedirectDeleteOne) Where(ps ...predicate.SlugRedirect) *SlugRedirectDeleteOne {
+	srdo.srd.mutation.Where(ps...)
+	return srdo
+}
+
+// Exec executes the deletion query.
+func (srdo *SlugRedirectDeleteOne) Exec(ctx context.Context) error {
+	n, err := srdo.srd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{slugredirect.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (srdo *SlugRedirectDeleteOne) ExecX(ctx context.Context) {
+	if err := srdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+)
+
+// SlugRedirectQuery is the builder for querying SlugRedirect entities.
+type SlugRedirectQuery struct {
+	config
+	ctx        *QueryContext
+	order      []slugredirect.OrderOption
+	inters     []Interceptor
+	predicates []predicate.SlugRedirect
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the SlugRedirectQuery builder.
+func (srq *SlugRedirectQuery) Where(ps ...predicate.SlugRedirect) *SlugRedirectQuery {
+	srq.predicates = append(srq.predicates, ps...)
+	return srq
+}
+
+// Limit the number of records to be returned by this query.
+func (srq *SlugRedirectQuery) Limit(limit int) *SlugRedirectQuery {
+	srq.ctx.Limit = &limit
+	return srq
+}
+
+// Offset to start from.
+func (srq *SlugRedirectQuery) Offset(offset int) *SlugRedirectQuery {
+	srq.ctx.Offset = &offset
+	return srq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (srq *SlugRedirectQuery) Unique(unique bool) *SlugRedirectQuery {
+	srq.ctx.Unique = &unique
+	return srq
+}
+
+// Order specifies how the records should be ordered.
+func (srq *SlugRedirectQuery) Order(o ...slugredirect.OrderOption) *SlugRedirectQuery {
+	srq.order = append(srq.order, o...)
+	return srq
+}
+
+// First returns the first SlugRedirect entity from the query.
+// Returns a *NotFoundError when no SlugRedirect was found.
+func (srq *SlugRedirectQuery) First(ctx context.Context) (*SlugRedirect, error) {
+	nodes, err := srq.Limit(1).All(setContextOp(ctx, srq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{slugredirect.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (srq *SlugRedirectQuery) FirstX(ctx context.Context) *SlugRedirect {
+	node, err := srq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first SlugRedirect ID from the query.
+// Returns a *NotFoundError when no SlugRedirect ID was found.
+func (srq *SlugRedirectQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = srq.Limit(1).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{slugredirect.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (srq *SlugRedirectQuery) FirstIDX(ctx context.Context) string {
+	id, err := srq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single SlugRedirect entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one SlugRedirect entity is found.
+// Returns a *NotFoundError when no SlugRedirect entities are found.
+func (srq *SlugRedirectQuery) Only(ctx context.Context) (*SlugRedirect, error) {
+	nodes, err := srq.Limit(2).All(setContextOp(ctx, srq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{slugredirect.Label}
+	default:
+		return nil, &NotSingularError{slugredirect.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (srq *SlugRedirectQuery) OnlyX(ctx context.Context) *SlugRedirect {
+	node, err := srq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only SlugRedirect ID in the query.
+// Returns a *NotSingularError when more than one SlugRedirect ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (srq *SlugRedirectQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = srq.Limit(2).IDs(setContextOp(ctx, srq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{slugredirect.Label}
+	default:
+		err = &NotSingularError{slugredirect.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (srq *SlugRedirectQuery) OnlyIDX(ctx context.Context) string {
+	id, err := srq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of SlugRedirects.
+func (srq *SlugRedirectQuery) All(ctx context.Context) ([]*SlugRedirect, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryAll)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*SlugRedirect, *SlugRedirectQuery]()
+	return withInterceptors[[]*SlugRedirect](ctx, srq, qr, srq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (srq *SlugRedirectQuery) AllX(ctx context.Context) []*SlugRedirect {
+	nodes, err := srq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of SlugRedirect IDs.
+func (srq *SlugRedirectQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if srq.ctx.Unique == nil && srq.path != nil {
+		srq.Unique(true)
+	}
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryIDs)
+	if err = srq.Select(slugredirect.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (srq *SlugRedirectQuery) IDsX(ctx context.Context) []string {
+	ids, err := srq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (srq *SlugRedirectQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryCount)
+	if err := srq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, srq, querierCount[*SlugRedirectQuery](), srq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (srq *SlugRedirectQuery) CountX(ctx context.Context) int {
+	count, err := srq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (srq *SlugRedirectQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, srq.ctx, ent.OpQueryExist)
+	switch _, err := srq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (srq *SlugRedirectQuery) ExistX(ctx context.Context) bool {
+	exist, err := srq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the SlugRedirectQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (srq *SlugRedirectQuery) Clone() *SlugRedirectQuery {
+	if srq == nil {
+		return nil
+	}
+	return &SlugRedirectQuery{
+		config:     srq.config,
+		ctx:        srq.ctx.Clone(),
+		order:      append([]slugredirect.OrderOption{}, srq.order...),
+		inters:     append([]Interceptor{}, srq.inters...),
+		predicates: append([]predicate.SlugRedirect{}, srq.predicates...),
+		// clone intermediate query.
+		sql:  srq.sql.Clone(),
+		path: srq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		Entity slugredirect.Entity `json:"entity,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.SlugRedirect.Query().
+//		GroupBy(slugredirect.FieldEntity).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (srq *SlugRedirectQuery) GroupBy(field string, fields ...string) *SlugRedirectGroupBy {
+	srq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &SlugRedirectGroupBy{build: srq}
+	grbuild.flds = &srq.ctx.Fields
+	grbuild.label = slugredirect.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		Entity slugredirect.Entity `json:"entity,omitempty"`
+//	}
+//
+//	client.SlugRedirect.Query().
+//		Select(slugredirect.FieldEntity).
+//		Scan(ctx, &v)
+func (srq *SlugRedirectQuery) Select(fields ...string) *SlugRedirectSelect {
+	srq.ctx.Fields = append(srq.ctx.Fields, fields...)
+	sbuild := &SlugRedirectSelect{SlugRedirectQuery: srq}
+	sbuild.label = slugredirect.Label
+	sbuild.flds, sbuild.scan = &srq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a SlugRedirectSelect configured with the given aggregations.
+func (srq *SlugRedirectQuery) Aggregate(fns ...AggregateFunc) *SlugRedirectSelect {
+	return srq.Select().Aggregate(fns...)
+}
+
+func (srq *SlugRedirectQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range srq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, srq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range srq.ctx.Fields {
+		if !slugredirect.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if srq.path != nil {
+		prev, err := srq.path(ctx)
+		if err != nil {
+			return err
+		}
+		srq.sql = prev
+	}
+	return nil
+}
+
+func (srq *SlugRedirectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*SlugRedirect, error) {
+	var (
+		nodes = []*SlugRedirect{}
+		_spec = srq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*SlugRedirect).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &SlugRedirect{config: srq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, srq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (srq *SlugRedirectQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := srq.querySpec()
+	_spec.Node.Columns = srq.ctx.Fields
+	if len(srq.ctx.Fields) > 0 {
+		_spec.Unique = srq.ctx.Unique != nil && *srq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, srq.driver, _spec)
+}
+
+func (srq *SlugRedirectQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(slugredirect.Table, slugredirect.Columns, sqlgraph.NewFieldSpec(slugredirect.FieldID, field.TypeString))
+	_spec.From = srq.sql
+	if unique := srq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if srq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := srq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slugredirect.FieldID)
+		for i := range fields {
+			if fields[i] != slugredirect.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := srq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := srq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (srq *SlugRedirectQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(srq.driver.Dialect())
+	t1 := builder.Table(slugredirect.Table)
+	columns := srq.ctx.Fields
+	if len(columns) == 0 {
+		columns = slugredirect.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if srq.sql != nil {
+		selector = srq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if srq.ctx.Unique != nil && *srq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range srq.predicates {
+		p(selector)
+	}
+	for _, p := range srq.order {
+		p(selector)
+	}
+	if offset := srq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := srq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// SlugRedirectGroupBy is the group-by builder for SlugRedirect entities.
+type SlugRedirectGroupBy struct {
+	selector
+	build *SlugRedirectQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (srgb *SlugRedirectGroupBy) Aggregate(fns ...AggregateFunc) *SlugRedirectGroupBy {
+	srgb.fns = append(srgb.fns, fns...)
+	return srgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srgb *SlugRedirectGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srgb.build.ctx, ent.OpQueryGroupBy)
+	if err := srgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlugRedirectQuery, *SlugRedirectGroupBy](ctx, srgb.build, srgb, srgb.build.inters, v)
+}
+
+func (srgb *SlugRedirectGroupBy) sqlScan(ctx context.Context, root *SlugRedirectQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(srgb.fns))
+	for _, fn := range srgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*srgb.flds)+len(srgb.fns))
+		for _, f := range *srgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*srgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// SlugRedirectSelect is the builder for selecting fields of SlugRedirect entities.
+type SlugRedirectSelect struct {
+	*SlugRedirectQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (srs *SlugRedirectSelect) Aggregate(fns ...AggregateFunc) *SlugRedirectSelect {
+	srs.fns = append(srs.fns, fns...)
+	return srs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (srs *SlugRedirectSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, srs.ctx, ent.OpQuerySelect)
+	if err := srs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*SlugRedirectQuery, *SlugRedirectSelect](ctx, srs.SlugRedirectQuery, srs, srs.inters, v)
+}
+
+func (srs *SlugRedirectSelect) sqlScan(ctx context.Context, root *SlugRedirectQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(srs.fns))
+	for _, fn := range srs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*srs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := srs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
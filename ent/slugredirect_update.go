@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/slugredirect"
+)
+
+// SlugRedirectUpdate is the builder for updating SlugRedirect entities.
+type SlugRedirectUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SlugRedirectMutation
+}
+
+// Where appends a list predicates to the SlugRedirectUpdate builder.
+func (sru *SlugRedirectUpdate) Where(ps ...predicate.SlugRedirect) *SlugRedirectUpdate {
+	sru.mutation.Where(ps...)
+	return sru
+}
+
+// Mutation returns the SlugRedirectMutation object of the builder.
+func (sru *SlugRedirectUpdate) Mutation() *SlugRedirectMutation {
+	return sru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sru *SlugRedirectUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, sru.sqlSave, sru.mutation, sru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sru *SlugRedirectUpdate) SaveX(ctx context.Context) int {
+	affected, err := sru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sru *SlugRedirectUpdate) Exec(ctx context.Context) error {
+	_, err := sru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sru *SlugRedirectUpdate) ExecX(ctx context.Context) {
+	if err := sru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sru *SlugRedirectUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(slugredirect.Table, slugredirect.Columns, sqlgraph.NewFieldSpec(slugredirect.FieldID, field.TypeString))
+	if ps := sru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slugredirect.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sru.mutation.done = true
+	return n, nil
+}
+
+// SlugRedirectUpdateOne is the builder for updating a single SlugRedirect entity.
+type SlugRedirectUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SlugRedirectMutation
+}
+
+// Mutation returns the SlugRedirectMutation object of the builder.
+func (sruo *SlugRedirectUpdateOne) Mutation() *SlugRedirectMutation {
+	return sruo.mutation
+}
+
+// Where appends a list predicates to the SlugRedirectUpdate builder.
+func (sruo *SlugRedirectUpdateOne) Where(ps ...predicate.SlugRedirect) *SlugRedirectUpdateOne {
+	sruo.mutation.Where(ps...)
+	return sruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (sruo *SlugRedirectUpdateOne) Select(field string, fields ...string) *SlugRedirectUpdateOne {
+	sruo.fields = append([]string{field}, fields...)
+	return sruo
+}
+
+// Save executes the query and returns the updated SlugRedirect entity.
+func (sruo *SlugRedirectUpdateOne) Save(ctx context.Context) (*SlugRedirect, error) {
+	return withHooks(ctx, sruo.sqlSave, sruo.mutation, sruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sruo *SlugRedirectUpdateOne) SaveX(ctx context.Context) *SlugRedirect {
+	node, err := sruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (sruo *SlugRedirectUpdateOne) Exec(ctx context.Context) error {
+	_, err := sruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sruo *SlugRedirectUpdateOne) ExecX(ctx context.Context) {
+	if err := sruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (sruo *SlugRedirectUpdateOne) sqlSave(ctx context.Context) (_node *SlugRedirect, err error) {
+	_spec := sqlgraph.NewUpdateSpec(slugredirect.Table, slugredirect.Columns, sqlgraph.NewFieldSpec(slugredirect.FieldID, field.TypeString))
+	id, ok := sruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SlugRedirect.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := sruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, slugredirect.FieldID)
+		for _, f := range fields {
+			if !slugredirect.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != slugredirect.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := sruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &SlugRedirect{config: sruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, sruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{slugredirect.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	sruo.mutation.done = true
+	return _node, nil
+}
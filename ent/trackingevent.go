@@ -0,0 +1,188 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// TrackingEvent is the model entity for the TrackingEvent schema.
+type TrackingEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ShipmentID holds the value of the "shipment_id" field.
+	ShipmentID string `json:"shipment_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status trackingevent.Status `json:"status,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// OccurredAt holds the value of the "occurred_at" field.
+	OccurredAt time.Time `json:"occurred_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TrackingEventQuery when eager-loading is set.
+	Edges        TrackingEventEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TrackingEventEdges holds the relations/edges for other nodes in the graph.
+type TrackingEventEdges struct {
+	// Shipment holds the value of the shipment edge.
+	Shipment *Shipment `json:"shipment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ShipmentOrErr returns the Shipment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TrackingEventEdges) ShipmentOrErr() (*Shipment, error) {
+	if e.Shipment != nil {
+		return e.Shipment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: shipment.Label}
+	}
+	return nil, &NotLoadedError{edge: "shipment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrackingEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trackingevent.FieldID, trackingevent.FieldShipmentID, trackingevent.FieldStatus, trackingevent.FieldDescription, trackingevent.FieldLocation:
+			values[i] = new(sql.NullString)
+		case trackingevent.FieldOccurredAt, trackingevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrackingEvent fields.
+func (te *TrackingEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trackingevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				te.ID = value.String
+			}
+		case trackingevent.FieldShipmentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field shipment_id", values[i])
+			} else if value.Valid {
+				te.ShipmentID = value.String
+			}
+		case trackingevent.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				te.Status = trackingevent.Status(value.String)
+			}
+		case trackingevent.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				te.Description = value.String
+			}
+		case trackingevent.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				te.Location = value.String
+			}
+		case trackingevent.FieldOccurredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field occurred_at", values[i])
+			} else if value.Valid {
+				te.OccurredAt = value.Time
+			}
+		case trackingevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				te.CreatedAt = value.Time
+			}
+		default:
+			te.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrackingEvent.
+// This includes values selected through modifiers, order, etc.
+func (te *TrackingEvent) Value(name string) (ent.Value, error) {
+	return te.selectValues.Get(name)
+}
+
+// QueryShipment queries the "shipment" edge of the TrackingEvent entity.
+func (te *TrackingEvent) QueryShipment() *ShipmentQuery {
+	return NewTrackingEventClient(te.config).QueryShipment(te)
+}
+
+// Update returns a builder for updating this TrackingEvent.
+// Note that you need to call TrackingEvent.Unwrap() before calling this method if this TrackingEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (te *TrackingEvent) Update() *TrackingEventUpdateOne {
+	return NewTrackingEventClient(te.config).UpdateOne(te)
+}
+
+// Unwrap unwraps the TrackingEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (te *TrackingEvent) Unwrap() *TrackingEvent {
+	_tx, ok := te.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrackingEvent is not a transactional entity")
+	}
+	te.config.driver = _tx.drv
+	return te
+}
+
+// String implements the fmt.Stringer.
+func (te *TrackingEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrackingEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", te.ID))
+	builder.WriteString("shipment_id=")
+	builder.WriteString(te.ShipmentID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", te.Status))
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(te.Description)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(te.Location)
+	builder.WriteString(", ")
+	builder.WriteString("occurred_at=")
+	builder.WriteString(te.OccurredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(te.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrackingEvents is a parsable slice of TrackingEvent.
+type TrackingEvents []*TrackingEvent
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trackingevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the trackingevent type in the database.
+	Label = "tracking_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldShipmentID holds the string denoting the shipment_id field in the database.
+	FieldShipmentID = "shipment_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldOccurredAt holds the string denoting the occurred_at field in the database.
+	FieldOccurredAt = "occurred_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeShipment holds the string denoting the shipment edge name in mutations.
+	EdgeShipment = "shipment"
+	// Table holds the table name of the trackingevent in the database.
+	Table = "tracking_events"
+	// ShipmentTable is the table that holds the shipment relation/edge.
+	ShipmentTable = "tracking_events"
+	// ShipmentInverseTable is the table name for the Shipment entity.
+	// It exists in this package in order to avoid circular dependency with the "shipment" package.
+	ShipmentInverseTable = "shipments"
+	// ShipmentColumn is the table column denoting the shipment relation/edge.
+	ShipmentColumn = "shipment_id"
+)
+
+// Columns holds all SQL columns for trackingevent fields.
+var Columns = []string{
+	FieldID,
+	FieldShipmentID,
+	FieldStatus,
+	FieldDescription,
+	FieldLocation,
+	FieldOccurredAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DescriptionValidator is a validator for the "description" field. It is called by the builders before save.
+	DescriptionValidator func(string) error
+	// DefaultOccurredAt holds the default value on creation for the "occurred_at" field.
+	DefaultOccurredAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// Status values.
+const (
+	StatusPosted         Status = "posted"
+	StatusInTransit      Status = "in_transit"
+	StatusOutForDelivery Status = "out_for_delivery"
+	StatusDelivered      Status = "delivered"
+	StatusFailedAttempt  Status = "failed_attempt"
+	StatusReturned       Status = "returned"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPosted, StatusInTransit, StatusOutForDelivery, StatusDelivered, StatusFailedAttempt, StatusReturned:
+		return nil
+	default:
+		return fmt.Errorf("trackingevent: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the TrackingEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByShipmentID orders the results by the shipment_id field.
+func ByShipmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldShipmentID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// ByOccurredAt orders the results by the occurred_at field.
+func ByOccurredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOccurredAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByShipmentField orders the results by shipment field.
+func ByShipmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShipmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newShipmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ShipmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ShipmentTable, ShipmentColumn),
+	)
+}
@@ -0,0 +1,444 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trackingevent
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContainsFold(FieldID, id))
+}
+
+// ShipmentID applies equality check predicate on the "shipment_id" field. It's identical to ShipmentIDEQ.
+func ShipmentID(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldShipmentID, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldDescription, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldLocation, v))
+}
+
+// OccurredAt applies equality check predicate on the "occurred_at" field. It's identical to OccurredAtEQ.
+func OccurredAt(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ShipmentIDEQ applies the EQ predicate on the "shipment_id" field.
+func ShipmentIDEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldShipmentID, v))
+}
+
+// ShipmentIDNEQ applies the NEQ predicate on the "shipment_id" field.
+func ShipmentIDNEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldShipmentID, v))
+}
+
+// ShipmentIDIn applies the In predicate on the "shipment_id" field.
+func ShipmentIDIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldShipmentID, vs...))
+}
+
+// ShipmentIDNotIn applies the NotIn predicate on the "shipment_id" field.
+func ShipmentIDNotIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldShipmentID, vs...))
+}
+
+// ShipmentIDGT applies the GT predicate on the "shipment_id" field.
+func ShipmentIDGT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldShipmentID, v))
+}
+
+// ShipmentIDGTE applies the GTE predicate on the "shipment_id" field.
+func ShipmentIDGTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldShipmentID, v))
+}
+
+// ShipmentIDLT applies the LT predicate on the "shipment_id" field.
+func ShipmentIDLT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldShipmentID, v))
+}
+
+// ShipmentIDLTE applies the LTE predicate on the "shipment_id" field.
+func ShipmentIDLTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldShipmentID, v))
+}
+
+// ShipmentIDContains applies the Contains predicate on the "shipment_id" field.
+func ShipmentIDContains(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContains(FieldShipmentID, v))
+}
+
+// ShipmentIDHasPrefix applies the HasPrefix predicate on the "shipment_id" field.
+func ShipmentIDHasPrefix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasPrefix(FieldShipmentID, v))
+}
+
+// ShipmentIDHasSuffix applies the HasSuffix predicate on the "shipment_id" field.
+func ShipmentIDHasSuffix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasSuffix(FieldShipmentID, v))
+}
+
+// ShipmentIDIsNil applies the IsNil predicate on the "shipment_id" field.
+func ShipmentIDIsNil() predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIsNull(FieldShipmentID))
+}
+
+// ShipmentIDNotNil applies the NotNil predicate on the "shipment_id" field.
+func ShipmentIDNotNil() predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotNull(FieldShipmentID))
+}
+
+// ShipmentIDEqualFold applies the EqualFold predicate on the "shipment_id" field.
+func ShipmentIDEqualFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEqualFold(FieldShipmentID, v))
+}
+
+// ShipmentIDContainsFold applies the ContainsFold predicate on the "shipment_id" field.
+func ShipmentIDContainsFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContainsFold(FieldShipmentID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// OccurredAtEQ applies the EQ predicate on the "occurred_at" field.
+func OccurredAtEQ(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtNEQ applies the NEQ predicate on the "occurred_at" field.
+func OccurredAtNEQ(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldOccurredAt, v))
+}
+
+// OccurredAtIn applies the In predicate on the "occurred_at" field.
+func OccurredAtIn(vs ...time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtNotIn applies the NotIn predicate on the "occurred_at" field.
+func OccurredAtNotIn(vs ...time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldOccurredAt, vs...))
+}
+
+// OccurredAtGT applies the GT predicate on the "occurred_at" field.
+func OccurredAtGT(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldOccurredAt, v))
+}
+
+// OccurredAtGTE applies the GTE predicate on the "occurred_at" field.
+func OccurredAtGTE(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldOccurredAt, v))
+}
+
+// OccurredAtLT applies the LT predicate on the "occurred_at" field.
+func OccurredAtLT(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldOccurredAt, v))
+}
+
+// OccurredAtLTE applies the LTE predicate on the "occurred_at" field.
+func OccurredAtLTE(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldOccurredAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasShipment applies the HasEdge predicate on the "shipment" edge.
+func HasShipment() predicate.TrackingEvent {
+	return predicate.TrackingEvent(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ShipmentTable, ShipmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasShipmentWith applies the HasEdge predicate on the "shipment" edge with a given conditions (other predicates).
+func HasShipmentWith(preds ...predicate.Shipment) predicate.TrackingEvent {
+	return predicate.TrackingEvent(func(s *sql.Selector) {
+		step := newShipmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TrackingEvent) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TrackingEvent) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TrackingEvent) predicate.TrackingEvent {
+	return predicate.TrackingEvent(sql.NotPredicates(p))
+}
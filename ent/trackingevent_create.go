@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// TrackingEventCreate is the builder for creating a TrackingEvent entity.
+type TrackingEventCreate struct {
+	config
+	mutation *TrackingEventMutation
+	hooks    []Hook
+}
+
+// SetShipmentID sets the "shipment_id" field.
+func (tec *TrackingEventCreate) SetShipmentID(s string) *TrackingEventCreate {
+	tec.mutation.SetShipmentID(s)
+	return tec
+}
+
+// SetNillableShipmentID sets the "shipment_id" field if the given value is not nil.
+func (tec *TrackingEventCreate) SetNillableShipmentID(s *string) *TrackingEventCreate {
+	if s != nil {
+		tec.SetShipmentID(*s)
+	}
+	return tec
+}
+
+// SetStatus sets the "status" field.
+func (tec *TrackingEventCreate) SetStatus(t trackingevent.Status) *TrackingEventCreate {
+	tec.mutation.SetStatus(t)
+	return tec
+}
+
+// SetDescription sets the "description" field.
+func (tec *TrackingEventCreate) SetDescription(s string) *TrackingEventCreate {
+	tec.mutation.SetDescription(s)
+	return tec
+}
+
+// SetLocation sets the "location" field.
+func (tec *TrackingEventCreate) SetLocation(s string) *TrackingEventCreate {
+	tec.mutation.SetLocation(s)
+	return tec
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (tec *TrackingEventCreate) SetNillableLocation(s *string) *TrackingEventCreate {
+	if s != nil {
+		tec.SetLocation(*s)
+	}
+	return tec
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (tec *TrackingEventCreate) SetOccurredAt(t time.Time) *TrackingEventCreate {
+	tec.mutation.SetOccurredAt(t)
+	return tec
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (tec *TrackingEventCreate) SetNillableOccurredAt(t *time.Time) *TrackingEventCreate {
+	if t != nil {
+		tec.SetOccurredAt(*t)
+	}
+	return tec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tec *TrackingEventCreate) SetCreatedAt(t time.Time) *TrackingEventCreate {
+	tec.mutation.SetCreatedAt(t)
+	return tec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tec *TrackingEventCreate) SetNillableCreatedAt(t *time.Time) *TrackingEventCreate {
+	if t != nil {
+		tec.SetCreatedAt(*t)
+	}
+	return tec
+}
+
+// SetID sets the "id" field.
+func (tec *TrackingEventCreate) SetID(s string) *TrackingEventCreate {
+	tec.mutation.SetID(s)
+	return tec
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (tec *TrackingEventCreate) SetShipment(s *Shipment) *TrackingEventCreate {
+	return tec.SetShipmentID(s.ID)
+}
+
+// Mutation returns the TrackingEventMutation object of the builder.
+func (tec *TrackingEventCreate) Mutation() *TrackingEventMutation {
+	return tec.mutation
+}
+
+// Save creates the TrackingEvent in the database.
+func (tec *TrackingEventCreate) Save(ctx context.Context) (*TrackingEvent, error) {
+	tec.defaults()
+	return withHooks(ctx, tec.sqlSave, tec.mutation, tec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tec *TrackingEventCreate) SaveX(ctx context.Context) *TrackingEvent {
+	v, err := tec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tec *TrackingEventCreate) Exec(ctx context.Context) error {
+	_, err := tec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tec *TrackingEventCreate) ExecX(ctx context.Context) {
+	if err := tec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tec *TrackingEventCreate) defaults() {
+	if _, ok := tec.mutation.OccurredAt(); !ok {
+		v := trackingevent.DefaultOccurredAt()
+		tec.mutation.SetOccurredAt(v)
+	}
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		v := trackingevent.DefaultCreatedAt()
+		tec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tec *TrackingEventCreate) check() error {
+	if _, ok := tec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "TrackingEvent.status"`)}
+	}
+	if v, ok := tec.mutation.Status(); ok {
+		if err := trackingevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.status": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "TrackingEvent.description"`)}
+	}
+	if v, ok := tec.mutation.Description(); ok {
+		if err := trackingevent.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.description": %w`, err)}
+		}
+	}
+	if _, ok := tec.mutation.OccurredAt(); !ok {
+		return &ValidationError{Name: "occurred_at", err: errors.New(`ent: missing required field "TrackingEvent.occurred_at"`)}
+	}
+	if _, ok := tec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrackingEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (tec *TrackingEventCreate) sqlSave(ctx context.Context) (*TrackingEvent, error) {
+	if err := tec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected TrackingEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	tec.mutation.id = &_node.ID
+	tec.mutation.done = true
+	return _node, nil
+}
+
+func (tec *TrackingEventCreate) createSpec() (*TrackingEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrackingEvent{config: tec.config}
+		_spec = sqlgraph.NewCreateSpec(trackingevent.Table, sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString))
+	)
+	if id, ok := tec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tec.mutation.Status(); ok {
+		_spec.SetField(trackingevent.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := tec.mutation.Description(); ok {
+		_spec.SetField(trackingevent.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := tec.mutation.Location(); ok {
+		_spec.SetField(trackingevent.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := tec.mutation.OccurredAt(); ok {
+		_spec.SetField(trackingevent.FieldOccurredAt, field.TypeTime, value)
+		_node.OccurredAt = value
+	}
+	if value, ok := tec.mutation.CreatedAt(); ok {
+		_spec.SetField(trackingevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := tec.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackingevent.ShipmentTable,
+			Columns: []string{trackingevent.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ShipmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TrackingEventCreateBulk is the builder for creating many TrackingEvent entities in bulk.
+type TrackingEventCreateBulk struct {
+	config
+	err      error
+	builders []*TrackingEventCreate
+}
+
+// Save creates the TrackingEvent entities in the database.
+func (tecb *TrackingEventCreateBulk) Save(ctx context.Context) ([]*TrackingEvent, error) {
+	if tecb.err != nil {
+		return nil, tecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tecb.builders))
+	nodes := make([]*TrackingEvent, len(tecb.builders))
+	mutators := make([]Mutator, len(tecb.builders))
+	for i := range tecb.builders {
+		func(i int, root context.Context) {
+			builder := tecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrackingEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tecb *TrackingEventCreateBulk) SaveX(ctx context.Context) []*TrackingEvent {
+	v, err := tecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tecb *TrackingEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := tecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tecb *TrackingEventCreateBulk) ExecX(ctx context.Context) {
+	if err := tecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// TrackingEventDelete is the builder for deleting a TrackingEvent entity.
+type TrackingEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *TrackingEventMutation
+}
+
+// Where appends a list predicates to the TrackingEventDelete builder.
+func (ted *TrackingEventDelete) Where(ps ...predicate.TrackingEvent) *TrackingEventDelete {
+	ted.mutation.Where(ps...)
+	return ted
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ted *TrackingEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ted.sqlExec, ted.mutation, ted.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ted *TrackingEventDelete) ExecX(ctx context.Context) int {
+	n, err := ted.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ted *TrackingEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(trackingevent.Table, sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString))
+	if ps := ted.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ted.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ted.mutation.done = true
+	return affected, err
+}
+
+// TrackingEventDeleteOne is the builder for deleting a single TrackingEvent entity.
+type TrackingEventDeleteOne struct {
+	ted *TrackingEventDelete
+}
+
+// Where appends a list predicates to the TrackingEventDelete builder.
+func (tedo *TrackingEventDeleteOne) Where(ps ...predicate.TrackingEvent) *TrackingEventDeleteOne {
+	tedo.ted.mutation.Where(ps...)
+	return tedo
+}
+
+// Exec executes the deletion query.
+func (tedo *TrackingEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := tedo.ted.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{trackingevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tedo *TrackingEventDeleteOne) ExecX(ctx context.Context) {
+	if err := tedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// TrackingEventQuery is the builder for querying TrackingEvent entities.
+type TrackingEventQuery struct {
+	config
+	ctx          *QueryContext
+	order        []trackingevent.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.TrackingEvent
+	withShipment *ShipmentQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TrackingEventQuery builder.
+func (teq *TrackingEventQuery) Where(ps ...predicate.TrackingEvent) *TrackingEventQuery {
+	teq.predicates = append(teq.predicates, ps...)
+	return teq
+}
+
+// Limit the number of records to be returned by this query.
+func (teq *TrackingEventQuery) Limit(limit int) *TrackingEventQuery {
+	teq.ctx.Limit = &limit
+	return teq
+}
+
+// Offset to start from.
+func (teq *TrackingEventQuery) Offset(offset int) *TrackingEventQuery {
+	teq.ctx.Offset = &offset
+	return teq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (teq *TrackingEventQuery) Unique(unique bool) *TrackingEventQuery {
+	teq.ctx.Unique = &unique
+	return teq
+}
+
+// Order specifies how the records should be ordered.
+func (teq *TrackingEventQuery) Order(o ...trackingevent.OrderOption) *TrackingEventQuery {
+	teq.order = append(teq.order, o...)
+	return teq
+}
+
+// QueryShipment chains the current query on the "shipment" edge.
+func (teq *TrackingEventQuery) QueryShipment() *ShipmentQuery {
+	query := (&ShipmentClient{config: teq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := teq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := teq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(trackingevent.Table, trackingevent.FieldID, selector),
+			sqlgraph.To(shipment.Table, shipment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, trackingevent.ShipmentTable, trackingevent.ShipmentColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(teq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TrackingEvent entity from the query.
+// Returns a *NotFoundError when no TrackingEvent was found.
+func (teq *TrackingEventQuery) First(ctx context.Context) (*TrackingEvent, error) {
+	nodes, err := teq.Limit(1).All(setContextOp(ctx, teq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{trackingevent.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (teq *TrackingEventQuery) FirstX(ctx context.Context) *TrackingEvent {
+	node, err := teq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TrackingEvent ID from the query.
+// Returns a *NotFoundError when no TrackingEvent ID was found.
+func (teq *TrackingEventQuery) FirstID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = teq.Limit(1).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{trackingevent.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (teq *TrackingEventQuery) FirstIDX(ctx context.Context) string {
+	id, err := teq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TrackingEvent entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TrackingEvent entity is found.
+// Returns a *NotFoundError when no TrackingEvent entities are found.
+func (teq *TrackingEventQuery) Only(ctx context.Context) (*TrackingEvent, error) {
+	nodes, err := teq.Limit(2).All(setContextOp(ctx, teq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{trackingevent.Label}
+	default:
+		return nil, &NotSingularError{trackingevent.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (teq *TrackingEventQuery) OnlyX(ctx context.Context) *TrackingEvent {
+	node, err := teq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TrackingEvent ID in the query.
+// Returns a *NotSingularError when more than one TrackingEvent ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (teq *TrackingEventQuery) OnlyID(ctx context.Context) (id string, err error) {
+	var ids []string
+	if ids, err = teq.Limit(2).IDs(setContextOp(ctx, teq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{trackingevent.Label}
+	default:
+		err = &NotSingularError{trackingevent.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (teq *TrackingEventQuery) OnlyIDX(ctx context.Context) string {
+	id, err := teq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TrackingEvents.
+func (teq *TrackingEventQuery) All(ctx context.Context) ([]*TrackingEvent, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryAll)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TrackingEvent, *TrackingEventQuery]()
+	return withInterceptors[[]*TrackingEvent](ctx, teq, qr, teq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (teq *TrackingEventQuery) AllX(ctx context.Context) []*TrackingEvent {
+	nodes, err := teq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TrackingEvent IDs.
+func (teq *TrackingEventQuery) IDs(ctx context.Context) (ids []string, err error) {
+	if teq.ctx.Unique == nil && teq.path != nil {
+		teq.Unique(true)
+	}
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryIDs)
+	if err = teq.Select(trackingevent.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (teq *TrackingEventQuery) IDsX(ctx context.Context) []string {
+	ids, err := teq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (teq *TrackingEventQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryCount)
+	if err := teq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, teq, querierCount[*TrackingEventQuery](), teq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (teq *TrackingEventQuery) CountX(ctx context.Context) int {
+	count, err := teq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (teq *TrackingEventQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, teq.ctx, ent.OpQueryExist)
+	switch _, err := teq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (teq *TrackingEventQuery) ExistX(ctx context.Context) bool {
+	exist, err := teq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TrackingEventQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (teq *TrackingEventQuery) Clone() *TrackingEventQuery {
+	if teq == nil {
+		return nil
+	}
+	return &TrackingEventQuery{
+		config:       teq.config,
+		ctx:          teq.ctx.Clone(),
+		order:        append([]trackingevent.OrderOption{}, teq.order...),
+		inters:       append([]Interceptor{}, teq.inters...),
+		predicates:   append([]predicate.TrackingEvent{}, teq.predicates...),
+		withShipment: teq.withShipment.Clone(),
+		// clone intermediate query.
+		sql:  teq.sql.Clone(),
+		path: teq.path,
+	}
+}
+
+// WithShipment tells the query-builder to eager-load the nodes that are connected to
+// the "shipment" edge. The optional arguments are used to configure the query builder of the edge.
+func (teq *TrackingEventQuery) WithShipment(opts ...func(*ShipmentQuery)) *TrackingEventQuery {
+	query := (&ShipmentClient{config: teq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	teq.withShipment = query
+	return teq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ShipmentID string `json:"shipment_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TrackingEvent.Query().
+//		GroupBy(trackingevent.FieldShipmentID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (teq *TrackingEventQuery) GroupBy(field string, fields ...string) *TrackingEventGroupBy {
+	teq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TrackingEventGroupBy{build: teq}
+	grbuild.flds = &teq.ctx.Fields
+	grbuild.label = trackingevent.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ShipmentID string `json:"shipment_id,omitempty"`
+//	}
+//
+//	client.TrackingEvent.Query().
+//		Select(trackingevent.FieldShipmentID).
+//		Scan(ctx, &v)
+func (teq *TrackingEventQuery) Select(fields ...string) *TrackingEventSelect {
+	teq.ctx.Fields = append(teq.ctx.Fields, fields...)
+	sbuild := &TrackingEventSelect{TrackingEventQuery: teq}
+	sbuild.label = trackingevent.Label
+	sbuild.flds, sbuild.scan = &teq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TrackingEventSelect configured with the given aggregations.
+func (teq *TrackingEventQuery) Aggregate(fns ...AggregateFunc) *TrackingEventSelect {
+	return teq.Select().Aggregate(fns...)
+}
+
+func (teq *TrackingEventQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range teq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, teq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range teq.ctx.Fields {
+		if !trackingevent.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if teq.path != nil {
+		prev, err := teq.path(ctx)
+		if err != nil {
+			return err
+		}
+		teq.sql = prev
+	}
+	return nil
+}
+
+func (teq *TrackingEventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TrackingEvent, error) {
+	var (
+		nodes       = []*TrackingEvent{}
+		_spec       = teq.querySpec()
+		loadedTypes = [1]bool{
+			teq.withShipment != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TrackingEvent).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TrackingEvent{config: teq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, teq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := teq.withShipment; query != nil {
+		if err := teq.loadShipment(ctx, query, nodes, nil,
+			func(n *TrackingEvent, e *Shipment) { n.Edges.Shipment = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (teq *TrackingEventQuery) loadShipment(ctx context.Context, query *ShipmentQuery, nodes []*TrackingEvent, init func(*TrackingEvent), assign func(*TrackingEvent, *Shipment)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*TrackingEvent)
+	for i := range nodes {
+		fk := nodes[i].ShipmentID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(shipment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "shipment_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (teq *TrackingEventQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := teq.querySpec()
+	_spec.Node.Columns = teq.ctx.Fields
+	if len(teq.ctx.Fields) > 0 {
+		_spec.Unique = teq.ctx.Unique != nil && *teq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, teq.driver, _spec)
+}
+
+func (teq *TrackingEventQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(trackingevent.Table, trackingevent.Columns, sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString))
+	_spec.From = teq.sql
+	if unique := teq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if teq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := teq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trackingevent.FieldID)
+		for i := range fields {
+			if fields[i] != trackingevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if teq.withShipment != nil {
+			_spec.Node.AddColumnOnce(trackingevent.FieldShipmentID)
+		}
+	}
+	if ps := teq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := teq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (teq *TrackingEventQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(teq.driver.Dialect())
+	t1 := builder.Table(trackingevent.Table)
+	columns := teq.ctx.Fields
+	if len(columns) == 0 {
+		columns = trackingevent.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if teq.sql != nil {
+		selector = teq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if teq.ctx.Unique != nil && *teq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range teq.predicates {
+		p(selector)
+	}
+	for _, p := range teq.order {
+		p(selector)
+	}
+	if offset := teq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := teq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TrackingEventGroupBy is the group-by builder for TrackingEvent entities.
+type TrackingEventGroupBy struct {
+	selector
+	build *TrackingEventQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tegb *TrackingEventGroupBy) Aggregate(fns ...AggregateFunc) *TrackingEventGroupBy {
+	tegb.fns = append(tegb.fns, fns...)
+	return tegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tegb *TrackingEventGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tegb.build.ctx, ent.OpQueryGroupBy)
+	if err := tegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrackingEventQuery, *TrackingEventGroupBy](ctx, tegb.build, tegb, tegb.build.inters, v)
+}
+
+func (tegb *TrackingEventGroupBy) sqlScan(ctx context.Context, root *TrackingEventQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tegb.fns))
+	for _, fn := range tegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tegb.flds)+len(tegb.fns))
+		for _, f := range *tegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TrackingEventSelect is the builder for selecting fields of TrackingEvent entities.
+type TrackingEventSelect struct {
+	*TrackingEventQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tes *TrackingEventSelect) Aggregate(fns ...AggregateFunc) *TrackingEventSelect {
+	tes.fns = append(tes.fns, fns...)
+	return tes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tes *TrackingEventSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tes.ctx, ent.OpQuerySelect)
+	if err := tes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TrackingEventQuery, *TrackingEventSelect](ctx, tes.TrackingEventQuery, tes, tes.inters, v)
+}
+
+func (tes *TrackingEventSelect) sqlScan(ctx context.Context, root *TrackingEventQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tes.fns))
+	for _, fn := range tes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,487 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/shipment"
+	"github.com/vtrod/veecomm-api/ent/trackingevent"
+)
+
+// TrackingEventUpdate is the builder for updating TrackingEvent entities.
+type TrackingEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrackingEventMutation
+}
+
+// Where appends a list predicates to the TrackingEventUpdate builder.
+func (teu *TrackingEventUpdate) Where(ps ...predicate.TrackingEvent) *TrackingEventUpdate {
+	teu.mutation.Where(ps...)
+	return teu
+}
+
+// SetShipmentID sets the "shipment_id" field.
+func (teu *TrackingEventUpdate) SetShipmentID(s string) *TrackingEventUpdate {
+	teu.mutation.SetShipmentID(s)
+	return teu
+}
+
+// SetNillableShipmentID sets the "shipment_id" field if the given value is not nil.
+func (teu *TrackingEventUpdate) SetNillableShipmentID(s *string) *TrackingEventUpdate {
+	if s != nil {
+		teu.SetShipmentID(*s)
+	}
+	return teu
+}
+
+// ClearShipmentID clears the value of the "shipment_id" field.
+func (teu *TrackingEventUpdate) ClearShipmentID() *TrackingEventUpdate {
+	teu.mutation.ClearShipmentID()
+	return teu
+}
+
+// SetStatus sets the "status" field.
+func (teu *TrackingEventUpdate) SetStatus(t trackingevent.Status) *TrackingEventUpdate {
+	teu.mutation.SetStatus(t)
+	return teu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (teu *TrackingEventUpdate) SetNillableStatus(t *trackingevent.Status) *TrackingEventUpdate {
+	if t != nil {
+		teu.SetStatus(*t)
+	}
+	return teu
+}
+
+// SetDescription sets the "description" field.
+func (teu *TrackingEventUpdate) SetDescription(s string) *TrackingEventUpdate {
+	teu.mutation.SetDescription(s)
+	return teu
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (teu *TrackingEventUpdate) SetNillableDescription(s *string) *TrackingEventUpdate {
+	if s != nil {
+		teu.SetDescription(*s)
+	}
+	return teu
+}
+
+// SetLocation sets the "location" field.
+func (teu *TrackingEventUpdate) SetLocation(s string) *TrackingEventUpdate {
+	teu.mutation.SetLocation(s)
+	return teu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (teu *TrackingEventUpdate) SetNillableLocation(s *string) *TrackingEventUpdate {
+	if s != nil {
+		teu.SetLocation(*s)
+	}
+	return teu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (teu *TrackingEventUpdate) ClearLocation() *TrackingEventUpdate {
+	teu.mutation.ClearLocation()
+	return teu
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (teu *TrackingEventUpdate) SetOccurredAt(t time.Time) *TrackingEventUpdate {
+	teu.mutation.SetOccurredAt(t)
+	return teu
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (teu *TrackingEventUpdate) SetNillableOccurredAt(t *time.Time) *TrackingEventUpdate {
+	if t != nil {
+		teu.SetOccurredAt(*t)
+	}
+	return teu
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (teu *TrackingEventUpdate) SetShipment(s *Shipment) *TrackingEventUpdate {
+	return teu.SetShipmentID(s.ID)
+}
+
+// Mutation returns the TrackingEventMutation object of the builder.
+func (teu *TrackingEventUpdate) Mutation() *TrackingEventMutation {
+	return teu.mutation
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (teu *TrackingEventUpdate) ClearShipment() *TrackingEventUpdate {
+	teu.mutation.ClearShipment()
+	return teu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (teu *TrackingEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, teu.sqlSave, teu.mutation, teu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teu *TrackingEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := teu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (teu *TrackingEventUpdate) Exec(ctx context.Context) error {
+	_, err := teu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teu *TrackingEventUpdate) ExecX(ctx context.Context) {
+	if err := teu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teu *TrackingEventUpdate) check() error {
+	if v, ok := teu.mutation.Status(); ok {
+		if err := trackingevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.status": %w`, err)}
+		}
+	}
+	if v, ok := teu.mutation.Description(); ok {
+		if err := trackingevent.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (teu *TrackingEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := teu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackingevent.Table, trackingevent.Columns, sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString))
+	if ps := teu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teu.mutation.Status(); ok {
+		_spec.SetField(trackingevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := teu.mutation.Description(); ok {
+		_spec.SetField(trackingevent.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := teu.mutation.Location(); ok {
+		_spec.SetField(trackingevent.FieldLocation, field.TypeString, value)
+	}
+	if teu.mutation.LocationCleared() {
+		_spec.ClearField(trackingevent.FieldLocation, field.TypeString)
+	}
+	if value, ok := teu.mutation.OccurredAt(); ok {
+		_spec.SetField(trackingevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if teu.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackingevent.ShipmentTable,
+			Columns: []string{trackingevent.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teu.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackingevent.ShipmentTable,
+			Columns: []string{trackingevent.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, teu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	teu.mutation.done = true
+	return n, nil
+}
+
+// TrackingEventUpdateOne is the builder for updating a single TrackingEvent entity.
+type TrackingEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrackingEventMutation
+}
+
+// SetShipmentID sets the "shipment_id" field.
+func (teuo *TrackingEventUpdateOne) SetShipmentID(s string) *TrackingEventUpdateOne {
+	teuo.mutation.SetShipmentID(s)
+	return teuo
+}
+
+// SetNillableShipmentID sets the "shipment_id" field if the given value is not nil.
+func (teuo *TrackingEventUpdateOne) SetNillableShipmentID(s *string) *TrackingEventUpdateOne {
+	if s != nil {
+		teuo.SetShipmentID(*s)
+	}
+	return teuo
+}
+
+// ClearShipmentID clears the value of the "shipment_id" field.
+func (teuo *TrackingEventUpdateOne) ClearShipmentID() *TrackingEventUpdateOne {
+	teuo.mutation.ClearShipmentID()
+	return teuo
+}
+
+// SetStatus sets the "status" field.
+func (teuo *TrackingEventUpdateOne) SetStatus(t trackingevent.Status) *TrackingEventUpdateOne {
+	teuo.mutation.SetStatus(t)
+	return teuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (teuo *TrackingEventUpdateOne) SetNillableStatus(t *trackingevent.Status) *TrackingEventUpdateOne {
+	if t != nil {
+		teuo.SetStatus(*t)
+	}
+	return teuo
+}
+
+// SetDescription sets the "description" field.
+func (teuo *TrackingEventUpdateOne) SetDescription(s string) *TrackingEventUpdateOne {
+	teuo.mutation.SetDescription(s)
+	return teuo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (teuo *TrackingEventUpdateOne) SetNillableDescription(s *string) *TrackingEventUpdateOne {
+	if s != nil {
+		teuo.SetDescription(*s)
+	}
+	return teuo
+}
+
+// SetLocation sets the "location" field.
+func (teuo *TrackingEventUpdateOne) SetLocation(s string) *TrackingEventUpdateOne {
+	teuo.mutation.SetLocation(s)
+	return teuo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (teuo *TrackingEventUpdateOne) SetNillableLocation(s *string) *TrackingEventUpdateOne {
+	if s != nil {
+		teuo.SetLocation(*s)
+	}
+	return teuo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (teuo *TrackingEventUpdateOne) ClearLocation() *TrackingEventUpdateOne {
+	teuo.mutation.ClearLocation()
+	return teuo
+}
+
+// SetOccurredAt sets the "occurred_at" field.
+func (teuo *TrackingEventUpdateOne) SetOccurredAt(t time.Time) *TrackingEventUpdateOne {
+	teuo.mutation.SetOccurredAt(t)
+	return teuo
+}
+
+// SetNillableOccurredAt sets the "occurred_at" field if the given value is not nil.
+func (teuo *TrackingEventUpdateOne) SetNillableOccurredAt(t *time.Time) *TrackingEventUpdateOne {
+	if t != nil {
+		teuo.SetOccurredAt(*t)
+	}
+	return teuo
+}
+
+// SetShipment sets the "shipment" edge to the Shipment entity.
+func (teuo *TrackingEventUpdateOne) SetShipment(s *Shipment) *TrackingEventUpdateOne {
+	return teuo.SetShipmentID(s.ID)
+}
+
+// Mutation returns the TrackingEventMutation object of the builder.
+func (teuo *TrackingEventUpdateOne) Mutation() *TrackingEventMutation {
+	return teuo.mutation
+}
+
+// ClearShipment clears the "shipment" edge to the Shipment entity.
+func (teuo *TrackingEventUpdateOne) ClearShipment() *TrackingEventUpdateOne {
+	teuo.mutation.ClearShipment()
+	return teuo
+}
+
+// Where appends a list predicates to the TrackingEventUpdate builder.
+func (teuo *TrackingEventUpdateOne) Where(ps ...predicate.TrackingEvent) *TrackingEventUpdateOne {
+	teuo.mutation.Where(ps...)
+	return teuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (teuo *TrackingEventUpdateOne) Select(field string, fields ...string) *TrackingEventUpdateOne {
+	teuo.fields = append([]string{field}, fields...)
+	return teuo
+}
+
+// Save executes the query and returns the updated TrackingEvent entity.
+func (teuo *TrackingEventUpdateOne) Save(ctx context.Context) (*TrackingEvent, error) {
+	return withHooks(ctx, teuo.sqlSave, teuo.mutation, teuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (teuo *TrackingEventUpdateOne) SaveX(ctx context.Context) *TrackingEvent {
+	node, err := teuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (teuo *TrackingEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := teuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (teuo *TrackingEventUpdateOne) ExecX(ctx context.Context) {
+	if err := teuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (teuo *TrackingEventUpdateOne) check() error {
+	if v, ok := teuo.mutation.Status(); ok {
+		if err := trackingevent.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.status": %w`, err)}
+		}
+	}
+	if v, ok := teuo.mutation.Description(); ok {
+		if err := trackingevent.DescriptionValidator(v); err != nil {
+			return &ValidationError{Name: "description", err: fmt.Errorf(`ent: validator failed for field "TrackingEvent.description": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (teuo *TrackingEventUpdateOne) sqlSave(ctx context.Context) (_node *TrackingEvent, err error) {
+	if err := teuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trackingevent.Table, trackingevent.Columns, sqlgraph.NewFieldSpec(trackingevent.FieldID, field.TypeString))
+	id, ok := teuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrackingEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := teuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trackingevent.FieldID)
+		for _, f := range fields {
+			if !trackingevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trackingevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := teuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := teuo.mutation.Status(); ok {
+		_spec.SetField(trackingevent.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := teuo.mutation.Description(); ok {
+		_spec.SetField(trackingevent.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := teuo.mutation.Location(); ok {
+		_spec.SetField(trackingevent.FieldLocation, field.TypeString, value)
+	}
+	if teuo.mutation.LocationCleared() {
+		_spec.ClearField(trackingevent.FieldLocation, field.TypeString)
+	}
+	if value, ok := teuo.mutation.OccurredAt(); ok {
+		_spec.SetField(trackingevent.FieldOccurredAt, field.TypeTime, value)
+	}
+	if teuo.mutation.ShipmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackingevent.ShipmentTable,
+			Columns: []string{trackingevent.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := teuo.mutation.ShipmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   trackingevent.ShipmentTable,
+			Columns: []string{trackingevent.ShipmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shipment.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TrackingEvent{config: teuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, teuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trackingevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	teuo.mutation.done = true
+	return _node, nil
+}
@@ -12,6 +12,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccountToken is the client for interacting with the AccountToken builders.
+	AccountToken *AccountTokenClient
 	// Address is the client for interacting with the Address builders.
 	Address *AddressClient
 	// Avaliation is the client for interacting with the Avaliation builders.
@@ -24,14 +26,38 @@ type Tx struct {
 	Category *CategoryClient
 	// Coupon is the client for interacting with the Coupon builders.
 	Coupon *CouponClient
+	// LoginAttempt is the client for interacting with the LoginAttempt builders.
+	LoginAttempt *LoginAttemptClient
 	// Order is the client for interacting with the Order builders.
 	Order *OrderClient
 	// OrderItem is the client for interacting with the OrderItem builders.
 	OrderItem *OrderItemClient
+	// OrderStatusEvent is the client for interacting with the OrderStatusEvent builders.
+	OrderStatusEvent *OrderStatusEventClient
+	// Payment is the client for interacting with the Payment builders.
+	Payment *PaymentClient
 	// Product is the client for interacting with the Product builders.
 	Product *ProductClient
+	// ProductOption is the client for interacting with the ProductOption builders.
+	ProductOption *ProductOptionClient
+	// ProductVariant is the client for interacting with the ProductVariant builders.
+	ProductVariant *ProductVariantClient
+	// ReturnItem is the client for interacting with the ReturnItem builders.
+	ReturnItem *ReturnItemClient
+	// ReturnRequest is the client for interacting with the ReturnRequest builders.
+	ReturnRequest *ReturnRequestClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// Shipment is the client for interacting with the Shipment builders.
+	Shipment *ShipmentClient
+	// SlugRedirect is the client for interacting with the SlugRedirect builders.
+	SlugRedirect *SlugRedirectClient
+	// TrackingEvent is the client for interacting with the TrackingEvent builders.
+	TrackingEvent *TrackingEventClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -163,16 +189,29 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccountToken = NewAccountTokenClient(tx.config)
 	tx.Address = NewAddressClient(tx.config)
 	tx.Avaliation = NewAvaliationClient(tx.config)
 	tx.Cart = NewCartClient(tx.config)
 	tx.CartItem = NewCartItemClient(tx.config)
 	tx.Category = NewCategoryClient(tx.config)
 	tx.Coupon = NewCouponClient(tx.config)
+	tx.LoginAttempt = NewLoginAttemptClient(tx.config)
 	tx.Order = NewOrderClient(tx.config)
 	tx.OrderItem = NewOrderItemClient(tx.config)
+	tx.OrderStatusEvent = NewOrderStatusEventClient(tx.config)
+	tx.Payment = NewPaymentClient(tx.config)
 	tx.Product = NewProductClient(tx.config)
+	tx.ProductOption = NewProductOptionClient(tx.config)
+	tx.ProductVariant = NewProductVariantClient(tx.config)
+	tx.ReturnItem = NewReturnItemClient(tx.config)
+	tx.ReturnRequest = NewReturnRequestClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.Shipment = NewShipmentClient(tx.config)
+	tx.SlugRedirect = NewSlugRedirectClient(tx.config)
+	tx.TrackingEvent = NewTrackingEventClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.WebhookEvent = NewWebhookEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -182,7 +221,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Address.QueryXXX(), the query will be executed
+// applies a query, for example: AccountToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,159 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+)
+
+// WebhookEvent is the model entity for the WebhookEvent schema.
+type WebhookEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// EventID holds the value of the "event_id" field.
+	EventID string `json:"event_id,omitempty"`
+	// EventType holds the value of the "event_type" field.
+	EventType string `json:"event_type,omitempty"`
+	// TransactionID holds the value of the "transaction_id" field.
+	TransactionID string `json:"transaction_id,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload string `json:"payload,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WebhookEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldID, webhookevent.FieldProvider, webhookevent.FieldEventID, webhookevent.FieldEventType, webhookevent.FieldTransactionID, webhookevent.FieldPayload:
+			values[i] = new(sql.NullString)
+		case webhookevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WebhookEvent fields.
+func (we *WebhookEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case webhookevent.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				we.ID = value.String
+			}
+		case webhookevent.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				we.Provider = value.String
+			}
+		case webhookevent.FieldEventID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value.Valid {
+				we.EventID = value.String
+			}
+		case webhookevent.FieldEventType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event_type", values[i])
+			} else if value.Valid {
+				we.EventType = value.String
+			}
+		case webhookevent.FieldTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field transaction_id", values[i])
+			} else if value.Valid {
+				we.TransactionID = value.String
+			}
+		case webhookevent.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				we.Payload = value.String
+			}
+		case webhookevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				we.CreatedAt = value.Time
+			}
+		default:
+			we.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WebhookEvent.
+// This includes values selected through modifiers, order, etc.
+func (we *WebhookEvent) Value(name string) (ent.Value, error) {
+	return we.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this WebhookEvent.
+// Note that you need to call WebhookEvent.Unwrap() before calling this method if this WebhookEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (we *WebhookEvent) Update() *WebhookEventUpdateOne {
+	return NewWebhookEventClient(we.config).UpdateOne(we)
+}
+
+// Unwrap unwraps the WebhookEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (we *WebhookEvent) Unwrap() *WebhookEvent {
+	_tx, ok := we.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WebhookEvent is not a transactional entity")
+	}
+	we.config.driver = _tx.drv
+	return we
+}
+
+// String implements the fmt.Stringer.
+func (we *WebhookEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("WebhookEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", we.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(we.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("event_id=")
+	builder.WriteString(we.EventID)
+	builder.WriteString(", ")
+	builder.WriteString("event_type=")
+	builder.WriteString(we.EventType)
+	builder.WriteString(", ")
+	builder.WriteString("transaction_id=")
+	builder.WriteString(we.TransactionID)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(we.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(we.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WebhookEvents is a parsable slice of WebhookEvent.
+type WebhookEvents []*WebhookEvent
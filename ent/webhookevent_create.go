@@ -0,0 +1,298 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+)
+
+// WebhookEventCreate is the builder for creating a WebhookEvent entity.
+type WebhookEventCreate struct {
+	config
+	mutation *WebhookEventMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (wec *WebhookEventCreate) SetProvider(s string) *WebhookEventCreate {
+	wec.mutation.SetProvider(s)
+	return wec
+}
+
+// SetEventID sets the "event_id" field.
+func (wec *WebhookEventCreate) SetEventID(s string) *WebhookEventCreate {
+	wec.mutation.SetEventID(s)
+	return wec
+}
+
+// SetEventType sets the "event_type" field.
+func (wec *WebhookEventCreate) SetEventType(s string) *WebhookEventCreate {
+	wec.mutation.SetEventType(s)
+	return wec
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (wec *WebhookEventCreate) SetTransactionID(s string) *WebhookEventCreate {
+	wec.mutation.SetTransactionID(s)
+	return wec
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableTransactionID(s *string) *WebhookEventCreate {
+	if s != nil {
+		wec.SetTransactionID(*s)
+	}
+	return wec
+}
+
+// SetPayload sets the "payload" field.
+func (wec *WebhookEventCreate) SetPayload(s string) *WebhookEventCreate {
+	wec.mutation.SetPayload(s)
+	return wec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wec *WebhookEventCreate) SetCreatedAt(t time.Time) *WebhookEventCreate {
+	wec.mutation.SetCreatedAt(t)
+	return wec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wec *WebhookEventCreate) SetNillableCreatedAt(t *time.Time) *WebhookEventCreate {
+	if t != nil {
+		wec.SetCreatedAt(*t)
+	}
+	return wec
+}
+
+// SetID sets the "id" field.
+func (wec *WebhookEventCreate) SetID(s string) *WebhookEventCreate {
+	wec.mutation.SetID(s)
+	return wec
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (wec *WebhookEventCreate) Mutation() *WebhookEventMutation {
+	return wec.mutation
+}
+
+// Save creates the WebhookEvent in the database.
+func (wec *WebhookEventCreate) Save(ctx context.Context) (*WebhookEvent, error) {
+	wec.defaults()
+	return withHooks(ctx, wec.sqlSave, wec.mutation, wec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wec *WebhookEventCreate) SaveX(ctx context.Context) *WebhookEvent {
+	v, err := wec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wec *WebhookEventCreate) Exec(ctx context.Context) error {
+	_, err := wec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wec *WebhookEventCreate) ExecX(ctx context.Context) {
+	if err := wec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wec *WebhookEventCreate) defaults() {
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		v := webhookevent.DefaultCreatedAt()
+		wec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wec *WebhookEventCreate) check() error {
+	if _, ok := wec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "WebhookEvent.provider"`)}
+	}
+	if v, ok := wec.mutation.Provider(); ok {
+		if err := webhookevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "WebhookEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "WebhookEvent.event_id"`)}
+	}
+	if v, ok := wec.mutation.EventID(); ok {
+		if err := webhookevent.EventIDValidator(v); err != nil {
+			return &ValidationError{Name: "event_id", err: fmt.Errorf(`ent: validator failed for field "WebhookEvent.event_id": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.EventType(); !ok {
+		return &ValidationError{Name: "event_type", err: errors.New(`ent: missing required field "WebhookEvent.event_type"`)}
+	}
+	if v, ok := wec.mutation.EventType(); ok {
+		if err := webhookevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "WebhookEvent.event_type": %w`, err)}
+		}
+	}
+	if _, ok := wec.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "WebhookEvent.payload"`)}
+	}
+	if _, ok := wec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (wec *WebhookEventCreate) sqlSave(ctx context.Context) (*WebhookEvent, error) {
+	if err := wec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected WebhookEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	wec.mutation.id = &_node.ID
+	wec.mutation.done = true
+	return _node, nil
+}
+
+func (wec *WebhookEventCreate) createSpec() (*WebhookEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookEvent{config: wec.config}
+		_spec = sqlgraph.NewCreateSpec(webhookevent.Table, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeString))
+	)
+	if id, ok := wec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := wec.mutation.Provider(); ok {
+		_spec.SetField(webhookevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := wec.mutation.EventID(); ok {
+		_spec.SetField(webhookevent.FieldEventID, field.TypeString, value)
+		_node.EventID = value
+	}
+	if value, ok := wec.mutation.EventType(); ok {
+		_spec.SetField(webhookevent.FieldEventType, field.TypeString, value)
+		_node.EventType = value
+	}
+	if value, ok := wec.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+		_node.TransactionID = value
+	}
+	if value, ok := wec.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := wec.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// WebhookEventCreateBulk is the builder for creating many WebhookEvent entities in bulk.
+type WebhookEventCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookEventCreate
+}
+
+// Save creates the WebhookEvent entities in the database.
+func (wecb *WebhookEventCreateBulk) Save(ctx context.Context) ([]*WebhookEvent, error) {
+	if wecb.err != nil {
+		return nil, wecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wecb.builders))
+	nodes := make([]*WebhookEvent, len(wecb.builders))
+	mutators := make([]Mutator, len(wecb.builders))
+	for i := range wecb.builders {
+		func(i int, root context.Context) {
+			builder := wecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wecb *WebhookEventCreateBulk) SaveX(ctx context.Context) []*WebhookEvent {
+	v, err := wecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wecb *WebhookEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := wecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wecb *WebhookEventCreateBulk) ExecX(ctx context.Context) {
+	if err := wecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
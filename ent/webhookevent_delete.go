@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+)
+
+// WebhookEventDelete is the builder for deleting a WebhookEvent entity.
+type WebhookEventDelete struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// Where appends a list predicates to the WebhookEventDelete builder.
+func (wed *WebhookEventDelete) Where(ps ...predicate.WebhookEvent) *WebhookEventDelete {
+	wed.mutation.Where(ps...)
+	return wed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wed *WebhookEventDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wed.sqlExec, wed.mutation, wed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wed *WebhookEventDelete) ExecX(ctx context.Context) int {
+	n, err := wed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wed *WebhookEventDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(webhookevent.Table, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeString))
+	if ps := wed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wed.mutation.done = true
+	return affected, err
+}
+
+// WebhookEventDeleteOne is the builder for deleting a single WebhookEvent entity.
+type WebhookEventDeleteOne struct {
+	wed *WebhookEventDelete
+}
+
+// Where appends a list predicates to the WebhookEventDelete builder.
+func (wedo *WebhookEventDeleteOne) Where(ps ...predicate.WebhookEvent) *WebhookEventDeleteOne {
+	wedo.wed.mutation.Where(ps...)
+	return wedo
+}
+
+// Exec executes the deletion query.
+func (wedo *WebhookEventDeleteOne) Exec(ctx context.Context) error {
+	n, err := wedo.wed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{webhookevent.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wedo *WebhookEventDeleteOne) ExecX(ctx context.Context) {
+	if err := wedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/webhookevent"
+)
+
+// WebhookEventUpdate is the builder for updating WebhookEvent entities.
+type WebhookEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// Where appends a list predicates to the WebhookEventUpdate builder.
+func (weu *WebhookEventUpdate) Where(ps ...predicate.WebhookEvent) *WebhookEventUpdate {
+	weu.mutation.Where(ps...)
+	return weu
+}
+
+// SetEventType sets the "event_type" field.
+func (weu *WebhookEventUpdate) SetEventType(s string) *WebhookEventUpdate {
+	weu.mutation.SetEventType(s)
+	return weu
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableEventType(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetEventType(*s)
+	}
+	return weu
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (weu *WebhookEventUpdate) SetTransactionID(s string) *WebhookEventUpdate {
+	weu.mutation.SetTransactionID(s)
+	return weu
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillableTransactionID(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetTransactionID(*s)
+	}
+	return weu
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (weu *WebhookEventUpdate) ClearTransactionID() *WebhookEventUpdate {
+	weu.mutation.ClearTransactionID()
+	return weu
+}
+
+// SetPayload sets the "payload" field.
+func (weu *WebhookEventUpdate) SetPayload(s string) *WebhookEventUpdate {
+	weu.mutation.SetPayload(s)
+	return weu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (weu *WebhookEventUpdate) SetNillablePayload(s *string) *WebhookEventUpdate {
+	if s != nil {
+		weu.SetPayload(*s)
+	}
+	return weu
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weu *WebhookEventUpdate) Mutation() *WebhookEventMutation {
+	return weu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (weu *WebhookEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, weu.sqlSave, weu.mutation, weu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weu *WebhookEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := weu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (weu *WebhookEventUpdate) Exec(ctx context.Context) error {
+	_, err := weu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weu *WebhookEventUpdate) ExecX(ctx context.Context) {
+	if err := weu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weu *WebhookEventUpdate) check() error {
+	if v, ok := weu.mutation.EventType(); ok {
+		if err := webhookevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "WebhookEvent.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weu *WebhookEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := weu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookevent.Table, webhookevent.Columns, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeString))
+	if ps := weu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weu.mutation.EventType(); ok {
+		_spec.SetField(webhookevent.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := weu.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+	}
+	if weu.mutation.TransactionIDCleared() {
+		_spec.ClearField(webhookevent.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := weu.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, weu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	weu.mutation.done = true
+	return n, nil
+}
+
+// WebhookEventUpdateOne is the builder for updating a single WebhookEvent entity.
+type WebhookEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WebhookEventMutation
+}
+
+// SetEventType sets the "event_type" field.
+func (weuo *WebhookEventUpdateOne) SetEventType(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetEventType(s)
+	return weuo
+}
+
+// SetNillableEventType sets the "event_type" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableEventType(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetEventType(*s)
+	}
+	return weuo
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (weuo *WebhookEventUpdateOne) SetTransactionID(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetTransactionID(s)
+	return weuo
+}
+
+// SetNillableTransactionID sets the "transaction_id" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillableTransactionID(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetTransactionID(*s)
+	}
+	return weuo
+}
+
+// ClearTransactionID clears the value of the "transaction_id" field.
+func (weuo *WebhookEventUpdateOne) ClearTransactionID() *WebhookEventUpdateOne {
+	weuo.mutation.ClearTransactionID()
+	return weuo
+}
+
+// SetPayload sets the "payload" field.
+func (weuo *WebhookEventUpdateOne) SetPayload(s string) *WebhookEventUpdateOne {
+	weuo.mutation.SetPayload(s)
+	return weuo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (weuo *WebhookEventUpdateOne) SetNillablePayload(s *string) *WebhookEventUpdateOne {
+	if s != nil {
+		weuo.SetPayload(*s)
+	}
+	return weuo
+}
+
+// Mutation returns the WebhookEventMutation object of the builder.
+func (weuo *WebhookEventUpdateOne) Mutation() *WebhookEventMutation {
+	return weuo.mutation
+}
+
+// Where appends a list predicates to the WebhookEventUpdate builder.
+func (weuo *WebhookEventUpdateOne) Where(ps ...predicate.WebhookEvent) *WebhookEventUpdateOne {
+	weuo.mutation.Where(ps...)
+	return weuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (weuo *WebhookEventUpdateOne) Select(field string, fields ...string) *WebhookEventUpdateOne {
+	weuo.fields = append([]string{field}, fields...)
+	return weuo
+}
+
+// Save executes the query and returns the updated WebhookEvent entity.
+func (weuo *WebhookEventUpdateOne) Save(ctx context.Context) (*WebhookEvent, error) {
+	return withHooks(ctx, weuo.sqlSave, weuo.mutation, weuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) SaveX(ctx context.Context) *WebhookEvent {
+	node, err := weuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (weuo *WebhookEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := weuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (weuo *WebhookEventUpdateOne) ExecX(ctx context.Context) {
+	if err := weuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (weuo *WebhookEventUpdateOne) check() error {
+	if v, ok := weuo.mutation.EventType(); ok {
+		if err := webhookevent.EventTypeValidator(v); err != nil {
+			return &ValidationError{Name: "event_type", err: fmt.Errorf(`ent: validator failed for field "WebhookEvent.event_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (weuo *WebhookEventUpdateOne) sqlSave(ctx context.Context) (_node *WebhookEvent, err error) {
+	if err := weuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(webhookevent.Table, webhookevent.Columns, sqlgraph.NewFieldSpec(webhookevent.FieldID, field.TypeString))
+	id, ok := weuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WebhookEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := weuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, webhookevent.FieldID)
+		for _, f := range fields {
+			if !webhookevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != webhookevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := weuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := weuo.mutation.EventType(); ok {
+		_spec.SetField(webhookevent.FieldEventType, field.TypeString, value)
+	}
+	if value, ok := weuo.mutation.TransactionID(); ok {
+		_spec.SetField(webhookevent.FieldTransactionID, field.TypeString, value)
+	}
+	if weuo.mutation.TransactionIDCleared() {
+		_spec.ClearField(webhookevent.FieldTransactionID, field.TypeString)
+	}
+	if value, ok := weuo.mutation.Payload(); ok {
+		_spec.SetField(webhookevent.FieldPayload, field.TypeString, value)
+	}
+	_node = &WebhookEvent{config: weuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, weuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{webhookevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	weuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,90 @@
+package inventory
+
+import (
+	"context"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/ent/productvariant"
+)
+
+// SyncProduct recalcula o estoque de um produto com variantes como a soma dos estoques
+// das variantes. Produtos sem variantes mantêm o próprio estoque.
+func SyncProduct(ctx context.Context, client *ent.Client, productId string) error {
+	variants, err := client.ProductVariant.
+		Query().
+		Where(productvariant.ProductID(productId)).
+		All(ctx)
+
+	if err != nil || len(variants) == 0 {
+		return err
+	}
+
+	total := 0
+	for _, variant := range variants {
+		total += variant.Stock
+	}
+
+	return client.Product.
+		UpdateOneID(productId).
+		SetStock(total).
+		Exec(ctx)
+}
+
+// Reserve baixa o estoque da variante (se houver) e do produto, somente se houver
+// quantidade suficiente. Retorna false quando falta estoque; nesse caso a baixa da
+// variante pode já ter sido feita, então deve rodar dentro de uma transação.
+func Reserve(ctx context.Context, client *ent.Client, productId, variantId string, quantity int) (bool, error) {
+	if variantId != "" {
+		affected, err := client.ProductVariant.
+			Update().
+			Where(
+				productvariant.ID(variantId),
+				productvariant.StockGTE(quantity),
+			).
+			AddStock(-quantity).
+			Save(ctx)
+
+		if err != nil || affected == 0 {
+			return false, err
+		}
+	}
+
+	affected, err := client.Product.
+		Update().
+		Where(
+			product.ID(productId),
+			product.StockGTE(quantity),
+		).
+		AddStock(-quantity).
+		Save(ctx)
+
+	return affected > 0, err
+}
+
+// Restore devolve ao estoque a quantidade da variante (se houver) e do produto.
+// Produtos e variantes removidos do catálogo não têm estoque a restaurar.
+func Restore(ctx context.Context, client *ent.Client, productId, variantId string, quantity int) error {
+	if productId == "" {
+		return nil
+	}
+
+	if variantId != "" {
+		affected, err := client.ProductVariant.
+			Update().
+			Where(productvariant.ID(variantId)).
+			AddStock(quantity).
+			Save(ctx)
+
+		if err != nil || affected == 0 {
+			return err
+		}
+	}
+
+	_, err := client.Product.
+		Update().
+		Where(product.ID(productId)).
+		AddStock(quantity).
+		Save(ctx)
+
+	return err
+}
@@ -0,0 +1,213 @@
+package inventory
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/enttest"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// Helper que abre um banco SQLite em memória com o esquema do ent
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, "sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// Helper que cria um produto com uma variante por estoque informado e sincroniza o
+// estoque do produto
+func createProduct(t *testing.T, client *ent.Client, id string, variantStocks ...int) (*ent.Product, []*ent.ProductVariant) {
+	t.Helper()
+	ctx := context.Background()
+
+	prod := client.Product.
+		Create().
+		SetID(id).
+		SetName("Camiseta " + id).
+		SetSlug("camiseta-" + id).
+		SetDescription("Camiseta de algodão").
+		SetPrice(4990).
+		SetSku("CAM-" + id).
+		SetStock(2).
+		SaveX(ctx)
+
+	var variants []*ent.ProductVariant
+	for i, stock := range variantStocks {
+		variants = append(variants, client.ProductVariant.
+			Create().
+			SetID(fmt.Sprintf("%s-v%d", id, i+1)).
+			SetProductID(id).
+			SetSku(fmt.Sprintf("CAM-%s-%d", id, i+1)).
+			SetCombination(fmt.Sprintf("tamanho=%d", i+1)).
+			SetStock(stock).
+			SaveX(ctx))
+	}
+
+	if err := SyncProduct(ctx, client, id); err != nil {
+		t.Fatal(err)
+	}
+	return prod, variants
+}
+
+// Helper que confere o estoque do produto e de cada variante, e que o do produto é a
+// soma dos das variantes
+func assertStock(t *testing.T, client *ent.Client, productId string, want int, wantVariants ...int) {
+	t.Helper()
+	ctx := context.Background()
+
+	prod := client.Product.GetX(ctx, productId)
+	if prod.Stock != want {
+		t.Errorf("product stock = %d, want %d", prod.Stock, want)
+	}
+
+	sum := 0
+	for i, wantStock := range wantVariants {
+		variant := client.ProductVariant.GetX(ctx, fmt.Sprintf("%s-v%d", productId, i+1))
+		if variant.Stock != wantStock {
+			t.Errorf("variant %s stock = %d, want %d", variant.ID, variant.Stock, wantStock)
+		}
+		sum += variant.Stock
+	}
+	if len(wantVariants) > 0 && prod.Stock != sum {
+		t.Errorf("product stock = %d, variants sum = %d", prod.Stock, sum)
+	}
+}
+
+func TestSyncProduct(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+
+	_, variants := createProduct(t, client, "p1", 5, 3, 0)
+	assertStock(t, client, "p1", 8, 5, 3, 0)
+
+	client.ProductVariant.UpdateOne(variants[2]).SetStock(4).ExecX(ctx)
+	if err := SyncProduct(ctx, client, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	assertStock(t, client, "p1", 12, 5, 3, 4)
+
+	// Sem variantes o produto mantém o próprio estoque
+	createProduct(t, client, "p2")
+	assertStock(t, client, "p2", 2)
+}
+
+func TestReserveVariant(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createProduct(t, client, "p1", 5, 3)
+
+	tests := []struct {
+		variant  string
+		quantity int
+		want     bool
+		stock    int
+		variants []int
+	}{
+		{"p1-v1", 2, true, 6, []int{3, 3}},
+		{"p1-v2", 4, false, 6, []int{3, 3}},
+		{"p1-v2", 3, true, 3, []int{3, 0}},
+		{"p1-v2", 1, false, 3, []int{3, 0}},
+		{"p1-v1", 3, true, 0, []int{0, 0}},
+		{"inexistente", 1, false, 0, []int{0, 0}},
+	}
+
+	for _, tt := range tests {
+		got, err := Reserve(ctx, client, "p1", tt.variant, tt.quantity)
+		if err != nil || got != tt.want {
+			t.Errorf("Reserve(%s, %d) = %v, %v; want %v", tt.variant, tt.quantity, got, err, tt.want)
+		}
+		assertStock(t, client, "p1", tt.stock, tt.variants...)
+	}
+}
+
+func TestReserveWithoutVariants(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createProduct(t, client, "p1")
+
+	if ok, err := Reserve(ctx, client, "p1", "", 3); err != nil || ok {
+		t.Errorf("Reserve beyond stock = %v, %v", ok, err)
+	}
+	if ok, err := Reserve(ctx, client, "p1", "", 2); err != nil || !ok {
+		t.Errorf("Reserve = %v, %v", ok, err)
+	}
+	assertStock(t, client, "p1", 0)
+}
+
+func TestReserveRollsBackVariantInTx(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createProduct(t, client, "p1", 5)
+
+	// Produto com menos estoque que a variante: a baixa da variante acontece antes de
+	// a do produto falhar, e só o rollback a desfaz
+	client.Product.UpdateOneID("p1").SetStock(1).ExecX(ctx)
+
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := Reserve(ctx, tx.Client(), "p1", "p1-v1", 2)
+	if err != nil || ok {
+		t.Fatalf("Reserve = %v, %v; want false", ok, err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatal(err)
+	}
+
+	if stock := client.ProductVariant.GetX(ctx, "p1-v1").Stock; stock != 5 {
+		t.Errorf("variant stock after rollback = %d, want 5", stock)
+	}
+}
+
+func TestRestore(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	_, variants := createProduct(t, client, "p1", 5, 3)
+
+	for _, reserve := range []struct {
+		variant  string
+		quantity int
+	}{{"p1-v1", 4}, {"p1-v2", 1}} {
+		if ok, err := Reserve(ctx, client, "p1", reserve.variant, reserve.quantity); err != nil || !ok {
+			t.Fatalf("Reserve = %v, %v", ok, err)
+		}
+	}
+	assertStock(t, client, "p1", 3, 1, 2)
+
+	if err := Restore(ctx, client, "p1", "p1-v1", 4); err != nil {
+		t.Fatal(err)
+	}
+	assertStock(t, client, "p1", 7, 5, 2)
+
+	// Variante removida do catálogo: o produto já foi sincronizado sem ela, então nada
+	// volta ao estoque
+	client.ProductVariant.DeleteOne(variants[1]).ExecX(ctx)
+	if err := SyncProduct(ctx, client, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := Restore(ctx, client, "p1", "p1-v2", 1); err != nil {
+		t.Fatal(err)
+	}
+	assertStock(t, client, "p1", 5, 5)
+
+	// Item sem produto (produto removido)
+	if err := Restore(ctx, client, "", "", 1); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRestoreWithoutVariants(t *testing.T) {
+	client := newTestClient(t)
+	ctx := context.Background()
+	createProduct(t, client, "p1")
+
+	if err := Restore(ctx, client, "p1", "", 3); err != nil {
+		t.Fatal(err)
+	}
+	assertStock(t, client, "p1", 5)
+}
@@ -0,0 +1,290 @@
+package loginguard
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Policy define os limites de tentativas de login
+type Policy struct {
+	// Falhas toleradas antes de começar a exigir espera entre tentativas. O limite
+	// por IP é maior porque vários usuários podem compartilhar o mesmo endereço.
+	FreeAttempts   int
+	FreeIPAttempts int
+	// Espera após a primeira falha além das toleradas; dobra a cada nova falha
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Falhas seguidas que bloqueiam a conta e por quanto tempo
+	MaxAccountFailures int
+	LockoutDuration    time.Duration
+	// Falhas a partir de um mesmo IP, somando todas as contas, que bloqueiam o IP
+	MaxIPFailures int
+	// Tempo sem falhas após o qual os contadores recomeçam do zero
+	ResetAfter time.Duration
+}
+
+// DefaultPolicy retorna uma política padrão
+func DefaultPolicy() Policy {
+	return Policy{
+		FreeAttempts:       3,
+		FreeIPAttempts:     20,
+		BaseDelay:          time.Second,
+		MaxDelay:           5 * time.Minute,
+		MaxAccountFailures: 10,
+		LockoutDuration:    30 * time.Minute,
+		MaxIPFailures:      100,
+		ResetAfter:         24 * time.Hour,
+	}
+}
+
+// Decision informa se uma tentativa de login pode ser feita agora
+type Decision struct {
+	Allowed    bool
+	Locked     bool
+	RetryAfter time.Duration
+}
+
+// add recusa a tentativa se a espera de uma das chaves for maior que zero
+func (d *Decision) add(wait time.Duration, locked bool) {
+	if wait <= 0 {
+		return
+	}
+	d.Allowed = false
+	d.Locked = d.Locked || locked
+	if wait > d.RetryAfter {
+		d.RetryAfter = wait
+	}
+}
+
+// Guard aplica a política de tentativas por conta e por IP
+type Guard struct {
+	store  Store
+	policy Policy
+	now    func() time.Time
+}
+
+// New cria um guard com o store e a política informados
+func New(store Store, policy Policy) *Guard {
+	return &Guard{store: store, policy: policy, now: time.Now}
+}
+
+// Check verifica se a conta e o IP podem tentar um login agora, sem reservar a tentativa
+func (g *Guard) Check(ctx context.Context, email, ip string) (Decision, error) {
+	now := g.now()
+	decision := Decision{Allowed: true}
+
+	for _, key := range g.keys(email, ip) {
+		record, err := g.store.Get(ctx, key)
+		if err != nil {
+			return Decision{}, err
+		}
+
+		wait, locked := g.wait(key, g.current(record, now), now)
+		decision.add(wait, locked)
+	}
+	return decision, nil
+}
+
+// Reserve verifica se a conta e o IP podem tentar um login agora e, se puderem,
+// reserva a tentativa na mesma operação do store. Até ser concluída com Fail,
+// Succeed ou Release, a tentativa conta como uma falha, para que requisições
+// simultâneas não passem todas pela verificação antes da primeira falha ser registrada.
+func (g *Guard) Reserve(ctx context.Context, email, ip string) (Decision, error) {
+	now := g.now()
+	decision := Decision{Allowed: true}
+	reserved := make([]string, 0, 2)
+
+	for _, key := range g.keys(email, ip) {
+		var wait time.Duration
+		var locked bool
+
+		_, err := g.store.Update(ctx, key, func(r Record) Record {
+			r = g.current(r, now)
+			wait, locked = g.wait(key, r, now)
+			if wait > 0 {
+				return r
+			}
+			r.Pending++
+			r.LastAttempt = now
+			return r
+		})
+		if err != nil {
+			g.release(ctx, reserved)
+			return Decision{}, err
+		}
+
+		if wait > 0 {
+			decision.add(wait, locked)
+			continue
+		}
+		reserved = append(reserved, key)
+	}
+
+	// Uma tentativa recusada não fica reservada em nenhuma das chaves
+	if !decision.Allowed {
+		if err := g.release(ctx, reserved); err != nil {
+			return Decision{}, err
+		}
+	}
+	return decision, nil
+}
+
+// Fail registra uma tentativa malsucedida, consumindo a reserva feita por Reserve,
+// e retorna se a conta ficou bloqueada
+func (g *Guard) Fail(ctx context.Context, email, ip string) (bool, error) {
+	now := g.now()
+	locked := false
+
+	for _, key := range g.keys(email, ip) {
+		_, limit := g.limits(key)
+
+		record, err := g.store.Update(ctx, key, func(r Record) Record {
+			// Contadores antigos recomeçam do zero
+			r = g.current(r, now)
+			r.Failures++
+			r.LastFailure = now
+			if r.Pending > 0 {
+				r.Pending--
+			}
+			if limit > 0 && r.Failures >= limit {
+				r.LockedUntil = now.Add(g.policy.LockoutDuration)
+			}
+			return r
+		})
+		if err != nil {
+			return false, err
+		}
+		if key == accountKey(email) && record.LockedUntil.After(now) {
+			locked = true
+		}
+	}
+	return locked, nil
+}
+
+// Succeed zera os contadores da conta após um login válido e libera a reserva do IP.
+// O contador de falhas do IP é mantido para que um atacante não o zere entrando na
+// própria conta.
+func (g *Guard) Succeed(ctx context.Context, email, ip string) error {
+	if err := g.store.Reset(ctx, accountKey(email)); err != nil {
+		return err
+	}
+	if ip == "" {
+		return nil
+	}
+	return g.release(ctx, []string{ipPrefix + ip})
+}
+
+// Release libera a tentativa reservada sem contá-la como falha, para requisições
+// que terminam antes de verificar as credenciais
+func (g *Guard) Release(ctx context.Context, email, ip string) error {
+	return g.release(ctx, g.keys(email, ip))
+}
+
+// Unlock remove o bloqueio e as falhas registradas de uma conta
+func (g *Guard) Unlock(ctx context.Context, email string) error {
+	return g.store.Reset(ctx, accountKey(email))
+}
+
+// wait calcula quanto tempo falta para a próxima tentativa permitida
+func (g *Guard) wait(key string, record Record, now time.Time) (time.Duration, bool) {
+	if record.LockedUntil.After(now) {
+		return record.LockedUntil.Sub(now), true
+	}
+
+	// Tentativas reservadas contam como falhas até terminarem
+	free, _ := g.limits(key)
+	extra := record.Failures + record.Pending - free
+	if extra <= 0 {
+		return 0, false
+	}
+
+	delay := g.policy.BaseDelay
+	for i := 1; i < extra && delay < g.policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > g.policy.MaxDelay {
+		delay = g.policy.MaxDelay
+	}
+
+	last := record.LastFailure
+	if record.Pending > 0 && record.LastAttempt.After(last) {
+		last = record.LastAttempt
+	}
+	if next := last.Add(delay); next.After(now) {
+		return next.Sub(now), false
+	}
+	return 0, false
+}
+
+// limits retorna as falhas toleradas e o limite de bloqueio do tipo de chave
+func (g *Guard) limits(key string) (int, int) {
+	if strings.HasPrefix(key, ipPrefix) {
+		return g.policy.FreeIPAttempts, g.policy.MaxIPFailures
+	}
+	return g.policy.FreeAttempts, g.policy.MaxAccountFailures
+}
+
+// expired indica se o registro é antigo o bastante para ser desconsiderado. Um
+// bloqueio que já terminou também zera os contadores, para que a próxima falha não
+// bloqueie a chave de novo imediatamente.
+func (g *Guard) expired(record Record, now time.Time) bool {
+	if record.LockedUntil.After(now) {
+		return false
+	}
+	if !record.LockedUntil.IsZero() {
+		return true
+	}
+	return g.policy.ResetAfter > 0 && now.Sub(record.LastFailure) > g.policy.ResetAfter
+}
+
+// current descarta as falhas expiradas e as reservas abandonadas do registro
+func (g *Guard) current(record Record, now time.Time) Record {
+	if g.expired(record, now) {
+		record = Record{Pending: record.Pending, LastAttempt: record.LastAttempt}
+	}
+	if record.Pending > 0 && now.Sub(record.LastAttempt) > reservationTTL {
+		record.Pending = 0
+	}
+	return record
+}
+
+// release desfaz uma reserva em cada uma das chaves
+func (g *Guard) release(ctx context.Context, keys []string) error {
+	for _, key := range keys {
+		_, err := g.store.Update(ctx, key, func(r Record) Record {
+			if r.Pending > 0 {
+				r.Pending--
+			}
+			return r
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Prefixos das chaves no store
+const (
+	accountPrefix = "account:"
+	ipPrefix      = "ip:"
+)
+
+// Tempo após o qual uma reserva não concluída (por exemplo, de uma requisição que
+// caiu no meio do login) deixa de contar
+const reservationTTL = time.Minute
+
+// keys retorna as chaves de conta e de IP de uma tentativa
+func (g *Guard) keys(email, ip string) []string {
+	keys := []string{accountKey(email)}
+	if ip != "" {
+		keys = append(keys, ipPrefix+ip)
+	}
+	return keys
+}
+
+// accountKey retorna a chave de uma conta no store
+func accountKey(email string) string {
+	return accountPrefix + strings.ToLower(strings.TrimSpace(email))
+}
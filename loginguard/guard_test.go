@@ -0,0 +1,274 @@
+package loginguard
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// testPolicy usa valores pequenos para deixar as contas dos testes legíveis
+func testPolicy() Policy {
+	return Policy{
+		FreeAttempts:       3,
+		FreeIPAttempts:     5,
+		BaseDelay:          time.Second,
+		MaxDelay:           8 * time.Second,
+		MaxAccountFailures: 6,
+		LockoutDuration:    30 * time.Minute,
+		MaxIPFailures:      10,
+		ResetAfter:         24 * time.Hour,
+	}
+}
+
+// newTestGuard cria um guard com store em memória e relógio controlado pelo teste
+func newTestGuard(policy Policy) (*Guard, *time.Time) {
+	clock := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	g := New(NewMemoryStore(), policy)
+	g.now = func() time.Time { return clock }
+	return g, &clock
+}
+
+func mustFail(t *testing.T, g *Guard, email, ip string) bool {
+	t.Helper()
+	locked, err := g.Fail(context.Background(), email, ip)
+	if err != nil {
+		t.Fatalf("Fail: %v", err)
+	}
+	return locked
+}
+
+func mustCheck(t *testing.T, g *Guard, email, ip string) Decision {
+	t.Helper()
+	decision, err := g.Check(context.Background(), email, ip)
+	if err != nil {
+		t.Fatalf("Check: %v", err)
+	}
+	return decision
+}
+
+func TestBackoffGrowsAndIsCapped(t *testing.T) {
+	g, _ := newTestGuard(testPolicy())
+
+	// As falhas toleradas não exigem espera; depois a espera dobra até o máximo
+	want := []time.Duration{0, 0, 0, time.Second, 2 * time.Second}
+	for i, wait := range want {
+		if mustFail(t, g, "user@example.com", "") {
+			t.Fatalf("failure %d locked the account", i+1)
+		}
+		decision := mustCheck(t, g, "user@example.com", "")
+		if decision.RetryAfter != wait || decision.Allowed != (wait == 0) || decision.Locked {
+			t.Errorf("after %d failures: got %+v, want RetryAfter %v", i+1, decision, wait)
+		}
+	}
+
+	policy := testPolicy()
+	policy.MaxAccountFailures = 0
+	g, _ = newTestGuard(policy)
+	for i := 0; i < 10; i++ {
+		mustFail(t, g, "user@example.com", "")
+	}
+	if decision := mustCheck(t, g, "user@example.com", ""); decision.RetryAfter != policy.MaxDelay {
+		t.Errorf("RetryAfter = %v, want MaxDelay %v", decision.RetryAfter, policy.MaxDelay)
+	}
+}
+
+func TestBackoffElapses(t *testing.T) {
+	g, clock := newTestGuard(testPolicy())
+	for i := 0; i < 5; i++ {
+		mustFail(t, g, "user@example.com", "")
+	}
+
+	*clock = clock.Add(time.Second)
+	if decision := mustCheck(t, g, "user@example.com", ""); decision.RetryAfter != time.Second {
+		t.Errorf("RetryAfter = %v, want 1s", decision.RetryAfter)
+	}
+
+	*clock = clock.Add(time.Second)
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("expected attempt to be allowed once the delay elapsed, got %+v", decision)
+	}
+}
+
+func TestLockoutAndUnlockAfterExpiry(t *testing.T) {
+	policy := testPolicy()
+	g, clock := newTestGuard(policy)
+
+	for i := 1; i < policy.MaxAccountFailures; i++ {
+		if mustFail(t, g, "user@example.com", "") {
+			t.Fatalf("failure %d locked the account", i)
+		}
+	}
+	if !mustFail(t, g, "user@example.com", "") {
+		t.Fatal("expected the account to be locked")
+	}
+
+	decision := mustCheck(t, g, "user@example.com", "")
+	if decision.Allowed || !decision.Locked || decision.RetryAfter != policy.LockoutDuration {
+		t.Fatalf("got %+v, want locked for %v", decision, policy.LockoutDuration)
+	}
+
+	// Após o bloqueio, a conta volta a ser liberada e o contador recomeça do zero
+	*clock = clock.Add(policy.LockoutDuration)
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed || decision.Locked {
+		t.Fatalf("expected the account to be unlocked, got %+v", decision)
+	}
+	if mustFail(t, g, "user@example.com", "") {
+		t.Fatal("one failure after the lockout expired locked the account again")
+	}
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("expected a single failure to be tolerated, got %+v", decision)
+	}
+}
+
+func TestSucceedResetsAccountButNotIP(t *testing.T) {
+	policy := testPolicy()
+	g, _ := newTestGuard(policy)
+
+	for i := 0; i < policy.FreeIPAttempts+1; i++ {
+		mustFail(t, g, "user@example.com", "10.0.0.1")
+	}
+	if err := g.Succeed(context.Background(), "USER@example.com ", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("account still throttled after success: %+v", decision)
+	}
+	if decision := mustCheck(t, g, "other@example.com", "10.0.0.1"); decision.Allowed {
+		t.Errorf("IP counter was reset by a successful login: %+v", decision)
+	}
+}
+
+func TestIPLockoutAcrossAccounts(t *testing.T) {
+	policy := testPolicy()
+	g, _ := newTestGuard(policy)
+
+	for i := 0; i < policy.MaxIPFailures; i++ {
+		mustFail(t, g, "user"+string(rune('a'+i))+"@example.com", "10.0.0.1")
+	}
+
+	decision := mustCheck(t, g, "new@example.com", "10.0.0.1")
+	if decision.Allowed || !decision.Locked {
+		t.Errorf("expected the IP to be locked, got %+v", decision)
+	}
+	if decision := mustCheck(t, g, "new@example.com", "10.0.0.2"); !decision.Allowed {
+		t.Errorf("other IPs should not be affected, got %+v", decision)
+	}
+}
+
+func TestCountersResetAfterInactivity(t *testing.T) {
+	policy := testPolicy()
+	g, clock := newTestGuard(policy)
+
+	for i := 0; i < policy.MaxAccountFailures-1; i++ {
+		mustFail(t, g, "user@example.com", "")
+	}
+
+	*clock = clock.Add(policy.ResetAfter + time.Second)
+	if mustFail(t, g, "user@example.com", "") {
+		t.Error("old failures should not count towards the lockout")
+	}
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("expected attempt to be allowed, got %+v", decision)
+	}
+}
+
+func TestUnlock(t *testing.T) {
+	policy := testPolicy()
+	g, _ := newTestGuard(policy)
+
+	for i := 0; i < policy.MaxAccountFailures; i++ {
+		mustFail(t, g, "user@example.com", "")
+	}
+	if err := g.Unlock(context.Background(), "user@example.com"); err != nil {
+		t.Fatal(err)
+	}
+	if decision := mustCheck(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("expected the account to be unlocked, got %+v", decision)
+	}
+}
+
+func mustReserve(t *testing.T, g *Guard, email, ip string) Decision {
+	t.Helper()
+	decision, err := g.Reserve(context.Background(), email, ip)
+	if err != nil {
+		t.Fatalf("Reserve: %v", err)
+	}
+	return decision
+}
+
+func TestReserveCountsConcurrentAttempts(t *testing.T) {
+	policy := testPolicy()
+	g, _ := newTestGuard(policy)
+
+	// Tentativas simultâneas que ainda não terminaram contam como falhas, então só
+	// as toleradas passam antes que qualquer uma delas seja registrada
+	allowed := 0
+	for i := 0; i < 10; i++ {
+		if mustReserve(t, g, "user@example.com", "10.0.0.1").Allowed {
+			allowed++
+		}
+	}
+	if allowed != policy.FreeAttempts+1 {
+		t.Errorf("allowed %d concurrent attempts, want %d", allowed, policy.FreeAttempts+1)
+	}
+
+	// As recusadas não ficam reservadas no IP
+	record, _ := g.store.Get(context.Background(), ipPrefix+"10.0.0.1")
+	if record.Pending != allowed {
+		t.Errorf("IP pending = %d, want %d", record.Pending, allowed)
+	}
+}
+
+func TestFailConsumesReservation(t *testing.T) {
+	g, _ := newTestGuard(testPolicy())
+
+	for i := 0; i < 3; i++ {
+		if !mustReserve(t, g, "user@example.com", "10.0.0.1").Allowed {
+			t.Fatalf("attempt %d was not allowed", i+1)
+		}
+		mustFail(t, g, "user@example.com", "10.0.0.1")
+	}
+
+	record, _ := g.store.Get(context.Background(), accountKey("user@example.com"))
+	if record.Failures != 3 || record.Pending != 0 {
+		t.Errorf("record = %+v, want 3 failures and no pending attempts", record)
+	}
+}
+
+func TestSucceedAndReleaseFreeReservations(t *testing.T) {
+	g, _ := newTestGuard(testPolicy())
+	ctx := context.Background()
+
+	mustReserve(t, g, "user@example.com", "10.0.0.1")
+	if err := g.Succeed(ctx, "user@example.com", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+	mustReserve(t, g, "other@example.com", "10.0.0.1")
+	if err := g.Release(ctx, "other@example.com", "10.0.0.1"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{accountKey("user@example.com"), accountKey("other@example.com"), ipPrefix + "10.0.0.1"} {
+		if record, _ := g.store.Get(ctx, key); record.Pending != 0 || record.Failures != 0 {
+			t.Errorf("%s = %+v, want no pending attempts or failures", key, record)
+		}
+	}
+}
+
+func TestAbandonedReservationsExpire(t *testing.T) {
+	policy := testPolicy()
+	g, clock := newTestGuard(policy)
+
+	for i := 0; i < policy.FreeAttempts+1; i++ {
+		mustReserve(t, g, "user@example.com", "")
+	}
+	if decision := mustReserve(t, g, "user@example.com", ""); decision.Allowed {
+		t.Fatalf("expected pending attempts to throttle the account, got %+v", decision)
+	}
+
+	*clock = clock.Add(reservationTTL + time.Second)
+	if decision := mustReserve(t, g, "user@example.com", ""); !decision.Allowed {
+		t.Errorf("expected abandoned reservations to stop counting, got %+v", decision)
+	}
+}
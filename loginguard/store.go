@@ -0,0 +1,79 @@
+package loginguard
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Record guarda as falhas recentes de uma chave (conta ou IP) e as tentativas
+// reservadas que ainda não terminaram
+type Record struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+	Pending     int
+	LastAttempt time.Time
+}
+
+// Store persiste os contadores de falhas. Implementações precisam ser seguras
+// para uso concorrente.
+type Store interface {
+	Get(ctx context.Context, key string) (Record, error)
+	// Update aplica fn ao registro da chave e grava o resultado numa única operação
+	// atômica, para que verificações e contagens simultâneas não se percam
+	Update(ctx context.Context, key string, fn func(Record) Record) (Record, error)
+	Reset(ctx context.Context, key string) error
+}
+
+// MemoryStore mantém os contadores em memória. Serve para uma única instância
+// da API e para testes; os contadores são perdidos ao reiniciar.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// NewMemoryStore cria um store em memória vazio
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]Record)}
+}
+
+// Get retorna o registro da chave (zerado se não existir)
+func (s *MemoryStore) Get(ctx context.Context, key string) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.records[key], nil
+}
+
+// Update altera o registro da chave com o store bloqueado
+func (s *MemoryStore) Update(ctx context.Context, key string, fn func(Record) Record) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record := fn(s.records[key])
+	s.records[key] = record
+	return record, nil
+}
+
+// Reset remove os contadores da chave
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, key)
+	return nil
+}
+
+// Prune remove registros sem falhas desde o instante informado
+func (s *MemoryStore) Prune(before time.Time) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	removed := 0
+	for key, record := range s.records {
+		if record.LastFailure.Before(before) && record.LockedUntil.Before(before) && record.LastAttempt.Before(before) {
+			delete(s.records, key)
+			removed++
+		}
+	}
+	return removed
+}
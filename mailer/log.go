@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// LogMailer não envia emails: grava as mensagens em um arquivo ou no log da
+// aplicação. Indicado para desenvolvimento local.
+type LogMailer struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewLogMailer cria um mailer que grava as mensagens no arquivo informado ou,
+// se o caminho estiver vazio, no log da aplicação
+func NewLogMailer(path string) *LogMailer {
+	return &LogMailer{path: path}
+}
+
+// Send registra a mensagem
+func (m *LogMailer) Send(ctx context.Context, msg Message) error {
+	if err := msg.validate(); err != nil {
+		return err
+	}
+
+	entry := fmt.Sprintf("Date: %s\nTo: %s\nSubject: %s\n\n%s\n", time.Now().Format(time.RFC3339), msg.To, msg.Subject, msg.Body)
+
+	if m.path == "" {
+		log.Printf("Email (não enviado)\n%s", entry)
+		return nil
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s\n----\n", entry)
+	return err
+}
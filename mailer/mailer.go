@@ -0,0 +1,34 @@
+package mailer
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrInvalidMessage é retornado quando a mensagem não tem destinatário ou assunto
+var ErrInvalidMessage = errors.New("mensagem de email inválida")
+
+// Message representa um email em texto simples
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer define um serviço capaz de enviar emails transacionais
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// validate verifica os campos obrigatórios da mensagem
+func (m Message) validate() error {
+	if strings.TrimSpace(m.To) == "" || strings.TrimSpace(m.Subject) == "" {
+		return ErrInvalidMessage
+	}
+	// Quebras de linha no destinatário ou no assunto permitiriam injetar cabeçalhos
+	if strings.ContainsAny(m.To, "\r\n") || strings.ContainsAny(m.Subject, "\r\n") {
+		return ErrInvalidMessage
+	}
+	return nil
+}
@@ -4,9 +4,20 @@ import (
 	"context"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+	"github.com/vtrod/veecomm-api/controllers"
 	"github.com/vtrod/veecomm-api/database"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/user"
+	"github.com/vtrod/veecomm-api/loginguard"
+	"github.com/vtrod/veecomm-api/mailer"
 	"github.com/vtrod/veecomm-api/middleware"
+	"github.com/vtrod/veecomm-api/payments"
 	"github.com/vtrod/veecomm-api/routes"
+	"github.com/vtrod/veecomm-api/search"
+	"github.com/vtrod/veecomm-api/shipping"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/gofiber/fiber/v3/middleware/cors"
@@ -34,10 +45,28 @@ func main() {
 	}
 
 	// Inicializar aplicação Fiber
-	app := fiber.New(fiber.Config{
+	appConfig := fiber.Config{
 		AppName:      "VeeComm API",
 		ErrorHandler: customErrorHandler,
-	})
+	}
+
+	// Atrás de um proxy reverso, o IP do cliente (usado nos limites de login) vem do
+	// cabeçalho do proxy, mas só quando a requisição chega de um proxy confiável
+	if value := os.Getenv("TRUSTED_PROXIES"); value != "" {
+		proxies := make([]string, 0)
+		for _, proxy := range strings.Split(value, ",") {
+			if proxy = strings.TrimSpace(proxy); proxy != "" {
+				proxies = append(proxies, proxy)
+			}
+		}
+		appConfig.TrustProxy = true
+		appConfig.TrustProxyConfig = fiber.TrustProxyConfig{Proxies: proxies}
+		appConfig.ProxyHeader = getEnv("PROXY_HEADER", "X-Real-IP")
+		appConfig.EnableIPValidation = true
+		log.Printf("IP do cliente lido de %s quando a requisição vem de %s", appConfig.ProxyHeader, strings.Join(proxies, ", "))
+	}
+
+	app := fiber.New(appConfig)
 
 	// Configurar middlewares globais
 	app.Use(recover.New())
@@ -45,19 +74,276 @@ func main() {
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     os.Getenv("CORS_ALLOW_ORIGINS"),
 		AllowMethods:     "GET,POST,PUT,DELETE,OPTIONS",
-		AllowHeaders:     "Origin, Content-Type, Accept, Authorization",
+		AllowHeaders:     "Origin, Content-Type, Accept, Authorization, X-Cart-Token, X-Order-Token",
+		ExposeHeaders:    "X-Cart-Token",
 		AllowCredentials: true,
 	}))
 
-	// Middleware para injetar o cliente do banco de dados
+	// Inicializar provedor de frete (tabela padrão ou arquivo configurado)
+	rateTable := shipping.DefaultRateTable()
+	if path := os.Getenv("SHIPPING_RATES_FILE"); path != "" {
+		rateTable, err = shipping.LoadRateTable(path)
+		if err != nil {
+			log.Fatalf("Falha ao carregar tabela de frete: %v", err)
+		}
+	}
+	shippingProvider := shipping.NewTableRateProvider(rateTable)
+
+	// Inicializar busca de produtos: índice em memória (padrão) ou consulta direta ao banco
+	var searchIndex search.SearchIndex
+	switch name := os.Getenv("SEARCH_BACKEND"); name {
+	case "", "memory":
+		memoryIndex := search.NewMemoryIndex(client)
+		client.Product.Use(memoryIndex.Hook())
+		if err := memoryIndex.Build(context.Background()); err != nil {
+			log.Fatalf("Falha ao montar o índice de busca: %v", err)
+		}
+		log.Printf("Índice de busca montado com %d produto(s)", memoryIndex.Len())
+		searchIndex = memoryIndex
+	case "sql":
+		searchIndex = search.NewSQLIndex(client)
+	default:
+		log.Fatalf("Backend de busca desconhecido: %s", name)
+	}
+
+	// Prazos de pagamento de Pix e boleto
+	pixExpiration := 30 * time.Minute
+	if value := os.Getenv("PIX_EXPIRATION"); value != "" {
+		pixExpiration, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("PIX_EXPIRATION inválido: %v", err)
+		}
+	}
+	boletoDueDays := 3
+	if value := os.Getenv("BOLETO_DUE_DAYS"); value != "" {
+		boletoDueDays, err = strconv.Atoi(value)
+		if err != nil || boletoDueDays < 1 {
+			log.Fatalf("BOLETO_DUE_DAYS inválido: %s", value)
+		}
+	}
+	boletoSettlementDays := 3
+	if value := os.Getenv("BOLETO_SETTLEMENT_DAYS"); value != "" {
+		boletoSettlementDays, err = strconv.Atoi(value)
+		if err != nil || boletoSettlementDays < 0 {
+			log.Fatalf("BOLETO_SETTLEMENT_DAYS inválido: %s", value)
+		}
+	}
+
+	// Chave Pix e convênio do boleto identificam o recebedor e só têm valores de
+	// exemplo no gateway fake
+	gatewayName := os.Getenv("PAYMENT_GATEWAY")
+	fakeGateway := gatewayName == "" || gatewayName == "fake"
+	pixKey := os.Getenv("PIX_KEY")
+	boletoAgreement := os.Getenv("BOLETO_AGREEMENT")
+	if !fakeGateway && (pixKey == "" || boletoAgreement == "") {
+		log.Fatalf("PIX_KEY e BOLETO_AGREEMENT são obrigatórios com o gateway %s", gatewayName)
+	}
+	if pixKey == "" {
+		pixKey = "pix@veecomm.com.br"
+	}
+	if boletoAgreement == "" {
+		boletoAgreement = "1234567"
+	}
+
+	asyncConfig := payments.AsyncConfig{
+		Pix: payments.PixConfig{
+			Key:          pixKey,
+			MerchantName: getEnv("PIX_MERCHANT_NAME", "VeeComm"),
+			MerchantCity: getEnv("PIX_MERCHANT_CITY", "Sao Paulo"),
+		},
+		PixExpiration: pixExpiration,
+		Boleto: payments.BoletoConfig{
+			BankCode:  getEnv("BOLETO_BANK_CODE", "001"),
+			Agreement: boletoAgreement,
+			Wallet:    getEnv("BOLETO_WALLET", "18"),
+		},
+		BoletoDueDays:        boletoDueDays,
+		BoletoSettlementDays: boletoSettlementDays,
+	}
+
+	// Prazo para solicitar devoluções após a entrega
+	returnWindowDays := 7
+	if value := os.Getenv("RETURN_WINDOW_DAYS"); value != "" {
+		returnWindowDays, err = strconv.Atoi(value)
+		if err != nil || returnWindowDays < 0 {
+			log.Fatalf("RETURN_WINDOW_DAYS inválido: %s", value)
+		}
+	}
+	returnWindow := time.Duration(returnWindowDays) * 24 * time.Hour
+
+	// Inicializar gateway de pagamento
+	var paymentGateway payments.PaymentGateway
+	switch gatewayName {
+	case "", "fake":
+		paymentGateway = payments.NewFakeGateway(asyncConfig)
+	default:
+		log.Fatalf("Gateway de pagamento desconhecido: %s", gatewayName)
+	}
+
+	// Webhooks do gateway só são aceitos com um segredo de assinatura configurado
+	webhookVerifiers := map[string]payments.WebhookVerifier{}
+	if secret := os.Getenv("PAYMENT_WEBHOOK_SECRET"); secret != "" {
+		webhookVerifiers[paymentGateway.Name()] = payments.NewHMACVerifier(secret)
+	} else {
+		log.Println("PAYMENT_WEBHOOK_SECRET não definido, webhooks de pagamento desativados")
+	}
+
+	// Limites de tentativas de login (contadores em memória, por instância)
+	loginPolicy := loginguard.DefaultPolicy()
+	if value := os.Getenv("LOGIN_MAX_FAILURES"); value != "" {
+		loginPolicy.MaxAccountFailures, err = strconv.Atoi(value)
+		if err != nil || loginPolicy.MaxAccountFailures <= 0 {
+			log.Fatalf("LOGIN_MAX_FAILURES inválido: %s", value)
+		}
+	}
+	if value := os.Getenv("LOGIN_LOCKOUT"); value != "" {
+		loginPolicy.LockoutDuration, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("LOGIN_LOCKOUT inválido: %v", err)
+		}
+	}
+	loginStore := loginguard.NewMemoryStore()
+	loginGuard := loginguard.New(loginStore, loginPolicy)
+
+	// Inicializar envio de emails (SMTP ou registro em arquivo/log para desenvolvimento).
+	// A escolha é obrigatória, para que um ambiente de produção não passe a gravar em log
+	// os links de redefinição de senha por esquecer de configurar o SMTP.
+	var accountMailer mailer.Mailer
+	switch name := os.Getenv("MAILER"); name {
+	case "":
+		log.Fatal("MAILER não definido (use MAILER=smtp, ou MAILER=log apenas em desenvolvimento)")
+	case "log":
+		log.Println("MAILER=log: emails não são enviados, apenas gravados no log; não use em produção")
+		accountMailer = mailer.NewLogMailer(os.Getenv("MAIL_LOG_FILE"))
+	case "smtp":
+		smtpPort, err := strconv.Atoi(getEnv("SMTP_PORT", "587"))
+		if err != nil {
+			log.Fatalf("SMTP_PORT inválido: %v", err)
+		}
+		accountMailer = mailer.NewSMTPMailer(mailer.SMTPConfig{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     smtpPort,
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     getEnv("MAIL_FROM", "VeeComm <no-reply@veecomm.com.br>"),
+		})
+	default:
+		log.Fatalf("Mailer desconhecido: %s", name)
+	}
+
+	// Endereço do site usado nos links enviados por email
+	appURL := strings.TrimRight(getEnv("APP_URL", "http://localhost:3000"), "/")
+
+	// Exigir email verificado para finalizar compras
+	requireVerifiedEmail := false
+	if value := os.Getenv("REQUIRE_VERIFIED_EMAIL"); value != "" {
+		requireVerifiedEmail, err = strconv.ParseBool(value)
+		if err != nil {
+			log.Fatalf("REQUIRE_VERIFIED_EMAIL inválido: %s", value)
+		}
+	}
+
+	// Cancelar periodicamente pedidos com Pix ou boleto vencido e limpar sessões, carrinhos de visitantes e contadores de login antigos
+	go func() {
+		ticker := time.NewTicker(time.Minute)
+		defer ticker.Stop()
+		for range ticker.C {
+			expired, err := controllers.ExpireUnpaidOrders(context.Background(), client, paymentGateway)
+			if err != nil {
+				log.Printf("Erro ao expirar pedidos não pagos: %v", err)
+			} else if expired > 0 {
+				log.Printf("%d pedido(s) cancelado(s) por falta de pagamento", expired)
+			}
+
+			if _, err := controllers.PurgeExpiredSessions(context.Background(), client); err != nil {
+				log.Printf("Erro ao remover sessões expiradas: %v", err)
+			}
+			if _, err := controllers.PurgeExpiredGuestCarts(context.Background(), client); err != nil {
+				log.Printf("Erro ao remover carrinhos de visitantes abandonados: %v", err)
+			}
+			loginStore.Prune(time.Now().Add(-loginPolicy.ResetAfter))
+		}
+	}()
+
+	// Middleware para injetar o cliente do banco de dados e os serviços
 	app.Use(func(c fiber.Ctx) error {
 		c.Locals("dbClient", client)
+		c.Locals("shippingProvider", shipping.ShippingProvider(shippingProvider))
+		c.Locals("searchIndex", searchIndex)
+		c.Locals("paymentGateway", paymentGateway)
+		c.Locals("webhookVerifiers", webhookVerifiers)
+		c.Locals("returnWindow", returnWindow)
+		c.Locals("mailer", accountMailer)
+		c.Locals("appURL", appURL)
+		c.Locals("requireVerifiedEmail", requireVerifiedEmail)
+		c.Locals("loginGuard", loginGuard)
 		return c.Next()
 	})
 
 	// Aplicar middleware de autenticação para todas as rotas
+	// Configurar autenticação (expiração do token de acesso e do refresh token)
+	tokenExpiry := 15 * time.Minute
+	if value := os.Getenv("TOKEN_EXPIRY"); value != "" {
+		tokenExpiry, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("TOKEN_EXPIRY inválido: %v", err)
+		}
+	}
+	refreshTokenExpiry := 30 * 24 * time.Hour
+	if value := os.Getenv("REFRESH_TOKEN_EXPIRY"); value != "" {
+		refreshTokenExpiry, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("REFRESH_TOKEN_EXPIRY inválido: %v", err)
+		}
+	}
+	guestCartExpiry := 7 * 24 * time.Hour
+	if value := os.Getenv("GUEST_CART_EXPIRY"); value != "" {
+		guestCartExpiry, err = time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("GUEST_CART_EXPIRY inválido: %v", err)
+		}
+	}
+
+	// Promover os emails configurados apenas enquanto não existir nenhum administrador
+	if value := os.Getenv("ADMIN_EMAILS"); value != "" {
+		if err := bootstrapAdmins(context.Background(), client, strings.Split(value, ",")); err != nil {
+			log.Fatalf("Falha ao criar administradores iniciais: %v", err)
+		}
+	}
+
+	// Exigir autenticação em dois fatores de quem tem permissões administrativas
+	requireAdminTwoFactor := false
+	if value := os.Getenv("REQUIRE_ADMIN_2FA"); value != "" {
+		requireAdminTwoFactor, err = strconv.ParseBool(value)
+		if err != nil {
+			log.Fatalf("REQUIRE_ADMIN_2FA inválido: %s", value)
+		}
+	}
+
+	// Carregar chaves de assinatura dos tokens. Sem elas a aplicação não inicia.
+	var signingKeys *middleware.KeySet
+	if dir := os.Getenv("JWT_KEYS_DIR"); dir != "" {
+		signingKeys, err = middleware.LoadKeySet(dir, os.Getenv("JWT_ACTIVE_KID"))
+		if err != nil {
+			log.Fatalf("Falha ao carregar chaves JWT: %v", err)
+		}
+	} else if ephemeral, _ := strconv.ParseBool(os.Getenv("JWT_EPHEMERAL_KEY")); ephemeral {
+		signingKeys, err = middleware.NewEphemeralKeySet()
+		if err != nil {
+			log.Fatalf("Falha ao gerar chave JWT temporária: %v", err)
+		}
+		log.Println("Usando chave JWT temporária; os tokens deixam de valer ao reiniciar")
+	} else {
+		log.Fatal("JWT_KEYS_DIR não definido (use JWT_EPHEMERAL_KEY=true apenas em desenvolvimento)")
+	}
+	log.Printf("Tokens assinados com a chave %s (%s)", signingKeys.Active().ID, signingKeys.Active().Algorithm)
+
 	app.Use(middleware.New(middleware.Config{
-		JWTSecret: os.Getenv("JWT_SECRET"),
+		Keys:                  signingKeys,
+		TokenExpiry:           tokenExpiry,
+		RefreshTokenExpiry:    refreshTokenExpiry,
+		GuestCartExpiry:       guestCartExpiry,
+		RequireAdminTwoFactor: requireAdminTwoFactor,
 	}))
 
 	// Configurar rotas
@@ -73,6 +359,47 @@ func main() {
 	log.Fatal(app.Listen(":" + port))
 }
 
+// getEnv retorna a variável de ambiente ou o valor padrão se ela não estiver definida
+func getEnv(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// bootstrapAdmins concede o papel de administrador aos emails verificados informados se a loja
+// ainda não tiver nenhum administrador. Depois disso os papéis são geridos pela API.
+func bootstrapAdmins(ctx context.Context, client *ent.Client, emails []string) error {
+	hasAdmin, err := client.User.
+		Query().
+		Where(user.RoleEQ(user.RoleAdmin)).
+		Exist(ctx)
+	if err != nil || hasAdmin {
+		return err
+	}
+
+	for i, email := range emails {
+		emails[i] = strings.ToLower(strings.TrimSpace(email))
+	}
+
+	// Somente contas com email verificado, para que ninguém cadastre um dos emails
+	// antes do dono e receba o papel de administrador
+	promoted, err := client.User.
+		Update().
+		Where(
+			user.EmailIn(emails...),
+			user.EmailVerifiedAtNotNil(),
+		).
+		SetRole(user.RoleAdmin).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("%d usuário(s) promovido(s) a administrador", promoted)
+	return nil
+}
+
 // customErrorHandler lida com erros da aplicação
 func customErrorHandler(c fiber.Ctx, err error) error {
 	// Status code padrão
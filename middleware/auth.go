@@ -2,8 +2,11 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/session"
 	"github.com/vtrod/veecomm-api/ent/user"
 
 	"github.com/gofiber/fiber/v3"
@@ -12,27 +15,204 @@ import (
 
 // Config armazena as configurações do middleware de autenticação
 type Config struct {
-	JWTSecret string
+	// Chaves de assinatura dos tokens (obrigatório)
+	Keys               *KeySet
+	TokenExpiry        time.Duration
+	RefreshTokenExpiry time.Duration
+	// Inatividade após a qual o carrinho de um visitante expira
+	GuestCartExpiry time.Duration
+	// Exige 2FA de quem tem permissões administrativas; sem ela as permissões ficam suspensas
+	RequireAdminTwoFactor bool
 }
 
-// DefaultConfig retorna uma configuração padrão
+// Validade do token intermediário entre a senha e o código de dois fatores
+const challengeExpiry = 5 * time.Minute
+
+// Finalidade gravada nos tokens intermediários de login
+const challengePurpose = "2fa"
+
+// Finalidade gravada nos tokens de carrinho de visitantes
+const cartPurpose = "cart"
+
+// Validade do link de consulta enviado a quem comprou sem cadastro
+const orderLookupExpiry = 180 * 24 * time.Hour
+
+// Finalidade gravada nos tokens de consulta de pedidos
+const orderPurpose = "order"
+
+// ErrInvalidChallenge é retornado para tokens intermediários de login inválidos ou expirados
+var ErrInvalidChallenge = errors.New("desafio de login inválido ou expirado")
+
+// ErrInvalidCartToken é retornado para tokens de carrinho inválidos ou expirados
+var ErrInvalidCartToken = errors.New("token de carrinho inválido ou expirado")
+
+// ErrInvalidOrderToken é retornado para tokens de consulta de pedido inválidos ou expirados
+var ErrInvalidOrderToken = errors.New("token de consulta do pedido inválido ou expirado")
+
+// DefaultConfig retorna uma configuração padrão. As chaves de assinatura não têm
+// valor padrão e precisam ser informadas.
 func DefaultConfig() Config {
 	return Config{
-		JWTSecret: "your-secret-key", // Em produção, use variável de ambiente
+		TokenExpiry:        15 * time.Minute,
+		RefreshTokenExpiry: 30 * 24 * time.Hour,
+		GuestCartExpiry:    7 * 24 * time.Hour,
+	}
+}
+
+// GenerateToken emite um JWT de curta duração com as claims "userId", "role" e "sid".
+// A claim "role" é apenas informativa para os clientes: a autorização usa o papel
+// armazenado no banco. "sid" identifica a família de sessões que emitiu o token.
+func (config Config) GenerateToken(userID, role, sessionID string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(config.TokenExpiry)
+
+	tokenString, err := config.Keys.sign(jwt.MapClaims{
+		"userId": userID,
+		"role":   role,
+		"sid":    sessionID,
+		"iat":    time.Now().Unix(),
+		"exp":    expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, expiresAt, nil
+}
+
+// GenerateChallengeToken emite o token intermediário de um login que ainda precisa do
+// código de dois fatores. Ele não tem "sid" e por isso não é aceito como token de acesso.
+func (config Config) GenerateChallengeToken(userID string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(challengeExpiry)
+
+	tokenString, err := config.Keys.sign(jwt.MapClaims{
+		"userId":  userID,
+		"purpose": challengePurpose,
+		"iat":     time.Now().Unix(),
+		"exp":     expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, expiresAt, nil
+}
+
+// ParseChallengeToken valida um token intermediário de login e retorna o ID do usuário
+func (config Config) ParseChallengeToken(tokenString string) (string, error) {
+	token, err := config.parse(tokenString)
+	if err != nil || !token.Valid {
+		return "", ErrInvalidChallenge
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != challengePurpose {
+		return "", ErrInvalidChallenge
 	}
+
+	userID, ok := claims["userId"].(string)
+	if !ok || userID == "" {
+		return "", ErrInvalidChallenge
+	}
+	return userID, nil
+}
+
+// GenerateCartToken emite o token que identifica o carrinho de um visitante. Ele não
+// tem "userId" nem "sid" e por isso não é aceito como token de acesso.
+func (config Config) GenerateCartToken(cartID string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(config.GuestCartExpiry)
+
+	tokenString, err := config.Keys.sign(jwt.MapClaims{
+		"cartId":  cartID,
+		"purpose": cartPurpose,
+		"iat":     time.Now().Unix(),
+		"exp":     expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, expiresAt, nil
+}
+
+// ParseCartToken valida um token de carrinho e retorna o ID do carrinho
+func (config Config) ParseCartToken(tokenString string) (string, error) {
+	token, err := config.parse(tokenString)
+	if err != nil || !token.Valid {
+		return "", ErrInvalidCartToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != cartPurpose {
+		return "", ErrInvalidCartToken
+	}
+
+	cartID, ok := claims["cartId"].(string)
+	if !ok || cartID == "" {
+		return "", ErrInvalidCartToken
+	}
+	return cartID, nil
+}
+
+// GenerateOrderToken emite o token que permite consultar um pedido sem login,
+// enviado a quem comprou sem cadastro
+func (config Config) GenerateOrderToken(orderID string) (string, time.Time, error) {
+	expiresAt := time.Now().Add(orderLookupExpiry)
+
+	tokenString, err := config.Keys.sign(jwt.MapClaims{
+		"orderId": orderID,
+		"purpose": orderPurpose,
+		"iat":     time.Now().Unix(),
+		"exp":     expiresAt.Unix(),
+	})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return tokenString, expiresAt, nil
+}
+
+// ParseOrderToken valida um token de consulta e retorna o ID do pedido
+func (config Config) ParseOrderToken(tokenString string) (string, error) {
+	token, err := config.parse(tokenString)
+	if err != nil || !token.Valid {
+		return "", ErrInvalidOrderToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["purpose"] != orderPurpose {
+		return "", ErrInvalidOrderToken
+	}
+
+	orderID, ok := claims["orderId"].(string)
+	if !ok || orderID == "" {
+		return "", ErrInvalidOrderToken
+	}
+	return orderID, nil
+}
+
+// parse valida a assinatura do token com a chave indicada pelo kid. Só algoritmos
+// assimétricos são aceitos, o que impede tokens HS256 e "none".
+func (config Config) parse(tokenString string) (*jwt.Token, error) {
+	return jwt.Parse(tokenString, config.Keys.keyFunc, jwt.WithValidMethods([]string{AlgRS256, AlgEdDSA}))
 }
 
 // New cria uma nova instância do middleware de autenticação
 func New(config Config) fiber.Handler {
-	// Validar configuração
-	if config.JWTSecret == "" {
-		config.JWTSecret = DefaultConfig().JWTSecret
+	// Validar configuração: sem chaves não há como emitir nem validar tokens
+	if config.Keys == nil {
+		panic("middleware: Config.Keys é obrigatório")
+	}
+	if config.TokenExpiry <= 0 {
+		config.TokenExpiry = DefaultConfig().TokenExpiry
+	}
+	if config.RefreshTokenExpiry <= 0 {
+		config.RefreshTokenExpiry = DefaultConfig().RefreshTokenExpiry
+	}
+	if config.GuestCartExpiry <= 0 {
+		config.GuestCartExpiry = DefaultConfig().GuestCartExpiry
 	}
 
 	// Retornar middleware handler
 	return func(c fiber.Ctx) error {
-		// Injetar o cliente do banco de dados no contexto
+		// Injetar o cliente do banco de dados e a configuração no contexto
 		client := c.Locals("dbClient").(*ent.Client)
+		c.Locals("authConfig", config)
 		
 		// Obter token do cabeçalho de autorização
 		authHeader := c.Get("Authorization")
@@ -40,7 +220,8 @@ func New(config Config) fiber.Handler {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
@@ -50,7 +231,8 @@ func New(config Config) fiber.Handler {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
@@ -58,19 +240,14 @@ func New(config Config) fiber.Handler {
 		tokenString := parts[1]
 
 		// Parse do token JWT
-		token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-			// Verificar método de assinatura
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, fiber.NewError(fiber.StatusUnauthorized, "Método de assinatura inválido")
-			}
-			return []byte(config.JWTSecret), nil
-		})
+		token, err := config.parse(tokenString)
 
 		if err != nil || !token.Valid {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
@@ -80,7 +257,8 @@ func New(config Config) fiber.Handler {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
@@ -90,66 +268,83 @@ func New(config Config) fiber.Handler {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
-		// Verificar se o usuário existe no banco de dados
+		// Tokens sem sessão ou de sessões encerradas não são aceitos
 		ctx := context.Background()
-		userObj, err := client.User.Query().Where(user.ID(userID)).First(ctx)
-		if err != nil {
+		sessionID, ok := claims["sid"].(string)
+		if !ok || sessionID == "" {
 			// Definir usuário não autenticado
 			c.Locals("authenticated", false)
 			c.Locals("userId", "")
-			c.Locals("isAdmin", false)
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
 			return c.Next()
 		}
 
-		// Verificar se é admin (exemplo simples)
-		isAdmin := false
-		if role, ok := claims["role"].(string); ok && role == "admin" {
-			isAdmin = true
+		active, err := client.Session.
+			Query().
+			Where(
+				session.FamilyID(sessionID),
+				session.UserID(userID),
+				session.RevokedAtIsNil(),
+				session.ExpiresAtGT(time.Now()),
+			).
+			Exist(ctx)
+		if err != nil || !active {
+			// Definir usuário não autenticado
+			c.Locals("authenticated", false)
+			c.Locals("userId", "")
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
+			return c.Next()
 		}
 
-		// Definir informações do usuário no contexto
+		// Verificar se o usuário existe no banco de dados
+		userObj, err := client.User.Query().Where(user.ID(userID)).First(ctx)
+		if err != nil {
+			// Definir usuário não autenticado
+			c.Locals("authenticated", false)
+			c.Locals("userId", "")
+			c.Locals("role", "")
+			c.Locals("permissions", []string{})
+			return c.Next()
+		}
+
+		// Papel e permissões vêm sempre do banco, nunca das claims do token,
+		// para que alterações e revogações valham a partir da próxima requisição
 		c.Locals("authenticated", true)
 		c.Locals("userId", userID)
+		c.Locals("sessionId", sessionID)
 		c.Locals("user", userObj)
-		c.Locals("isAdmin", isAdmin)
-
-		// Continuar com a próxima middleware/handler
-		return c.Next()
-	}
-}
+		c.Locals("role", string(userObj.Role))
 
-// Protected verifica se o usuário está autenticado
-func Protected() fiber.Handler {
-	return func(c fiber.Ctx) error {
-		// Verificar se o usuário está autenticado
-		authenticated, ok := c.Locals("authenticated").(bool)
-		if !ok || !authenticated {
-			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-				"message": "Não autorizado",
-			})
+		// Pela política de 2FA, permissões administrativas só valem com o segundo fator ativo
+		permissions := EffectivePermissions(userObj)
+		if config.RequireAdminTwoFactor && len(permissions) > 0 && userObj.TotpEnabledAt == nil {
+			permissions = []string{}
+			c.Locals("twoFactorSetupRequired", true)
 		}
+		c.Locals("permissions", permissions)
 
 		// Continuar com a próxima middleware/handler
 		return c.Next()
 	}
 }
 
-// AdminOnly verifica se o usuário é administrador
-func AdminOnly() fiber.Handler {
-	return func(c fiber.Ctx) error {
-		// Verificar se o usuário é admin
-		isAdmin, ok := c.Locals("isAdmin").(bool)
-		if !ok || !isAdmin {
-			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
-				"message": "Acesso restrito a administradores",
-			})
-		}
-
-		// Continuar com a próxima middleware/handler
-		return c.Next()
+// Protected verifica se o usuário está autenticado
+func Protected(c fiber.Ctx) error {
+	// Verificar se o usuário está autenticado
+	authenticated, ok := c.Locals("authenticated").(bool)
+	if !ok || !authenticated {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"message": "Não autorizado",
+		})
 	}
-} 
\ No newline at end of file
+
+	// Continuar com a próxima middleware/handler
+	return c.Next()
+}
@@ -0,0 +1,254 @@
+package middleware
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Algoritmos de assinatura aceitos
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+// Tamanho mínimo aceito para chaves RSA
+const minRSAKeyBits = 2048
+
+// Erros de carregamento das chaves de assinatura
+var (
+	ErrNoSigningKeys     = errors.New("nenhuma chave de assinatura configurada")
+	ErrUnknownActiveKey  = errors.New("chave ativa não encontrada entre as chaves carregadas")
+	ErrActiveKeyIsPublic = errors.New("a chave ativa precisa ter a parte privada")
+	ErrUnsupportedKey    = errors.New("tipo de chave não suportado (use RSA de 2048 bits ou mais, ou Ed25519)")
+)
+
+// SigningKey é uma chave identificada por "kid". Chaves só com a parte pública
+// servem apenas para validar tokens emitidos antes de uma rotação.
+type SigningKey struct {
+	ID        string
+	Algorithm string
+	Private   crypto.Signer
+	Public    crypto.PublicKey
+}
+
+// KeySet reúne as chaves conhecidas e indica qual delas assina os novos tokens
+type KeySet struct {
+	active string
+	keys   map[string]SigningKey
+	order  []string
+}
+
+// NewKeySet cria um conjunto de chaves com a chave ativa informada
+func NewKeySet(active string, keys ...SigningKey) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoSigningKeys
+	}
+
+	set := &KeySet{active: active, keys: make(map[string]SigningKey, len(keys))}
+	for _, key := range keys {
+		if _, exists := set.keys[key.ID]; exists {
+			return nil, fmt.Errorf("kid duplicado: %s", key.ID)
+		}
+		set.keys[key.ID] = key
+		set.order = append(set.order, key.ID)
+	}
+
+	activeKey, ok := set.keys[active]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownActiveKey, active)
+	}
+	if activeKey.Private == nil {
+		return nil, fmt.Errorf("%w: %s", ErrActiveKeyIsPublic, active)
+	}
+	return set, nil
+}
+
+// LoadKeySet carrega as chaves PEM de um diretório. O nome do arquivo, sem a extensão
+// .pem, é o "kid". Arquivos com chave privada (PKCS#8 ou PKCS#1) podem assinar;
+// arquivos só com a chave pública ficam disponíveis apenas para validação. Se
+// active estiver vazio, a chave privada com o maior kid em ordem alfabética é usada.
+func LoadKeySet(dir, active string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+
+	keys := make([]SigningKey, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+		key, err := ParseSigningKey(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		keys = append(keys, key)
+	}
+
+	if active == "" {
+		for _, key := range keys {
+			if key.Private != nil {
+				active = key.ID
+			}
+		}
+	}
+
+	return NewKeySet(active, keys...)
+}
+
+// ParseSigningKey lê uma chave RSA ou Ed25519 em formato PEM
+func ParseSigningKey(kid string, data []byte) (SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return SigningKey{}, errors.New("arquivo PEM inválido")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return SigningKey{}, fmt.Errorf("bloco PEM não suportado: %s", block.Type)
+	}
+	if err != nil {
+		return SigningKey{}, err
+	}
+
+	return newSigningKey(kid, parsed)
+}
+
+// NewEphemeralKeySet gera uma chave Ed25519 em memória. Os tokens deixam de valer
+// quando a aplicação reinicia, por isso serve apenas para desenvolvimento.
+func NewEphemeralKeySet() (*KeySet, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, err
+	}
+
+	key, err := newSigningKey("ephemeral-"+hex.EncodeToString(suffix), private)
+	if err != nil {
+		return nil, err
+	}
+	return NewKeySet(key.ID, key)
+}
+
+// Active retorna a chave que assina os novos tokens
+func (s *KeySet) Active() SigningKey {
+	return s.keys[s.active]
+}
+
+// Lookup retorna a chave com o kid informado
+func (s *KeySet) Lookup(kid string) (SigningKey, bool) {
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// JWK representa uma chave pública no formato da RFC 7517
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JWKS retorna as chaves públicas de todas as chaves do conjunto
+func (s *KeySet) JWKS() []JWK {
+	jwks := make([]JWK, 0, len(s.order))
+	for _, kid := range s.order {
+		key := s.keys[kid]
+		jwk := JWK{KeyID: kid, Use: "sig", Algorithm: key.Algorithm}
+
+		switch public := key.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		}
+		jwks = append(jwks, jwk)
+	}
+	return jwks
+}
+
+// sign assina as claims com a chave ativa, gravando o kid no cabeçalho
+func (s *KeySet) sign(claims jwt.Claims) (string, error) {
+	key := s.Active()
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.Private)
+}
+
+// keyFunc escolhe a chave de validação pelo kid e confere o algoritmo
+func (s *KeySet) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.Lookup(kid)
+	if !ok {
+		return nil, fmt.Errorf("chave desconhecida: %q", kid)
+	}
+	if token.Method.Alg() != key.Algorithm {
+		return nil, fmt.Errorf("algoritmo %s não corresponde à chave %s", token.Method.Alg(), kid)
+	}
+	return key.Public, nil
+}
+
+// newSigningKey identifica o algoritmo a partir do tipo da chave
+func newSigningKey(kid string, parsed interface{}) (SigningKey, error) {
+	if kid == "" {
+		return SigningKey{}, errors.New("kid vazio")
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return SigningKey{}, ErrUnsupportedKey
+		}
+		return SigningKey{ID: kid, Algorithm: AlgRS256, Private: k, Public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSAKeyBits {
+			return SigningKey{}, ErrUnsupportedKey
+		}
+		return SigningKey{ID: kid, Algorithm: AlgRS256, Public: k}, nil
+	case ed25519.PrivateKey:
+		return SigningKey{ID: kid, Algorithm: AlgEdDSA, Private: k, Public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return SigningKey{ID: kid, Algorithm: AlgEdDSA, Public: k}, nil
+	}
+	return SigningKey{}, ErrUnsupportedKey
+}
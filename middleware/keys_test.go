@@ -0,0 +1,269 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Chave RSA compartilhada pelos testes, já que gerá-la é lento
+var testRSAKey *rsa.PrivateKey
+
+func rsaTestKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	if testRSAKey == nil {
+		key, err := rsa.GenerateKey(rand.Reader, minRSAKeyBits)
+		if err != nil {
+			t.Fatalf("rsa.GenerateKey() err = %v", err)
+		}
+		testRSAKey = key
+	}
+	return testRSAKey
+}
+
+func ed25519TestKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("ed25519.GenerateKey() err = %v", err)
+	}
+	return private
+}
+
+func mustSigningKey(t *testing.T, kid string, parsed interface{}) SigningKey {
+	t.Helper()
+	key, err := newSigningKey(kid, parsed)
+	if err != nil {
+		t.Fatalf("newSigningKey(%q) err = %v", kid, err)
+	}
+	return key
+}
+
+func mustKeySet(t *testing.T, active string, keys ...SigningKey) *KeySet {
+	t.Helper()
+	set, err := NewKeySet(active, keys...)
+	if err != nil {
+		t.Fatalf("NewKeySet(%q) err = %v", active, err)
+	}
+	return set
+}
+
+func signTestToken(t *testing.T, set *KeySet) string {
+	t.Helper()
+	token, err := set.sign(jwt.RegisteredClaims{
+		Subject:   "user-1",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	})
+	if err != nil {
+		t.Fatalf("sign() err = %v", err)
+	}
+	return token
+}
+
+func parseTestToken(set *KeySet, token string) error {
+	_, err := Config{Keys: set}.parse(token)
+	return err
+}
+
+func TestKeySetRejectsKidAndAlgorithmMismatch(t *testing.T) {
+	edKey := ed25519TestKey(t)
+	signer := mustKeySet(t, "a", mustSigningKey(t, "a", edKey))
+	token := signTestToken(t, signer)
+
+	tests := []struct {
+		name    string
+		set     *KeySet
+		wantErr bool
+	}{
+		{"mesma chave", signer, false},
+		{"só a chave pública", mustKeySet(t, "b", mustSigningKey(t, "a", edKey.Public()), mustSigningKey(t, "b", ed25519TestKey(t))), false},
+		{"kid desconhecido", mustKeySet(t, "b", mustSigningKey(t, "b", edKey)), true},
+		{"mesmo kid com outra chave", mustKeySet(t, "a", mustSigningKey(t, "a", ed25519TestKey(t))), true},
+		{"mesmo kid com outro algoritmo", mustKeySet(t, "a", mustSigningKey(t, "a", rsaTestKey(t))), true},
+	}
+
+	for _, tt := range tests {
+		if err := parseTestToken(tt.set, token); (err != nil) != tt.wantErr {
+			t.Errorf("%s: parse() err = %v, want erro %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestKeySetRejectsTokenWithoutKid(t *testing.T) {
+	key := ed25519TestKey(t)
+	set := mustKeySet(t, "a", mustSigningKey(t, "a", key))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, jwt.RegisteredClaims{Subject: "user-1"}).SignedString(key)
+	if err != nil {
+		t.Fatalf("SignedString() err = %v", err)
+	}
+	if err := parseTestToken(set, token); err == nil {
+		t.Errorf("parse() sem kid err = nil, want erro")
+	}
+}
+
+func TestNewSigningKeyRSAFloor(t *testing.T) {
+	small, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() err = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		parsed  interface{}
+		wantAlg string
+		wantErr error
+	}{
+		{"RSA de 2048 bits", rsaTestKey(t), AlgRS256, nil},
+		{"pública RSA de 2048 bits", &rsaTestKey(t).PublicKey, AlgRS256, nil},
+		{"RSA de 1024 bits", small, "", ErrUnsupportedKey},
+		{"pública RSA de 1024 bits", &small.PublicKey, "", ErrUnsupportedKey},
+		{"Ed25519", ed25519TestKey(t), AlgEdDSA, nil},
+		{"tipo desconhecido", "chave", "", ErrUnsupportedKey},
+	}
+
+	for _, tt := range tests {
+		key, err := newSigningKey("kid", tt.parsed)
+		if !errors.Is(err, tt.wantErr) || key.Algorithm != tt.wantAlg {
+			t.Errorf("%s: newSigningKey() = %q, %v, want %q, %v", tt.name, key.Algorithm, err, tt.wantAlg, tt.wantErr)
+		}
+	}
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(small)})
+	if _, err := ParseSigningKey("pequena", data); !errors.Is(err, ErrUnsupportedKey) {
+		t.Errorf("ParseSigningKey() RSA de 1024 bits err = %v, want %v", err, ErrUnsupportedKey)
+	}
+}
+
+func TestKeySetJWKS(t *testing.T) {
+	rsaKey := rsaTestKey(t)
+	edKey := ed25519TestKey(t)
+	set := mustKeySet(t, "rsa", mustSigningKey(t, "rsa", rsaKey), mustSigningKey(t, "ed", edKey.Public()))
+
+	jwks := set.JWKS()
+	if len(jwks) != 2 {
+		t.Fatalf("JWKS() = %d chaves, want 2", len(jwks))
+	}
+
+	want := []JWK{
+		{
+			KeyType:   "RSA",
+			KeyID:     "rsa",
+			Use:       "sig",
+			Algorithm: AlgRS256,
+			N:         base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:         "AQAB",
+		},
+		{
+			KeyType:   "OKP",
+			KeyID:     "ed",
+			Use:       "sig",
+			Algorithm: AlgEdDSA,
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(edKey.Public().(ed25519.PublicKey)),
+		},
+	}
+	for i := range want {
+		if jwks[i] != want[i] {
+			t.Errorf("JWKS()[%d] = %+v, want %+v", i, jwks[i], want[i])
+		}
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	oldKey := ed25519TestKey(t)
+	oldSet := mustKeySet(t, "2026-09", mustSigningKey(t, "2026-09", oldKey))
+	oldToken := signTestToken(t, oldSet)
+
+	// Depois da rotação a chave antiga fica só com a parte pública
+	rotated := mustKeySet(t, "2026-10",
+		mustSigningKey(t, "2026-09", oldKey.Public()),
+		mustSigningKey(t, "2026-10", rsaTestKey(t)),
+	)
+
+	if err := parseTestToken(rotated, oldToken); err != nil {
+		t.Errorf("parse() de token anterior à rotação err = %v", err)
+	}
+
+	newToken := signTestToken(t, rotated)
+	parsed, err := Config{Keys: rotated}.parse(newToken)
+	if err != nil {
+		t.Fatalf("parse() de token novo err = %v", err)
+	}
+	if kid := parsed.Header["kid"]; kid != "2026-10" {
+		t.Errorf("kid do token novo = %v, want 2026-10", kid)
+	}
+	if alg := parsed.Method.Alg(); alg != AlgRS256 {
+		t.Errorf("algoritmo do token novo = %s, want %s", alg, AlgRS256)
+	}
+
+	// Quando a chave antiga é removida, os tokens emitidos com ela deixam de valer
+	retired := mustKeySet(t, "2026-10", mustSigningKey(t, "2026-10", rsaTestKey(t)))
+	if err := parseTestToken(retired, oldToken); err == nil {
+		t.Errorf("parse() com a chave antiga removida err = nil, want erro")
+	}
+}
+
+func TestNewKeySetActiveKey(t *testing.T) {
+	key := ed25519TestKey(t)
+
+	tests := []struct {
+		name    string
+		active  string
+		keys    []SigningKey
+		wantErr error
+	}{
+		{"sem chaves", "a", nil, ErrNoSigningKeys},
+		{"ativa desconhecida", "b", []SigningKey{mustSigningKey(t, "a", key)}, ErrUnknownActiveKey},
+		{"ativa só com a parte pública", "a", []SigningKey{mustSigningKey(t, "a", key.Public())}, ErrActiveKeyIsPublic},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewKeySet(tt.active, tt.keys...); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: NewKeySet() err = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLoadKeySet(t *testing.T) {
+	dir := t.TempDir()
+	writePEM := func(name, blockType string, der []byte) {
+		data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o600); err != nil {
+			t.Fatalf("WriteFile(%s) err = %v", name, err)
+		}
+	}
+
+	oldKey := ed25519TestKey(t)
+	oldPublic, err := x509.MarshalPKIXPublicKey(oldKey.Public())
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey() err = %v", err)
+	}
+	writePEM("2026-09.pem", "PUBLIC KEY", oldPublic)
+	writePEM("2026-10.pem", "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaTestKey(t)))
+
+	set, err := LoadKeySet(dir, "")
+	if err != nil {
+		t.Fatalf("LoadKeySet() err = %v", err)
+	}
+	if active := set.Active(); active.ID != "2026-10" || active.Algorithm != AlgRS256 {
+		t.Errorf("Active() = %s (%s), want 2026-10 (%s)", active.ID, active.Algorithm, AlgRS256)
+	}
+	if key, ok := set.Lookup("2026-09"); !ok || key.Private != nil {
+		t.Errorf("Lookup(2026-09) = %+v, %v, want só a parte pública", key, ok)
+	}
+
+	if _, err := LoadKeySet(dir, "2026-09"); !errors.Is(err, ErrActiveKeyIsPublic) {
+		t.Errorf("LoadKeySet() com ativa pública err = %v, want %v", err, ErrActiveKeyIsPublic)
+	}
+}
@@ -0,0 +1,94 @@
+package middleware
+
+import (
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/user"
+
+	"github.com/gofiber/fiber/v3"
+)
+
+// Permissões que podem ser exigidas pelas rotas
+const (
+	PermCatalogWrite    = "catalog:write"
+	PermOrdersManage    = "orders:manage"
+	PermCouponsManage   = "coupons:manage"
+	PermUsersManage     = "users:manage"
+	PermReviewsModerate = "reviews:moderate"
+	PermReportsView     = "reports:view"
+)
+
+// AllPermissions lista todas as permissões conhecidas
+var AllPermissions = []string{
+	PermCatalogWrite,
+	PermOrdersManage,
+	PermCouponsManage,
+	PermUsersManage,
+	PermReviewsModerate,
+	PermReportsView,
+}
+
+// Permissões concedidas por cada papel
+var rolePermissions = map[user.Role][]string{
+	user.RoleAdmin:    AllPermissions,
+	user.RoleCustomer: {},
+}
+
+// IsValidPermission indica se a permissão existe
+func IsValidPermission(permission string) bool {
+	for _, p := range AllPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// EffectivePermissions combina as permissões do papel com as concedidas ao usuário
+func EffectivePermissions(u *ent.User) []string {
+	seen := make(map[string]bool)
+	permissions := make([]string, 0)
+	for _, list := range [][]string{rolePermissions[u.Role], u.Permissions} {
+		for _, p := range list {
+			if !seen[p] && IsValidPermission(p) {
+				seen[p] = true
+				permissions = append(permissions, p)
+			}
+		}
+	}
+	return permissions
+}
+
+// HasPermission verifica se o usuário autenticado possui a permissão
+func HasPermission(c fiber.Ctx, permission string) bool {
+	permissions, _ := c.Locals("permissions").([]string)
+	for _, p := range permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// RequirePermission exige que o usuário autenticado possua todas as permissões informadas
+func RequirePermission(permissions ...string) fiber.Handler {
+	return func(c fiber.Ctx) error {
+		for _, permission := range permissions {
+			if !HasPermission(c, permission) {
+				if pending, _ := c.Locals("twoFactorSetupRequired").(bool); pending {
+					return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+						"message":    "Ative a autenticação em dois fatores para usar permissões administrativas",
+						"code":       "two_factor_required",
+						"permission": permission,
+					})
+				}
+				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+					"message":    "Permissão insuficiente",
+					"permission": permission,
+				})
+			}
+		}
+
+		// Continuar com a próxima middleware/handler
+		return c.Next()
+	}
+}
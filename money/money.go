@@ -0,0 +1,207 @@
+package money
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Amount é um valor monetário em centavos. Todas as contas com dinheiro são feitas em
+// inteiros; frações de centavo só aparecem em percentuais e proporções, e nesses casos
+// o resultado é arredondado para o centavo mais próximo, com as metades arredondadas
+// para longe do zero (R$ 0,005 vira R$ 0,01).
+type Amount int64
+
+// Zero é o valor nulo
+const Zero Amount = 0
+
+// ErrInvalidAmount é retornado ao ler um valor monetário mal formatado
+var ErrInvalidAmount = errors.New("valor monetário inválido")
+
+// FromCents cria um valor a partir de centavos
+func FromCents(cents int64) Amount {
+	return Amount(cents)
+}
+
+// Parse lê um valor decimal em reais ("19.90", "-3", "0.5"). Casas além dos centavos
+// são arredondadas com a mesma regra das demais operações.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	negative := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+
+	whole, frac, _ := strings.Cut(s, ".")
+	if whole == "" && frac == "" {
+		return 0, ErrInvalidAmount
+	}
+	if whole == "" {
+		whole = "0"
+	}
+	for _, part := range []string{whole, frac} {
+		for _, r := range part {
+			if r < '0' || r > '9' {
+				return 0, ErrInvalidAmount
+			}
+		}
+	}
+
+	reais, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil || reais > math.MaxInt64/100-1 {
+		return 0, ErrInvalidAmount
+	}
+
+	// Centavos pelas duas primeiras casas; a terceira decide o arredondamento
+	frac += "000"
+	cents := reais*100 + int64(frac[0]-'0')*10 + int64(frac[1]-'0')
+	if frac[2] >= '5' {
+		cents++
+	}
+
+	if negative {
+		cents = -cents
+	}
+	return Amount(cents), nil
+}
+
+// MustParse é como Parse, mas entra em pânico se o valor for inválido. Usado em
+// valores fixos no código.
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(fmt.Sprintf("money: %q: %v", s, err))
+	}
+	return a
+}
+
+// Cents retorna o valor em centavos
+func (a Amount) Cents() int64 {
+	return int64(a)
+}
+
+// Float64 retorna o valor em reais, apenas para exibição e integrações que exigem float
+func (a Amount) Float64() float64 {
+	return float64(a) / 100
+}
+
+// String formata o valor em reais com duas casas decimais ("19.90")
+func (a Amount) String() string {
+	sign := ""
+	cents := int64(a)
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
+
+// Mul multiplica o valor por uma quantidade
+func (a Amount) Mul(quantity int) Amount {
+	return a * Amount(quantity)
+}
+
+// Percent calcula o percentual indicado do valor. O percentual também usa duas casas
+// decimais (Percent(MustParse("12.5")) é 12,5%), e o resultado é arredondado.
+func (a Amount) Percent(rate Amount) Amount {
+	return a.Prorate(int64(rate), 100*100)
+}
+
+// Prorate calcula a fração numerator/denominator do valor, arredondando o resultado.
+// Com denominador zero o resultado é zero.
+func (a Amount) Prorate(numerator, denominator int64) Amount {
+	if denominator == 0 {
+		return 0
+	}
+	return Amount(divRound(int64(a)*numerator, denominator))
+}
+
+// Min retorna o menor entre dois valores
+func Min(a, b Amount) Amount {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// Max retorna o maior entre dois valores
+func Max(a, b Amount) Amount {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Value grava o valor em centavos no banco de dados
+func (a Amount) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan lê o valor em centavos gravado no banco de dados
+func (a *Amount) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+	case int64:
+		*a = Amount(v)
+	case []byte:
+		return a.scanText(string(v))
+	case string:
+		return a.scanText(v)
+	default:
+		return fmt.Errorf("money: tipo %T não suportado", src)
+	}
+	return nil
+}
+
+// scanText lê os centavos de colunas que o driver entrega como texto
+func (a *Amount) scanText(s string) error {
+	cents, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("money: %w", err)
+	}
+	*a = Amount(cents)
+	return nil
+}
+
+// MarshalJSON escreve o valor como número em reais com duas casas (19.90), o mesmo
+// formato usado pela API antes da troca para centavos
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON aceita números ou textos em reais, sem passar por float64
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "null" {
+		return nil
+	}
+
+	// Números como 1e2 não são aceitos; valores monetários vêm em notação decimal
+	parsed, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidAmount, string(data))
+	}
+	*a = parsed
+	return nil
+}
+
+// divRound divide arredondando a metade para longe do zero
+func divRound(n, d int64) int64 {
+	if d < 0 {
+		n, d = -n, -d
+	}
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
@@ -0,0 +1,210 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: "19.90", want: 1990},
+		{in: "19.9", want: 1990},
+		{in: "19", want: 1900},
+		{in: "+2", want: 200},
+		{in: " 3.10 ", want: 310},
+		{in: ".5", want: 50},
+		{in: "0.5", want: 50},
+		{in: "-3", want: -300},
+		{in: "-0.5", want: -50},
+		// A terceira casa decide o arredondamento, com as metades para longe do zero
+		{in: "0.005", want: 1},
+		{in: "0.0049", want: 0},
+		{in: "0.0050", want: 1},
+		{in: "1.994", want: 199},
+		{in: "1.995", want: 200},
+		{in: "12.3456", want: 1235},
+		{in: "-0.005", want: -1},
+		{in: "-0.004", want: 0},
+		{in: "-1.995", want: -200},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: ".", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1,50", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1e2", wantErr: true},
+		{in: "--1", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := Parse(tt.in)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidAmount) {
+				t.Errorf("Parse(%q) error = %v, want ErrInvalidAmount", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Parse(%q) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		in   Amount
+		want string
+	}{
+		{0, "0.00"},
+		{5, "0.05"},
+		{1990, "19.90"},
+		{100000, "1000.00"},
+		{-5, "-0.05"},
+		{-1990, "-19.90"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.in.String(); got != tt.want {
+			t.Errorf("Amount(%d).String() = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		amount Amount
+		rate   string
+		want   Amount
+	}{
+		{10000, "10", 1000},
+		{1000, "12.5", 125},
+		{999, "10", 100},
+		{333, "33.33", 111},
+		// Meio centavo arredonda para longe do zero
+		{5, "10", 1},
+		{3, "50", 2},
+		{1, "50", 1},
+		{-5, "10", -1},
+		{-3, "50", -2},
+		{1234, "0", 0},
+		{1234, "100", 1234},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Percent(MustParse(tt.rate)); got != tt.want {
+			t.Errorf("Amount(%d).Percent(%s) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestProrate(t *testing.T) {
+	tests := []struct {
+		amount                 Amount
+		numerator, denominator int64
+		want                   Amount
+	}{
+		{1000, 1, 3, 333},
+		{1000, 2, 3, 667},
+		{-1000, 2, 3, -667},
+		{5, 1, 2, 3},
+		{-5, 1, 2, -3},
+		{5, -1, 2, -3},
+		{5, 1, -2, -3},
+		{1000, 0, 3, 0},
+		{1000, 1, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tt.amount.Prorate(tt.numerator, tt.denominator); got != tt.want {
+			t.Errorf("Amount(%d).Prorate(%d, %d) = %d, want %d", tt.amount, tt.numerator, tt.denominator, got, tt.want)
+		}
+	}
+}
+
+func TestMinMaxMul(t *testing.T) {
+	if got := Min(300, -200); got != -200 {
+		t.Errorf("Min = %d, want -200", got)
+	}
+	if got := Max(300, -200); got != 300 {
+		t.Errorf("Max = %d, want 300", got)
+	}
+	if got := Amount(1990).Mul(3); got != 5970 {
+		t.Errorf("Mul = %d, want 5970", got)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Price Amount `json:"price"`
+	}{1990})
+	if err != nil || string(data) != `{"price":19.90}` {
+		t.Errorf("Marshal = %s, %v", data, err)
+	}
+
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{in: `19.9`, want: 1990},
+		{in: `"19.90"`, want: 1990},
+		{in: `0.005`, want: 1},
+		{in: `-2`, want: -200},
+		{in: `null`, want: 42},
+		{in: `1e2`, wantErr: true},
+		{in: `"abc"`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := Amount(42)
+		err := json.Unmarshal([]byte(tt.in), &got)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidAmount) {
+				t.Errorf("Unmarshal(%s) error = %v, want ErrInvalidAmount", tt.in, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Unmarshal(%s) = %d, %v; want %d", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		src     any
+		want    Amount
+		wantErr bool
+	}{
+		{src: int64(1990), want: 1990},
+		{src: []byte("-150"), want: -150},
+		{src: "75", want: 75},
+		{src: nil, want: 0},
+		{src: "19.90", wantErr: true},
+		{src: 19.9, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got := Amount(42)
+		err := got.Scan(tt.src)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("Scan(%v) expected an error", tt.src)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Scan(%v) = %d, %v; want %d", tt.src, got, err, tt.want)
+		}
+	}
+
+	if v, err := Amount(1990).Value(); err != nil || v != int64(1990) {
+		t.Errorf("Value = %v, %v", v, err)
+	}
+}
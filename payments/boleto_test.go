@@ -0,0 +1,120 @@
+package payments
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// Linha digitável de um boleto real do Banco do Brasil usada nos testes dos dígitos:
+// 00190.50095 40144.816069 06809.350314 3 37370000000100
+
+func TestBoletoMod10(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		{"001905009", "5"},
+		{"4014481606", "9"},
+		{"0680935031", "4"},
+		{"0", "0"},
+		{"5", "9"},
+	}
+
+	for _, tt := range tests {
+		if got := boletoMod10(tt.digits); got != tt.want {
+			t.Errorf("boletoMod10(%q) = %s, want %s", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestBoletoMod11(t *testing.T) {
+	tests := []struct {
+		digits string
+		want   string
+	}{
+		// Código de barras do boleto de exemplo sem o dígito geral (5ª posição)
+		{"0019" + "3737" + "0000000100" + "0500940144816060680935031", "3"},
+		{"1", "9"},
+		// Restos que dariam 0, 10 ou 11 viram 1
+		{"0000", "1"},
+		{"6", "1"},
+	}
+
+	for _, tt := range tests {
+		if got := boletoMod11(tt.digits); got != tt.want {
+			t.Errorf("boletoMod11(%q) = %s, want %s", tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestBoletoDueFactor(t *testing.T) {
+	tests := []struct {
+		date string
+		want int
+	}{
+		{"1997-10-07", 0},
+		{"2000-07-03", 1000},
+		{"2025-02-21", 9999},
+		// Ao passar de 9999 o fator reinicia em 1000
+		{"2025-02-22", 1000},
+		{"2025-02-23", 1001},
+		{"2049-10-13", 9999},
+		{"2049-10-14", 1000},
+	}
+
+	for _, tt := range tests {
+		date, err := time.Parse("2006-01-02", tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// O horário e o fuso do vencimento não mudam o fator
+		date = date.Add(23 * time.Hour)
+		if got := boletoDueFactor(date); got != tt.want {
+			t.Errorf("boletoDueFactor(%s) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestNewBoleto(t *testing.T) {
+	cfg := BoletoConfig{BankCode: "001", Agreement: "1234567", Wallet: "17"}
+	due := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	boleto := NewBoleto(cfg, 42, 12345, due)
+
+	barcode := boleto.Barcode
+	if len(barcode) != 44 {
+		t.Fatalf("barcode has %d digits: %s", len(barcode), barcode)
+	}
+	if got, want := barcode[:4], "0019"; got != want {
+		t.Errorf("bank and currency = %s, want %s", got, want)
+	}
+	if got, want := barcode[5:9], "1016"; got != want {
+		t.Errorf("due factor = %s, want %s", got, want)
+	}
+	if got, want := barcode[9:19], "0000012345"; got != want {
+		t.Errorf("value = %s, want %s", got, want)
+	}
+	if got, want := barcode[19:], "000000"+"1234567"+"0000000042"+"17"; got != want {
+		t.Errorf("free field = %s, want %s", got, want)
+	}
+	if dv := boletoMod11(barcode[:4] + barcode[5:]); barcode[4:5] != dv {
+		t.Errorf("general check digit = %s, want %s", barcode[4:5], dv)
+	}
+
+	// A linha digitável tem três campos com dígito próprio, o dígito geral e o fator com o valor
+	line := strings.NewReplacer(".", "", " ", "").Replace(boleto.DigitableLine)
+	if len(line) != 47 {
+		t.Fatalf("digitable line has %d digits: %s", len(line), boleto.DigitableLine)
+	}
+	for _, field := range []string{line[0:10], line[10:21], line[21:32]} {
+		if dv := boletoMod10(field[:len(field)-1]); field[len(field)-1:] != dv {
+			t.Errorf("field %s check digit = %s, want %s", field, field[len(field)-1:], dv)
+		}
+	}
+	if got, want := line[32:33]+line[33:], barcode[4:19]; got != want {
+		t.Errorf("line tail = %s, want %s", got, want)
+	}
+	if got, want := line[0:4]+line[4:9]+line[10:20]+line[21:31], barcode[0:4]+barcode[19:]; got != want {
+		t.Errorf("line fields = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,206 @@
+package payments
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"github.com/vtrod/veecomm-api/money"
+	"sync"
+	"time"
+)
+
+// Token que faz o FakeGateway recusar a autorização
+const FakeDeclineToken = "tok_declined"
+
+// AsyncConfig define os dados do recebedor e os prazos de Pix e boleto.
+// BoletoSettlementDays é a carência após o vencimento para a compensação bancária
+// de boletos pagos no último dia, antes que o pagamento seja considerado expirado.
+type AsyncConfig struct {
+	Pix                  PixConfig
+	PixExpiration        time.Duration
+	Boleto               BoletoConfig
+	BoletoDueDays        int
+	BoletoSettlementDays int
+}
+
+// FakeGateway é um gateway determinístico em memória para desenvolvimento e testes
+type FakeGateway struct {
+	mu           sync.Mutex
+	config       AsyncConfig
+	transactions map[string]*fakeTransaction
+}
+
+type fakeTransaction struct {
+	authorized money.Amount
+	captured   money.Amount
+	refunded   money.Amount
+	voided     bool
+	async      bool
+}
+
+// NewFakeGateway cria um gateway fake vazio
+func NewFakeGateway(config AsyncConfig) *FakeGateway {
+	return &FakeGateway{
+		config:       config,
+		transactions: make(map[string]*fakeTransaction),
+	}
+}
+
+// Name identifica o provedor
+func (g *FakeGateway) Name() string {
+	return "fake"
+}
+
+// Authorize aprova qualquer valor positivo, exceto quando o token é FakeDeclineToken
+func (g *FakeGateway) Authorize(ctx context.Context, req AuthorizeRequest) (Result, error) {
+	if req.Amount <= 0 {
+		return Result{}, ErrInvalidAmount
+	}
+
+	transactionID := fakeTransactionID(req.OrderID, req.Method)
+	if req.Token == FakeDeclineToken {
+		return Result{
+			TransactionID: transactionID,
+			Status:        StatusDeclined,
+			Amount:        req.Amount,
+			Message:       "Pagamento recusado pelo emissor",
+		}, nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.transactions[transactionID] = &fakeTransaction{
+		authorized: req.Amount,
+		async:      IsAsyncMethod(req.Method),
+	}
+
+	// Pix e boleto ficam pendentes até a confirmação via webhook
+	if IsAsyncMethod(req.Method) {
+		return Result{
+			TransactionID: transactionID,
+			Status:        StatusPending,
+			Amount:        req.Amount,
+			Instructions:  g.instructions(req, transactionID),
+		}, nil
+	}
+
+	return Result{
+		TransactionID: transactionID,
+		Status:        StatusAuthorized,
+		Amount:        req.Amount,
+	}, nil
+}
+
+// Capture efetiva um valor até o limite autorizado
+func (g *FakeGateway) Capture(ctx context.Context, transactionID string, amount money.Amount) (Result, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	t, ok := g.transactions[transactionID]
+	if !ok {
+		return Result{}, ErrTransactionNotFound
+	}
+	if t.voided || t.captured > 0 {
+		return Result{}, ErrInvalidOperation
+	}
+	if amount <= 0 || amount > t.authorized {
+		return Result{}, ErrInvalidAmount
+	}
+
+	t.captured = amount
+	return Result{
+		TransactionID: transactionID,
+		Status:        StatusPaid,
+		Amount:        amount,
+	}, nil
+}
+
+// Refund devolve um valor até o limite capturado ainda não reembolsado
+func (g *FakeGateway) Refund(ctx context.Context, transactionID string, amount money.Amount) (Result, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	t, ok := g.transactions[transactionID]
+	if !ok {
+		return Result{}, ErrTransactionNotFound
+	}
+	// Pix e boleto são liquidados fora do gateway, então o valor pago é o valor cobrado
+	captured := t.captured
+	if captured == 0 && t.async && !t.voided {
+		captured = t.authorized
+	}
+	if captured == 0 {
+		return Result{}, ErrInvalidOperation
+	}
+
+	available := captured - t.refunded
+	if amount <= 0 || amount > available {
+		return Result{}, ErrInvalidAmount
+	}
+
+	t.refunded += amount
+	status := StatusPartiallyRefunded
+	if t.refunded >= captured {
+		status = StatusRefunded
+	}
+
+	return Result{
+		TransactionID: transactionID,
+		Status:        status,
+		Amount:        amount,
+	}, nil
+}
+
+// Void cancela uma autorização que ainda não foi capturada
+func (g *FakeGateway) Void(ctx context.Context, transactionID string) (Result, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	t, ok := g.transactions[transactionID]
+	if !ok {
+		return Result{}, ErrTransactionNotFound
+	}
+	if t.captured > 0 {
+		return Result{}, ErrInvalidOperation
+	}
+
+	t.voided = true
+	return Result{
+		TransactionID: transactionID,
+		Status:        StatusVoided,
+		Amount:        t.authorized,
+	}, nil
+}
+
+// Gera o código Pix ou o boleto da transação
+func (g *FakeGateway) instructions(req AuthorizeRequest, transactionID string) *Instructions {
+	now := time.Now()
+
+	if req.Method == MethodPix {
+		return &Instructions{
+			PixPayload: PixPayload(g.config.Pix, transactionID, req.Amount),
+			ExpiresAt:  now.Add(g.config.PixExpiration),
+		}
+	}
+
+	// O boleto vence ao fim do dia do vencimento, mas só expira depois da carência de
+	// compensação, para não cancelar boletos pagos que ainda não foram compensados
+	due := now.AddDate(0, 0, g.config.BoletoDueDays)
+	endOfDue := time.Date(due.Year(), due.Month(), due.Day(), 23, 59, 59, 0, due.Location())
+	sum := sha256.Sum256([]byte(transactionID))
+	boleto := NewBoleto(g.config.Boleto, binary.BigEndian.Uint64(sum[:8]), req.Amount, due)
+
+	return &Instructions{
+		BoletoBarcode:       boleto.Barcode,
+		BoletoDigitableLine: boleto.DigitableLine,
+		ExpiresAt:           endOfDue.AddDate(0, 0, g.config.BoletoSettlementDays),
+	}
+}
+
+// O ID da transação é derivado do pedido para que execuções repetidas sejam reproduzíveis
+func fakeTransactionID(orderID, method string) string {
+	sum := sha256.Sum256([]byte(orderID + ":" + method))
+	return "fake_" + hex.EncodeToString(sum[:8])
+}
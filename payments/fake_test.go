@@ -0,0 +1,42 @@
+package payments
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFakeGatewayBoletoExpiresAfterSettlement(t *testing.T) {
+	tests := []struct {
+		settlementDays int
+	}{
+		{0},
+		{3},
+	}
+
+	for _, tt := range tests {
+		gateway := NewFakeGateway(AsyncConfig{
+			Boleto:               BoletoConfig{BankCode: "001", Agreement: "1234567", Wallet: "18"},
+			BoletoDueDays:        3,
+			BoletoSettlementDays: tt.settlementDays,
+		})
+
+		result, err := gateway.Authorize(context.Background(), AuthorizeRequest{
+			OrderID: "pedido-1",
+			Amount:  1990,
+			Method:  MethodBoleto,
+		})
+		if err != nil {
+			t.Fatalf("Authorize() err = %v", err)
+		}
+		if result.Status != StatusPending || result.Instructions == nil {
+			t.Fatalf("Authorize() = %+v, want pending com instruções", result)
+		}
+
+		due := time.Now().AddDate(0, 0, 3)
+		want := time.Date(due.Year(), due.Month(), due.Day(), 23, 59, 59, 0, due.Location()).AddDate(0, 0, tt.settlementDays)
+		if got := result.Instructions.ExpiresAt; !got.Equal(want) {
+			t.Errorf("carência %d: ExpiresAt = %v, want %v", tt.settlementDays, got, want)
+		}
+	}
+}
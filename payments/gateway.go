@@ -0,0 +1,96 @@
+package payments
+
+import (
+	"context"
+	"errors"
+	"github.com/vtrod/veecomm-api/money"
+	"time"
+)
+
+// Status representa o estado de um pagamento retornado pelo gateway
+type Status string
+
+// Status possíveis de um pagamento
+const (
+	StatusPending           Status = "pending"
+	StatusAuthorized        Status = "authorized"
+	StatusPaid              Status = "paid"
+	StatusDeclined          Status = "declined"
+	StatusRefunded          Status = "refunded"
+	StatusPartiallyRefunded Status = "partially_refunded"
+	StatusVoided            Status = "voided"
+	StatusExpired           Status = "expired"
+)
+
+// Métodos de pagamento aceitos no checkout
+const (
+	MethodCreditCard = "credit_card"
+	MethodDebitCard  = "debit_card"
+	MethodPix        = "pix"
+	MethodBoleto     = "boleto"
+)
+
+// Métodos válidos para Order.payment_method
+var Methods = []string{MethodCreditCard, MethodDebitCard, MethodPix, MethodBoleto}
+
+// IsValidMethod indica se o método de pagamento é suportado
+func IsValidMethod(method string) bool {
+	for _, m := range Methods {
+		if m == method {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAsyncMethod indica se o método é pago fora do checkout e confirmado via webhook
+func IsAsyncMethod(method string) bool {
+	return method == MethodPix || method == MethodBoleto
+}
+
+// Erros retornados pelos gateways de pagamento
+var (
+	ErrInvalidAmount       = errors.New("valor de pagamento inválido")
+	ErrTransactionNotFound = errors.New("transação não encontrada")
+	ErrInvalidOperation    = errors.New("operação não permitida para o estado atual da transação")
+)
+
+// AuthorizeRequest contém os dados para autorizar um pagamento
+type AuthorizeRequest struct {
+	OrderID string
+	Amount  money.Amount
+	Method  string
+	Token   string
+}
+
+// Instructions contém os dados que o cliente usa para pagar um método assíncrono
+type Instructions struct {
+	PixPayload          string    `json:"pix_payload,omitempty"`
+	BoletoBarcode       string    `json:"boleto_barcode,omitempty"`
+	BoletoDigitableLine string    `json:"boleto_digitable_line,omitempty"`
+	ExpiresAt           time.Time `json:"expires_at"`
+}
+
+// Result representa a resposta do gateway para uma operação
+type Result struct {
+	TransactionID string        `json:"transaction_id"`
+	Status        Status        `json:"status"`
+	Amount        money.Amount  `json:"amount"`
+	Message       string        `json:"message,omitempty"`
+	Instructions  *Instructions `json:"instructions,omitempty"`
+}
+
+// PaymentGateway define as operações suportadas por um provedor de pagamento
+type PaymentGateway interface {
+	// Name identifica o provedor (armazenado em cada pagamento)
+	Name() string
+	// Authorize reserva o valor no meio de pagamento do cliente. Para Pix e boleto
+	// o resultado fica pendente e traz as instruções de pagamento.
+	Authorize(ctx context.Context, req AuthorizeRequest) (Result, error)
+	// Capture efetiva a cobrança de um valor autorizado
+	Capture(ctx context.Context, transactionID string, amount money.Amount) (Result, error)
+	// Refund devolve total ou parcialmente um valor capturado
+	Refund(ctx context.Context, transactionID string, amount money.Amount) (Result, error)
+	// Void cancela uma autorização ainda não capturada
+	Void(ctx context.Context, transactionID string) (Result, error)
+}
@@ -0,0 +1,106 @@
+package payments
+
+import (
+	"fmt"
+	"github.com/vtrod/veecomm-api/money"
+	"strings"
+)
+
+// PixConfig identifica o recebedor nas cobranças Pix
+type PixConfig struct {
+	Key          string
+	MerchantName string
+	MerchantCity string
+}
+
+// PixPayload monta o código "copia e cola" de uma cobrança Pix estática no formato
+// BR Code (EMV-MPM), terminando com o CRC16 de todo o conteúdo
+func PixPayload(cfg PixConfig, txid string, amount money.Amount) string {
+	merchantAccount := emvField("00", "br.gov.bcb.pix") + emvField("01", cfg.Key)
+
+	var b strings.Builder
+	b.WriteString(emvField("00", "01"))
+	b.WriteString(emvField("26", merchantAccount))
+	b.WriteString(emvField("52", "0000"))
+	b.WriteString(emvField("53", "986"))
+	b.WriteString(emvField("54", amount.String()))
+	b.WriteString(emvField("58", "BR"))
+	b.WriteString(emvField("59", pixText(cfg.MerchantName, 25)))
+	b.WriteString(emvField("60", pixText(cfg.MerchantCity, 15)))
+	b.WriteString(emvField("62", emvField("05", pixTxID(txid))))
+
+	// O CRC é calculado sobre o payload incluindo o ID e o tamanho do próprio campo
+	b.WriteString("6304")
+	payload := b.String()
+	return payload + fmt.Sprintf("%04X", crc16CCITT([]byte(payload)))
+}
+
+// Campo EMV no formato ID (2 dígitos) + tamanho (2 dígitos) + valor
+func emvField(id, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// O txid aceita até 25 caracteres alfanuméricos
+func pixTxID(txid string) string {
+	var b strings.Builder
+	for _, r := range txid {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	id := b.String()
+	if id == "" {
+		return "***"
+	}
+	if len(id) > 25 {
+		id = id[:25]
+	}
+	return id
+}
+
+// Letras acentuadas maiúsculas e suas versões sem acento
+var pixFolds = map[rune]rune{
+	'Á': 'A', 'À': 'A', 'Â': 'A', 'Ã': 'A', 'Ä': 'A',
+	'É': 'E', 'È': 'E', 'Ê': 'E', 'Ë': 'E',
+	'Í': 'I', 'Ì': 'I', 'Î': 'I', 'Ï': 'I',
+	'Ó': 'O', 'Ò': 'O', 'Ô': 'O', 'Õ': 'O', 'Ö': 'O',
+	'Ú': 'U', 'Ù': 'U', 'Û': 'U', 'Ü': 'U',
+	'Ç': 'C', 'Ñ': 'N',
+}
+
+// Nome e cidade do recebedor vão em maiúsculas, sem acentos e só com caracteres
+// ASCII, para que o tamanho em bytes informado no campo EMV seja o tamanho real e
+// o limite do campo (em bytes) não seja ultrapassado
+func pixText(s string, max int) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(strings.TrimSpace(s)) {
+		if folded, ok := pixFolds[r]; ok {
+			r = folded
+		}
+		if r >= ' ' && r <= '~' {
+			b.WriteRune(r)
+		}
+	}
+
+	text := b.String()
+	if len(text) > max {
+		text = text[:max]
+	}
+	return strings.TrimSpace(text)
+}
+
+// CRC16-CCITT (polinômio 0x1021, valor inicial 0xFFFF), exigido pelo BR Code
+func crc16CCITT(data []byte) uint16 {
+	crc := uint16(0xFFFF)
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
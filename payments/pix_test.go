@@ -0,0 +1,83 @@
+package payments
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCRC16CCITT(t *testing.T) {
+	tests := []struct {
+		data string
+		want uint16
+	}{
+		// Valor de verificação do CRC-16/CCITT-FALSE
+		{"123456789", 0x29B1},
+		// Exemplo do manual do BR Code do Banco Central, sem os 4 dígitos do CRC
+		{"00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***6304", 0x1D3D},
+	}
+
+	for _, tt := range tests {
+		if got := crc16CCITT([]byte(tt.data)); got != tt.want {
+			t.Errorf("crc16CCITT(%q) = %04X, want %04X", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestPixText(t *testing.T) {
+	tests := []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"São Paulo", 15, "SAO PAULO"},
+		{"Florianópolis", 15, "FLORIANOPOLIS"},
+		{"  Brasília ", 15, "BRASILIA"},
+		{"Loja de Calçados Açaí & Cia Ltda", 25, "LOJA DE CALCADOS ACAI & C"},
+		{"Rio de Janeiro Capital", 15, "RIO DE JANEIRO"},
+		{"Café ☕ Bom", 25, "CAFE  BOM"},
+	}
+
+	for _, tt := range tests {
+		got := pixText(tt.in, tt.max)
+		if got != tt.want {
+			t.Errorf("pixText(%q, %d) = %q, want %q", tt.in, tt.max, got, tt.want)
+		}
+		if len(got) > tt.max {
+			t.Errorf("pixText(%q, %d) has %d bytes", tt.in, tt.max, len(got))
+		}
+	}
+}
+
+func TestPixPayload(t *testing.T) {
+	cfg := PixConfig{
+		Key:          "123e4567-e12b-12d1-a456-426655440000",
+		MerchantName: "Lojas Ação e Companhia Limitada",
+		MerchantCity: "São José dos Campos",
+	}
+	payload := PixPayload(cfg, "pedido-42", 1990)
+
+	for _, field := range []string{
+		"000201",
+		"26580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-426655440000",
+		"540519.90",
+		"5925LOJAS ACAO E COMPANHIA LI",
+		"6015SAO JOSE DOS CA",
+		"62120508pedido42",
+	} {
+		if !strings.Contains(payload, field) {
+			t.Errorf("payload %q does not contain %q", payload, field)
+		}
+	}
+
+	// O payload é ASCII e termina com o CRC do restante
+	for i := 0; i < len(payload); i++ {
+		if payload[i] > '~' {
+			t.Fatalf("payload has a non-ASCII byte at %d: %q", i, payload)
+		}
+	}
+	body, crc := payload[:len(payload)-4], payload[len(payload)-4:]
+	if want := fmt.Sprintf("%04X", crc16CCITT([]byte(body))); crc != want {
+		t.Errorf("CRC = %s, want %s", crc, want)
+	}
+}
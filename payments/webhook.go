@@ -0,0 +1,92 @@
+package payments
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"github.com/vtrod/veecomm-api/money"
+	"strings"
+)
+
+// Cabeçalho HTTP que carrega a assinatura HMAC do corpo do webhook
+const SignatureHeader = "X-Webhook-Signature"
+
+// EventType identifica o tipo de evento notificado pelo provedor
+type EventType string
+
+// Tipos de evento suportados
+const (
+	EventPaymentPaid    EventType = "payment.paid"
+	EventPaymentExpired EventType = "payment.expired"
+)
+
+// Erros retornados na validação de webhooks
+var (
+	ErrInvalidSignature = errors.New("assinatura do webhook inválida")
+	ErrInvalidEvent     = errors.New("evento de webhook inválido")
+)
+
+// WebhookEvent é a notificação assíncrona enviada pelo provedor de pagamento
+type WebhookEvent struct {
+	ID            string       `json:"id"`
+	Type          EventType    `json:"type"`
+	TransactionID string       `json:"transaction_id"`
+	Amount        money.Amount `json:"amount"`
+}
+
+// WebhookVerifier valida a assinatura e decodifica os eventos de um provedor
+type WebhookVerifier interface {
+	ParseEvent(payload []byte, signature string) (WebhookEvent, error)
+}
+
+// HMACVerifier valida webhooks assinados com HMAC-SHA256 sobre o corpo da requisição
+type HMACVerifier struct {
+	secret []byte
+}
+
+// NewHMACVerifier cria um verificador com o segredo compartilhado com o provedor
+func NewHMACVerifier(secret string) *HMACVerifier {
+	return &HMACVerifier{secret: []byte(secret)}
+}
+
+// Sign calcula a assinatura esperada para um corpo, no formato "sha256=<hex>"
+func (v *HMACVerifier) Sign(payload []byte) string {
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ParseEvent confere a assinatura em tempo constante e decodifica o evento. A assinatura
+// deve vir no formato "sha256=<hex>".
+func (v *HMACVerifier) ParseEvent(payload []byte, signature string) (WebhookEvent, error) {
+	encoded, ok := strings.CutPrefix(strings.TrimSpace(signature), "sha256=")
+	if !ok {
+		return WebhookEvent{}, ErrInvalidSignature
+	}
+
+	received, err := hex.DecodeString(encoded)
+	if err != nil || len(received) == 0 {
+		return WebhookEvent{}, ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, v.secret)
+	mac.Write(payload)
+	if !hmac.Equal(received, mac.Sum(nil)) {
+		return WebhookEvent{}, ErrInvalidSignature
+	}
+
+	var event WebhookEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		return WebhookEvent{}, ErrInvalidEvent
+	}
+	if event.ID == "" || event.TransactionID == "" {
+		return WebhookEvent{}, ErrInvalidEvent
+	}
+	if event.Type != EventPaymentPaid && event.Type != EventPaymentExpired {
+		return WebhookEvent{}, ErrInvalidEvent
+	}
+
+	return event, nil
+}
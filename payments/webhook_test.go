@@ -0,0 +1,65 @@
+package payments
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHMACVerifierParseEvent(t *testing.T) {
+	verifier := NewHMACVerifier("segredo")
+	payload := []byte(`{"id":"evt_1","type":"payment.paid","transaction_id":"tx_1","amount":19.90}`)
+	signature := verifier.Sign(payload)
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		wantErr   error
+	}{
+		{"assinatura válida", payload, signature, nil},
+		{"assinatura com espaços", payload, " " + signature + " ", nil},
+		{"segredo errado", payload, NewHMACVerifier("outro").Sign(payload), ErrInvalidSignature},
+		{"sem prefixo sha256=", payload, strings.TrimPrefix(signature, "sha256="), ErrInvalidSignature},
+		{"assinatura vazia", payload, "", ErrInvalidSignature},
+		{"assinatura que não é hex", payload, "sha256=zz", ErrInvalidSignature},
+		{"corpo adulterado", []byte(strings.Replace(string(payload), "19.90", "0.01", 1)), signature, ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		event, err := verifier.ParseEvent(tt.payload, tt.signature)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: ParseEvent() err = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr != nil {
+			continue
+		}
+		want := WebhookEvent{ID: "evt_1", Type: EventPaymentPaid, TransactionID: "tx_1", Amount: 1990}
+		if event != want {
+			t.Errorf("%s: ParseEvent() = %+v, want %+v", tt.name, event, want)
+		}
+	}
+}
+
+func TestHMACVerifierParseEventInvalid(t *testing.T) {
+	verifier := NewHMACVerifier("segredo")
+
+	tests := []struct {
+		name    string
+		payload string
+	}{
+		{"json inválido", `{"id":`},
+		{"sem id", `{"type":"payment.paid","transaction_id":"tx_1"}`},
+		{"sem transação", `{"id":"evt_1","type":"payment.paid"}`},
+		{"tipo desconhecido", `{"id":"evt_1","type":"payment.refunded","transaction_id":"tx_1"}`},
+	}
+
+	for _, tt := range tests {
+		payload := []byte(tt.payload)
+		_, err := verifier.ParseEvent(payload, verifier.Sign(payload))
+		if !errors.Is(err, ErrInvalidEvent) {
+			t.Errorf("%s: ParseEvent() err = %v, want %v", tt.name, err, ErrInvalidEvent)
+		}
+	}
+}
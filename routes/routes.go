@@ -9,44 +9,62 @@ import (
 
 // SetupRoutes configura todas as rotas da aplicação
 func SetupRoutes(app *fiber.App) {
+	// Chaves públicas para validação dos tokens por outros serviços
+	app.Get("/.well-known/jwks.json", controllers.GetJWKS)
+
 	// API grupo de rotas
 	api := app.Group("/api")
 	
 	// 1. Rotas de Produtos (Products)
 	products := api.Group("/products")
 	products.Get("/", controllers.GetAllProducts)                     // Listar todos os produtos
+	products.Get("/search", controllers.SearchProducts)               // Buscar produtos com filtros e facetas
+	products.Get("/slug/:slug", controllers.GetProductBySlug)         // Obter produto pelo slug
 	products.Get("/:id", controllers.GetProduct)                      // Obter detalhes de um produto
 	products.Get("/category/:categoryId", controllers.GetProductsByCategory) // Listar produtos por categoria
 	products.Get("/promotions", controllers.GetPromotionProducts)     // Listar produtos em promoção
-	products.Post("/", middleware.Protected, middleware.AdminOnly, controllers.CreateProduct)                     // Criar novo produto
-	products.Put("/:id", middleware.Protected, middleware.AdminOnly, controllers.UpdateProduct)                   // Atualizar produto
-	products.Delete("/:id", middleware.Protected, middleware.AdminOnly, controllers.DeleteProduct)                // Deletar produto
+	products.Post("/", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.CreateProduct)                     // Criar novo produto
+	products.Put("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.UpdateProduct)                   // Atualizar produto
+	products.Delete("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.DeleteProduct)                // Deletar produto
+	products.Put("/:id/options", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.SetProductOptions)                      // Definir opções (eixos de variação) do produto
+	products.Post("/:id/variants", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.CreateProductVariant)                // Criar variante
+	products.Put("/:id/variants/:variantId", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.UpdateProductVariant)      // Atualizar variante
+	products.Delete("/:id/variants/:variantId", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.DeleteProductVariant)   // Remover variante
 
 	// 2. Rotas de Categorias (Categories)
 	categories := api.Group("/categories")
 	categories.Get("/", controllers.GetAllCategories)                 // Listar todas as categorias
+	categories.Get("/tree", controllers.GetCategoryTree)              // Obter a árvore de categorias
+	categories.Get("/slug/:slug", controllers.GetCategoryBySlug)      // Obter categoria pelo slug
 	categories.Get("/:id", controllers.GetCategory)                   // Obter detalhes de uma categoria
-	categories.Post("/", middleware.Protected, middleware.AdminOnly, controllers.CreateCategory)                  // Criar nova categoria
-	categories.Put("/:id", middleware.Protected, middleware.AdminOnly, controllers.UpdateCategory)                // Atualizar categoria
-	categories.Delete("/:id", middleware.Protected, middleware.AdminOnly, controllers.DeleteCategory)             // Deletar categoria
+	categories.Post("/", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.CreateCategory)                  // Criar nova categoria
+	categories.Put("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.UpdateCategory)                // Atualizar categoria
+	categories.Delete("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCatalogWrite), controllers.DeleteCategory)             // Deletar categoria
 
-	// 3. Rotas de Carrinho (Cart)
-	cart := api.Group("/cart", middleware.Protected)
+	// 3. Rotas de Carrinho (Cart) - visitantes usam o token de carrinho (cookie ou X-Cart-Token)
+	cart := api.Group("/cart")
 	cart.Get("/", controllers.GetCart)                                // Obter itens do carrinho
 	cart.Post("/items", controllers.AddToCart)                        // Adicionar item ao carrinho
 	cart.Put("/items/:itemId", controllers.UpdateCartItem)            // Atualizar quantidade de item
 	cart.Delete("/items/:itemId", controllers.RemoveCartItem)         // Remover item do carrinho
 	cart.Post("/coupon", controllers.ApplyCoupon)                     // Aplicar cupom de desconto
 	cart.Delete("/coupon", controllers.RemoveCoupon)                  // Remover cupom de desconto
+	cart.Put("/shipping", controllers.SetCartShipping)                // Selecionar modalidade de frete
+	cart.Post("/acknowledge", controllers.AcknowledgeCartChanges)     // Confirmar alterações de preço e disponibilidade
 	cart.Delete("/", controllers.ClearCart)                           // Limpar carrinho
 
 	// 4. Rotas de Pedidos (Orders)
-	orders := api.Group("/orders", middleware.Protected)
-	orders.Get("/", controllers.GetUserOrders)                        // Listar pedidos do usuário
+	// Compras sem cadastro: criar pedido e consultá-lo com o token de consulta do pedido
+	orders := api.Group("/orders")
+	orders.Get("/", middleware.Protected, controllers.GetUserOrders)                        // Listar pedidos do usuário
 	orders.Get("/:id", controllers.GetOrder)                          // Obter detalhes de um pedido
+	orders.Get("/:id/history", middleware.Protected, controllers.GetOrderHistory)           // Obter histórico de status do pedido
 	orders.Post("/", controllers.CreateOrder)                         // Criar novo pedido
-	orders.Put("/:id/status", middleware.AdminOnly, controllers.UpdateOrderStatus)          // Atualizar status do pedido
-	orders.Delete("/:id", controllers.CancelOrder)                    // Cancelar/deletar pedido
+	orders.Post("/claim", middleware.Protected, controllers.ClaimGuestOrders)               // Vincular pedidos feitos sem cadastro
+	orders.Put("/:id/status", middleware.Protected, middleware.RequirePermission(middleware.PermOrdersManage), controllers.UpdateOrderStatus)          // Atualizar status do pedido
+	orders.Delete("/:id", middleware.Protected, controllers.CancelOrder)                    // Cancelar/deletar pedido
+	orders.Post("/:id/returns", middleware.Protected, controllers.RequestReturn)            // Solicitar devolução de itens
+	orders.Get("/:id/returns", middleware.Protected, controllers.GetOrderReturns)           // Listar devoluções do pedido
 
 	// 5. Rotas de Avaliações (Reviews)
 	api.Get("/products/:productId/reviews", controllers.GetProductReviews)          // Listar avaliações de um produto
@@ -67,28 +85,57 @@ func SetupRoutes(app *fiber.App) {
 	auth := api.Group("/auth")
 	auth.Post("/login", controllers.LoginUser)                        // Login de usuário
 	auth.Post("/register", controllers.RegisterUser)                  // Registrar novo usuário
+	auth.Post("/refresh", controllers.RefreshToken)                   // Renovar tokens (rotação do refresh token)
+	auth.Post("/logout", controllers.LogoutUser)                      // Encerrar sessão
+	auth.Post("/2fa", controllers.VerifyTwoFactorLogin)               // Concluir login com o código de dois fatores
+	auth.Post("/forgot-password", controllers.ForgotPassword)         // Solicitar link de redefinição de senha
+	auth.Post("/reset-password", controllers.ResetPassword)           // Redefinir senha com o token recebido
+	auth.Post("/verify-email", controllers.VerifyEmail)               // Confirmar email com o token recebido
 	
 	users := api.Group("/users", middleware.Protected)
 	users.Get("/profile", controllers.GetUserProfile)                 // Obter perfil do usuário
 	users.Put("/profile", controllers.UpdateUserProfile)              // Atualizar perfil do usuário
+	users.Post("/verify-email", controllers.SendVerificationEmail)    // Reenviar link de verificação de email
+	users.Post("/2fa/setup", controllers.SetupTwoFactor)              // Gerar segredo TOTP e códigos de recuperação
+	users.Post("/2fa/enable", controllers.EnableTwoFactor)            // Ativar 2FA confirmando um código
+	users.Delete("/2fa", controllers.DisableTwoFactor)                // Desativar 2FA
+	users.Get("/sessions", controllers.GetUserSessions)               // Listar sessões ativas
+	users.Delete("/sessions", controllers.DeleteUserSessions)         // Encerrar todas as outras sessões
+	users.Delete("/sessions/:id", controllers.DeleteUserSession)      // Encerrar uma sessão
 
 	// 8. Rotas de Frete e Entrega (Shipping)
 	shipping := api.Group("/shipping")
 	shipping.Post("/calculate", controllers.CalculateShipping)        // Calcular custo de frete
-	shipping.Get("/:orderId/track", middleware.Protected, controllers.TrackShipping)        // Rastrear entrega
+	shipping.Get("/:orderId/track", controllers.TrackShipping)        // Rastrear entrega (dono, admin ou token de consulta)
+	shipping.Post("/:orderId/shipment", middleware.Protected, middleware.RequirePermission(middleware.PermOrdersManage), controllers.CreateShipment)   // Registrar remessa do pedido (admin)
+	shipping.Post("/:orderId/events", middleware.Protected, middleware.RequirePermission(middleware.PermOrdersManage), controllers.AddTrackingEvent)   // Adicionar evento de rastreamento (admin)
 	
-	// 9. Rotas de Cupons (Coupons)
+	// 9. Rotas de Pagamentos (Payments)
+	paymentRoutes := api.Group("/payments")
+	paymentRoutes.Post("/webhook/:provider", controllers.HandlePaymentWebhook)   // Receber notificações do provedor de pagamento
+
+	// 10. Rotas de Cupons (Coupons)
 	coupons := api.Group("/coupons")
-	coupons.Get("/", middleware.Protected, middleware.AdminOnly, controllers.GetAllCoupons)                       // Listar todos os cupons (admin)
-	coupons.Get("/:id", middleware.Protected, middleware.AdminOnly, controllers.GetCoupon)                        // Obter detalhes de um cupom (admin)
+	coupons.Get("/", middleware.Protected, middleware.RequirePermission(middleware.PermCouponsManage), controllers.GetAllCoupons)                       // Listar todos os cupons (admin)
+	coupons.Get("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCouponsManage), controllers.GetCoupon)                        // Obter detalhes de um cupom (admin)
 	coupons.Post("/validate", controllers.ValidateCoupon)             // Validar cupom
-	coupons.Post("/", middleware.Protected, middleware.AdminOnly, controllers.CreateCoupon)                       // Criar novo cupom (admin)
-	coupons.Put("/:id", middleware.Protected, middleware.AdminOnly, controllers.UpdateCoupon)                     // Atualizar cupom (admin)
-	coupons.Delete("/:id", middleware.Protected, middleware.AdminOnly, controllers.DeleteCoupon)                  // Deletar cupom (admin)
+	coupons.Post("/", middleware.Protected, middleware.RequirePermission(middleware.PermCouponsManage), controllers.CreateCoupon)                       // Criar novo cupom (admin)
+	coupons.Put("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCouponsManage), controllers.UpdateCoupon)                     // Atualizar cupom (admin)
+	coupons.Delete("/:id", middleware.Protected, middleware.RequirePermission(middleware.PermCouponsManage), controllers.DeleteCoupon)                  // Deletar cupom (admin)
 
-	// 10. Rota de Administração (Dashboard)
-	admin := api.Group("/admin", middleware.Protected, middleware.AdminOnly)
-	admin.Get("/dashboard", controllers.GetDashboardData)             // Obter dados do dashboard
-	admin.Get("/users", controllers.GetAllUsers)                      // Listar todos os usuários
-	admin.Get("/orders", controllers.GetAllOrders)                    // Listar todos os pedidos
+	// 11. Rota de Administração (Dashboard)
+	admin := api.Group("/admin", middleware.Protected)
+	admin.Get("/dashboard", middleware.RequirePermission(middleware.PermReportsView), controllers.GetDashboardData)   // Obter dados do dashboard
+	admin.Get("/users", middleware.RequirePermission(middleware.PermUsersManage), controllers.GetAllUsers)            // Listar todos os usuários
+	admin.Put("/users/:id/role", middleware.RequirePermission(middleware.PermUsersManage), controllers.UpdateUserRole) // Alterar papel do usuário
+	admin.Post("/users/:id/permissions", middleware.RequirePermission(middleware.PermUsersManage), controllers.GrantUserPermission)               // Conceder permissão
+	admin.Delete("/users/:id/permissions/:permission", middleware.RequirePermission(middleware.PermUsersManage), controllers.RevokeUserPermission) // Revogar permissão
+	admin.Post("/users/:id/unlock", middleware.RequirePermission(middleware.PermUsersManage), controllers.UnlockUser)  // Desbloquear conta após excesso de tentativas de login
+	admin.Get("/login-attempts", middleware.RequirePermission(middleware.PermUsersManage), controllers.GetLoginAttempts) // Auditoria de tentativas de login malsucedidas
+	admin.Get("/orders", middleware.RequirePermission(middleware.PermOrdersManage), controllers.GetAllOrders)         // Listar todos os pedidos
+	admin.Get("/returns", middleware.RequirePermission(middleware.PermOrdersManage), controllers.GetAllReturns)       // Listar devoluções
+	admin.Get("/payments/void-failed", middleware.RequirePermission(middleware.PermOrdersManage), controllers.GetVoidFailedPayments) // Listar autorizações pendentes de cancelamento
+	admin.Post("/payments/:id/void", middleware.RequirePermission(middleware.PermOrdersManage), controllers.RetryPaymentVoid)        // Cancelar manualmente uma autorização
+	admin.Put("/returns/:id/approve", middleware.RequirePermission(middleware.PermOrdersManage), controllers.ApproveReturn) // Aprovar devolução e reembolsar
+	admin.Put("/returns/:id/reject", middleware.RequirePermission(middleware.PermOrdersManage), controllers.RejectReturn)   // Rejeitar devolução
 } 
\ No newline at end of file
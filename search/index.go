@@ -0,0 +1,133 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// Ordenações aceitas na busca
+const (
+	SortRelevance   = "relevance"
+	SortPriceAsc    = "price_asc"
+	SortPriceDesc   = "price_desc"
+	SortRating      = "rating"
+	SortNewest      = "newest"
+	SortBestSelling = "best_selling"
+)
+
+// Limites de paginação da busca
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+// ErrInvalidSort é retornado quando a ordenação pedida não é suportada
+var ErrInvalidSort = errors.New("ordenação inválida")
+
+// Query descreve uma busca de produtos. Filtros com valor zero não são aplicados.
+type Query struct {
+	// Texto buscado no nome, na descrição e no SKU; todas as palavras precisam aparecer
+	Text        string
+	CategoryIDs []string
+	// Faixa de preço sobre o preço de venda atual (o promocional, se houver)
+	MinPrice *money.Amount
+	MaxPrice *money.Amount
+	OnSale   bool
+	InStock  bool
+	// Nota média mínima (0 a 5)
+	MinRating float64
+	Sort      string
+	Page      int
+	Limit     int
+}
+
+// Normalize preenche a ordenação e a paginação padrão e valida a ordenação
+func (q *Query) Normalize() error {
+	switch q.Sort {
+	case "":
+		q.Sort = SortRelevance
+	case SortRelevance, SortPriceAsc, SortPriceDesc, SortRating, SortNewest, SortBestSelling:
+	default:
+		return ErrInvalidSort
+	}
+
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.Limit < 1 {
+		q.Limit = DefaultLimit
+	}
+	if q.Limit > MaxLimit {
+		q.Limit = MaxLimit
+	}
+	return nil
+}
+
+// Offset retorna quantos resultados pular para chegar à página pedida
+func (q Query) Offset() int {
+	return (q.Page - 1) * q.Limit
+}
+
+// CategoryFacet conta os resultados de uma categoria
+type CategoryFacet struct {
+	CategoryID string `json:"category_id"`
+	Name       string `json:"name"`
+	Count      int    `json:"count"`
+}
+
+// PriceFacet conta os resultados em uma faixa de preço. Max zero indica faixa sem
+// limite superior.
+type PriceFacet struct {
+	Min   money.Amount `json:"min"`
+	Max   money.Amount `json:"max,omitempty"`
+	Count int          `json:"count"`
+}
+
+// Facets agrupa as contagens por categoria e por faixa de preço. Cada contagem
+// considera todos os filtros, exceto o do próprio grupo, para que o cliente possa
+// mostrar quantos resultados teria ao trocar de categoria ou de faixa.
+type Facets struct {
+	Categories  []CategoryFacet `json:"categories"`
+	PriceRanges []PriceFacet    `json:"price_ranges"`
+}
+
+// Result é uma página de resultados com o total e as facetas
+type Result struct {
+	Products []*ent.Product `json:"products"`
+	Total    int            `json:"total"`
+	Facets   Facets         `json:"facets"`
+}
+
+// PriceRange é uma faixa de preço [Min, Max) usada nas facetas; Max zero não tem limite
+type PriceRange struct {
+	Min money.Amount
+	Max money.Amount
+}
+
+// Faixas de preço das facetas, em centavos
+var PriceRanges = []PriceRange{
+	{Min: 0, Max: 5000},
+	{Min: 5000, Max: 10000},
+	{Min: 10000, Max: 20000},
+	{Min: 20000, Max: 50000},
+	{Min: 50000},
+}
+
+// SearchIndex define um mecanismo de busca de produtos
+type SearchIndex interface {
+	Search(ctx context.Context, q Query) (Result, error)
+}
+
+// sortCategoryFacets ordena as categorias da que tem mais resultados para a que tem
+// menos, e pelo nome em caso de empate
+func sortCategoryFacets(facets []CategoryFacet) {
+	sort.Slice(facets, func(i, j int) bool {
+		if facets[i].Count != facets[j].Count {
+			return facets[i].Count > facets[j].Count
+		}
+		return facets[i].Name < facets[j].Name
+	})
+}
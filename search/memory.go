@@ -0,0 +1,539 @@
+package search
+
+import (
+	"context"
+	"log"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/hook"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/money"
+)
+
+// Parâmetros do BM25
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Peso de cada campo na frequência dos termos: o nome vale mais que o SKU, que vale
+// mais que a descrição
+const (
+	nameWeight        = 3
+	skuWeight         = 2
+	descriptionWeight = 1
+)
+
+// Peso das expansões de um termo da busca em relação ao termo exato
+const (
+	prefixWeight = 0.8
+	typoWeight   = 0.6
+)
+
+// Tamanho mínimo do prefixo para autocompletar
+const minPrefixLength = 2
+
+// Quantidade de produtos lidos por consulta ao montar o índice
+const buildBatchSize = 500
+
+// MemoryIndex é um índice invertido mantido em memória. Os termos passam por remoção
+// de acentos e redução ao radical, a última palavra da busca é completada por prefixo,
+// palavras sem correspondência aceitam erros de digitação e os resultados são
+// ordenados por BM25. O índice é montado com Build e acompanha as alterações pelo hook
+// registrado com Hook; cada instância da aplicação mantém o próprio índice.
+type MemoryIndex struct {
+	client *ent.Client
+
+	mu       sync.RWMutex
+	docs     map[string]*indexedProduct
+	postings map[string]map[string]int
+	totalLen int
+	// Vocabulário ordenado para a busca por prefixo, refeito quando fica desatualizado
+	vocab      []string
+	vocabDirty bool
+}
+
+// indexedProduct é um produto indexado com a frequência ponderada de cada termo
+type indexedProduct struct {
+	product *ent.Product
+	terms   map[string]int
+	length  int
+}
+
+// NewMemoryIndex cria um índice vazio. O cliente é usado para carregar os produtos e
+// para consultar nomes de categorias e vendas, que não ficam no índice.
+func NewMemoryIndex(client *ent.Client) *MemoryIndex {
+	return &MemoryIndex{
+		client:   client,
+		docs:     make(map[string]*indexedProduct),
+		postings: make(map[string]map[string]int),
+	}
+}
+
+// Build carrega todos os produtos do banco para o índice
+func (idx *MemoryIndex) Build(ctx context.Context) error {
+	lastID := ""
+	for {
+		batch, err := idx.client.Product.
+			Query().
+			Where(product.IDGT(lastID)).
+			Order(product.ByID()).
+			Limit(buildBatchSize).
+			All(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		idx.mu.Lock()
+		for _, prod := range batch {
+			idx.put(prod)
+		}
+		idx.mu.Unlock()
+
+		if len(batch) < buildBatchSize {
+			return nil
+		}
+		lastID = batch[len(batch)-1].ID
+	}
+}
+
+// Len retorna a quantidade de produtos indexados
+func (idx *MemoryIndex) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Hook mantém o índice sincronizado com as alterações de produtos. Os produtos
+// alterados são relidos do banco depois da gravação, ou depois do commit quando a
+// alteração faz parte de uma transação, para que rollbacks não cheguem ao índice.
+func (idx *MemoryIndex) Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.ProductFunc(func(ctx context.Context, m *ent.ProductMutation) (ent.Value, error) {
+			// IDs afetados por atualizações e exclusões, lidos antes da gravação
+			var ids []string
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if created, ok := v.(*ent.Product); ok && m.Op().Is(ent.OpCreate) {
+				ids = []string{created.ID}
+			}
+
+			if tx, err := m.Tx(); err == nil {
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						if err := next.Commit(ctx, tx); err != nil {
+							return err
+						}
+						idx.refreshLogged(ids)
+						return nil
+					})
+				})
+			} else {
+				idx.refreshLogged(ids)
+			}
+			return v, nil
+		})
+	}
+}
+
+// Refresh relê os produtos do banco, atualizando os que existem e removendo os excluídos
+func (idx *MemoryIndex) Refresh(ctx context.Context, ids ...string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	products, err := idx.client.Product.
+		Query().
+		Where(product.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return err
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	found := make(map[string]bool, len(products))
+	for _, prod := range products {
+		idx.put(prod)
+		found[prod.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			idx.remove(id)
+		}
+	}
+	return nil
+}
+
+// refreshLogged atualiza o índice sem interromper a gravação que o disparou. Uma
+// falha deixa o produto desatualizado até a próxima alteração ou reinício.
+func (idx *MemoryIndex) refreshLogged(ids []string) {
+	if err := idx.Refresh(context.Background(), ids...); err != nil {
+		log.Printf("Erro ao atualizar o índice de busca para %v: %v", ids, err)
+	}
+}
+
+// put indexa ou reindexa um produto. Deve ser chamado com o lock de escrita.
+func (idx *MemoryIndex) put(prod *ent.Product) {
+	idx.remove(prod.ID)
+
+	doc := &indexedProduct{product: prod, terms: make(map[string]int)}
+	for _, field := range []struct {
+		text   string
+		weight int
+	}{
+		{prod.Name, nameWeight},
+		{prod.Sku, skuWeight},
+		{prod.Description, descriptionWeight},
+	} {
+		for _, term := range tokenize(field.text) {
+			doc.terms[term] += field.weight
+			doc.length += field.weight
+		}
+	}
+
+	for term, tf := range doc.terms {
+		postings, ok := idx.postings[term]
+		if !ok {
+			postings = make(map[string]int)
+			idx.postings[term] = postings
+			idx.vocabDirty = true
+		}
+		postings[prod.ID] = tf
+	}
+	idx.docs[prod.ID] = doc
+	idx.totalLen += doc.length
+}
+
+// remove tira um produto do índice. Deve ser chamado com o lock de escrita.
+func (idx *MemoryIndex) remove(id string) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+
+	for term := range doc.terms {
+		postings := idx.postings[term]
+		delete(postings, id)
+		if len(postings) == 0 {
+			delete(idx.postings, term)
+			idx.vocabDirty = true
+		}
+	}
+	delete(idx.docs, id)
+	idx.totalLen -= doc.length
+}
+
+// Search retorna a página pedida com o total e as facetas
+func (idx *MemoryIndex) Search(ctx context.Context, q Query) (Result, error) {
+	if err := q.Normalize(); err != nil {
+		return Result{}, err
+	}
+
+	idx.sortVocab()
+
+	idx.mu.RLock()
+	scores, matched := idx.match(q.Text)
+
+	// Filtrar e contar as facetas, cada uma sem o filtro do próprio grupo
+	var hits []*indexedProduct
+	categoryCounts := make(map[string]int)
+	priceCounts := make([]int, len(PriceRanges))
+	for id, doc := range idx.docs {
+		if matched != nil && !matched[id] {
+			continue
+		}
+		prod := doc.product
+		inCategory := matchesCategory(q, prod)
+		inPrice := matchesPrice(q, prod)
+		if !matchesFlags(q, prod) {
+			continue
+		}
+
+		if inPrice && prod.CategoryID != "" {
+			categoryCounts[prod.CategoryID]++
+		}
+		if inCategory {
+			price := effectivePriceOf(prod)
+			for i, r := range PriceRanges {
+				if price >= r.Min && (r.Max == 0 || price < r.Max) {
+					priceCounts[i]++
+				}
+			}
+		}
+		if inCategory && inPrice {
+			hits = append(hits, doc)
+		}
+	}
+	idx.mu.RUnlock()
+
+	if err := idx.sortHits(ctx, q, hits, scores); err != nil {
+		return Result{}, err
+	}
+
+	page := make([]*ent.Product, 0, q.Limit)
+	for i := q.Offset(); i < len(hits) && len(page) < q.Limit; i++ {
+		page = append(page, hits[i].product)
+	}
+
+	categories, err := categoryFacets(ctx, idx.client, categoryCounts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	prices := make([]PriceFacet, len(PriceRanges))
+	for i, r := range PriceRanges {
+		prices[i] = PriceFacet{Min: r.Min, Max: r.Max, Count: priceCounts[i]}
+	}
+
+	return Result{
+		Products: page,
+		Total:    len(hits),
+		Facets:   Facets{Categories: categories, PriceRanges: prices},
+	}, nil
+}
+
+// match calcula a pontuação BM25 dos produtos que contêm todas as palavras da busca.
+// Sem texto retorna nil, indicando que todos os produtos atendem. Deve ser chamado
+// com o lock de leitura.
+func (idx *MemoryIndex) match(text string) (map[string]float64, map[string]bool) {
+	ws := words(text)
+	if len(ws) == 0 {
+		return nil, nil
+	}
+
+	var scores map[string]float64
+	for i, w := range ws {
+		// Pontuação de cada produto nesta palavra: a melhor entre as expansões
+		wordScores := make(map[string]float64)
+		for term, weight := range idx.expand(w, i == len(ws)-1) {
+			for id, tf := range idx.postings[term] {
+				score := weight * idx.bm25(term, tf, idx.docs[id].length)
+				if score > wordScores[id] {
+					wordScores[id] = score
+				}
+			}
+		}
+
+		// Todas as palavras precisam aparecer
+		if scores == nil {
+			scores = wordScores
+			continue
+		}
+		for id := range scores {
+			if s, ok := wordScores[id]; ok {
+				scores[id] += s
+			} else {
+				delete(scores, id)
+			}
+		}
+	}
+
+	matched := make(map[string]bool, len(scores))
+	for id := range scores {
+		matched[id] = true
+	}
+	return scores, matched
+}
+
+// expand retorna os termos do índice que correspondem a uma palavra da busca e o peso
+// de cada um: o radical exato, os termos que começam com a palavra (só na última, que
+// o cliente pode ainda estar digitando) e, se nada disso existir, os termos a poucos
+// erros de digitação. Deve ser chamado com o lock de leitura.
+func (idx *MemoryIndex) expand(word string, last bool) map[string]float64 {
+	terms := make(map[string]float64)
+	stemmed := stem(word)
+	if _, ok := idx.postings[stemmed]; ok {
+		terms[stemmed] = 1
+	}
+
+	if last {
+		for _, prefix := range []string{word, stemmed} {
+			if len(prefix) < minPrefixLength {
+				continue
+			}
+			start := sort.SearchStrings(idx.vocab, prefix)
+			for i := start; i < len(idx.vocab) && strings.HasPrefix(idx.vocab[i], prefix); i++ {
+				if _, ok := terms[idx.vocab[i]]; !ok {
+					terms[idx.vocab[i]] = prefixWeight
+				}
+			}
+		}
+	}
+
+	if len(terms) > 0 {
+		return terms
+	}
+
+	// Tolerância a erros de digitação, proporcional ao tamanho da palavra
+	maxDistance := maxTypos(stemmed)
+	if maxDistance == 0 {
+		return terms
+	}
+	for _, term := range idx.vocab {
+		if d := editDistance(stemmed, term, maxDistance); d <= maxDistance {
+			terms[term] = typoWeight / float64(d)
+		}
+	}
+	return terms
+}
+
+// bm25 calcula a contribuição de um termo para a pontuação de um produto. Deve ser
+// chamado com o lock de leitura.
+func (idx *MemoryIndex) bm25(term string, tf, length int) float64 {
+	n := float64(len(idx.docs))
+	df := float64(len(idx.postings[term]))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	avgLength := float64(idx.totalLen) / n
+	norm := float64(tf) + bm25K1*(1-bm25B+bm25B*float64(length)/avgLength)
+	return idf * float64(tf) * (bm25K1 + 1) / norm
+}
+
+// sortVocab refaz o vocabulário ordenado se algum termo foi incluído ou removido
+func (idx *MemoryIndex) sortVocab() {
+	idx.mu.RLock()
+	dirty := idx.vocabDirty
+	idx.mu.RUnlock()
+	if !dirty {
+		return
+	}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	if !idx.vocabDirty {
+		return
+	}
+	idx.vocab = make([]string, 0, len(idx.postings))
+	for term := range idx.postings {
+		idx.vocab = append(idx.vocab, term)
+	}
+	sort.Strings(idx.vocab)
+	idx.vocabDirty = false
+}
+
+// sortHits ordena os resultados conforme a ordenação pedida, desempatando pelos
+// produtos mais recentes
+func (idx *MemoryIndex) sortHits(ctx context.Context, q Query, hits []*indexedProduct, scores map[string]float64) error {
+	var key func(p *ent.Product) float64
+	switch q.Sort {
+	case SortPriceAsc:
+		key = func(p *ent.Product) float64 { return -float64(effectivePriceOf(p)) }
+	case SortPriceDesc:
+		key = func(p *ent.Product) float64 { return float64(effectivePriceOf(p)) }
+	case SortRating:
+		key = func(p *ent.Product) float64 { return p.Rating }
+	case SortBestSelling:
+		sold, err := idx.soldQuantities(ctx)
+		if err != nil {
+			return err
+		}
+		key = func(p *ent.Product) float64 { return float64(sold[p.ID]) }
+	case SortRelevance:
+		key = func(p *ent.Product) float64 { return scores[p.ID] }
+	}
+
+	sort.SliceStable(hits, func(i, j int) bool {
+		a, b := hits[i].product, hits[j].product
+		if key != nil {
+			if ka, kb := key(a), key(b); ka != kb {
+				return ka > kb
+			}
+		}
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID < b.ID
+	})
+	return nil
+}
+
+// soldQuantities soma a quantidade vendida de cada produto em pedidos não cancelados
+func (idx *MemoryIndex) soldQuantities(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		ProductID string `json:"product_id"`
+		Sum       int    `json:"sum"`
+	}
+
+	err := idx.client.OrderItem.
+		Query().
+		Where(
+			orderitem.ProductIDNotNil(),
+			orderitem.HasOrderWith(order.StatusNEQ(order.StatusCancelled)),
+		).
+		GroupBy(orderitem.FieldProductID).
+		Aggregate(ent.Sum(orderitem.FieldQuantity)).
+		Scan(ctx, &rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sold := make(map[string]int, len(rows))
+	for _, row := range rows {
+		sold[row.ProductID] = row.Sum
+	}
+	return sold, nil
+}
+
+// matchesCategory verifica o filtro de categoria
+func matchesCategory(q Query, prod *ent.Product) bool {
+	if len(q.CategoryIDs) == 0 {
+		return true
+	}
+	for _, id := range q.CategoryIDs {
+		if prod.CategoryID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesPrice verifica a faixa de preço sobre o preço de venda atual
+func matchesPrice(q Query, prod *ent.Product) bool {
+	price := effectivePriceOf(prod)
+	if q.MinPrice != nil && price < *q.MinPrice {
+		return false
+	}
+	if q.MaxPrice != nil && price > *q.MaxPrice {
+		return false
+	}
+	return true
+}
+
+// matchesFlags verifica os filtros de promoção, estoque e nota
+func matchesFlags(q Query, prod *ent.Product) bool {
+	if q.OnSale && !(prod.OnSale && prod.SalePrice > 0) {
+		return false
+	}
+	if q.InStock && prod.Stock <= 0 {
+		return false
+	}
+	return prod.Rating >= q.MinRating
+}
+
+// effectivePriceOf retorna o preço de venda atual: o promocional quando o produto está
+// em promoção com sale_price definido, senão o preço normal
+func effectivePriceOf(prod *ent.Product) money.Amount {
+	if prod.OnSale && prod.SalePrice > 0 {
+		return prod.SalePrice
+	}
+	return prod.Price
+}
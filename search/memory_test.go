@@ -0,0 +1,120 @@
+package search
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"github.com/vtrod/veecomm-api/ent"
+)
+
+// Helper que monta um índice em memória com os produtos, sem banco
+func newTestIndex(products ...*ent.Product) *MemoryIndex {
+	idx := NewMemoryIndex(nil)
+	for _, prod := range products {
+		idx.put(prod)
+	}
+	idx.sortVocab()
+	return idx
+}
+
+func TestMemoryIndexExpand(t *testing.T) {
+	idx := newTestIndex(
+		&ent.Product{ID: "p1", Name: "Camiseta Básica"},
+		&ent.Product{ID: "p2", Name: "Camisa Social"},
+		&ent.Product{ID: "p3", Name: "Notebook Gamer"},
+	)
+
+	tests := []struct {
+		word string
+		last bool
+		want map[string]float64
+	}{
+		// Radical exato, e os termos que começam com ele na última palavra
+		{"camisa", false, map[string]float64{"camis": 1}},
+		{"camisa", true, map[string]float64{"camis": 1, "camiset": prefixWeight}},
+		// Prefixo só vale para a última palavra
+		{"cami", true, map[string]float64{"camis": prefixWeight, "camiset": prefixWeight}},
+		{"c", true, map[string]float64{}},
+		// Sem correspondência, aceita erros conforme o tamanho
+		{"camizeta", false, map[string]float64{"camiset": typoWeight}},
+		{"nottebok", false, map[string]float64{"notebook": typoWeight / 2}},
+		{"cam", false, map[string]float64{}},
+		{"bermuda", false, map[string]float64{}},
+	}
+
+	for _, tt := range tests {
+		if got := idx.expand(tt.word, tt.last); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("expand(%q, %v) = %v, want %v", tt.word, tt.last, got, tt.want)
+		}
+	}
+}
+
+func TestMemoryIndexRanking(t *testing.T) {
+	idx := newTestIndex(
+		&ent.Product{ID: "p1", Name: "Bermuda Azul", Description: "Combina com a camiseta"},
+		&ent.Product{ID: "p2", Name: "Camiseta Azul", Description: "Camiseta de algodão"},
+		&ent.Product{ID: "p3", Name: "Camiseta Preta"},
+		&ent.Product{ID: "p4", Name: "Caneca", Sku: "AZUL-01"},
+	)
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		// O nome pesa mais que a descrição, e todas as palavras precisam aparecer
+		{"camiseta azul", []string{"p2", "p1"}},
+		{"camisetas azul", []string{"p2", "p1"}},
+		{"Camiseta  AZUL", []string{"p2", "p1"}},
+		// A última palavra é completada por prefixo
+		{"camiseta az", []string{"p2", "p1"}},
+		// Erros de digitação
+		{"camizeta preta", []string{"p3"}},
+		// Empate entre p1 e p2 (mesmo peso e tamanho) desfeito pelo id; o SKU pesa menos
+		{"azul", []string{"p1", "p2", "p4"}},
+		{"vermelha", nil},
+	}
+
+	for _, tt := range tests {
+		scores, matched := idx.match(tt.text)
+
+		var hits []*indexedProduct
+		for id := range matched {
+			hits = append(hits, idx.docs[id])
+		}
+		if err := idx.sortHits(context.Background(), Query{Sort: SortRelevance}, hits, scores); err != nil {
+			t.Fatal(err)
+		}
+
+		var got []string
+		for _, hit := range hits {
+			got = append(got, hit.product.ID)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("match(%q) = %v, want %v (scores %v)", tt.text, got, tt.want, scores)
+		}
+	}
+}
+
+func TestMemoryIndexRemove(t *testing.T) {
+	idx := newTestIndex(
+		&ent.Product{ID: "p1", Name: "Camiseta Azul"},
+		&ent.Product{ID: "p2", Name: "Camiseta Preta"},
+	)
+
+	idx.remove("p1")
+	if _, ok := idx.postings["azul"]; ok {
+		t.Error("term only used by the removed product should leave the index")
+	}
+	if got := idx.postings["camiset"]; !reflect.DeepEqual(got, map[string]int{"p2": nameWeight}) {
+		t.Errorf("postings[camiset] = %v", got)
+	}
+	if idx.Len() != 1 || idx.totalLen != idx.docs["p2"].length {
+		t.Errorf("Len = %d, totalLen = %d", idx.Len(), idx.totalLen)
+	}
+
+	// Reindexar substitui os termos antigos
+	idx.put(&ent.Product{ID: "p2", Name: "Caneca"})
+	if _, ok := idx.postings["camiset"]; ok {
+		t.Error("reindexed product kept its old terms")
+	}
+}
@@ -0,0 +1,235 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"github.com/vtrod/veecomm-api/ent"
+	"github.com/vtrod/veecomm-api/ent/category"
+	"github.com/vtrod/veecomm-api/ent/order"
+	"github.com/vtrod/veecomm-api/ent/orderitem"
+	"github.com/vtrod/veecomm-api/ent/predicate"
+	"github.com/vtrod/veecomm-api/ent/product"
+	"github.com/vtrod/veecomm-api/money"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// Grupos de filtros, usados para excluir o filtro do próprio grupo ao contar as facetas
+const (
+	facetNone = iota
+	facetCategory
+	facetPrice
+)
+
+// SQLIndex busca direto no banco com LIKE. Não precisa de manutenção, mas não tolera
+// erros de digitação e a relevância considera apenas se o texto aparece no nome.
+type SQLIndex struct {
+	client *ent.Client
+}
+
+// NewSQLIndex cria um índice que consulta o banco a cada busca
+func NewSQLIndex(client *ent.Client) *SQLIndex {
+	return &SQLIndex{client: client}
+}
+
+// Search retorna a página pedida com o total e as facetas
+func (s *SQLIndex) Search(ctx context.Context, q Query) (Result, error) {
+	if err := q.Normalize(); err != nil {
+		return Result{}, err
+	}
+
+	where := sqlPredicates(q, facetNone)
+	total, err := s.client.Product.Query().Where(where...).Count(ctx)
+	if err != nil {
+		return Result{}, err
+	}
+
+	products, err := s.client.Product.
+		Query().
+		Where(where...).
+		Order(sqlOrder(q)...).
+		Limit(q.Limit).
+		Offset(q.Offset()).
+		All(ctx)
+
+	if err != nil {
+		return Result{}, err
+	}
+
+	categories, err := s.categoryFacets(ctx, q)
+	if err != nil {
+		return Result{}, err
+	}
+
+	prices, err := s.priceFacets(ctx, q)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Products: products,
+		Total:    total,
+		Facets:   Facets{Categories: categories, PriceRanges: prices},
+	}, nil
+}
+
+// categoryFacets conta os resultados por categoria, ignorando o filtro de categoria
+func (s *SQLIndex) categoryFacets(ctx context.Context, q Query) ([]CategoryFacet, error) {
+	var rows []struct {
+		CategoryID string `json:"category_id"`
+		Count      int    `json:"count"`
+	}
+
+	where := append(sqlPredicates(q, facetCategory), product.CategoryIDNotNil())
+	err := s.client.Product.
+		Query().
+		Where(where...).
+		GroupBy(product.FieldCategoryID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.CategoryID] = row.Count
+	}
+	return categoryFacets(ctx, s.client, counts)
+}
+
+// priceFacets conta os resultados em cada faixa de preço, ignorando o filtro de preço
+func (s *SQLIndex) priceFacets(ctx context.Context, q Query) ([]PriceFacet, error) {
+	where := sqlPredicates(q, facetPrice)
+
+	facets := make([]PriceFacet, 0, len(PriceRanges))
+	for _, r := range PriceRanges {
+		bucket := []predicate.Product{effectivePriceP(">=", r.Min)}
+		if r.Max > 0 {
+			bucket = append(bucket, effectivePriceP("<", r.Max))
+		}
+
+		count, err := s.client.Product.
+			Query().
+			Where(where...).
+			Where(bucket...).
+			Count(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+		facets = append(facets, PriceFacet{Min: r.Min, Max: r.Max, Count: count})
+	}
+	return facets, nil
+}
+
+// categoryFacets monta as facetas de categoria com o nome de cada uma, da que tem mais
+// resultados para a que tem menos
+func categoryFacets(ctx context.Context, client *ent.Client, counts map[string]int) ([]CategoryFacet, error) {
+	ids := make([]string, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+
+	categories, err := client.Category.
+		Query().
+		Where(category.IDIn(ids...)).
+		All(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	facets := make([]CategoryFacet, 0, len(categories))
+	for _, cat := range categories {
+		facets = append(facets, CategoryFacet{CategoryID: cat.ID, Name: cat.Name, Count: counts[cat.ID]})
+	}
+	sortCategoryFacets(facets)
+	return facets, nil
+}
+
+// sqlPredicates traduz os filtros da busca, exceto os do grupo informado
+func sqlPredicates(q Query, except int) []predicate.Product {
+	var where []predicate.Product
+
+	// Cada palavra precisa aparecer em algum dos campos
+	for _, term := range strings.Fields(q.Text) {
+		where = append(where, product.Or(
+			product.NameContainsFold(term),
+			product.DescriptionContainsFold(term),
+			product.SkuContainsFold(term),
+		))
+	}
+
+	if len(q.CategoryIDs) > 0 && except != facetCategory {
+		where = append(where, product.CategoryIDIn(q.CategoryIDs...))
+	}
+	if except != facetPrice {
+		if q.MinPrice != nil {
+			where = append(where, effectivePriceP(">=", *q.MinPrice))
+		}
+		if q.MaxPrice != nil {
+			where = append(where, effectivePriceP("<=", *q.MaxPrice))
+		}
+	}
+	if q.OnSale {
+		where = append(where, product.OnSale(true), product.SalePriceGT(0))
+	}
+	if q.InStock {
+		where = append(where, product.StockGT(0))
+	}
+	if q.MinRating > 0 {
+		where = append(where, product.RatingGTE(q.MinRating))
+	}
+	return where
+}
+
+// sqlOrder traduz a ordenação da busca, desempatando pelos produtos mais recentes
+func sqlOrder(q Query) []product.OrderOption {
+	newest := product.ByCreatedAt(sql.OrderDesc())
+
+	switch q.Sort {
+	case SortPriceAsc:
+		return []product.OrderOption{func(s *sql.Selector) { s.OrderExpr(sql.Expr(effectivePrice(s))) }, newest}
+	case SortPriceDesc:
+		return []product.OrderOption{func(s *sql.Selector) { s.OrderExpr(sql.DescExpr(sql.Expr(effectivePrice(s)))) }, newest}
+	case SortRating:
+		return []product.OrderOption{product.ByRating(sql.OrderDesc()), product.ByReviewCount(sql.OrderDesc()), newest}
+	case SortBestSelling:
+		return []product.OrderOption{bestSellingOrder, newest}
+	case SortRelevance:
+		// Produtos com o texto no nome aparecem antes dos que só o têm na descrição ou no SKU
+		if text := strings.TrimSpace(q.Text); text != "" {
+			return []product.OrderOption{func(s *sql.Selector) {
+				s.OrderExpr(sql.DescExpr(sql.ContainsFold(s.C(product.FieldName), text)))
+			}, newest}
+		}
+	}
+	return []product.OrderOption{newest}
+}
+
+// bestSellingOrder ordena pela quantidade vendida em pedidos não cancelados
+func bestSellingOrder(s *sql.Selector) {
+	sold := fmt.Sprintf(
+		"(SELECT COALESCE(SUM(oi.%s), 0) FROM %s oi JOIN %s o ON o.%s = oi.%s WHERE oi.%s = %s AND o.%s <> ?)",
+		orderitem.FieldQuantity, orderitem.Table, order.Table, order.FieldID, orderitem.FieldOrderID,
+		orderitem.FieldProductID, s.C(product.FieldID), order.FieldStatus,
+	)
+	s.OrderExpr(sql.DescExpr(sql.Expr(sold, string(order.StatusCancelled))))
+}
+
+// effectivePriceP compara o preço de venda atual com o valor informado
+func effectivePriceP(op string, value money.Amount) predicate.Product {
+	return predicate.Product(func(s *sql.Selector) {
+		s.Where(sql.ExprP(effectivePrice(s)+" "+op+" ?", value.Cents()))
+	})
+}
+
+// effectivePrice é a expressão SQL do preço de venda atual: o promocional quando o
+// produto está em promoção com sale_price definido, senão o preço normal
+func effectivePrice(s *sql.Selector) string {
+	return fmt.Sprintf("(CASE WHEN %s AND %s > 0 THEN %s ELSE %s END)",
+		s.C(product.FieldOnSale), s.C(product.FieldSalePrice), s.C(product.FieldSalePrice), s.C(product.FieldPrice))
+}
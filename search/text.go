@@ -0,0 +1,155 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Letras acentuadas e suas versões sem acento
+var accentFolds = map[rune]rune{
+	'á': 'a', 'à': 'a', 'â': 'a', 'ã': 'a', 'ä': 'a',
+	'é': 'e', 'è': 'e', 'ê': 'e', 'ë': 'e',
+	'í': 'i', 'ì': 'i', 'î': 'i', 'ï': 'i',
+	'ó': 'o', 'ò': 'o', 'ô': 'o', 'õ': 'o', 'ö': 'o',
+	'ú': 'u', 'ù': 'u', 'û': 'u', 'ü': 'u',
+	'ç': 'c', 'ñ': 'n',
+}
+
+// Palavras comuns que não ajudam a distinguir produtos (já sem acento)
+var stopwords = map[string]bool{
+	"a": true, "o": true, "as": true, "os": true, "e": true, "de": true, "da": true,
+	"do": true, "das": true, "dos": true, "em": true, "na": true, "no": true,
+	"nas": true, "nos": true, "um": true, "uma": true, "para": true, "pra": true,
+	"com": true, "sem": true, "por": true, "ao": true, "aos": true, "que": true,
+}
+
+// fold converte o texto para minúsculas sem acentos
+func fold(text string) string {
+	return strings.Map(func(r rune) rune {
+		r = unicode.ToLower(r)
+		if folded, ok := accentFolds[r]; ok {
+			return folded
+		}
+		return r
+	}, text)
+}
+
+// words separa o texto em palavras sem acento, descartando pontuação e stopwords
+func words(text string) []string {
+	fields := strings.FieldsFunc(fold(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := fields[:0]
+	for _, w := range fields {
+		if !stopwords[w] {
+			kept = append(kept, w)
+		}
+	}
+	return kept
+}
+
+// tokenize separa o texto em termos indexáveis: palavras sem acento e reduzidas ao radical
+func tokenize(text string) []string {
+	ws := words(text)
+	for i, w := range ws {
+		ws[i] = stem(w)
+	}
+	return ws
+}
+
+// Sufixos de plural e suas substituições, do mais específico para o mais genérico
+var pluralSuffixes = []struct {
+	suffix, replacement string
+}{
+	{"oes", "ao"}, {"aes", "ao"}, {"ais", "al"}, {"eis", "el"}, {"ois", "ol"},
+	{"ns", "m"}, {"res", "r"}, {"zes", "z"}, {"ses", "s"},
+}
+
+// Sufixos de diminutivo, removidos antes da vogal final
+var diminutiveSuffixes = []string{"zinhos", "zinhas", "zinho", "zinha", "inhos", "inhas", "inho", "inha"}
+
+// stem reduz uma palavra sem acento a um radical simplificado do português, para que
+// plurais, diminutivos e variações de gênero caiam no mesmo termo ("camisetas",
+// "camiseta" e "camisetinha" viram "camiset"). Palavras curtas e números ficam como estão.
+func stem(w string) string {
+	if len(w) < 4 || !unicode.IsLetter(rune(w[len(w)-1])) {
+		return w
+	}
+
+	// Plural
+	plural := false
+	for _, p := range pluralSuffixes {
+		if strings.HasSuffix(w, p.suffix) && len(w)-len(p.suffix) >= 2 {
+			w = strings.TrimSuffix(w, p.suffix) + p.replacement
+			plural = true
+			break
+		}
+	}
+	if !plural && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") &&
+		!strings.HasSuffix(w, "us") && !strings.HasSuffix(w, "is") {
+		w = strings.TrimSuffix(w, "s")
+	}
+
+	// Diminutivo
+	for _, suffix := range diminutiveSuffixes {
+		if strings.HasSuffix(w, suffix) && len(w)-len(suffix) >= 3 {
+			w = strings.TrimSuffix(w, suffix)
+			break
+		}
+	}
+
+	// Vogal final (gênero e terminações verbais simples)
+	if len(w) > 4 {
+		switch w[len(w)-1] {
+		case 'a', 'e', 'o':
+			w = w[:len(w)-1]
+		}
+	}
+	return w
+}
+
+// editDistance calcula a distância de Levenshtein entre a e b, parando assim que ela
+// passa de max (nesse caso retorna max+1)
+func editDistance(a, b string, max int) int {
+	ra, rb := []rune(a), []rune(b)
+	if diff := len(ra) - len(rb); diff > max || -diff > max {
+		return max + 1
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin > max {
+			return max + 1
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// maxTypos define quantos erros de digitação são tolerados conforme o tamanho do termo
+func maxTypos(term string) int {
+	switch n := len([]rune(term)); {
+	case n < 4:
+		return 0
+	case n < 8:
+		return 1
+	default:
+		return 2
+	}
+}
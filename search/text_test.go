@@ -0,0 +1,143 @@
+package search
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Algodão", "algodao"},
+		{"AÇÚCAR", "acucar"},
+		{"Pão de Ló", "pao de lo"},
+		{"Ñandu Über Crème", "nandu uber creme"},
+		{"iPhone 15", "iphone 15"},
+	}
+
+	for _, tt := range tests {
+		if got := fold(tt.in); got != tt.want {
+			t.Errorf("fold(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Camiseta de Algodão, para o Verão!", []string{"camiseta", "algodao", "verao"}},
+		{"iPhone 15 Pro-Max", []string{"iphone", "15", "pro", "max"}},
+		{"  a o de  ", []string{}},
+		{"", []string{}},
+	}
+
+	for _, tt := range tests {
+		got := words(tt.in)
+		if len(got) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("words(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		// Plurais, gênero e diminutivos caem no mesmo radical
+		{"camiseta", "camiset"},
+		{"camisetas", "camiset"},
+		{"camisetinha", "camiset"},
+		{"camisetinhas", "camiset"},
+		{"vestido", "vestid"},
+		{"vestidos", "vestid"},
+		{"calcas", "calc"},
+		{"botao", "bota"},
+		{"botoes", "bota"},
+		{"capitao", "capita"},
+		{"capitaes", "capita"},
+		{"jornais", "jornal"},
+		{"aneis", "anel"},
+		{"papeis", "papel"},
+		{"lencois", "lencol"},
+		{"bens", "bem"},
+		{"flores", "flor"},
+		{"luzes", "luz"},
+		{"gases", "gas"},
+		// Terminações que não são plural
+		{"onibus", "onibus"},
+		{"lapis", "lapis"},
+		{"stress", "stress"},
+		// Palavras curtas e números ficam como estão
+		{"cor", "cor"},
+		{"usb", "usb"},
+		{"mesa", "mesa"},
+		{"2024", "2024"},
+		{"128gb", "128gb"},
+	}
+
+	for _, tt := range tests {
+		if got := stem(tt.in); got != tt.want {
+			t.Errorf("stem(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	got := tokenize("Camisetas de Algodão com Botões")
+	want := []string{"camiset", "algoda", "bota"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("tokenize = %q, want %q", got, want)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		max  int
+		want int
+	}{
+		{"abc", "abc", 0, 0},
+		{"kitten", "sitting", 3, 3},
+		{"camiset", "camset", 1, 1},
+		{"notebok", "notebook", 1, 1},
+		{"", "abc", 3, 3},
+		{"acao", "ação", 2, 2},
+		// Acima do limite retorna max+1, inclusive pela diferença de tamanho
+		{"kitten", "sitting", 2, 3},
+		{"abc", "abcdef", 2, 3},
+		{"camiset", "bermud", 1, 2},
+	}
+
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b, tt.max); got != tt.want {
+			t.Errorf("editDistance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestMaxTypos(t *testing.T) {
+	tests := []struct {
+		term string
+		want int
+	}{
+		{"", 0},
+		{"cor", 0},
+		{"mesa", 1},
+		{"ação", 1},
+		{"camiset", 1},
+		{"notebook", 2},
+		{"smartphone", 2},
+	}
+
+	for _, tt := range tests {
+		if got := maxTypos(tt.term); got != tt.want {
+			t.Errorf("maxTypos(%q) = %d, want %d", tt.term, got, tt.want)
+		}
+	}
+}
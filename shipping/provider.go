@@ -0,0 +1,135 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"github.com/vtrod/veecomm-api/money"
+	"strings"
+)
+
+// Erros retornados pelos provedores de frete
+var (
+	ErrInvalidCEP       = errors.New("CEP inválido")
+	ErrEstadoMismatch   = errors.New("UF não corresponde ao CEP")
+	ErrNoRatesAvailable = errors.New("nenhuma opção de frete disponível para o destino")
+)
+
+// QuoteRequest contém os dados necessários para cotar um frete
+type QuoteRequest struct {
+	CEP         string
+	Estado      string
+	WeightGrams int
+	Subtotal    money.Amount
+}
+
+// Option representa uma modalidade de entrega cotada
+type Option struct {
+	Service       string       `json:"service"`
+	Name          string       `json:"name"`
+	Price         money.Amount `json:"price"`
+	EstimatedDays int          `json:"estimated_days"`
+	FreeShipping  bool         `json:"free_shipping"`
+}
+
+// ShippingProvider define um provedor capaz de cotar opções de frete
+type ShippingProvider interface {
+	Quote(ctx context.Context, req QuoteRequest) ([]Option, error)
+}
+
+// FindOption retorna a opção correspondente ao serviço informado
+func FindOption(options []Option, service string) (Option, bool) {
+	for _, opt := range options {
+		if opt.Service == service {
+			return opt, true
+		}
+	}
+	return Option{}, false
+}
+
+// NormalizeCEP remove a formatação do CEP e valida seus 8 dígitos
+func NormalizeCEP(cep string) (string, error) {
+	var b strings.Builder
+	for _, r := range cep {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		} else if r != '-' && r != '.' && r != ' ' {
+			return "", ErrInvalidCEP
+		}
+	}
+
+	normalized := b.String()
+	if len(normalized) != 8 {
+		return "", ErrInvalidCEP
+	}
+	return normalized, nil
+}
+
+// Faixas de CEP (5 primeiros dígitos) por estado
+var estadoCEPRanges = []struct {
+	Estado   string
+	From, To int
+}{
+	{"SP", 1000, 19999},
+	{"RJ", 20000, 28999},
+	{"ES", 29000, 29999},
+	{"MG", 30000, 39999},
+	{"BA", 40000, 48999},
+	{"SE", 49000, 49999},
+	{"PE", 50000, 56999},
+	{"AL", 57000, 57999},
+	{"PB", 58000, 58999},
+	{"RN", 59000, 59999},
+	{"CE", 60000, 63999},
+	{"PI", 64000, 64999},
+	{"MA", 65000, 65999},
+	{"PA", 66000, 68899},
+	{"AP", 68900, 68999},
+	{"AM", 69000, 69299},
+	{"RR", 69300, 69399},
+	{"AM", 69400, 69899},
+	{"AC", 69900, 69999},
+	{"DF", 70000, 72799},
+	{"GO", 72800, 72999},
+	{"DF", 73000, 73699},
+	{"GO", 73700, 76799},
+	{"RO", 76800, 76999},
+	{"TO", 77000, 77999},
+	{"MT", 78000, 78899},
+	{"MS", 79000, 79999},
+	{"PR", 80000, 87999},
+	{"SC", 88000, 89999},
+	{"RS", 90000, 99999},
+}
+
+// EstadoFromCEP identifica a UF a partir de um CEP já normalizado
+func EstadoFromCEP(cep string) string {
+	if len(cep) != 8 {
+		return ""
+	}
+
+	prefix := 0
+	for _, r := range cep[:5] {
+		prefix = prefix*10 + int(r-'0')
+	}
+
+	for _, rng := range estadoCEPRanges {
+		if prefix >= rng.From && prefix <= rng.To {
+			return rng.Estado
+		}
+	}
+	return ""
+}
+
+// ResolveEstado deriva a UF de um CEP já normalizado e confere a UF informada, se houver.
+// A UF vem sempre do CEP, para que o destino não possa ser trocado por outro mais barato.
+func ResolveEstado(cep, estado string) (string, error) {
+	derived := EstadoFromCEP(cep)
+	if derived == "" {
+		return "", ErrInvalidCEP
+	}
+
+	if estado = strings.TrimSpace(estado); estado != "" && !strings.EqualFold(estado, derived) {
+		return "", ErrEstadoMismatch
+	}
+	return derived, nil
+}
@@ -0,0 +1,90 @@
+package shipping
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeCEP(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr error
+	}{
+		{"01310-100", "01310100", nil},
+		{"01.310-100", "01310100", nil},
+		{" 20040 020 ", "20040020", nil},
+		{"90010000", "90010000", nil},
+		{"0131010", "", ErrInvalidCEP},
+		{"013101000", "", ErrInvalidCEP},
+		{"01310-10a", "", ErrInvalidCEP},
+		{"", "", ErrInvalidCEP},
+	}
+
+	for _, tt := range tests {
+		got, err := NormalizeCEP(tt.in)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("NormalizeCEP(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestEstadoFromCEP(t *testing.T) {
+	tests := []struct {
+		cep  string
+		want string
+	}{
+		{"01310100", "SP"},
+		{"19999999", "SP"},
+		{"20040020", "RJ"},
+		{"30130010", "MG"},
+		{"69300000", "RR"},
+		{"69400000", "AM"},
+		{"70040010", "DF"},
+		{"72800000", "GO"},
+		{"73000000", "DF"},
+		{"90010000", "RS"},
+		{"00999999", ""},
+		{"0131010", ""},
+	}
+
+	for _, tt := range tests {
+		if got := EstadoFromCEP(tt.cep); got != tt.want {
+			t.Errorf("EstadoFromCEP(%q) = %q, want %q", tt.cep, got, tt.want)
+		}
+	}
+}
+
+func TestResolveEstado(t *testing.T) {
+	tests := []struct {
+		cep     string
+		estado  string
+		want    string
+		wantErr error
+	}{
+		{"01310100", "", "SP", nil},
+		{"01310100", "SP", "SP", nil},
+		{"01310100", " sp ", "SP", nil},
+		{"01310100", "AC", "", ErrEstadoMismatch},
+		{"69900000", "SP", "", ErrEstadoMismatch},
+		{"00999999", "SP", "", ErrInvalidCEP},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveEstado(tt.cep, tt.estado)
+		if got != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("ResolveEstado(%q, %q) = %q, %v, want %q, %v", tt.cep, tt.estado, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestFindOption(t *testing.T) {
+	options := []Option{{Service: "economy"}, {Service: "express"}}
+
+	if opt, ok := FindOption(options, "express"); !ok || opt.Service != "express" {
+		t.Errorf("FindOption(express) = %+v, %v, want express, true", opt, ok)
+	}
+	if _, ok := FindOption(options, "same_day"); ok {
+		t.Errorf("FindOption(same_day) ok = true, want false")
+	}
+}
@@ -0,0 +1,195 @@
+package shipping
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/vtrod/veecomm-api/money"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// CEPRange representa uma faixa de CEPs (inclusiva, 8 dígitos)
+type CEPRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ServiceRate define o preço de uma modalidade de entrega em uma zona
+type ServiceRate struct {
+	Service       string       `json:"service"`
+	Name          string       `json:"name"`
+	BasePrice     money.Amount `json:"base_price"`
+	IncludedGrams int          `json:"included_grams"`
+	PricePerKg    money.Amount `json:"price_per_kg"`
+	EstimatedDays int          `json:"estimated_days"`
+	FreeAbove     money.Amount `json:"free_above,omitempty"`
+}
+
+// Zone agrupa destinos que compartilham a mesma tabela de preços
+type Zone struct {
+	Name      string        `json:"name"`
+	Estados   []string      `json:"estados,omitempty"`
+	CEPRanges []CEPRange    `json:"cep_ranges,omitempty"`
+	Services  []ServiceRate `json:"services"`
+}
+
+// RateTable é a tabela completa usada pelo TableRateProvider
+type RateTable struct {
+	Zones []Zone `json:"zones"`
+}
+
+// TableRateProvider cota fretes a partir de uma tabela de preços por zona
+type TableRateProvider struct {
+	table RateTable
+}
+
+// NewTableRateProvider cria um provedor a partir da tabela informada
+func NewTableRateProvider(table RateTable) *TableRateProvider {
+	return &TableRateProvider{table: table}
+}
+
+// LoadRateTable carrega uma tabela de preços em JSON do disco
+func LoadRateTable(path string) (RateTable, error) {
+	var table RateTable
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return table, err
+	}
+
+	if err := json.Unmarshal(data, &table); err != nil {
+		return table, err
+	}
+	return table, nil
+}
+
+// Quote retorna as modalidades disponíveis para o destino, peso e subtotal
+func (p *TableRateProvider) Quote(ctx context.Context, req QuoteRequest) ([]Option, error) {
+	cep, err := NormalizeCEP(req.CEP)
+	if err != nil {
+		return nil, err
+	}
+
+	estado, err := ResolveEstado(cep, req.Estado)
+	if err != nil {
+		return nil, err
+	}
+
+	zone, ok := p.findZone(cep, estado)
+	if !ok || len(zone.Services) == 0 {
+		return nil, ErrNoRatesAvailable
+	}
+
+	options := make([]Option, 0, len(zone.Services))
+	for _, rate := range zone.Services {
+		options = append(options, rate.quote(req.WeightGrams, req.Subtotal))
+	}
+	return options, nil
+}
+
+// Faixas de CEP têm prioridade sobre a UF para permitir zonas locais
+func (p *TableRateProvider) findZone(cep, estado string) (Zone, bool) {
+	value, _ := strconv.Atoi(cep)
+	for _, zone := range p.table.Zones {
+		for _, rng := range zone.CEPRanges {
+			from, _ := strconv.Atoi(rng.From)
+			to, _ := strconv.Atoi(rng.To)
+			if value >= from && value <= to {
+				return zone, true
+			}
+		}
+	}
+
+	for _, zone := range p.table.Zones {
+		for _, uf := range zone.Estados {
+			if strings.EqualFold(uf, estado) {
+				return zone, true
+			}
+		}
+	}
+	return Zone{}, false
+}
+
+// Calcula o preço da modalidade: base + quilos adicionais, zerando acima do limite de frete grátis
+func (r ServiceRate) quote(weightGrams int, subtotal money.Amount) Option {
+	opt := Option{
+		Service:       r.Service,
+		Name:          r.Name,
+		EstimatedDays: r.EstimatedDays,
+	}
+
+	if r.FreeAbove > 0 && subtotal >= r.FreeAbove {
+		opt.FreeShipping = true
+		return opt
+	}
+
+	price := r.BasePrice
+	if extra := weightGrams - r.IncludedGrams; extra > 0 {
+		// Cada quilo adicional iniciado é cobrado inteiro
+		price += r.PricePerKg.Mul((extra + 999) / 1000)
+	}
+	opt.Price = price
+	return opt
+}
+
+// Helper para montar as modalidades padrão de uma zona (valores em centavos)
+func standardServices(economyBase, economyKg money.Amount, economyDays int, expressBase, expressKg money.Amount, expressDays int, freeAbove money.Amount) []ServiceRate {
+	return []ServiceRate{
+		{
+			Service:       "economy",
+			Name:          "Econômico",
+			BasePrice:     economyBase,
+			IncludedGrams: 1000,
+			PricePerKg:    economyKg,
+			EstimatedDays: economyDays,
+			FreeAbove:     freeAbove,
+		},
+		{
+			Service:       "express",
+			Name:          "Expresso",
+			BasePrice:     expressBase,
+			IncludedGrams: 1000,
+			PricePerKg:    expressKg,
+			EstimatedDays: expressDays,
+		},
+	}
+}
+
+// DefaultRateTable retorna a tabela embutida, organizada por região do Brasil
+func DefaultRateTable() RateTable {
+	return RateTable{
+		Zones: []Zone{
+			{
+				Name:      "Grande São Paulo",
+				CEPRanges: []CEPRange{{From: "01000000", To: "09999999"}},
+				Services:  standardServices(990, 200, 2, 1990, 350, 1, 14900),
+			},
+			{
+				Name:     "Sudeste",
+				Estados:  []string{"SP", "RJ", "MG", "ES"},
+				Services: standardServices(1590, 300, 5, 2990, 500, 2, 19900),
+			},
+			{
+				Name:     "Sul",
+				Estados:  []string{"PR", "SC", "RS"},
+				Services: standardServices(1990, 400, 7, 3990, 700, 3, 24900),
+			},
+			{
+				Name:     "Centro-Oeste",
+				Estados:  []string{"DF", "GO", "MT", "MS"},
+				Services: standardServices(2290, 450, 8, 4490, 800, 4, 29900),
+			},
+			{
+				Name:     "Nordeste",
+				Estados:  []string{"BA", "SE", "AL", "PE", "PB", "RN", "CE", "PI", "MA"},
+				Services: standardServices(2690, 500, 10, 5490, 900, 5, 34900),
+			},
+			{
+				Name:     "Norte",
+				Estados:  []string{"PA", "AP", "AM", "RR", "AC", "RO", "TO"},
+				Services: standardServices(3290, 600, 12, 6490, 1100, 6, 39900),
+			},
+		},
+	}
+}
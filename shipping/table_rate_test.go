@@ -0,0 +1,59 @@
+package shipping
+
+import (
+	"context"
+	"errors"
+	"github.com/vtrod/veecomm-api/money"
+	"testing"
+)
+
+func TestTableRateProviderQuote(t *testing.T) {
+	provider := NewTableRateProvider(DefaultRateTable())
+
+	tests := []struct {
+		name        string
+		req         QuoteRequest
+		wantEconomy money.Amount
+		wantFree    bool
+		wantErr     error
+	}{
+		{"faixa de CEP da Grande São Paulo", QuoteRequest{CEP: "01310-100", WeightGrams: 500, Subtotal: 5000}, 990, false, nil},
+		{"UF derivada do CEP", QuoteRequest{CEP: "20040-020", WeightGrams: 500, Subtotal: 5000}, 1590, false, nil},
+		{"UF informada igual à do CEP", QuoteRequest{CEP: "90010-000", Estado: "rs", WeightGrams: 500, Subtotal: 5000}, 1990, false, nil},
+		{"quilos adicionais arredondados para cima", QuoteRequest{CEP: "20040-020", WeightGrams: 2001, Subtotal: 5000}, 1590 + 2*300, false, nil},
+		{"frete grátis acima do limite", QuoteRequest{CEP: "20040-020", WeightGrams: 500, Subtotal: 19900}, 0, true, nil},
+		{"UF diferente da do CEP", QuoteRequest{CEP: "69900-000", Estado: "SP", WeightGrams: 500}, 0, false, ErrEstadoMismatch},
+		{"CEP inválido", QuoteRequest{CEP: "123"}, 0, false, ErrInvalidCEP},
+		{"CEP sem UF", QuoteRequest{CEP: "00999-999"}, 0, false, ErrInvalidCEP},
+	}
+
+	for _, tt := range tests {
+		options, err := provider.Quote(context.Background(), tt.req)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: Quote() err = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr != nil {
+			continue
+		}
+
+		economy, ok := FindOption(options, "economy")
+		if !ok {
+			t.Errorf("%s: Quote() = %+v, sem a modalidade economy", tt.name, options)
+			continue
+		}
+		if economy.Price != tt.wantEconomy || economy.FreeShipping != tt.wantFree {
+			t.Errorf("%s: economy = %+v, want preço %s e frete grátis %v", tt.name, economy, tt.wantEconomy, tt.wantFree)
+		}
+	}
+}
+
+func TestTableRateProviderNoZone(t *testing.T) {
+	provider := NewTableRateProvider(RateTable{
+		Zones: []Zone{{Name: "Sul", Estados: []string{"PR", "SC", "RS"}, Services: standardServices(1990, 400, 7, 3990, 700, 3, 0)}},
+	})
+
+	if _, err := provider.Quote(context.Background(), QuoteRequest{CEP: "01310-100"}); !errors.Is(err, ErrNoRatesAvailable) {
+		t.Errorf("Quote() err = %v, want %v", err, ErrNoRatesAvailable)
+	}
+}
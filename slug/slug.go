@@ -0,0 +1,74 @@
+package slug
+
+import (
+	"strconv"
+	"strings"
+)
+
+// MaxLength limita o tamanho dos slugs gerados, sem contar o sufixo de desempate
+const MaxLength = 80
+
+// Letras acentuadas e outros caracteres latinos e suas versões em ASCII
+var transliterations = map[rune]string{
+	'á': "a", 'à': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a",
+	'é': "e", 'è': "e", 'ê': "e", 'ë': "e",
+	'í': "i", 'ì': "i", 'î': "i", 'ï': "i",
+	'ó': "o", 'ò': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o",
+	'ú': "u", 'ù': "u", 'û': "u", 'ü': "u",
+	'ç': "c", 'ñ': "n", 'ý': "y", 'ÿ': "y",
+	'æ': "ae", 'œ': "oe", 'ß': "ss",
+	'ª': "a", 'º': "o",
+}
+
+// Make gera um slug a partir de um nome: letras minúsculas sem acento e números,
+// com as palavras separadas por hífen e "&" escrito como "e" ("Camisetas & Regatas
+// Básicas" vira "camisetas-e-regatas-basicas"). Slugs longos são cortados em
+// MaxLength, no fim de uma palavra. Retorna vazio se o nome não tiver letras nem números.
+func Make(name string) string {
+	var b strings.Builder
+	separate := false
+
+	write := func(s string) {
+		if separate && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		separate = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			write(string(r))
+		case r == '&':
+			separate = true
+			write("e")
+			separate = true
+		default:
+			if ascii, ok := transliterations[r]; ok {
+				write(ascii)
+			} else {
+				separate = true
+			}
+		}
+	}
+
+	s := b.String()
+	if len(s) > MaxLength {
+		if cut := strings.LastIndexByte(s[:MaxLength+1], '-'); cut > 0 {
+			s = s[:cut]
+		} else {
+			s = s[:MaxLength]
+		}
+	}
+	return strings.Trim(s, "-")
+}
+
+// WithSuffix acrescenta o número de desempate ao slug: a primeira tentativa usa o
+// slug como está e as seguintes terminam em -2, -3 etc.
+func WithSuffix(slug string, attempt int) string {
+	if attempt <= 1 {
+		return slug
+	}
+	return slug + "-" + strconv.Itoa(attempt)
+}
@@ -0,0 +1,77 @@
+package slug
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{"Camisetas & Regatas Básicas", "camisetas-e-regatas-basicas"},
+		{"Pão de Açúcar", "pao-de-acucar"},
+		{"ÁÉÍÓÚ ÀÂÃ Ç Ñ", "aeiou-aaa-c-n"},
+		{"Æther Straße Œuvre", "aether-strasse-oeuvre"},
+		{"1ª Edição", "1a-edicao"},
+		{"Nº 5", "no-5"},
+		{"  Olá,   Mundo!! ", "ola-mundo"},
+		{"produto---novo", "produto-novo"},
+		{"iPhone 15 Pro/Max", "iphone-15-pro-max"},
+		{"C++ & C#", "c-e-c"},
+		{"&", "e"},
+		{"!!!", ""},
+		{"日本", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Make(tt.name); got != tt.want {
+			t.Errorf("Make(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMakeMaxLength(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		// Corta no fim da última palavra que cabe
+		{strings.Repeat("palavra ", 20), strings.TrimSuffix(strings.Repeat("palavra-", 10), "-")},
+		{strings.Repeat("a", 80) + " b", strings.Repeat("a", 80)},
+		// Uma palavra só, maior que o limite, é cortada no meio
+		{strings.Repeat("a", 100), strings.Repeat("a", 80)},
+		// Exatamente no limite fica como está
+		{strings.Repeat("a", 78) + " b", strings.Repeat("a", 78) + "-b"},
+	}
+
+	for _, tt := range tests {
+		got := Make(tt.name)
+		if got != tt.want {
+			t.Errorf("Make(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+		if len(got) > MaxLength {
+			t.Errorf("Make(%q) has %d bytes, more than %d", tt.name, len(got), MaxLength)
+		}
+	}
+}
+
+func TestWithSuffix(t *testing.T) {
+	tests := []struct {
+		slug    string
+		attempt int
+		want    string
+	}{
+		{"camiseta", 0, "camiseta"},
+		{"camiseta", 1, "camiseta"},
+		{"camiseta", 2, "camiseta-2"},
+		{"camiseta", 10, "camiseta-10"},
+		{"camiseta-2", 3, "camiseta-2-3"},
+	}
+
+	for _, tt := range tests {
+		if got := WithSuffix(tt.slug, tt.attempt); got != tt.want {
+			t.Errorf("WithSuffix(%q, %d) = %q, want %q", tt.slug, tt.attempt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package totp
+
+import (
+	"testing"
+	"time"
+)
+
+// Segredo ASCII "12345678901234567890" dos vetores SHA-1 da RFC 6238 (apêndice B)
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// A RFC usa 8 dígitos; com 6 dígitos o código são os 6 últimos
+	tests := []struct {
+		unix int64
+		rfc  string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1111111111, "14050471"},
+		{1234567890, "89005924"},
+		{2000000000, "69279037"},
+		{20000000000, "65353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code(%d): %v", tt.unix, err)
+		}
+		if want := tt.rfc[len(tt.rfc)-Digits:]; got != want {
+			t.Errorf("Code(%d) = %s, want %s", tt.unix, got, want)
+		}
+	}
+}
+
+func TestCodeInvalidSecret(t *testing.T) {
+	for _, secret := range []string{"", "not base32!"} {
+		if _, err := Code(secret, 1); err != ErrInvalidSecret {
+			t.Errorf("Code(%q) error = %v, want ErrInvalidSecret", secret, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+
+	codeAt := func(step int64) string {
+		code, err := Code(rfcSecret, step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return code
+	}
+
+	tests := []struct {
+		name     string
+		code     string
+		skew     int
+		lastStep int64
+		wantStep int64
+		wantOK   bool
+	}{
+		{"current step", codeAt(current), 1, 0, current, true},
+		{"spaces are ignored", codeAt(current)[:3] + " " + codeAt(current)[3:], 1, 0, current, true},
+		{"previous step within skew", codeAt(current - 1), 1, 0, current - 1, true},
+		{"next step within skew", codeAt(current + 1), 1, 0, current + 1, true},
+		{"outside skew", codeAt(current - 2), 1, 0, 0, false},
+		{"no skew", codeAt(current - 1), 0, 0, 0, false},
+		{"already used step", codeAt(current), 1, current, 0, false},
+		{"later step after used one", codeAt(current + 1), 1, current, current + 1, true},
+		{"wrong length", "12345", 1, 0, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			step, ok := Validate(rfcSecret, tt.code, now, tt.skew, tt.lastStep)
+			if ok != tt.wantOK || step != tt.wantStep {
+				t.Errorf("Validate = (%d, %v), want (%d, %v)", step, ok, tt.wantStep, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestGenerateSecretRoundTrip(t *testing.T) {
+	secret, err := GenerateSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := Code(secret, Step(time.Now()))
+	if err != nil {
+		t.Fatalf("Code with generated secret: %v", err)
+	}
+	if len(code) != Digits {
+		t.Errorf("len(code) = %d, want %d", len(code), Digits)
+	}
+}